@@ -7,20 +7,29 @@ import (
 	"fmt"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/config"
 	_ "github.com/go-park-mail-ru/2024_1_Cyberkotletki/docs"
+	authgrpc "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/grpc/auth"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/grpc/profanity"
+	staticgrpc "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/grpc/static"
 	delivery "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/http"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/http/utils"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/health"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/job"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/logger"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository/postgres"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository/redis"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/service"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/pkg/connector"
 	"github.com/google/uuid"
+	"github.com/labstack/echo-contrib/echoprometheus"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
-	"github.com/labstack/gommon/log"
-	_ "github.com/prometheus/client_golang/prometheus"
 	echoSwagger "github.com/swaggo/echo-swagger"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/labstack/echo/otelecho"
+	"log/slog"
 	"net/http"
 	"os"
 	"os/signal"
@@ -43,16 +52,30 @@ func main() {
 		return
 	}
 
-	logger := log.New("server: ")
 	coreParams := config.ParseCoreServiceParams()
 	authParams := config.ParseAuthServiceParams()
 	staticParams := config.ParseStaticServiceParams()
-	logger.Printf("Параметры запуска сервера: %v \n", coreParams)
+	log, err := logger.Init("core", logger.Config(coreParams.Logging))
+	if err != nil {
+		logger.Fatal("ошибка при инициализации логгера", "error", err)
+	}
+	log.Info("параметры запуска сервера",
+		"addr", coreParams.GetServerAddr(),
+		"metrics_addr", coreParams.Metrics.GetAddr(),
+		"tracing_exporter", coreParams.Tracing.Exporter,
+	)
+
+	shutdownTracing, err := tracing.Init(context.Background(), "core", tracing.Config(coreParams.Tracing))
+	if err != nil {
+		logger.Fatal("ошибка при инициализации трассировки", "error", err)
+	}
 
-	echoServer := Init(logger, coreParams, authParams, staticParams)
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, os.Kill)
 	defer stop()
+	echoServer, importUseCase := Init(ctx, coreParams, authParams, staticParams)
+	metricsServer := metrics.NewServer(coreParams.Metrics.GetAddr())
 	go Run(echoServer, coreParams)
+	go metrics.Run(metricsServer, logger.ForPackage("metrics"))
 
 	<-ctx.Done()
 	ctx, cancel := context.WithTimeout(
@@ -61,54 +84,160 @@ func main() {
 	)
 	defer cancel()
 	Shutdown(ctx, echoServer)
+	// новые импорты после остановки сервера не начнутся, а незавершенные будут помечены неудавшимися при запуске
+	if err := importUseCase.Wait(ctx); err != nil {
+		log.Error("не удалось дождаться завершения импортов", "error", err)
+	}
+	if err := metricsServer.Shutdown(ctx); err != nil {
+		log.Error("ошибка при выключении сервера метрик", "error", err)
+	}
+	if err := shutdownTracing(ctx); err != nil {
+		log.Error("ошибка при выключении трассировки", "error", err)
+	}
 }
 
+// Init подключается к зависимостям, собирает обработчики и запускает фоновые задачи, которые работают до
+// отмены ctx. Импорт возвращается отдельно, чтобы при остановке дождаться запущенных импортов
 func Init(
-	logger echo.Logger,
+	ctx context.Context,
 	coreParams config.Config,
 	authParams config.AuthConfig,
 	staticParams config.StaticConfig,
-) *echo.Echo {
+) (*echo.Echo, usecase.Import) {
 	// DBConn
 	psqlConn, err := connector.GetPostgresConnector(coreParams.Postgres.GetConnectURL())
 	if err != nil {
-		logger.Fatalf("Ошибка при подключении к базе данных: %v", err)
+		logger.Fatal("ошибка при подключении к базе данных", "error", err)
+	}
+	if err = metrics.RegisterPostgresPool(psqlConn.DB, "core"); err != nil {
+		logger.Fatal("ошибка при регистрации метрик базы данных", "error", err)
 	}
 	s3conn, err := connector.GetS3Connector(
 		staticParams.S3.Endpoint, staticParams.S3.Region, staticParams.S3.AccessKeyID, staticParams.S3.SecretAccessKey,
 	)
 	if err != nil {
-		logger.Fatal("Ошибка при подключении к S3: ", err)
+		logger.Fatal("ошибка при подключении к S3", "error", err)
 	}
 	redisConn, err := connector.GetRedisConnector(authParams.Redis.Addr, authParams.Redis.Password, authParams.Redis.DB)
 	if err != nil {
-		logger.Fatal("Ошибка при подключении к Redis: ", err)
+		logger.Fatal("ошибка при подключении к Redis", "error", err)
 	}
 
 	// Repositories
 	userRepo := postgres.NewUserRepository(psqlConn)
-	contentRepo := postgres.NewContentRepository(psqlConn)
+	contentRepo := redis.NewContentCacheRepository(
+		redisConn,
+		postgres.NewContentRepository(psqlConn),
+		coreParams.ContentCache.ContentTTL,
+		coreParams.ContentCache.PreviewTTL,
+	)
 	reviewRepo := postgres.NewReviewRepository(psqlConn)
+	reviewCommentRepo := postgres.NewReviewCommentRepository(psqlConn)
+	reviewModerationRepo := postgres.NewReviewModerationRepository(psqlConn)
 	compilationRepo := postgres.NewCompilationRepository(psqlConn)
 	searchRepo := postgres.NewSearchRepository(psqlConn, contentRepo)
 	favouriteRepo := postgres.NewFavouriteRepository(psqlConn)
+	userRatingRepo := postgres.NewUserRatingRepository(psqlConn)
+	watchProgressRepo := postgres.NewWatchProgressRepository(psqlConn)
+	diaryRepo := postgres.NewDiaryRepository(psqlConn)
+	userListRepo := postgres.NewUserListRepository(psqlConn)
+	privacyRepo := postgres.NewPrivacyRepository(psqlConn)
+	profileRepo := postgres.NewProfileRepository(psqlConn)
+	followRepo := postgres.NewFollowRepository(psqlConn)
+	activityRepo := postgres.NewActivityRepository(psqlConn)
+	importRepo := postgres.NewImportRepository(psqlConn)
+	userStatsRepo := redis.NewUserStatsCacheRepository(
+		redisConn,
+		postgres.NewUserStatsRepository(psqlConn),
+		coreParams.StatsCache.TTL,
+	)
+	recommendationRepo := postgres.NewRecommendationRepository(psqlConn)
+	contentSimilarityRepo := postgres.NewContentSimilarityRepository(psqlConn)
+	chartRepo := postgres.NewChartRepository(psqlConn)
 	staticRepo := postgres.NewStaticRepository(psqlConn, s3conn, staticParams.S3.BucketName, staticParams.MaxFileSize)
 	authRepository := redis.NewSessionRepository(redisConn, authParams.SessionAliveTime)
 
 	// Use Cases
 	profanityUseCase, err := profanity.NewGateway(coreParams.Microservices.ProfanityFilter.Addr)
 	if err != nil {
-		logger.Fatalf("Ошибка при подключении к сервису фильтрации сообщений: %v", err)
+		logger.Fatal("ошибка при подключении к сервису фильтрации сообщений", "error", err)
 	}
 
 	authUseCase := service.NewAuthService(authRepository)
 	staticUseCase := service.NewStaticService(staticRepo)
 	userUseCase := service.NewUserService(userRepo, staticUseCase)
 	contentUseCase := service.NewContentService(contentRepo, staticUseCase, coreParams.ContentSecretKey)
-	reviewUseCase := service.NewReviewService(reviewRepo, userRepo, contentRepo, staticUseCase, profanityUseCase)
+	reviewUseCase := service.NewReviewService(
+		reviewRepo, userRepo, contentRepo, staticUseCase, profanityUseCase, activityRepo, userStatsRepo, reviewModerationRepo,
+	)
+	reviewCommentUseCase := service.NewReviewCommentService(
+		reviewCommentRepo, reviewRepo, userRepo, staticUseCase, profanityUseCase, reviewModerationRepo,
+	)
+	reviewModerationUseCase := service.NewReviewModerationService(
+		reviewModerationRepo, reviewRepo, contentRepo, userStatsRepo, reviewUseCase,
+	)
 	compilationUseCase := service.NewCompilationService(compilationRepo, staticUseCase, contentUseCase)
 	searchUseCase := service.NewSearchService(searchRepo, contentUseCase)
-	favouriteUseCase := service.NewFavouriteService(favouriteRepo, contentUseCase)
+	favouriteUseCase := service.NewFavouriteService(favouriteRepo, contentUseCase, activityRepo, userStatsRepo)
+	userRatingUseCase := service.NewUserRatingService(
+		userRatingRepo, contentRepo, contentUseCase, activityRepo, userStatsRepo,
+	)
+	watchProgressUseCase := service.NewWatchProgressService(
+		watchProgressRepo, contentUseCase, favouriteUseCase, userStatsRepo,
+	)
+	diaryUseCase := service.NewDiaryService(diaryRepo, contentUseCase)
+	privacyUseCase := service.NewPrivacyService(privacyRepo, followRepo)
+	userListUseCase := service.NewUserListService(userListRepo, contentUseCase, privacyUseCase, activityRepo)
+	profileUseCase := service.NewProfileService(
+		profileRepo, userStatsRepo, userUseCase, privacyUseCase, reviewUseCase,
+	)
+	followUseCase := service.NewFollowService(followRepo, userUseCase)
+	feedUseCase := service.NewFeedService(activityRepo, userUseCase, contentUseCase)
+	importUseCase := service.NewImportService(
+		importRepo, userRatingRepo, favouriteRepo, contentRepo, contentUseCase, userStatsRepo,
+		coreParams.Import.MaxConcurrent,
+	)
+	if err = importUseCase.FailInterruptedJobs(ctx); err != nil {
+		logger.Fatal("ошибка при завершении прерванных импортов", "error", err)
+	}
+	recommendationUseCase := service.NewRecommendationService(recommendationRepo, contentUseCase)
+	contentSimilarityUseCase := service.NewContentSimilarityService(contentSimilarityRepo)
+	chartUseCase := service.NewChartService(chartRepo, contentUseCase)
+
+	// Jobs
+	go job.Every(ctx, "recommendation_neighbors",
+		time.Duration(coreParams.Recommendations.RefreshInterval)*time.Second,
+		recommendationUseCase.RefreshNeighbors,
+	)
+	go job.Every(ctx, "similar_content_refresh",
+		time.Duration(coreParams.SimilarContent.RefreshInterval)*time.Second,
+		contentSimilarityUseCase.RefreshSimilarContent,
+	)
+	go job.Every(ctx, "similar_content_rebuild",
+		time.Duration(coreParams.SimilarContent.RebuildInterval)*time.Second,
+		contentSimilarityUseCase.RebuildSimilarContent,
+	)
+	go job.Every(ctx, "charts",
+		time.Duration(coreParams.Charts.RefreshInterval)*time.Second,
+		chartUseCase.RefreshCharts,
+	)
+
+	// Health
+	authGateway, err := authgrpc.NewGateway(coreParams.Microservices.Auth.Addr)
+	if err != nil {
+		logger.Fatal("ошибка при подключении к сервису авторизации", "error", err)
+	}
+	staticGateway, err := staticgrpc.NewGateway(coreParams.Microservices.Static.Addr)
+	if err != nil {
+		logger.Fatal("ошибка при подключении к сервису статики", "error", err)
+	}
+	checker := health.NewChecker(coreParams.Health.GetTimeout()).
+		Add("postgres", health.Postgres(psqlConn.DB)).
+		Add("redis", health.Redis(redisConn)).
+		Add("s3", health.S3(s3conn, staticParams.S3.BucketName)).
+		Add("profanity", profanityUseCase.Ping).
+		Add("auth", health.GRPC(authGateway.Conn())).
+		Add("static", health.GRPC(staticGateway.Conn()))
 
 	sessionManager := utils.NewSessionManager(authUseCase,
 		coreParams.Microservices.Auth.HTTPSessionAliveTime, coreParams.HTTP.SecureCookies)
@@ -119,14 +248,30 @@ func Init(
 	userDelivery := delivery.NewUserEndpoints(userUseCase, authUseCase, staticUseCase, sessionManager)
 	contentDelivery := delivery.NewContentEndpoints(contentUseCase)
 	playgroundDelivery := delivery.NewPlaygroundEndpoints()
-	reviewDelivery := delivery.NewReviewEndpoints(reviewUseCase, authUseCase)
+	reviewDelivery := delivery.NewReviewEndpoints(reviewUseCase, authUseCase, privacyUseCase)
+	reviewCommentDelivery := delivery.NewReviewCommentEndpoints(reviewCommentUseCase, authUseCase)
+	reviewModerationDelivery := delivery.NewReviewModerationEndpoints(reviewModerationUseCase, authUseCase)
 	compilationDelivery := delivery.NewCompilationEndpoints(compilationUseCase)
 	searchDelivery := delivery.NewSearchEndpoints(searchUseCase)
 	ongoingDelivery := delivery.NewOngoingContentEndpoints(contentUseCase, authUseCase)
-	favouriteDelivery := delivery.NewFavouriteEndpoints(favouriteUseCase, authUseCase)
+	favouriteDelivery := delivery.NewFavouriteEndpoints(favouriteUseCase, authUseCase, privacyUseCase)
+	userRatingDelivery := delivery.NewUserRatingEndpoints(userRatingUseCase, authUseCase)
+	watchProgressDelivery := delivery.NewWatchProgressEndpoints(watchProgressUseCase, authUseCase)
+	diaryDelivery := delivery.NewDiaryEndpoints(diaryUseCase, authUseCase)
+	userListDelivery := delivery.NewUserListEndpoints(userListUseCase, authUseCase)
+	profileDelivery := delivery.NewProfileEndpoints(profileUseCase, privacyUseCase, authUseCase)
+	followDelivery := delivery.NewFollowEndpoints(followUseCase, authUseCase)
+	feedDelivery := delivery.NewFeedEndpoints(feedUseCase, authUseCase)
+	importDelivery := delivery.NewImportEndpoints(importUseCase, authUseCase)
+	recommendationDelivery := delivery.NewRecommendationEndpoints(recommendationUseCase, authUseCase)
+	chartDelivery := delivery.NewChartEndpoints(chartUseCase)
+	healthDelivery := delivery.NewHealthEndpoints(checker)
 
 	// REST API
 	echoServer := echo.New()
+	// все логи пишутся в JSON через slog, баннер echo их бы ломал
+	echoServer.HideBanner = true
+	echoServer.HidePort = true
 	echoServer.Server.ReadTimeout = time.Duration(coreParams.HTTP.Server.ReadTimeout) * time.Second
 	echoServer.Server.ReadHeaderTimeout = time.Duration(coreParams.HTTP.Server.ReadTimeout) * time.Second
 	echoServer.Server.WriteTimeout = time.Duration(coreParams.HTTP.Server.WriteTimeout) * time.Second
@@ -136,10 +281,15 @@ func Init(
 	staticAPI := echoServer.Group("")
 	staticDelivery.Configure(staticAPI)
 
+	// healthz, readyz
+	healthAPI := echoServer.Group("")
+	healthDelivery.Configure(healthAPI)
+
 	// middleware
-	// metrics
-	// echoServer.Use(echoprometheus.NewMiddleware("Kinoskop"))
-	// echoServer.GET("/metrics", echoprometheus.NewHandler())
+	// tracing
+	echoServer.Use(otelecho.Middleware("core"))
+	// metrics, сами метрики отдаются на отдельном порту
+	echoServer.Use(echoprometheus.NewMiddleware(metrics.Namespace))
 	// config
 	echoServer.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(ctx echo.Context) error {
@@ -153,9 +303,13 @@ func Init(
 			reqID := uuid.New().String()
 			ctx.Set(echo.HeaderXRequestID, reqID)
 			ctx.Response().Header().Set(echo.HeaderXRequestID, reqID)
+			// ID запроса попадает в спан и в baggage, откуда его получат gRPC сервисы
+			ctx.SetRequest(ctx.Request().WithContext(tracing.WithRequestID(ctx.Request().Context(), reqID)))
 			return next(ctx)
 		}
 	})
+	// logging, добавляет ID запроса, ID пользователя, маршрут и время обработки в каждую запись лога
+	echoServer.Use(logger.EchoMiddleware())
 	// CORS
 	echoServer.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(ctx echo.Context) error {
@@ -193,15 +347,10 @@ func Init(
 		return func(ctx echo.Context) error {
 			defer func() {
 				if recErr := recover(); recErr != nil {
-					reqID := ctx.Get(echo.HeaderXRequestID)
-					if reqID == nil {
-						reqID = "unknown"
-					}
-					logs := fmt.Errorf(
-						"внутренняя ошибка сервера: %v\nRequestID: %v\nStack Trace:\n%s",
-						recErr, reqID, debug.Stack(),
+					logger.ForPackage("http").ErrorContext(ctx.Request().Context(), "паника при обработке запроса",
+						"error", fmt.Sprint(recErr),
+						"stack", string(debug.Stack()),
 					)
-					ctx.Logger().Error(logs)
 					ctx.Error(entity.ErrInternal)
 				}
 			}()
@@ -221,12 +370,17 @@ func Init(
 	// user
 	userAPI := api.Group("/user")
 	userDelivery.Configure(userAPI)
+	profileDelivery.Configure(userAPI)
 	// auth
 	authAPI := api.Group("/auth")
 	authDelivery.Configure(authAPI)
 	// reviews
 	reviewAPI := api.Group("/review")
 	reviewDelivery.Configure(reviewAPI)
+	reviewCommentDelivery.Configure(reviewAPI)
+	// moderation
+	moderationAPI := api.Group("/moderation")
+	reviewModerationDelivery.Configure(moderationAPI)
 	// compilations
 	compilationAPI := api.Group("/compilation")
 	compilationDelivery.Configure(compilationAPI)
@@ -239,17 +393,45 @@ func Init(
 	// favourite
 	favouriteAPI := api.Group("/favourite")
 	favouriteDelivery.Configure(favouriteAPI)
-	return echoServer
+	// ratings
+	ratingAPI := api.Group("/rating")
+	userRatingDelivery.Configure(ratingAPI)
+	// watch progress
+	progressAPI := api.Group("/progress")
+	watchProgressDelivery.Configure(progressAPI)
+	// diary
+	diaryAPI := api.Group("/diary")
+	diaryDelivery.Configure(diaryAPI)
+	// lists
+	listAPI := api.Group("/list")
+	userListDelivery.Configure(listAPI)
+	// follows
+	followAPI := api.Group("/follow")
+	followDelivery.Configure(followAPI)
+	// feed
+	feedAPI := api.Group("/feed")
+	feedDelivery.Configure(feedAPI)
+	// import
+	importAPI := api.Group("/import")
+	importDelivery.Configure(importAPI)
+	// recommendations
+	recommendationAPI := api.Group("/recommendations")
+	recommendationDelivery.Configure(recommendationAPI)
+	// charts
+	chartAPI := api.Group("/chart")
+	chartDelivery.Configure(chartAPI)
+	return echoServer, importUseCase
 }
 
 func Run(server *echo.Echo, params config.Config) {
+	slog.Info("сервер запущен", "addr", params.GetServerAddr())
 	if err := server.Start(params.GetServerAddr()); err != nil && !errors.Is(err, http.ErrServerClosed) {
-		server.Logger.Fatalf("Сервер завершил свою работу по причине: %v\n", err)
+		logger.Fatal("сервер завершил свою работу", "error", err)
 	}
 }
 
 func Shutdown(ctx context.Context, server *echo.Echo) {
 	if err := server.Shutdown(ctx); err != nil {
-		server.Logger.Fatalf("Во время выключения сервера возникла ошибка: %s\n", err)
+		logger.Fatal("ошибка при выключении сервера", "error", err)
 	}
 }
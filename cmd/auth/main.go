@@ -6,46 +6,75 @@ import (
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/config"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/grpc/auth"
 	authProto "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/grpc/auth/proto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/health"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/logger"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository/redis"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/service"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/pkg/connector"
-	"github.com/labstack/gommon/log"
 	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"net"
 	"os/signal"
 	"syscall"
 )
 
 func main() {
-	logger := log.New("Auth Microservice: ")
 	params := config.ParseAuthServiceParams()
-	logger.Printf("Параметры запуска сервера: %v \n", params)
+	log, err := logger.Init("auth", logger.Config(params.Logging))
+	if err != nil {
+		logger.Fatal("ошибка при инициализации логгера", "error", err)
+	}
+
+	shutdownTracing, err := tracing.Init(context.Background(), "auth", tracing.Config(params.Tracing))
+	if err != nil {
+		logger.Fatal("ошибка при инициализации трассировки", "error", err)
+	}
 
 	redisConn, err := connector.GetRedisConnector(params.Redis.Addr, params.Redis.Password, params.Redis.DB)
 	if err != nil {
-		logger.Fatal("Ошибка при подключении к Redis: ", err)
+		logger.Fatal("ошибка при подключении к Redis", "error", err)
 	}
 	authRepository := redis.NewSessionRepository(redisConn, params.SessionAliveTime)
 	authUseCase := service.NewAuthService(authRepository)
 	authService := auth.NewGrpc(authUseCase)
-	server := grpc.NewServer()
+	server := grpc.NewServer(
+		append(append(metrics.ServerOptions(), tracing.ServerOptions()...), logger.ServerOptions()...)...,
+	)
 	authProto.RegisterAuthServiceServer(server, authService)
+	healthServer := grpchealth.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
 	addr := fmt.Sprintf("%s:%d", params.IP, params.Port)
 
 	lis, err := net.Listen("tcp", addr)
 	if err != nil {
-		logger.Fatal("Невозможно прослушать порт:", err)
+		logger.Fatal("невозможно прослушать порт", "addr", addr, "error", err)
 	}
-	logger.Info("Слушаем grpc по адресу", addr)
+	log.Info("слушаем grpc", "addr", addr, "metrics_addr", params.Metrics.GetAddr())
+
+	metricsServer := metrics.NewServer(params.Metrics.GetAddr())
+	go metrics.Run(metricsServer, logger.ForPackage("metrics"))
 
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGKILL)
 	defer stop()
+	// статус health сервиса зависит от доступности зависимостей
+	go health.NewChecker(params.Health.GetTimeout()).Add("redis", health.Redis(redisConn)).
+		Serve(ctx, healthServer, params.Health.GetInterval(), "", authProto.AuthService_ServiceDesc.ServiceName)
 	go func() {
 		err := server.Serve(lis)
 		if err != nil {
-			logger.Error(err)
+			log.Error("grpc сервер завершил свою работу", "error", err)
 		}
 	}()
 	<-ctx.Done()
+	healthServer.Shutdown()
 	server.GracefulStop()
+	if err = metricsServer.Shutdown(context.Background()); err != nil {
+		log.Error("ошибка при выключении сервера метрик", "error", err)
+	}
+	if err = shutdownTracing(context.Background()); err != nil {
+		log.Error("ошибка при выключении трассировки", "error", err)
+	}
 }
@@ -6,52 +6,86 @@ import (
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/config"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/grpc/static"
 	staticProto "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/grpc/static/proto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/health"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/logger"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository/postgres"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/service"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/pkg/connector"
-	"github.com/labstack/gommon/log"
 	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"net"
 	"os/signal"
 	"syscall"
 )
 
 func main() {
-	logger := log.New("Static Microservice: ")
 	params := config.ParseStaticServiceParams()
-	logger.Printf("Параметры запуска сервера: %v \n", params)
+	log, err := logger.Init("static", logger.Config(params.Logging))
+	if err != nil {
+		logger.Fatal("ошибка при инициализации логгера", "error", err)
+	}
+
+	shutdownTracing, err := tracing.Init(context.Background(), "static", tracing.Config(params.Tracing))
+	if err != nil {
+		logger.Fatal("ошибка при инициализации трассировки", "error", err)
+	}
 
 	s3conn, err := connector.GetS3Connector(
 		params.S3.Endpoint, params.S3.Region, params.S3.AccessKeyID, params.S3.SecretAccessKey,
 	)
 	if err != nil {
-		logger.Fatal("Ошибка при подключении к S3: ", err)
+		logger.Fatal("ошибка при подключении к S3", "error", err)
 	}
 	db, err := connector.GetPostgresConnector(params.Postgres.GetConnectURL())
 	if err != nil {
-		logger.Fatal("Ошибка при подключении к Postgres: ", err)
+		logger.Fatal("ошибка при подключении к Postgres", "error", err)
+	}
+	if err = metrics.RegisterPostgresPool(db.DB, "static"); err != nil {
+		logger.Fatal("ошибка при регистрации метрик базы данных", "error", err)
 	}
 	staticRepository := postgres.NewStaticRepository(db, s3conn, params.S3.BucketName, params.MaxFileSize)
 	staticUseCase := service.NewStaticService(staticRepository)
 	staticService := static.NewGrpc(staticUseCase)
-	server := grpc.NewServer()
+	server := grpc.NewServer(
+		append(append(metrics.ServerOptions(), tracing.ServerOptions()...), logger.ServerOptions()...)...,
+	)
 	staticProto.RegisterStaticServiceServer(server, staticService)
+	healthServer := grpchealth.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
 	addr := fmt.Sprintf("%s:%d", params.IP, params.Port)
 
 	lis, err := net.Listen("tcp", addr)
 	if err != nil {
-		logger.Fatal("Невозможно прослушать порт:", err)
+		logger.Fatal("невозможно прослушать порт", "addr", addr, "error", err)
 	}
-	logger.Info("Слушаем grpc по адресу", addr)
+	log.Info("слушаем grpc", "addr", addr, "metrics_addr", params.Metrics.GetAddr())
+
+	metricsServer := metrics.NewServer(params.Metrics.GetAddr())
+	go metrics.Run(metricsServer, logger.ForPackage("metrics"))
 
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGKILL)
 	defer stop()
+	// статус health сервиса зависит от доступности зависимостей
+	go health.NewChecker(params.Health.GetTimeout()).
+		Add("postgres", health.Postgres(db.DB)).
+		Add("s3", health.S3(s3conn, params.S3.BucketName)).
+		Serve(ctx, healthServer, params.Health.GetInterval(), "", staticProto.StaticService_ServiceDesc.ServiceName)
 	go func() {
 		err := server.Serve(lis)
 		if err != nil {
-			logger.Error(err)
+			log.Error("grpc сервер завершил свою работу", "error", err)
 		}
 	}()
 	<-ctx.Done()
+	healthServer.Shutdown()
 	server.GracefulStop()
+	if err = metricsServer.Shutdown(context.Background()); err != nil {
+		log.Error("ошибка при выключении сервера метрик", "error", err)
+	}
+	if err = shutdownTracing(context.Background()); err != nil {
+		log.Error("ошибка при выключении трассировки", "error", err)
+	}
 }
@@ -2,11 +2,13 @@ package config
 
 import (
 	"fmt"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/logger"
 	"github.com/joho/godotenv"
-	"github.com/labstack/gommon/log"
 	"github.com/mcuadros/go-defaults"
 	"gopkg.in/yaml.v3"
+	"log/slog"
 	"os"
+	"time"
 )
 
 type Server struct {
@@ -18,6 +20,27 @@ type Server struct {
 	GracefulShutdownTimeout int    `yaml:"graceful_shutdown_timeout" default:"60"`
 }
 
+type Metrics struct {
+	IP   string `yaml:"ip"   default:"0.0.0.0"`
+	Port int    `yaml:"port" default:"9080"`
+}
+
+type Tracing struct {
+	Exporter    string  `yaml:"exporter"     default:"none"`
+	Endpoint    string  `yaml:"endpoint"     default:"jaeger:4317"`
+	SampleRatio float64 `yaml:"sample_ratio" default:"1"`
+}
+
+type Logging struct {
+	Level    string            `yaml:"level"    default:"info"`
+	Packages map[string]string `yaml:"packages"`
+}
+
+type Health struct {
+	Timeout  int `yaml:"timeout"  default:"3"`
+	Interval int `yaml:"interval" default:"10"`
+}
+
 type RedisDatabase struct {
 	Addr     string `yaml:"addr" default:"redis:6379"`
 	Password string `yaml:"-"`
@@ -50,8 +73,37 @@ type Config struct {
 			Addr string `yaml:"profanity_filter_addr" default:"profanity:8050"`
 		} `yaml:"profanity_filter_service"`
 	} `yaml:"microservices"`
+	ContentCache struct {
+		ContentTTL int `yaml:"content_ttl" default:"600"`
+		PreviewTTL int `yaml:"preview_ttl" default:"3600"`
+	} `yaml:"content_cache"`
+	StatsCache struct {
+		TTL int `yaml:"ttl" default:"3600"`
+	} `yaml:"stats_cache"`
+	Recommendations struct {
+		// период пересчета похожего контента в секундах
+		RefreshInterval int `yaml:"refresh_interval" default:"3600"`
+	} `yaml:"recommendations"`
+	SimilarContent struct {
+		// период пересчета похожего контента для измененного контента в секундах
+		RefreshInterval int `yaml:"refresh_interval" default:"60"`
+		// период полной перестройки похожего контента в секундах
+		RebuildInterval int `yaml:"rebuild_interval" default:"86400"`
+	} `yaml:"similar_content"`
+	Charts struct {
+		// период пересчета вычисляемых подборок в секундах
+		RefreshInterval int `yaml:"refresh_interval" default:"3600"`
+	} `yaml:"charts"`
+	Import struct {
+		// сколько импортов истории просмотров может выполняться одновременно
+		MaxConcurrent int `yaml:"max_concurrent" default:"4"`
+	} `yaml:"import"`
 	ContentSecretKey string           `yaml:"-"`
 	Postgres         PostgresDatabase `yaml:"postgres"`
+	Metrics          Metrics          `yaml:"metrics"`
+	Tracing          Tracing          `yaml:"tracing"`
+	Logging          Logging          `yaml:"logging"`
+	Health           Health           `yaml:"health"`
 }
 
 type AuthConfig struct {
@@ -59,6 +111,10 @@ type AuthConfig struct {
 	Port             int           `yaml:"port"               default:"8081"`
 	SessionAliveTime int           `yaml:"session_alive_time" default:"86400"`
 	Redis            RedisDatabase `yaml:"redis"`
+	Metrics          Metrics       `yaml:"metrics"`
+	Tracing          Tracing       `yaml:"tracing"`
+	Logging          Logging       `yaml:"logging"`
+	Health           Health        `yaml:"health"`
 }
 
 type StaticConfig struct {
@@ -73,12 +129,28 @@ type StaticConfig struct {
 		BucketName      string `yaml:"bucket_name" default:"kinoskop_dev"`
 	} `yaml:"s3"`
 	Postgres PostgresDatabase `yaml:"postgres"`
+	Metrics  Metrics          `yaml:"metrics"`
+	Tracing  Tracing          `yaml:"tracing"`
+	Logging  Logging          `yaml:"logging"`
+	Health   Health           `yaml:"health"`
 }
 
 func (cfg *Config) GetServerAddr() string {
 	return fmt.Sprintf("%s:%d", cfg.HTTP.Server.IP, cfg.HTTP.Server.Port)
 }
 
+func (cfg *Metrics) GetAddr() string {
+	return fmt.Sprintf("%s:%d", cfg.IP, cfg.Port)
+}
+
+func (cfg *Health) GetTimeout() time.Duration {
+	return time.Duration(cfg.Timeout) * time.Second
+}
+
+func (cfg *Health) GetInterval() time.Duration {
+	return time.Duration(cfg.Interval) * time.Second
+}
+
 func (cfg *PostgresDatabase) GetConnectURL() string {
 	return fmt.Sprintf(
 		"postgres://%s:%s@%s:%d/kinoskop?sslmode=disable",
@@ -128,16 +200,16 @@ func ParseCoreServiceParams() Config {
 	// читаем конфиг
 	yamlFile, err := os.ReadFile("config.yaml")
 	if err != nil {
-		log.Fatalf("Ошибка при чтении конфига сервера: %v", err)
+		logger.Fatal("ошибка при чтении конфига сервера", "error", err)
 	}
 	err = yaml.Unmarshal(yamlFile, &cfg)
 	if err != nil {
-		log.Fatalf("Ошибка при парсинге конфига сервера: %v", err)
+		logger.Fatal("ошибка при парсинге конфига сервера", "error", err)
 	}
 	// читаем переменные окружения
 	err = godotenv.Load()
 	if err != nil {
-		fmt.Println(".env файл отсутствует, переменные будут загружены из переменных окружения")
+		slog.Info(".env файл отсутствует, переменные будут загружены из переменных окружения")
 	}
 	cfg.Postgres.User = os.Getenv("POSTGRES_USER")
 	cfg.Postgres.Pass = os.Getenv("POSTGRES_PASSWORD")
@@ -150,16 +222,16 @@ func ParseAuthServiceParams() AuthConfig {
 	// читаем конфиг
 	yamlFile, err := os.ReadFile("config_auth.yaml")
 	if err != nil {
-		log.Fatalf("Ошибка при чтении конфига сервера: %v", err)
+		logger.Fatal("ошибка при чтении конфига сервера", "error", err)
 	}
 	err = yaml.Unmarshal(yamlFile, &cfg)
 	if err != nil {
-		log.Fatalf("Ошибка при парсинге конфига сервера: %v", err)
+		logger.Fatal("ошибка при парсинге конфига сервера", "error", err)
 	}
 	// читаем переменные окружения
 	err = godotenv.Load()
 	if err != nil {
-		fmt.Println("Ошибка загрузки .env файла")
+		slog.Warn("ошибка загрузки .env файла", "error", err)
 	}
 	cfg.Redis.Password = os.Getenv("REDIS_PASSWORD")
 	return cfg
@@ -170,16 +242,16 @@ func ParseStaticServiceParams() StaticConfig {
 	// читаем конфиг
 	yamlFile, err := os.ReadFile("config_static.yaml")
 	if err != nil {
-		log.Fatalf("Ошибка при чтении конфига сервера: %v", err)
+		logger.Fatal("ошибка при чтении конфига сервера", "error", err)
 	}
 	err = yaml.Unmarshal(yamlFile, &cfg)
 	if err != nil {
-		log.Fatalf("Ошибка при парсинге конфига сервера: %v", err)
+		logger.Fatal("ошибка при парсинге конфига сервера", "error", err)
 	}
 	// читаем переменные окружения
 	err = godotenv.Load()
 	if err != nil {
-		fmt.Println("Ошибка загрузки .env файла")
+		slog.Warn("ошибка загрузки .env файла", "error", err)
 	}
 	cfg.Postgres.User = os.Getenv("POSTGRES_USER")
 	cfg.Postgres.Pass = os.Getenv("POSTGRES_PASSWORD")
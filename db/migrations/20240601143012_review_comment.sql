@@ -0,0 +1,56 @@
+-- +goose Up
+-- Комментарии к рецензиям. Допускается один уровень вложенности: parent_id указывает на комментарий верхнего уровня
+CREATE TABLE IF NOT EXISTS review_comment
+(
+    id         INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    review_id  INT NOT NULL,
+    user_id    INT NOT NULL,
+    parent_id  INT,
+    text       TEXT
+        CONSTRAINT review_comment_text_length CHECK (LENGTH(text) > 0 AND LENGTH(text) <= 2000) NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (review_id) REFERENCES review (id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES "user" (id) ON DELETE CASCADE,
+    FOREIGN KEY (parent_id) REFERENCES review_comment (id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_review_comment_review_id ON review_comment (review_id, created_at) WHERE parent_id IS NULL;
+CREATE INDEX idx_review_comment_parent_id ON review_comment (parent_id, created_at);
+
+CREATE TRIGGER update_at_review_comment
+    BEFORE UPDATE
+    ON review_comment
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+
+-- Количество комментариев хранится в рецензии, чтобы не считать его при выдаче каждого списка рецензий
+ALTER TABLE review
+    ADD COLUMN IF NOT EXISTS comments INT DEFAULT 0
+        CONSTRAINT comments_positive CHECK (comments >= 0);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_review_comments()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    -- ответы удаляются каскадно вместе с родительским комментарием, триггер срабатывает для каждого из них
+    IF TG_OP = 'INSERT' THEN
+        UPDATE review
+        SET comments = comments + 1
+        WHERE id = NEW.review_id;
+    ELSIF TG_OP = 'DELETE' THEN
+        UPDATE review
+        SET comments = comments - 1
+        WHERE id = OLD.review_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE 'plpgsql';
+-- +goose StatementEnd
+
+CREATE TRIGGER update_review_comments
+    AFTER INSERT OR DELETE
+    ON review_comment
+    FOR EACH ROW
+EXECUTE FUNCTION update_review_comments();
@@ -0,0 +1,79 @@
+-- +goose Up
+-- Скрытые модератором рецензии не выдаются в списках рецензий контента и не учитываются в его рейтинге
+ALTER TABLE review
+    ADD COLUMN IF NOT EXISTS hidden BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE INDEX idx_review_content_id_visible ON review (content_id, rating DESC) WHERE NOT hidden;
+
+-- Модераторы назначаются вручную
+CREATE TABLE IF NOT EXISTS moderator
+(
+    user_id    INT PRIMARY KEY,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES "user" (id) ON DELETE CASCADE
+);
+
+-- Жалобы пользователей на рецензии. Жалоба считается рассмотренной, когда модератор принял по рецензии решение
+CREATE TABLE IF NOT EXISTS review_report
+(
+    id         INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    review_id  INT  NOT NULL,
+    user_id    INT  NOT NULL,
+    reason     TEXT NOT NULL
+        CHECK (reason IN ('spam', 'insult', 'spoiler', 'offtopic', 'other')),
+    comment    TEXT NOT NULL DEFAULT ''
+        CONSTRAINT review_report_comment_length CHECK (LENGTH(comment) <= 500),
+    resolved   BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (review_id) REFERENCES review (id) ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES "user" (id) ON DELETE CASCADE,
+    CONSTRAINT review_report_unique UNIQUE (review_id, user_id)
+);
+
+CREATE INDEX idx_review_report_unresolved ON review_report (review_id) WHERE NOT resolved;
+
+-- Журнал решений модераторов. Ссылки на рецензию нет, чтобы запись сохранялась после удаления рецензии
+CREATE TABLE IF NOT EXISTS review_moderation_log
+(
+    id           INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    review_id    INT  NOT NULL,
+    moderator_id INT,
+    action       TEXT NOT NULL
+        CHECK (action IN ('approve', 'hide', 'delete')),
+    note         TEXT NOT NULL DEFAULT ''
+        CONSTRAINT review_moderation_log_note_length CHECK (LENGTH(note) <= 500),
+    created_at   TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (moderator_id) REFERENCES "user" (id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_review_moderation_log_review_id ON review_moderation_log (review_id);
+
+DROP TRIGGER IF EXISTS update_content_rating ON review;
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_content_rating()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    -- скрытые рецензии в рейтинге не учитываются
+    IF TG_OP = 'DELETE' THEN
+        UPDATE content
+        SET rating = COALESCE(
+                (SELECT AVG(content_rating) FROM review WHERE content_id = OLD.content_id AND NOT hidden), imdb, 0)
+        WHERE id = OLD.content_id;
+    ELSE
+        UPDATE content
+        SET rating = COALESCE(
+                (SELECT AVG(content_rating) FROM review WHERE content_id = NEW.content_id AND NOT hidden), imdb, 0)
+        WHERE id = NEW.content_id;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE 'plpgsql';
+-- +goose StatementEnd
+
+CREATE TRIGGER update_content_rating
+    AFTER INSERT OR DELETE OR UPDATE
+    ON review
+    FOR EACH ROW
+EXECUTE FUNCTION update_content_rating();
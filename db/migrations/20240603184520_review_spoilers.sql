@@ -0,0 +1,5 @@
+-- +goose Up
+-- Отметка автора о том, что рецензия содержит спойлеры. Также выставляется сервером, если в тексте есть
+-- спойлеры, размеченные тегами [spoiler]...[/spoiler]
+ALTER TABLE review
+    ADD COLUMN IF NOT EXISTS has_spoilers BOOLEAN NOT NULL DEFAULT FALSE;
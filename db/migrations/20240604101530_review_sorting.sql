@@ -0,0 +1,15 @@
+-- +goose Up
+-- Индексы под режимы сортировки и фильтры списков рецензий контента. Сортировка по полезности (rating)
+-- обслуживается индексом idx_review_content_id_visible
+CREATE INDEX idx_review_content_id_created_at ON review (content_id, created_at DESC) WHERE NOT hidden;
+CREATE INDEX idx_review_content_id_content_rating ON review (content_id, content_rating) WHERE NOT hidden;
+-- Спорность рецензии: чем больше голосов и чем ближе число лайков к числу дизлайков, тем выше.
+-- Выражение должно совпадать с сортировкой в запросе, иначе индекс не будет использован
+CREATE INDEX idx_review_content_id_controversial ON review (
+    content_id,
+    (POWER(likes + dislikes, LEAST(likes, dislikes)::FLOAT / GREATEST(likes, dislikes, 1))) DESC
+) WHERE NOT hidden;
+
+-- Индексы для списков рецензий пользователя
+CREATE INDEX idx_review_user_id_created_at ON review (user_id, created_at DESC);
+CREATE INDEX idx_review_user_id_content_rating ON review (user_id, content_rating);
@@ -0,0 +1,48 @@
+-- +goose Up
+-- Число правок рецензии и время последней правки. updated_at для этого не подходит, так как меняется и при
+-- пересчете лайков и комментариев
+ALTER TABLE review
+    ADD COLUMN IF NOT EXISTS edit_count INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS edited_at  TIMESTAMPTZ;
+
+-- История правок рецензий: каждая запись - версия рецензии до очередной правки
+CREATE TABLE IF NOT EXISTS review_revision
+(
+    id             INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    review_id      INT         NOT NULL,
+    title          TEXT        NOT NULL,
+    text           TEXT        NOT NULL,
+    content_rating INT         NOT NULL,
+    has_spoilers   BOOLEAN     NOT NULL,
+    -- время, когда версия была написана, и время, когда ее заменила правка
+    created_at     TIMESTAMPTZ NOT NULL,
+    replaced_at    TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (review_id) REFERENCES review (id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_review_revision_review_id ON review_revision (review_id, replaced_at);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION save_review_revision()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    -- голоса, комментарии и модерация тоже обновляют рецензию, но правкой не считаются
+    IF (OLD.title, OLD.text, OLD.content_rating, OLD.has_spoilers) IS DISTINCT FROM
+       (NEW.title, NEW.text, NEW.content_rating, NEW.has_spoilers) THEN
+        INSERT INTO review_revision (review_id, title, text, content_rating, has_spoilers, created_at)
+        VALUES (OLD.id, OLD.title, OLD.text, OLD.content_rating, OLD.has_spoilers,
+                COALESCE(OLD.edited_at, OLD.created_at));
+        NEW.edit_count = OLD.edit_count + 1;
+        NEW.edited_at = CURRENT_TIMESTAMP;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE 'plpgsql';
+-- +goose StatementEnd
+
+CREATE TRIGGER save_review_revision
+    BEFORE UPDATE
+    ON review
+    FOR EACH ROW
+EXECUTE FUNCTION save_review_revision();
@@ -0,0 +1,61 @@
+-- +goose Up
+-- Число учтенных в рейтинге рецензий и байесовская оценка контента. Простое среднее в content.rating ставит
+-- контент с единственной оценкой 10/10 выше контента с сотнями оценок 9/10, поэтому для сортировки
+-- используется взвешенная оценка: (v * R + m * C) / (v + m), где v - число рецензий, R - их средняя оценка,
+-- m - вес априорной оценки, C - априорная оценка (рейтинг IMDB, а если его нет - средняя оценка по всем рецензиям)
+ALTER TABLE content
+    ADD COLUMN IF NOT EXISTS review_count    INT           NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS weighted_rating DECIMAL(4, 2) NOT NULL DEFAULT 0
+        CONSTRAINT content_weighted_rating CHECK (weighted_rating >= 0 AND weighted_rating <= 10);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION recalculate_content_rating(target_content_id INT)
+    RETURNS VOID AS
+$$
+DECLARE
+    -- сколько рецензий нужно, чтобы их средняя оценка весила столько же, сколько априорная
+    min_votes CONSTANT INT := 10;
+    votes              INT;
+    average            NUMERIC;
+    prior              NUMERIC;
+BEGIN
+    -- скрытые рецензии в рейтинге не учитываются
+    SELECT COUNT(*), AVG(content_rating)
+    INTO votes, average
+    FROM review
+    WHERE content_id = target_content_id
+      AND NOT hidden;
+
+    SELECT COALESCE(NULLIF(imdb, 0), (SELECT AVG(content_rating) FROM review WHERE NOT hidden), 0)
+    INTO prior
+    FROM content
+    WHERE id = target_content_id;
+
+    UPDATE content
+    SET rating          = COALESCE(average, imdb, 0),
+        review_count    = votes,
+        weighted_rating = (votes * COALESCE(average, 0) + min_votes * prior) / (votes + min_votes)
+    WHERE id = target_content_id;
+END;
+$$ LANGUAGE 'plpgsql';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_content_rating()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        PERFORM recalculate_content_rating(OLD.content_id);
+    ELSE
+        PERFORM recalculate_content_rating(NEW.content_id);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE 'plpgsql';
+-- +goose StatementEnd
+
+SELECT recalculate_content_rating(id)
+FROM content;
+
+CREATE INDEX IF NOT EXISTS idx_content_weighted_rating ON content (weighted_rating DESC, id);
@@ -0,0 +1,77 @@
+-- +goose Up
+-- Оценки контента без рецензии
+CREATE TABLE IF NOT EXISTS user_rating
+(
+    user_id    INT         NOT NULL,
+    content_id INT         NOT NULL,
+    rating     INT
+        CONSTRAINT user_rating_range CHECK (rating >= 1 AND rating <= 10) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, content_id),
+    FOREIGN KEY (user_id) REFERENCES "user" (id) ON DELETE CASCADE,
+    FOREIGN KEY (content_id) REFERENCES content (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_rating_content_id ON user_rating (content_id);
+CREATE INDEX IF NOT EXISTS idx_user_rating_user_id_updated_at ON user_rating (user_id, updated_at DESC);
+
+-- в рейтинге теперь учитываются не только рецензии
+ALTER TABLE content
+    RENAME COLUMN review_count TO rating_count;
+
+-- Оценки, из которых складывается рейтинг контента: оценки видимых рецензий и оценки без рецензии тех
+-- пользователей, которые рецензию на контент не писали. Если рецензия есть, учитывается только она
+CREATE OR REPLACE VIEW content_rating_vote AS
+SELECT content_id, user_id, content_rating AS rating
+FROM review
+WHERE NOT hidden
+UNION ALL
+SELECT content_id, user_id, rating
+FROM user_rating
+WHERE NOT EXISTS (SELECT 1
+                  FROM review
+                  WHERE review.user_id = user_rating.user_id
+                    AND review.content_id = user_rating.content_id);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION recalculate_content_rating(target_content_id INT)
+    RETURNS VOID AS
+$$
+DECLARE
+    -- сколько оценок нужно, чтобы их среднее весило столько же, сколько априорная оценка
+    min_votes CONSTANT INT := 10;
+    votes              INT;
+    average            NUMERIC;
+    prior              NUMERIC;
+BEGIN
+    SELECT COUNT(*), AVG(rating)
+    INTO votes, average
+    FROM content_rating_vote
+    WHERE content_id = target_content_id;
+
+    SELECT COALESCE(NULLIF(imdb, 0), (SELECT AVG(rating) FROM content_rating_vote), 0)
+    INTO prior
+    FROM content
+    WHERE id = target_content_id;
+
+    UPDATE content
+    SET rating          = COALESCE(average, imdb, 0),
+        rating_count    = votes,
+        weighted_rating = (votes * COALESCE(average, 0) + min_votes * prior) / (votes + min_votes)
+    WHERE id = target_content_id;
+END;
+$$ LANGUAGE 'plpgsql';
+-- +goose StatementEnd
+
+CREATE TRIGGER update_at_user_rating
+    BEFORE UPDATE
+    ON user_rating
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_content_rating
+    AFTER INSERT OR DELETE OR UPDATE
+    ON user_rating
+    FOR EACH ROW
+EXECUTE FUNCTION update_content_rating();
@@ -0,0 +1,108 @@
+-- +goose Up
+-- Оценки отдельных сезонов и эпизодов сериалов. На рейтинг самого сериала они не влияют
+CREATE TABLE IF NOT EXISTS season_rating
+(
+    user_id    INT         NOT NULL,
+    season_id  INT         NOT NULL,
+    rating     INT
+        CONSTRAINT season_rating_range CHECK (rating >= 1 AND rating <= 10) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, season_id),
+    FOREIGN KEY (user_id) REFERENCES "user" (id) ON DELETE CASCADE,
+    FOREIGN KEY (season_id) REFERENCES season (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS episode_rating
+(
+    user_id    INT         NOT NULL,
+    episode_id INT         NOT NULL,
+    rating     INT
+        CONSTRAINT episode_rating_range CHECK (rating >= 1 AND rating <= 10) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, episode_id),
+    FOREIGN KEY (user_id) REFERENCES "user" (id) ON DELETE CASCADE,
+    FOREIGN KEY (episode_id) REFERENCES episode (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_season_rating_season_id ON season_rating (season_id);
+CREATE INDEX IF NOT EXISTS idx_episode_rating_episode_id ON episode_rating (episode_id);
+
+CREATE TRIGGER update_at_season_rating
+    BEFORE UPDATE
+    ON season_rating
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_at_episode_rating
+    BEFORE UPDATE
+    ON episode_rating
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
+
+-- Средняя оценка и число оценок, пересчитываются триггерами ниже
+ALTER TABLE season
+    ADD COLUMN IF NOT EXISTS rating       DECIMAL(3, 1) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS rating_count INT           NOT NULL DEFAULT 0;
+
+ALTER TABLE episode
+    ADD COLUMN IF NOT EXISTS rating       DECIMAL(3, 1) NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS rating_count INT           NOT NULL DEFAULT 0;
+
+-- для списка лучших эпизодов сериала
+CREATE INDEX IF NOT EXISTS idx_episode_season_id_rating ON episode (season_id, rating DESC);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_season_rating()
+    RETURNS TRIGGER AS
+$$
+DECLARE
+    target_season_id INT;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        target_season_id = OLD.season_id;
+    ELSE
+        target_season_id = NEW.season_id;
+    END IF;
+    UPDATE season
+    SET rating       = COALESCE((SELECT AVG(rating) FROM season_rating WHERE season_id = target_season_id), 0),
+        rating_count = (SELECT COUNT(*) FROM season_rating WHERE season_id = target_season_id)
+    WHERE id = target_season_id;
+    RETURN NULL;
+END;
+$$ LANGUAGE 'plpgsql';
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION update_episode_rating()
+    RETURNS TRIGGER AS
+$$
+DECLARE
+    target_episode_id INT;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        target_episode_id = OLD.episode_id;
+    ELSE
+        target_episode_id = NEW.episode_id;
+    END IF;
+    UPDATE episode
+    SET rating       = COALESCE((SELECT AVG(rating) FROM episode_rating WHERE episode_id = target_episode_id), 0),
+        rating_count = (SELECT COUNT(*) FROM episode_rating WHERE episode_id = target_episode_id)
+    WHERE id = target_episode_id;
+    RETURN NULL;
+END;
+$$ LANGUAGE 'plpgsql';
+-- +goose StatementEnd
+
+CREATE TRIGGER update_season_rating
+    AFTER INSERT OR DELETE OR UPDATE
+    ON season_rating
+    FOR EACH ROW
+EXECUTE FUNCTION update_season_rating();
+
+CREATE TRIGGER update_episode_rating
+    AFTER INSERT OR DELETE OR UPDATE
+    ON episode_rating
+    FOR EACH ROW
+EXECUTE FUNCTION update_episode_rating();
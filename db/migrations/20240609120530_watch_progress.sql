@@ -0,0 +1,13 @@
+-- +goose Up
+-- Просмотренные эпизоды сериалов
+CREATE TABLE IF NOT EXISTS watched_episode
+(
+    user_id    INT         NOT NULL,
+    episode_id INT         NOT NULL,
+    watched_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, episode_id),
+    FOREIGN KEY (user_id) REFERENCES "user" (id) ON DELETE CASCADE,
+    FOREIGN KEY (episode_id) REFERENCES episode (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_watched_episode_episode_id ON watched_episode (episode_id);
@@ -0,0 +1,28 @@
+-- +goose Up
+-- Дневник просмотров: каждая запись - один просмотр контента в конкретный день. Оценка в дневнике личная и на
+-- рейтинг контента не влияет, категории избранного от дневника не зависят
+CREATE TABLE IF NOT EXISTS diary_entry
+(
+    id         INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    user_id    INT         NOT NULL,
+    content_id INT         NOT NULL,
+    watched_on DATE        NOT NULL,
+    rating     INT
+        CONSTRAINT diary_entry_rating_range CHECK (rating >= 1 AND rating <= 10),
+    note       TEXT        NOT NULL DEFAULT ''
+        CONSTRAINT diary_entry_note_length CHECK (LENGTH(note) <= 1000),
+    rewatch    BOOLEAN     NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES "user" (id) ON DELETE CASCADE,
+    FOREIGN KEY (content_id) REFERENCES content (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_diary_entry_user_id_watched_on ON diary_entry (user_id, watched_on DESC);
+CREATE INDEX IF NOT EXISTS idx_diary_entry_user_id_content_id ON diary_entry (user_id, content_id);
+
+CREATE TRIGGER update_at_diary_entry
+    BEFORE UPDATE
+    ON diary_entry
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
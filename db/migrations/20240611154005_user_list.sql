@@ -0,0 +1,39 @@
+-- +goose Up
+-- Пользовательские списки контента. В отличие от категорий избранного, списков может быть сколько угодно,
+-- а порядок элементов задает пользователь
+CREATE TABLE IF NOT EXISTS user_list
+(
+    id          INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    user_id     INT         NOT NULL,
+    title       TEXT
+        CONSTRAINT user_list_title_length CHECK (LENGTH(title) >= 1 AND LENGTH(title) <= 100) NOT NULL,
+    description TEXT        NOT NULL DEFAULT ''
+        CONSTRAINT user_list_description_length CHECK (LENGTH(description) <= 1000),
+    is_public   BOOLEAN     NOT NULL DEFAULT FALSE,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at  TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES "user" (id) ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS user_list_item
+(
+    list_id    INT         NOT NULL,
+    content_id INT         NOT NULL,
+    -- позиция в списке, начиная с 1
+    position   INT         NOT NULL,
+    note       TEXT        NOT NULL DEFAULT ''
+        CONSTRAINT user_list_item_note_length CHECK (LENGTH(note) <= 500),
+    added_at   TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (list_id, content_id),
+    FOREIGN KEY (list_id) REFERENCES user_list (id) ON DELETE CASCADE,
+    FOREIGN KEY (content_id) REFERENCES content (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_list_user_id ON user_list (user_id, updated_at DESC);
+CREATE INDEX IF NOT EXISTS idx_user_list_item_list_id_position ON user_list_item (list_id, position);
+
+CREATE TRIGGER update_at_user_list
+    BEFORE UPDATE
+    ON user_list
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
@@ -0,0 +1,22 @@
+-- +goose Up
+-- Настройки приватности профиля. Если строки нет, все разделы публичные
+CREATE TABLE IF NOT EXISTS user_privacy
+(
+    user_id    INT         NOT NULL PRIMARY KEY,
+    favourites TEXT        NOT NULL DEFAULT 'public'
+        CONSTRAINT user_privacy_favourites CHECK (favourites IN ('public', 'followers', 'private')),
+    reviews    TEXT        NOT NULL DEFAULT 'public'
+        CONSTRAINT user_privacy_reviews CHECK (reviews IN ('public', 'followers', 'private')),
+    ratings    TEXT        NOT NULL DEFAULT 'public'
+        CONSTRAINT user_privacy_ratings CHECK (ratings IN ('public', 'followers', 'private')),
+    lists      TEXT        NOT NULL DEFAULT 'public'
+        CONSTRAINT user_privacy_lists CHECK (lists IN ('public', 'followers', 'private')),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES "user" (id) ON DELETE CASCADE
+);
+
+CREATE TRIGGER update_at_user_privacy
+    BEFORE UPDATE
+    ON user_privacy
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
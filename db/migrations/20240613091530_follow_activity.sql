@@ -0,0 +1,37 @@
+-- +goose Up
+-- Подписки пользователей друг на друга
+CREATE TABLE IF NOT EXISTS user_follow
+(
+    follower_id INT         NOT NULL,
+    followee_id INT         NOT NULL,
+    created_at  TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (follower_id, followee_id),
+    CONSTRAINT user_follow_not_self CHECK (follower_id <> followee_id),
+    FOREIGN KEY (follower_id) REFERENCES "user" (id) ON DELETE CASCADE,
+    FOREIGN KEY (followee_id) REFERENCES "user" (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_follow_followee_id ON user_follow (followee_id, created_at DESC);
+
+-- Журнал действий пользователей, из которого собирается лента подписок. Заполняются только поля,
+-- относящиеся к типу события: review_id у рецензий, rating у оценок, list_id у списков, category у избранного
+CREATE TABLE IF NOT EXISTS activity
+(
+    id         INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    user_id    INT         NOT NULL,
+    type       TEXT        NOT NULL
+        CONSTRAINT activity_type CHECK (type IN ('review', 'rating', 'list_item', 'favourite')),
+    content_id INT         NOT NULL,
+    review_id  INT,
+    list_id    INT,
+    rating     INT,
+    category   TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES "user" (id) ON DELETE CASCADE,
+    FOREIGN KEY (content_id) REFERENCES content (id) ON DELETE CASCADE,
+    -- удаленные рецензии и списки пропадают из ленты вместе с событиями
+    FOREIGN KEY (review_id) REFERENCES review (id) ON DELETE CASCADE,
+    FOREIGN KEY (list_id) REFERENCES user_list (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_activity_user_id ON activity (user_id, created_at DESC);
@@ -0,0 +1,45 @@
+-- +goose Up
+-- Задачи импорта истории просмотров из выгрузок других сервисов. Импорт выполняется в фоне, а задача хранит
+-- его состояние и итоги
+CREATE TABLE IF NOT EXISTS import_job
+(
+    id           INT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY,
+    user_id      INT         NOT NULL,
+    source       TEXT        NOT NULL
+        CONSTRAINT import_job_source CHECK (source IN ('letterboxd', 'imdb', 'kinopoisk')),
+    category     TEXT        NOT NULL
+        CHECK (category IN ('favourite', 'watching', 'watched', 'planned', 'rewatching', 'abandoned')),
+    status       TEXT        NOT NULL DEFAULT 'pending'
+        CONSTRAINT import_job_status CHECK (status IN ('pending', 'running', 'done', 'failed')),
+    total_rows   INT         NOT NULL DEFAULT 0,
+    matched_rows INT         NOT NULL DEFAULT 0,
+    error        TEXT        NOT NULL DEFAULT '',
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at   TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (user_id) REFERENCES "user" (id) ON DELETE CASCADE
+);
+
+-- Строки файла, для которых не нашелся контент. Пользователь может сопоставить их вручную, тогда
+-- в content_id записывается выбранный контент
+CREATE TABLE IF NOT EXISTS import_row
+(
+    job_id         INT  NOT NULL,
+    row_number     INT  NOT NULL,
+    title          TEXT NOT NULL DEFAULT '',
+    original_title TEXT NOT NULL DEFAULT '',
+    year           INT  NOT NULL DEFAULT 0,
+    kinopoisk_id   INT  NOT NULL DEFAULT 0,
+    rating         INT  NOT NULL DEFAULT 0,
+    content_id     INT,
+    PRIMARY KEY (job_id, row_number),
+    FOREIGN KEY (job_id) REFERENCES import_job (id) ON DELETE CASCADE,
+    FOREIGN KEY (content_id) REFERENCES content (id) ON DELETE SET NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_import_job_user_id ON import_job (user_id, created_at DESC);
+
+CREATE TRIGGER update_at_import_job
+    BEFORE UPDATE
+    ON import_job
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at_column();
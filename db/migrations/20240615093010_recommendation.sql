@@ -0,0 +1,34 @@
+-- +goose Up
+-- Насколько пользователю понравился контент: 1 - высокая оценка (рецензией или без нее) или категория
+-- избранного favourite, 0.5 - контент просмотрен и не получил низкую оценку. Низко оцененный контент не
+-- попадает в представление, даже если он просмотрен
+CREATE OR REPLACE VIEW content_preference AS
+SELECT user_id, content_id, MAX(weight) AS weight
+FROM (SELECT user_id, content_id, 1.0 AS weight
+      FROM content_rating_vote
+      WHERE rating >= 7
+      UNION ALL
+      SELECT user_id, content_id, 1.0
+      FROM favourite
+      WHERE category = 'favourite'
+      UNION ALL
+      SELECT user_id, content_id, 0.5
+      FROM favourite
+      WHERE category IN ('watched', 'watching', 'rewatching')
+        AND NOT EXISTS (SELECT 1
+                        FROM content_rating_vote
+                        WHERE content_rating_vote.user_id = favourite.user_id
+                          AND content_rating_vote.content_id = favourite.content_id
+                          AND content_rating_vote.rating <= 4)) AS preference
+GROUP BY user_id, content_id;
+
+-- Ближайшие соседи контента для рекомендаций. Таблица целиком пересчитывается фоновой задачей
+CREATE TABLE IF NOT EXISTS content_neighbor
+(
+    content_id  INT              NOT NULL,
+    neighbor_id INT              NOT NULL,
+    score       DOUBLE PRECISION NOT NULL,
+    PRIMARY KEY (content_id, neighbor_id),
+    FOREIGN KEY (content_id) REFERENCES content (id) ON DELETE CASCADE,
+    FOREIGN KEY (neighbor_id) REFERENCES content (id) ON DELETE CASCADE
+);
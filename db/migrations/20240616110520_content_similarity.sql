@@ -0,0 +1,87 @@
+-- +goose Up
+-- Похожий контент для блока "похожее" на странице контента. Заполняется фоновой задачей
+CREATE TABLE IF NOT EXISTS content_similarity
+(
+    content_id INT              NOT NULL,
+    similar_id INT              NOT NULL,
+    score      DOUBLE PRECISION NOT NULL,
+    PRIMARY KEY (content_id, similar_id),
+    FOREIGN KEY (content_id) REFERENCES content (id) ON DELETE CASCADE,
+    FOREIGN KEY (similar_id) REFERENCES content (id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_content_similarity_similar_id ON content_similarity (similar_id);
+
+-- Контент, у которого изменились жанры, страны, участники, год выхода или рейтинг. Фоновая задача
+-- пересчитывает для него похожий контент и удаляет его из очереди. Внешнего ключа нет: триггеры срабатывают и
+-- при каскадном удалении контента, когда строки в content уже нет
+CREATE TABLE IF NOT EXISTS content_similarity_stale
+(
+    content_id INT PRIMARY KEY,
+    marked_at  TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION mark_content_similarity_stale()
+    RETURNS TRIGGER AS
+$$
+BEGIN
+    -- в самой таблице content ключ называется id, в остальных - content_id
+    IF TG_TABLE_NAME = 'content' THEN
+        INSERT INTO content_similarity_stale (content_id) VALUES (NEW.id) ON CONFLICT DO NOTHING;
+        RETURN NULL;
+    END IF;
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        INSERT INTO content_similarity_stale (content_id) VALUES (OLD.content_id) ON CONFLICT DO NOTHING;
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        INSERT INTO content_similarity_stale (content_id) VALUES (NEW.content_id) ON CONFLICT DO NOTHING;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE 'plpgsql';
+-- +goose StatementEnd
+
+CREATE TRIGGER mark_content_similarity_stale
+    AFTER INSERT
+    ON content
+    FOR EACH ROW
+EXECUTE FUNCTION mark_content_similarity_stale();
+
+-- рейтинг пересчитывается после каждой оценки, но часто не меняется
+CREATE TRIGGER mark_content_similarity_stale_rating
+    AFTER UPDATE OF rating
+    ON content
+    FOR EACH ROW
+    WHEN (OLD.rating IS DISTINCT FROM NEW.rating)
+EXECUTE FUNCTION mark_content_similarity_stale();
+
+CREATE TRIGGER mark_content_similarity_stale
+    AFTER INSERT OR UPDATE OR DELETE
+    ON genre_content
+    FOR EACH ROW
+EXECUTE FUNCTION mark_content_similarity_stale();
+
+CREATE TRIGGER mark_content_similarity_stale
+    AFTER INSERT OR UPDATE OR DELETE
+    ON country_content
+    FOR EACH ROW
+EXECUTE FUNCTION mark_content_similarity_stale();
+
+CREATE TRIGGER mark_content_similarity_stale
+    AFTER INSERT OR UPDATE OR DELETE
+    ON person_role
+    FOR EACH ROW
+EXECUTE FUNCTION mark_content_similarity_stale();
+
+CREATE TRIGGER mark_content_similarity_stale
+    AFTER INSERT OR UPDATE OF premiere
+    ON movie
+    FOR EACH ROW
+EXECUTE FUNCTION mark_content_similarity_stale();
+
+CREATE TRIGGER mark_content_similarity_stale
+    AFTER INSERT OR UPDATE OF year_start
+    ON series
+    FOR EACH ROW
+EXECUTE FUNCTION mark_content_similarity_stale();
@@ -0,0 +1,32 @@
+-- +goose Up
+-- Год выхода контента: премьера фильма или начало сериала
+CREATE OR REPLACE VIEW content_release AS
+SELECT content.id AS content_id, COALESCE(EXTRACT(YEAR FROM movie.premiere)::INT, series.year_start) AS year
+FROM content
+         LEFT JOIN movie ON movie.content_id = content.id
+         LEFT JOIN series ON series.content_id = content.id;
+
+-- Вычисляемые подборки: trending, top_movies, top_series, genre:<ID жанра> и decade:<первый год десятилетия>.
+-- Обе таблицы целиком пересчитываются фоновой задачей
+CREATE TABLE IF NOT EXISTS chart
+(
+    key          TEXT PRIMARY KEY,
+    title        TEXT        NOT NULL,
+    refreshed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS chart_content
+(
+    chart_key  TEXT             NOT NULL,
+    position   INT              NOT NULL,
+    content_id INT              NOT NULL,
+    score      DOUBLE PRECISION NOT NULL,
+    PRIMARY KEY (chart_key, position),
+    FOREIGN KEY (chart_key) REFERENCES chart (key) ON DELETE CASCADE,
+    FOREIGN KEY (content_id) REFERENCES content (id) ON DELETE CASCADE
+);
+
+-- для подсчета активности за неделю
+CREATE INDEX IF NOT EXISTS idx_review_created_at ON review (created_at) WHERE NOT hidden;
+CREATE INDEX IF NOT EXISTS idx_user_rating_created_at ON user_rating (created_at);
+CREATE INDEX IF NOT EXISTS idx_favourite_updated_at ON favourite (updated_at);
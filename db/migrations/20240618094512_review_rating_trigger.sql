@@ -0,0 +1,10 @@
+-- +goose Up
+-- Рейтинг контента зависит только от оценки и видимости рецензии. Без списка столбцов триггер срабатывал и при
+-- изменении счетчиков лайков и комментариев, пересчитывая рейтинг на каждый голос и комментарий
+DROP TRIGGER IF EXISTS update_content_rating ON review;
+
+CREATE TRIGGER update_content_rating
+    AFTER INSERT OR DELETE OR UPDATE OF content_rating, hidden
+    ON review
+    FOR EACH ROW
+EXECUTE FUNCTION update_content_rating();
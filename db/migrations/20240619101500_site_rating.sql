@@ -0,0 +1,46 @@
+-- +goose Up
+-- Средняя оценка по всем оценкам сайта - априорная оценка взвешенного рейтинга для контента без рейтинга IMDB.
+-- Считать ее в recalculate_content_rating значит проходить по всем оценкам на каждую запись, поэтому она
+-- хранится в таблице из одной строки и обновляется фоновой задачей пересчета подборок
+CREATE TABLE IF NOT EXISTS site_rating
+(
+    id           BOOLEAN PRIMARY KEY DEFAULT TRUE
+        CONSTRAINT site_rating_single_row CHECK (id),
+    average      NUMERIC     NOT NULL DEFAULT 0,
+    refreshed_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+INSERT INTO site_rating (average)
+SELECT COALESCE(AVG(rating), 0)
+FROM content_rating_vote
+ON CONFLICT (id) DO NOTHING;
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION recalculate_content_rating(target_content_id INT)
+    RETURNS VOID AS
+$$
+DECLARE
+    -- сколько оценок нужно, чтобы их среднее весило столько же, сколько априорная оценка
+    min_votes CONSTANT INT := 10;
+    votes              INT;
+    average            NUMERIC;
+    prior              NUMERIC;
+BEGIN
+    SELECT COUNT(*), AVG(rating)
+    INTO votes, average
+    FROM content_rating_vote
+    WHERE content_id = target_content_id;
+
+    SELECT COALESCE(NULLIF(imdb, 0), (SELECT site_rating.average FROM site_rating), 0)
+    INTO prior
+    FROM content
+    WHERE id = target_content_id;
+
+    UPDATE content
+    SET rating          = COALESCE(average, imdb, 0),
+        rating_count    = votes,
+        weighted_rating = (votes * COALESCE(average, 0) + min_votes * prior) / (votes + min_votes)
+    WHERE id = target_content_id;
+END;
+$$ LANGUAGE 'plpgsql';
+-- +goose StatementEnd
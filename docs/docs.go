@@ -112,9 +112,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/compilation/type/{compilationType}": {
+        "/api/chart/decade/{decade}/{page}": {
             "get": {
-                "description": "Получение списка подборок по id типа подборки",
+                "description": "Контент, вышедший в десятилетии, с наибольшим взвешенным рейтингом",
                 "consumes": [
                     "application/json"
                 ],
@@ -122,14 +122,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "compilation"
+                    "chart"
                 ],
-                "summary": "Получение списка подборок по типу подборок",
+                "summary": "Лучшее десятилетия",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "id типа подборки",
-                        "name": "compilationType",
+                        "type": "integer",
+                        "description": "первый год десятилетия, например 1990",
+                        "name": "decade",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "номер страницы",
+                        "name": "page",
                         "in": "path",
                         "required": true
                     }
@@ -138,7 +145,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.CompilationResponseList"
+                            "$ref": "#/definitions/dto.CompilationResponse"
                         }
                     },
                     "400": {
@@ -147,34 +154,11 @@ const docTemplate = `{
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
-                    }
-                }
-            }
-        },
-        "/api/compilation/types": {
-            "get": {
-                "description": "Получение списка подборок по id",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "compilation"
-                ],
-                "summary": "Получение списка подборок",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/dto.CompilationTypeResponseList"
-                        }
                     },
                     "500": {
                         "description": "Internal Server Error",
@@ -185,9 +169,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/compilation/{id}/{page}": {
+        "/api/chart/genre/{id}/{page}": {
             "get": {
-                "description": "Получение карточек контента подборки по id",
+                "description": "Контент жанра с наибольшим взвешенным рейтингом",
                 "consumes": [
                     "application/json"
                 ],
@@ -195,13 +179,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "compilation"
+                    "chart"
                 ],
-                "summary": "Получение карточек контента подборки",
+                "summary": "Лучшее в жанре",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "id подборки",
+                        "description": "id жанра",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -218,7 +202,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.Compilation"
+                            "$ref": "#/definitions/dto.CompilationResponse"
                         }
                     },
                     "400": {
@@ -242,21 +226,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/content/person/{id}": {
+        "/api/chart/top/movies/{page}": {
             "get": {
-                "description": "Получение персоны по id",
+                "description": "Фильмы с наибольшим взвешенным рейтингом",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "content"
+                    "chart"
                 ],
-                "summary": "Получение персоны по id",
+                "summary": "250 лучших фильмов",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID персоны",
-                        "name": "id",
+                        "description": "номер страницы",
+                        "name": "page",
                         "in": "path",
                         "required": true
                     }
@@ -265,13 +252,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.Person"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
+                            "$ref": "#/definitions/dto.CompilationResponse"
                         }
                     },
                     "404": {
@@ -289,21 +270,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/content/{id}": {
+        "/api/chart/top/series/{page}": {
             "get": {
-                "description": "Получение контента по id",
+                "description": "Сериалы с наибольшим взвешенным рейтингом",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "content"
+                    "chart"
                 ],
-                "summary": "Получение контента по id",
+                "summary": "250 лучших сериалов",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID контента",
-                        "name": "id",
+                        "description": "номер страницы",
+                        "name": "page",
                         "in": "path",
                         "required": true
                     }
@@ -312,13 +296,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.Content"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
+                            "$ref": "#/definitions/dto.CompilationResponse"
                         }
                     },
                     "404": {
@@ -336,39 +314,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/favourite": {
-            "put": {
-                "security": [
-                    {
-                        "_csrf": []
-                    }
-                ],
-                "description": "Добавление в избранное. Если уже в избранном, то ошибка не возвращается (идемпотентный метод).",
+        "/api/chart/trending/{page}": {
+            "get": {
+                "description": "Контент, который чаще всего оценивали, обсуждали и добавляли в избранное за последнюю неделю",
                 "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Favourite"
+                    "chart"
                 ],
+                "summary": "Контент в тренде",
                 "parameters": [
                     {
-                        "description": "Данные для добавления в избранное",
-                        "name": "payload",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.CreateFavouriteRequest"
-                        }
+                        "type": "integer",
+                        "description": "номер страницы",
+                        "name": "page",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
+                            "$ref": "#/definitions/dto.CompilationResponse"
                         }
                     },
                     "404": {
@@ -386,30 +358,69 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/favourite/my": {
+        "/api/compilation/type/{compilationType}": {
             "get": {
-                "description": "Получение избранного пользователя",
+                "description": "Получение списка подборок по id типа подборки",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Favourite"
+                    "compilation"
+                ],
+                "summary": "Получение списка подборок по типу подборок",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "id типа подборки",
+                        "name": "compilationType",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.FavouritesResponse"
+                            "$ref": "#/definitions/dto.CompilationResponseList"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
+                    }
+                }
+            }
+        },
+        "/api/compilation/types": {
+            "get": {
+                "description": "Получение списка подборок по id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "compilation"
+                ],
+                "summary": "Получение списка подборок",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CompilationTypeResponseList"
+                        }
                     },
                     "500": {
                         "description": "Internal Server Error",
@@ -420,26 +431,40 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/favourite/status/{id}": {
+        "/api/compilation/{id}/{page}": {
             "get": {
-                "description": "Получение статуса контента в избранном",
+                "description": "Получение карточек контента подборки по id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Favourite"
+                    "compilation"
                 ],
+                "summary": "Получение карточек контента подборки",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Идентификатор контента",
+                        "description": "id подборки",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "номер страницы",
+                        "name": "page",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.FavouriteStatusResponse"
+                            "$ref": "#/definitions/dto.Compilation"
                         }
                     },
                     "400": {
@@ -448,12 +473,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -469,16 +488,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/favourite/{id}": {
+        "/api/content/person/{id}": {
             "get": {
-                "description": "Получение избранного пользователя",
+                "description": "Получение персоны по id",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Favourite"
+                    "content"
                 ],
+                "summary": "Получение персоны по id",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Идентификатор пользователя",
+                        "description": "ID персоны",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -488,7 +511,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.FavouritesResponse"
+                            "$ref": "#/definitions/dto.Person"
                         }
                     },
                     "400": {
@@ -510,21 +533,22 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "_csrf": []
-                    }
+            }
+        },
+        "/api/content/{id}": {
+            "get": {
+                "description": "Получение контента по id",
+                "produces": [
+                    "application/json"
                 ],
-                "description": "Удаление из избранного.",
                 "tags": [
-                    "Favourite"
+                    "content"
                 ],
+                "summary": "Получение контента по id",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Идентификатор контента",
+                        "description": "ID контента",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -532,16 +556,13 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
+                            "$ref": "#/definitions/dto.Content"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
@@ -561,23 +582,30 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/ongoing/nearest": {
+        "/api/content/{id}/episodes/best": {
             "get": {
+                "description": "До 10 эпизодов сериала с наибольшей взвешенной оценкой пользователей. Эпизоды без оценок не выводятся",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ongoing_content"
+                    "content"
                 ],
-                "summary": "Получить ближайшие релизы",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.PreviewContent"
-                            }
+                "summary": "Лучшие эпизоды сериала",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID контента",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.BestEpisodes"
                         }
                     },
                     "400": {
@@ -586,6 +614,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -595,20 +629,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/ongoing/subscriptions": {
-            "get": {
+        "/api/diary": {
+            "post": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "description": "Добавляет просмотр контента в конкретный день. Оценка и заметка необязательны, оценка в дневнике",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ongoing_content"
+                    "diary"
+                ],
+                "summary": "Добавить просмотр в дневник",
+                "parameters": [
+                    {
+                        "description": "Запись дневника",
+                        "name": "entry",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.DiaryEntryRequest"
+                        }
+                    }
                 ],
-                "summary": "Получить ID контентов, на которые подписан пользователь",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.SubscriptionsResponse"
+                            "$ref": "#/definitions/dto.DiaryEntry"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "401": {
@@ -617,6 +677,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -626,27 +692,47 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/ongoing/years": {
+        "/api/diary/calendar/{year}/{month}": {
             "get": {
+                "description": "Записи дневника текущего пользователя за месяц по дням. Дни без просмотров не включаются",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ongoing_content"
+                    "diary"
+                ],
+                "summary": "Календарь просмотров",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Год",
+                        "name": "year",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Месяц от 1 до 12",
+                        "name": "month",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Получить все года релизов",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "integer"
-                            }
+                            "$ref": "#/definitions/dto.DiaryCalendar"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
@@ -660,12 +746,16 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/ongoing/{id}/is_released": {
+        "/api/diary/content/{id}": {
             "get": {
+                "description": "Все просмотры контента текущим пользователем, начиная с последнего, и число повторных просмотров",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "ongoing_content"
+                    "diary"
                 ],
-                "summary": "Проверить, вышел ли контент. Использует WebSocket",
+                "summary": "Просмотры контента",
                 "parameters": [
                     {
                         "type": "integer",
@@ -676,10 +766,10 @@ const docTemplate = `{
                     }
                 ],
                 "responses": {
-                    "101": {
-                        "description": "WebSocket connection is established",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dto.DiaryContentHistory"
                         }
                     },
                     "400": {
@@ -688,8 +778,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
@@ -701,51 +791,32 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "security": [
-                    {
-                        "_csrf": []
-                    }
-                ],
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/api/diary/entry/{id}": {
+            "get": {
+                "description": "Запись дневника текущего пользователя вместе с превью контента",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ongoing_content"
+                    "diary"
                 ],
-                "summary": "Установить состояние релиза",
+                "summary": "Запись дневника",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID контента",
+                        "description": "ID записи",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Секретный ключ",
-                        "name": "secret_key",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Вышел ли контент",
-                        "name": "is_released",
-                        "in": "query",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dto.DiaryEntryWithContent"
                         }
                     },
                     "400": {
@@ -754,8 +825,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
@@ -773,15 +844,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/ongoing/{id}/subscribe": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "_csrf": []
                     }
                 ],
+                "description": "Изменяет дату, оценку, заметку и отметку о повторном просмотре. Контент записи не меняется",
                 "consumes": [
                     "application/json"
                 ],
@@ -789,23 +859,32 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "ongoing_content"
+                    "diary"
                 ],
-                "summary": "Подписаться на выход контента",
+                "summary": "Изменить запись дневника",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID контента",
+                        "description": "ID записи",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Запись дневника",
+                        "name": "entry",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.DiaryEntryRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/dto.DiaryEntry"
                         }
                     },
                     "400": {
@@ -840,20 +919,14 @@ const docTemplate = `{
                         "_csrf": []
                     }
                 ],
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "ongoing_content"
+                    "diary"
                 ],
-                "summary": "Отписаться от выхода контента",
+                "summary": "Удалить запись дневника",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID контента",
+                        "description": "ID записи",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -861,10 +934,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "string"
-                        }
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -893,23 +963,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/ongoing/{year}/{month}": {
+        "/api/diary/stats/{year}": {
             "get": {
+                "description": "Число просмотров, разных фильмов и сериалов, повторных просмотров, средняя оценка и просмотры",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "ongoing_content"
+                    "diary"
                 ],
-                "summary": "Получить релизы по месяцу и году",
+                "summary": "Статистика просмотров за год",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Месяц",
-                        "name": "month",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "type": "integer",
                         "description": "Год",
@@ -922,10 +986,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.PreviewContent"
-                            }
+                            "$ref": "#/definitions/dto.DiaryYearStats"
                         }
                     },
                     "400": {
@@ -934,8 +995,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
@@ -949,57 +1010,34 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/playground/ping": {
-            "get": {
-                "description": "Проверка соединения через классический ping pong",
-                "tags": [
-                    "Playground"
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Pong",
-                        "schema": {
-                            "type": "string"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/review": {
+        "/api/favourite": {
             "put": {
                 "security": [
                     {
                         "_csrf": []
                     }
                 ],
-                "description": "Обновить рецензию",
+                "description": "Добавление в избранное. Если уже в избранном, то ошибка не возвращается (идемпотентный метод).",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "review"
+                    "Favourite"
                 ],
-                "summary": "Обновить рецензию",
                 "parameters": [
                     {
-                        "description": "Данные для обновления рецензии",
-                        "name": "reviewUpdate",
+                        "description": "Данные для добавления в избранное",
+                        "name": "payload",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.ReviewUpdateRequest"
+                            "$ref": "#/definitions/dto.CreateFavouriteRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ReviewResponse"
-                        }
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1007,18 +1045,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
-                        }
-                    },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -1032,41 +1058,29 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
-                "security": [
-                    {
-                        "_csrf": []
-                    }
-                ],
-                "description": "Создать рецензию",
-                "consumes": [
-                    "application/json"
-                ],
+            }
+        },
+        "/api/favourite/export": {
+            "get": {
+                "description": "Выгрузка избранного, оценок и рецензий текущего пользователя файлом. Форматы: csv (по умолчанию),",
                 "produces": [
-                    "application/json"
+                    "application/json",
+                    "text/plain"
                 ],
                 "tags": [
-                    "review"
+                    "Favourite"
                 ],
-                "summary": "Создать рецензию",
                 "parameters": [
                     {
-                        "description": "Данные для создания рецензии",
-                        "name": "reviewCreate",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.ReviewCreateRequest"
-                        }
+                        "type": "string",
+                        "description": "Формат выгрузки: csv, json или letterboxd",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ReviewResponse"
-                        }
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1080,18 +1094,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
-                        }
-                    },
-                    "409": {
-                        "description": "Conflict",
-                        "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1101,41 +1103,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/review/content/{id}/{page}": {
+        "/api/favourite/my": {
             "get": {
-                "description": "Получить рецензии контента",
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Получение избранного пользователя",
                 "tags": [
-                    "review"
-                ],
-                "summary": "Получить рецензии контента",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "ID контента",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Номер страницы",
-                        "name": "page",
-                        "in": "path",
-                        "required": true
-                    }
+                    "Favourite"
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ReviewResponseList"
+                            "$ref": "#/definitions/dto.FavouritesResponse"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
@@ -1149,22 +1137,18 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/review/myReview": {
+        "/api/favourite/status/{id}": {
             "get": {
-                "description": "Получить рецензию пользователя к контенту",
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Получение статуса контента в избранном",
                 "tags": [
-                    "review"
+                    "Favourite"
                 ],
-                "summary": "Получить рецензию пользователя к контенту",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID контента",
-                        "name": "content_id",
-                        "in": "query",
+                        "description": "Идентификатор контента",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -1172,7 +1156,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ReviewResponse"
+                            "$ref": "#/definitions/dto.FavouriteStatusResponse"
                         }
                     },
                     "400": {
@@ -1202,21 +1186,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/review/recent": {
+        "/api/favourite/{id}": {
             "get": {
-                "description": "Получить последние рецензии",
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Получение избранного пользователя. Если пользователь скрыл избранное настройками приватности,",
                 "tags": [
-                    "review"
+                    "Favourite"
+                ],
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Идентификатор пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Получить последние рецензии",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ReviewResponseList"
+                            "$ref": "#/definitions/dto.FavouritesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "500": {
@@ -1226,22 +1233,21 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/review/user/{id}/recent": {
-            "get": {
-                "description": "Получить последние рецензии пользователя",
-                "produces": [
-                    "application/json"
+            },
+            "delete": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
                 ],
+                "description": "Удаление из избранного.",
                 "tags": [
-                    "review"
+                    "Favourite"
                 ],
-                "summary": "Получить последние рецензии пользователя",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID пользователя",
+                        "description": "Идентификатор контента",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1249,10 +1255,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ReviewResponseList"
-                        }
+                        "description": "OK"
                     },
                     "400": {
                         "description": "Bad Request",
@@ -1260,6 +1263,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1269,24 +1284,17 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/review/user/{id}/{page}": {
+        "/api/feed/{page}": {
             "get": {
-                "description": "Получить рецензии пользователя",
+                "description": "Новые рецензии, оценки, добавления в списки и изменения избранного пользователей, на которых",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "review"
+                    "follow"
                 ],
-                "summary": "Получить рецензии пользователя",
+                "summary": "Лента подписок",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "ID пользователя",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "type": "integer",
                         "description": "Номер страницы",
@@ -1299,7 +1307,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.UserReviewResponseList"
+                            "$ref": "#/definitions/dto.Feed"
                         }
                     },
                     "400": {
@@ -1308,6 +1316,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1317,23 +1331,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/review/{id}": {
+        "/api/follow/{id}": {
             "get": {
-                "description": "Получить рецензию по id",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Количество подписчиков и подписок пользователя и подписан ли на него текущий пользователь",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "review"
+                    "follow"
                 ],
-                "summary": "Получить рецензию",
+                "summary": "Подписки пользователя",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID рецензии",
+                        "description": "ID пользователя",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1343,7 +1354,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ReviewResponse"
+                            "$ref": "#/definitions/dto.FollowStatus"
                         }
                     },
                     "400": {
@@ -1352,12 +1363,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1366,24 +1371,21 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "put": {
                 "security": [
                     {
                         "_csrf": []
                     }
                 ],
-                "description": "Удалить рецензию",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Подписывает текущего пользователя на пользователя. Повторная подписка не считается ошибкой",
                 "tags": [
-                    "review"
+                    "follow"
                 ],
-                "summary": "Удалить рецензию",
+                "summary": "Подписаться",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID рецензии",
+                        "description": "ID пользователя",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1405,12 +1407,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
-                        }
-                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -1424,34 +1420,25 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/api/review/{id}/vote": {
-            "put": {
+            },
+            "delete": {
                 "security": [
                     {
                         "_csrf": []
                     }
                 ],
-                "description": "Поставить оценку на рецензию",
+                "description": "Отменяет подписку текущего пользователя на пользователя",
                 "tags": [
-                    "review"
+                    "follow"
                 ],
-                "summary": "Поставить оценку на рецензию",
+                "summary": "Отписаться",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID рецензии",
+                        "description": "ID пользователя",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "boolean",
-                        "description": "Лайк или дизлайк",
-                        "name": "vote",
-                        "in": "query",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -1483,48 +1470,43 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "_csrf": []
-                    }
-                ],
-                "description": "Убрать лайк с рецензии",
-                "consumes": [
+            }
+        },
+        "/api/follow/{id}/followers/{page}": {
+            "get": {
+                "description": "Подписчики пользователя по 20 на странице, начиная с последних подписавшихся",
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "review"
+                    "follow"
                 ],
-                "summary": "Убрать лайк с рецензии",
+                "summary": "Подписчики пользователя",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID рецензии",
+                        "description": "ID пользователя",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Номер страницы",
+                        "name": "page",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
+                            "$ref": "#/definitions/dto.FollowUsers"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
@@ -1538,29 +1520,99 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/search": {
+        "/api/follow/{id}/following/{page}": {
             "get": {
-                "description": "Поиск фильмов, сериалов и персон",
+                "description": "Пользователи, на которых подписан пользователь, по 20 на странице, начиная с последних подписок",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "follow"
+                ],
+                "summary": "Подписки пользователя",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Номер страницы",
+                        "name": "page",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.FollowUsers"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/import": {
+            "post": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "description": "Принимает CSV-выгрузку Letterboxd, IMDb или Kinopoisk и запускает импорт в фоне. Найденный",
                 "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Search"
+                    "import"
                 ],
+                "summary": "Импортировать историю просмотров",
                 "parameters": [
+                    {
+                        "type": "file",
+                        "description": "CSV-файл выгрузки",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
                     {
                         "type": "string",
-                        "description": "Поисковый запрос",
-                        "name": "query",
-                        "in": "query",
+                        "description": "Источник: letterboxd, imdb или kinopoisk",
+                        "name": "source",
+                        "in": "formData",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Категория избранного",
+                        "name": "category",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.SearchResult"
+                            "$ref": "#/definitions/dto.ImportJob"
                         }
                     },
                     "400": {
@@ -1569,6 +1621,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
@@ -1578,19 +1642,20 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/static/{id}": {
+        "/api/import/{id}": {
             "get": {
-                "description": "Получение ссылки на статический файл по id. Возвращает ссылку подобного вида:",
-                "consumes": [
+                "description": "Состояние задачи импорта и строки, для которых не нашелся контент. Строку можно сопоставить",
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Static"
+                    "import"
                 ],
+                "summary": "Состояние импорта",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID статики",
+                        "description": "ID задачи",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1598,22 +1663,31 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ImportJob"
+                        }
                     },
                     "400": {
-                        "description": "невалидный id статики",
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "404": {
-                        "description": "файл не найден",
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "500": {
-                        "description": "ошибка сервера",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
@@ -1621,32 +1695,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/user/avatar": {
+        "/api/import/{id}/row/{row}": {
             "put": {
                 "security": [
                     {
                         "_csrf": []
                     }
                 ],
-                "description": "Позволяет загрузить аватарку пользователя. Необходимо быть авторизованным",
+                "description": "Вручную указывает контент для строки, которую не удалось сопоставить. Контент добавляется",
+                "consumes": [
+                    "application/json"
+                ],
                 "tags": [
-                    "User"
+                    "import"
                 ],
+                "summary": "Сопоставить строку импорта",
                 "parameters": [
                     {
-                        "type": "string",
-                        "default": "session=xxx",
-                        "description": "session",
-                        "name": "Cookie",
-                        "in": "header",
+                        "type": "integer",
+                        "description": "ID задачи",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "type": "file",
-                        "description": "файл с аватаркой",
-                        "name": "avatar",
-                        "in": "formData",
+                        "type": "integer",
+                        "description": "Номер строки в файле",
+                        "name": "row",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Выбранный контент",
+                        "name": "resolve",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ImportResolveRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -1654,19 +1740,25 @@ const docTemplate = `{
                         "description": "OK"
                     },
                     "400": {
-                        "description": "Невалидное изображение",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "401": {
-                        "description": "Не авторизован",
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "500": {
-                        "description": "Внутренняя ошибка сервера",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
@@ -1674,49 +1766,50 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/user/login": {
+        "/api/list": {
             "post": {
                 "security": [
                     {
                         "_csrf": []
                     }
                 ],
-                "description": "Авторизация пользователя. При успешной авторизации отправляет куки с сессией. Если пользователь уже",
+                "description": "Создает именованный список контента. Приватный список видит только его автор",
                 "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "User"
+                    "list"
                 ],
+                "summary": "Создать список",
                 "parameters": [
                     {
-                        "description": "Данные для входа",
-                        "name": "loginData",
+                        "description": "Данные списка",
+                        "name": "list",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.Login"
+                            "$ref": "#/definitions/dto.UserListRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/echo.HTTPError"
+                            "$ref": "#/definitions/dto.UserList"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
@@ -1730,37 +1823,31 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/user/me": {
+        "/api/list/my": {
             "get": {
-                "description": "Возвращает id авторизованного пользователя",
-                "consumes": [
+                "description": "Все списки текущего пользователя, включая приватные, начиная с последних измененных",
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
-                ],
-                "parameters": [
-                    {
-                        "type": "string",
-                        "default": "session=xxx",
-                        "description": "session",
-                        "name": "Cookie",
-                        "in": "header",
-                        "required": true
-                    }
+                    "list"
                 ],
+                "summary": "Мои списки",
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserLists"
+                        }
                     },
                     "401": {
-                        "description": "Не авторизован",
+                        "description": "Unauthorized",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "500": {
-                        "description": "Внутренняя ошибка сервера",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
@@ -1768,57 +1855,46 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/user/password": {
-            "put": {
-                "security": [
-                    {
-                        "_csrf": []
-                    }
-                ],
-                "description": "Обновляет пароль пользователя. Необходимо быть авторизованным, при этом все сессии пользователя",
-                "consumes": [
+        "/api/list/user/{id}": {
+            "get": {
+                "description": "Публичные списки пользователя, начиная с последних измененных. Автору возвращаются и приватные.",
+                "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "list"
                 ],
+                "summary": "Списки пользователя",
                 "parameters": [
                     {
-                        "type": "string",
-                        "default": "session=xxx",
-                        "description": "session",
-                        "name": "Cookie",
-                        "in": "header",
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "id",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Данные для обновления пароля",
-                        "name": "loginData",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.UpdatePassword"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserLists"
+                        }
                     },
                     "400": {
-                        "description": "Неверный пароль или невалидный payload",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
-                    "401": {
-                        "description": "Не авторизован",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "500": {
-                        "description": "Внутренняя ошибка сервера",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
@@ -1826,24 +1902,22 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/user/profile": {
+        "/api/list/{id}": {
             "get": {
-                "description": "Возвращает профиль пользователя по id",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Список вместе с элементами по порядку и превью контента. Чужой приватный список и списки",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "list"
                 ],
+                "summary": "Список с элементами",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID пользователя",
+                        "description": "ID списка",
                         "name": "id",
-                        "in": "query",
+                        "in": "path",
                         "required": true
                     }
                 ],
@@ -1851,23 +1925,23 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.UserProfile"
+                            "$ref": "#/definitions/dto.UserListWithItems"
                         }
                     },
                     "400": {
-                        "description": "Неверный id",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "404": {
-                        "description": "Пользователь не найден",
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "500": {
-                        "description": "Внутренняя ошибка сервера",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
@@ -1880,50 +1954,123 @@ const docTemplate = `{
                         "_csrf": []
                     }
                 ],
-                "description": "Позволяет обновить следующие данные пользователя: почта, имя (никнейм). Необходимо быть авторизованным.",
+                "description": "Изменяет название, описание и видимость списка",
                 "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "User"
+                    "list"
                 ],
+                "summary": "Изменить список",
                 "parameters": [
                     {
-                        "type": "string",
-                        "default": "session=xxx",
-                        "description": "session",
-                        "name": "Cookie",
-                        "in": "header",
+                        "type": "integer",
+                        "description": "ID списка",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Данные для обновления профиля",
-                        "name": "updateData",
+                        "description": "Данные списка",
+                        "name": "list",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.UserUpdate"
+                            "$ref": "#/definitions/dto.UserListRequest"
                         }
                     }
                 ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "tags": [
+                    "list"
+                ],
+                "summary": "Удалить список",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID списка",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK"
                     },
                     "400": {
-                        "description": "Невалидные данные для обновления профиля",
+                        "description": "Bad Request",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "401": {
-                        "description": "Не авторизован",
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "500": {
-                        "description": "Внутренняя ошибка сервера",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
@@ -1931,28 +2078,36 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/user/register": {
+        "/api/list/{id}/item": {
             "post": {
                 "security": [
                     {
                         "_csrf": []
                     }
                 ],
-                "description": "Регистрация пользователя. Сразу же возвращает сессию в cookies",
+                "description": "Добавляет контент в конец списка. Заметка необязательна",
                 "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "User"
+                    "list"
                 ],
+                "summary": "Добавить контент в список",
                 "parameters": [
                     {
-                        "description": "Данные для регистрации",
-                        "name": "registerData",
+                        "type": "integer",
+                        "description": "ID списка",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Элемент списка",
+                        "name": "item",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.Register"
+                            "$ref": "#/definitions/dto.UserListItemRequest"
                         }
                     }
                 ],
@@ -1966,6 +2121,24 @@ const docTemplate = `{
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
                     "409": {
                         "description": "Conflict",
                         "schema": {
@@ -1981,22 +2154,43 @@ const docTemplate = `{
                 }
             }
         },
-        "/static/{path}": {
-            "get": {
-                "description": "Получение статического файла по относительному пути. Возвращает файл в виде байтов.",
+        "/api/list/{id}/item/{contentID}": {
+            "put": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
                 "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "Static"
+                    "list"
                 ],
+                "summary": "Изменить заметку к элементу списка",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Путь до статики",
-                        "name": "path",
+                        "type": "integer",
+                        "description": "ID списка",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID контента",
+                        "name": "contentID",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Элемент списка",
+                        "name": "item",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserListItemRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -2004,508 +2198,5336 @@ const docTemplate = `{
                         "description": "OK"
                     },
                     "400": {
-                        "description": "невалидный путь до статики",
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "404": {
-                        "description": "файл не найден",
+                        "description": "Not Found",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     },
                     "500": {
-                        "description": "ошибка сервера",
+                        "description": "Internal Server Error",
                         "schema": {
                             "$ref": "#/definitions/echo.HTTPError"
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "dto.Compilation": {
-            "type": "object",
-            "properties": {
-                "compilation_type_id": {
-                    "type": "integer",
-                    "format": "int",
-                    "example": 1
-                },
-                "id": {
-                    "type": "integer",
-                    "format": "int",
-                    "example": 1
-                },
-                "poster": {
-                    "type": "string",
-                    "format": "string",
-                    "example": "static/poster.jpg"
-                },
-                "title": {
-                    "type": "string",
-                    "format": "string",
-                    "example": "The Best"
-                }
-            }
-        },
-        "dto.CompilationResponseList": {
-            "type": "object",
-            "properties": {
-                "compilations": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.Compilation"
+            },
+            "delete": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "description": "Удаляет контент из списка, следующие элементы сдвигаются на одну позицию вверх",
+                "tags": [
+                    "list"
+                ],
+                "summary": "Удалить контент из списка",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID списка",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID контента",
+                        "name": "contentID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
                     }
                 }
             }
         },
-        "dto.CompilationType": {
-            "type": "object",
-            "properties": {
-                "id": {
-                    "type": "integer",
-                    "format": "int",
-                    "example": 1
-                },
-                "type": {
-                    "type": "string",
-                    "format": "string",
-                    "example": "C"
-                }
-            }
-        },
-        "dto.CompilationTypeResponseList": {
-            "type": "object",
+        "/api/list/{id}/order": {
+            "put": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "description": "Расставляет элементы в переданном порядке. Порядок должен содержать каждый элемент списка ровно",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "list"
+                ],
+                "summary": "Изменить порядок элементов списка",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID списка",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Новый порядок",
+                        "name": "order",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserListOrderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/moderation/log/{page}": {
+            "get": {
+                "description": "Решения модераторов по рецензиям, сначала новые. Только для модераторов",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "moderation"
+                ],
+                "summary": "Журнал модерации",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Номер страницы",
+                        "name": "page",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ModerationLog"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/moderation/queue/{page}": {
+            "get": {
+                "description": "Рецензии с нерассмотренными жалобами, сначала с наибольшим числом жалоб. Для отредактированных",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "moderation"
+                ],
+                "summary": "Очередь модерации",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Номер страницы",
+                        "name": "page",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ModerationQueue"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/moderation/review/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "description": "approve оставляет рецензию видимой, hide скрывает ее из списков и рейтинга контента, delete удаляет.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "moderation"
+                ],
+                "summary": "Решение модератора по рецензии",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID рецензии",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Решение модератора",
+                        "name": "decision",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ModerationDecisionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/moderation/review/{id}/report": {
+            "post": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "description": "Пожаловаться на рецензию. Возможные причины: spam, insult, spoiler, offtopic, other",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "moderation"
+                ],
+                "summary": "Пожаловаться на рецензию",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID рецензии",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Причина жалобы",
+                        "name": "report",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReviewReportRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/ongoing/nearest": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ongoing_content"
+                ],
+                "summary": "Получить ближайшие релизы",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.PreviewContent"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/ongoing/subscriptions": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ongoing_content"
+                ],
+                "summary": "Получить ID контентов, на которые подписан пользователь",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SubscriptionsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/ongoing/years": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ongoing_content"
+                ],
+                "summary": "Получить все года релизов",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "integer"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/ongoing/{id}/is_released": {
+            "get": {
+                "tags": [
+                    "ongoing_content"
+                ],
+                "summary": "Проверить, вышел ли контент. Использует WebSocket",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID контента",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "101": {
+                        "description": "WebSocket connection is established",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ongoing_content"
+                ],
+                "summary": "Установить состояние релиза",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID контента",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Секретный ключ",
+                        "name": "secret_key",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Вышел ли контент",
+                        "name": "is_released",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/ongoing/{id}/subscribe": {
+            "post": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ongoing_content"
+                ],
+                "summary": "Подписаться на выход контента",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID контента",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ongoing_content"
+                ],
+                "summary": "Отписаться от выхода контента",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID контента",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/ongoing/{year}/{month}": {
+            "get": {
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "ongoing_content"
+                ],
+                "summary": "Получить релизы по месяцу и году",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Месяц",
+                        "name": "month",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Год",
+                        "name": "year",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.PreviewContent"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/playground/ping": {
+            "get": {
+                "description": "Проверка соединения через классический ping pong",
+                "tags": [
+                    "Playground"
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Pong",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/progress/content/{id}": {
+            "get": {
+                "description": "Эпизоды сериала по сезонам с отметками о просмотре текущим пользователем",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progress"
+                ],
+                "summary": "Прогресс просмотра сериала",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID сериала",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WatchProgress"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "description": "Ставит или снимает отметку о просмотре эпизода, всего сезона или всех эпизодов сериала до указанного",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progress"
+                ],
+                "summary": "Отметить просмотр",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID сериала",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Отметка о просмотре",
+                        "name": "progress",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.WatchProgressRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WatchProgress"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/progress/content/{id}/next": {
+            "get": {
+                "description": "Следующий эпизод сериала: первый непросмотренный после последнего просмотренного, а если таких",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "progress"
+                ],
+                "summary": "Продолжить просмотр",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID сериала",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SeasonEpisode"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/rating/content/{id}": {
+            "get": {
+                "description": "Оценка контента без рецензии, поставленная текущим пользователем",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rating"
+                ],
+                "summary": "Моя оценка контента",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID контента",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserRating"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "description": "Оценить контент от 1 до 10 без рецензии. Повторная оценка заменяет предыдущую. Если у пользователя",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rating"
+                ],
+                "summary": "Оценить контент",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID контента",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Оценка",
+                        "name": "rating",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserRatingRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserRating"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "description": "Удалить оценку контента без рецензии",
+                "tags": [
+                    "rating"
+                ],
+                "summary": "Удалить оценку контента",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID контента",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/rating/episode/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "description": "Оценить эпизод сериала от 1 до 10. Повторная оценка заменяет предыдущую. На рейтинг сериала не влияет",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rating"
+                ],
+                "summary": "Оценить эпизод сериала",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID эпизода",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Оценка",
+                        "name": "rating",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserRatingRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "description": "Удалить оценку эпизода сериала",
+                "tags": [
+                    "rating"
+                ],
+                "summary": "Удалить оценку эпизода сериала",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID эпизода",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/rating/my/{page}": {
+            "get": {
+                "description": "Оценки контента без рецензии, поставленные текущим пользователем, начиная с последних измененных",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rating"
+                ],
+                "summary": "Мои оценки",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Номер страницы",
+                        "name": "page",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserRatingList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/rating/season/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "description": "Оценить сезон сериала от 1 до 10. Повторная оценка заменяет предыдущую. На рейтинг сериала не влияет",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rating"
+                ],
+                "summary": "Оценить сезон сериала",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID сезона",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Оценка",
+                        "name": "rating",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserRatingRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "description": "Удалить оценку сезона сериала",
+                "tags": [
+                    "rating"
+                ],
+                "summary": "Удалить оценку сезона сериала",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID сезона",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/recommendations": {
+            "get": {
+                "description": "Контент, похожий на тот, который понравился текущему пользователю или был им просмотрен, с",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recommendation"
+                ],
+                "summary": "Персональные рекомендации",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Количество рекомендаций, от 1 до 50, по умолчанию 20",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Recommendations"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/review": {
+            "put": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "description": "Обновить рецензию. Спойлеры в тексте размечаются тегами [spoiler]...[/spoiler]",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "review"
+                ],
+                "summary": "Обновить рецензию",
+                "parameters": [
+                    {
+                        "description": "Данные для обновления рецензии",
+                        "name": "reviewUpdate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReviewUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "description": "Создать рецензию. Спойлеры в тексте размечаются тегами [spoiler]...[/spoiler], в ответе текст",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "review"
+                ],
+                "summary": "Создать рецензию",
+                "parameters": [
+                    {
+                        "description": "Данные для создания рецензии",
+                        "name": "reviewCreate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReviewCreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/review/comment/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "description": "Изменить текст своего комментария",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "review"
+                ],
+                "summary": "Изменить комментарий",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID комментария",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Данные для обновления комментария",
+                        "name": "commentUpdate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReviewCommentUpdateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReviewCommentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "description": "Удалить свой комментарий. Ответы на него удаляются вместе с ним",
+                "tags": [
+                    "review"
+                ],
+                "summary": "Удалить комментарий",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID комментария",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/review/content/{id}/rating": {
+            "get": {
+                "description": "Число оценок от 1 до 10, средняя оценка, медиана и взвешенная оценка контента. Учитываются оценки",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "review"
+                ],
+                "summary": "Распределение оценок контента",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID контента",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ContentRatingStats"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/review/content/{id}/{page}": {
+            "get": {
+                "description": "Получить рецензии контента",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "review"
+                ],
+                "summary": "Получить рецензии контента",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID контента",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Номер страницы",
+                        "name": "page",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Не показывать рецензии со спойлерами",
+                        "name": "hide_spoilers",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Сортировка: newest, oldest, helpful, controversial, highest, lowest",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Тональность: positive (оценка 7-10), neutral (5-6), negative (1-4)",
+                        "name": "sentiment",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Минимальная длина текста рецензии без учета разметки спойлеров",
+                        "name": "min_length",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReviewResponseList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/review/myReview": {
+            "get": {
+                "description": "Получить рецензию пользователя к контенту",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "review"
+                ],
+                "summary": "Получить рецензию пользователя к контенту",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID контента",
+                        "name": "content_id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/review/recent": {
+            "get": {
+                "description": "Получить последние рецензии",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "review"
+                ],
+                "summary": "Получить последние рецензии",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Не показывать рецензии со спойлерами",
+                        "name": "hide_spoilers",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Тональность: positive (оценка 7-10), neutral (5-6), negative (1-4)",
+                        "name": "sentiment",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Минимальная длина текста рецензии без учета разметки спойлеров",
+                        "name": "min_length",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReviewResponseList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/review/user/{id}/recent": {
+            "get": {
+                "description": "Получить последние рецензии пользователя",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "review"
+                ],
+                "summary": "Получить последние рецензии пользователя",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Не показывать рецензии со спойлерами",
+                        "name": "hide_spoilers",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Тональность: positive (оценка 7-10), neutral (5-6), negative (1-4)",
+                        "name": "sentiment",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Минимальная длина текста рецензии без учета разметки спойлеров",
+                        "name": "min_length",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReviewResponseList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/review/user/{id}/{page}": {
+            "get": {
+                "description": "Получить рецензии пользователя",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "review"
+                ],
+                "summary": "Получить рецензии пользователя",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Номер страницы",
+                        "name": "page",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Не показывать рецензии со спойлерами",
+                        "name": "hide_spoilers",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Сортировка: newest, oldest, helpful, controversial, highest, lowest",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Тональность: positive (оценка 7-10), neutral (5-6), negative (1-4)",
+                        "name": "sentiment",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Минимальная длина текста рецензии без учета разметки спойлеров",
+                        "name": "min_length",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserReviewResponseList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/review/{id}": {
+            "get": {
+                "description": "Получить рецензию по id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "review"
+                ],
+                "summary": "Получить рецензию",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID рецензии",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "description": "Удалить рецензию",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "review"
+                ],
+                "summary": "Удалить рецензию",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID рецензии",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/review/{id}/comments": {
+            "post": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "description": "Оставить комментарий к рецензии или ответить на комментарий верхнего уровня",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "review"
+                ],
+                "summary": "Оставить комментарий к рецензии",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID рецензии",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Данные для создания комментария",
+                        "name": "commentCreate",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReviewCommentCreateRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReviewCommentResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/review/{id}/comments/{page}": {
+            "get": {
+                "description": "Получить комментарии верхнего уровня к рецензии вместе с ответами на них",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "review"
+                ],
+                "summary": "Получить комментарии к рецензии",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID рецензии",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Номер страницы",
+                        "name": "page",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReviewCommentResponseList"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/review/{id}/history": {
+            "get": {
+                "description": "Получить рецензию и ее предыдущие версии, начиная с первоначальной",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "review"
+                ],
+                "summary": "Получить историю правок рецензии",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID рецензии",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReviewHistory"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/review/{id}/vote": {
+            "put": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "description": "Поставить оценку на рецензию",
+                "tags": [
+                    "review"
+                ],
+                "summary": "Поставить оценку на рецензию",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID рецензии",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Лайк или дизлайк",
+                        "name": "vote",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "description": "Убрать лайк с рецензии",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "review"
+                ],
+                "summary": "Убрать лайк с рецензии",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID рецензии",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/search": {
+            "get": {
+                "description": "Поиск фильмов, сериалов и персон",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Search"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Поисковый запрос",
+                        "name": "query",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.SearchResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/static/{id}": {
+            "get": {
+                "description": "Получение ссылки на статический файл по id. Возвращает ссылку подобного вида:",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Static"
+                ],
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID статики",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "невалидный id статики",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "файл не найден",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/avatar": {
+            "put": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "description": "Позволяет загрузить аватарку пользователя. Необходимо быть авторизованным",
+                "tags": [
+                    "User"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "session=xxx",
+                        "description": "session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "файл с аватаркой",
+                        "name": "avatar",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Невалидное изображение",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/login": {
+            "post": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "description": "Авторизация пользователя. При успешной авторизации отправляет куки с сессией. Если пользователь уже",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "parameters": [
+                    {
+                        "description": "Данные для входа",
+                        "name": "loginData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.Login"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/me": {
+            "get": {
+                "description": "Возвращает id авторизованного пользователя",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "session=xxx",
+                        "description": "session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/password": {
+            "put": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "description": "Обновляет пароль пользователя. Необходимо быть авторизованным, при этом все сессии пользователя",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "session=xxx",
+                        "description": "session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Данные для обновления пароля",
+                        "name": "loginData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdatePassword"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Неверный пароль или невалидный payload",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/privacy": {
+            "get": {
+                "description": "Видимость избранного, рецензий, оценок и списков текущего пользователя",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "privacy"
+                ],
+                "summary": "Настройки приватности",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PrivacySettings"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "description": "Изменяет видимость разделов профиля. public - видно всем, followers - только подписчикам,",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "privacy"
+                ],
+                "summary": "Изменить настройки приватности",
+                "parameters": [
+                    {
+                        "description": "Настройки приватности",
+                        "name": "settings",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.PrivacySettings"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PrivacySettings"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/profile": {
+            "get": {
+                "description": "Возвращает профиль пользователя по id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "id",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserProfile"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный id",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "description": "Позволяет обновить следующие данные пользователя: почта, имя (никнейм). Необходимо быть авторизованным.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "default": "session=xxx",
+                        "description": "session",
+                        "name": "Cookie",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Данные для обновления профиля",
+                        "name": "updateData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserUpdate"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Невалидные данные для обновления профиля",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "401": {
+                        "description": "Не авторизован",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Внутренняя ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/register": {
+            "post": {
+                "security": [
+                    {
+                        "_csrf": []
+                    }
+                ],
+                "description": "Регистрация пользователя. Сразу же возвращает сессию в cookies",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "User"
+                ],
+                "parameters": [
+                    {
+                        "description": "Данные для регистрации",
+                        "name": "registerData",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.Register"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/{id}/public": {
+            "get": {
+                "description": "Профиль пользователя без почты: счетчики, последние рецензии и любимые жанры. Поля разделов,",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "privacy"
+                ],
+                "summary": "Публичный профиль",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PublicProfile"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/{id}/stats": {
+            "get": {
+                "description": "Число контента по категориям избранного, время просмотра в минутах, любимые жанры, страны,",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "privacy"
+                ],
+                "summary": "Статистика пользователя",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.UserStats"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        },
+        "/healthz": {
+            "get": {
+                "description": "Проверка того, что процесс жив. Зависимости не проверяются",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.HealthResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/readyz": {
+            "get": {
+                "description": "Проверка готовности принимать трафик: доступность Postgres, Redis, S3 и gRPC сервисов.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Health"
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.HealthResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/dto.HealthResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/static/{path}": {
+            "get": {
+                "description": "Получение статического файла по относительному пути. Возвращает файл в виде байтов.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Static"
+                ],
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Путь до статики",
+                        "name": "path",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "400": {
+                        "description": "невалидный путь до статики",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "404": {
+                        "description": "файл не найден",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    },
+                    "500": {
+                        "description": "ошибка сервера",
+                        "schema": {
+                            "$ref": "#/definitions/echo.HTTPError"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dto.BestEpisodes": {
+            "type": "object",
+            "properties": {
+                "episodes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SeasonEpisode"
+                    }
+                }
+            }
+        },
+        "dto.Compilation": {
+            "type": "object",
+            "properties": {
+                "compilation_type_id": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "id": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "poster": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "static/poster.jpg"
+                },
+                "title": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "The Best"
+                }
+            }
+        },
+        "dto.CompilationResponse": {
+            "type": "object",
+            "properties": {
+                "compilation": {
+                    "$ref": "#/definitions/dto.Compilation"
+                },
+                "content": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PreviewContent"
+                    }
+                },
+                "content_length": {
+                    "type": "integer"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "per_page": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.CompilationResponseList": {
+            "type": "object",
+            "properties": {
+                "compilations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.Compilation"
+                    }
+                }
+            }
+        },
+        "dto.CompilationType": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "type": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "C"
+                }
+            }
+        },
+        "dto.CompilationTypeResponseList": {
+            "type": "object",
+            "properties": {
+                "compilation_types": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CompilationType"
+                    }
+                }
+            }
+        },
+        "dto.Content": {
+            "type": "object",
+            "properties": {
+                "actors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PersonPreview"
+                    }
+                },
+                "ageRestriction": {
+                    "type": "integer",
+                    "example": 18
+                },
+                "backdropURL": {
+                    "type": "string",
+                    "example": "/static/backdrop.jpg"
+                },
+                "budget": {
+                    "type": "string",
+                    "example": "1000000"
+                },
+                "composers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PersonPreview"
+                    }
+                },
+                "countries": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Россия",
+                        "США"
+                    ]
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Описание фильма или сериала"
+                },
+                "directors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PersonPreview"
+                    }
+                },
+                "editors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PersonPreview"
+                    }
+                },
+                "facts": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Факты о фильме или сериале"
+                    ]
+                },
+                "genres": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Боевик",
+                        "Драма"
+                    ]
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "imdbRating": {
+                    "type": "number",
+                    "example": 9.1
+                },
+                "movie": {
+                    "$ref": "#/definitions/dto.MovieContent"
+                },
+                "ongoing": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "ongoingDate": {
+                    "type": "string",
+                    "example": "2022-01-02T15:04:05Z"
+                },
+                "operators": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PersonPreview"
+                    }
+                },
+                "originalTitle": {
+                    "type": "string",
+                    "example": "Batman"
+                },
+                "picturesURL": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "/static/picture1.jpg",
+                        "/static/picture2.jpg"
+                    ]
+                },
+                "posterURL": {
+                    "type": "string",
+                    "example": "/static/poster.jpg"
+                },
+                "producers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PersonPreview"
+                    }
+                },
+                "rating": {
+                    "type": "number",
+                    "example": 9.1
+                },
+                "series": {
+                    "$ref": "#/definitions/dto.SeriesContent"
+                },
+                "similarContent": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PreviewContentCardVertical"
+                    }
+                },
+                "slogan": {
+                    "type": "string",
+                    "example": "I'm Batman"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Бэтмен"
+                },
+                "trailerLink": {
+                    "type": "string",
+                    "example": "https://www.youtube.com/watch?v=123456"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "movie"
+                },
+                "writers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PersonPreview"
+                    }
+                }
+            }
+        },
+        "dto.ContentRatingStats": {
+            "type": "object",
+            "properties": {
+                "average": {
+                    "type": "number",
+                    "format": "float",
+                    "example": 7.9
+                },
+                "contentID": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "histogram": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.RatingHistogramBar"
+                    }
+                },
+                "median": {
+                    "type": "number",
+                    "format": "float",
+                    "example": 8
+                },
+                "ratingCount": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 40
+                },
+                "weightedRating": {
+                    "type": "number",
+                    "format": "float",
+                    "example": 7.6
+                }
+            }
+        },
+        "dto.CountryCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 12
+                },
+                "id": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "США"
+                }
+            }
+        },
+        "dto.CreateFavouriteRequest": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "nolint:lll",
+                    "type": "string",
+                    "format": "string",
+                    "example": "favourite"
+                },
+                "contentID": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                }
+            }
+        },
+        "dto.DiaryCalendar": {
+            "type": "object",
+            "properties": {
+                "days": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.DiaryDay"
+                    }
+                },
+                "entries": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 12
+                },
+                "month": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 6
+                },
+                "year": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 2024
+                }
+            }
+        },
+        "dto.DiaryContentHistory": {
+            "type": "object",
+            "properties": {
+                "contentID": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.DiaryEntry"
+                    }
+                },
+                "rewatches": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 2
+                },
+                "views": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 3
+                }
+            }
+        },
+        "dto.DiaryDay": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "2024-06-01"
+                },
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.DiaryEntryWithContent"
+                    }
+                }
+            }
+        },
+        "dto.DiaryEntry": {
+            "type": "object",
+            "properties": {
+                "contentID": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "createdAt": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "2022-01-02T15:04:05Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "note": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "Пересмотрел в кинотеатре"
+                },
+                "rating": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 8
+                },
+                "rewatch": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "2022-01-02T15:04:05Z"
+                },
+                "watchedOn": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "2024-06-01"
+                }
+            }
+        },
+        "dto.DiaryEntryRequest": {
+            "type": "object",
+            "properties": {
+                "contentID": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "note": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "Пересмотрел в кинотеатре"
+                },
+                "rating": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 8
+                },
+                "rewatch": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "watchedOn": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "2024-06-01"
+                }
+            }
+        },
+        "dto.DiaryEntryWithContent": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "$ref": "#/definitions/dto.PreviewContent"
+                },
+                "contentID": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "createdAt": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "2022-01-02T15:04:05Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "note": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "Пересмотрел в кинотеатре"
+                },
+                "rating": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 8
+                },
+                "rewatch": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "2022-01-02T15:04:05Z"
+                },
+                "watchedOn": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "2024-06-01"
+                }
+            }
+        },
+        "dto.DiaryMonthStats": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 12
+                },
+                "month": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 6
+                }
+            }
+        },
+        "dto.DiaryYearStats": {
+            "type": "object",
+            "properties": {
+                "averageRating": {
+                    "type": "number",
+                    "format": "float",
+                    "example": 7.5
+                },
+                "entries": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 120
+                },
+                "months": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.DiaryMonthStats"
+                    }
+                },
+                "rewatches": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 20
+                },
+                "uniqueContents": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 100
+                },
+                "year": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 2024
+                }
+            }
+        },
+        "dto.Episode": {
+            "type": "object",
+            "properties": {
+                "duration": {
+                    "type": "integer",
+                    "example": 45
+                },
+                "episodeNumber": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "rating": {
+                    "type": "number",
+                    "example": 8.9
+                },
+                "ratingCount": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Название серии"
+                }
+            }
+        },
+        "dto.EpisodeProgress": {
+            "type": "object",
+            "properties": {
+                "duration": {
+                    "type": "integer",
+                    "example": 45
+                },
+                "episodeNumber": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "rating": {
+                    "type": "number",
+                    "example": 8.9
+                },
+                "ratingCount": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Название серии"
+                },
+                "watched": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "dto.Favourite": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "description": "nolint:lll",
+                    "type": "string",
+                    "format": "string",
+                    "example": "favourite"
+                },
+                "content": {
+                    "$ref": "#/definitions/dto.PreviewContent"
+                }
+            }
+        },
+        "dto.FavouriteStatusResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "description": "nolint:lll",
+                    "type": "string",
+                    "format": "string",
+                    "example": "favourite"
+                }
+            }
+        },
+        "dto.FavouritesResponse": {
+            "type": "object",
+            "properties": {
+                "favourites": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.Favourite"
+                    }
+                }
+            }
+        },
+        "dto.Feed": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 20
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FeedItem"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "pages": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "total": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                }
+            }
+        },
+        "dto.FeedItem": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "watching"
+                },
+                "content": {
+                    "$ref": "#/definitions/dto.PreviewContent"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "2022-01-02T15:04:05Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "listID": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "rating": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 8
+                },
+                "reviewID": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "type": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "rating"
+                },
+                "user": {
+                    "$ref": "#/definitions/dto.FollowUser"
+                }
+            }
+        },
+        "dto.FollowStatus": {
+            "type": "object",
+            "properties": {
+                "followersCount": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 10
+                },
+                "following": {
+                    "type": "boolean",
+                    "format": "bool",
+                    "example": true
+                },
+                "followingCount": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 5
+                }
+            }
+        },
+        "dto.FollowUser": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "avatars/avatar.jpg"
+                },
+                "id": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "Егор"
+                }
+            }
+        },
+        "dto.FollowUsers": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 20
+                },
+                "page": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "pages": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "total": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "users": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FollowUser"
+                    }
+                }
+            }
+        },
+        "dto.GenreCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 12
+                },
+                "id": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "Драма"
+                }
+            }
+        },
+        "dto.HealthResponse": {
+            "type": "object",
+            "properties": {
+                "checks": {
+                    "description": "nolint:lll",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "ok"
+                }
+            }
+        },
+        "dto.ImportJob": {
+            "type": "object",
+            "properties": {
+                "category": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "watched"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "2024-06-14 10:22:40 +0000 UTC"
+                },
+                "error": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "не удалось импортировать"
+                },
+                "id": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "matchedRows": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 115
+                },
+                "source": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "letterboxd"
+                },
+                "status": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "done"
+                },
+                "totalRows": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 120
+                },
+                "unmatched": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ImportRow"
+                    }
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "2024-06-14 10:22:45 +0000 UTC"
+                }
+            }
+        },
+        "dto.ImportResolveRequest": {
+            "type": "object",
+            "properties": {
+                "contentID": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                }
+            }
+        },
+        "dto.ImportRow": {
+            "type": "object",
+            "properties": {
+                "contentID": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 0
+                },
+                "kinopoiskID": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 409
+                },
+                "originalTitle": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "Heat"
+                },
+                "rating": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 9
+                },
+                "row": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 12
+                },
+                "title": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "Схватка"
+                },
+                "year": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1995
+                }
+            }
+        },
+        "dto.Login": {
+            "type": "object",
+            "properties": {
+                "login": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "email@email.com"
+                },
+                "password": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "SecretPassword1!"
+                }
+            }
+        },
+        "dto.ModerationDecisionRequest": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "hide"
+                },
+                "note": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "оскорбления"
+                }
+            }
+        },
+        "dto.ModerationLog": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 10
+                },
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ModerationLogEntry"
+                    }
+                },
+                "page": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "pages": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "total": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                }
+            }
+        },
+        "dto.ModerationLogEntry": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "hide"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "2022-01-02T15:04:05Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "moderatorID": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "note": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "оскорбления в тексте"
+                },
+                "reviewID": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                }
+            }
+        },
+        "dto.ModerationQueue": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 10
+                },
+                "page": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "pages": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "reviews": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ModerationQueueItem"
+                    }
+                },
+                "total": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                }
+            }
+        },
+        "dto.ModerationQueueItem": {
+            "type": "object",
+            "properties": {
+                "hidden": {
+                    "type": "boolean",
+                    "format": "bool",
+                    "example": false
+                },
+                "lastReportedAt": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "2022-01-02T15:04:05Z"
+                },
+                "original": {
+                    "description": "Original первоначальная версия рецензии, если автор редактировал ее",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/dto.ReviewRevision"
+                        }
+                    ]
+                },
+                "reasons": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "spam",
+                        "insult"
+                    ]
+                },
+                "reports": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 3
+                },
+                "review": {
+                    "$ref": "#/definitions/dto.ReviewResponse"
+                }
+            }
+        },
+        "dto.MonthActivity": {
+            "type": "object",
+            "properties": {
+                "episodes": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 12
+                },
+                "favourites": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 5
+                },
+                "month": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "2024-06"
+                },
+                "ratings": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 3
+                },
+                "reviews": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                }
+            }
+        },
+        "dto.MovieContent": {
+            "type": "object",
+            "properties": {
+                "duration": {
+                    "type": "integer",
+                    "example": 134
+                },
+                "premiere": {
+                    "type": "string",
+                    "example": "2020-01-01"
+                }
+            }
+        },
+        "dto.Person": {
+            "type": "object",
             "properties": {
-                "compilation_types": {
+                "birthDate": {
+                    "type": "string",
+                    "example": "1964-09-02"
+                },
+                "deathDate": {
+                    "type": "string",
+                    "example": "2021-09-02"
+                },
+                "enName": {
+                    "type": "string",
+                    "example": "Keanu Reeves"
+                },
+                "height": {
+                    "type": "integer",
+                    "example": 185
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Киану Ривз"
+                },
+                "photoURL": {
+                    "type": "string",
+                    "example": "/static/photo.jpg"
+                },
+                "roles": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "$ref": "#/definitions/dto.PreviewContentCardVertical"
+                        }
+                    }
+                },
+                "sex": {
+                    "type": "string",
+                    "example": "M"
+                }
+            }
+        },
+        "dto.PersonCount": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 3
+                },
+                "enName": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "Michael Mann"
+                },
+                "id": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "Майкл Манн"
+                }
+            }
+        },
+        "dto.PersonPreview": {
+            "type": "object",
+            "properties": {
+                "enName": {
+                    "type": "string",
+                    "example": "Keanu Reeves"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Киану Ривз"
+                }
+            }
+        },
+        "dto.PersonPreviewWithPhoto": {
+            "type": "object",
+            "properties": {
+                "enName": {
+                    "type": "string",
+                    "example": "Keanu Reeves"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Киану Ривз"
+                },
+                "photoURL": {
+                    "type": "string",
+                    "example": "/static/photo.jpg"
+                }
+            }
+        },
+        "dto.PreviewContent": {
+            "type": "object",
+            "properties": {
+                "actors": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.CompilationType"
-                    }
+                        "type": "string"
+                    },
+                    "example": [
+                        "Том Хэнкс",
+                        "Сергей Бодров"
+                    ]
+                },
+                "country": {
+                    "type": "string",
+                    "example": "Россия"
+                },
+                "director": {
+                    "type": "string",
+                    "example": "Тарантино"
+                },
+                "duration": {
+                    "description": "Поля, которые есть только у фильмов",
+                    "type": "integer",
+                    "example": 134
+                },
+                "genre": {
+                    "type": "string",
+                    "example": "Боевик"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "ongoing": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "ongoingDate": {
+                    "description": "Поля, которые есть только у онгоингов",
+                    "type": "string",
+                    "example": "2022-01-02T15:04:05Z"
+                },
+                "originalTitle": {
+                    "type": "string",
+                    "example": "Batman"
+                },
+                "poster": {
+                    "type": "string",
+                    "example": "/static/poster.jpg"
+                },
+                "rating": {
+                    "type": "number",
+                    "example": 9.1
+                },
+                "release": {
+                    "type": "integer",
+                    "example": 2020
+                },
+                "seasonsNumber": {
+                    "description": "Поля, которые есть только у сериалов",
+                    "type": "integer",
+                    "example": 1
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Бэтмен"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "movie"
+                },
+                "yearEnd": {
+                    "type": "integer",
+                    "example": 2021
+                },
+                "yearStart": {
+                    "type": "integer",
+                    "example": 2020
+                }
+            }
+        },
+        "dto.PreviewContentCardVertical": {
+            "type": "object",
+            "properties": {
+                "genre": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Боевик"
+                    ]
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "poster": {
+                    "type": "string",
+                    "example": "/static/poster.jpg"
+                },
+                "rating": {
+                    "type": "number",
+                    "example": 9.1
+                },
+                "releaseYear": {
+                    "description": "Поля, которые есть только у фильмов",
+                    "type": "integer",
+                    "example": 2020
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Бэтмен"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "movie"
+                },
+                "yearEnd": {
+                    "type": "integer",
+                    "example": 2021
+                },
+                "yearStart": {
+                    "description": "Поля, которые есть только у сериалов",
+                    "type": "integer",
+                    "example": 2020
+                }
+            }
+        },
+        "dto.PrivacySettings": {
+            "type": "object",
+            "properties": {
+                "favourites": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "public"
+                },
+                "lists": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "private"
+                },
+                "ratings": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "followers"
+                },
+                "reviews": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "public"
                 }
             }
         },
-        "dto.Content": {
+        "dto.PublicProfile": {
             "type": "object",
             "properties": {
-                "actors": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.PersonPreview"
-                    }
+                "avatar": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "avatars/avatar.jpg"
                 },
-                "ageRestriction": {
+                "favouritesCount": {
                     "type": "integer",
-                    "example": 18
-                },
-                "backdropURL": {
-                    "type": "string",
-                    "example": "/static/backdrop.jpg"
+                    "format": "int",
+                    "example": 20
                 },
-                "budget": {
-                    "type": "string",
-                    "example": "1000000"
+                "id": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
                 },
-                "composers": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.PersonPreview"
-                    }
+                "listsCount": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 3
                 },
-                "countries": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "Россия",
-                        "США"
-                    ]
+                "me": {
+                    "type": "boolean",
+                    "format": "bool",
+                    "example": false
                 },
-                "description": {
+                "name": {
                     "type": "string",
-                    "example": "Описание фильма или сериала"
+                    "format": "string",
+                    "example": "Егор"
                 },
-                "directors": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.PersonPreview"
-                    }
+                "rating": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 100
                 },
-                "editors": {
+                "ratingsCount": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 50
+                },
+                "recentReviews": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.PersonPreview"
+                        "$ref": "#/definitions/dto.ReviewResponse"
                     }
                 },
-                "facts": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "Факты о фильме или сериале"
-                    ]
+                "reviewsCount": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 10
                 },
-                "genres": {
+                "topGenres": {
                     "type": "array",
                     "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "Боевик",
-                        "Драма"
-                    ]
+                        "$ref": "#/definitions/dto.GenreCount"
+                    }
+                }
+            }
+        },
+        "dto.RatedContent": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "$ref": "#/definitions/dto.PreviewContent"
                 },
-                "id": {
+                "contentID": {
                     "type": "integer",
+                    "format": "int",
                     "example": 1
                 },
-                "imdbRating": {
-                    "type": "number",
-                    "example": 9.1
-                },
-                "movie": {
-                    "$ref": "#/definitions/dto.MovieContent"
-                },
-                "ongoing": {
-                    "type": "boolean",
-                    "example": true
-                },
-                "ongoingDate": {
+                "createdAt": {
                     "type": "string",
+                    "format": "string",
                     "example": "2022-01-02T15:04:05Z"
                 },
-                "operators": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.PersonPreview"
-                    }
-                },
-                "originalTitle": {
-                    "type": "string",
-                    "example": "Batman"
-                },
-                "picturesURL": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "/static/picture1.jpg",
-                        "/static/picture2.jpg"
-                    ]
+                "rating": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 8
                 },
-                "posterURL": {
+                "updatedAt": {
                     "type": "string",
-                    "example": "/static/poster.jpg"
-                },
-                "producers": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.PersonPreview"
-                    }
+                    "format": "string",
+                    "example": "2022-01-02T15:04:05Z"
+                }
+            }
+        },
+        "dto.RatingHistogramBar": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 12
                 },
                 "rating": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 9
+                }
+            }
+        },
+        "dto.RatingStats": {
+            "type": "object",
+            "properties": {
+                "average": {
                     "type": "number",
-                    "example": 9.1
+                    "format": "float",
+                    "example": 7.45
                 },
-                "series": {
-                    "$ref": "#/definitions/dto.SeriesContent"
+                "count": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 42
                 },
-                "similarContent": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.PreviewContentCardVertical"
-                    }
+                "siteAverage": {
+                    "type": "number",
+                    "format": "float",
+                    "example": 6.9
+                }
+            }
+        },
+        "dto.Recommendation": {
+            "type": "object",
+            "properties": {
+                "becauseOf": {
+                    "$ref": "#/definitions/dto.RecommendationSource"
                 },
-                "slogan": {
+                "content": {
+                    "$ref": "#/definitions/dto.PreviewContent"
+                },
+                "reason": {
                     "type": "string",
-                    "example": "I'm Batman"
+                    "format": "string",
+                    "example": "Потому что вам понравился «Бэтмен»"
+                }
+            }
+        },
+        "dto.RecommendationSource": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
                 },
                 "title": {
                     "type": "string",
+                    "format": "string",
                     "example": "Бэтмен"
-                },
-                "trailerLink": {
+                }
+            }
+        },
+        "dto.Recommendations": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.Recommendation"
+                    }
+                }
+            }
+        },
+        "dto.Register": {
+            "type": "object",
+            "properties": {
+                "email": {
                     "type": "string",
-                    "example": "https://www.youtube.com/watch?v=123456"
+                    "format": "string",
+                    "example": "email@email.com"
                 },
-                "type": {
+                "password": {
                     "type": "string",
-                    "example": "movie"
+                    "format": "string",
+                    "example": "SecretPassword1!"
+                }
+            }
+        },
+        "dto.ReviewCommentCreateRequest": {
+            "type": "object",
+            "properties": {
+                "parentID": {
+                    "description": "ParentID - id комментария, на который дается ответ. 0 для комментария верхнего уровня",
+                    "type": "integer",
+                    "format": "int",
+                    "example": 0
                 },
-                "writers": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.PersonPreview"
-                    }
+                "text": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "согласен"
                 }
             }
         },
-        "dto.CreateFavouriteRequest": {
+        "dto.ReviewCommentResponse": {
             "type": "object",
             "properties": {
-                "category": {
-                    "description": "nolint:lll",
+                "authorAvatar": {
                     "type": "string",
                     "format": "string",
-                    "example": "favourite"
+                    "example": "avatars/avatar.jpg"
+                },
+                "authorID": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "authorName": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "Author"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "2022-01-02T15:04:05Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "parentID": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 0
+                },
+                "replies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ReviewCommentResponse"
+                    }
                 },
-                "contentID": {
+                "reviewID": {
                     "type": "integer",
                     "format": "int",
                     "example": 1
+                },
+                "text": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "согласен"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "2022-01-02T15:04:05Z"
                 }
             }
         },
-        "dto.Episode": {
+        "dto.ReviewCommentResponseList": {
             "type": "object",
             "properties": {
-                "duration": {
+                "comments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ReviewCommentResponse"
+                    }
+                },
+                "count": {
                     "type": "integer",
-                    "example": 45
+                    "format": "int",
+                    "example": 10
                 },
-                "episodeNumber": {
+                "page": {
                     "type": "integer",
+                    "format": "int",
                     "example": 1
                 },
-                "id": {
+                "pages": {
                     "type": "integer",
+                    "format": "int",
                     "example": 1
                 },
-                "title": {
-                    "type": "string",
-                    "example": "Название серии"
+                "total": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
                 }
             }
         },
-        "dto.Favourite": {
+        "dto.ReviewCommentUpdateRequest": {
             "type": "object",
             "properties": {
-                "category": {
-                    "description": "nolint:lll",
+                "text": {
                     "type": "string",
                     "format": "string",
-                    "example": "favourite"
-                },
-                "content": {
-                    "$ref": "#/definitions/dto.PreviewContent"
+                    "example": "согласен"
                 }
             }
         },
-        "dto.FavouriteStatusResponse": {
+        "dto.ReviewCreateRequest": {
             "type": "object",
             "properties": {
-                "status": {
-                    "description": "nolint:lll",
+                "contentID": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "hasSpoilers": {
+                    "type": "boolean",
+                    "format": "bool",
+                    "example": false
+                },
+                "rating": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 5
+                },
+                "text": {
                     "type": "string",
                     "format": "string",
-                    "example": "favourite"
+                    "example": "i like it"
+                },
+                "title": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "Title"
                 }
             }
         },
-        "dto.FavouritesResponse": {
+        "dto.ReviewHistory": {
             "type": "object",
             "properties": {
-                "favourites": {
+                "review": {
+                    "$ref": "#/definitions/dto.ReviewResponse"
+                },
+                "revisions": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.Favourite"
+                        "$ref": "#/definitions/dto.ReviewRevision"
                     }
                 }
             }
         },
-        "dto.Login": {
+        "dto.ReviewReportRequest": {
             "type": "object",
             "properties": {
-                "login": {
+                "comment": {
                     "type": "string",
                     "format": "string",
-                    "example": "email@email.com"
+                    "example": "реклама"
                 },
-                "password": {
+                "reason": {
                     "type": "string",
                     "format": "string",
-                    "example": "SecretPassword1!"
+                    "example": "spam"
                 }
             }
         },
-        "dto.MovieContent": {
+        "dto.ReviewResponse": {
             "type": "object",
             "properties": {
-                "duration": {
+                "authorAvatar": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "avatars/avatar.jpg"
+                },
+                "authorID": {
                     "type": "integer",
-                    "example": 134
+                    "format": "int",
+                    "example": 1
                 },
-                "premiere": {
+                "authorName": {
                     "type": "string",
-                    "example": "2020-01-01"
+                    "format": "string",
+                    "example": "Author"
+                },
+                "comments": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 3
+                },
+                "contentID": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "contentName": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "Content"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "format": "int",
+                    "example": "2022-01-02T15:04:05Z"
+                },
+                "dislikes": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 5
+                },
+                "editCount": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 2
+                },
+                "editedAt": {
+                    "description": "EditedAt время последней правки, пустое, если рецензия не редактировалась",
+                    "type": "string",
+                    "format": "string",
+                    "example": "2022-01-03T15:04:05Z"
+                },
+                "hasSpoilers": {
+                    "type": "boolean",
+                    "format": "bool",
+                    "example": true
+                },
+                "id": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "likes": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 5
+                },
+                "rating": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 5
+                },
+                "spoilers": {
+                    "description": "Spoilers диапазоны спойлеров в тексте рецензии, которые нужно скрыть",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SpoilerRange"
+                    }
+                },
+                "text": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "i like it"
+                },
+                "title": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "Title"
                 }
             }
         },
-        "dto.Person": {
+        "dto.ReviewResponseList": {
             "type": "object",
             "properties": {
-                "birthDate": {
+                "count": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 10
+                },
+                "page": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "pages": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "reviews": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ReviewResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                }
+            }
+        },
+        "dto.ReviewRevision": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
                     "type": "string",
-                    "example": "1964-09-02"
+                    "format": "string",
+                    "example": "2022-01-02T15:04:05Z"
                 },
-                "deathDate": {
+                "hasSpoilers": {
+                    "type": "boolean",
+                    "format": "bool",
+                    "example": false
+                },
+                "rating": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 5
+                },
+                "replacedAt": {
                     "type": "string",
-                    "example": "2021-09-02"
+                    "format": "string",
+                    "example": "2022-01-03T15:04:05Z"
                 },
-                "enName": {
+                "spoilers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SpoilerRange"
+                    }
+                },
+                "text": {
                     "type": "string",
-                    "example": "Keanu Reeves"
+                    "format": "string",
+                    "example": "i like it"
                 },
-                "height": {
+                "title": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "Title"
+                }
+            }
+        },
+        "dto.ReviewUpdateRequest": {
+            "type": "object",
+            "properties": {
+                "hasSpoilers": {
+                    "type": "boolean",
+                    "format": "bool",
+                    "example": false
+                },
+                "rating": {
                     "type": "integer",
-                    "example": 185
+                    "format": "int",
+                    "example": 5
                 },
-                "id": {
+                "reviewID": {
                     "type": "integer",
+                    "format": "int",
                     "example": 1
                 },
-                "name": {
-                    "type": "string",
-                    "example": "Киану Ривз"
-                },
-                "photoURL": {
+                "text": {
                     "type": "string",
-                    "example": "/static/photo.jpg"
-                },
-                "roles": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "array",
-                        "items": {
-                            "$ref": "#/definitions/dto.PreviewContentCardVertical"
-                        }
-                    }
+                    "format": "string",
+                    "example": "i like it"
                 },
-                "sex": {
+                "title": {
                     "type": "string",
-                    "example": "M"
+                    "format": "string",
+                    "example": "Title"
                 }
             }
         },
-        "dto.PersonPreview": {
+        "dto.SearchResult": {
             "type": "object",
             "properties": {
-                "enName": {
-                    "type": "string",
-                    "example": "Keanu Reeves"
-                },
-                "id": {
-                    "type": "integer",
-                    "example": 1
+                "content": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PreviewContent"
+                    }
                 },
-                "name": {
-                    "type": "string",
-                    "example": "Киану Ривз"
+                "persons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PersonPreviewWithPhoto"
+                    }
                 }
             }
         },
-        "dto.PersonPreviewWithPhoto": {
+        "dto.Season": {
             "type": "object",
             "properties": {
-                "enName": {
-                    "type": "string",
-                    "example": "Keanu Reeves"
+                "episodes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.Episode"
+                    }
                 },
                 "id": {
                     "type": "integer",
                     "example": 1
                 },
-                "name": {
-                    "type": "string",
-                    "example": "Киану Ривз"
+                "rating": {
+                    "type": "number",
+                    "example": 8.4
                 },
-                "photoURL": {
-                    "type": "string",
-                    "example": "/static/photo.jpg"
+                "ratingCount": {
+                    "type": "integer",
+                    "example": 12
                 }
             }
         },
-        "dto.PreviewContent": {
+        "dto.SeasonEpisode": {
             "type": "object",
             "properties": {
-                "actors": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "Том Хэнкс",
-                        "Сергей Бодров"
-                    ]
-                },
-                "country": {
-                    "type": "string",
-                    "example": "Россия"
-                },
-                "director": {
-                    "type": "string",
-                    "example": "Тарантино"
-                },
                 "duration": {
-                    "description": "Поля, которые есть только у фильмов",
                     "type": "integer",
-                    "example": 134
+                    "example": 45
                 },
-                "genre": {
-                    "type": "string",
-                    "example": "Боевик"
+                "episodeNumber": {
+                    "type": "integer",
+                    "example": 1
                 },
                 "id": {
                     "type": "integer",
                     "example": 1
                 },
-                "ongoing": {
-                    "type": "boolean",
-                    "example": true
-                },
-                "ongoingDate": {
-                    "description": "Поля, которые есть только у онгоингов",
-                    "type": "string",
-                    "example": "2022-01-02T15:04:05Z"
-                },
-                "originalTitle": {
-                    "type": "string",
-                    "example": "Batman"
-                },
-                "poster": {
-                    "type": "string",
-                    "example": "/static/poster.jpg"
-                },
                 "rating": {
                     "type": "number",
-                    "example": 9.1
+                    "example": 8.9
                 },
-                "release": {
+                "ratingCount": {
                     "type": "integer",
-                    "example": 2020
+                    "example": 30
                 },
-                "seasonsNumber": {
-                    "description": "Поля, которые есть только у сериалов",
+                "seasonID": {
                     "type": "integer",
                     "example": 1
                 },
+                "seasonTitle": {
+                    "type": "string",
+                    "example": "Сезон 1"
+                },
                 "title": {
                     "type": "string",
-                    "example": "Бэтмен"
+                    "example": "Название серии"
+                }
+            }
+        },
+        "dto.SeasonProgress": {
+            "type": "object",
+            "properties": {
+                "episodes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.EpisodeProgress"
+                    }
                 },
-                "type": {
+                "id": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "title": {
                     "type": "string",
-                    "example": "movie"
+                    "format": "string",
+                    "example": "Сезон 1"
+                }
+            }
+        },
+        "dto.SeriesContent": {
+            "type": "object",
+            "properties": {
+                "seasons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.Season"
+                    }
                 },
                 "yearEnd": {
                     "type": "integer",
-                    "example": 2021
+                    "example": 2020
                 },
                 "yearStart": {
                     "type": "integer",
@@ -2513,70 +7535,115 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.PreviewContentCardVertical": {
+        "dto.SpoilerRange": {
             "type": "object",
             "properties": {
-                "genre": {
+                "end": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 22
+                },
+                "start": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 8
+                }
+            }
+        },
+        "dto.SubscriptionsResponse": {
+            "type": "object",
+            "properties": {
+                "subscriptions": {
                     "type": "array",
                     "items": {
-                        "type": "string"
-                    },
-                    "example": [
-                        "Боевик"
-                    ]
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "dto.UpdatePassword": {
+            "type": "object",
+            "properties": {
+                "newPassword": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "NewPassword1!"
+                },
+                "oldPassword": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "OldPassword1!"
+                }
+            }
+        },
+        "dto.UserList": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "2022-01-02T15:04:05Z"
+                },
+                "description": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "То, что стоит пересмотреть"
                 },
                 "id": {
                     "type": "integer",
+                    "format": "int",
                     "example": 1
                 },
-                "poster": {
-                    "type": "string",
-                    "example": "/static/poster.jpg"
-                },
-                "rating": {
-                    "type": "number",
-                    "example": 9.1
+                "isPublic": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "releaseYear": {
-                    "description": "Поля, которые есть только у фильмов",
+                "itemsCount": {
                     "type": "integer",
-                    "example": 2020
+                    "format": "int",
+                    "example": 10
                 },
                 "title": {
                     "type": "string",
-                    "example": "Бэтмен"
+                    "format": "string",
+                    "example": "Лучшие фильмы 2023"
                 },
-                "type": {
+                "updatedAt": {
                     "type": "string",
-                    "example": "movie"
-                },
-                "yearEnd": {
-                    "type": "integer",
-                    "example": 2021
+                    "format": "string",
+                    "example": "2022-01-02T15:04:05Z"
                 },
-                "yearStart": {
-                    "description": "Поля, которые есть только у сериалов",
+                "userID": {
                     "type": "integer",
-                    "example": 2020
+                    "format": "int",
+                    "example": 1
                 }
             }
         },
-        "dto.Register": {
+        "dto.UserListItem": {
             "type": "object",
             "properties": {
-                "email": {
+                "addedAt": {
                     "type": "string",
                     "format": "string",
-                    "example": "email@email.com"
+                    "example": "2022-01-02T15:04:05Z"
                 },
-                "password": {
+                "content": {
+                    "$ref": "#/definitions/dto.PreviewContent"
+                },
+                "note": {
                     "type": "string",
                     "format": "string",
-                    "example": "SecretPassword1!"
+                    "example": "Смотреть с друзьями"
+                },
+                "position": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
                 }
             }
         },
-        "dto.ReviewCreateRequest": {
+        "dto.UserListItemRequest": {
             "type": "object",
             "properties": {
                 "contentID": {
@@ -2584,89 +7651,155 @@ const docTemplate = `{
                     "format": "int",
                     "example": 1
                 },
-                "rating": {
-                    "type": "integer",
-                    "format": "int",
-                    "example": 5
-                },
-                "text": {
+                "note": {
                     "type": "string",
                     "format": "string",
-                    "example": "i like it"
+                    "example": "Смотреть с друзьями"
+                }
+            }
+        },
+        "dto.UserListOrderRequest": {
+            "type": "object",
+            "properties": {
+                "contentIDs": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        3,
+                        1,
+                        2
+                    ]
+                }
+            }
+        },
+        "dto.UserListRequest": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "То, что стоит пересмотреть"
+                },
+                "isPublic": {
+                    "type": "boolean",
+                    "example": true
                 },
                 "title": {
                     "type": "string",
                     "format": "string",
-                    "example": "Title"
+                    "example": "Лучшие фильмы 2023"
                 }
             }
         },
-        "dto.ReviewResponse": {
+        "dto.UserListWithItems": {
             "type": "object",
             "properties": {
-                "authorAvatar": {
+                "createdAt": {
+                    "type": "string",
+                    "format": "string",
+                    "example": "2022-01-02T15:04:05Z"
+                },
+                "description": {
                     "type": "string",
                     "format": "string",
-                    "example": "avatars/avatar.jpg"
+                    "example": "То, что стоит пересмотреть"
                 },
-                "authorID": {
+                "id": {
                     "type": "integer",
                     "format": "int",
                     "example": 1
                 },
-                "authorName": {
-                    "type": "string",
-                    "format": "string",
-                    "example": "Author"
+                "isPublic": {
+                    "type": "boolean",
+                    "example": true
                 },
-                "contentID": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.UserListItem"
+                    }
+                },
+                "itemsCount": {
                     "type": "integer",
                     "format": "int",
-                    "example": 1
+                    "example": 10
                 },
-                "contentName": {
+                "title": {
                     "type": "string",
                     "format": "string",
-                    "example": "Content"
+                    "example": "Лучшие фильмы 2023"
                 },
-                "createdAt": {
+                "updatedAt": {
                     "type": "string",
-                    "format": "int",
+                    "format": "string",
                     "example": "2022-01-02T15:04:05Z"
                 },
-                "dislikes": {
+                "userID": {
                     "type": "integer",
                     "format": "int",
-                    "example": 5
+                    "example": 1
+                }
+            }
+        },
+        "dto.UserLists": {
+            "type": "object",
+            "properties": {
+                "lists": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.UserList"
+                    }
+                }
+            }
+        },
+        "dto.UserProfile": {
+            "type": "object",
+            "properties": {
+                "avatar": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
                 },
                 "id": {
-                    "type": "integer",
-                    "format": "int",
-                    "example": 1
+                    "type": "integer"
                 },
-                "likes": {
-                    "type": "integer",
-                    "format": "int",
-                    "example": 5
+                "name": {
+                    "type": "string"
                 },
                 "rating": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.UserRating": {
+            "type": "object",
+            "properties": {
+                "contentID": {
                     "type": "integer",
                     "format": "int",
-                    "example": 5
+                    "example": 1
                 },
-                "text": {
+                "createdAt": {
                     "type": "string",
                     "format": "string",
-                    "example": "i like it"
+                    "example": "2022-01-02T15:04:05Z"
                 },
-                "title": {
+                "rating": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 8
+                },
+                "updatedAt": {
                     "type": "string",
                     "format": "string",
-                    "example": "Title"
+                    "example": "2022-01-02T15:04:05Z"
                 }
             }
         },
-        "dto.ReviewResponseList": {
+        "dto.UserRatingList": {
             "type": "object",
             "properties": {
                 "count": {
@@ -2684,10 +7817,10 @@ const docTemplate = `{
                     "format": "int",
                     "example": 1
                 },
-                "reviews": {
+                "ratings": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.ReviewResponse"
+                        "$ref": "#/definitions/dto.RatedContent"
                     }
                 },
                 "total": {
@@ -2697,172 +7830,171 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.ReviewUpdateRequest": {
+        "dto.UserRatingRequest": {
             "type": "object",
             "properties": {
                 "rating": {
                     "type": "integer",
                     "format": "int",
-                    "example": 5
-                },
-                "reviewID": {
-                    "type": "integer",
-                    "format": "int",
-                    "example": 1
-                },
-                "text": {
-                    "type": "string",
-                    "format": "string",
-                    "example": "i like it"
-                },
-                "title": {
-                    "type": "string",
-                    "format": "string",
-                    "example": "Title"
+                    "example": 8
                 }
             }
         },
-        "dto.SearchResult": {
+        "dto.UserReviewResponseList": {
             "type": "object",
             "properties": {
-                "content": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.PreviewContent"
-                    }
+                "count": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 10
                 },
-                "persons": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.PersonPreviewWithPhoto"
-                    }
-                }
-            }
-        },
-        "dto.Season": {
-            "type": "object",
-            "properties": {
-                "episodes": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.Episode"
-                    }
+                "me": {
+                    "type": "boolean",
+                    "format": "bool",
+                    "example": true
                 },
-                "id": {
+                "page": {
                     "type": "integer",
+                    "format": "int",
                     "example": 1
-                }
-            }
-        },
-        "dto.SeriesContent": {
-            "type": "object",
-            "properties": {
-                "seasons": {
+                },
+                "pages": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
+                },
+                "reviews": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/dto.Season"
+                        "$ref": "#/definitions/dto.ReviewResponse"
                     }
                 },
-                "yearEnd": {
-                    "type": "integer",
-                    "example": 2020
-                },
-                "yearStart": {
+                "total": {
                     "type": "integer",
-                    "example": 2020
+                    "format": "int",
+                    "example": 1
                 }
             }
         },
-        "dto.SubscriptionsResponse": {
+        "dto.UserStats": {
             "type": "object",
             "properties": {
-                "subscriptions": {
+                "activity": {
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "$ref": "#/definitions/dto.MonthActivity"
                     }
-                }
-            }
-        },
-        "dto.UpdatePassword": {
-            "type": "object",
-            "properties": {
-                "newPassword": {
-                    "type": "string",
-                    "format": "string",
-                    "example": "NewPassword1!"
                 },
-                "oldPassword": {
-                    "type": "string",
-                    "format": "string",
-                    "example": "OldPassword1!"
+                "ratings": {
+                    "$ref": "#/definitions/dto.RatingStats"
+                },
+                "watched": {
+                    "$ref": "#/definitions/dto.WatchedStats"
                 }
             }
         },
-        "dto.UserProfile": {
+        "dto.UserUpdate": {
             "type": "object",
             "properties": {
-                "avatar": {
-                    "type": "string"
-                },
                 "email": {
                     "type": "string"
                 },
-                "id": {
-                    "type": "integer"
-                },
                 "name": {
                     "type": "string"
-                },
-                "rating": {
-                    "type": "integer"
                 }
             }
         },
-        "dto.UserReviewResponseList": {
+        "dto.WatchProgress": {
             "type": "object",
             "properties": {
-                "count": {
+                "completed": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "contentID": {
                     "type": "integer",
                     "format": "int",
-                    "example": 10
+                    "example": 1
                 },
-                "me": {
-                    "type": "boolean",
-                    "format": "bool",
-                    "example": true
+                "seasons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.SeasonProgress"
+                    }
                 },
-                "page": {
+                "total": {
                     "type": "integer",
                     "format": "int",
-                    "example": 1
+                    "example": 10
                 },
-                "pages": {
+                "watched": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 5
+                }
+            }
+        },
+        "dto.WatchProgressRequest": {
+            "type": "object",
+            "properties": {
+                "episodeID": {
                     "type": "integer",
                     "format": "int",
                     "example": 1
                 },
-                "reviews": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/dto.ReviewResponse"
-                    }
+                "seasonID": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 1
                 },
-                "total": {
+                "upToEpisodeID": {
                     "type": "integer",
                     "format": "int",
                     "example": 1
+                },
+                "watched": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "dto.UserUpdate": {
+        "dto.WatchedStats": {
             "type": "object",
             "properties": {
-                "email": {
-                    "type": "string"
+                "actors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PersonCount"
+                    }
                 },
-                "name": {
-                    "type": "string"
+                "categories": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "countries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.CountryCount"
+                    }
+                },
+                "directors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.PersonCount"
+                    }
+                },
+                "genres": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.GenreCount"
+                    }
+                },
+                "runtime": {
+                    "type": "integer",
+                    "format": "int",
+                    "example": 5400
                 }
             }
         },
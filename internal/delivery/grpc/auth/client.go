@@ -3,18 +3,22 @@ package auth
 import (
 	"context"
 	auth "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/grpc/auth/proto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
 type Gateway struct {
+	conn        *grpc.ClientConn
 	authManager auth.AuthServiceClient
 }
 
 func NewGateway(connectAddr string) (*Gateway, error) {
+	opts := append(metrics.DialOptions(), tracing.DialOptions()...)
 	grpcConn, err := grpc.Dial(
 		connectAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))...,
 	)
 	if err != nil {
 		return nil, err
@@ -27,35 +31,40 @@ func NewGateway(connectAddr string) (*Gateway, error) {
 		return nil, err
 	}
 
-	return &Gateway{authManager: authManager}, nil
+	return &Gateway{conn: grpcConn, authManager: authManager}, nil
 }
 
-func (gate *Gateway) Logout(session string) error {
-	_, err := gate.authManager.Logout(context.Background(), &auth.Session{Token: session})
+// Conn возвращает соединение шлюза, например для проверки готовности сервиса
+func (gate *Gateway) Conn() *grpc.ClientConn {
+	return gate.conn
+}
+
+func (gate *Gateway) Logout(ctx context.Context, session string) error {
+	_, err := gate.authManager.Logout(ctx, &auth.Session{Token: session})
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (gate *Gateway) LogoutAll(userID int) error {
-	_, err := gate.authManager.LogoutAll(context.Background(), &auth.User{Id: uint64(userID)})
+func (gate *Gateway) LogoutAll(ctx context.Context, userID int) error {
+	_, err := gate.authManager.LogoutAll(ctx, &auth.User{Id: uint64(userID)})
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
-func (gate *Gateway) GetUserIDBySession(session string) (int, error) {
-	user, err := gate.authManager.GetUserIDBySession(context.Background(), &auth.Session{Token: session})
+func (gate *Gateway) GetUserIDBySession(ctx context.Context, session string) (int, error) {
+	user, err := gate.authManager.GetUserIDBySession(ctx, &auth.Session{Token: session})
 	if err != nil {
 		return 0, err
 	}
 	return int(user.Id), nil
 }
 
-func (gate *Gateway) CreateSession(userID int) (string, error) {
-	session, err := gate.authManager.CreateSession(context.Background(), &auth.User{Id: uint64(userID)})
+func (gate *Gateway) CreateSession(ctx context.Context, userID int) (string, error) {
+	session, err := gate.authManager.CreateSession(ctx, &auth.User{Id: uint64(userID)})
 	if err != nil {
 		return "", err
 	}
@@ -15,32 +15,32 @@ func NewGrpc(authUC usecase.Auth) *Grpc {
 	return &Grpc{authUC: authUC}
 }
 
-func (service *Grpc) Logout(_ context.Context, session *authProto.Session) (*authProto.Nothing, error) {
-	err := service.authUC.Logout(session.GetToken())
+func (service *Grpc) Logout(ctx context.Context, session *authProto.Session) (*authProto.Nothing, error) {
+	err := service.authUC.Logout(ctx, session.GetToken())
 	if err != nil {
 		return nil, err
 	}
 	return &authProto.Nothing{}, nil
 }
 
-func (service *Grpc) LogoutAll(_ context.Context, userID *authProto.User) (*authProto.Nothing, error) {
-	err := service.authUC.LogoutAll(int(userID.GetId()))
+func (service *Grpc) LogoutAll(ctx context.Context, userID *authProto.User) (*authProto.Nothing, error) {
+	err := service.authUC.LogoutAll(ctx, int(userID.GetId()))
 	if err != nil {
 		return nil, err
 	}
 	return &authProto.Nothing{}, nil
 }
 
-func (service *Grpc) GetUserIDBySession(_ context.Context, session *authProto.Session) (*authProto.User, error) {
-	userID, err := service.authUC.GetUserIDBySession(session.GetToken())
+func (service *Grpc) GetUserIDBySession(ctx context.Context, session *authProto.Session) (*authProto.User, error) {
+	userID, err := service.authUC.GetUserIDBySession(ctx, session.GetToken())
 	if err != nil {
 		return nil, err
 	}
 	return &authProto.User{Id: uint64(userID)}, nil
 }
 
-func (service *Grpc) CreateSession(_ context.Context, userID *authProto.User) (*authProto.Session, error) {
-	session, err := service.authUC.CreateSession(int(userID.GetId()))
+func (service *Grpc) CreateSession(ctx context.Context, userID *authProto.User) (*authProto.Session, error) {
+	session, err := service.authUC.CreateSession(ctx, int(userID.GetId()))
 	if err != nil {
 		return nil, err
 	}
@@ -5,8 +5,11 @@ import (
 	"errors"
 	"fmt"
 	profanity "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/grpc/profanity/proto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"time"
 )
 
 type Gateway struct {
@@ -14,9 +17,10 @@ type Gateway struct {
 }
 
 func NewGateway(connectAddr string) (*Gateway, error) {
+	opts := append(metrics.DialOptions(), tracing.DialOptions()...)
 	grpcConn, err := grpc.Dial(
 		connectAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))...,
 	)
 	if err != nil {
 		return nil, err
@@ -32,10 +36,17 @@ func NewGateway(connectAddr string) (*Gateway, error) {
 	return &Gateway{profanityManager: profanityManager}, nil
 }
 
-func (gate *Gateway) FilterMessage(text string) (string, error) {
-	filteredMessage, err := gate.profanityManager.FilterMessage(context.Background(), &profanity.Text{Text: text})
+func (gate *Gateway) FilterMessage(ctx context.Context, text string) (string, error) {
+	defer metrics.ObserveSince(metrics.ProfanityFilterDuration, time.Now())
+	filteredMessage, err := gate.profanityManager.FilterMessage(ctx, &profanity.Text{Text: text})
 	if err != nil {
 		return "", errors.Join(fmt.Errorf("ошибка при фильтрации сообщения"), err)
 	}
 	return filteredMessage.GetText(), nil
 }
+
+// Ping проверяет доступность сервиса фильтрации сообщений
+func (gate *Gateway) Ping(ctx context.Context) error {
+	_, err := gate.profanityManager.Ping(ctx, &profanity.Nothing{})
+	return err
+}
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"context"
 	static "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/grpc/static/proto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
@@ -17,13 +19,15 @@ const (
 )
 
 type Gateway struct {
+	conn          *grpc.ClientConn
 	staticManager static.StaticServiceClient
 }
 
 func NewGateway(connectAddr string) (*Gateway, error) {
+	opts := append(metrics.DialOptions(), tracing.DialOptions()...)
 	grpcConn, err := grpc.Dial(
 		connectAddr,
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))...,
 	)
 	if err != nil {
 		return nil, err
@@ -36,11 +40,16 @@ func NewGateway(connectAddr string) (*Gateway, error) {
 		return nil, err
 	}
 
-	return &Gateway{staticManager: staticManager}, nil
+	return &Gateway{conn: grpcConn, staticManager: staticManager}, nil
 }
 
-func (gate *Gateway) GetStatic(staticID int) (string, error) {
-	staticFile, err := gate.staticManager.GetStatic(context.Background(), &static.Static{Id: uint64(staticID)})
+// Conn возвращает соединение шлюза, например для проверки готовности сервиса
+func (gate *Gateway) Conn() *grpc.ClientConn {
+	return gate.conn
+}
+
+func (gate *Gateway) GetStatic(ctx context.Context, staticID int) (string, error) {
+	staticFile, err := gate.staticManager.GetStatic(ctx, &static.Static{Id: uint64(staticID)})
 	if err != nil {
 		if strings.Contains(err.Error(), repository.ErrStaticNotFound.Error()) {
 			return "", usecase.ErrStaticNotFound
@@ -50,8 +59,8 @@ func (gate *Gateway) GetStatic(staticID int) (string, error) {
 	return staticFile.Uri, nil
 }
 
-func (gate *Gateway) GetStaticFile(staticURI string) (io.ReadSeeker, error) {
-	stream, err := gate.staticManager.GetStaticFile(context.Background(), &static.Static{Uri: staticURI})
+func (gate *Gateway) GetStaticFile(ctx context.Context, staticURI string) (io.ReadSeeker, error) {
+	stream, err := gate.staticManager.GetStaticFile(ctx, &static.Static{Uri: staticURI})
 	if err != nil {
 		if strings.Contains(err.Error(), repository.ErrStaticNotFound.Error()) {
 			return nil, usecase.ErrStaticNotFound
@@ -84,8 +93,8 @@ func (gate *Gateway) GetStaticFile(staticURI string) (io.ReadSeeker, error) {
 	return io.ReadSeeker(bytes.NewReader(buffer)), nil
 }
 
-func (gate *Gateway) UploadAvatar(reader io.ReadSeeker) (int, error) {
-	stream, err := gate.staticManager.UploadAvatar(context.Background())
+func (gate *Gateway) UploadAvatar(ctx context.Context, reader io.ReadSeeker) (int, error) {
+	stream, err := gate.staticManager.UploadAvatar(ctx)
 	if err != nil {
 		return -1, err
 	}
@@ -19,8 +19,8 @@ func NewGrpc(staticUC usecase.Static) *Grpc {
 	return &Grpc{staticUC: staticUC}
 }
 
-func (service *Grpc) GetStatic(_ context.Context, static *staticProto.Static) (*staticProto.Static, error) {
-	uri, err := service.staticUC.GetStatic(int(static.GetId()))
+func (service *Grpc) GetStatic(ctx context.Context, static *staticProto.Static) (*staticProto.Static, error) {
+	uri, err := service.staticUC.GetStatic(ctx, int(static.GetId()))
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +42,7 @@ func (service *Grpc) UploadAvatar(stream staticProto.StaticService_UploadAvatarS
 	}
 
 	reader := bytes.NewReader(bytesAvatar)
-	staticID, err := service.staticUC.UploadAvatar(reader)
+	staticID, err := service.staticUC.UploadAvatar(stream.Context(), reader)
 	switch {
 	case errors.Is(err, usecase.ErrStaticTooBigFile):
 		return stream.SendAndClose(&staticProto.Static{Error: "ErrStaticTooBigFile"})
@@ -65,7 +65,7 @@ func (service *Grpc) GetStaticFile(
 	if err != nil {
 		return err
 	}
-	file, err := service.staticUC.GetStaticFile(uri)
+	file, err := service.staticUC.GetStaticFile(stream.Context(), uri)
 	if err != nil {
 		return err
 	}
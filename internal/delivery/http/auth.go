@@ -56,7 +56,7 @@ func (h *AuthEndpoints) Logout(ctx echo.Context) error {
 	// если сессии не было в базе сессий, то это не имеет значения - пользователь в любом случае вышел, поэтому
 	// ошибку игнорируем
 	// no-lint
-	_ = h.authUC.Logout(cookie.Value)
+	_ = h.authUC.Logout(ctx.Request().Context(), cookie.Value)
 	h.sessionManager.SessionSet(ctx, "session", time.Unix(0, 0))
 	return ctx.NoContent(http.StatusOK)
 }
@@ -75,7 +75,7 @@ func (h *AuthEndpoints) LogoutAll(ctx echo.Context) error {
 		// сессия в куках не найдена, значит считаем, что пользователь уже вышел
 		return ctx.NoContent(http.StatusOK)
 	}
-	userID, err := h.authUC.GetUserIDBySession(cookie.Value)
+	userID, err := h.authUC.GetUserIDBySession(ctx.Request().Context(), cookie.Value)
 	if errors.Is(err, usecase.ErrSessionNotFound) {
 		// сессия в базе не найдена, значит пользователь уже вышел
 		return ctx.NoContent(http.StatusOK)
@@ -83,7 +83,7 @@ func (h *AuthEndpoints) LogoutAll(ctx echo.Context) error {
 	if err != nil {
 		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
 	}
-	if err = h.authUC.LogoutAll(userID); err != nil {
+	if err = h.authUC.LogoutAll(ctx.Request().Context(), userID); err != nil {
 		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
 	}
 	h.sessionManager.SessionSet(ctx, "session", time.Unix(0, 0))
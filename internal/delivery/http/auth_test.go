@@ -27,7 +27,7 @@ func TestAuthEndpoints_IsAuth(t *testing.T) {
 			ExpectedErr: nil,
 			Cookies:     &http.Cookie{Name: "session", Value: "xxx"},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession(gomock.Any()).Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), gomock.Any()).Return(1, nil)
 			},
 		},
 		{
@@ -81,7 +81,7 @@ func TestAuthEndpoints_Logout(t *testing.T) {
 			ExpectedErr: nil,
 			Cookies:     &http.Cookie{Name: "session", Value: "xxx"},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().Logout("xxx").Return(nil)
+				usecase.EXPECT().Logout(gomock.Any(), "xxx").Return(nil)
 			},
 		},
 	}
@@ -129,7 +129,7 @@ func TestAuthEndpoints_LogoutAll(t *testing.T) {
 			ExpectedErr: nil,
 			Cookies:     &http.Cookie{Name: "session", Value: "xxx"},
 			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
-				uc.EXPECT().GetUserIDBySession("xxx").Return(1, usecase.ErrSessionNotFound)
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, usecase.ErrSessionNotFound)
 			},
 		},
 		{
@@ -137,7 +137,7 @@ func TestAuthEndpoints_LogoutAll(t *testing.T) {
 			ExpectedErr: &echo.HTTPError{Code: 500, Message: "Внутренняя ошибка сервера", Internal: errors.New("error")},
 			Cookies:     &http.Cookie{Name: "session", Value: "xxx"},
 			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
-				uc.EXPECT().GetUserIDBySession("xxx").Return(1, errors.New("error"))
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, errors.New("error"))
 			},
 		},
 		{
@@ -145,8 +145,8 @@ func TestAuthEndpoints_LogoutAll(t *testing.T) {
 			ExpectedErr: &echo.HTTPError{Code: 500, Message: "Внутренняя ошибка сервера", Internal: errors.New("error")},
 			Cookies:     &http.Cookie{Name: "session", Value: "xxx"},
 			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
-				uc.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
-				uc.EXPECT().LogoutAll(1).Return(errors.New("error"))
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+				uc.EXPECT().LogoutAll(gomock.Any(), 1).Return(errors.New("error"))
 			},
 		},
 		{
@@ -154,8 +154,8 @@ func TestAuthEndpoints_LogoutAll(t *testing.T) {
 			ExpectedErr: nil,
 			Cookies:     &http.Cookie{Name: "session", Value: "xxx"},
 			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
-				uc.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
-				uc.EXPECT().LogoutAll(1).Return(nil)
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+				uc.EXPECT().LogoutAll(gomock.Any(), 1).Return(nil)
 			},
 		},
 	}
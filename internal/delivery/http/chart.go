@@ -0,0 +1,130 @@
+package http
+
+import (
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/http/utils"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"strconv"
+)
+
+type ChartEndpoints struct {
+	chartUC usecase.Chart
+}
+
+func NewChartEndpoints(chartUC usecase.Chart) ChartEndpoints {
+	return ChartEndpoints{chartUC: chartUC}
+}
+
+func (h *ChartEndpoints) Configure(server *echo.Group) {
+	server.GET("/trending/:page", h.GetTrending)
+	server.GET("/top/movies/:page", h.GetTopMovies)
+	server.GET("/top/series/:page", h.GetTopSeries)
+	server.GET("/genre/:id/:page", h.GetGenreChart)
+	server.GET("/decade/:decade/:page", h.GetDecadeChart)
+}
+
+// getChart отдает страницу подборки с ключом key. Как и у обычных подборок, невалидная страница считается первой
+func (h *ChartEndpoints) getChart(ctx echo.Context, key string) error {
+	page, err := strconv.ParseInt(ctx.Param("page"), 10, 64)
+	if err != nil || page < 1 {
+		page = 1
+	}
+	chart, err := h.chartUC.GetChart(ctx.Request().Context(), key, int(page))
+	switch {
+	case errors.Is(err, usecase.ErrChartNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Подборка не найдена", nil)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	}
+	return utils.WriteJSON(ctx, chart)
+}
+
+// GetTrending
+// @Summary Контент в тренде
+// @Tags chart
+// @Description Контент, который чаще всего оценивали, обсуждали и добавляли в избранное за последнюю неделю
+// @Accept json
+// @Produce json
+// @Param page path int true "номер страницы"
+// @Success 200 {object} dto.CompilationResponse
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/chart/trending/{page} [get]
+func (h *ChartEndpoints) GetTrending(ctx echo.Context) error {
+	return h.getChart(ctx, entity.ChartTrending)
+}
+
+// GetTopMovies
+// @Summary 250 лучших фильмов
+// @Tags chart
+// @Description Фильмы с наибольшим взвешенным рейтингом
+// @Accept json
+// @Produce json
+// @Param page path int true "номер страницы"
+// @Success 200 {object} dto.CompilationResponse
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/chart/top/movies/{page} [get]
+func (h *ChartEndpoints) GetTopMovies(ctx echo.Context) error {
+	return h.getChart(ctx, entity.ChartTopMovies)
+}
+
+// GetTopSeries
+// @Summary 250 лучших сериалов
+// @Tags chart
+// @Description Сериалы с наибольшим взвешенным рейтингом
+// @Accept json
+// @Produce json
+// @Param page path int true "номер страницы"
+// @Success 200 {object} dto.CompilationResponse
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/chart/top/series/{page} [get]
+func (h *ChartEndpoints) GetTopSeries(ctx echo.Context) error {
+	return h.getChart(ctx, entity.ChartTopSeries)
+}
+
+// GetGenreChart
+// @Summary Лучшее в жанре
+// @Tags chart
+// @Description Контент жанра с наибольшим взвешенным рейтингом
+// @Accept json
+// @Produce json
+// @Param id path int true "id жанра"
+// @Param page path int true "номер страницы"
+// @Success 200 {object} dto.CompilationResponse
+// @Failure 400 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/chart/genre/{id}/{page} [get]
+func (h *ChartEndpoints) GetGenreChart(ctx echo.Context) error {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id жанра", nil)
+	}
+	return h.getChart(ctx, entity.GenreChartKey(int(id)))
+}
+
+// GetDecadeChart
+// @Summary Лучшее десятилетия
+// @Tags chart
+// @Description Контент, вышедший в десятилетии, с наибольшим взвешенным рейтингом
+// @Accept json
+// @Produce json
+// @Param decade path int true "первый год десятилетия, например 1990"
+// @Param page path int true "номер страницы"
+// @Success 200 {object} dto.CompilationResponse
+// @Failure 400 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/chart/decade/{decade}/{page} [get]
+func (h *ChartEndpoints) GetDecadeChart(ctx echo.Context) error {
+	decade, err := strconv.ParseInt(ctx.Param("decade"), 10, 64)
+	if err != nil || decade%10 != 0 {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидное десятилетие", nil)
+	}
+	return h.getChart(ctx, entity.DecadeChartKey(int(decade)))
+}
@@ -0,0 +1,179 @@
+package http
+
+import (
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	mockusecase "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestChartEndpoints_GetTopMovies(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                  string
+		Page                  string
+		ExpectedErr           error
+		SetupChartUsecaseMock func(usecase *mockusecase.MockChart)
+	}{
+		{
+			Name:        "Успешно",
+			Page:        "2",
+			ExpectedErr: nil,
+			SetupChartUsecaseMock: func(usecase *mockusecase.MockChart) {
+				usecase.EXPECT().GetChart(gomock.Any(), entity.ChartTopMovies, 2).Return(nil, nil)
+			},
+		},
+		{
+			Name:        "Не найдено",
+			Page:        "1",
+			ExpectedErr: &echo.HTTPError{Code: 404, Message: "Подборка не найдена"},
+			SetupChartUsecaseMock: func(uc *mockusecase.MockChart) {
+				uc.EXPECT().GetChart(gomock.Any(), entity.ChartTopMovies, 1).Return(nil, usecase.ErrChartNotFound)
+			},
+		},
+		{
+			Name:        "Внутренняя ошибка сервера",
+			Page:        "1",
+			ExpectedErr: &echo.HTTPError{Code: 500, Message: "Внутренняя ошибка сервера", Internal: errors.New("123")},
+			SetupChartUsecaseMock: func(usecase *mockusecase.MockChart) {
+				usecase.EXPECT().GetChart(gomock.Any(), entity.ChartTopMovies, 1).Return(nil, errors.New("123"))
+			},
+		},
+		{
+			Name:        "Невалидная страница",
+			Page:        "-3",
+			ExpectedErr: nil,
+			SetupChartUsecaseMock: func(usecase *mockusecase.MockChart) {
+				usecase.EXPECT().GetChart(gomock.Any(), entity.ChartTopMovies, 1).Return(nil, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockChartUsecase := mockusecase.NewMockChart(ctrl)
+			tc.SetupChartUsecaseMock(mockChartUsecase)
+			chartHandler := NewChartEndpoints(mockChartUsecase)
+			req := httptest.NewRequest(http.MethodGet, "/chart/top/movies/"+tc.Page, nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/chart/top/movies/:page")
+			c.SetParamNames("page")
+			c.SetParamValues(tc.Page)
+			err := chartHandler.GetTopMovies(c)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestChartEndpoints_GetGenreChart(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                  string
+		GenreID               string
+		ExpectedErr           error
+		SetupChartUsecaseMock func(usecase *mockusecase.MockChart)
+	}{
+		{
+			Name:        "Успешно",
+			GenreID:     "3",
+			ExpectedErr: nil,
+			SetupChartUsecaseMock: func(usecase *mockusecase.MockChart) {
+				usecase.EXPECT().GetChart(gomock.Any(), "genre:3", 1).Return(nil, nil)
+			},
+		},
+		{
+			Name:                  "Невалидный айди",
+			GenreID:               "три",
+			ExpectedErr:           &echo.HTTPError{Code: 400, Message: "Невалидный id жанра"},
+			SetupChartUsecaseMock: func(usecase *mockusecase.MockChart) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockChartUsecase := mockusecase.NewMockChart(ctrl)
+			tc.SetupChartUsecaseMock(mockChartUsecase)
+			chartHandler := NewChartEndpoints(mockChartUsecase)
+			req := httptest.NewRequest(http.MethodGet, "/chart/genre/"+tc.GenreID+"/1", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/chart/genre/:id/:page")
+			c.SetParamNames("id", "page")
+			c.SetParamValues(tc.GenreID, "1")
+			err := chartHandler.GetGenreChart(c)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestChartEndpoints_GetDecadeChart(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                  string
+		Decade                string
+		ExpectedErr           error
+		SetupChartUsecaseMock func(usecase *mockusecase.MockChart)
+	}{
+		{
+			Name:        "Успешно",
+			Decade:      "1990",
+			ExpectedErr: nil,
+			SetupChartUsecaseMock: func(usecase *mockusecase.MockChart) {
+				usecase.EXPECT().GetChart(gomock.Any(), "decade:1990", 1).Return(nil, nil)
+			},
+		},
+		{
+			Name:                  "Год не начинает десятилетие",
+			Decade:                "1994",
+			ExpectedErr:           &echo.HTTPError{Code: 400, Message: "Невалидное десятилетие"},
+			SetupChartUsecaseMock: func(usecase *mockusecase.MockChart) {},
+		},
+		{
+			Name:                  "Не число",
+			Decade:                "девяностые",
+			ExpectedErr:           &echo.HTTPError{Code: 400, Message: "Невалидное десятилетие"},
+			SetupChartUsecaseMock: func(usecase *mockusecase.MockChart) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockChartUsecase := mockusecase.NewMockChart(ctrl)
+			tc.SetupChartUsecaseMock(mockChartUsecase)
+			chartHandler := NewChartEndpoints(mockChartUsecase)
+			req := httptest.NewRequest(http.MethodGet, "/chart/decade/"+tc.Decade+"/1", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/chart/decade/:decade/:page")
+			c.SetParamNames("decade", "page")
+			c.SetParamValues(tc.Decade, "1")
+			err := chartHandler.GetDecadeChart(c)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
@@ -33,7 +33,7 @@ func (h *CompilationEndpoints) Configure(server *echo.Group) {
 // @Failure 500 {object} echo.HTTPError
 // @Router /api/compilation/types [get]
 func (h *CompilationEndpoints) GetCompilationTypes(ctx echo.Context) error {
-	compType, err := h.compilationUC.GetCompilationTypes()
+	compType, err := h.compilationUC.GetCompilationTypes(ctx.Request().Context())
 	if err != nil {
 		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
 	}
@@ -56,7 +56,7 @@ func (h *CompilationEndpoints) GetCompilationsByCompilationType(ctx echo.Context
 	if err != nil {
 		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id типа подборки", nil)
 	}
-	compilations, err := h.compilationUC.GetCompilationsByCompilationType(int(compilationType))
+	compilations, err := h.compilationUC.GetCompilationsByCompilationType(ctx.Request().Context(), int(compilationType))
 	if err != nil {
 		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
 	}
@@ -85,7 +85,7 @@ func (h *CompilationEndpoints) GetCompilationContent(ctx echo.Context) error {
 	if err != nil {
 		page = 1
 	}
-	compilation, err := h.compilationUC.GetCompilationContent(int(id), int(page))
+	compilation, err := h.compilationUC.GetCompilationContent(ctx.Request().Context(), int(id), int(page))
 	switch {
 	case errors.Is(err, usecase.ErrCompilationNotFound):
 		return utils.NewError(ctx, http.StatusNotFound, "Подборка не найдена", nil)
@@ -24,14 +24,14 @@ func TestCompilationEndpoints_GetCompilationTypes(t *testing.T) {
 			Name:        "Успех",
 			ExpectedErr: nil,
 			SetupCompilationUsecaseMock: func(usecase *mockusecase.MockCompilation) {
-				usecase.EXPECT().GetCompilationTypes().Return(nil, nil)
+				usecase.EXPECT().GetCompilationTypes(gomock.Any()).Return(nil, nil)
 			},
 		},
 		{
 			Name:        "Неизвестная ошибка",
 			ExpectedErr: &echo.HTTPError{Code: 500, Message: "Внутренняя ошибка сервера", Internal: errors.New("123")},
 			SetupCompilationUsecaseMock: func(usecase *mockusecase.MockCompilation) {
-				usecase.EXPECT().GetCompilationTypes().Return(nil, errors.New("123"))
+				usecase.EXPECT().GetCompilationTypes(gomock.Any()).Return(nil, errors.New("123"))
 			},
 		},
 	}
@@ -69,7 +69,7 @@ func TestCompilationEndpoints_GetCompilationsByCompilationType(t *testing.T) {
 			CompilationTypeID: "1",
 			ExpectedErr:       nil,
 			SetupCompilationUsecaseMock: func(usecase *mockusecase.MockCompilation) {
-				usecase.EXPECT().GetCompilationsByCompilationType(1).Return(nil, nil)
+				usecase.EXPECT().GetCompilationsByCompilationType(gomock.Any(), 1).Return(nil, nil)
 			},
 		},
 		{
@@ -83,7 +83,7 @@ func TestCompilationEndpoints_GetCompilationsByCompilationType(t *testing.T) {
 			CompilationTypeID: "3",
 			ExpectedErr:       &echo.HTTPError{Code: 500, Message: "Внутренняя ошибка сервера", Internal: errors.New("123")},
 			SetupCompilationUsecaseMock: func(usecase *mockusecase.MockCompilation) {
-				usecase.EXPECT().GetCompilationsByCompilationType(3).Return(nil, errors.New("123"))
+				usecase.EXPECT().GetCompilationsByCompilationType(gomock.Any(), 3).Return(nil, errors.New("123"))
 			},
 		},
 	}
@@ -126,7 +126,7 @@ func TestCompilationEndpoints_GetCompilationContent(t *testing.T) {
 			Page:          "1",
 			ExpectedErr:   nil,
 			SetupCompilationUsecaseMock: func(usecase *mockusecase.MockCompilation) {
-				usecase.EXPECT().GetCompilationContent(1, 1).Return(nil, nil)
+				usecase.EXPECT().GetCompilationContent(gomock.Any(), 1, 1).Return(nil, nil)
 			},
 		},
 		{
@@ -135,7 +135,7 @@ func TestCompilationEndpoints_GetCompilationContent(t *testing.T) {
 			Page:          "1",
 			ExpectedErr:   &echo.HTTPError{Code: 404, Message: "Подборка не найдена"},
 			SetupCompilationUsecaseMock: func(uc *mockusecase.MockCompilation) {
-				uc.EXPECT().GetCompilationContent(2, 1).Return(nil, usecase.ErrCompilationNotFound)
+				uc.EXPECT().GetCompilationContent(gomock.Any(), 2, 1).Return(nil, usecase.ErrCompilationNotFound)
 			},
 		},
 		{
@@ -144,7 +144,7 @@ func TestCompilationEndpoints_GetCompilationContent(t *testing.T) {
 			Page:          "1",
 			ExpectedErr:   &echo.HTTPError{Code: 500, Message: "Внутренняя ошибка сервера", Internal: errors.New("123")},
 			SetupCompilationUsecaseMock: func(usecase *mockusecase.MockCompilation) {
-				usecase.EXPECT().GetCompilationContent(3, 1).Return(nil, errors.New("123"))
+				usecase.EXPECT().GetCompilationContent(gomock.Any(), 3, 1).Return(nil, errors.New("123"))
 			},
 		},
 		{
@@ -160,7 +160,7 @@ func TestCompilationEndpoints_GetCompilationContent(t *testing.T) {
 			Page:          "два",
 			ExpectedErr:   nil,
 			SetupCompilationUsecaseMock: func(usecase *mockusecase.MockCompilation) {
-				usecase.EXPECT().GetCompilationContent(1, 1).Return(nil, nil)
+				usecase.EXPECT().GetCompilationContent(gomock.Any(), 1, 1).Return(nil, nil)
 			},
 		},
 	}
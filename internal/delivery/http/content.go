@@ -19,6 +19,7 @@ func NewContentEndpoints(useCase usecase.Content) ContentEndpoints {
 
 func (h *ContentEndpoints) Configure(server *echo.Group) {
 	server.GET("/:id", h.GetContent)
+	server.GET("/:id/episodes/best", h.GetBestEpisodes)
 	server.GET("/person/:id", h.GetPerson)
 }
 
@@ -38,7 +39,7 @@ func (h *ContentEndpoints) GetContent(ctx echo.Context) error {
 	if err != nil {
 		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id контента", nil)
 	}
-	content, err := h.useCase.GetContentByID(int(id))
+	content, err := h.useCase.GetContentByID(ctx.Request().Context(), int(id))
 	switch {
 	case errors.Is(err, usecase.ErrContentNotFound):
 		return utils.NewError(ctx, http.StatusNotFound, "Контент с таким id не найден", err)
@@ -49,6 +50,33 @@ func (h *ContentEndpoints) GetContent(ctx echo.Context) error {
 	}
 }
 
+// GetBestEpisodes
+// @Summary Лучшие эпизоды сериала
+// @Tags content
+// @Description До 10 эпизодов сериала с наибольшей взвешенной оценкой пользователей. Эпизоды без оценок не выводятся
+// @Produce json
+// @Param id path int true "ID контента"
+// @Success 200 {object} dto.BestEpisodes
+// @Failure 400 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/content/{id}/episodes/best [get]
+func (h *ContentEndpoints) GetBestEpisodes(ctx echo.Context) error {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id контента", nil)
+	}
+	episodes, err := h.useCase.GetBestEpisodes(ctx.Request().Context(), int(id))
+	switch {
+	case errors.Is(err, usecase.ErrContentNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Контент с таким id не найден", err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return utils.WriteJSON(ctx, episodes)
+	}
+}
+
 // GetPerson
 // @Summary Получение персоны по id
 // @Tags content
@@ -65,7 +93,7 @@ func (h *ContentEndpoints) GetPerson(ctx echo.Context) error {
 	if err != nil {
 		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id персоны", nil)
 	}
-	person, err := h.useCase.GetPersonByID(int(id))
+	person, err := h.useCase.GetPersonByID(ctx.Request().Context(), int(id))
 	switch {
 	case errors.Is(err, usecase.ErrPersonNotFound):
 		return utils.NewError(ctx, http.StatusNotFound, "Персона с таким id не найдена", err)
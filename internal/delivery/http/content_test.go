@@ -48,7 +48,7 @@ func TestContentEndpoints_GetContent(t *testing.T) {
 				Type:           "movie",
 			},
 			SetupContentUsecaseMock: func(mock *mockusecase.MockContent) {
-				mock.EXPECT().GetContentByID(1).Return(&dto.Content{
+				mock.EXPECT().GetContentByID(gomock.Any(), 1).Return(&dto.Content{
 					ID:             1,
 					Title:          "Бэтмен",
 					OriginalTitle:  "Batman",
@@ -82,7 +82,7 @@ func TestContentEndpoints_GetContent(t *testing.T) {
 			ExpectedErr:    &echo.HTTPError{Code: 404, Message: "Контент с таким id не найден"},
 			ExpectedOutput: nil,
 			SetupContentUsecaseMock: func(mock *mockusecase.MockContent) {
-				mock.EXPECT().GetContentByID(1).Return(nil, usecase.ErrContentNotFound)
+				mock.EXPECT().GetContentByID(gomock.Any(), 1).Return(nil, usecase.ErrContentNotFound)
 			},
 		},
 		{
@@ -91,7 +91,7 @@ func TestContentEndpoints_GetContent(t *testing.T) {
 			ExpectedErr:    &echo.HTTPError{Code: 500, Message: "Внутренняя ошибка сервера", Internal: errors.New("123")},
 			ExpectedOutput: nil,
 			SetupContentUsecaseMock: func(mock *mockusecase.MockContent) {
-				mock.EXPECT().GetContentByID(1).Return(nil, errors.New("123"))
+				mock.EXPECT().GetContentByID(gomock.Any(), 1).Return(nil, errors.New("123"))
 			},
 		},
 	}
@@ -142,7 +142,7 @@ func TestContentEndpoints_GetPerson(t *testing.T) {
 				Height:    185,
 			},
 			SetupContentUsecaseMock: func(mock *mockusecase.MockContent) {
-				mock.EXPECT().GetPersonByID(1).Return(&dto.Person{
+				mock.EXPECT().GetPersonByID(gomock.Any(), 1).Return(&dto.Person{
 					ID:        1,
 					Name:      "Киану Ривз",
 					EnName:    "Keanu Reeves",
@@ -170,7 +170,7 @@ func TestContentEndpoints_GetPerson(t *testing.T) {
 			ExpectedErr:    &echo.HTTPError{Code: 404, Message: "Персона с таким id не найдена"},
 			ExpectedOutput: nil,
 			SetupContentUsecaseMock: func(mock *mockusecase.MockContent) {
-				mock.EXPECT().GetPersonByID(1).Return(nil, usecase.ErrPersonNotFound)
+				mock.EXPECT().GetPersonByID(gomock.Any(), 1).Return(nil, usecase.ErrPersonNotFound)
 			},
 		},
 		{
@@ -179,7 +179,7 @@ func TestContentEndpoints_GetPerson(t *testing.T) {
 			ExpectedErr:    &echo.HTTPError{Code: 500, Message: "Внутренняя ошибка сервера", Internal: errors.New("123")},
 			ExpectedOutput: nil,
 			SetupContentUsecaseMock: func(mock *mockusecase.MockContent) {
-				mock.EXPECT().GetPersonByID(1).Return(nil, errors.New("123"))
+				mock.EXPECT().GetPersonByID(gomock.Any(), 1).Return(nil, errors.New("123"))
 			},
 		},
 	}
@@ -205,3 +205,58 @@ func TestContentEndpoints_GetPerson(t *testing.T) {
 	}
 
 }
+
+func TestContentEndpoints_GetBestEpisodes(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                    string
+		ContentID               string
+		ExpectedErr             error
+		SetupContentUsecaseMock func(mock *mockusecase.MockContent)
+	}{
+		{
+			Name:        "Успешное получение",
+			ContentID:   "1",
+			ExpectedErr: nil,
+			SetupContentUsecaseMock: func(mock *mockusecase.MockContent) {
+				mock.EXPECT().GetBestEpisodes(gomock.Any(), 1).Return(&dto.BestEpisodes{}, nil)
+			},
+		},
+		{
+			Name:                    "Невалидный id",
+			ContentID:               "abc",
+			ExpectedErr:             &echo.HTTPError{Code: 400, Message: "Невалидный id контента"},
+			SetupContentUsecaseMock: func(mock *mockusecase.MockContent) {},
+		},
+		{
+			Name:        "Контент не найден",
+			ContentID:   "1",
+			ExpectedErr: &echo.HTTPError{Code: 404, Message: "Контент с таким id не найден"},
+			SetupContentUsecaseMock: func(mock *mockusecase.MockContent) {
+				mock.EXPECT().GetBestEpisodes(gomock.Any(), 1).Return(nil, usecase.ErrContentNotFound)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockContentUsecase := mockusecase.NewMockContent(ctrl)
+			contentEndpoints := NewContentEndpoints(mockContentUsecase)
+			tc.SetupContentUsecaseMock(mockContentUsecase)
+			req := httptest.NewRequest(http.MethodGet, "/content/"+tc.ContentID+"/episodes/best", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/content/:id/episodes/best")
+			c.SetParamNames("id")
+			c.SetParamValues(tc.ContentID)
+			err := contentEndpoints.GetBestEpisodes(c)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,270 @@
+package http
+
+import (
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/http/utils"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"strconv"
+)
+
+type DiaryEndpoints struct {
+	diaryUC usecase.Diary
+	authUC  usecase.Auth
+}
+
+func NewDiaryEndpoints(diaryUC usecase.Diary, authUC usecase.Auth) DiaryEndpoints {
+	return DiaryEndpoints{diaryUC: diaryUC, authUC: authUC}
+}
+
+func (h *DiaryEndpoints) Configure(server *echo.Group) {
+	server.POST("", h.CreateEntry)
+	server.GET("/entry/:id", h.GetEntry)
+	server.PUT("/entry/:id", h.UpdateEntry)
+	server.DELETE("/entry/:id", h.DeleteEntry)
+	server.GET("/calendar/:year/:month", h.GetCalendar)
+	server.GET("/stats/:year", h.GetYearStats)
+	server.GET("/content/:id", h.GetContentHistory)
+}
+
+// CreateEntry
+// @Summary Добавить просмотр в дневник
+// @Tags diary
+// @Description Добавляет просмотр контента в конкретный день. Оценка и заметка необязательны, оценка в дневнике
+// личная и на рейтинг контента не влияет. Категории избранного от дневника не зависят
+// @Accept json
+// @Produce json
+// @Param entry body dto.DiaryEntryRequest true "Запись дневника"
+// @Success 200 {object} dto.DiaryEntry
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/diary [post]
+// @Security _csrf
+func (h *DiaryEndpoints) CreateEntry(ctx echo.Context) error {
+	entryRequest := new(dto.DiaryEntryRequest)
+	if err := utils.ReadJSON(ctx, entryRequest); err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный запрос", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	entry, err := h.diaryUC.CreateEntry(ctx.Request().Context(), userID, *entryRequest)
+	var diaryErr usecase.DiaryErrorIncorrectData
+	switch {
+	case errors.Is(err, usecase.ErrDiaryContentNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Контент не найден", err)
+	case errors.As(err, &diaryErr):
+		return utils.NewError(ctx, http.StatusBadRequest, diaryErr.Error(), err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return utils.WriteJSON(ctx, entry)
+	}
+}
+
+// GetEntry
+// @Summary Запись дневника
+// @Tags diary
+// @Description Запись дневника текущего пользователя вместе с превью контента
+// @Produce json
+// @Param id path int true "ID записи"
+// @Success 200 {object} dto.DiaryEntryWithContent
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/diary/entry/{id} [get]
+func (h *DiaryEndpoints) GetEntry(ctx echo.Context) error {
+	entryID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id записи", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	entry, err := h.diaryUC.GetEntry(ctx.Request().Context(), userID, int(entryID))
+	switch {
+	case errors.Is(err, usecase.ErrDiaryEntryNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Запись не найдена", err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return utils.WriteJSON(ctx, entry)
+	}
+}
+
+// UpdateEntry
+// @Summary Изменить запись дневника
+// @Tags diary
+// @Description Изменяет дату, оценку, заметку и отметку о повторном просмотре. Контент записи не меняется
+// @Accept json
+// @Produce json
+// @Param id path int true "ID записи"
+// @Param entry body dto.DiaryEntryRequest true "Запись дневника"
+// @Success 200 {object} dto.DiaryEntry
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/diary/entry/{id} [put]
+// @Security _csrf
+func (h *DiaryEndpoints) UpdateEntry(ctx echo.Context) error {
+	entryID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id записи", nil)
+	}
+	entryRequest := new(dto.DiaryEntryRequest)
+	if err = utils.ReadJSON(ctx, entryRequest); err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный запрос", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	entry, err := h.diaryUC.UpdateEntry(ctx.Request().Context(), userID, int(entryID), *entryRequest)
+	var diaryErr usecase.DiaryErrorIncorrectData
+	switch {
+	case errors.Is(err, usecase.ErrDiaryEntryNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Запись не найдена", err)
+	case errors.As(err, &diaryErr):
+		return utils.NewError(ctx, http.StatusBadRequest, diaryErr.Error(), err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return utils.WriteJSON(ctx, entry)
+	}
+}
+
+// DeleteEntry
+// @Summary Удалить запись дневника
+// @Tags diary
+// @Param id path int true "ID записи"
+// @Success 200
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/diary/entry/{id} [delete]
+// @Security _csrf
+func (h *DiaryEndpoints) DeleteEntry(ctx echo.Context) error {
+	entryID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id записи", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	err = h.diaryUC.DeleteEntry(ctx.Request().Context(), userID, int(entryID))
+	switch {
+	case errors.Is(err, usecase.ErrDiaryEntryNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Запись не найдена", err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return ctx.NoContent(http.StatusOK)
+	}
+}
+
+// GetCalendar
+// @Summary Календарь просмотров
+// @Tags diary
+// @Description Записи дневника текущего пользователя за месяц по дням. Дни без просмотров не включаются
+// @Produce json
+// @Param year path int true "Год"
+// @Param month path int true "Месяц от 1 до 12"
+// @Success 200 {object} dto.DiaryCalendar
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/diary/calendar/{year}/{month} [get]
+func (h *DiaryEndpoints) GetCalendar(ctx echo.Context) error {
+	year, err := strconv.ParseInt(ctx.Param("year"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный год", nil)
+	}
+	month, err := strconv.ParseInt(ctx.Param("month"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный месяц", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	calendar, err := h.diaryUC.GetCalendar(ctx.Request().Context(), userID, int(year), int(month))
+	var diaryErr usecase.DiaryErrorIncorrectData
+	switch {
+	case errors.As(err, &diaryErr):
+		return utils.NewError(ctx, http.StatusBadRequest, diaryErr.Error(), err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return utils.WriteJSON(ctx, calendar)
+	}
+}
+
+// GetYearStats
+// @Summary Статистика просмотров за год
+// @Tags diary
+// @Description Число просмотров, разных фильмов и сериалов, повторных просмотров, средняя оценка и просмотры
+// по месяцам
+// @Produce json
+// @Param year path int true "Год"
+// @Success 200 {object} dto.DiaryYearStats
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/diary/stats/{year} [get]
+func (h *DiaryEndpoints) GetYearStats(ctx echo.Context) error {
+	year, err := strconv.ParseInt(ctx.Param("year"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный год", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	stats, err := h.diaryUC.GetYearStats(ctx.Request().Context(), userID, int(year))
+	var diaryErr usecase.DiaryErrorIncorrectData
+	switch {
+	case errors.As(err, &diaryErr):
+		return utils.NewError(ctx, http.StatusBadRequest, diaryErr.Error(), err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return utils.WriteJSON(ctx, stats)
+	}
+}
+
+// GetContentHistory
+// @Summary Просмотры контента
+// @Tags diary
+// @Description Все просмотры контента текущим пользователем, начиная с последнего, и число повторных просмотров
+// @Produce json
+// @Param id path int true "ID контента"
+// @Success 200 {object} dto.DiaryContentHistory
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/diary/content/{id} [get]
+func (h *DiaryEndpoints) GetContentHistory(ctx echo.Context) error {
+	contentID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id контента", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	history, err := h.diaryUC.GetContentHistory(ctx.Request().Context(), userID, int(contentID))
+	if err != nil {
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	}
+	return utils.WriteJSON(ctx, history)
+}
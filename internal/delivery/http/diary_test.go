@@ -0,0 +1,135 @@
+package http
+
+import (
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	mockusecase "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDiaryEndpoints_CreateEntry(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                  string
+		Body                  string
+		ExpectedErr           error
+		SetupDiaryUsecaseMock func(uc *mockusecase.MockDiary)
+	}{
+		{
+			Name:        "Успешное добавление",
+			Body:        `{"contentID":2,"watchedOn":"2024-06-01","rewatch":true}`,
+			ExpectedErr: nil,
+			SetupDiaryUsecaseMock: func(uc *mockusecase.MockDiary) {
+				uc.EXPECT().CreateEntry(gomock.Any(), 1, dto.DiaryEntryRequest{
+					ContentID: 2,
+					WatchedOn: "2024-06-01",
+					Rewatch:   true,
+				}).Return(&dto.DiaryEntry{ID: 5}, nil)
+			},
+		},
+		{
+			Name:        "Некорректные данные",
+			Body:        `{"contentID":2,"watchedOn":"завтра"}`,
+			ExpectedErr: &echo.HTTPError{Code: 400, Message: "дата просмотра должна быть в формате ГГГГ-ММ-ДД"},
+			SetupDiaryUsecaseMock: func(uc *mockusecase.MockDiary) {
+				uc.EXPECT().CreateEntry(gomock.Any(), 1, gomock.Any()).Return(nil, usecase.DiaryErrorIncorrectData{
+					Err: errors.New("дата просмотра должна быть в формате ГГГГ-ММ-ДД"),
+				})
+			},
+		},
+		{
+			Name:        "Контент не найден",
+			Body:        `{"contentID":2,"watchedOn":"2024-06-01"}`,
+			ExpectedErr: &echo.HTTPError{Code: 404, Message: "Контент не найден"},
+			SetupDiaryUsecaseMock: func(uc *mockusecase.MockDiary) {
+				uc.EXPECT().CreateEntry(gomock.Any(), 1, gomock.Any()).Return(nil, usecase.ErrDiaryContentNotFound)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockDiaryUsecase := mockusecase.NewMockDiary(ctrl)
+			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
+			mockAuthUsecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			tc.SetupDiaryUsecaseMock(mockDiaryUsecase)
+			diaryHandler := NewDiaryEndpoints(mockDiaryUsecase, mockAuthUsecase)
+			req := httptest.NewRequest(http.MethodPost, "/diary", strings.NewReader(tc.Body))
+			req.AddCookie(&http.Cookie{Name: "session", Value: "xxx"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			err := diaryHandler.CreateEntry(c)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestDiaryEndpoints_GetCalendar(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                  string
+		Year                  string
+		Month                 string
+		ExpectedErr           error
+		SetupDiaryUsecaseMock func(uc *mockusecase.MockDiary)
+	}{
+		{
+			Name:        "Успешное получение",
+			Year:        "2024",
+			Month:       "6",
+			ExpectedErr: nil,
+			SetupDiaryUsecaseMock: func(uc *mockusecase.MockDiary) {
+				uc.EXPECT().GetCalendar(gomock.Any(), 1, 2024, 6).Return(&dto.DiaryCalendar{Year: 2024, Month: 6}, nil)
+			},
+		},
+		{
+			Name:        "Месяц вне диапазона",
+			Year:        "2024",
+			Month:       "13",
+			ExpectedErr: &echo.HTTPError{Code: 400, Message: "месяц должен быть от 1 до 12"},
+			SetupDiaryUsecaseMock: func(uc *mockusecase.MockDiary) {
+				uc.EXPECT().GetCalendar(gomock.Any(), 1, 2024, 13).Return(nil, usecase.DiaryErrorIncorrectData{
+					Err: errors.New("месяц должен быть от 1 до 12"),
+				})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockDiaryUsecase := mockusecase.NewMockDiary(ctrl)
+			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
+			mockAuthUsecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			tc.SetupDiaryUsecaseMock(mockDiaryUsecase)
+			diaryHandler := NewDiaryEndpoints(mockDiaryUsecase, mockAuthUsecase)
+			req := httptest.NewRequest(http.MethodGet, "/diary/calendar/", nil)
+			req.AddCookie(&http.Cookie{Name: "session", Value: "xxx"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/diary/calendar/:year/:month")
+			c.SetParamNames("year", "month")
+			c.SetParamValues(tc.Year, tc.Month)
+			err := diaryHandler.GetCalendar(c)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
@@ -2,7 +2,9 @@ package http
 
 import (
 	"errors"
+	"fmt"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/http/utils"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
 	"github.com/labstack/echo/v4"
@@ -13,10 +15,15 @@ import (
 type FavouriteEndpoints struct {
 	favouriteUC usecase.Favourite
 	authUC      usecase.Auth
+	privacyUC   usecase.Privacy
 }
 
-func NewFavouriteEndpoints(favouriteUC usecase.Favourite, authUC usecase.Auth) FavouriteEndpoints {
-	return FavouriteEndpoints{favouriteUC: favouriteUC, authUC: authUC}
+func NewFavouriteEndpoints(
+	favouriteUC usecase.Favourite,
+	authUC usecase.Auth,
+	privacyUC usecase.Privacy,
+) FavouriteEndpoints {
+	return FavouriteEndpoints{favouriteUC: favouriteUC, authUC: authUC, privacyUC: privacyUC}
 }
 
 func (h *FavouriteEndpoints) Configure(server *echo.Group) {
@@ -24,6 +31,7 @@ func (h *FavouriteEndpoints) Configure(server *echo.Group) {
 	server.DELETE("/:id", h.DeleteFavourite)
 	server.GET("/:id", h.GetFavouritesByUser)
 	server.GET("/my", h.GetMyFavourites)
+	server.GET("/export", h.ExportHistory)
 	server.GET("/status/:id", h.GetStatus)
 }
 
@@ -48,7 +56,7 @@ func (h *FavouriteEndpoints) CreateFavourite(ctx echo.Context) error {
 	if err = utils.ReadJSON(ctx, favouriteData); err != nil {
 		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный JSON", nil)
 	}
-	err = h.favouriteUC.CreateFavourite(userID, favouriteData.ContentID, favouriteData.Category)
+	err = h.favouriteUC.CreateFavourite(ctx.Request().Context(), userID, favouriteData.ContentID, favouriteData.Category)
 	switch {
 	case errors.Is(err, usecase.ErrFavouriteContentNotFound):
 		return utils.NewError(ctx, http.StatusNotFound, "Контент не найден", err)
@@ -79,7 +87,7 @@ func (h *FavouriteEndpoints) DeleteFavourite(ctx echo.Context) error {
 	if err != nil {
 		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный ID", err)
 	}
-	err = h.favouriteUC.DeleteFavourite(userID, int(contentID))
+	err = h.favouriteUC.DeleteFavourite(ctx.Request().Context(), userID, int(contentID))
 	switch {
 	case errors.Is(err, usecase.ErrFavouriteNotFound):
 		return utils.NewError(ctx, http.StatusNotFound, "Избранное не найдено", err)
@@ -92,10 +100,12 @@ func (h *FavouriteEndpoints) DeleteFavourite(ctx echo.Context) error {
 
 // GetFavouritesByUser
 // @Tags Favourite
-// @Description Получение избранного пользователя
+// @Description Получение избранного пользователя. Если пользователь скрыл избранное настройками приватности,
+// возвращается 403
 // @Param 	id	path	int	true	"Идентификатор пользователя"
 // @Success     200 {object}    dto.FavouritesResponse
 // @Failure		400	{object}	echo.HTTPError
+// @Failure		403	{object}	echo.HTTPError
 // @Failure		404	{object}	echo.HTTPError
 // @Failure		500	{object}	echo.HTTPError
 // @Router /api/favourite/{id} [get]
@@ -104,7 +114,12 @@ func (h *FavouriteEndpoints) GetFavouritesByUser(ctx echo.Context) error {
 	if err != nil {
 		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный ID", err)
 	}
-	favourites, err := h.favouriteUC.GetFavourites(int(userID))
+	err = checkPrivacy(ctx, h.authUC, h.privacyUC, int(userID), entity.PrivacySectionFavourites,
+		"Пользователь скрыл избранное")
+	if err != nil {
+		return err
+	}
+	favourites, err := h.favouriteUC.GetFavourites(ctx.Request().Context(), int(userID))
 	switch {
 	case errors.Is(err, usecase.ErrFavouriteUserNotFound):
 		return utils.NewError(ctx, http.StatusNotFound, "Пользователь не найден", err)
@@ -128,7 +143,7 @@ func (h *FavouriteEndpoints) GetMyFavourites(ctx echo.Context) error {
 	if err != nil {
 		return utils.NewError(ctx, http.StatusUnauthorized, "Не авторизован", err)
 	}
-	favourites, err := h.favouriteUC.GetFavourites(userID)
+	favourites, err := h.favouriteUC.GetFavourites(ctx.Request().Context(), userID)
 	switch {
 	case errors.Is(err, usecase.ErrFavouriteUserNotFound):
 		return utils.NewError(ctx, http.StatusNotFound, "Пользователь не найден", err)
@@ -158,7 +173,7 @@ func (h *FavouriteEndpoints) GetStatus(ctx echo.Context) error {
 	if err != nil {
 		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный ID", err)
 	}
-	status, err := h.favouriteUC.GetStatus(userID, int(contentID))
+	status, err := h.favouriteUC.GetStatus(ctx.Request().Context(), userID, int(contentID))
 	switch {
 	case errors.Is(err, usecase.ErrFavouriteNotFound):
 		return utils.NewError(ctx, http.StatusNotFound, "Не добавлено в избранное", err)
@@ -168,3 +183,55 @@ func (h *FavouriteEndpoints) GetStatus(ctx echo.Context) error {
 		return utils.WriteJSON(ctx, status)
 	}
 }
+
+// exportContentTypes типы содержимого выгрузки по форматам
+var exportContentTypes = map[string]string{
+	entity.ExportFormatCSV:        "text/csv; charset=utf-8",
+	entity.ExportFormatJSON:       echo.MIMEApplicationJSONCharsetUTF8,
+	entity.ExportFormatLetterboxd: "text/csv; charset=utf-8",
+}
+
+// ExportHistory
+// @Tags Favourite
+// @Description Выгрузка избранного, оценок и рецензий текущего пользователя файлом. Форматы: csv (по умолчанию),
+// json и letterboxd - CSV, который принимает импорт Letterboxd. Запланированный и не оцененный контент в выгрузку
+// letterboxd не попадает
+// @Produce json
+// @Produce plain
+// @Param 	format	query	string	false	"Формат выгрузки: csv, json или letterboxd"
+// @Success     200
+// @Failure		400	{object}	echo.HTTPError
+// @Failure		401	{object}	echo.HTTPError
+// @Failure		500	{object}	echo.HTTPError
+// @Router /api/favourite/export [get]
+func (h *FavouriteEndpoints) ExportHistory(ctx echo.Context) error {
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Не авторизован", err)
+	}
+	format := ctx.QueryParam("format")
+	if format == "" {
+		format = entity.ExportFormatCSV
+	}
+	// формат проверяется до записи заголовков, иначе ошибка пришла бы как скачиваемый файл
+	if err = entity.ValidateExportFormat(format); err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, err.Error(), nil)
+	}
+	extension := format
+	if format == entity.ExportFormatLetterboxd {
+		extension = entity.ExportFormatCSV
+	}
+	ctx.Response().Header().Set(echo.HeaderContentType, exportContentTypes[format])
+	ctx.Response().Header().Set(echo.HeaderContentDisposition,
+		fmt.Sprintf("attachment; filename=\"history-%s.%s\"", format, extension))
+	// выгрузка пишется в ответ по мере чтения из базы. Если ошибка случится после начала записи,
+	// пользователь получит обрезанный файл, а ошибка попадет только в лог
+	err = h.favouriteUC.ExportHistory(ctx.Request().Context(), userID, format, ctx.Response())
+	if err != nil {
+		if !ctx.Response().Committed {
+			ctx.Response().Header().Del(echo.HeaderContentDisposition)
+		}
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	}
+	return nil
+}
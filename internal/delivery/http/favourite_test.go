@@ -1,6 +1,7 @@
 package http
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -13,6 +14,7 @@ import (
 	"io"
 
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/http/utils"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
 	mockusecase "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/mocks"
@@ -43,10 +45,10 @@ func TestFavouriteEndpoints_CreateFavourite1(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupFavouriteUsecaseMock: func(usecase *mockusecase.MockFavourite) {
-				usecase.EXPECT().CreateFavourite(1, 1, "favourite").Return(nil).AnyTimes()
+				usecase.EXPECT().CreateFavourite(gomock.Any(), 1, 1, "favourite").Return(nil).AnyTimes()
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil).AnyTimes()
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil).AnyTimes()
 			},
 		},
 		{
@@ -58,7 +60,7 @@ func TestFavouriteEndpoints_CreateFavourite1(t *testing.T) {
 			ExpectedErr:               &echo.HTTPError{Code: 401, Message: "Не авторизован"},
 			SetupFavouriteUsecaseMock: func(mock *mockusecase.MockFavourite) {},
 			SetupAuthUsecaseMock: func(mock *mockusecase.MockAuth) {
-				mock.EXPECT().GetUserIDBySession(gomock.Any()).Return(-1, utils.ErrUnauthorized).AnyTimes()
+				mock.EXPECT().GetUserIDBySession(gomock.Any(), gomock.Any()).Return(-1, utils.ErrUnauthorized).AnyTimes()
 			},
 		},
 	}
@@ -76,7 +78,7 @@ func TestFavouriteEndpoints_CreateFavourite1(t *testing.T) {
 			tc.SetupFavouriteUsecaseMock(mockFavouriteUsecase)
 			tc.SetupAuthUsecaseMock(mockAuthUsecase)
 
-			favouriteHandler := NewFavouriteEndpoints(mockFavouriteUsecase, mockAuthUsecase)
+			favouriteHandler := NewFavouriteEndpoints(mockFavouriteUsecase, mockAuthUsecase, nil)
 			req := httptest.NewRequest(http.MethodPut, "/favourite", tc.Input())
 
 			req.AddCookie(tc.Cookies)
@@ -112,10 +114,10 @@ func TestFavouriteEndpoints_DeleteFavourite(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupFavouriteUsecaseMock: func(usecase *mockusecase.MockFavourite) {
-				usecase.EXPECT().DeleteFavourite(1, 1).Return(nil)
+				usecase.EXPECT().DeleteFavourite(gomock.Any(), 1, 1).Return(nil)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 		{
@@ -130,10 +132,10 @@ func TestFavouriteEndpoints_DeleteFavourite(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupFavouriteUsecaseMock: func(uc *mockusecase.MockFavourite) {
-				uc.EXPECT().DeleteFavourite(1, 1).Return(usecase.ErrFavouriteNotFound)
+				uc.EXPECT().DeleteFavourite(gomock.Any(), 1, 1).Return(usecase.ErrFavouriteNotFound)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 		{
@@ -149,7 +151,7 @@ func TestFavouriteEndpoints_DeleteFavourite(t *testing.T) {
 			},
 			SetupFavouriteUsecaseMock: func(usecase *mockusecase.MockFavourite) {},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession(gomock.Any()).Return(-1, utils.ErrUnauthorized)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), gomock.Any()).Return(-1, utils.ErrUnauthorized)
 			},
 		},
 		{
@@ -165,10 +167,10 @@ func TestFavouriteEndpoints_DeleteFavourite(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupFavouriteUsecaseMock: func(usecase *mockusecase.MockFavourite) {
-				usecase.EXPECT().DeleteFavourite(1, 1).Return(errors.New("123"))
+				usecase.EXPECT().DeleteFavourite(gomock.Any(), 1, 1).Return(errors.New("123"))
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 	}
@@ -186,7 +188,7 @@ func TestFavouriteEndpoints_DeleteFavourite(t *testing.T) {
 			tc.SetupFavouriteUsecaseMock(mockFavouriteUsecase)
 			tc.SetupAuthUsecaseMock(mockAuthUsecase)
 
-			favouriteHandler := NewFavouriteEndpoints(mockFavouriteUsecase, mockAuthUsecase)
+			favouriteHandler := NewFavouriteEndpoints(mockFavouriteUsecase, mockAuthUsecase, nil)
 			req := httptest.NewRequest(http.MethodDelete, "/favourite/", nil)
 			if tc.Cookies != nil {
 				req.AddCookie(tc.Cookies)
@@ -230,7 +232,7 @@ func TestReviewEndpoints_GetFavourites(t *testing.T) {
 			},
 
 			SetupFavouriteUsecaseMock: func(usecase *mockusecase.MockFavourite) {
-				usecase.EXPECT().GetFavourites(1).Return(&dto.FavouritesResponse{
+				usecase.EXPECT().GetFavourites(gomock.Any(), 1).Return(&dto.FavouritesResponse{
 					Favourites: []dto.Favourite{
 						{
 							Content: dto.PreviewContent{
@@ -253,7 +255,7 @@ func TestReviewEndpoints_GetFavourites(t *testing.T) {
 			},
 			ExpectedOutput: nil,
 			SetupFavouriteUsecaseMock: func(usecase *mockusecase.MockFavourite) {
-				usecase.EXPECT().GetFavourites(1).Return(nil, errors.New("123"))
+				usecase.EXPECT().GetFavourites(gomock.Any(), 1).Return(nil, errors.New("123"))
 			},
 		},
 		{
@@ -278,7 +280,7 @@ func TestReviewEndpoints_GetFavourites(t *testing.T) {
 			},
 			ExpectedOutput: nil,
 			SetupFavouriteUsecaseMock: func(usecase *mockusecase.MockFavourite) {
-				usecase.EXPECT().GetFavourites(1).Return(nil, errors.New("123"))
+				usecase.EXPECT().GetFavourites(gomock.Any(), 1).Return(nil, errors.New("123"))
 			},
 		},
 	}
@@ -292,8 +294,11 @@ func TestReviewEndpoints_GetFavourites(t *testing.T) {
 			defer ctrl.Finish()
 			mockFavouriteUsecase := mockusecase.NewMockFavourite(ctrl)
 			tc.SetupFavouriteUsecaseMock(mockFavouriteUsecase)
+			mockPrivacyUsecase := mockusecase.NewMockPrivacy(ctrl)
+			mockPrivacyUsecase.EXPECT().CanView(gomock.Any(), -1, 1, entity.PrivacySectionFavourites).
+				Return(true, nil).AnyTimes()
 
-			favouriteHandler := NewFavouriteEndpoints(mockFavouriteUsecase, nil)
+			favouriteHandler := NewFavouriteEndpoints(mockFavouriteUsecase, nil, mockPrivacyUsecase)
 			req := httptest.NewRequest(http.MethodDelete, "/favourite/", nil)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
@@ -341,7 +346,7 @@ func TestReviewEndpoints_GetMyFavourites(t *testing.T) {
 				},
 			},
 			SetupFavouriteUsecaseMock: func(usecase *mockusecase.MockFavourite) {
-				usecase.EXPECT().GetFavourites(1).Return(&dto.FavouritesResponse{
+				usecase.EXPECT().GetFavourites(gomock.Any(), 1).Return(&dto.FavouritesResponse{
 					Favourites: []dto.Favourite{
 						{
 							Content: dto.PreviewContent{
@@ -353,7 +358,7 @@ func TestReviewEndpoints_GetMyFavourites(t *testing.T) {
 				}, nil)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 		{
@@ -369,10 +374,10 @@ func TestReviewEndpoints_GetMyFavourites(t *testing.T) {
 			},
 			ExpectedOutput: nil,
 			SetupFavouriteUsecaseMock: func(usecase *mockusecase.MockFavourite) {
-				usecase.EXPECT().GetFavourites(1).Return(nil, errors.New("123"))
+				usecase.EXPECT().GetFavourites(gomock.Any(), 1).Return(nil, errors.New("123"))
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 		{
@@ -397,10 +402,10 @@ func TestReviewEndpoints_GetMyFavourites(t *testing.T) {
 			},
 			ExpectedOutput: nil,
 			SetupFavouriteUsecaseMock: func(uc *mockusecase.MockFavourite) {
-				uc.EXPECT().GetFavourites(1).Return(nil, usecase.ErrFavouriteUserNotFound)
+				uc.EXPECT().GetFavourites(gomock.Any(), 1).Return(nil, usecase.ErrFavouriteUserNotFound)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 	}
@@ -417,7 +422,7 @@ func TestReviewEndpoints_GetMyFavourites(t *testing.T) {
 			tc.SetupFavouriteUsecaseMock(mockFavouriteUsecase)
 			tc.SetupAuthUsecaseMock(mockAuthUsecase)
 
-			favouriteHandler := NewFavouriteEndpoints(mockFavouriteUsecase, mockAuthUsecase)
+			favouriteHandler := NewFavouriteEndpoints(mockFavouriteUsecase, mockAuthUsecase, nil)
 			req := httptest.NewRequest(http.MethodDelete, "/favourite/", nil)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
@@ -456,10 +461,10 @@ func TestReviewEndpoints_GetStatus(t *testing.T) {
 				Status: "favourite",
 			},
 			SetupFavouriteUsecaseMock: func(usecase *mockusecase.MockFavourite) {
-				usecase.EXPECT().GetStatus(1, 1).Return(&dto.FavouriteStatusResponse{Status: "favourite"}, nil)
+				usecase.EXPECT().GetStatus(gomock.Any(), 1, 1).Return(&dto.FavouriteStatusResponse{Status: "favourite"}, nil)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 		{
@@ -475,10 +480,10 @@ func TestReviewEndpoints_GetStatus(t *testing.T) {
 			},
 			ExpectedOutput: nil,
 			SetupFavouriteUsecaseMock: func(uc *mockusecase.MockFavourite) {
-				uc.EXPECT().GetStatus(1, 1).Return(nil, usecase.ErrFavouriteNotFound)
+				uc.EXPECT().GetStatus(gomock.Any(), 1, 1).Return(nil, usecase.ErrFavouriteNotFound)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 		{
@@ -506,10 +511,10 @@ func TestReviewEndpoints_GetStatus(t *testing.T) {
 			},
 			ExpectedOutput: nil,
 			SetupFavouriteUsecaseMock: func(usecase *mockusecase.MockFavourite) {
-				usecase.EXPECT().GetStatus(1, 1).Return(nil, errors.New("123"))
+				usecase.EXPECT().GetStatus(gomock.Any(), 1, 1).Return(nil, errors.New("123"))
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 		{
@@ -526,7 +531,7 @@ func TestReviewEndpoints_GetStatus(t *testing.T) {
 			ExpectedOutput:            nil,
 			SetupFavouriteUsecaseMock: func(usecase *mockusecase.MockFavourite) {},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 		{
@@ -543,10 +548,10 @@ func TestReviewEndpoints_GetStatus(t *testing.T) {
 			},
 			ExpectedOutput: nil,
 			SetupFavouriteUsecaseMock: func(usecase *mockusecase.MockFavourite) {
-				usecase.EXPECT().GetStatus(1, 1).Return(nil, errors.New("123"))
+				usecase.EXPECT().GetStatus(gomock.Any(), 1, 1).Return(nil, errors.New("123"))
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 	}
@@ -562,7 +567,7 @@ func TestReviewEndpoints_GetStatus(t *testing.T) {
 			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
 			tc.SetupFavouriteUsecaseMock(mockFavouriteUsecase)
 			tc.SetupAuthUsecaseMock(mockAuthUsecase)
-			favouriteHandler := NewFavouriteEndpoints(mockFavouriteUsecase, mockAuthUsecase)
+			favouriteHandler := NewFavouriteEndpoints(mockFavouriteUsecase, mockAuthUsecase, nil)
 			req := httptest.NewRequest(http.MethodDelete, "/favourite/", nil)
 			rec := httptest.NewRecorder()
 			if tc.Cookies != nil {
@@ -583,3 +588,107 @@ func TestReviewEndpoints_GetStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestFavouriteEndpoints_ExportHistory(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                      string
+		Format                    string
+		Cookies                   *http.Cookie
+		ExpectedErr               error
+		ExpectedBody              string
+		ExpectedDisposition       string
+		SetupFavouriteUsecaseMock func(uc *mockusecase.MockFavourite)
+		SetupAuthUsecaseMock      func(uc *mockusecase.MockAuth)
+	}{
+		{
+			Name:                "CSV по умолчанию",
+			Cookies:             &http.Cookie{Name: "session", Value: "xxx"},
+			ExpectedBody:        "content_id\n",
+			ExpectedDisposition: `attachment; filename="history-csv.csv"`,
+			SetupFavouriteUsecaseMock: func(uc *mockusecase.MockFavourite) {
+				uc.EXPECT().ExportHistory(gomock.Any(), 1, entity.ExportFormatCSV, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ int, _ string, w io.Writer) error {
+						_, err := io.WriteString(w, "content_id\n")
+						return err
+					})
+			},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			},
+		},
+		{
+			Name:                "Letterboxd",
+			Format:              entity.ExportFormatLetterboxd,
+			Cookies:             &http.Cookie{Name: "session", Value: "xxx"},
+			ExpectedDisposition: `attachment; filename="history-letterboxd.csv"`,
+			SetupFavouriteUsecaseMock: func(uc *mockusecase.MockFavourite) {
+				uc.EXPECT().ExportHistory(gomock.Any(), 1, entity.ExportFormatLetterboxd, gomock.Any()).Return(nil)
+			},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			},
+		},
+		{
+			Name:                      "Неизвестный формат",
+			Format:                    "xml",
+			Cookies:                   &http.Cookie{Name: "session", Value: "xxx"},
+			ExpectedErr:               &echo.HTTPError{Code: 400, Message: "формат выгрузки должен быть csv, json или letterboxd"},
+			SetupFavouriteUsecaseMock: func(uc *mockusecase.MockFavourite) {},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			},
+		},
+		{
+			Name:   "Ошибка до начала выгрузки",
+			Format: entity.ExportFormatJSON,
+			Cookies: &http.Cookie{
+				Name:  "session",
+				Value: "xxx",
+			},
+			ExpectedErr: &echo.HTTPError{
+				Code:     500,
+				Message:  "Внутренняя ошибка сервера",
+				Internal: errors.New("123"),
+			},
+			SetupFavouriteUsecaseMock: func(uc *mockusecase.MockFavourite) {
+				uc.EXPECT().ExportHistory(gomock.Any(), 1, entity.ExportFormatJSON, gomock.Any()).Return(errors.New("123"))
+			},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			},
+		},
+		{
+			Name:                      "Пользователь не авторизован",
+			ExpectedErr:               &echo.HTTPError{Code: 401, Message: "Не авторизован"},
+			SetupFavouriteUsecaseMock: func(uc *mockusecase.MockFavourite) {},
+			SetupAuthUsecaseMock:      func(uc *mockusecase.MockAuth) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockFavouriteUsecase := mockusecase.NewMockFavourite(ctrl)
+			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
+			tc.SetupFavouriteUsecaseMock(mockFavouriteUsecase)
+			tc.SetupAuthUsecaseMock(mockAuthUsecase)
+			h := NewFavouriteEndpoints(mockFavouriteUsecase, mockAuthUsecase, mockusecase.NewMockPrivacy(ctrl))
+			req := httptest.NewRequest(http.MethodGet, "/favourite/export?format="+tc.Format, nil)
+			if tc.Cookies != nil {
+				req.AddCookie(tc.Cookies)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			err := h.ExportHistory(c)
+			require.Equal(t, tc.ExpectedErr, err)
+			require.Equal(t, tc.ExpectedBody, rec.Body.String())
+			require.Equal(t, tc.ExpectedDisposition, rec.Header().Get(echo.HeaderContentDisposition))
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package http
+
+import (
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/http/utils"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"strconv"
+)
+
+// количество событий на странице ленты
+const feedItemsPerPage = 20
+
+type FeedEndpoints struct {
+	feedUC usecase.Feed
+	authUC usecase.Auth
+}
+
+func NewFeedEndpoints(feedUC usecase.Feed, authUC usecase.Auth) FeedEndpoints {
+	return FeedEndpoints{feedUC: feedUC, authUC: authUC}
+}
+
+func (h *FeedEndpoints) Configure(server *echo.Group) {
+	server.GET("/:page", h.GetFeed)
+}
+
+// GetFeed
+// @Summary Лента подписок
+// @Tags follow
+// @Description Новые рецензии, оценки, добавления в списки и изменения избранного пользователей, на которых
+// подписан текущий пользователь, по 20 на странице, начиная с последних. События разделов, скрытых от
+// подписчиков, и приватных списков не возвращаются
+// @Produce json
+// @Param page path int true "Номер страницы"
+// @Success 200 {object} dto.Feed
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/feed/{page} [get]
+func (h *FeedEndpoints) GetFeed(ctx echo.Context) error {
+	page, err := strconv.ParseInt(ctx.Param("page"), 10, 64)
+	if err != nil || page < 1 {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный номер страницы", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	feed, err := h.feedUC.GetFeed(ctx.Request().Context(), userID, feedItemsPerPage, int(page))
+	if err != nil {
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	}
+	return utils.WriteJSON(ctx, feed)
+}
@@ -0,0 +1,79 @@
+package http
+
+import (
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	mockusecase "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFeedEndpoints_GetFeed(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                 string
+		Page                 string
+		Cookie               *http.Cookie
+		ExpectedErr          error
+		SetupFeedUsecaseMock func(uc *mockusecase.MockFeed)
+		SetupAuthUsecaseMock func(uc *mockusecase.MockAuth)
+	}{
+		{
+			Name:        "Успешное получение",
+			Page:        "1",
+			Cookie:      &http.Cookie{Name: "session", Value: "xxx"},
+			ExpectedErr: nil,
+			SetupFeedUsecaseMock: func(uc *mockusecase.MockFeed) {
+				uc.EXPECT().GetFeed(gomock.Any(), 1, 20, 1).Return(&dto.Feed{}, nil)
+			},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			},
+		},
+		{
+			Name:                 "Неавторизованный пользователь",
+			Page:                 "1",
+			ExpectedErr:          &echo.HTTPError{Code: 401, Message: "Для этой операции нужно авторизоваться"},
+			SetupFeedUsecaseMock: func(uc *mockusecase.MockFeed) {},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {},
+		},
+		{
+			Name:                 "Невалидная страница",
+			Page:                 "abc",
+			Cookie:               &http.Cookie{Name: "session", Value: "xxx"},
+			ExpectedErr:          &echo.HTTPError{Code: 400, Message: "Невалидный номер страницы"},
+			SetupFeedUsecaseMock: func(uc *mockusecase.MockFeed) {},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockFeedUsecase := mockusecase.NewMockFeed(ctrl)
+			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
+			tc.SetupFeedUsecaseMock(mockFeedUsecase)
+			tc.SetupAuthUsecaseMock(mockAuthUsecase)
+			feedHandler := NewFeedEndpoints(mockFeedUsecase, mockAuthUsecase)
+			req := httptest.NewRequest(http.MethodGet, "/feed/", nil)
+			if tc.Cookie != nil {
+				req.AddCookie(tc.Cookie)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/feed/:page")
+			c.SetParamNames("page")
+			c.SetParamValues(tc.Page)
+			err := feedHandler.GetFeed(c)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
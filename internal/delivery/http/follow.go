@@ -0,0 +1,173 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/http/utils"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"strconv"
+)
+
+// количество пользователей на странице подписчиков и подписок
+const followUsersPerPage = 20
+
+type FollowEndpoints struct {
+	followUC usecase.Follow
+	authUC   usecase.Auth
+}
+
+func NewFollowEndpoints(followUC usecase.Follow, authUC usecase.Auth) FollowEndpoints {
+	return FollowEndpoints{followUC: followUC, authUC: authUC}
+}
+
+func (h *FollowEndpoints) Configure(server *echo.Group) {
+	server.GET("/:id", h.GetFollowStatus)
+	server.PUT("/:id", h.Follow)
+	server.DELETE("/:id", h.Unfollow)
+	server.GET("/:id/followers/:page", h.GetFollowers)
+	server.GET("/:id/following/:page", h.GetFollowing)
+}
+
+// GetFollowStatus
+// @Summary Подписки пользователя
+// @Tags follow
+// @Description Количество подписчиков и подписок пользователя и подписан ли на него текущий пользователь
+// @Produce json
+// @Param id path int true "ID пользователя"
+// @Success 200 {object} dto.FollowStatus
+// @Failure 400 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/follow/{id} [get]
+func (h *FollowEndpoints) GetFollowStatus(ctx echo.Context) error {
+	userID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id пользователя", nil)
+	}
+	// если не удалось получить id пользователя из сессии, то это не ошибка, просто неавторизованный пользователь
+	// no-lint
+	clientUserID, _ := utils.GetUserIDFromSession(ctx, h.authUC)
+	status, err := h.followUC.GetFollowStatus(ctx.Request().Context(), clientUserID, int(userID))
+	if err != nil {
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	}
+	return utils.WriteJSON(ctx, status)
+}
+
+// Follow
+// @Summary Подписаться
+// @Tags follow
+// @Description Подписывает текущего пользователя на пользователя. Повторная подписка не считается ошибкой
+// @Param id path int true "ID пользователя"
+// @Success 200
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/follow/{id} [put]
+// @Security _csrf
+func (h *FollowEndpoints) Follow(ctx echo.Context) error {
+	followeeID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id пользователя", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	err = h.followUC.Follow(ctx.Request().Context(), userID, int(followeeID))
+	switch {
+	case errors.Is(err, usecase.ErrFollowSelf):
+		return utils.NewError(ctx, http.StatusBadRequest, "Нельзя подписаться на себя", err)
+	case errors.Is(err, usecase.ErrFollowUserNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Пользователь не найден", err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return ctx.NoContent(http.StatusOK)
+	}
+}
+
+// Unfollow
+// @Summary Отписаться
+// @Tags follow
+// @Description Отменяет подписку текущего пользователя на пользователя
+// @Param id path int true "ID пользователя"
+// @Success 200
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/follow/{id} [delete]
+// @Security _csrf
+func (h *FollowEndpoints) Unfollow(ctx echo.Context) error {
+	followeeID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id пользователя", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	err = h.followUC.Unfollow(ctx.Request().Context(), userID, int(followeeID))
+	switch {
+	case errors.Is(err, usecase.ErrFollowNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Вы не подписаны на этого пользователя", err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return ctx.NoContent(http.StatusOK)
+	}
+}
+
+// writeFollowUsers читает id пользователя и номер страницы из пути и отдает страницу, полученную из get
+func writeFollowUsers(
+	ctx echo.Context,
+	get func(ctx context.Context, userID, count, page int) (*dto.FollowUsers, error),
+) error {
+	userID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id пользователя", nil)
+	}
+	page, err := strconv.ParseInt(ctx.Param("page"), 10, 64)
+	if err != nil || page < 1 {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный номер страницы", nil)
+	}
+	users, err := get(ctx.Request().Context(), int(userID), followUsersPerPage, int(page))
+	if err != nil {
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	}
+	return utils.WriteJSON(ctx, users)
+}
+
+// GetFollowers
+// @Summary Подписчики пользователя
+// @Tags follow
+// @Description Подписчики пользователя по 20 на странице, начиная с последних подписавшихся
+// @Produce json
+// @Param id path int true "ID пользователя"
+// @Param page path int true "Номер страницы"
+// @Success 200 {object} dto.FollowUsers
+// @Failure 400 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/follow/{id}/followers/{page} [get]
+func (h *FollowEndpoints) GetFollowers(ctx echo.Context) error {
+	return writeFollowUsers(ctx, h.followUC.GetFollowers)
+}
+
+// GetFollowing
+// @Summary Подписки пользователя
+// @Tags follow
+// @Description Пользователи, на которых подписан пользователь, по 20 на странице, начиная с последних подписок
+// @Produce json
+// @Param id path int true "ID пользователя"
+// @Param page path int true "Номер страницы"
+// @Success 200 {object} dto.FollowUsers
+// @Failure 400 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/follow/{id}/following/{page} [get]
+func (h *FollowEndpoints) GetFollowing(ctx echo.Context) error {
+	return writeFollowUsers(ctx, h.followUC.GetFollowing)
+}
@@ -0,0 +1,160 @@
+package http
+
+import (
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	mockusecase "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFollowEndpoints_Follow(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                   string
+		UserID                 string
+		Cookie                 *http.Cookie
+		ExpectedErr            error
+		SetupFollowUsecaseMock func(uc *mockusecase.MockFollow)
+		SetupAuthUsecaseMock   func(uc *mockusecase.MockAuth)
+	}{
+		{
+			Name:        "Успешная подписка",
+			UserID:      "2",
+			Cookie:      &http.Cookie{Name: "session", Value: "xxx"},
+			ExpectedErr: nil,
+			SetupFollowUsecaseMock: func(uc *mockusecase.MockFollow) {
+				uc.EXPECT().Follow(gomock.Any(), 1, 2).Return(nil)
+			},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			},
+		},
+		{
+			Name:        "Подписка на себя",
+			UserID:      "1",
+			Cookie:      &http.Cookie{Name: "session", Value: "xxx"},
+			ExpectedErr: &echo.HTTPError{Code: 400, Message: "Нельзя подписаться на себя"},
+			SetupFollowUsecaseMock: func(uc *mockusecase.MockFollow) {
+				uc.EXPECT().Follow(gomock.Any(), 1, 1).Return(usecase.ErrFollowSelf)
+			},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			},
+		},
+		{
+			Name:        "Пользователь не найден",
+			UserID:      "2",
+			Cookie:      &http.Cookie{Name: "session", Value: "xxx"},
+			ExpectedErr: &echo.HTTPError{Code: 404, Message: "Пользователь не найден"},
+			SetupFollowUsecaseMock: func(uc *mockusecase.MockFollow) {
+				uc.EXPECT().Follow(gomock.Any(), 1, 2).Return(usecase.ErrFollowUserNotFound)
+			},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			},
+		},
+		{
+			Name:                   "Неавторизованный пользователь",
+			UserID:                 "2",
+			ExpectedErr:            &echo.HTTPError{Code: 401, Message: "Для этой операции нужно авторизоваться"},
+			SetupFollowUsecaseMock: func(uc *mockusecase.MockFollow) {},
+			SetupAuthUsecaseMock:   func(uc *mockusecase.MockAuth) {},
+		},
+		{
+			Name:                   "Невалидный id",
+			UserID:                 "abc",
+			ExpectedErr:            &echo.HTTPError{Code: 400, Message: "Невалидный id пользователя"},
+			SetupFollowUsecaseMock: func(uc *mockusecase.MockFollow) {},
+			SetupAuthUsecaseMock:   func(uc *mockusecase.MockAuth) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockFollowUsecase := mockusecase.NewMockFollow(ctrl)
+			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
+			tc.SetupFollowUsecaseMock(mockFollowUsecase)
+			tc.SetupAuthUsecaseMock(mockAuthUsecase)
+			followHandler := NewFollowEndpoints(mockFollowUsecase, mockAuthUsecase)
+			req := httptest.NewRequest(http.MethodPut, "/follow/", nil)
+			if tc.Cookie != nil {
+				req.AddCookie(tc.Cookie)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/follow/:id")
+			c.SetParamNames("id")
+			c.SetParamValues(tc.UserID)
+			err := followHandler.Follow(c)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestFollowEndpoints_GetFollowers(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                   string
+		UserID                 string
+		Page                   string
+		ExpectedErr            error
+		SetupFollowUsecaseMock func(uc *mockusecase.MockFollow)
+	}{
+		{
+			Name:        "Успешное получение",
+			UserID:      "1",
+			Page:        "2",
+			ExpectedErr: nil,
+			SetupFollowUsecaseMock: func(uc *mockusecase.MockFollow) {
+				uc.EXPECT().GetFollowers(gomock.Any(), 1, 20, 2).Return(&dto.FollowUsers{}, nil)
+			},
+		},
+		{
+			Name:                   "Невалидная страница",
+			UserID:                 "1",
+			Page:                   "0",
+			ExpectedErr:            &echo.HTTPError{Code: 400, Message: "Невалидный номер страницы"},
+			SetupFollowUsecaseMock: func(uc *mockusecase.MockFollow) {},
+		},
+		{
+			Name:                   "Невалидный id",
+			UserID:                 "abc",
+			Page:                   "1",
+			ExpectedErr:            &echo.HTTPError{Code: 400, Message: "Невалидный id пользователя"},
+			SetupFollowUsecaseMock: func(uc *mockusecase.MockFollow) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockFollowUsecase := mockusecase.NewMockFollow(ctrl)
+			tc.SetupFollowUsecaseMock(mockFollowUsecase)
+			followHandler := NewFollowEndpoints(mockFollowUsecase, nil)
+			req := httptest.NewRequest(http.MethodGet, "/follow/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/follow/:id/followers/:page")
+			c.SetParamNames("id", "page")
+			c.SetParamValues(tc.UserID, tc.Page)
+			err := followHandler.GetFollowers(c)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
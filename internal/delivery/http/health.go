@@ -0,0 +1,71 @@
+package http
+
+import (
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/http/utils"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/health"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/logger"
+	"github.com/labstack/echo/v4"
+	"github.com/mailru/easyjson"
+	"net/http"
+)
+
+const (
+	healthStatusOK   = "ok"
+	healthStatusFail = "fail"
+)
+
+type HealthEndpoints struct {
+	checker *health.Checker
+}
+
+func NewHealthEndpoints(checker *health.Checker) HealthEndpoints {
+	return HealthEndpoints{checker: checker}
+}
+
+func (h *HealthEndpoints) Configure(server *echo.Group) {
+	server.GET("/healthz", h.Healthz)
+	server.GET("/readyz", h.Readyz)
+}
+
+// Healthz
+// @Tags Health
+// @Description Проверка того, что процесс жив. Зависимости не проверяются
+// @Produce json
+// @Success 200 {object} dto.HealthResponse
+// @Router /healthz [get]
+func (h *HealthEndpoints) Healthz(ctx echo.Context) error {
+	return utils.WriteJSON(ctx, dto.HealthResponse{Status: healthStatusOK})
+}
+
+// Readyz
+// @Tags Health
+// @Description Проверка готовности принимать трафик: доступность Postgres, Redis, S3 и gRPC сервисов.
+// Если хотя бы одна зависимость недоступна, возвращается 503
+// @Produce json
+// @Success 200 {object} dto.HealthResponse
+// @Failure 503 {object} dto.HealthResponse
+// @Router /readyz [get]
+func (h *HealthEndpoints) Readyz(ctx echo.Context) error {
+	response := dto.HealthResponse{Status: healthStatusOK, Checks: make(map[string]string)}
+	code := http.StatusOK
+	for name, err := range h.checker.Check(ctx.Request().Context()) {
+		if err == nil {
+			response.Checks[name] = healthStatusOK
+			continue
+		}
+		// причина отказа может содержать адреса и детали инфраструктуры, поэтому наружу отдаем только статус
+		logger.ForPackage("http").WarnContext(ctx.Request().Context(), "зависимость недоступна",
+			"dependency", name,
+			"error", err,
+		)
+		response.Checks[name] = healthStatusFail
+		response.Status = healthStatusFail
+		code = http.StatusServiceUnavailable
+	}
+	jsonData, err := easyjson.Marshal(response)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	}
+	return ctx.JSONBlob(code, jsonData)
+}
@@ -0,0 +1,88 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/health"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHealthEndpoints_Healthz(t *testing.T) {
+	t.Parallel()
+
+	e := echo.New()
+	healthEndpoints := NewHealthEndpoints(health.NewChecker(time.Second))
+	req := httptest.NewRequest(http.MethodGet, "/healthz", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	err := healthEndpoints.Healthz(c)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.JSONEq(t, `{"status":"ok"}`, rec.Body.String())
+}
+
+func TestHealthEndpoints_Readyz(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name         string
+		Probes       map[string]health.Probe
+		ExpectedCode int
+		ExpectedBody string
+	}{
+		{
+			Name: "Все зависимости доступны",
+			Probes: map[string]health.Probe{
+				"postgres": func(context.Context) error { return nil },
+				"redis":    func(context.Context) error { return nil },
+			},
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: `{"status":"ok","checks":{"postgres":"ok","redis":"ok"}}`,
+		},
+		{
+			Name: "Одна зависимость недоступна",
+			Probes: map[string]health.Probe{
+				"postgres": func(context.Context) error { return nil },
+				"redis":    func(context.Context) error { return errors.New("connection refused") },
+			},
+			ExpectedCode: http.StatusServiceUnavailable,
+			ExpectedBody: `{"status":"fail","checks":{"postgres":"ok","redis":"fail"}}`,
+		},
+		{
+			Name: "Зависимость не укладывается в таймаут",
+			Probes: map[string]health.Probe{
+				"s3": func(ctx context.Context) error {
+					<-ctx.Done()
+					return ctx.Err()
+				},
+			},
+			ExpectedCode: http.StatusServiceUnavailable,
+			ExpectedBody: `{"status":"fail","checks":{"s3":"fail"}}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			checker := health.NewChecker(10 * time.Millisecond)
+			for name, probe := range tc.Probes {
+				checker.Add(name, probe)
+			}
+			healthEndpoints := NewHealthEndpoints(checker)
+			req := httptest.NewRequest(http.MethodGet, "/readyz", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			err := healthEndpoints.Readyz(c)
+			require.NoError(t, err)
+			require.Equal(t, tc.ExpectedCode, rec.Code)
+			require.JSONEq(t, tc.ExpectedBody, rec.Body.String())
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package http
+
+import (
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/http/utils"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"strconv"
+)
+
+// importMaxFileSize максимальный размер файла выгрузки. Выгрузка на несколько тысяч фильмов занимает
+// меньше мегабайта, так что запас большой
+const importMaxFileSize = 5 << 20
+
+type ImportEndpoints struct {
+	importUC usecase.Import
+	authUC   usecase.Auth
+}
+
+func NewImportEndpoints(importUC usecase.Import, authUC usecase.Auth) ImportEndpoints {
+	return ImportEndpoints{importUC: importUC, authUC: authUC}
+}
+
+func (h *ImportEndpoints) Configure(server *echo.Group) {
+	server.POST("", h.StartImport)
+	server.GET("/:id", h.GetJob)
+	server.PUT("/:id/row/:row", h.ResolveRow)
+}
+
+// StartImport
+// @Summary Импортировать историю просмотров
+// @Tags import
+// @Description Принимает CSV-выгрузку Letterboxd, IMDb или Kinopoisk и запускает импорт в фоне. Найденный
+// контент добавляется в избранное с указанной категорией (по умолчанию watched), оценки из файла становятся
+// оценками пользователя. Ход импорта и несопоставленные строки можно узнать по ID задачи
+// @Accept mpfd
+// @Produce json
+// @Param file formData file true "CSV-файл выгрузки"
+// @Param source formData string true "Источник: letterboxd, imdb или kinopoisk"
+// @Param category formData string false "Категория избранного"
+// @Success 200 {object} dto.ImportJob
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 429 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/import [post]
+// @Security _csrf
+func (h *ImportEndpoints) StartImport(ctx echo.Context) error {
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	fileHeader, err := ctx.FormFile("file")
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Файл не прикреплён", nil)
+	}
+	if fileHeader.Size > importMaxFileSize {
+		return utils.NewError(ctx, http.StatusBadRequest, "Файл должен быть не больше 5 МБ", nil)
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный файл", nil)
+	}
+	job, err := h.importUC.StartImport(
+		ctx.Request().Context(),
+		userID,
+		ctx.FormValue("source"),
+		ctx.FormValue("category"),
+		file,
+	)
+	// сервис читает файл целиком до запуска импорта в фоне, поэтому его можно сразу закрыть
+	// no-lint
+	_ = file.Close()
+	var importErr usecase.ImportErrorIncorrectData
+	switch {
+	case errors.As(err, &importErr):
+		return utils.NewError(ctx, http.StatusBadRequest, importErr.Error(), err)
+	case errors.Is(err, usecase.ErrImportTooManyJobs):
+		return utils.NewError(ctx, http.StatusTooManyRequests, "Слишком много импортов, попробуйте позже", err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return utils.WriteJSON(ctx, job)
+	}
+}
+
+// GetJob
+// @Summary Состояние импорта
+// @Tags import
+// @Description Состояние задачи импорта и строки, для которых не нашелся контент. Строку можно сопоставить
+// вручную
+// @Produce json
+// @Param id path int true "ID задачи"
+// @Success 200 {object} dto.ImportJob
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/import/{id} [get]
+func (h *ImportEndpoints) GetJob(ctx echo.Context) error {
+	jobID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id задачи", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	job, err := h.importUC.GetJob(ctx.Request().Context(), userID, int(jobID))
+	switch {
+	case errors.Is(err, usecase.ErrImportJobNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Задача импорта не найдена", err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return utils.WriteJSON(ctx, job)
+	}
+}
+
+// ResolveRow
+// @Summary Сопоставить строку импорта
+// @Tags import
+// @Description Вручную указывает контент для строки, которую не удалось сопоставить. Контент добавляется
+// в избранное, а оценка из строки становится оценкой пользователя
+// @Accept json
+// @Param id path int true "ID задачи"
+// @Param row path int true "Номер строки в файле"
+// @Param resolve body dto.ImportResolveRequest true "Выбранный контент"
+// @Success 200
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/import/{id}/row/{row} [put]
+// @Security _csrf
+func (h *ImportEndpoints) ResolveRow(ctx echo.Context) error {
+	jobID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id задачи", nil)
+	}
+	rowNumber, err := strconv.ParseInt(ctx.Param("row"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный номер строки", nil)
+	}
+	resolveRequest := new(dto.ImportResolveRequest)
+	if err = utils.ReadJSON(ctx, resolveRequest); err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный запрос", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	err = h.importUC.ResolveRow(ctx.Request().Context(), userID, int(jobID), int(rowNumber), *resolveRequest)
+	switch {
+	case errors.Is(err, usecase.ErrImportJobNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Задача импорта не найдена", err)
+	case errors.Is(err, usecase.ErrImportRowNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Строка не найдена среди несопоставленных", err)
+	case errors.Is(err, usecase.ErrImportContentNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Контент не найден", err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return ctx.NoContent(http.StatusOK)
+	}
+}
@@ -0,0 +1,277 @@
+package http
+
+import (
+	"bytes"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	mockusecase "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestImportEndpoints_StartImport(t *testing.T) {
+	t.Parallel()
+
+	withFile := func() (io.Reader, string) {
+		var buffer bytes.Buffer
+		writer := multipart.NewWriter(&buffer)
+		_ = writer.WriteField("source", "letterboxd")
+		part, _ := writer.CreateFormFile("file", "watched.csv")
+		_, _ = part.Write([]byte("Name,Year\nHeat,1995\n"))
+		_ = writer.Close()
+		return &buffer, writer.FormDataContentType()
+	}
+	testCases := []struct {
+		Name                   string
+		Input                  func() (io.Reader, string)
+		Cookie                 *http.Cookie
+		ExpectedErr            error
+		SetupImportUsecaseMock func(uc *mockusecase.MockImport)
+		SetupAuthUsecaseMock   func(uc *mockusecase.MockAuth)
+	}{
+		{
+			Name:        "Успешный запуск",
+			Input:       withFile,
+			Cookie:      &http.Cookie{Name: "session", Value: "xxx"},
+			ExpectedErr: nil,
+			SetupImportUsecaseMock: func(uc *mockusecase.MockImport) {
+				uc.EXPECT().StartImport(gomock.Any(), 1, "letterboxd", "", gomock.Any()).Return(&dto.ImportJob{ID: 1}, nil)
+			},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			},
+		},
+		{
+			Name:        "Некорректный файл",
+			Input:       withFile,
+			Cookie:      &http.Cookie{Name: "session", Value: "xxx"},
+			ExpectedErr: &echo.HTTPError{Code: 400, Message: "в файле нет столбца с названием"},
+			SetupImportUsecaseMock: func(uc *mockusecase.MockImport) {
+				uc.EXPECT().StartImport(gomock.Any(), 1, "letterboxd", "", gomock.Any()).
+					Return(nil, usecase.ImportErrorIncorrectData{Err: errors.New("в файле нет столбца с названием")})
+			},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			},
+		},
+		{
+			Name:        "Слишком много импортов",
+			Input:       withFile,
+			Cookie:      &http.Cookie{Name: "session", Value: "xxx"},
+			ExpectedErr: &echo.HTTPError{Code: 429, Message: "Слишком много импортов, попробуйте позже"},
+			SetupImportUsecaseMock: func(uc *mockusecase.MockImport) {
+				uc.EXPECT().StartImport(gomock.Any(), 1, "letterboxd", "", gomock.Any()).
+					Return(nil, usecase.ErrImportTooManyJobs)
+			},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			},
+		},
+		{
+			Name: "Файл не прикреплён",
+			Input: func() (io.Reader, string) {
+				var buffer bytes.Buffer
+				writer := multipart.NewWriter(&buffer)
+				_ = writer.Close()
+				return &buffer, writer.FormDataContentType()
+			},
+			Cookie:                 &http.Cookie{Name: "session", Value: "xxx"},
+			ExpectedErr:            &echo.HTTPError{Code: 400, Message: "Файл не прикреплён"},
+			SetupImportUsecaseMock: func(uc *mockusecase.MockImport) {},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			},
+		},
+		{
+			Name:                   "Неавторизованный пользователь",
+			Input:                  withFile,
+			ExpectedErr:            &echo.HTTPError{Code: 401, Message: "Для этой операции нужно авторизоваться"},
+			SetupImportUsecaseMock: func(uc *mockusecase.MockImport) {},
+			SetupAuthUsecaseMock:   func(uc *mockusecase.MockAuth) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockImportUsecase := mockusecase.NewMockImport(ctrl)
+			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
+			tc.SetupImportUsecaseMock(mockImportUsecase)
+			tc.SetupAuthUsecaseMock(mockAuthUsecase)
+			importHandler := NewImportEndpoints(mockImportUsecase, mockAuthUsecase)
+			body, contentType := tc.Input()
+			req := httptest.NewRequest(http.MethodPost, "/import", body)
+			req.Header.Set(echo.HeaderContentType, contentType)
+			if tc.Cookie != nil {
+				req.AddCookie(tc.Cookie)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			err := importHandler.StartImport(c)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestImportEndpoints_GetJob(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                   string
+		JobID                  string
+		Cookie                 *http.Cookie
+		ExpectedErr            error
+		SetupImportUsecaseMock func(uc *mockusecase.MockImport)
+		SetupAuthUsecaseMock   func(uc *mockusecase.MockAuth)
+	}{
+		{
+			Name:        "Успешное получение",
+			JobID:       "5",
+			Cookie:      &http.Cookie{Name: "session", Value: "xxx"},
+			ExpectedErr: nil,
+			SetupImportUsecaseMock: func(uc *mockusecase.MockImport) {
+				uc.EXPECT().GetJob(gomock.Any(), 1, 5).Return(&dto.ImportJob{ID: 5}, nil)
+			},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			},
+		},
+		{
+			Name:        "Задача не найдена",
+			JobID:       "5",
+			Cookie:      &http.Cookie{Name: "session", Value: "xxx"},
+			ExpectedErr: &echo.HTTPError{Code: 404, Message: "Задача импорта не найдена"},
+			SetupImportUsecaseMock: func(uc *mockusecase.MockImport) {
+				uc.EXPECT().GetJob(gomock.Any(), 1, 5).Return(nil, usecase.ErrImportJobNotFound)
+			},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			},
+		},
+		{
+			Name:                   "Невалидный id",
+			JobID:                  "abc",
+			ExpectedErr:            &echo.HTTPError{Code: 400, Message: "Невалидный id задачи"},
+			SetupImportUsecaseMock: func(uc *mockusecase.MockImport) {},
+			SetupAuthUsecaseMock:   func(uc *mockusecase.MockAuth) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockImportUsecase := mockusecase.NewMockImport(ctrl)
+			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
+			tc.SetupImportUsecaseMock(mockImportUsecase)
+			tc.SetupAuthUsecaseMock(mockAuthUsecase)
+			importHandler := NewImportEndpoints(mockImportUsecase, mockAuthUsecase)
+			req := httptest.NewRequest(http.MethodGet, "/import/", nil)
+			if tc.Cookie != nil {
+				req.AddCookie(tc.Cookie)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/import/:id")
+			c.SetParamNames("id")
+			c.SetParamValues(tc.JobID)
+			err := importHandler.GetJob(c)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestImportEndpoints_ResolveRow(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                   string
+		Row                    string
+		Body                   string
+		ExpectedErr            error
+		SetupImportUsecaseMock func(uc *mockusecase.MockImport)
+		SetupAuthUsecaseMock   func(uc *mockusecase.MockAuth)
+	}{
+		{
+			Name:        "Успешное сопоставление",
+			Row:         "3",
+			Body:        `{"contentID":10}`,
+			ExpectedErr: nil,
+			SetupImportUsecaseMock: func(uc *mockusecase.MockImport) {
+				uc.EXPECT().ResolveRow(gomock.Any(), 1, 5, 3, dto.ImportResolveRequest{ContentID: 10}).Return(nil)
+			},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			},
+		},
+		{
+			Name:        "Строка не найдена",
+			Row:         "3",
+			Body:        `{"contentID":10}`,
+			ExpectedErr: &echo.HTTPError{Code: 404, Message: "Строка не найдена среди несопоставленных"},
+			SetupImportUsecaseMock: func(uc *mockusecase.MockImport) {
+				uc.EXPECT().ResolveRow(gomock.Any(), 1, 5, 3, dto.ImportResolveRequest{ContentID: 10}).
+					Return(usecase.ErrImportRowNotFound)
+			},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			},
+		},
+		{
+			Name:                   "Невалидный номер строки",
+			Row:                    "abc",
+			Body:                   `{"contentID":10}`,
+			ExpectedErr:            &echo.HTTPError{Code: 400, Message: "Невалидный номер строки"},
+			SetupImportUsecaseMock: func(uc *mockusecase.MockImport) {},
+			SetupAuthUsecaseMock:   func(uc *mockusecase.MockAuth) {},
+		},
+		{
+			Name:                   "Невалидный JSON",
+			Row:                    "3",
+			Body:                   `{"contentID":`,
+			ExpectedErr:            &echo.HTTPError{Code: 400, Message: "Невалидный запрос"},
+			SetupImportUsecaseMock: func(uc *mockusecase.MockImport) {},
+			SetupAuthUsecaseMock:   func(uc *mockusecase.MockAuth) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockImportUsecase := mockusecase.NewMockImport(ctrl)
+			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
+			tc.SetupImportUsecaseMock(mockImportUsecase)
+			tc.SetupAuthUsecaseMock(mockAuthUsecase)
+			importHandler := NewImportEndpoints(mockImportUsecase, mockAuthUsecase)
+			req := httptest.NewRequest(http.MethodPut, "/import/", strings.NewReader(tc.Body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			req.AddCookie(&http.Cookie{Name: "session", Value: "xxx"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/import/:id/row/:row")
+			c.SetParamNames("id", "row")
+			c.SetParamValues("5", tc.Row)
+			err := importHandler.ResolveRow(c)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
@@ -43,7 +43,7 @@ func (h *OngoingContentEndpoints) Configure(server *echo.Group) {
 // @Failure 500 {object} echo.HTTPError
 // @Router /api/ongoing/nearest [get]
 func (h *OngoingContentEndpoints) GetNearestOngoings(ctx echo.Context) error {
-	ongoingContent, err := h.contentUC.GetNearestOngoings()
+	ongoingContent, err := h.contentUC.GetNearestOngoings(ctx.Request().Context())
 	switch {
 	case errors.Is(err, usecase.ErrContentNotFound):
 		return utils.NewError(ctx, http.StatusNotFound, "контент календаря релизов не найден", err)
@@ -75,7 +75,7 @@ func (h *OngoingContentEndpoints) GetOngoingContentByMonthAndYear(ctx echo.Conte
 		return utils.NewError(ctx, http.StatusBadRequest, "невалидный год", err)
 	}
 
-	ongoingContent, err := h.contentUC.GetOngoingContentByMonthAndYear(int(month), int(year))
+	ongoingContent, err := h.contentUC.GetOngoingContentByMonthAndYear(ctx.Request().Context(), int(month), int(year))
 	switch {
 	case errors.Is(err, usecase.ErrContentNotFound):
 		return utils.NewError(ctx, http.StatusNotFound, "контент календаря релизов не найден", err)
@@ -95,7 +95,7 @@ func (h *OngoingContentEndpoints) GetOngoingContentByMonthAndYear(ctx echo.Conte
 // @Failure 500 {object} echo.HTTPError
 // @Router /api/ongoing/years [get]
 func (h *OngoingContentEndpoints) GetAllReleaseYears(ctx echo.Context) error {
-	years, err := h.contentUC.GetAllOngoingsYears()
+	years, err := h.contentUC.GetAllOngoingsYears(ctx.Request().Context())
 	switch {
 	case errors.Is(err, usecase.ErrContentNotFound):
 		return utils.NewError(ctx, http.StatusNotFound, "года релизов не найдены", err)
@@ -127,12 +127,12 @@ func (h *OngoingContentEndpoints) IsReleased(ctx echo.Context) error {
 		releasedCh := make(chan bool)
 		errCh := make(chan error)
 
-		go h.contentUC.IsOngoingContentReleased(int(id), releasedCh, errCh)
+		go h.contentUC.IsOngoingContentReleased(ws.Request().Context(), int(id), releasedCh, errCh)
 
 		for {
 			select {
 			case <-releasedCh:
-				content, err := h.contentUC.GetPreviewContentByID(int(id))
+				content, err := h.contentUC.GetPreviewContentByID(ws.Request().Context(), int(id))
 				if err != nil {
 					utils.WebsocketError(ctx, err)
 					return
@@ -179,7 +179,7 @@ func (h *OngoingContentEndpoints) SetReleasedState(ctx echo.Context) error {
 		return utils.NewError(ctx, http.StatusBadRequest, "невалидное значение is_released", err)
 	}
 
-	err = h.contentUC.SetReleasedState(secretKey, int(id), isReleased)
+	err = h.contentUC.SetReleasedState(ctx.Request().Context(), secretKey, int(id), isReleased)
 	switch {
 	case errors.Is(err, usecase.ErrContentNotFound):
 		return utils.NewError(ctx, http.StatusNotFound, "контент календаря релизов не найден", err)
@@ -228,7 +228,7 @@ func (h *OngoingContentEndpoints) SubscribeOnContent(ctx echo.Context) error {
 		return utils.NewError(ctx, http.StatusUnauthorized, "Не авторизован", err)
 	}
 
-	err = h.contentUC.SubscribeOnContent(userID, int(contentID))
+	err = h.contentUC.SubscribeOnContent(ctx.Request().Context(), userID, int(contentID))
 	return subscribeResponse(ctx, err)
 }
 
@@ -255,7 +255,7 @@ func (h *OngoingContentEndpoints) UnsubscribeFromContent(ctx echo.Context) error
 		return utils.NewError(ctx, http.StatusUnauthorized, "Не авторизован", err)
 	}
 
-	err = h.contentUC.UnsubscribeFromContent(userID, int(contentID))
+	err = h.contentUC.UnsubscribeFromContent(ctx.Request().Context(), userID, int(contentID))
 	return subscribeResponse(ctx, err)
 }
 
@@ -273,7 +273,7 @@ func (h *OngoingContentEndpoints) GetSubscribedContentIDs(ctx echo.Context) erro
 		return utils.NewError(ctx, http.StatusUnauthorized, "Не авторизован", err)
 	}
 
-	subscriptions, err := h.contentUC.GetSubscribedContentIDs(userID)
+	subscriptions, err := h.contentUC.GetSubscribedContentIDs(ctx.Request().Context(), userID)
 	if err != nil {
 		return utils.NewError(ctx, http.StatusInternalServerError, "ошибка при получении подписок", err)
 	}
@@ -50,7 +50,7 @@ func TestOngoingContentEndpoints_GetNearestOngoings(t *testing.T) {
 				},
 			},
 			SetupOngoingContentUsecaseMock: func(mock *mockusecase.MockContent) {
-				mock.EXPECT().GetNearestOngoings().Return(&dto.PreviewOngoingContentList{
+				mock.EXPECT().GetNearestOngoings(gomock.Any()).Return(&dto.PreviewOngoingContentList{
 					OnGoingContentList: []*dto.PreviewContent{
 						{
 							ID:          1,
@@ -68,14 +68,14 @@ func TestOngoingContentEndpoints_GetNearestOngoings(t *testing.T) {
 			Name:        "Контент не найден",
 			ExpectedErr: &echo.HTTPError{Code: 404, Message: "контент календаря релизов не найден"},
 			SetupOngoingContentUsecaseMock: func(mock *mockusecase.MockContent) {
-				mock.EXPECT().GetNearestOngoings().Return(nil, usecase.ErrContentNotFound)
+				mock.EXPECT().GetNearestOngoings(gomock.Any()).Return(nil, usecase.ErrContentNotFound)
 			},
 		},
 		{
 			Name:        "Неожиданная ошибка",
 			ExpectedErr: &echo.HTTPError{Code: 500, Message: "ошибка при получении ближайших релизов", Internal: errors.New("123")},
 			SetupOngoingContentUsecaseMock: func(mock *mockusecase.MockContent) {
-				mock.EXPECT().GetNearestOngoings().Return(nil, errors.New("123"))
+				mock.EXPECT().GetNearestOngoings(gomock.Any()).Return(nil, errors.New("123"))
 			},
 		},
 	}
@@ -132,7 +132,7 @@ func TestOngoingContentEndpoints_GetOngoingContentByMonthAndYear(t *testing.T) {
 				},
 			},
 			SetupOngoingContentUsecaseMock: func(mock *mockusecase.MockContent) {
-				mock.EXPECT().GetOngoingContentByMonthAndYear(releaseMonth, releaseYear).Return(&dto.PreviewOngoingContentList{
+				mock.EXPECT().GetOngoingContentByMonthAndYear(gomock.Any(), releaseMonth, releaseYear).Return(&dto.PreviewOngoingContentList{
 					OnGoingContentList: []*dto.PreviewContent{
 						{
 							ID:          1,
@@ -175,7 +175,7 @@ func TestOngoingContentEndpoints_GetOngoingContentByMonthAndYear(t *testing.T) {
 				Message: "контент календаря релизов не найден",
 			},
 			SetupOngoingContentUsecaseMock: func(mock *mockusecase.MockContent) {
-				mock.EXPECT().GetOngoingContentByMonthAndYear(1, 2025).Return(nil, usecase.ErrContentNotFound)
+				mock.EXPECT().GetOngoingContentByMonthAndYear(gomock.Any(), 1, 2025).Return(nil, usecase.ErrContentNotFound)
 			},
 		},
 		{
@@ -188,7 +188,7 @@ func TestOngoingContentEndpoints_GetOngoingContentByMonthAndYear(t *testing.T) {
 				Internal: errors.New("123"),
 			},
 			SetupOngoingContentUsecaseMock: func(mock *mockusecase.MockContent) {
-				mock.EXPECT().GetOngoingContentByMonthAndYear(1, 2025).Return(nil, errors.New("123"))
+				mock.EXPECT().GetOngoingContentByMonthAndYear(gomock.Any(), 1, 2025).Return(nil, errors.New("123"))
 			},
 		},
 	}
@@ -231,7 +231,7 @@ func TestOngoingContentEndpoints_GetAllReleaseYears(t *testing.T) {
 				Years: []int{2022, 2023, 2024},
 			},
 			SetupOngoingContentUsecaseMock: func(mock *mockusecase.MockContent) {
-				mock.EXPECT().GetAllOngoingsYears().Return(&dto.ReleaseYearsResponse{
+				mock.EXPECT().GetAllOngoingsYears(gomock.Any()).Return(&dto.ReleaseYearsResponse{
 					Years: []int{2022, 2023, 2024},
 				}, nil)
 			},
@@ -243,7 +243,7 @@ func TestOngoingContentEndpoints_GetAllReleaseYears(t *testing.T) {
 				Message: "года релизов не найдены",
 			},
 			SetupOngoingContentUsecaseMock: func(mock *mockusecase.MockContent) {
-				mock.EXPECT().GetAllOngoingsYears().Return(nil, usecase.ErrContentNotFound)
+				mock.EXPECT().GetAllOngoingsYears(gomock.Any()).Return(nil, usecase.ErrContentNotFound)
 			},
 		},
 		{
@@ -254,7 +254,7 @@ func TestOngoingContentEndpoints_GetAllReleaseYears(t *testing.T) {
 				Internal: errors.New("123"),
 			},
 			SetupOngoingContentUsecaseMock: func(mock *mockusecase.MockContent) {
-				mock.EXPECT().GetAllOngoingsYears().Return(nil, errors.New("123"))
+				mock.EXPECT().GetAllOngoingsYears(gomock.Any()).Return(nil, errors.New("123"))
 			},
 		},
 	}
@@ -297,7 +297,7 @@ func TestOngoingContentEndpoints_SetReleasedState(t *testing.T) {
 			SecretKey:   "123",
 			IsReleased:  "true",
 			SetupOngoingContentUsecaseMock: func(mock *mockusecase.MockContent) {
-				mock.EXPECT().SetReleasedState("123", 1, true).Return(nil)
+				mock.EXPECT().SetReleasedState(gomock.Any(), "123", 1, true).Return(nil)
 			},
 		},
 		{
@@ -311,7 +311,7 @@ func TestOngoingContentEndpoints_SetReleasedState(t *testing.T) {
 			SecretKey:  "123",
 			IsReleased: "true",
 			SetupOngoingContentUsecaseMock: func(mock *mockusecase.MockContent) {
-				mock.EXPECT().SetReleasedState("123", 1, true).Return(errors.New("123"))
+				mock.EXPECT().SetReleasedState(gomock.Any(), "123", 1, true).Return(errors.New("123"))
 			},
 		},
 		{
@@ -346,7 +346,7 @@ func TestOngoingContentEndpoints_SetReleasedState(t *testing.T) {
 			SecretKey:  "1234",
 			IsReleased: "true",
 			SetupOngoingContentUsecaseMock: func(mock *mockusecase.MockContent) {
-				mock.EXPECT().SetReleasedState("1234", 1, true).Return(usecase.ErrContentInvalidSecretKey)
+				mock.EXPECT().SetReleasedState(gomock.Any(), "1234", 1, true).Return(usecase.ErrContentInvalidSecretKey)
 			},
 		},
 		{
@@ -359,7 +359,7 @@ func TestOngoingContentEndpoints_SetReleasedState(t *testing.T) {
 			SecretKey:  "123",
 			IsReleased: "true",
 			SetupOngoingContentUsecaseMock: func(mock *mockusecase.MockContent) {
-				mock.EXPECT().SetReleasedState("123", 1, true).Return(usecase.ErrContentNotFound)
+				mock.EXPECT().SetReleasedState(gomock.Any(), "123", 1, true).Return(usecase.ErrContentNotFound)
 			},
 		},
 	}
@@ -405,7 +405,7 @@ func TestOngoingContentEndpoints_SubscribeOnContent(t *testing.T) {
 			ID:          "1",
 			Cookies:     &http.Cookie{Name: "session", Value: "123"},
 			SetupOngoingContentUsecaseMock: func(mock *mockusecase.MockContent) {
-				mock.EXPECT().SubscribeOnContent(1, 1).Return(nil)
+				mock.EXPECT().SubscribeOnContent(gomock.Any(), 1, 1).Return(nil)
 			},
 		},
 		{
@@ -418,7 +418,7 @@ func TestOngoingContentEndpoints_SubscribeOnContent(t *testing.T) {
 			ID:      "1",
 			Cookies: &http.Cookie{Name: "session", Value: "123"},
 			SetupOngoingContentUsecaseMock: func(mock *mockusecase.MockContent) {
-				mock.EXPECT().SubscribeOnContent(1, 1).Return(errors.New("123"))
+				mock.EXPECT().SubscribeOnContent(gomock.Any(), 1, 1).Return(errors.New("123"))
 			},
 		},
 		{
@@ -430,7 +430,7 @@ func TestOngoingContentEndpoints_SubscribeOnContent(t *testing.T) {
 			ID:      "1",
 			Cookies: &http.Cookie{Name: "session", Value: "123"},
 			SetupOngoingContentUsecaseMock: func(mock *mockusecase.MockContent) {
-				mock.EXPECT().SubscribeOnContent(1, 1).Return(usecase.ErrContentNotFound)
+				mock.EXPECT().SubscribeOnContent(gomock.Any(), 1, 1).Return(usecase.ErrContentNotFound)
 			},
 		},
 		{
@@ -442,7 +442,7 @@ func TestOngoingContentEndpoints_SubscribeOnContent(t *testing.T) {
 			ID:      "1",
 			Cookies: &http.Cookie{Name: "session", Value: "123"},
 			SetupOngoingContentUsecaseMock: func(mock *mockusecase.MockContent) {
-				mock.EXPECT().SubscribeOnContent(1, 1).Return(usecase.ErrUserNotFound)
+				mock.EXPECT().SubscribeOnContent(gomock.Any(), 1, 1).Return(usecase.ErrUserNotFound)
 			},
 		},
 		{
@@ -451,7 +451,7 @@ func TestOngoingContentEndpoints_SubscribeOnContent(t *testing.T) {
 			ID:          "1",
 			Cookies:     nil,
 			SetupOngoingContentUsecaseMock: func(mock *mockusecase.MockContent) {
-				mock.EXPECT().SubscribeOnContent(1, 1).Times(0)
+				mock.EXPECT().SubscribeOnContent(gomock.Any(), 1, 1).Times(0)
 			},
 		},
 		{
@@ -460,7 +460,7 @@ func TestOngoingContentEndpoints_SubscribeOnContent(t *testing.T) {
 			ID:          "abc",
 			Cookies:     &http.Cookie{Name: "session", Value: "123"},
 			SetupOngoingContentUsecaseMock: func(mock *mockusecase.MockContent) {
-				mock.EXPECT().SubscribeOnContent(1, 1).Times(0)
+				mock.EXPECT().SubscribeOnContent(gomock.Any(), 1, 1).Times(0)
 			},
 		},
 	}
@@ -484,7 +484,7 @@ func TestOngoingContentEndpoints_SubscribeOnContent(t *testing.T) {
 			c.SetPath("/ongoing/:id/subscribe")
 			c.SetParamNames("id")
 			c.SetParamValues(tc.ID)
-			mockAuthUseCase.EXPECT().GetUserIDBySession("123").Return(1, nil).AnyTimes()
+			mockAuthUseCase.EXPECT().GetUserIDBySession(gomock.Any(), "123").Return(1, nil).AnyTimes()
 			err := ongoingContentEndpoints.SubscribeOnContent(c)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
@@ -507,7 +507,7 @@ func TestOngoingContentEndpoints_UnsubscribeFromContent(t *testing.T) {
 			ID:          "1",
 			Cookies:     &http.Cookie{Name: "session", Value: "123"},
 			SetupOngoingContentUsecaseMock: func(mock *mockusecase.MockContent) {
-				mock.EXPECT().UnsubscribeFromContent(1, 1).Return(nil)
+				mock.EXPECT().UnsubscribeFromContent(gomock.Any(), 1, 1).Return(nil)
 			},
 		},
 		{
@@ -551,7 +551,7 @@ func TestOngoingContentEndpoints_UnsubscribeFromContent(t *testing.T) {
 			c.SetPath("/ongoing/:id/subscribe")
 			c.SetParamNames("id")
 			c.SetParamValues(tc.ID)
-			mockAuthUseCase.EXPECT().GetUserIDBySession("123").Return(1, nil).AnyTimes()
+			mockAuthUseCase.EXPECT().GetUserIDBySession(gomock.Any(), "123").Return(1, nil).AnyTimes()
 			err := ongoingContentEndpoints.UnsubscribeFromContent(c)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
@@ -572,7 +572,7 @@ func TestOngoingContentEndpoints_GetSubscribedContentIDs(t *testing.T) {
 			ExpectedErr: nil,
 			Cookies:     &http.Cookie{Name: "session", Value: "123"},
 			SetupOngoingContentUsecaseMock: func(mock *mockusecase.MockContent) {
-				mock.EXPECT().GetSubscribedContentIDs(1).Return(&dto.SubscriptionsResponse{Subscriptions: []int{1}}, nil)
+				mock.EXPECT().GetSubscribedContentIDs(gomock.Any(), 1).Return(&dto.SubscriptionsResponse{Subscriptions: []int{1}}, nil)
 			},
 		},
 		{
@@ -593,7 +593,7 @@ func TestOngoingContentEndpoints_GetSubscribedContentIDs(t *testing.T) {
 			},
 			Cookies: &http.Cookie{Name: "session", Value: "123"},
 			SetupOngoingContentUsecaseMock: func(mock *mockusecase.MockContent) {
-				mock.EXPECT().GetSubscribedContentIDs(1).Return(nil, errors.New("123"))
+				mock.EXPECT().GetSubscribedContentIDs(gomock.Any(), 1).Return(nil, errors.New("123"))
 			},
 		},
 	}
@@ -615,7 +615,7 @@ func TestOngoingContentEndpoints_GetSubscribedContentIDs(t *testing.T) {
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
 			c.SetPath("/ongoing/subscribed")
-			mockAuthUseCase.EXPECT().GetUserIDBySession("123").Return(1, nil).AnyTimes()
+			mockAuthUseCase.EXPECT().GetUserIDBySession(gomock.Any(), "123").Return(1, nil).AnyTimes()
 			err := ongoingContentEndpoints.GetSubscribedContentIDs(c)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
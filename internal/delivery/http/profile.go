@@ -0,0 +1,177 @@
+package http
+
+import (
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/http/utils"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"strconv"
+)
+
+type ProfileEndpoints struct {
+	profileUC usecase.Profile
+	privacyUC usecase.Privacy
+	authUC    usecase.Auth
+}
+
+func NewProfileEndpoints(
+	profileUC usecase.Profile,
+	privacyUC usecase.Privacy,
+	authUC usecase.Auth,
+) ProfileEndpoints {
+	return ProfileEndpoints{profileUC: profileUC, privacyUC: privacyUC, authUC: authUC}
+}
+
+func (h *ProfileEndpoints) Configure(server *echo.Group) {
+	server.GET("/privacy", h.GetPrivacySettings)
+	server.PUT("/privacy", h.UpdatePrivacySettings)
+	server.GET("/:id/public", h.GetPublicProfile)
+	server.GET("/:id/stats", h.GetUserStats)
+}
+
+// checkPrivacy проверяет, виден ли раздел section профиля ownerID текущему пользователю.
+// Если раздел скрыт, возвращает ошибку 403 с сообщением hiddenMessage
+func checkPrivacy(
+	ctx echo.Context,
+	authUC usecase.Auth,
+	privacyUC usecase.Privacy,
+	ownerID int,
+	section, hiddenMessage string,
+) error {
+	// если не удалось получить id пользователя из сессии, то это не ошибка, просто неавторизованный пользователь
+	// no-lint
+	clientUserID, _ := utils.GetUserIDFromSession(ctx, authUC)
+	canView, err := privacyUC.CanView(ctx.Request().Context(), clientUserID, ownerID, section)
+	switch {
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	case !canView:
+		return utils.NewError(ctx, http.StatusForbidden, hiddenMessage, nil)
+	default:
+		return nil
+	}
+}
+
+// GetPrivacySettings
+// @Summary Настройки приватности
+// @Tags privacy
+// @Description Видимость избранного, рецензий, оценок и списков текущего пользователя
+// @Produce json
+// @Success 200 {object} dto.PrivacySettings
+// @Failure 401 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/user/privacy [get]
+func (h *ProfileEndpoints) GetPrivacySettings(ctx echo.Context) error {
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	settings, err := h.privacyUC.GetSettings(ctx.Request().Context(), userID)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	}
+	return utils.WriteJSON(ctx, settings)
+}
+
+// UpdatePrivacySettings
+// @Summary Изменить настройки приватности
+// @Tags privacy
+// @Description Изменяет видимость разделов профиля. public - видно всем, followers - только подписчикам,
+// private - только владельцу
+// @Accept json
+// @Produce json
+// @Param settings body dto.PrivacySettings true "Настройки приватности"
+// @Success 200 {object} dto.PrivacySettings
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/user/privacy [put]
+// @Security _csrf
+func (h *ProfileEndpoints) UpdatePrivacySettings(ctx echo.Context) error {
+	settingsRequest := new(dto.PrivacySettings)
+	if err := utils.ReadJSON(ctx, settingsRequest); err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный запрос", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	settings, err := h.privacyUC.UpdateSettings(ctx.Request().Context(), userID, *settingsRequest)
+	var privacyErr usecase.PrivacyErrorIncorrectData
+	switch {
+	case errors.Is(err, usecase.ErrPrivacyUserNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Пользователь не найден", err)
+	case errors.As(err, &privacyErr):
+		return utils.NewError(ctx, http.StatusBadRequest, privacyErr.Error(), err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return utils.WriteJSON(ctx, settings)
+	}
+}
+
+// GetPublicProfile
+// @Summary Публичный профиль
+// @Tags privacy
+// @Description Профиль пользователя без почты: счетчики, последние рецензии и любимые жанры. Поля разделов,
+// скрытых настройками приватности, не возвращаются
+// @Produce json
+// @Param id path int true "ID пользователя"
+// @Success 200 {object} dto.PublicProfile
+// @Failure 400 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/user/{id}/public [get]
+func (h *ProfileEndpoints) GetPublicProfile(ctx echo.Context) error {
+	userID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id пользователя", nil)
+	}
+	// если не удалось получить id пользователя из сессии, то это не ошибка, просто неавторизованный пользователь
+	// no-lint
+	clientUserID, _ := utils.GetUserIDFromSession(ctx, h.authUC)
+	profile, err := h.profileUC.GetPublicProfile(ctx.Request().Context(), clientUserID, int(userID))
+	switch {
+	case errors.Is(err, usecase.ErrProfileUserNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Пользователь не найден", err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return utils.WriteJSON(ctx, profile)
+	}
+}
+
+// GetUserStats
+// @Summary Статистика пользователя
+// @Tags privacy
+// @Description Число контента по категориям избранного, время просмотра в минутах, любимые жанры, страны,
+// режиссеры и актеры, средняя оценка пользователя в сравнении с рейтингом сайта и активность по месяцам за год.
+// Просмотренный контент виден вместе с избранным, средняя оценка учитывает только видимые оценки и рецензии
+// @Produce json
+// @Param id path int true "ID пользователя"
+// @Success 200 {object} dto.UserStats
+// @Failure 400 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/user/{id}/stats [get]
+func (h *ProfileEndpoints) GetUserStats(ctx echo.Context) error {
+	userID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id пользователя", nil)
+	}
+	// если не удалось получить id пользователя из сессии, то это не ошибка, просто неавторизованный пользователь
+	// no-lint
+	clientUserID, _ := utils.GetUserIDFromSession(ctx, h.authUC)
+	stats, err := h.profileUC.GetUserStats(ctx.Request().Context(), clientUserID, int(userID))
+	switch {
+	case errors.Is(err, usecase.ErrProfileUserNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Пользователь не найден", err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return utils.WriteJSON(ctx, stats)
+	}
+}
@@ -0,0 +1,227 @@
+package http
+
+import (
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	mockusecase "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestProfileEndpoints_GetPublicProfile(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                    string
+		UserID                  string
+		ExpectedErr             error
+		SetupProfileUsecaseMock func(uc *mockusecase.MockProfile)
+	}{
+		{
+			Name:        "Успешное получение",
+			UserID:      "1",
+			ExpectedErr: nil,
+			SetupProfileUsecaseMock: func(uc *mockusecase.MockProfile) {
+				uc.EXPECT().GetPublicProfile(gomock.Any(), -1, 1).Return(&dto.PublicProfile{ID: 1}, nil)
+			},
+		},
+		{
+			Name:        "Пользователь не найден",
+			UserID:      "1",
+			ExpectedErr: &echo.HTTPError{Code: 404, Message: "Пользователь не найден"},
+			SetupProfileUsecaseMock: func(uc *mockusecase.MockProfile) {
+				uc.EXPECT().GetPublicProfile(gomock.Any(), -1, 1).Return(nil, usecase.ErrProfileUserNotFound)
+			},
+		},
+		{
+			Name:                    "Невалидный id",
+			UserID:                  "abc",
+			ExpectedErr:             &echo.HTTPError{Code: 400, Message: "Невалидный id пользователя"},
+			SetupProfileUsecaseMock: func(uc *mockusecase.MockProfile) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockProfileUsecase := mockusecase.NewMockProfile(ctrl)
+			tc.SetupProfileUsecaseMock(mockProfileUsecase)
+			profileHandler := NewProfileEndpoints(mockProfileUsecase, nil, nil)
+			req := httptest.NewRequest(http.MethodGet, "/user/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/user/:id/public")
+			c.SetParamNames("id")
+			c.SetParamValues(tc.UserID)
+			err := profileHandler.GetPublicProfile(c)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestProfileEndpoints_UpdatePrivacySettings(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                    string
+		Body                    string
+		ExpectedErr             error
+		SetupPrivacyUsecaseMock func(uc *mockusecase.MockPrivacy)
+	}{
+		{
+			Name:        "Успешное изменение",
+			Body:        `{"favourites":"private","reviews":"public","ratings":"followers","lists":"public"}`,
+			ExpectedErr: nil,
+			SetupPrivacyUsecaseMock: func(uc *mockusecase.MockPrivacy) {
+				settings := dto.PrivacySettings{
+					Favourites: "private",
+					Reviews:    "public",
+					Ratings:    "followers",
+					Lists:      "public",
+				}
+				uc.EXPECT().UpdateSettings(gomock.Any(), 1, settings).Return(&settings, nil)
+			},
+		},
+		{
+			Name:        "Неизвестный уровень",
+			Body:        `{"favourites":"friends","reviews":"public","ratings":"public","lists":"public"}`,
+			ExpectedErr: &echo.HTTPError{Code: 400, Message: "уровень видимости должен быть public, followers или private"},
+			SetupPrivacyUsecaseMock: func(uc *mockusecase.MockPrivacy) {
+				uc.EXPECT().UpdateSettings(gomock.Any(), 1, gomock.Any()).Return(nil, usecase.PrivacyErrorIncorrectData{
+					Err: errors.New("уровень видимости должен быть public, followers или private"),
+				})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPrivacyUsecase := mockusecase.NewMockPrivacy(ctrl)
+			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
+			mockAuthUsecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			tc.SetupPrivacyUsecaseMock(mockPrivacyUsecase)
+			profileHandler := NewProfileEndpoints(nil, mockPrivacyUsecase, mockAuthUsecase)
+			req := httptest.NewRequest(http.MethodPut, "/user/privacy", strings.NewReader(tc.Body))
+			req.AddCookie(&http.Cookie{Name: "session", Value: "xxx"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			err := profileHandler.UpdatePrivacySettings(c)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestCheckPrivacy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		CanView     bool
+		ExpectedErr error
+	}{
+		{
+			Name:        "Раздел открыт",
+			CanView:     true,
+			ExpectedErr: nil,
+		},
+		{
+			Name:        "Раздел скрыт",
+			CanView:     false,
+			ExpectedErr: &echo.HTTPError{Code: 403, Message: "Пользователь скрыл избранное"},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPrivacyUsecase := mockusecase.NewMockPrivacy(ctrl)
+			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
+			mockAuthUsecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(2, nil)
+			mockPrivacyUsecase.EXPECT().CanView(gomock.Any(), 2, 1, entity.PrivacySectionFavourites).
+				Return(tc.CanView, nil)
+			req := httptest.NewRequest(http.MethodGet, "/favourite/1", nil)
+			req.AddCookie(&http.Cookie{Name: "session", Value: "xxx"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			err := checkPrivacy(c, mockAuthUsecase, mockPrivacyUsecase, 1, entity.PrivacySectionFavourites,
+				"Пользователь скрыл избранное")
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestProfileEndpoints_GetUserStats(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                    string
+		UserID                  string
+		ExpectedErr             error
+		SetupProfileUsecaseMock func(uc *mockusecase.MockProfile)
+	}{
+		{
+			Name:        "Успешное получение",
+			UserID:      "1",
+			ExpectedErr: nil,
+			SetupProfileUsecaseMock: func(uc *mockusecase.MockProfile) {
+				uc.EXPECT().GetUserStats(gomock.Any(), -1, 1).
+					Return(&dto.UserStats{Activity: []dto.MonthActivity{}}, nil)
+			},
+		},
+		{
+			Name:        "Пользователь не найден",
+			UserID:      "1",
+			ExpectedErr: &echo.HTTPError{Code: 404, Message: "Пользователь не найден"},
+			SetupProfileUsecaseMock: func(uc *mockusecase.MockProfile) {
+				uc.EXPECT().GetUserStats(gomock.Any(), -1, 1).Return(nil, usecase.ErrProfileUserNotFound)
+			},
+		},
+		{
+			Name:                    "Невалидный id",
+			UserID:                  "abc",
+			ExpectedErr:             &echo.HTTPError{Code: 400, Message: "Невалидный id пользователя"},
+			SetupProfileUsecaseMock: func(uc *mockusecase.MockProfile) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockProfileUsecase := mockusecase.NewMockProfile(ctrl)
+			tc.SetupProfileUsecaseMock(mockProfileUsecase)
+			profileHandler := NewProfileEndpoints(mockProfileUsecase, nil, nil)
+			req := httptest.NewRequest(http.MethodGet, "/user/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/user/:id/stats")
+			c.SetParamNames("id")
+			c.SetParamValues(tc.UserID)
+			err := profileHandler.GetUserStats(c)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package http
+
+import (
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/http/utils"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"strconv"
+)
+
+const (
+	// количество рекомендаций по умолчанию
+	recommendationsDefaultLimit = 20
+	recommendationsMaxLimit     = 50
+)
+
+type RecommendationEndpoints struct {
+	recommendationUC usecase.Recommendation
+	authUC           usecase.Auth
+}
+
+func NewRecommendationEndpoints(recommendationUC usecase.Recommendation, authUC usecase.Auth) RecommendationEndpoints {
+	return RecommendationEndpoints{recommendationUC: recommendationUC, authUC: authUC}
+}
+
+func (h *RecommendationEndpoints) Configure(server *echo.Group) {
+	server.GET("", h.GetRecommendations)
+}
+
+// GetRecommendations
+// @Summary Персональные рекомендации
+// @Tags recommendation
+// @Description Контент, похожий на тот, который понравился текущему пользователю или был им просмотрен, с
+// объяснением, из-за чего он рекомендован. Контент из избранного, оцененный или с рецензией пользователя не
+// рекомендуется. Похожий контент пересчитывается фоновой задачей, поэтому новые оценки учитываются не сразу
+// @Produce json
+// @Param limit query int false "Количество рекомендаций, от 1 до 50, по умолчанию 20"
+// @Success 200 {object} dto.Recommendations
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/recommendations [get]
+func (h *RecommendationEndpoints) GetRecommendations(ctx echo.Context) error {
+	limit := recommendationsDefaultLimit
+	if limitParam := ctx.QueryParam("limit"); limitParam != "" {
+		var err error
+		if limit, err = strconv.Atoi(limitParam); err != nil || limit < 1 || limit > recommendationsMaxLimit {
+			return utils.NewError(ctx, http.StatusBadRequest, "Невалидный параметр limit", nil)
+		}
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	recommendations, err := h.recommendationUC.GetRecommendations(ctx.Request().Context(), userID, limit)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	}
+	return utils.WriteJSON(ctx, recommendations)
+}
@@ -0,0 +1,84 @@
+package http
+
+import (
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	mockusecase "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRecommendationEndpoints_GetRecommendations(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                           string
+		Query                          string
+		Cookie                         *http.Cookie
+		ExpectedErr                    error
+		SetupRecommendationUsecaseMock func(uc *mockusecase.MockRecommendation)
+		SetupAuthUsecaseMock           func(uc *mockusecase.MockAuth)
+	}{
+		{
+			Name:   "Успешное получение",
+			Cookie: &http.Cookie{Name: "session", Value: "xxx"},
+			SetupRecommendationUsecaseMock: func(uc *mockusecase.MockRecommendation) {
+				uc.EXPECT().GetRecommendations(gomock.Any(), 1, 20).Return(&dto.Recommendations{}, nil)
+			},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			},
+		},
+		{
+			Name:   "Указанное количество",
+			Query:  "?limit=5",
+			Cookie: &http.Cookie{Name: "session", Value: "xxx"},
+			SetupRecommendationUsecaseMock: func(uc *mockusecase.MockRecommendation) {
+				uc.EXPECT().GetRecommendations(gomock.Any(), 1, 5).Return(&dto.Recommendations{}, nil)
+			},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			},
+		},
+		{
+			Name:                           "Неавторизованный пользователь",
+			ExpectedErr:                    &echo.HTTPError{Code: 401, Message: "Для этой операции нужно авторизоваться"},
+			SetupRecommendationUsecaseMock: func(uc *mockusecase.MockRecommendation) {},
+			SetupAuthUsecaseMock:           func(uc *mockusecase.MockAuth) {},
+		},
+		{
+			Name:                           "Слишком много рекомендаций",
+			Query:                          "?limit=100",
+			Cookie:                         &http.Cookie{Name: "session", Value: "xxx"},
+			ExpectedErr:                    &echo.HTTPError{Code: 400, Message: "Невалидный параметр limit"},
+			SetupRecommendationUsecaseMock: func(uc *mockusecase.MockRecommendation) {},
+			SetupAuthUsecaseMock:           func(uc *mockusecase.MockAuth) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRecommendationUsecase := mockusecase.NewMockRecommendation(ctrl)
+			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
+			tc.SetupRecommendationUsecaseMock(mockRecommendationUsecase)
+			tc.SetupAuthUsecaseMock(mockAuthUsecase)
+			recommendationHandler := NewRecommendationEndpoints(mockRecommendationUsecase, mockAuthUsecase)
+			req := httptest.NewRequest(http.MethodGet, "/recommendations"+tc.Query, nil)
+			if tc.Cookie != nil {
+				req.AddCookie(tc.Cookie)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			err := recommendationHandler.GetRecommendations(c)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
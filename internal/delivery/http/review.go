@@ -3,6 +3,7 @@ package http
 import (
 	"errors"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/http/utils"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
 	"github.com/labstack/echo/v4"
@@ -11,16 +12,18 @@ import (
 )
 
 type ReviewEndpoints struct {
-	reviewUC usecase.Review
-	authUC   usecase.Auth
+	reviewUC  usecase.Review
+	authUC    usecase.Auth
+	privacyUC usecase.Privacy
 }
 
-func NewReviewEndpoints(reviewUC usecase.Review, authUC usecase.Auth) ReviewEndpoints {
-	return ReviewEndpoints{reviewUC: reviewUC, authUC: authUC}
+func NewReviewEndpoints(reviewUC usecase.Review, authUC usecase.Auth, privacyUC usecase.Privacy) ReviewEndpoints {
+	return ReviewEndpoints{reviewUC: reviewUC, authUC: authUC, privacyUC: privacyUC}
 }
 
 func (h *ReviewEndpoints) Configure(server *echo.Group) {
 	server.GET("/:id", h.GetReview)
+	server.GET("/:id/history", h.GetReviewHistory)
 	server.GET("/myReview", h.GetMyContentReview)
 	server.POST("", h.CreateReview)
 	server.PUT("", h.UpdateReview)
@@ -28,11 +31,34 @@ func (h *ReviewEndpoints) Configure(server *echo.Group) {
 	server.GET("/recent", h.GetRecentReviews)
 	server.GET("/user/:id/recent", h.GetUserLatestReviews)
 	server.GET("/user/:id/:page", h.GetUserReviews)
+	server.GET("/content/:id/rating", h.GetContentRatingStats)
 	server.GET("/content/:id/:page", h.GetContentReviews)
 	server.PUT("/:id/vote", h.VoteReview)
 	server.DELETE("/:id/like", h.UnVoteReview)
 }
 
+// readReviewFilter читает из query-параметров режим сортировки и условия выборки рецензий для списков.
+// Корректность режима сортировки и тональности проверяется в usecase
+func readReviewFilter(ctx echo.Context) (dto.ReviewFilter, error) {
+	filter := dto.ReviewFilter{
+		Sort:      ctx.QueryParam("sort"),
+		Sentiment: ctx.QueryParam("sentiment"),
+	}
+	if hideSpoilers := ctx.QueryParam("hide_spoilers"); hideSpoilers != "" {
+		var err error
+		if filter.HideSpoilers, err = strconv.ParseBool(hideSpoilers); err != nil {
+			return dto.ReviewFilter{}, errors.New("невалидный параметр hide_spoilers")
+		}
+	}
+	if minLength := ctx.QueryParam("min_length"); minLength != "" {
+		var err error
+		if filter.MinTextLength, err = strconv.Atoi(minLength); err != nil || filter.MinTextLength < 0 {
+			return dto.ReviewFilter{}, errors.New("невалидный параметр min_length")
+		}
+	}
+	return filter, nil
+}
+
 // GetReview
 // @Summary Получить рецензию
 // @Tags review
@@ -42,6 +68,7 @@ func (h *ReviewEndpoints) Configure(server *echo.Group) {
 // @Param id path int true "ID рецензии"
 // @Success 200 {object} dto.ReviewResponse
 // @Failure 400 {object} echo.HTTPError
+// @Failure 403 {object} echo.HTTPError
 // @Failure 404 {object} echo.HTTPError
 // @Failure 500 {object} echo.HTTPError
 // @Router /api/review/{id} [get]
@@ -50,14 +77,82 @@ func (h *ReviewEndpoints) GetReview(ctx echo.Context) error {
 	if err != nil {
 		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id рецензии", err)
 	}
-	review, err := h.reviewUC.GetReview(int(id))
+	// неавторизованный пользователь не видит скрытые модератором рецензии
+	viewerID, _ := utils.GetUserIDFromSession(ctx, h.authUC)
+	review, err := h.reviewUC.GetReview(ctx.Request().Context(), int(id), viewerID)
+	switch {
+	case errors.Is(err, usecase.ErrReviewNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Рецензия не найдена", err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	}
+	err = checkPrivacy(ctx, h.authUC, h.privacyUC, review.AuthorID, entity.PrivacySectionReviews,
+		"Пользователь скрыл рецензии")
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(ctx, review)
+}
+
+// GetReviewHistory
+// @Summary Получить историю правок рецензии
+// @Tags review
+// @Description Получить рецензию и ее предыдущие версии, начиная с первоначальной
+// @Produce json
+// @Param id path int true "ID рецензии"
+// @Success 200 {object} dto.ReviewHistory
+// @Failure 400 {object} echo.HTTPError
+// @Failure 403 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/review/{id}/history [get]
+func (h *ReviewEndpoints) GetReviewHistory(ctx echo.Context) error {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id рецензии", err)
+	}
+	// неавторизованный пользователь не видит скрытые модератором рецензии
+	viewerID, _ := utils.GetUserIDFromSession(ctx, h.authUC)
+	history, err := h.reviewUC.GetReviewHistory(ctx.Request().Context(), int(id), viewerID)
 	switch {
 	case errors.Is(err, usecase.ErrReviewNotFound):
 		return utils.NewError(ctx, http.StatusNotFound, "Рецензия не найдена", err)
 	case err != nil:
 		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	}
+	err = checkPrivacy(ctx, h.authUC, h.privacyUC, history.Review.AuthorID, entity.PrivacySectionReviews,
+		"Пользователь скрыл рецензии")
+	if err != nil {
+		return err
+	}
+	return utils.WriteJSON(ctx, history)
+}
+
+// GetContentRatingStats
+// @Summary Распределение оценок контента
+// @Tags review
+// @Description Число оценок от 1 до 10, средняя оценка, медиана и взвешенная оценка контента. Учитываются оценки
+// рецензий, кроме скрытых модераторами, и оценки без рецензии
+// @Produce json
+// @Param id path int true "ID контента"
+// @Success 200 {object} dto.ContentRatingStats
+// @Failure 400 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/review/content/{id}/rating [get]
+func (h *ReviewEndpoints) GetContentRatingStats(ctx echo.Context) error {
+	id, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id контента", err)
+	}
+	stats, err := h.reviewUC.GetContentRatingStats(ctx.Request().Context(), int(id))
+	switch {
+	case errors.Is(err, usecase.ErrContentNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Контент не найден", err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
 	default:
-		return utils.WriteJSON(ctx, review)
+		return utils.WriteJSON(ctx, stats)
 	}
 }
 
@@ -82,7 +177,7 @@ func (h *ReviewEndpoints) GetMyContentReview(ctx echo.Context) error {
 	if err != nil {
 		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
 	}
-	reviews, err := h.reviewUC.GetContentReviewByAuthor(userID, int(contentID))
+	reviews, err := h.reviewUC.GetContentReviewByAuthor(ctx.Request().Context(), userID, int(contentID))
 	switch {
 	case errors.Is(err, usecase.ErrReviewNotFound):
 		return utils.NewError(ctx, http.StatusNotFound, "Рецензия не найдена", err)
@@ -96,7 +191,9 @@ func (h *ReviewEndpoints) GetMyContentReview(ctx echo.Context) error {
 // CreateReview
 // @Summary Создать рецензию
 // @Tags review
-// @Description Создать рецензию
+// @Description Создать рецензию. Спойлеры в тексте размечаются тегами [spoiler]...[/spoiler], в ответе текст
+// возвращается без тегов, а их диапазоны - в поле spoilers. Рецензия с размеченными спойлерами автоматически
+// отмечается как содержащая спойлеры
 // @Accept json
 // @Produce json
 // @Param reviewCreate body dto.ReviewCreateRequest true "Данные для создания рецензии"
@@ -118,7 +215,7 @@ func (h *ReviewEndpoints) CreateReview(ctx echo.Context) error {
 	if err != nil {
 		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
 	}
-	review, err := h.reviewUC.CreateReview(dto.ReviewCreate{
+	review, err := h.reviewUC.CreateReview(ctx.Request().Context(), dto.ReviewCreate{
 		ReviewCreateRequest: *reviewCreate,
 		UserID:              userID,
 	})
@@ -140,7 +237,7 @@ func (h *ReviewEndpoints) CreateReview(ctx echo.Context) error {
 // UpdateReview
 // @Summary Обновить рецензию
 // @Tags review
-// @Description Обновить рецензию
+// @Description Обновить рецензию. Спойлеры в тексте размечаются тегами [spoiler]...[/spoiler]
 // @Accept json
 // @Produce json
 // @Param reviewUpdate body dto.ReviewUpdateRequest true "Данные для обновления рецензии"
@@ -162,7 +259,7 @@ func (h *ReviewEndpoints) UpdateReview(ctx echo.Context) error {
 	if err != nil {
 		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
 	}
-	review, err := h.reviewUC.EditReview(dto.ReviewUpdate{
+	review, err := h.reviewUC.EditReview(ctx.Request().Context(), dto.ReviewUpdate{
 		ReviewUpdateRequest: *reviewUpdate,
 		UserID:              userID,
 	})
@@ -204,7 +301,7 @@ func (h *ReviewEndpoints) DeleteReview(ctx echo.Context) error {
 	if err != nil {
 		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
 	}
-	err = h.reviewUC.DeleteReview(int(id), userID)
+	err = h.reviewUC.DeleteReview(ctx.Request().Context(), int(id), userID)
 	switch {
 	case errors.Is(err, usecase.ErrReviewNotFound):
 		return utils.NewError(ctx, http.StatusNotFound, "Рецензия не найдена", err)
@@ -222,11 +319,19 @@ func (h *ReviewEndpoints) DeleteReview(ctx echo.Context) error {
 // @Tags review
 // @Description Получить последние рецензии
 // @Produce json
+// @Param hide_spoilers query bool false "Не показывать рецензии со спойлерами"
+// @Param sentiment query string false "Тональность: positive (оценка 7-10), neutral (5-6), negative (1-4)"
+// @Param min_length query int false "Минимальная длина текста рецензии без учета разметки спойлеров"
 // @Success 200 {object} dto.ReviewResponseList
+// @Failure 400 {object} echo.HTTPError
 // @Failure 500 {object} echo.HTTPError
 // @Router /api/review/recent [get]
 func (h *ReviewEndpoints) GetRecentReviews(ctx echo.Context) error {
-	reviews, err := h.reviewUC.GetLatestReviews(3)
+	filter, err := readReviewFilter(ctx)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, err.Error(), nil)
+	}
+	reviews, err := h.reviewUC.GetLatestReviews(ctx.Request().Context(), 3, filter)
 	if err != nil {
 		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
 	}
@@ -239,8 +344,12 @@ func (h *ReviewEndpoints) GetRecentReviews(ctx echo.Context) error {
 // @Description Получить последние рецензии пользователя
 // @Produce json
 // @Param id path int true "ID пользователя"
+// @Param hide_spoilers query bool false "Не показывать рецензии со спойлерами"
+// @Param sentiment query string false "Тональность: positive (оценка 7-10), neutral (5-6), negative (1-4)"
+// @Param min_length query int false "Минимальная длина текста рецензии без учета разметки спойлеров"
 // @Success 200 {object} dto.ReviewResponseList
 // @Failure 400 {object} echo.HTTPError
+// @Failure 403 {object} echo.HTTPError
 // @Failure 500 {object} echo.HTTPError
 // @Router /api/review/user/{id}/recent [get]
 func (h *ReviewEndpoints) GetUserLatestReviews(ctx echo.Context) error {
@@ -248,7 +357,16 @@ func (h *ReviewEndpoints) GetUserLatestReviews(ctx echo.Context) error {
 	if err != nil {
 		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id пользователя", err)
 	}
-	reviews, err := h.reviewUC.GetUserReviews(int(userID), 3, 1)
+	filter, err := readReviewFilter(ctx)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, err.Error(), nil)
+	}
+	err = checkPrivacy(ctx, h.authUC, h.privacyUC, int(userID), entity.PrivacySectionReviews,
+		"Пользователь скрыл рецензии")
+	if err != nil {
+		return err
+	}
+	reviews, err := h.reviewUC.GetUserReviews(ctx.Request().Context(), int(userID), 3, 1, filter)
 	if err != nil {
 		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
 	}
@@ -262,8 +380,13 @@ func (h *ReviewEndpoints) GetUserLatestReviews(ctx echo.Context) error {
 // @Produce json
 // @Param id path int true "ID пользователя"
 // @Param page path int true "Номер страницы"
+// @Param hide_spoilers query bool false "Не показывать рецензии со спойлерами"
+// @Param sort query string false "Сортировка: newest, oldest, helpful, controversial, highest, lowest"
+// @Param sentiment query string false "Тональность: positive (оценка 7-10), neutral (5-6), negative (1-4)"
+// @Param min_length query int false "Минимальная длина текста рецензии без учета разметки спойлеров"
 // @Success 200 {object} dto.UserReviewResponseList
 // @Failure 400 {object} echo.HTTPError
+// @Failure 403 {object} echo.HTTPError
 // @Failure 500 {object} echo.HTTPError
 // @Router /api/review/user/{id}/{page} [get]
 func (h *ReviewEndpoints) GetUserReviews(ctx echo.Context) error {
@@ -278,14 +401,28 @@ func (h *ReviewEndpoints) GetUserReviews(ctx echo.Context) error {
 	if err != nil {
 		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный номер страницы", nil)
 	}
-	reviews, err := h.reviewUC.GetUserReviews(int(userID), 10, int(page))
+	filter, err := readReviewFilter(ctx)
 	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, err.Error(), nil)
+	}
+	err = checkPrivacy(ctx, h.authUC, h.privacyUC, int(userID), entity.PrivacySectionReviews,
+		"Пользователь скрыл рецензии")
+	if err != nil {
+		return err
+	}
+	reviews, err := h.reviewUC.GetUserReviews(ctx.Request().Context(), int(userID), 10, int(page), filter)
+	var reviewErr usecase.ReviewErrorIncorrectData
+	switch {
+	case errors.As(err, &reviewErr):
+		return utils.NewError(ctx, http.StatusBadRequest, reviewErr.Error(), err)
+	case err != nil:
 		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return utils.WriteJSON(ctx, dto.UserReviewResponseList{
+			ReviewResponseList: *reviews,
+			Me:                 clientUserID == int(userID),
+		})
 	}
-	return utils.WriteJSON(ctx, dto.UserReviewResponseList{
-		ReviewResponseList: *reviews,
-		Me:                 clientUserID == int(userID),
-	})
 }
 
 // GetContentReviews
@@ -295,6 +432,10 @@ func (h *ReviewEndpoints) GetUserReviews(ctx echo.Context) error {
 // @Produce json
 // @Param id path int true "ID контента"
 // @Param page path int true "Номер страницы"
+// @Param hide_spoilers query bool false "Не показывать рецензии со спойлерами"
+// @Param sort query string false "Сортировка: newest, oldest, helpful, controversial, highest, lowest"
+// @Param sentiment query string false "Тональность: positive (оценка 7-10), neutral (5-6), negative (1-4)"
+// @Param min_length query int false "Минимальная длина текста рецензии без учета разметки спойлеров"
 // @Success 200 {object} dto.ReviewResponseList
 // @Failure 400 {object} echo.HTTPError
 // @Failure 500 {object} echo.HTTPError
@@ -308,11 +449,20 @@ func (h *ReviewEndpoints) GetContentReviews(ctx echo.Context) error {
 	if err != nil || page < 1 {
 		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный номер страницы", nil)
 	}
-	reviews, err := h.reviewUC.GetContentReviews(int(contentID), 10, int(page))
+	filter, err := readReviewFilter(ctx)
 	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, err.Error(), nil)
+	}
+	reviews, err := h.reviewUC.GetContentReviews(ctx.Request().Context(), int(contentID), 10, int(page), filter)
+	var reviewErr usecase.ReviewErrorIncorrectData
+	switch {
+	case errors.As(err, &reviewErr):
+		return utils.NewError(ctx, http.StatusBadRequest, reviewErr.Error(), err)
+	case err != nil:
 		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return utils.WriteJSON(ctx, reviews)
 	}
-	return utils.WriteJSON(ctx, reviews)
 }
 
 // VoteReview
@@ -341,7 +491,7 @@ func (h *ReviewEndpoints) VoteReview(ctx echo.Context) error {
 	if err != nil {
 		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
 	}
-	err = h.reviewUC.VoteReview(userID, int(reviewID), vote)
+	err = h.reviewUC.VoteReview(ctx.Request().Context(), userID, int(reviewID), vote)
 	switch {
 	case errors.Is(err, usecase.ErrReviewNotFound):
 		return utils.NewError(ctx, http.StatusNotFound, "Рецензия не найдена", err)
@@ -376,7 +526,7 @@ func (h *ReviewEndpoints) UnVoteReview(ctx echo.Context) error {
 	if err != nil {
 		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
 	}
-	err = h.reviewUC.UnVoteReview(userID, int(reviewID))
+	err = h.reviewUC.UnVoteReview(ctx.Request().Context(), userID, int(reviewID))
 	switch {
 	case errors.Is(err, usecase.ErrReviewVoteNotFound):
 		return utils.NewError(ctx, http.StatusNotFound, "Голос не найден", err)
@@ -0,0 +1,193 @@
+package http
+
+import (
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/http/utils"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"strconv"
+)
+
+type ReviewCommentEndpoints struct {
+	commentUC usecase.ReviewComment
+	authUC    usecase.Auth
+}
+
+func NewReviewCommentEndpoints(commentUC usecase.ReviewComment, authUC usecase.Auth) ReviewCommentEndpoints {
+	return ReviewCommentEndpoints{commentUC: commentUC, authUC: authUC}
+}
+
+func (h *ReviewCommentEndpoints) Configure(server *echo.Group) {
+	server.GET("/:id/comments/:page", h.GetReviewComments)
+	server.POST("/:id/comments", h.CreateComment)
+	server.PUT("/comment/:id", h.UpdateComment)
+	server.DELETE("/comment/:id", h.DeleteComment)
+}
+
+// GetReviewComments
+// @Summary Получить комментарии к рецензии
+// @Tags review
+// @Description Получить комментарии верхнего уровня к рецензии вместе с ответами на них
+// @Produce json
+// @Param id path int true "ID рецензии"
+// @Param page path int true "Номер страницы"
+// @Success 200 {object} dto.ReviewCommentResponseList
+// @Failure 400 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/review/{id}/comments/{page} [get]
+func (h *ReviewCommentEndpoints) GetReviewComments(ctx echo.Context) error {
+	reviewID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id рецензии", nil)
+	}
+	page, err := strconv.ParseInt(ctx.Param("page"), 10, 64)
+	if err != nil || page < 1 {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный номер страницы", nil)
+	}
+	// неавторизованный пользователь не видит комментарии к скрытым модератором рецензиям
+	viewerID, _ := utils.GetUserIDFromSession(ctx, h.authUC)
+	comments, err := h.commentUC.GetReviewComments(ctx.Request().Context(), int(reviewID), viewerID, 10, int(page))
+	switch {
+	case errors.Is(err, usecase.ErrReviewNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Рецензия не найдена", err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return utils.WriteJSON(ctx, comments)
+	}
+}
+
+// CreateComment
+// @Summary Оставить комментарий к рецензии
+// @Tags review
+// @Description Оставить комментарий к рецензии или ответить на комментарий верхнего уровня
+// @Accept json
+// @Produce json
+// @Param id path int true "ID рецензии"
+// @Param commentCreate body dto.ReviewCommentCreateRequest true "Данные для создания комментария"
+// @Success 200 {object} dto.ReviewCommentResponse
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/review/{id}/comments [post]
+// @Security _csrf
+func (h *ReviewCommentEndpoints) CreateComment(ctx echo.Context) error {
+	reviewID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id рецензии", nil)
+	}
+	commentCreate := new(dto.ReviewCommentCreateRequest)
+	if err = utils.ReadJSON(ctx, commentCreate); err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный запрос", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	comment, err := h.commentUC.CreateComment(ctx.Request().Context(), dto.ReviewCommentCreate{
+		ReviewCommentCreateRequest: *commentCreate,
+		ReviewID:                   int(reviewID),
+		UserID:                     userID,
+	})
+	var reviewErr usecase.ReviewErrorIncorrectData
+	switch {
+	case errors.Is(err, usecase.ErrReviewNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Рецензия не найдена", err)
+	case errors.Is(err, usecase.ErrReviewCommentNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Комментарий не найден", err)
+	case errors.As(err, &reviewErr):
+		return utils.NewError(ctx, http.StatusBadRequest, reviewErr.Error(), err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return utils.WriteJSON(ctx, comment)
+	}
+}
+
+// UpdateComment
+// @Summary Изменить комментарий
+// @Tags review
+// @Description Изменить текст своего комментария
+// @Accept json
+// @Produce json
+// @Param id path int true "ID комментария"
+// @Param commentUpdate body dto.ReviewCommentUpdateRequest true "Данные для обновления комментария"
+// @Success 200 {object} dto.ReviewCommentResponse
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 403 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/review/comment/{id} [put]
+// @Security _csrf
+func (h *ReviewCommentEndpoints) UpdateComment(ctx echo.Context) error {
+	commentID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id комментария", nil)
+	}
+	commentUpdate := new(dto.ReviewCommentUpdateRequest)
+	if err = utils.ReadJSON(ctx, commentUpdate); err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный запрос", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	comment, err := h.commentUC.EditComment(ctx.Request().Context(), dto.ReviewCommentUpdate{
+		ReviewCommentUpdateRequest: *commentUpdate,
+		CommentID:                  int(commentID),
+		UserID:                     userID,
+	})
+	var reviewErr usecase.ReviewErrorIncorrectData
+	switch {
+	case errors.Is(err, usecase.ErrReviewCommentNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Комментарий не найден", err)
+	case errors.Is(err, usecase.ErrReviewCommentForbidden):
+		return utils.NewError(ctx, http.StatusForbidden, "Недостаточно прав для выполнения операции", err)
+	case errors.As(err, &reviewErr):
+		return utils.NewError(ctx, http.StatusBadRequest, reviewErr.Error(), err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return utils.WriteJSON(ctx, comment)
+	}
+}
+
+// DeleteComment
+// @Summary Удалить комментарий
+// @Tags review
+// @Description Удалить свой комментарий. Ответы на него удаляются вместе с ним
+// @Param id path int true "ID комментария"
+// @Success 200
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 403 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/review/comment/{id} [delete]
+// @Security _csrf
+func (h *ReviewCommentEndpoints) DeleteComment(ctx echo.Context) error {
+	commentID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id комментария", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	err = h.commentUC.DeleteComment(ctx.Request().Context(), int(commentID), userID)
+	switch {
+	case errors.Is(err, usecase.ErrReviewCommentNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Комментарий не найден", err)
+	case errors.Is(err, usecase.ErrReviewCommentForbidden):
+		return utils.NewError(ctx, http.StatusForbidden, "Недостаточно прав для выполнения операции", err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return ctx.NoContent(http.StatusOK)
+	}
+}
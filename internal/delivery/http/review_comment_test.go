@@ -0,0 +1,256 @@
+package http
+
+import (
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/http/utils"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	mockusecase "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestReviewCommentEndpoints_GetReviewComments(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                    string
+		ReviewID                string
+		Page                    string
+		ExpectedErr             error
+		SetupCommentUsecaseMock func(usecase *mockusecase.MockReviewComment)
+	}{
+		{
+			Name:        "Успешное получение комментариев",
+			ReviewID:    "1",
+			Page:        "1",
+			ExpectedErr: nil,
+			SetupCommentUsecaseMock: func(uc *mockusecase.MockReviewComment) {
+				uc.EXPECT().GetReviewComments(gomock.Any(), 1, -1, 10, 1).Return(&dto.ReviewCommentResponseList{}, nil)
+			},
+		},
+		{
+			Name:                    "Невалидная страница",
+			ReviewID:                "1",
+			Page:                    "0",
+			ExpectedErr:             &echo.HTTPError{Code: 400, Message: "Невалидный номер страницы"},
+			SetupCommentUsecaseMock: func(uc *mockusecase.MockReviewComment) {},
+		},
+		{
+			Name:        "Рецензия не найдена",
+			ReviewID:    "1",
+			Page:        "1",
+			ExpectedErr: &echo.HTTPError{Code: 404, Message: "Рецензия не найдена"},
+			SetupCommentUsecaseMock: func(uc *mockusecase.MockReviewComment) {
+				uc.EXPECT().GetReviewComments(gomock.Any(), 1, -1, 10, 1).Return(nil, usecase.ErrReviewNotFound)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockCommentUsecase := mockusecase.NewMockReviewComment(ctrl)
+			tc.SetupCommentUsecaseMock(mockCommentUsecase)
+			commentHandler := NewReviewCommentEndpoints(mockCommentUsecase, mockusecase.NewMockAuth(ctrl))
+			req := httptest.NewRequest(http.MethodGet, "/review/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/review/:id/comments/:page")
+			c.SetParamNames("id", "page")
+			c.SetParamValues(tc.ReviewID, tc.Page)
+			err := commentHandler.GetReviewComments(c)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestReviewCommentEndpoints_CreateComment(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                    string
+		Body                    string
+		ExpectedErr             error
+		SetupCommentUsecaseMock func(usecase *mockusecase.MockReviewComment)
+		SetupAuthUsecaseMock    func(usecase *mockusecase.MockAuth)
+	}{
+		{
+			Name:        "Успешный ответ на комментарий",
+			Body:        `{"parentID":1,"text":"согласен"}`,
+			ExpectedErr: nil,
+			SetupCommentUsecaseMock: func(uc *mockusecase.MockReviewComment) {
+				uc.EXPECT().CreateComment(gomock.Any(), dto.ReviewCommentCreate{
+					ReviewCommentCreateRequest: dto.ReviewCommentCreateRequest{ParentID: 1, Text: "согласен"},
+					ReviewID:                   1,
+					UserID:                     1,
+				}).Return(&dto.ReviewCommentResponse{}, nil)
+			},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			},
+		},
+		{
+			Name:                    "Невалидный запрос",
+			Body:                    "kek",
+			ExpectedErr:             &echo.HTTPError{Code: 400, Message: "Невалидный запрос"},
+			SetupCommentUsecaseMock: func(uc *mockusecase.MockReviewComment) {},
+			SetupAuthUsecaseMock:    func(uc *mockusecase.MockAuth) {},
+		},
+		{
+			Name:                    "Пользователь не авторизован",
+			Body:                    `{"text":"согласен"}`,
+			ExpectedErr:             &echo.HTTPError{Code: 401, Message: "Для этой операции нужно авторизоваться"},
+			SetupCommentUsecaseMock: func(uc *mockusecase.MockReviewComment) {},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(-1, utils.ErrUnauthorized)
+			},
+		},
+		{
+			Name: "Слишком глубокая вложенность",
+			Body: `{"parentID":2,"text":"согласен"}`,
+			ExpectedErr: &echo.HTTPError{
+				Code:    400,
+				Message: usecase.ErrReviewCommentTooDeep.Error(),
+			},
+			SetupCommentUsecaseMock: func(uc *mockusecase.MockReviewComment) {
+				uc.EXPECT().CreateComment(gomock.Any(), gomock.Any()).
+					Return(nil, usecase.ReviewErrorIncorrectData{Err: usecase.ErrReviewCommentTooDeep})
+			},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			},
+		},
+		{
+			Name: "Комментарий не найден",
+			Body: `{"parentID":5,"text":"согласен"}`,
+			ExpectedErr: &echo.HTTPError{
+				Code:    404,
+				Message: "Комментарий не найден",
+			},
+			SetupCommentUsecaseMock: func(uc *mockusecase.MockReviewComment) {
+				uc.EXPECT().CreateComment(gomock.Any(), gomock.Any()).Return(nil, usecase.ErrReviewCommentNotFound)
+			},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockCommentUsecase := mockusecase.NewMockReviewComment(ctrl)
+			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
+			tc.SetupCommentUsecaseMock(mockCommentUsecase)
+			tc.SetupAuthUsecaseMock(mockAuthUsecase)
+			commentHandler := NewReviewCommentEndpoints(mockCommentUsecase, mockAuthUsecase)
+			req := httptest.NewRequest(http.MethodPost, "/review/", strings.NewReader(tc.Body))
+			req.AddCookie(&http.Cookie{Name: "session", Value: "xxx"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/review/:id/comments")
+			c.SetParamNames("id")
+			c.SetParamValues("1")
+			err := commentHandler.CreateComment(c)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestReviewCommentEndpoints_DeleteComment(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                    string
+		CommentID               string
+		ExpectedErr             error
+		SetupCommentUsecaseMock func(usecase *mockusecase.MockReviewComment)
+		SetupAuthUsecaseMock    func(usecase *mockusecase.MockAuth)
+	}{
+		{
+			Name:        "Успешное удаление",
+			CommentID:   "1",
+			ExpectedErr: nil,
+			SetupCommentUsecaseMock: func(uc *mockusecase.MockReviewComment) {
+				uc.EXPECT().DeleteComment(gomock.Any(), 1, 1).Return(nil)
+			},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			},
+		},
+		{
+			Name:      "Чужой комментарий",
+			CommentID: "1",
+			ExpectedErr: &echo.HTTPError{
+				Code:    403,
+				Message: "Недостаточно прав для выполнения операции",
+			},
+			SetupCommentUsecaseMock: func(uc *mockusecase.MockReviewComment) {
+				uc.EXPECT().DeleteComment(gomock.Any(), 1, 1).Return(usecase.ErrReviewCommentForbidden)
+			},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			},
+		},
+		{
+			Name:      "Неожиданная ошибка",
+			CommentID: "1",
+			ExpectedErr: &echo.HTTPError{
+				Code:     500,
+				Message:  "Внутренняя ошибка сервера",
+				Internal: errors.New("123"),
+			},
+			SetupCommentUsecaseMock: func(uc *mockusecase.MockReviewComment) {
+				uc.EXPECT().DeleteComment(gomock.Any(), 1, 1).Return(errors.New("123"))
+			},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			},
+		},
+		{
+			Name:                    "Невалидный айди",
+			CommentID:               "ogo!",
+			ExpectedErr:             &echo.HTTPError{Code: 400, Message: "Невалидный id комментария"},
+			SetupCommentUsecaseMock: func(uc *mockusecase.MockReviewComment) {},
+			SetupAuthUsecaseMock:    func(uc *mockusecase.MockAuth) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockCommentUsecase := mockusecase.NewMockReviewComment(ctrl)
+			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
+			tc.SetupCommentUsecaseMock(mockCommentUsecase)
+			tc.SetupAuthUsecaseMock(mockAuthUsecase)
+			commentHandler := NewReviewCommentEndpoints(mockCommentUsecase, mockAuthUsecase)
+			req := httptest.NewRequest(http.MethodDelete, "/review/comment/", nil)
+			req.AddCookie(&http.Cookie{Name: "session", Value: "xxx"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/review/comment/:id")
+			c.SetParamNames("id")
+			c.SetParamValues(tc.CommentID)
+			err := commentHandler.DeleteComment(c)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package http
+
+import (
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/http/utils"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"strconv"
+)
+
+type ReviewModerationEndpoints struct {
+	moderationUC usecase.ReviewModeration
+	authUC       usecase.Auth
+}
+
+func NewReviewModerationEndpoints(
+	moderationUC usecase.ReviewModeration,
+	authUC usecase.Auth,
+) ReviewModerationEndpoints {
+	return ReviewModerationEndpoints{moderationUC: moderationUC, authUC: authUC}
+}
+
+func (h *ReviewModerationEndpoints) Configure(server *echo.Group) {
+	server.POST("/review/:id/report", h.ReportReview)
+	server.GET("/queue/:page", h.GetModerationQueue)
+	server.PUT("/review/:id", h.ModerateReview)
+	server.GET("/log/:page", h.GetModerationLog)
+}
+
+// ReportReview
+// @Summary Пожаловаться на рецензию
+// @Tags moderation
+// @Description Пожаловаться на рецензию. Возможные причины: spam, insult, spoiler, offtopic, other
+// @Accept json
+// @Param id path int true "ID рецензии"
+// @Param report body dto.ReviewReportRequest true "Причина жалобы"
+// @Success 200
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 409 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/moderation/review/{id}/report [post]
+// @Security _csrf
+func (h *ReviewModerationEndpoints) ReportReview(ctx echo.Context) error {
+	reviewID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id рецензии", nil)
+	}
+	report := new(dto.ReviewReportRequest)
+	if err = utils.ReadJSON(ctx, report); err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный запрос", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	err = h.moderationUC.ReportReview(ctx.Request().Context(), dto.ReviewReportCreate{
+		ReviewReportRequest: *report,
+		ReviewID:            int(reviewID),
+		UserID:              userID,
+	})
+	var reviewErr usecase.ReviewErrorIncorrectData
+	switch {
+	case errors.Is(err, usecase.ErrReviewNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Рецензия не найдена", err)
+	case errors.Is(err, usecase.ErrReviewReportAlreadyExists):
+		return utils.NewError(ctx, http.StatusConflict, "Жалоба уже отправлена", err)
+	case errors.As(err, &reviewErr):
+		return utils.NewError(ctx, http.StatusBadRequest, reviewErr.Error(), err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return ctx.NoContent(http.StatusOK)
+	}
+}
+
+// GetModerationQueue
+// @Summary Очередь модерации
+// @Tags moderation
+// @Description Рецензии с нерассмотренными жалобами, сначала с наибольшим числом жалоб. Для отредактированных
+// рецензий возвращается и первоначальная версия. Только для модераторов
+// @Produce json
+// @Param page path int true "Номер страницы"
+// @Success 200 {object} dto.ModerationQueue
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 403 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/moderation/queue/{page} [get]
+func (h *ReviewModerationEndpoints) GetModerationQueue(ctx echo.Context) error {
+	page, err := strconv.ParseInt(ctx.Param("page"), 10, 64)
+	if err != nil || page < 1 {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный номер страницы", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	queue, err := h.moderationUC.GetModerationQueue(ctx.Request().Context(), userID, 10, int(page))
+	switch {
+	case errors.Is(err, usecase.ErrModerationForbidden):
+		return utils.NewError(ctx, http.StatusForbidden, "Операция доступна только модераторам", err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return utils.WriteJSON(ctx, queue)
+	}
+}
+
+// ModerateReview
+// @Summary Решение модератора по рецензии
+// @Tags moderation
+// @Description approve оставляет рецензию видимой, hide скрывает ее из списков и рейтинга контента, delete удаляет.
+// Все жалобы на рецензию считаются рассмотренными, решение записывается в журнал. Только для модераторов
+// @Accept json
+// @Param id path int true "ID рецензии"
+// @Param decision body dto.ModerationDecisionRequest true "Решение модератора"
+// @Success 200
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 403 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/moderation/review/{id} [put]
+// @Security _csrf
+func (h *ReviewModerationEndpoints) ModerateReview(ctx echo.Context) error {
+	reviewID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id рецензии", nil)
+	}
+	decision := new(dto.ModerationDecisionRequest)
+	if err = utils.ReadJSON(ctx, decision); err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный запрос", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	err = h.moderationUC.ModerateReview(ctx.Request().Context(), dto.ModerationDecisionCreate{
+		ModerationDecisionRequest: *decision,
+		ReviewID:                  int(reviewID),
+		ModeratorID:               userID,
+	})
+	var reviewErr usecase.ReviewErrorIncorrectData
+	switch {
+	case errors.Is(err, usecase.ErrModerationForbidden):
+		return utils.NewError(ctx, http.StatusForbidden, "Операция доступна только модераторам", err)
+	case errors.Is(err, usecase.ErrReviewNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Рецензия не найдена", err)
+	case errors.As(err, &reviewErr):
+		return utils.NewError(ctx, http.StatusBadRequest, reviewErr.Error(), err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return ctx.NoContent(http.StatusOK)
+	}
+}
+
+// GetModerationLog
+// @Summary Журнал модерации
+// @Tags moderation
+// @Description Решения модераторов по рецензиям, сначала новые. Только для модераторов
+// @Produce json
+// @Param page path int true "Номер страницы"
+// @Success 200 {object} dto.ModerationLog
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 403 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/moderation/log/{page} [get]
+func (h *ReviewModerationEndpoints) GetModerationLog(ctx echo.Context) error {
+	page, err := strconv.ParseInt(ctx.Param("page"), 10, 64)
+	if err != nil || page < 1 {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный номер страницы", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	log, err := h.moderationUC.GetModerationLog(ctx.Request().Context(), userID, 20, int(page))
+	switch {
+	case errors.Is(err, usecase.ErrModerationForbidden):
+		return utils.NewError(ctx, http.StatusForbidden, "Операция доступна только модераторам", err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return utils.WriteJSON(ctx, log)
+	}
+}
@@ -0,0 +1,144 @@
+package http
+
+import (
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	mockusecase "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestReviewModerationEndpoints_ReportReview(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                       string
+		Body                       string
+		ExpectedErr                error
+		SetupModerationUsecaseMock func(usecase *mockusecase.MockReviewModeration)
+	}{
+		{
+			Name:        "Успешная жалоба",
+			Body:        `{"reason":"spam","comment":"реклама"}`,
+			ExpectedErr: nil,
+			SetupModerationUsecaseMock: func(uc *mockusecase.MockReviewModeration) {
+				uc.EXPECT().ReportReview(gomock.Any(), dto.ReviewReportCreate{
+					ReviewReportRequest: dto.ReviewReportRequest{Reason: "spam", Comment: "реклама"},
+					ReviewID:            1,
+					UserID:              1,
+				}).Return(nil)
+			},
+		},
+		{
+			Name:        "Повторная жалоба",
+			Body:        `{"reason":"spam"}`,
+			ExpectedErr: &echo.HTTPError{Code: 409, Message: "Жалоба уже отправлена"},
+			SetupModerationUsecaseMock: func(uc *mockusecase.MockReviewModeration) {
+				uc.EXPECT().ReportReview(gomock.Any(), gomock.Any()).Return(usecase.ErrReviewReportAlreadyExists)
+			},
+		},
+		{
+			Name:        "Некорректная причина",
+			Body:        `{"reason":"boring"}`,
+			ExpectedErr: &echo.HTTPError{Code: 400, Message: "неизвестная причина"},
+			SetupModerationUsecaseMock: func(uc *mockusecase.MockReviewModeration) {
+				uc.EXPECT().ReportReview(gomock.Any(), gomock.Any()).
+					Return(usecase.ReviewErrorIncorrectData{Err: errors.New("неизвестная причина")})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockModerationUsecase := mockusecase.NewMockReviewModeration(ctrl)
+			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
+			mockAuthUsecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			tc.SetupModerationUsecaseMock(mockModerationUsecase)
+			moderationHandler := NewReviewModerationEndpoints(mockModerationUsecase, mockAuthUsecase)
+			req := httptest.NewRequest(http.MethodPost, "/moderation/", strings.NewReader(tc.Body))
+			req.AddCookie(&http.Cookie{Name: "session", Value: "xxx"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/moderation/review/:id/report")
+			c.SetParamNames("id")
+			c.SetParamValues("1")
+			err := moderationHandler.ReportReview(c)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestReviewModerationEndpoints_ModerateReview(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                       string
+		Body                       string
+		ExpectedErr                error
+		SetupModerationUsecaseMock func(usecase *mockusecase.MockReviewModeration)
+	}{
+		{
+			Name:        "Рецензия скрыта",
+			Body:        `{"action":"hide","note":"оскорбления"}`,
+			ExpectedErr: nil,
+			SetupModerationUsecaseMock: func(uc *mockusecase.MockReviewModeration) {
+				uc.EXPECT().ModerateReview(gomock.Any(), dto.ModerationDecisionCreate{
+					ModerationDecisionRequest: dto.ModerationDecisionRequest{Action: "hide", Note: "оскорбления"},
+					ReviewID:                  1,
+					ModeratorID:               1,
+				}).Return(nil)
+			},
+		},
+		{
+			Name:        "Не модератор",
+			Body:        `{"action":"delete"}`,
+			ExpectedErr: &echo.HTTPError{Code: 403, Message: "Операция доступна только модераторам"},
+			SetupModerationUsecaseMock: func(uc *mockusecase.MockReviewModeration) {
+				uc.EXPECT().ModerateReview(gomock.Any(), gomock.Any()).Return(usecase.ErrModerationForbidden)
+			},
+		},
+		{
+			Name:        "Рецензия не найдена",
+			Body:        `{"action":"approve"}`,
+			ExpectedErr: &echo.HTTPError{Code: 404, Message: "Рецензия не найдена"},
+			SetupModerationUsecaseMock: func(uc *mockusecase.MockReviewModeration) {
+				uc.EXPECT().ModerateReview(gomock.Any(), gomock.Any()).Return(usecase.ErrReviewNotFound)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockModerationUsecase := mockusecase.NewMockReviewModeration(ctrl)
+			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
+			mockAuthUsecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			tc.SetupModerationUsecaseMock(mockModerationUsecase)
+			moderationHandler := NewReviewModerationEndpoints(mockModerationUsecase, mockAuthUsecase)
+			req := httptest.NewRequest(http.MethodPut, "/moderation/", strings.NewReader(tc.Body))
+			req.AddCookie(&http.Cookie{Name: "session", Value: "xxx"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/moderation/review/:id")
+			c.SetParamNames("id")
+			c.SetParamValues("1")
+			err := moderationHandler.ModerateReview(c)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/http/utils"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
 	mockusecase "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/mocks"
@@ -26,6 +27,7 @@ func TestReviewHandler_GetReview(t *testing.T) {
 		ReviewID               string
 		ExpectedErr            error
 		Cookies                *http.Cookie
+		CanView                bool
 		SetupReviewUsecaseMock func(usecase *mockusecase.MockReview)
 	}{
 		{
@@ -36,8 +38,24 @@ func TestReviewHandler_GetReview(t *testing.T) {
 				Name:  "session",
 				Value: "xxx",
 			},
+			CanView: true,
 			SetupReviewUsecaseMock: func(uc *mockusecase.MockReview) {
-				uc.EXPECT().GetReview(1).Return(nil, nil)
+				uc.EXPECT().GetReview(gomock.Any(), 1, 1).
+					Return(&dto.ReviewResponse{Review: dto.Review{ID: 1, AuthorID: 2}}, nil)
+			},
+		},
+		{
+			Name:        "Автор скрыл рецензии",
+			ReviewID:    "1",
+			ExpectedErr: &echo.HTTPError{Code: 403, Message: "Пользователь скрыл рецензии"},
+			Cookies: &http.Cookie{
+				Name:  "session",
+				Value: "xxx",
+			},
+			CanView: false,
+			SetupReviewUsecaseMock: func(uc *mockusecase.MockReview) {
+				uc.EXPECT().GetReview(gomock.Any(), 1, 1).
+					Return(&dto.ReviewResponse{Review: dto.Review{ID: 1, AuthorID: 2}}, nil)
 			},
 		},
 		{
@@ -52,7 +70,7 @@ func TestReviewHandler_GetReview(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupReviewUsecaseMock: func(uc *mockusecase.MockReview) {
-				uc.EXPECT().GetReview(1).Return(nil, usecase.ErrReviewNotFound)
+				uc.EXPECT().GetReview(gomock.Any(), 1, 1).Return(nil, usecase.ErrReviewNotFound)
 			},
 		},
 		{
@@ -64,7 +82,7 @@ func TestReviewHandler_GetReview(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupReviewUsecaseMock: func(uc *mockusecase.MockReview) {
-				uc.EXPECT().GetReview(1).Return(nil, errors.New("123"))
+				uc.EXPECT().GetReview(gomock.Any(), 1, 1).Return(nil, errors.New("123"))
 			},
 		},
 		{
@@ -85,8 +103,12 @@ func TestReviewHandler_GetReview(t *testing.T) {
 			defer ctrl.Finish()
 			mockReviewUsecase := mockusecase.NewMockReview(ctrl)
 			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
+			mockAuthUsecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil).AnyTimes()
+			mockPrivacyUsecase := mockusecase.NewMockPrivacy(ctrl)
+			mockPrivacyUsecase.EXPECT().CanView(gomock.Any(), 1, 2, entity.PrivacySectionReviews).
+				Return(tc.CanView, nil).AnyTimes()
 			tc.SetupReviewUsecaseMock(mockReviewUsecase)
-			reviewHandler := NewReviewEndpoints(mockReviewUsecase, mockAuthUsecase)
+			reviewHandler := NewReviewEndpoints(mockReviewUsecase, mockAuthUsecase, mockPrivacyUsecase)
 			req := httptest.NewRequest(http.MethodGet, "/review/", nil)
 			if tc.Cookies != nil {
 				req.AddCookie(tc.Cookies)
@@ -102,6 +124,172 @@ func TestReviewHandler_GetReview(t *testing.T) {
 	}
 }
 
+func TestReviewEndpoints_GetReviewHistory(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                   string
+		ReviewID               string
+		ExpectedErr            error
+		ExpectedOutput         *dto.ReviewHistory
+		CanView                bool
+		SetupReviewUsecaseMock func(usecase *mockusecase.MockReview)
+	}{
+		{
+			Name:        "Успешное получение истории",
+			ReviewID:    "1",
+			ExpectedErr: nil,
+			ExpectedOutput: &dto.ReviewHistory{
+				Review: dto.ReviewResponse{
+					Review: dto.Review{ID: 1, AuthorID: 2, Title: "Title", Text: "i like it", EditCount: 1},
+				},
+				Revisions: []dto.ReviewRevision{{Title: "Title", Text: "i hate it"}},
+			},
+			CanView: true,
+			SetupReviewUsecaseMock: func(uc *mockusecase.MockReview) {
+				uc.EXPECT().GetReviewHistory(gomock.Any(), 1, -1).Return(&dto.ReviewHistory{
+					Review: dto.ReviewResponse{
+						Review: dto.Review{ID: 1, AuthorID: 2, Title: "Title", Text: "i like it", EditCount: 1},
+					},
+					Revisions: []dto.ReviewRevision{{Title: "Title", Text: "i hate it"}},
+				}, nil)
+			},
+		},
+		{
+			Name:        "Автор скрыл рецензии",
+			ReviewID:    "1",
+			ExpectedErr: &echo.HTTPError{Code: 403, Message: "Пользователь скрыл рецензии"},
+			CanView:     false,
+			SetupReviewUsecaseMock: func(uc *mockusecase.MockReview) {
+				uc.EXPECT().GetReviewHistory(gomock.Any(), 1, -1).Return(&dto.ReviewHistory{
+					Review: dto.ReviewResponse{Review: dto.Review{ID: 1, AuthorID: 2}},
+				}, nil)
+			},
+		},
+		{
+			Name:        "Рецензия не найдена",
+			ReviewID:    "1",
+			ExpectedErr: &echo.HTTPError{Code: 404, Message: "Рецензия не найдена"},
+			SetupReviewUsecaseMock: func(uc *mockusecase.MockReview) {
+				uc.EXPECT().GetReviewHistory(gomock.Any(), 1, -1).Return(nil, usecase.ErrReviewNotFound)
+			},
+		},
+		{
+			Name:                   "Невалидный айди",
+			ReviewID:               "ogo!",
+			ExpectedErr:            &echo.HTTPError{Code: 400, Message: "Невалидный id рецензии"},
+			SetupReviewUsecaseMock: func(uc *mockusecase.MockReview) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockReviewUsecase := mockusecase.NewMockReview(ctrl)
+			mockPrivacyUsecase := mockusecase.NewMockPrivacy(ctrl)
+			mockPrivacyUsecase.EXPECT().CanView(gomock.Any(), -1, 2, entity.PrivacySectionReviews).
+				Return(tc.CanView, nil).AnyTimes()
+			tc.SetupReviewUsecaseMock(mockReviewUsecase)
+			reviewHandler := NewReviewEndpoints(mockReviewUsecase, nil, mockPrivacyUsecase)
+			req := httptest.NewRequest(http.MethodGet, "/review/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/review/:id/history")
+			c.SetParamNames("id")
+			c.SetParamValues(tc.ReviewID)
+			err := reviewHandler.GetReviewHistory(c)
+			require.Equal(t, tc.ExpectedErr, err)
+			if tc.ExpectedErr == nil {
+				var history dto.ReviewHistory
+				err = json.NewDecoder(rec.Body).Decode(&history)
+				require.NoError(t, err)
+				require.Equal(t, *tc.ExpectedOutput, history)
+			}
+		})
+	}
+}
+
+func TestReviewEndpoints_GetContentRatingStats(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                   string
+		ContentID              string
+		ExpectedErr            error
+		ExpectedOutput         *dto.ContentRatingStats
+		SetupReviewUsecaseMock func(usecase *mockusecase.MockReview)
+	}{
+		{
+			Name:        "Успешное получение",
+			ContentID:   "1",
+			ExpectedErr: nil,
+			ExpectedOutput: &dto.ContentRatingStats{
+				ContentID:      1,
+				RatingCount:    1,
+				Average:        10,
+				Median:         10,
+				WeightedRating: 7.2,
+				Histogram:      []dto.RatingHistogramBar{{Rating: 10, Count: 1}},
+			},
+			SetupReviewUsecaseMock: func(uc *mockusecase.MockReview) {
+				uc.EXPECT().GetContentRatingStats(gomock.Any(), 1).Return(&dto.ContentRatingStats{
+					ContentID:      1,
+					RatingCount:    1,
+					Average:        10,
+					Median:         10,
+					WeightedRating: 7.2,
+					Histogram:      []dto.RatingHistogramBar{{Rating: 10, Count: 1}},
+				}, nil)
+			},
+		},
+		{
+			Name:        "Контент не найден",
+			ContentID:   "1",
+			ExpectedErr: &echo.HTTPError{Code: 404, Message: "Контент не найден"},
+			SetupReviewUsecaseMock: func(uc *mockusecase.MockReview) {
+				uc.EXPECT().GetContentRatingStats(gomock.Any(), 1).Return(nil, usecase.ErrContentNotFound)
+			},
+		},
+		{
+			Name:                   "Невалидный айди",
+			ContentID:              "ogo!",
+			ExpectedErr:            &echo.HTTPError{Code: 400, Message: "Невалидный id контента"},
+			SetupReviewUsecaseMock: func(uc *mockusecase.MockReview) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockReviewUsecase := mockusecase.NewMockReview(ctrl)
+			tc.SetupReviewUsecaseMock(mockReviewUsecase)
+			reviewHandler := NewReviewEndpoints(mockReviewUsecase, nil, nil)
+			req := httptest.NewRequest(http.MethodGet, "/review/", nil)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/review/content/:id/rating")
+			c.SetParamNames("id")
+			c.SetParamValues(tc.ContentID)
+			err := reviewHandler.GetContentRatingStats(c)
+			require.Equal(t, tc.ExpectedErr, err)
+			if tc.ExpectedErr == nil {
+				var stats dto.ContentRatingStats
+				err = json.NewDecoder(rec.Body).Decode(&stats)
+				require.NoError(t, err)
+				require.Equal(t, *tc.ExpectedOutput, stats)
+			}
+		})
+	}
+}
+
 func TestReviewEndpoints_GetMyContentReview(t *testing.T) {
 	t.Parallel()
 
@@ -139,7 +327,7 @@ func TestReviewEndpoints_GetMyContentReview(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {
-				usecase.EXPECT().GetContentReviewByAuthor(1, 1).Return(&dto.ReviewResponse{
+				usecase.EXPECT().GetContentReviewByAuthor(gomock.Any(), 1, 1).Return(&dto.ReviewResponse{
 					Review: dto.Review{
 						ID:        1,
 						AuthorID:  1,
@@ -157,7 +345,7 @@ func TestReviewEndpoints_GetMyContentReview(t *testing.T) {
 				}, nil)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 		{
@@ -186,10 +374,10 @@ func TestReviewEndpoints_GetMyContentReview(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupReviewUsecaseMock: func(uc *mockusecase.MockReview) {
-				uc.EXPECT().GetContentReviewByAuthor(1, 1).Return(nil, usecase.ErrReviewNotFound)
+				uc.EXPECT().GetContentReviewByAuthor(gomock.Any(), 1, 1).Return(nil, usecase.ErrReviewNotFound)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 		{
@@ -199,7 +387,7 @@ func TestReviewEndpoints_GetMyContentReview(t *testing.T) {
 			Cookies:                &http.Cookie{Name: "session", Value: "xxx"},
 			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession(gomock.Any()).Return(-1, utils.ErrUnauthorized)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), gomock.Any()).Return(-1, utils.ErrUnauthorized)
 			},
 		},
 		{
@@ -211,10 +399,10 @@ func TestReviewEndpoints_GetMyContentReview(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {
-				usecase.EXPECT().GetContentReviewByAuthor(1, 1).Return(nil, errors.New("123"))
+				usecase.EXPECT().GetContentReviewByAuthor(gomock.Any(), 1, 1).Return(nil, errors.New("123"))
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 	}
@@ -230,7 +418,7 @@ func TestReviewEndpoints_GetMyContentReview(t *testing.T) {
 			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
 			tc.SetupReviewUsecaseMock(mockReviewUsecase)
 			tc.SetupAuthUsecaseMock(mockAuthUsecase)
-			reviewHandler := NewReviewEndpoints(mockReviewUsecase, mockAuthUsecase)
+			reviewHandler := NewReviewEndpoints(mockReviewUsecase, mockAuthUsecase, nil)
 			req := httptest.NewRequest(http.MethodGet, "/review/myReview", nil)
 			if tc.Cookies != nil {
 				req.AddCookie(tc.Cookies)
@@ -283,7 +471,7 @@ func TestReviewEndpoints_CreateReview(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {
-				usecase.EXPECT().CreateReview(dto.ReviewCreate{
+				usecase.EXPECT().CreateReview(gomock.Any(), dto.ReviewCreate{
 					ReviewCreateRequest: dto.ReviewCreateRequest{
 						ContentID: 1,
 						Rating:    5,
@@ -309,7 +497,7 @@ func TestReviewEndpoints_CreateReview(t *testing.T) {
 				}, nil)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 		{
@@ -337,7 +525,7 @@ func TestReviewEndpoints_CreateReview(t *testing.T) {
 			Cookies:                &http.Cookie{Name: "session", Value: "xxx"},
 			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {},
 			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
-				uc.EXPECT().GetUserIDBySession(gomock.Any()).Return(-1, utils.ErrUnauthorized)
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), gomock.Any()).Return(-1, utils.ErrUnauthorized)
 			},
 		},
 		{
@@ -351,7 +539,7 @@ func TestReviewEndpoints_CreateReview(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {
-				usecase.EXPECT().CreateReview(dto.ReviewCreate{
+				usecase.EXPECT().CreateReview(gomock.Any(), dto.ReviewCreate{
 					ReviewCreateRequest: dto.ReviewCreateRequest{
 						ContentID: 1,
 						Rating:    5,
@@ -362,7 +550,7 @@ func TestReviewEndpoints_CreateReview(t *testing.T) {
 				}).Return(nil, errors.New("123"))
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 		{
@@ -379,7 +567,7 @@ func TestReviewEndpoints_CreateReview(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupReviewUsecaseMock: func(uc *mockusecase.MockReview) {
-				uc.EXPECT().CreateReview(dto.ReviewCreate{
+				uc.EXPECT().CreateReview(gomock.Any(), dto.ReviewCreate{
 					ReviewCreateRequest: dto.ReviewCreateRequest{
 						ContentID: 1,
 						Rating:    5,
@@ -390,7 +578,7 @@ func TestReviewEndpoints_CreateReview(t *testing.T) {
 				}).Return(nil, usecase.ErrReviewContentNotFound)
 			},
 			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
-				uc.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 		{
@@ -407,7 +595,7 @@ func TestReviewEndpoints_CreateReview(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupReviewUsecaseMock: func(uc *mockusecase.MockReview) {
-				uc.EXPECT().CreateReview(dto.ReviewCreate{
+				uc.EXPECT().CreateReview(gomock.Any(), dto.ReviewCreate{
 					ReviewCreateRequest: dto.ReviewCreateRequest{
 						ContentID: 1,
 						Rating:    5,
@@ -418,7 +606,7 @@ func TestReviewEndpoints_CreateReview(t *testing.T) {
 				}).Return(nil, usecase.ErrReviewAlreadyExists)
 			},
 			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
-				uc.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 		{
@@ -435,7 +623,7 @@ func TestReviewEndpoints_CreateReview(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupReviewUsecaseMock: func(uc *mockusecase.MockReview) {
-				uc.EXPECT().CreateReview(dto.ReviewCreate{
+				uc.EXPECT().CreateReview(gomock.Any(), dto.ReviewCreate{
 					ReviewCreateRequest: dto.ReviewCreateRequest{
 						ContentID: -100,
 						Rating:    5,
@@ -446,7 +634,7 @@ func TestReviewEndpoints_CreateReview(t *testing.T) {
 				}).Return(nil, usecase.ReviewErrorIncorrectData{Err: errors.New("content_id")})
 			},
 			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
-				uc.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 	}
@@ -462,7 +650,7 @@ func TestReviewEndpoints_CreateReview(t *testing.T) {
 			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
 			tc.SetupReviewUsecaseMock(mockReviewUsecase)
 			tc.SetupAuthUsecaseMock(mockAuthUsecase)
-			reviewHandler := NewReviewEndpoints(mockReviewUsecase, mockAuthUsecase)
+			reviewHandler := NewReviewEndpoints(mockReviewUsecase, mockAuthUsecase, nil)
 			req := httptest.NewRequest(http.MethodPost, "/review/", tc.Body())
 			if tc.Cookies != nil {
 				req.AddCookie(tc.Cookies)
@@ -515,7 +703,7 @@ func TestReviewEndpoints_UpdateReview(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {
-				usecase.EXPECT().EditReview(dto.ReviewUpdate{
+				usecase.EXPECT().EditReview(gomock.Any(), dto.ReviewUpdate{
 					ReviewUpdateRequest: dto.ReviewUpdateRequest{
 						ReviewID: 1,
 						Rating:   5,
@@ -541,7 +729,7 @@ func TestReviewEndpoints_UpdateReview(t *testing.T) {
 				}, nil)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 		{
@@ -574,7 +762,7 @@ func TestReviewEndpoints_UpdateReview(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupReviewUsecaseMock: func(uc *mockusecase.MockReview) {
-				uc.EXPECT().EditReview(dto.ReviewUpdate{
+				uc.EXPECT().EditReview(gomock.Any(), dto.ReviewUpdate{
 					ReviewUpdateRequest: dto.ReviewUpdateRequest{
 						ReviewID: 1,
 						Rating:   5,
@@ -585,7 +773,7 @@ func TestReviewEndpoints_UpdateReview(t *testing.T) {
 				}).Return(nil, usecase.ReviewErrorIncorrectData{Err: errors.New("review_id")})
 			},
 			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
-				uc.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 		{
@@ -602,7 +790,7 @@ func TestReviewEndpoints_UpdateReview(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupReviewUsecaseMock: func(uc *mockusecase.MockReview) {
-				uc.EXPECT().EditReview(dto.ReviewUpdate{
+				uc.EXPECT().EditReview(gomock.Any(), dto.ReviewUpdate{
 					ReviewUpdateRequest: dto.ReviewUpdateRequest{
 						ReviewID: 1,
 						Rating:   5,
@@ -613,7 +801,7 @@ func TestReviewEndpoints_UpdateReview(t *testing.T) {
 				}).Return(nil, usecase.ErrReviewNotFound)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 		{
@@ -630,7 +818,7 @@ func TestReviewEndpoints_UpdateReview(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupReviewUsecaseMock: func(uc *mockusecase.MockReview) {
-				uc.EXPECT().EditReview(dto.ReviewUpdate{
+				uc.EXPECT().EditReview(gomock.Any(), dto.ReviewUpdate{
 					ReviewUpdateRequest: dto.ReviewUpdateRequest{
 						ReviewID: 1,
 						Rating:   5,
@@ -641,7 +829,7 @@ func TestReviewEndpoints_UpdateReview(t *testing.T) {
 				}).Return(nil, usecase.ErrReviewForbidden)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 		{
@@ -653,7 +841,7 @@ func TestReviewEndpoints_UpdateReview(t *testing.T) {
 			Cookies:                &http.Cookie{Name: "session", Value: "xxx"},
 			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession(gomock.Any()).Return(-1, utils.ErrUnauthorized)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), gomock.Any()).Return(-1, utils.ErrUnauthorized)
 			},
 		},
 		{
@@ -667,7 +855,7 @@ func TestReviewEndpoints_UpdateReview(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {
-				usecase.EXPECT().EditReview(dto.ReviewUpdate{
+				usecase.EXPECT().EditReview(gomock.Any(), dto.ReviewUpdate{
 					ReviewUpdateRequest: dto.ReviewUpdateRequest{
 						ReviewID: 1,
 						Rating:   5,
@@ -678,7 +866,7 @@ func TestReviewEndpoints_UpdateReview(t *testing.T) {
 				}).Return(nil, errors.New("123"))
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 	}
@@ -694,7 +882,7 @@ func TestReviewEndpoints_UpdateReview(t *testing.T) {
 			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
 			tc.SetupReviewUsecaseMock(mockReviewUsecase)
 			tc.SetupAuthUsecaseMock(mockAuthUsecase)
-			reviewHandler := NewReviewEndpoints(mockReviewUsecase, mockAuthUsecase)
+			reviewHandler := NewReviewEndpoints(mockReviewUsecase, mockAuthUsecase, nil)
 			req := httptest.NewRequest(http.MethodPut, "/review/", tc.Body())
 			if tc.Cookies != nil {
 				req.AddCookie(tc.Cookies)
@@ -729,10 +917,10 @@ func TestReviewEndpoints_DeleteReview(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {
-				usecase.EXPECT().DeleteReview(1, 1).Return(nil)
+				usecase.EXPECT().DeleteReview(gomock.Any(), 1, 1).Return(nil)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 		{
@@ -747,10 +935,10 @@ func TestReviewEndpoints_DeleteReview(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupReviewUsecaseMock: func(uc *mockusecase.MockReview) {
-				uc.EXPECT().DeleteReview(1, 1).Return(usecase.ErrReviewNotFound)
+				uc.EXPECT().DeleteReview(gomock.Any(), 1, 1).Return(usecase.ErrReviewNotFound)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 		{
@@ -765,10 +953,10 @@ func TestReviewEndpoints_DeleteReview(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupReviewUsecaseMock: func(uc *mockusecase.MockReview) {
-				uc.EXPECT().DeleteReview(1, 1).Return(usecase.ErrReviewForbidden)
+				uc.EXPECT().DeleteReview(gomock.Any(), 1, 1).Return(usecase.ErrReviewForbidden)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 		{
@@ -798,7 +986,7 @@ func TestReviewEndpoints_DeleteReview(t *testing.T) {
 			},
 			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession(gomock.Any()).Return(-1, utils.ErrUnauthorized)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), gomock.Any()).Return(-1, utils.ErrUnauthorized)
 			},
 		},
 		{
@@ -814,10 +1002,10 @@ func TestReviewEndpoints_DeleteReview(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {
-				usecase.EXPECT().DeleteReview(1, 1).Return(errors.New("123"))
+				usecase.EXPECT().DeleteReview(gomock.Any(), 1, 1).Return(errors.New("123"))
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 	}
@@ -833,7 +1021,7 @@ func TestReviewEndpoints_DeleteReview(t *testing.T) {
 			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
 			tc.SetupReviewUsecaseMock(mockReviewUsecase)
 			tc.SetupAuthUsecaseMock(mockAuthUsecase)
-			reviewHandler := NewReviewEndpoints(mockReviewUsecase, mockAuthUsecase)
+			reviewHandler := NewReviewEndpoints(mockReviewUsecase, mockAuthUsecase, nil)
 			req := httptest.NewRequest(http.MethodDelete, "/review/", nil)
 			if tc.Cookies != nil {
 				req.AddCookie(tc.Cookies)
@@ -882,7 +1070,7 @@ func TestReviewEndpoints_GetRecentReviews(t *testing.T) {
 				},
 			},
 			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {
-				usecase.EXPECT().GetLatestReviews(3).Return(&dto.ReviewResponseList{
+				usecase.EXPECT().GetLatestReviews(gomock.Any(), 3, dto.ReviewFilter{}).Return(&dto.ReviewResponseList{
 					Reviews: []dto.ReviewResponse{
 						{
 							Review: dto.Review{
@@ -909,7 +1097,7 @@ func TestReviewEndpoints_GetRecentReviews(t *testing.T) {
 			ExpectedErr:    &echo.HTTPError{Code: 500, Message: "Внутренняя ошибка сервера", Internal: errors.New("123")},
 			ExpectedOutput: nil,
 			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {
-				usecase.EXPECT().GetLatestReviews(3).Return(nil, errors.New("123"))
+				usecase.EXPECT().GetLatestReviews(gomock.Any(), 3, dto.ReviewFilter{}).Return(nil, errors.New("123"))
 			},
 		},
 	}
@@ -923,7 +1111,7 @@ func TestReviewEndpoints_GetRecentReviews(t *testing.T) {
 			defer ctrl.Finish()
 			mockReviewUsecase := mockusecase.NewMockReview(ctrl)
 			tc.SetupReviewUsecaseMock(mockReviewUsecase)
-			reviewHandler := NewReviewEndpoints(mockReviewUsecase, nil)
+			reviewHandler := NewReviewEndpoints(mockReviewUsecase, nil, nil)
 			req := httptest.NewRequest(http.MethodGet, "/review/recent", nil)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
@@ -974,7 +1162,7 @@ func TestReviewEndpoints_GetUserLatestReviews(t *testing.T) {
 				},
 			},
 			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {
-				usecase.EXPECT().GetUserReviews(1, 3, 1).Return(&dto.ReviewResponseList{
+				usecase.EXPECT().GetUserReviews(gomock.Any(), 1, 3, 1, dto.ReviewFilter{}).Return(&dto.ReviewResponseList{
 					Reviews: []dto.ReviewResponse{
 						{
 							Review: dto.Review{
@@ -1006,7 +1194,7 @@ func TestReviewEndpoints_GetUserLatestReviews(t *testing.T) {
 			},
 			ExpectedOutput: nil,
 			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {
-				usecase.EXPECT().GetUserReviews(1, 3, 1).Return(nil, errors.New("123"))
+				usecase.EXPECT().GetUserReviews(gomock.Any(), 1, 3, 1, dto.ReviewFilter{}).Return(nil, errors.New("123"))
 			},
 		},
 		{
@@ -1030,7 +1218,10 @@ func TestReviewEndpoints_GetUserLatestReviews(t *testing.T) {
 			defer ctrl.Finish()
 			mockReviewUsecase := mockusecase.NewMockReview(ctrl)
 			tc.SetupReviewUsecaseMock(mockReviewUsecase)
-			reviewHandler := NewReviewEndpoints(mockReviewUsecase, nil)
+			mockPrivacyUsecase := mockusecase.NewMockPrivacy(ctrl)
+			mockPrivacyUsecase.EXPECT().CanView(gomock.Any(), -1, 1, entity.PrivacySectionReviews).
+				Return(true, nil).AnyTimes()
+			reviewHandler := NewReviewEndpoints(mockReviewUsecase, nil, mockPrivacyUsecase)
 			req := httptest.NewRequest(http.MethodGet, "/review/user", nil)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
@@ -1086,7 +1277,7 @@ func TestReviewEndpoints_GetUserReviews(t *testing.T) {
 				},
 			},
 			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {
-				usecase.EXPECT().GetUserReviews(1, 10, 1).Return(&dto.ReviewResponseList{
+				usecase.EXPECT().GetUserReviews(gomock.Any(), 1, 10, 1, dto.ReviewFilter{}).Return(&dto.ReviewResponseList{
 					Reviews: []dto.ReviewResponse{
 						{
 							Review: dto.Review{
@@ -1119,7 +1310,7 @@ func TestReviewEndpoints_GetUserReviews(t *testing.T) {
 			},
 			ExpectedOutput: nil,
 			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {
-				usecase.EXPECT().GetUserReviews(1, 10, 1).Return(nil, errors.New("123"))
+				usecase.EXPECT().GetUserReviews(gomock.Any(), 1, 10, 1, dto.ReviewFilter{}).Return(nil, errors.New("123"))
 			},
 		},
 		{
@@ -1155,7 +1346,7 @@ func TestReviewEndpoints_GetUserReviews(t *testing.T) {
 			},
 			ExpectedOutput: nil,
 			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {
-				usecase.EXPECT().GetUserReviews(1, 10, 1).Return(nil, errors.New("123"))
+				usecase.EXPECT().GetUserReviews(gomock.Any(), 1, 10, 1, dto.ReviewFilter{}).Return(nil, errors.New("123"))
 			},
 		},
 	}
@@ -1169,7 +1360,10 @@ func TestReviewEndpoints_GetUserReviews(t *testing.T) {
 			defer ctrl.Finish()
 			mockReviewUsecase := mockusecase.NewMockReview(ctrl)
 			tc.SetupReviewUsecaseMock(mockReviewUsecase)
-			reviewHandler := NewReviewEndpoints(mockReviewUsecase, nil)
+			mockPrivacyUsecase := mockusecase.NewMockPrivacy(ctrl)
+			mockPrivacyUsecase.EXPECT().CanView(gomock.Any(), -1, 1, entity.PrivacySectionReviews).
+				Return(true, nil).AnyTimes()
+			reviewHandler := NewReviewEndpoints(mockReviewUsecase, nil, mockPrivacyUsecase)
 			req := httptest.NewRequest(http.MethodGet, "/review/user", nil)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
@@ -1195,6 +1389,7 @@ func TestReviewEndpoints_GetContentReviews(t *testing.T) {
 		Name                   string
 		ContentID              string
 		Page                   string
+		Query                  string
 		ExpectedErr            error
 		ExpectedOutput         *dto.ReviewResponseList
 		SetupReviewUsecaseMock func(usecase *mockusecase.MockReview)
@@ -1225,7 +1420,7 @@ func TestReviewEndpoints_GetContentReviews(t *testing.T) {
 				},
 			},
 			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {
-				usecase.EXPECT().GetContentReviews(1, 10, 1).Return(&dto.ReviewResponseList{
+				usecase.EXPECT().GetContentReviews(gomock.Any(), 1, 10, 1, dto.ReviewFilter{}).Return(&dto.ReviewResponseList{
 					Reviews: []dto.ReviewResponse{
 						{
 							Review: dto.Review{
@@ -1247,6 +1442,59 @@ func TestReviewEndpoints_GetContentReviews(t *testing.T) {
 				}, nil)
 			},
 		},
+		{
+			Name:        "Без рецензий со спойлерами",
+			ContentID:   "1",
+			Page:        "1",
+			Query:       "?hide_spoilers=true",
+			ExpectedErr: nil,
+			ExpectedOutput: &dto.ReviewResponseList{
+				Reviews: []dto.ReviewResponse{},
+			},
+			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {
+				usecase.EXPECT().GetContentReviews(gomock.Any(), 1, 10, 1, dto.ReviewFilter{HideSpoilers: true}).
+					Return(&dto.ReviewResponseList{Reviews: []dto.ReviewResponse{}}, nil)
+			},
+		},
+		{
+			Name:      "Невалидный фильтр спойлеров",
+			ContentID: "1",
+			Page:      "1",
+			Query:     "?hide_spoilers=ogo!",
+			ExpectedErr: &echo.HTTPError{
+				Code:    400,
+				Message: "невалидный параметр hide_spoilers",
+			},
+			ExpectedOutput:         nil,
+			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {},
+		},
+		{
+			Name:      "Неизвестная сортировка",
+			ContentID: "1",
+			Page:      "1",
+			Query:     "?sort=random",
+			ExpectedErr: &echo.HTTPError{
+				Code:    400,
+				Message: "неизвестная сортировка",
+			},
+			ExpectedOutput: nil,
+			SetupReviewUsecaseMock: func(uc *mockusecase.MockReview) {
+				uc.EXPECT().GetContentReviews(gomock.Any(), 1, 10, 1, dto.ReviewFilter{Sort: "random"}).
+					Return(nil, usecase.ReviewErrorIncorrectData{Err: errors.New("неизвестная сортировка")})
+			},
+		},
+		{
+			Name:      "Невалидная минимальная длина текста",
+			ContentID: "1",
+			Page:      "1",
+			Query:     "?min_length=-5",
+			ExpectedErr: &echo.HTTPError{
+				Code:    400,
+				Message: "невалидный параметр min_length",
+			},
+			ExpectedOutput:         nil,
+			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {},
+		},
 		{
 			Name:      "Неожиданная ошибка",
 			ContentID: "1",
@@ -1258,7 +1506,7 @@ func TestReviewEndpoints_GetContentReviews(t *testing.T) {
 			},
 			ExpectedOutput: nil,
 			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {
-				usecase.EXPECT().GetContentReviews(1, 10, 1).Return(nil, errors.New("123"))
+				usecase.EXPECT().GetContentReviews(gomock.Any(), 1, 10, 1, dto.ReviewFilter{}).Return(nil, errors.New("123"))
 			},
 		},
 		{
@@ -1294,8 +1542,8 @@ func TestReviewEndpoints_GetContentReviews(t *testing.T) {
 			defer ctrl.Finish()
 			mockReviewUsecase := mockusecase.NewMockReview(ctrl)
 			tc.SetupReviewUsecaseMock(mockReviewUsecase)
-			reviewHandler := NewReviewEndpoints(mockReviewUsecase, nil)
-			req := httptest.NewRequest(http.MethodGet, "/review/content", nil)
+			reviewHandler := NewReviewEndpoints(mockReviewUsecase, nil, nil)
+			req := httptest.NewRequest(http.MethodGet, "/review/content"+tc.Query, nil)
 			rec := httptest.NewRecorder()
 			c := e.NewContext(req, rec)
 			c.SetPath("/review/content/:id/:page")
@@ -1335,10 +1583,10 @@ func TestReviewEndpoints_VoteReview(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {
-				usecase.EXPECT().VoteReview(1, 1, true).Return(nil)
+				usecase.EXPECT().VoteReview(gomock.Any(), 1, 1, true).Return(nil)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 		{
@@ -1355,10 +1603,10 @@ func TestReviewEndpoints_VoteReview(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {
-				usecase.EXPECT().VoteReview(1, 1, true).Return(errors.New("123"))
+				usecase.EXPECT().VoteReview(gomock.Any(), 1, 1, true).Return(errors.New("123"))
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 		{
@@ -1404,10 +1652,10 @@ func TestReviewEndpoints_VoteReview(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupReviewUsecaseMock: func(uc *mockusecase.MockReview) {
-				uc.EXPECT().VoteReview(1, 1, true).Return(usecase.ErrReviewNotFound)
+				uc.EXPECT().VoteReview(gomock.Any(), 1, 1, true).Return(usecase.ErrReviewNotFound)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 		{
@@ -1424,7 +1672,7 @@ func TestReviewEndpoints_VoteReview(t *testing.T) {
 			},
 			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession(gomock.Any()).Return(-1, utils.ErrUnauthorized)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), gomock.Any()).Return(-1, utils.ErrUnauthorized)
 			},
 		},
 		{
@@ -1440,10 +1688,10 @@ func TestReviewEndpoints_VoteReview(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupReviewUsecaseMock: func(uc *mockusecase.MockReview) {
-				uc.EXPECT().VoteReview(1, 1, true).Return(usecase.ErrReviewVoteAlreadyExists)
+				uc.EXPECT().VoteReview(gomock.Any(), 1, 1, true).Return(usecase.ErrReviewVoteAlreadyExists)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 	}
@@ -1459,7 +1707,7 @@ func TestReviewEndpoints_VoteReview(t *testing.T) {
 			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
 			tc.SetupReviewUsecaseMock(mockReviewUsecase)
 			tc.SetupAuthUsecaseMock(mockAuthUsecase)
-			reviewHandler := NewReviewEndpoints(mockReviewUsecase, mockAuthUsecase)
+			reviewHandler := NewReviewEndpoints(mockReviewUsecase, mockAuthUsecase, nil)
 			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/review/like?vote=%s", tc.Vote), nil)
 			if tc.Cookies != nil {
 				req.AddCookie(tc.Cookies)
@@ -1495,10 +1743,10 @@ func TestReviewEndpoints_UnVoteReview(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {
-				usecase.EXPECT().UnVoteReview(1, 1).Return(nil)
+				usecase.EXPECT().UnVoteReview(gomock.Any(), 1, 1).Return(nil)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 		{
@@ -1514,10 +1762,10 @@ func TestReviewEndpoints_UnVoteReview(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {
-				usecase.EXPECT().UnVoteReview(1, 1).Return(errors.New("123"))
+				usecase.EXPECT().UnVoteReview(gomock.Any(), 1, 1).Return(errors.New("123"))
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 		{
@@ -1546,10 +1794,10 @@ func TestReviewEndpoints_UnVoteReview(t *testing.T) {
 				Value: "xxx",
 			},
 			SetupReviewUsecaseMock: func(uc *mockusecase.MockReview) {
-				uc.EXPECT().UnVoteReview(1, 1).Return(usecase.ErrReviewVoteNotFound)
+				uc.EXPECT().UnVoteReview(gomock.Any(), 1, 1).Return(usecase.ErrReviewVoteNotFound)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("xxx").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
 			},
 		},
 		{
@@ -1565,7 +1813,7 @@ func TestReviewEndpoints_UnVoteReview(t *testing.T) {
 			},
 			SetupReviewUsecaseMock: func(usecase *mockusecase.MockReview) {},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession(gomock.Any()).Return(-1, utils.ErrUnauthorized)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), gomock.Any()).Return(-1, utils.ErrUnauthorized)
 			},
 		},
 	}
@@ -1581,7 +1829,7 @@ func TestReviewEndpoints_UnVoteReview(t *testing.T) {
 			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
 			tc.SetupReviewUsecaseMock(mockReviewUsecase)
 			tc.SetupAuthUsecaseMock(mockAuthUsecase)
-			reviewHandler := NewReviewEndpoints(mockReviewUsecase, mockAuthUsecase)
+			reviewHandler := NewReviewEndpoints(mockReviewUsecase, mockAuthUsecase, nil)
 			req := httptest.NewRequest(http.MethodPost, "/review/unlike", nil)
 			if tc.Cookies != nil {
 				req.AddCookie(tc.Cookies)
@@ -36,7 +36,7 @@ func (h *SearchEndpoints) Search(ctx echo.Context) error {
 	if len(searchQuery) > 100 {
 		return utils.NewError(ctx, http.StatusBadRequest, "Слишком длинный запрос", nil)
 	}
-	searchResult, err := h.searchUC.Search(searchQuery)
+	searchResult, err := h.searchUC.Search(ctx.Request().Context(), searchQuery)
 	if err != nil {
 		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
 	}
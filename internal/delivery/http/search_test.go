@@ -45,7 +45,7 @@ func TestSearchEndpoints_Search(t *testing.T) {
 			},
 			ExpectedErr: nil,
 			SetupSearchUsecaseMock: func(usecase *mockusecase.MockSearch) {
-				usecase.EXPECT().Search("hello").Return(&dto.SearchResult{}, nil)
+				usecase.EXPECT().Search(gomock.Any(), "hello").Return(&dto.SearchResult{}, nil)
 			},
 		},
 		{
@@ -55,7 +55,7 @@ func TestSearchEndpoints_Search(t *testing.T) {
 			},
 			ExpectedErr: &echo.HTTPError{Code: 500, Message: "Внутренняя ошибка сервера", Internal: errors.New("123")},
 			SetupSearchUsecaseMock: func(usecase *mockusecase.MockSearch) {
-				usecase.EXPECT().Search("hello").Return(nil, errors.New("123"))
+				usecase.EXPECT().Search(gomock.Any(), "hello").Return(nil, errors.New("123"))
 			},
 		},
 	}
@@ -40,7 +40,7 @@ func (h *StaticEndpoints) GetStaticURL(ctx echo.Context) error {
 	if err != nil {
 		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id статики", nil)
 	}
-	staticURL, err := h.staticUC.GetStatic(int(id))
+	staticURL, err := h.staticUC.GetStatic(ctx.Request().Context(), int(id))
 	switch {
 	case errors.Is(err, usecase.ErrStaticNotFound):
 		return utils.NewError(ctx, http.StatusNotFound, "Статика не найдена", nil)
@@ -62,7 +62,7 @@ func (h *StaticEndpoints) GetStaticURL(ctx echo.Context) error {
 // @Router /static/{path} [get]
 func (h *StaticEndpoints) GetStaticFile(ctx echo.Context) error {
 	path := ctx.Param("path")
-	staticFile, err := h.staticUC.GetStaticFile(path)
+	staticFile, err := h.staticUC.GetStaticFile(ctx.Request().Context(), path)
 	switch {
 	case errors.Is(err, usecase.ErrStaticNotFound):
 		return utils.NewError(ctx, http.StatusNotFound, "Статика не найдена", nil)
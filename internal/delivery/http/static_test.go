@@ -27,7 +27,7 @@ func TestStaticEndpoints_GetStatic(t *testing.T) {
 			StaticId:    "1",
 			ExpectedErr: nil,
 			SetupStaticUsecaseMock: func(usecase *mockusecase.MockStatic) {
-				usecase.EXPECT().GetStatic(1).Return("static_url", nil)
+				usecase.EXPECT().GetStatic(gomock.Any(), 1).Return("static_url", nil)
 			},
 		},
 		{
@@ -41,7 +41,7 @@ func TestStaticEndpoints_GetStatic(t *testing.T) {
 			StaticId:    "2",
 			ExpectedErr: &echo.HTTPError{Code: 404, Message: "Статика не найдена"},
 			SetupStaticUsecaseMock: func(uc *mockusecase.MockStatic) {
-				uc.EXPECT().GetStatic(2).Return("", usecase.ErrStaticNotFound)
+				uc.EXPECT().GetStatic(gomock.Any(), 2).Return("", usecase.ErrStaticNotFound)
 			},
 		},
 		{
@@ -49,7 +49,7 @@ func TestStaticEndpoints_GetStatic(t *testing.T) {
 			StaticId:    "3",
 			ExpectedErr: &echo.HTTPError{Code: 500, Message: "Внутренняя ошибка сервера", Internal: errors.New("123")},
 			SetupStaticUsecaseMock: func(uc *mockusecase.MockStatic) {
-				uc.EXPECT().GetStatic(3).Return("", errors.New("123"))
+				uc.EXPECT().GetStatic(gomock.Any(), 3).Return("", errors.New("123"))
 			},
 		},
 	}
@@ -90,7 +90,7 @@ func TestStaticEndpoints_GetStaticFile(t *testing.T) {
 			StaticPath:  "path",
 			ExpectedErr: nil,
 			SetupStaticUsecaseMock: func(usecase *mockusecase.MockStatic) {
-				usecase.EXPECT().GetStaticFile("path").Return(bytes.NewReader([]byte{1}), nil)
+				usecase.EXPECT().GetStaticFile(gomock.Any(), "path").Return(bytes.NewReader([]byte{1}), nil)
 			},
 		},
 		{
@@ -98,7 +98,7 @@ func TestStaticEndpoints_GetStaticFile(t *testing.T) {
 			StaticPath:  "path",
 			ExpectedErr: &echo.HTTPError{Code: 404, Message: "Статика не найдена"},
 			SetupStaticUsecaseMock: func(uc *mockusecase.MockStatic) {
-				uc.EXPECT().GetStaticFile("path").Return(nil, usecase.ErrStaticNotFound)
+				uc.EXPECT().GetStaticFile(gomock.Any(), "path").Return(nil, usecase.ErrStaticNotFound)
 			},
 		},
 		{
@@ -106,7 +106,7 @@ func TestStaticEndpoints_GetStaticFile(t *testing.T) {
 			StaticPath:  "path",
 			ExpectedErr: &echo.HTTPError{Code: 500, Message: "Внутренняя ошибка сервера", Internal: errors.New("123")},
 			SetupStaticUsecaseMock: func(uc *mockusecase.MockStatic) {
-				uc.EXPECT().GetStaticFile("path").Return(nil, errors.New("123"))
+				uc.EXPECT().GetStaticFile(gomock.Any(), "path").Return(nil, errors.New("123"))
 			},
 		},
 	}
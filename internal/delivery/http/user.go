@@ -53,7 +53,7 @@ func (h *UserEndpoints) Register(ctx echo.Context) error {
 	if err := utils.ReadJSON(ctx, registerData); err != nil {
 		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный JSON", nil)
 	}
-	userID, err := h.userUC.Register(registerData)
+	userID, err := h.userUC.Register(ctx.Request().Context(), registerData)
 	var errUserIncorrectData usecase.UserIncorrectDataError
 	switch {
 	case errors.Is(err, usecase.ErrUserAlreadyExists):
@@ -90,7 +90,7 @@ func (h *UserEndpoints) Login(ctx echo.Context) error {
 	if err := utils.ReadJSON(ctx, loginData); err != nil {
 		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный JSON", nil)
 	}
-	userID, err := h.userUC.Login(loginData)
+	userID, err := h.userUC.Login(ctx.Request().Context(), loginData)
 	var errUserIncorrectData usecase.UserIncorrectDataError
 	switch {
 	case errors.Is(err, usecase.ErrUserNotFound):
@@ -130,7 +130,7 @@ func (h *UserEndpoints) UpdatePassword(ctx echo.Context) error {
 	if err = utils.ReadJSON(ctx, updateData); err != nil {
 		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный JSON", nil)
 	}
-	err = h.userUC.UpdatePassword(userID, updateData)
+	err = h.userUC.UpdatePassword(ctx.Request().Context(), userID, updateData)
 	switch {
 	case errors.Is(err, usecase.ErrUserNotFound):
 		return utils.NewError(ctx, http.StatusNotFound, "Пользователь не найден", err)
@@ -139,7 +139,7 @@ func (h *UserEndpoints) UpdatePassword(ctx echo.Context) error {
 	case err != nil:
 		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
 	}
-	_, err = h.authUC.CreateSession(userID)
+	_, err = h.authUC.CreateSession(ctx.Request().Context(), userID)
 	if err != nil {
 		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
 	}
@@ -170,7 +170,7 @@ func (h *UserEndpoints) UploadAvatar(ctx echo.Context) error {
 	if err != nil {
 		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный файл", nil)
 	}
-	err = h.userUC.UpdateAvatar(userID, file)
+	err = h.userUC.UpdateAvatar(ctx.Request().Context(), userID, file)
 	var errUserIncorrectData usecase.UserIncorrectDataError
 	switch {
 	case errors.Is(err, usecase.ErrUserNotFound):
@@ -207,7 +207,7 @@ func (h *UserEndpoints) UpdateInfo(ctx echo.Context) error {
 	if err = utils.ReadJSON(ctx, updateData); err != nil {
 		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный JSON", nil)
 	}
-	err = h.userUC.UpdateInfo(userID, updateData)
+	err = h.userUC.UpdateInfo(ctx.Request().Context(), userID, updateData)
 	var errUserIncorrectData usecase.UserIncorrectDataError
 	switch {
 	case errors.Is(err, usecase.ErrUserNotFound):
@@ -237,7 +237,7 @@ func (h *UserEndpoints) GetProfile(ctx echo.Context) error {
 	if err != nil {
 		return utils.NewError(ctx, http.StatusBadRequest, "Неверный id", nil)
 	}
-	user, err := h.userUC.GetUser(int(userID))
+	user, err := h.userUC.GetUser(ctx.Request().Context(), int(userID))
 	switch {
 	case errors.Is(err, usecase.ErrUserNotFound):
 		return utils.NewError(ctx, http.StatusNotFound, "Пользователь не найден", err)
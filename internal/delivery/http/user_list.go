@@ -0,0 +1,374 @@
+package http
+
+import (
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/http/utils"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"strconv"
+)
+
+type UserListEndpoints struct {
+	userListUC usecase.UserList
+	authUC     usecase.Auth
+}
+
+func NewUserListEndpoints(userListUC usecase.UserList, authUC usecase.Auth) UserListEndpoints {
+	return UserListEndpoints{userListUC: userListUC, authUC: authUC}
+}
+
+func (h *UserListEndpoints) Configure(server *echo.Group) {
+	server.POST("", h.CreateList)
+	server.GET("/my", h.GetMyLists)
+	server.GET("/user/:id", h.GetUserLists)
+	server.GET("/:id", h.GetList)
+	server.PUT("/:id", h.UpdateList)
+	server.DELETE("/:id", h.DeleteList)
+	server.POST("/:id/item", h.AddItem)
+	server.PUT("/:id/item/:contentID", h.UpdateItem)
+	server.DELETE("/:id/item/:contentID", h.RemoveItem)
+	server.PUT("/:id/order", h.ReorderItems)
+}
+
+// userListError возвращает ответ для ошибок, общих для изменения списка и его элементов
+func userListError(ctx echo.Context, err error) error {
+	var listErr usecase.UserListErrorIncorrectData
+	switch {
+	case errors.Is(err, usecase.ErrUserListNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Список не найден", err)
+	case errors.Is(err, usecase.ErrUserListForbidden):
+		return utils.NewError(ctx, http.StatusForbidden, "Это чужой список", err)
+	case errors.Is(err, usecase.ErrUserListItemNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Контента нет в списке", err)
+	case errors.As(err, &listErr):
+		return utils.NewError(ctx, http.StatusBadRequest, listErr.Error(), err)
+	default:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	}
+}
+
+// CreateList
+// @Summary Создать список
+// @Tags list
+// @Description Создает именованный список контента. Приватный список видит только его автор
+// @Accept json
+// @Produce json
+// @Param list body dto.UserListRequest true "Данные списка"
+// @Success 200 {object} dto.UserList
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/list [post]
+// @Security _csrf
+func (h *UserListEndpoints) CreateList(ctx echo.Context) error {
+	listRequest := new(dto.UserListRequest)
+	if err := utils.ReadJSON(ctx, listRequest); err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный запрос", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	list, err := h.userListUC.CreateList(ctx.Request().Context(), userID, *listRequest)
+	if err != nil {
+		return userListError(ctx, err)
+	}
+	return utils.WriteJSON(ctx, list)
+}
+
+// GetList
+// @Summary Список с элементами
+// @Tags list
+// @Description Список вместе с элементами по порядку и превью контента. Чужой приватный список и списки
+// пользователя, скрывшего их настройками приватности, не найдутся
+// @Produce json
+// @Param id path int true "ID списка"
+// @Success 200 {object} dto.UserListWithItems
+// @Failure 400 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/list/{id} [get]
+func (h *UserListEndpoints) GetList(ctx echo.Context) error {
+	listID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id списка", nil)
+	}
+	// если не удалось получить id пользователя из сессии, то это не ошибка, просто неавторизованный пользователь
+	// no-lint
+	clientUserID, _ := utils.GetUserIDFromSession(ctx, h.authUC)
+	list, err := h.userListUC.GetList(ctx.Request().Context(), clientUserID, int(listID))
+	switch {
+	case errors.Is(err, usecase.ErrUserListNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Список не найден", err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return utils.WriteJSON(ctx, list)
+	}
+}
+
+// GetMyLists
+// @Summary Мои списки
+// @Tags list
+// @Description Все списки текущего пользователя, включая приватные, начиная с последних измененных
+// @Produce json
+// @Success 200 {object} dto.UserLists
+// @Failure 401 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/list/my [get]
+func (h *UserListEndpoints) GetMyLists(ctx echo.Context) error {
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	lists, err := h.userListUC.GetUserLists(ctx.Request().Context(), userID, userID)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	}
+	return utils.WriteJSON(ctx, lists)
+}
+
+// GetUserLists
+// @Summary Списки пользователя
+// @Tags list
+// @Description Публичные списки пользователя, начиная с последних измененных. Автору возвращаются и приватные.
+// Если пользователь скрыл списки настройками приватности, возвращается 403
+// @Produce json
+// @Param id path int true "ID пользователя"
+// @Success 200 {object} dto.UserLists
+// @Failure 400 {object} echo.HTTPError
+// @Failure 403 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/list/user/{id} [get]
+func (h *UserListEndpoints) GetUserLists(ctx echo.Context) error {
+	ownerID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id пользователя", nil)
+	}
+	// если не удалось получить id пользователя из сессии, то это не ошибка, просто неавторизованный пользователь
+	// no-lint
+	clientUserID, _ := utils.GetUserIDFromSession(ctx, h.authUC)
+	lists, err := h.userListUC.GetUserLists(ctx.Request().Context(), clientUserID, int(ownerID))
+	switch {
+	case errors.Is(err, usecase.ErrUserListsHidden):
+		return utils.NewError(ctx, http.StatusForbidden, "Пользователь скрыл списки", err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return utils.WriteJSON(ctx, lists)
+	}
+}
+
+// UpdateList
+// @Summary Изменить список
+// @Tags list
+// @Description Изменяет название, описание и видимость списка
+// @Accept json
+// @Produce json
+// @Param id path int true "ID списка"
+// @Param list body dto.UserListRequest true "Данные списка"
+// @Success 200 {object} dto.UserList
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 403 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/list/{id} [put]
+// @Security _csrf
+func (h *UserListEndpoints) UpdateList(ctx echo.Context) error {
+	listID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id списка", nil)
+	}
+	listRequest := new(dto.UserListRequest)
+	if err = utils.ReadJSON(ctx, listRequest); err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный запрос", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	list, err := h.userListUC.UpdateList(ctx.Request().Context(), userID, int(listID), *listRequest)
+	if err != nil {
+		return userListError(ctx, err)
+	}
+	return utils.WriteJSON(ctx, list)
+}
+
+// DeleteList
+// @Summary Удалить список
+// @Tags list
+// @Param id path int true "ID списка"
+// @Success 200
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 403 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/list/{id} [delete]
+// @Security _csrf
+func (h *UserListEndpoints) DeleteList(ctx echo.Context) error {
+	listID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id списка", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	if err = h.userListUC.DeleteList(ctx.Request().Context(), userID, int(listID)); err != nil {
+		return userListError(ctx, err)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// AddItem
+// @Summary Добавить контент в список
+// @Tags list
+// @Description Добавляет контент в конец списка. Заметка необязательна
+// @Accept json
+// @Param id path int true "ID списка"
+// @Param item body dto.UserListItemRequest true "Элемент списка"
+// @Success 200
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 403 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 409 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/list/{id}/item [post]
+// @Security _csrf
+func (h *UserListEndpoints) AddItem(ctx echo.Context) error {
+	listID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id списка", nil)
+	}
+	itemRequest := new(dto.UserListItemRequest)
+	if err = utils.ReadJSON(ctx, itemRequest); err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный запрос", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	err = h.userListUC.AddItem(ctx.Request().Context(), userID, int(listID), *itemRequest)
+	switch {
+	case errors.Is(err, usecase.ErrUserListContentNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Контент не найден", err)
+	case errors.Is(err, usecase.ErrUserListItemAlreadyExists):
+		return utils.NewError(ctx, http.StatusConflict, "Контент уже есть в списке", err)
+	case err != nil:
+		return userListError(ctx, err)
+	default:
+		return ctx.NoContent(http.StatusOK)
+	}
+}
+
+// UpdateItem
+// @Summary Изменить заметку к элементу списка
+// @Tags list
+// @Accept json
+// @Param id path int true "ID списка"
+// @Param contentID path int true "ID контента"
+// @Param item body dto.UserListItemRequest true "Элемент списка"
+// @Success 200
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 403 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/list/{id}/item/{contentID} [put]
+// @Security _csrf
+func (h *UserListEndpoints) UpdateItem(ctx echo.Context) error {
+	listID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id списка", nil)
+	}
+	contentID, err := strconv.ParseInt(ctx.Param("contentID"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id контента", nil)
+	}
+	itemRequest := new(dto.UserListItemRequest)
+	if err = utils.ReadJSON(ctx, itemRequest); err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный запрос", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	err = h.userListUC.UpdateItem(ctx.Request().Context(), userID, int(listID), int(contentID), *itemRequest)
+	if err != nil {
+		return userListError(ctx, err)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// RemoveItem
+// @Summary Удалить контент из списка
+// @Tags list
+// @Description Удаляет контент из списка, следующие элементы сдвигаются на одну позицию вверх
+// @Param id path int true "ID списка"
+// @Param contentID path int true "ID контента"
+// @Success 200
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 403 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/list/{id}/item/{contentID} [delete]
+// @Security _csrf
+func (h *UserListEndpoints) RemoveItem(ctx echo.Context) error {
+	listID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id списка", nil)
+	}
+	contentID, err := strconv.ParseInt(ctx.Param("contentID"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id контента", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	if err = h.userListUC.RemoveItem(ctx.Request().Context(), userID, int(listID), int(contentID)); err != nil {
+		return userListError(ctx, err)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
+
+// ReorderItems
+// @Summary Изменить порядок элементов списка
+// @Tags list
+// @Description Расставляет элементы в переданном порядке. Порядок должен содержать каждый элемент списка ровно
+// один раз
+// @Accept json
+// @Param id path int true "ID списка"
+// @Param order body dto.UserListOrderRequest true "Новый порядок"
+// @Success 200
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 403 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/list/{id}/order [put]
+// @Security _csrf
+func (h *UserListEndpoints) ReorderItems(ctx echo.Context) error {
+	listID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id списка", nil)
+	}
+	orderRequest := new(dto.UserListOrderRequest)
+	if err = utils.ReadJSON(ctx, orderRequest); err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный запрос", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	if err = h.userListUC.ReorderItems(ctx.Request().Context(), userID, int(listID), *orderRequest); err != nil {
+		return userListError(ctx, err)
+	}
+	return ctx.NoContent(http.StatusOK)
+}
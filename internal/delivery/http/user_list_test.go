@@ -0,0 +1,206 @@
+package http
+
+import (
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	mockusecase "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestUserListEndpoints_GetList(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                     string
+		ListID                   string
+		Cookie                   *http.Cookie
+		ExpectedErr              error
+		SetupUserListUsecaseMock func(uc *mockusecase.MockUserList)
+		SetupAuthUsecaseMock     func(uc *mockusecase.MockAuth)
+	}{
+		{
+			Name:        "Неавторизованный пользователь",
+			ListID:      "1",
+			ExpectedErr: nil,
+			SetupUserListUsecaseMock: func(uc *mockusecase.MockUserList) {
+				uc.EXPECT().GetList(gomock.Any(), -1, 1).Return(&dto.UserListWithItems{}, nil)
+			},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {},
+		},
+		{
+			Name:        "Свой приватный список",
+			ListID:      "1",
+			Cookie:      &http.Cookie{Name: "session", Value: "xxx"},
+			ExpectedErr: nil,
+			SetupUserListUsecaseMock: func(uc *mockusecase.MockUserList) {
+				uc.EXPECT().GetList(gomock.Any(), 2, 1).Return(&dto.UserListWithItems{}, nil)
+			},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {
+				uc.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(2, nil)
+			},
+		},
+		{
+			Name:        "Список не найден",
+			ListID:      "1",
+			ExpectedErr: &echo.HTTPError{Code: 404, Message: "Список не найден"},
+			SetupUserListUsecaseMock: func(uc *mockusecase.MockUserList) {
+				uc.EXPECT().GetList(gomock.Any(), -1, 1).Return(nil, usecase.ErrUserListNotFound)
+			},
+			SetupAuthUsecaseMock: func(uc *mockusecase.MockAuth) {},
+		},
+		{
+			Name:                     "Невалидный id",
+			ListID:                   "abc",
+			ExpectedErr:              &echo.HTTPError{Code: 400, Message: "Невалидный id списка"},
+			SetupUserListUsecaseMock: func(uc *mockusecase.MockUserList) {},
+			SetupAuthUsecaseMock:     func(uc *mockusecase.MockAuth) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUserListUsecase := mockusecase.NewMockUserList(ctrl)
+			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
+			tc.SetupUserListUsecaseMock(mockUserListUsecase)
+			tc.SetupAuthUsecaseMock(mockAuthUsecase)
+			userListHandler := NewUserListEndpoints(mockUserListUsecase, mockAuthUsecase)
+			req := httptest.NewRequest(http.MethodGet, "/list/", nil)
+			if tc.Cookie != nil {
+				req.AddCookie(tc.Cookie)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/list/:id")
+			c.SetParamNames("id")
+			c.SetParamValues(tc.ListID)
+			err := userListHandler.GetList(c)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestUserListEndpoints_GetUserLists(t *testing.T) {
+	t.Parallel()
+
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockUserListUsecase := mockusecase.NewMockUserList(ctrl)
+	mockUserListUsecase.EXPECT().GetUserLists(gomock.Any(), -1, 1).Return(nil, usecase.ErrUserListsHidden)
+	userListHandler := NewUserListEndpoints(mockUserListUsecase, nil)
+	req := httptest.NewRequest(http.MethodGet, "/list/user/", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/list/user/:id")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+	err := userListHandler.GetUserLists(c)
+	require.Equal(t, &echo.HTTPError{Code: 403, Message: "Пользователь скрыл списки"}, err)
+}
+
+func TestUserListEndpoints_AddItem(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                     string
+		Body                     string
+		ExpectedErr              error
+		SetupUserListUsecaseMock func(uc *mockusecase.MockUserList)
+	}{
+		{
+			Name:        "Успешное добавление",
+			Body:        `{"contentID":5,"note":"заметка"}`,
+			ExpectedErr: nil,
+			SetupUserListUsecaseMock: func(uc *mockusecase.MockUserList) {
+				uc.EXPECT().AddItem(gomock.Any(), 2, 1, dto.UserListItemRequest{ContentID: 5, Note: "заметка"}).
+					Return(nil)
+			},
+		},
+		{
+			Name:        "Контент уже в списке",
+			Body:        `{"contentID":5}`,
+			ExpectedErr: &echo.HTTPError{Code: 409, Message: "Контент уже есть в списке"},
+			SetupUserListUsecaseMock: func(uc *mockusecase.MockUserList) {
+				uc.EXPECT().AddItem(gomock.Any(), 2, 1, gomock.Any()).Return(usecase.ErrUserListItemAlreadyExists)
+			},
+		},
+		{
+			Name:        "Чужой список",
+			Body:        `{"contentID":5}`,
+			ExpectedErr: &echo.HTTPError{Code: 403, Message: "Это чужой список"},
+			SetupUserListUsecaseMock: func(uc *mockusecase.MockUserList) {
+				uc.EXPECT().AddItem(gomock.Any(), 2, 1, gomock.Any()).Return(usecase.ErrUserListForbidden)
+			},
+		},
+		{
+			Name:        "Длинная заметка",
+			Body:        `{"contentID":5,"note":"..."}`,
+			ExpectedErr: &echo.HTTPError{Code: 400, Message: "заметка должна быть не длиннее 500 символов"},
+			SetupUserListUsecaseMock: func(uc *mockusecase.MockUserList) {
+				uc.EXPECT().AddItem(gomock.Any(), 2, 1, gomock.Any()).Return(usecase.UserListErrorIncorrectData{
+					Err: errors.New("заметка должна быть не длиннее 500 символов"),
+				})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUserListUsecase := mockusecase.NewMockUserList(ctrl)
+			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
+			mockAuthUsecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(2, nil)
+			tc.SetupUserListUsecaseMock(mockUserListUsecase)
+			userListHandler := NewUserListEndpoints(mockUserListUsecase, mockAuthUsecase)
+			req := httptest.NewRequest(http.MethodPost, "/list/", strings.NewReader(tc.Body))
+			req.AddCookie(&http.Cookie{Name: "session", Value: "xxx"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/list/:id/item")
+			c.SetParamNames("id")
+			c.SetParamValues("1")
+			err := userListHandler.AddItem(c)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestUserListEndpoints_ReorderItems(t *testing.T) {
+	t.Parallel()
+
+	e := echo.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockUserListUsecase := mockusecase.NewMockUserList(ctrl)
+	mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
+	mockAuthUsecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(2, nil)
+	mockUserListUsecase.EXPECT().
+		ReorderItems(gomock.Any(), 2, 1, dto.UserListOrderRequest{ContentIDs: []int{3, 5}}).
+		Return(nil)
+	userListHandler := NewUserListEndpoints(mockUserListUsecase, mockAuthUsecase)
+	req := httptest.NewRequest(http.MethodPut, "/list/", strings.NewReader(`{"contentIDs":[3,5]}`))
+	req.AddCookie(&http.Cookie{Name: "session", Value: "xxx"})
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/list/:id/order")
+	c.SetParamNames("id")
+	c.SetParamValues("1")
+	require.NoError(t, userListHandler.ReorderItems(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+}
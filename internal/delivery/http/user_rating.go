@@ -0,0 +1,290 @@
+package http
+
+import (
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/http/utils"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"strconv"
+)
+
+type UserRatingEndpoints struct {
+	ratingUC usecase.UserRating
+	authUC   usecase.Auth
+}
+
+func NewUserRatingEndpoints(ratingUC usecase.UserRating, authUC usecase.Auth) UserRatingEndpoints {
+	return UserRatingEndpoints{ratingUC: ratingUC, authUC: authUC}
+}
+
+func (h *UserRatingEndpoints) Configure(server *echo.Group) {
+	server.GET("/my/:page", h.GetMyRatings)
+	server.GET("/content/:id", h.GetMyRating)
+	server.PUT("/content/:id", h.SetRating)
+	server.DELETE("/content/:id", h.DeleteRating)
+	server.PUT("/season/:id", h.SetSeasonRating)
+	server.DELETE("/season/:id", h.DeleteSeasonRating)
+	server.PUT("/episode/:id", h.SetEpisodeRating)
+	server.DELETE("/episode/:id", h.DeleteEpisodeRating)
+}
+
+// GetMyRatings
+// @Summary Мои оценки
+// @Tags rating
+// @Description Оценки контента без рецензии, поставленные текущим пользователем, начиная с последних измененных
+// @Produce json
+// @Param page path int true "Номер страницы"
+// @Success 200 {object} dto.UserRatingList
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/rating/my/{page} [get]
+func (h *UserRatingEndpoints) GetMyRatings(ctx echo.Context) error {
+	page, err := strconv.ParseInt(ctx.Param("page"), 10, 64)
+	if err != nil || page < 1 {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный номер страницы", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	ratings, err := h.ratingUC.GetUserRatings(ctx.Request().Context(), userID, 20, int(page))
+	if err != nil {
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	}
+	return utils.WriteJSON(ctx, ratings)
+}
+
+// GetMyRating
+// @Summary Моя оценка контента
+// @Tags rating
+// @Description Оценка контента без рецензии, поставленная текущим пользователем
+// @Produce json
+// @Param id path int true "ID контента"
+// @Success 200 {object} dto.UserRating
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/rating/content/{id} [get]
+func (h *UserRatingEndpoints) GetMyRating(ctx echo.Context) error {
+	contentID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id контента", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	rating, err := h.ratingUC.GetRating(ctx.Request().Context(), userID, int(contentID))
+	switch {
+	case errors.Is(err, usecase.ErrUserRatingNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Оценка не найдена", err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return utils.WriteJSON(ctx, rating)
+	}
+}
+
+// SetRating
+// @Summary Оценить контент
+// @Tags rating
+// @Description Оценить контент от 1 до 10 без рецензии. Повторная оценка заменяет предыдущую. Если у пользователя
+// есть рецензия на контент, в рейтинге контента учитывается оценка рецензии
+// @Accept json
+// @Produce json
+// @Param id path int true "ID контента"
+// @Param rating body dto.UserRatingRequest true "Оценка"
+// @Success 200 {object} dto.UserRating
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/rating/content/{id} [put]
+// @Security _csrf
+func (h *UserRatingEndpoints) SetRating(ctx echo.Context) error {
+	contentID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id контента", nil)
+	}
+	ratingRequest := new(dto.UserRatingRequest)
+	if err = utils.ReadJSON(ctx, ratingRequest); err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный запрос", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	rating, err := h.ratingUC.SetRating(ctx.Request().Context(), dto.UserRatingSet{
+		UserRatingRequest: *ratingRequest,
+		ContentID:         int(contentID),
+		UserID:            userID,
+	})
+	switch {
+	case errors.Is(err, usecase.ErrUserRatingContentNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Контент не найден", err)
+	case errors.Is(err, usecase.ErrUserRatingOutOfRange):
+		return utils.NewError(ctx, http.StatusBadRequest, "Оценка должна быть в диапазоне от 1 до 10", err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return utils.WriteJSON(ctx, rating)
+	}
+}
+
+// DeleteRating
+// @Summary Удалить оценку контента
+// @Tags rating
+// @Description Удалить оценку контента без рецензии
+// @Param id path int true "ID контента"
+// @Success 200
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/rating/content/{id} [delete]
+// @Security _csrf
+func (h *UserRatingEndpoints) DeleteRating(ctx echo.Context) error {
+	contentID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id контента", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	err = h.ratingUC.DeleteRating(ctx.Request().Context(), userID, int(contentID))
+	switch {
+	case errors.Is(err, usecase.ErrUserRatingNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Оценка не найдена", err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return ctx.NoContent(http.StatusOK)
+	}
+}
+
+// SetSeasonRating
+// @Summary Оценить сезон сериала
+// @Tags rating
+// @Description Оценить сезон сериала от 1 до 10. Повторная оценка заменяет предыдущую. На рейтинг сериала не влияет
+// @Accept json
+// @Param id path int true "ID сезона"
+// @Param rating body dto.UserRatingRequest true "Оценка"
+// @Success 200
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/rating/season/{id} [put]
+// @Security _csrf
+func (h *UserRatingEndpoints) SetSeasonRating(ctx echo.Context) error {
+	return h.setSeriesPartRating(ctx, entity.SeriesPartSeason)
+}
+
+// DeleteSeasonRating
+// @Summary Удалить оценку сезона сериала
+// @Tags rating
+// @Description Удалить оценку сезона сериала
+// @Param id path int true "ID сезона"
+// @Success 200
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/rating/season/{id} [delete]
+// @Security _csrf
+func (h *UserRatingEndpoints) DeleteSeasonRating(ctx echo.Context) error {
+	return h.deleteSeriesPartRating(ctx, entity.SeriesPartSeason)
+}
+
+// SetEpisodeRating
+// @Summary Оценить эпизод сериала
+// @Tags rating
+// @Description Оценить эпизод сериала от 1 до 10. Повторная оценка заменяет предыдущую. На рейтинг сериала не влияет
+// @Accept json
+// @Param id path int true "ID эпизода"
+// @Param rating body dto.UserRatingRequest true "Оценка"
+// @Success 200
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/rating/episode/{id} [put]
+// @Security _csrf
+func (h *UserRatingEndpoints) SetEpisodeRating(ctx echo.Context) error {
+	return h.setSeriesPartRating(ctx, entity.SeriesPartEpisode)
+}
+
+// DeleteEpisodeRating
+// @Summary Удалить оценку эпизода сериала
+// @Tags rating
+// @Description Удалить оценку эпизода сериала
+// @Param id path int true "ID эпизода"
+// @Success 200
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/rating/episode/{id} [delete]
+// @Security _csrf
+func (h *UserRatingEndpoints) DeleteEpisodeRating(ctx echo.Context) error {
+	return h.deleteSeriesPartRating(ctx, entity.SeriesPartEpisode)
+}
+
+func (h *UserRatingEndpoints) setSeriesPartRating(ctx echo.Context, part entity.SeriesPart) error {
+	partID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id", nil)
+	}
+	ratingRequest := new(dto.UserRatingRequest)
+	if err = utils.ReadJSON(ctx, ratingRequest); err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный запрос", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	err = h.ratingUC.SetSeriesPartRating(ctx.Request().Context(), dto.SeriesPartRatingSet{
+		UserRatingRequest: *ratingRequest,
+		Part:              string(part),
+		PartID:            int(partID),
+		UserID:            userID,
+	})
+	switch {
+	case errors.Is(err, usecase.ErrUserRatingSeriesPartNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Сезон или эпизод не найден", err)
+	case errors.Is(err, usecase.ErrUserRatingOutOfRange):
+		return utils.NewError(ctx, http.StatusBadRequest, "Оценка должна быть в диапазоне от 1 до 10", err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return ctx.NoContent(http.StatusOK)
+	}
+}
+
+func (h *UserRatingEndpoints) deleteSeriesPartRating(ctx echo.Context, part entity.SeriesPart) error {
+	partID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	err = h.ratingUC.DeleteSeriesPartRating(ctx.Request().Context(), string(part), userID, int(partID))
+	switch {
+	case errors.Is(err, usecase.ErrUserRatingSeriesPartNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Сезон или эпизод не найден", err)
+	case errors.Is(err, usecase.ErrUserRatingNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Оценка не найдена", err)
+	case err != nil:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	default:
+		return ctx.NoContent(http.StatusOK)
+	}
+}
@@ -0,0 +1,213 @@
+package http
+
+import (
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	mockusecase "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestUserRatingEndpoints_SetRating(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                   string
+		ContentID              string
+		Body                   string
+		ExpectedErr            error
+		SetupRatingUsecaseMock func(usecase *mockusecase.MockUserRating)
+	}{
+		{
+			Name:        "Успешная оценка",
+			ContentID:   "2",
+			Body:        `{"rating":8}`,
+			ExpectedErr: nil,
+			SetupRatingUsecaseMock: func(uc *mockusecase.MockUserRating) {
+				uc.EXPECT().SetRating(gomock.Any(), dto.UserRatingSet{
+					UserRatingRequest: dto.UserRatingRequest{Rating: 8},
+					ContentID:         2,
+					UserID:            1,
+				}).Return(&dto.UserRating{ContentID: 2, Rating: 8}, nil)
+			},
+		},
+		{
+			Name:        "Оценка вне диапазона",
+			ContentID:   "2",
+			Body:        `{"rating":0}`,
+			ExpectedErr: &echo.HTTPError{Code: 400, Message: "Оценка должна быть в диапазоне от 1 до 10"},
+			SetupRatingUsecaseMock: func(uc *mockusecase.MockUserRating) {
+				uc.EXPECT().SetRating(gomock.Any(), gomock.Any()).Return(nil, usecase.ErrUserRatingOutOfRange)
+			},
+		},
+		{
+			Name:        "Контент не найден",
+			ContentID:   "2",
+			Body:        `{"rating":8}`,
+			ExpectedErr: &echo.HTTPError{Code: 404, Message: "Контент не найден"},
+			SetupRatingUsecaseMock: func(uc *mockusecase.MockUserRating) {
+				uc.EXPECT().SetRating(gomock.Any(), gomock.Any()).Return(nil, usecase.ErrUserRatingContentNotFound)
+			},
+		},
+		{
+			Name:        "Внутренняя ошибка",
+			ContentID:   "2",
+			Body:        `{"rating":8}`,
+			ExpectedErr: &echo.HTTPError{Code: 500, Message: "Внутренняя ошибка сервера", Internal: errors.New("ошибка")},
+			SetupRatingUsecaseMock: func(uc *mockusecase.MockUserRating) {
+				uc.EXPECT().SetRating(gomock.Any(), gomock.Any()).Return(nil, errors.New("ошибка"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRatingUsecase := mockusecase.NewMockUserRating(ctrl)
+			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
+			mockAuthUsecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			tc.SetupRatingUsecaseMock(mockRatingUsecase)
+			ratingHandler := NewUserRatingEndpoints(mockRatingUsecase, mockAuthUsecase)
+			req := httptest.NewRequest(http.MethodPut, "/rating/", strings.NewReader(tc.Body))
+			req.AddCookie(&http.Cookie{Name: "session", Value: "xxx"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/rating/content/:id")
+			c.SetParamNames("id")
+			c.SetParamValues(tc.ContentID)
+			err := ratingHandler.SetRating(c)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestUserRatingEndpoints_DeleteRating(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                   string
+		ContentID              string
+		ExpectedErr            error
+		SetupRatingUsecaseMock func(usecase *mockusecase.MockUserRating)
+	}{
+		{
+			Name:        "Успешное удаление",
+			ContentID:   "2",
+			ExpectedErr: nil,
+			SetupRatingUsecaseMock: func(uc *mockusecase.MockUserRating) {
+				uc.EXPECT().DeleteRating(gomock.Any(), 1, 2).Return(nil)
+			},
+		},
+		{
+			Name:        "Оценка не найдена",
+			ContentID:   "2",
+			ExpectedErr: &echo.HTTPError{Code: 404, Message: "Оценка не найдена"},
+			SetupRatingUsecaseMock: func(uc *mockusecase.MockUserRating) {
+				uc.EXPECT().DeleteRating(gomock.Any(), 1, 2).Return(usecase.ErrUserRatingNotFound)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRatingUsecase := mockusecase.NewMockUserRating(ctrl)
+			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
+			mockAuthUsecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			tc.SetupRatingUsecaseMock(mockRatingUsecase)
+			ratingHandler := NewUserRatingEndpoints(mockRatingUsecase, mockAuthUsecase)
+			req := httptest.NewRequest(http.MethodDelete, "/rating/", nil)
+			req.AddCookie(&http.Cookie{Name: "session", Value: "xxx"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/rating/content/:id")
+			c.SetParamNames("id")
+			c.SetParamValues(tc.ContentID)
+			err := ratingHandler.DeleteRating(c)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestUserRatingEndpoints_SetEpisodeRating(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                   string
+		EpisodeID              string
+		Body                   string
+		ExpectedErr            error
+		SetupRatingUsecaseMock func(usecase *mockusecase.MockUserRating)
+	}{
+		{
+			Name:        "Успешная оценка",
+			EpisodeID:   "5",
+			Body:        `{"rating":9}`,
+			ExpectedErr: nil,
+			SetupRatingUsecaseMock: func(uc *mockusecase.MockUserRating) {
+				uc.EXPECT().SetSeriesPartRating(gomock.Any(), dto.SeriesPartRatingSet{
+					UserRatingRequest: dto.UserRatingRequest{Rating: 9},
+					Part:              "episode",
+					PartID:            5,
+					UserID:            1,
+				}).Return(nil)
+			},
+		},
+		{
+			Name:        "Эпизод не найден",
+			EpisodeID:   "5",
+			Body:        `{"rating":9}`,
+			ExpectedErr: &echo.HTTPError{Code: 404, Message: "Сезон или эпизод не найден"},
+			SetupRatingUsecaseMock: func(uc *mockusecase.MockUserRating) {
+				uc.EXPECT().SetSeriesPartRating(gomock.Any(), gomock.Any()).Return(usecase.ErrUserRatingSeriesPartNotFound)
+			},
+		},
+		{
+			Name:        "Оценка вне диапазона",
+			EpisodeID:   "5",
+			Body:        `{"rating":11}`,
+			ExpectedErr: &echo.HTTPError{Code: 400, Message: "Оценка должна быть в диапазоне от 1 до 10"},
+			SetupRatingUsecaseMock: func(uc *mockusecase.MockUserRating) {
+				uc.EXPECT().SetSeriesPartRating(gomock.Any(), gomock.Any()).Return(usecase.ErrUserRatingOutOfRange)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRatingUsecase := mockusecase.NewMockUserRating(ctrl)
+			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
+			mockAuthUsecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			tc.SetupRatingUsecaseMock(mockRatingUsecase)
+			ratingHandler := NewUserRatingEndpoints(mockRatingUsecase, mockAuthUsecase)
+			req := httptest.NewRequest(http.MethodPut, "/rating/", strings.NewReader(tc.Body))
+			req.AddCookie(&http.Cookie{Name: "session", Value: "xxx"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/rating/episode/:id")
+			c.SetParamNames("id")
+			c.SetParamValues(tc.EpisodeID)
+			err := ratingHandler.SetEpisodeRating(c)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
@@ -52,10 +52,10 @@ func TestUserEndpoints_Register(t *testing.T) {
 			},
 			ExpectedErr: nil,
 			SetupUserUsecaseMock: func(usecase *mockusecase.MockUser) {
-				usecase.EXPECT().Register(gomock.Any()).Return(1, nil)
+				usecase.EXPECT().Register(gomock.Any(), gomock.Any()).Return(1, nil)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().CreateSession(1).Return("session", nil)
+				usecase.EXPECT().CreateSession(gomock.Any(), 1).Return("session", nil)
 			},
 			SetupStaticUsecaseMock: func(usecase *mockusecase.MockStatic) {},
 		},
@@ -70,7 +70,7 @@ func TestUserEndpoints_Register(t *testing.T) {
 			},
 			ExpectedErr: &echo.HTTPError{Code: 500, Message: "Внутренняя ошибка сервера", Internal: errors.New("123")},
 			SetupUserUsecaseMock: func(uc *mockusecase.MockUser) {
-				uc.EXPECT().Register(gomock.Any()).Return(0, errors.New("123"))
+				uc.EXPECT().Register(gomock.Any(), gomock.Any()).Return(0, errors.New("123"))
 			},
 			SetupAuthUsecaseMock:   func(usecase *mockusecase.MockAuth) {},
 			SetupStaticUsecaseMock: func(usecase *mockusecase.MockStatic) {},
@@ -86,7 +86,7 @@ func TestUserEndpoints_Register(t *testing.T) {
 			},
 			ExpectedErr: &echo.HTTPError{Code: 409, Message: "Пользователь с такой почтой уже существует"},
 			SetupUserUsecaseMock: func(uc *mockusecase.MockUser) {
-				uc.EXPECT().Register(gomock.Any()).Return(0, usecase.ErrUserAlreadyExists)
+				uc.EXPECT().Register(gomock.Any(), gomock.Any()).Return(0, usecase.ErrUserAlreadyExists)
 			},
 			SetupAuthUsecaseMock:   func(usecase *mockusecase.MockAuth) {},
 			SetupStaticUsecaseMock: func(usecase *mockusecase.MockStatic) {},
@@ -102,7 +102,7 @@ func TestUserEndpoints_Register(t *testing.T) {
 			},
 			ExpectedErr: &echo.HTTPError{Code: 400, Message: "123"},
 			SetupUserUsecaseMock: func(uc *mockusecase.MockUser) {
-				uc.EXPECT().Register(gomock.Any()).Return(0, usecase.UserIncorrectDataError{Err: errors.New("123")})
+				uc.EXPECT().Register(gomock.Any(), gomock.Any()).Return(0, usecase.UserIncorrectDataError{Err: errors.New("123")})
 			},
 			SetupAuthUsecaseMock:   func(usecase *mockusecase.MockAuth) {},
 			SetupStaticUsecaseMock: func(usecase *mockusecase.MockStatic) {},
@@ -118,10 +118,10 @@ func TestUserEndpoints_Register(t *testing.T) {
 			},
 			ExpectedErr: &echo.HTTPError{Code: 500, Message: "Внутренняя ошибка сервера", Internal: errors.New("123")},
 			SetupUserUsecaseMock: func(usecase *mockusecase.MockUser) {
-				usecase.EXPECT().Register(gomock.Any()).Return(1, nil)
+				usecase.EXPECT().Register(gomock.Any(), gomock.Any()).Return(1, nil)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().CreateSession(1).Return("", errors.New("123"))
+				usecase.EXPECT().CreateSession(gomock.Any(), 1).Return("", errors.New("123"))
 			},
 			SetupStaticUsecaseMock: func(usecase *mockusecase.MockStatic) {},
 		},
@@ -176,10 +176,10 @@ func TestUserEndpoints_Login(t *testing.T) {
 			},
 			ExpectedErr: nil,
 			SetupUserUsecaseMock: func(usecase *mockusecase.MockUser) {
-				usecase.EXPECT().Login(gomock.Any()).Return(1, nil)
+				usecase.EXPECT().Login(gomock.Any(), gomock.Any()).Return(1, nil)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().CreateSession(1).Return("session", nil)
+				usecase.EXPECT().CreateSession(gomock.Any(), 1).Return("session", nil)
 			},
 		},
 		{
@@ -202,7 +202,7 @@ func TestUserEndpoints_Login(t *testing.T) {
 			},
 			ExpectedErr: &echo.HTTPError{Code: 500, Message: "Внутренняя ошибка сервера", Internal: errors.New("123")},
 			SetupUserUsecaseMock: func(usecase *mockusecase.MockUser) {
-				usecase.EXPECT().Login(gomock.Any()).Return(0, errors.New("123"))
+				usecase.EXPECT().Login(gomock.Any(), gomock.Any()).Return(0, errors.New("123"))
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {},
 		},
@@ -217,10 +217,10 @@ func TestUserEndpoints_Login(t *testing.T) {
 			},
 			ExpectedErr: &echo.HTTPError{Code: 500, Message: "Внутренняя ошибка сервера", Internal: errors.New("123")},
 			SetupUserUsecaseMock: func(usecase *mockusecase.MockUser) {
-				usecase.EXPECT().Login(gomock.Any()).Return(0, nil)
+				usecase.EXPECT().Login(gomock.Any(), gomock.Any()).Return(0, nil)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().CreateSession(0).Return("", errors.New("123"))
+				usecase.EXPECT().CreateSession(gomock.Any(), 0).Return("", errors.New("123"))
 			},
 		},
 		{
@@ -234,7 +234,7 @@ func TestUserEndpoints_Login(t *testing.T) {
 			},
 			ExpectedErr: &echo.HTTPError{Code: 404, Message: "Пользователь не найден"},
 			SetupUserUsecaseMock: func(uc *mockusecase.MockUser) {
-				uc.EXPECT().Login(gomock.Any()).Return(0, usecase.ErrUserNotFound)
+				uc.EXPECT().Login(gomock.Any(), gomock.Any()).Return(0, usecase.ErrUserNotFound)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {},
 		},
@@ -249,7 +249,7 @@ func TestUserEndpoints_Login(t *testing.T) {
 			},
 			ExpectedErr: &echo.HTTPError{Code: 403, Message: "123"},
 			SetupUserUsecaseMock: func(uc *mockusecase.MockUser) {
-				uc.EXPECT().Login(gomock.Any()).Return(0, usecase.UserIncorrectDataError{Err: errors.New("123")})
+				uc.EXPECT().Login(gomock.Any(), gomock.Any()).Return(0, usecase.UserIncorrectDataError{Err: errors.New("123")})
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {},
 		},
@@ -305,11 +305,11 @@ func TestUserEndpoints_UpdatePassword(t *testing.T) {
 			ExpectedErr: nil,
 			Cookies:     &http.Cookie{Name: "session", Value: "session"},
 			SetupUserUsecaseMock: func(usecase *mockusecase.MockUser) {
-				usecase.EXPECT().UpdatePassword(1, gomock.Any()).Return(nil)
+				usecase.EXPECT().UpdatePassword(gomock.Any(), 1, gomock.Any()).Return(nil)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("session").Return(1, nil)
-				usecase.EXPECT().CreateSession(1).Return("session", nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "session").Return(1, nil)
+				usecase.EXPECT().CreateSession(gomock.Any(), 1).Return("session", nil)
 			},
 		},
 		{
@@ -321,7 +321,7 @@ func TestUserEndpoints_UpdatePassword(t *testing.T) {
 			Cookies:              &http.Cookie{Name: "session", Value: "session"},
 			SetupUserUsecaseMock: func(usecase *mockusecase.MockUser) {},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("session").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "session").Return(1, nil)
 			},
 		},
 		{
@@ -336,10 +336,10 @@ func TestUserEndpoints_UpdatePassword(t *testing.T) {
 			ExpectedErr: &echo.HTTPError{Code: 500, Message: "Внутренняя ошибка сервера", Internal: errors.New("123")},
 			Cookies:     &http.Cookie{Name: "session", Value: "session"},
 			SetupUserUsecaseMock: func(usecase *mockusecase.MockUser) {
-				usecase.EXPECT().UpdatePassword(1, gomock.Any()).Return(errors.New("123"))
+				usecase.EXPECT().UpdatePassword(gomock.Any(), 1, gomock.Any()).Return(errors.New("123"))
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("session").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "session").Return(1, nil)
 			},
 		},
 		{
@@ -354,10 +354,10 @@ func TestUserEndpoints_UpdatePassword(t *testing.T) {
 			ExpectedErr: &echo.HTTPError{Code: 400, Message: "123"},
 			Cookies:     &http.Cookie{Name: "session", Value: "session"},
 			SetupUserUsecaseMock: func(uc *mockusecase.MockUser) {
-				uc.EXPECT().UpdatePassword(1, gomock.Any()).Return(usecase.UserIncorrectDataError{Err: errors.New("123")})
+				uc.EXPECT().UpdatePassword(gomock.Any(), 1, gomock.Any()).Return(usecase.UserIncorrectDataError{Err: errors.New("123")})
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("session").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "session").Return(1, nil)
 			},
 		},
 		{
@@ -386,11 +386,11 @@ func TestUserEndpoints_UpdatePassword(t *testing.T) {
 			ExpectedErr: &echo.HTTPError{Code: 500, Message: "Внутренняя ошибка сервера", Internal: errors.New("123")},
 			Cookies:     &http.Cookie{Name: "session", Value: "session"},
 			SetupUserUsecaseMock: func(usecase *mockusecase.MockUser) {
-				usecase.EXPECT().UpdatePassword(1, gomock.Any()).Return(nil)
+				usecase.EXPECT().UpdatePassword(gomock.Any(), 1, gomock.Any()).Return(nil)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("session").Return(1, nil)
-				usecase.EXPECT().CreateSession(1).Return("", errors.New("123"))
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "session").Return(1, nil)
+				usecase.EXPECT().CreateSession(gomock.Any(), 1).Return("", errors.New("123"))
 			},
 		},
 		{
@@ -405,10 +405,10 @@ func TestUserEndpoints_UpdatePassword(t *testing.T) {
 			ExpectedErr: &echo.HTTPError{Code: 404, Message: "Пользователь не найден"},
 			Cookies:     &http.Cookie{Name: "session", Value: "session"},
 			SetupUserUsecaseMock: func(uc *mockusecase.MockUser) {
-				uc.EXPECT().UpdatePassword(1, gomock.Any()).Return(usecase.ErrUserNotFound)
+				uc.EXPECT().UpdatePassword(gomock.Any(), 1, gomock.Any()).Return(usecase.ErrUserNotFound)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("session").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "session").Return(1, nil)
 			},
 		},
 	}
@@ -469,10 +469,10 @@ func TestUserEndpoints_UploadAvatar(t *testing.T) {
 			ExpectedErr: nil,
 			Cookies:     &http.Cookie{Name: "session", Value: "session"},
 			SetupUserUsecaseMock: func(usecase *mockusecase.MockUser) {
-				usecase.EXPECT().UpdateAvatar(1, gomock.Any()).Return(nil)
+				usecase.EXPECT().UpdateAvatar(gomock.Any(), 1, gomock.Any()).Return(nil)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("session").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "session").Return(1, nil)
 			},
 			SetupStaticUsecaseMock: func(usecase *mockusecase.MockStatic) {},
 		},
@@ -488,7 +488,7 @@ func TestUserEndpoints_UploadAvatar(t *testing.T) {
 			Cookies:              &http.Cookie{Name: "session", Value: "session"},
 			SetupUserUsecaseMock: func(usecase *mockusecase.MockUser) {},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("session").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "session").Return(1, nil)
 			},
 			SetupStaticUsecaseMock: func(usecase *mockusecase.MockStatic) {},
 		},
@@ -505,10 +505,10 @@ func TestUserEndpoints_UploadAvatar(t *testing.T) {
 			ExpectedErr: &echo.HTTPError{Code: 400, Message: "123"},
 			Cookies:     &http.Cookie{Name: "session", Value: "session"},
 			SetupUserUsecaseMock: func(uc *mockusecase.MockUser) {
-				uc.EXPECT().UpdateAvatar(1, gomock.Any()).Return(usecase.UserIncorrectDataError{Err: errors.New("123")})
+				uc.EXPECT().UpdateAvatar(gomock.Any(), 1, gomock.Any()).Return(usecase.UserIncorrectDataError{Err: errors.New("123")})
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("session").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "session").Return(1, nil)
 			},
 			SetupStaticUsecaseMock: func(usecase *mockusecase.MockStatic) {},
 		},
@@ -541,10 +541,10 @@ func TestUserEndpoints_UploadAvatar(t *testing.T) {
 			ExpectedErr: &echo.HTTPError{Code: 500, Message: "Внутренняя ошибка сервера", Internal: errors.New("123")},
 			Cookies:     &http.Cookie{Name: "session", Value: "session"},
 			SetupUserUsecaseMock: func(usecase *mockusecase.MockUser) {
-				usecase.EXPECT().UpdateAvatar(1, gomock.Any()).Return(errors.New("123"))
+				usecase.EXPECT().UpdateAvatar(gomock.Any(), 1, gomock.Any()).Return(errors.New("123"))
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("session").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "session").Return(1, nil)
 			},
 			SetupStaticUsecaseMock: func(usecase *mockusecase.MockStatic) {},
 		},
@@ -561,10 +561,10 @@ func TestUserEndpoints_UploadAvatar(t *testing.T) {
 			ExpectedErr: &echo.HTTPError{Code: 404, Message: "Пользователь не найден"},
 			Cookies:     &http.Cookie{Name: "session", Value: "session"},
 			SetupUserUsecaseMock: func(uc *mockusecase.MockUser) {
-				uc.EXPECT().UpdateAvatar(1, gomock.Any()).Return(usecase.ErrUserNotFound)
+				uc.EXPECT().UpdateAvatar(gomock.Any(), 1, gomock.Any()).Return(usecase.ErrUserNotFound)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("session").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "session").Return(1, nil)
 			},
 			SetupStaticUsecaseMock: func(usecase *mockusecase.MockStatic) {},
 		},
@@ -581,10 +581,10 @@ func TestUserEndpoints_UploadAvatar(t *testing.T) {
 			ExpectedErr: &echo.HTTPError{Code: 400, Message: "123"},
 			Cookies:     &http.Cookie{Name: "session", Value: "session"},
 			SetupUserUsecaseMock: func(uc *mockusecase.MockUser) {
-				uc.EXPECT().UpdateAvatar(1, gomock.Any()).Return(usecase.UserIncorrectDataError{Err: errors.New("123")})
+				uc.EXPECT().UpdateAvatar(gomock.Any(), 1, gomock.Any()).Return(usecase.UserIncorrectDataError{Err: errors.New("123")})
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("session").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "session").Return(1, nil)
 			},
 			SetupStaticUsecaseMock: func(usecase *mockusecase.MockStatic) {},
 		},
@@ -645,10 +645,10 @@ func TestUserEndpoints_UpdateInfo(t *testing.T) {
 			ExpectedErr: nil,
 			Cookies:     &http.Cookie{Name: "session", Value: "session"},
 			SetupUserUsecaseMock: func(usecase *mockusecase.MockUser) {
-				usecase.EXPECT().UpdateInfo(1, gomock.Any()).Return(nil)
+				usecase.EXPECT().UpdateInfo(gomock.Any(), 1, gomock.Any()).Return(nil)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("session").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "session").Return(1, nil)
 			},
 		},
 		{
@@ -660,7 +660,7 @@ func TestUserEndpoints_UpdateInfo(t *testing.T) {
 			Cookies:              &http.Cookie{Name: "session", Value: "session"},
 			SetupUserUsecaseMock: func(usecase *mockusecase.MockUser) {},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("session").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "session").Return(1, nil)
 			},
 		},
 		{
@@ -675,10 +675,10 @@ func TestUserEndpoints_UpdateInfo(t *testing.T) {
 			ExpectedErr: &echo.HTTPError{Code: 400, Message: "123"},
 			Cookies:     &http.Cookie{Name: "session", Value: "session"},
 			SetupUserUsecaseMock: func(uc *mockusecase.MockUser) {
-				uc.EXPECT().UpdateInfo(1, gomock.Any()).Return(usecase.UserIncorrectDataError{Err: errors.New("123")})
+				uc.EXPECT().UpdateInfo(gomock.Any(), 1, gomock.Any()).Return(usecase.UserIncorrectDataError{Err: errors.New("123")})
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("session").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "session").Return(1, nil)
 			},
 		},
 		{
@@ -693,10 +693,10 @@ func TestUserEndpoints_UpdateInfo(t *testing.T) {
 			ExpectedErr: &echo.HTTPError{Code: 500, Message: "Внутренняя ошибка сервера", Internal: errors.New("123")},
 			Cookies:     &http.Cookie{Name: "session", Value: "session"},
 			SetupUserUsecaseMock: func(usecase *mockusecase.MockUser) {
-				usecase.EXPECT().UpdateInfo(1, gomock.Any()).Return(errors.New("123"))
+				usecase.EXPECT().UpdateInfo(gomock.Any(), 1, gomock.Any()).Return(errors.New("123"))
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("session").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "session").Return(1, nil)
 			},
 		},
 		{
@@ -715,7 +715,7 @@ func TestUserEndpoints_UpdateInfo(t *testing.T) {
 			},
 			SetupUserUsecaseMock: func(usecase *mockusecase.MockUser) {},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("session").Return(0, utils.ErrUnauthorized)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "session").Return(0, utils.ErrUnauthorized)
 			},
 		},
 		{
@@ -733,10 +733,10 @@ func TestUserEndpoints_UpdateInfo(t *testing.T) {
 				Value: "session",
 			},
 			SetupUserUsecaseMock: func(uc *mockusecase.MockUser) {
-				uc.EXPECT().UpdateInfo(1, gomock.Any()).Return(usecase.ErrUserNotFound)
+				uc.EXPECT().UpdateInfo(gomock.Any(), 1, gomock.Any()).Return(usecase.ErrUserNotFound)
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("session").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "session").Return(1, nil)
 			},
 		},
 	}
@@ -787,7 +787,7 @@ func TestUserEndpoints_GetProfile(t *testing.T) {
 			RequestID:   "1",
 			ExpectedErr: nil,
 			SetupUserUsecaseMock: func(usecase *mockusecase.MockUser) {
-				usecase.EXPECT().GetUser(1).Return(&dto.UserProfile{
+				usecase.EXPECT().GetUser(gomock.Any(), 1).Return(&dto.UserProfile{
 					ID:     1,
 					Name:   "name",
 					Email:  "email",
@@ -801,7 +801,7 @@ func TestUserEndpoints_GetProfile(t *testing.T) {
 			RequestID:   "1",
 			ExpectedErr: &echo.HTTPError{Code: 500, Message: "Внутренняя ошибка сервера", Internal: errors.New("123")},
 			SetupUserUsecaseMock: func(uc *mockusecase.MockUser) {
-				uc.EXPECT().GetUser(1).Return(nil, errors.New("123"))
+				uc.EXPECT().GetUser(gomock.Any(), 1).Return(nil, errors.New("123"))
 			},
 		},
 		{
@@ -809,7 +809,7 @@ func TestUserEndpoints_GetProfile(t *testing.T) {
 			RequestID:   "1",
 			ExpectedErr: &echo.HTTPError{Code: 404, Message: "Пользователь не найден"},
 			SetupUserUsecaseMock: func(uc *mockusecase.MockUser) {
-				uc.EXPECT().GetUser(1).Return(nil, usecase.ErrUserNotFound)
+				uc.EXPECT().GetUser(gomock.Any(), 1).Return(nil, usecase.ErrUserNotFound)
 			},
 		},
 		{
@@ -863,14 +863,14 @@ func TestUserEndpoints_GetMyID(t *testing.T) {
 				ID: 1,
 			},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("session").Return(1, nil)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "session").Return(1, nil)
 			},
 		},
 		{
 			Name:        "Не авторизован",
 			ExpectedErr: &echo.HTTPError{Code: 401, Message: "Не авторизован"},
 			SetupAuthUsecaseMock: func(usecase *mockusecase.MockAuth) {
-				usecase.EXPECT().GetUserIDBySession("session").Return(0, utils.ErrUnauthorized)
+				usecase.EXPECT().GetUserIDBySession(gomock.Any(), "session").Return(0, utils.ErrUnauthorized)
 			},
 		},
 	}
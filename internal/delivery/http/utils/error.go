@@ -1,15 +1,15 @@
 package utils
 
 import (
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/logger"
 	"github.com/labstack/echo/v4"
-	"io"
-	"net/http"
-	"net/url"
 )
 
 // WebsocketError логирует ошибку вебсокета
 func WebsocketError(ctx echo.Context, err error) {
-	ctx.Logger().Error(GetErrMsgFromContext(ctx, err))
+	logger.ForPackage("http").ErrorContext(ctx.Request().Context(), "ошибка вебсокета",
+		append(requestAttrs(ctx), "error", err)...,
+	)
 }
 
 // NewError возвращает *echo.HTTPError, чтобы middleware echo автоматически конвертировал её в JSON-ошибку. Если
@@ -21,37 +21,20 @@ func NewError(ctx echo.Context, status int, message string, err error) *echo.HTT
 	httpError.Message = message
 	if status >= 500 {
 		httpError.Internal = err
-		ctx.Logger().Error(GetErrMsgFromContext(ctx, err))
+		logger.ForPackage("http").ErrorContext(ctx.Request().Context(), "внутренняя ошибка сервера",
+			append(requestAttrs(ctx), "status", status, "error", err)...,
+		)
 	}
 	return httpError
 }
 
-// ServerErrorMsg описывает структуру ошибки для логирования
-type ServerErrorMsg struct {
-	URL         url.URL
-	UserAgent   string
-	Header      http.Header
-	Method      string
-	QueryParams url.Values
-	ClientIP    string
-	RequestBody string
-	Error       error
-}
-
-func GetErrMsgFromContext(ctx echo.Context, err error) ServerErrorMsg {
-	body, e := io.ReadAll(ctx.Request().Body)
-	if e != nil {
-		body = []byte("не удалось получить тело запроса")
-	}
-
-	return ServerErrorMsg{
-		URL:         *ctx.Request().URL,
-		UserAgent:   ctx.Request().UserAgent(),
-		Header:      ctx.Request().Header,
-		Method:      ctx.Request().Method,
-		QueryParams: ctx.QueryParams(),
-		ClientIP:    ctx.RealIP(),
-		RequestBody: string(body),
-		Error:       err,
+// requestAttrs возвращает атрибуты запроса для логирования. Заголовки и тело запроса не логируются, так как
+// содержат куки, CSRF-токены и пароли
+func requestAttrs(ctx echo.Context) []any {
+	return []any{
+		"method", ctx.Request().Method,
+		"path", ctx.Request().URL.Path,
+		"user_agent", ctx.Request().UserAgent(),
+		"client_ip", ctx.RealIP(),
 	}
 }
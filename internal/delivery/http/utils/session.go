@@ -2,6 +2,7 @@ package utils
 
 import (
 	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/logger"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
 	"github.com/labstack/echo/v4"
 	"net/http"
@@ -23,7 +24,7 @@ func NewSessionManager(authUC usecase.Auth, sessionAliveTime int, secureCookies
 }
 
 func (s SessionManager) CreateSession(ctx echo.Context, authUC usecase.Auth, userID int) error {
-	session, err := authUC.CreateSession(userID)
+	session, err := authUC.CreateSession(ctx.Request().Context(), userID)
 	if err != nil {
 		return err
 	}
@@ -54,10 +55,11 @@ func GetUserIDFromSession(ctx echo.Context, authUC usecase.Auth) (int, error) {
 	if err != nil {
 		return -1, ErrUnauthorized
 	}
-	userID, err := authUC.GetUserIDBySession(session.Value)
+	userID, err := authUC.GetUserIDBySession(ctx.Request().Context(), session.Value)
 	if err != nil {
 		return -1, ErrUnauthorized
 	}
+	logger.SetUserID(ctx.Request().Context(), userID)
 	return userID, nil
 }
 
@@ -0,0 +1,143 @@
+package http
+
+import (
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/delivery/http/utils"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"strconv"
+)
+
+type WatchProgressEndpoints struct {
+	progressUC usecase.WatchProgress
+	authUC     usecase.Auth
+}
+
+func NewWatchProgressEndpoints(progressUC usecase.WatchProgress, authUC usecase.Auth) WatchProgressEndpoints {
+	return WatchProgressEndpoints{progressUC: progressUC, authUC: authUC}
+}
+
+func (h *WatchProgressEndpoints) Configure(server *echo.Group) {
+	server.GET("/content/:id", h.GetProgress)
+	server.PUT("/content/:id", h.UpdateProgress)
+	server.GET("/content/:id/next", h.GetNextEpisode)
+}
+
+// progressError возвращает ошибку, общую для всех запросов прогресса просмотра
+func progressError(ctx echo.Context, err error) error {
+	switch {
+	case errors.Is(err, usecase.ErrWatchProgressContentNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Контент не найден", err)
+	case errors.Is(err, usecase.ErrWatchProgressNotSeries):
+		return utils.NewError(ctx, http.StatusBadRequest, "Прогресс просмотра есть только у сериалов", err)
+	default:
+		return utils.NewError(ctx, http.StatusInternalServerError, "Внутренняя ошибка сервера", err)
+	}
+}
+
+// GetProgress
+// @Summary Прогресс просмотра сериала
+// @Tags progress
+// @Description Эпизоды сериала по сезонам с отметками о просмотре текущим пользователем
+// @Produce json
+// @Param id path int true "ID сериала"
+// @Success 200 {object} dto.WatchProgress
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/progress/content/{id} [get]
+func (h *WatchProgressEndpoints) GetProgress(ctx echo.Context) error {
+	contentID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id контента", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	progress, err := h.progressUC.GetProgress(ctx.Request().Context(), userID, int(contentID))
+	if err != nil {
+		return progressError(ctx, err)
+	}
+	return utils.WriteJSON(ctx, progress)
+}
+
+// UpdateProgress
+// @Summary Отметить просмотр
+// @Tags progress
+// @Description Ставит или снимает отметку о просмотре эпизода, всего сезона или всех эпизодов сериала до указанного
+// включительно. Когда просмотрены все эпизоды, сериал из категорий watching, rewatching, planned и abandoned
+// избранного переносится в watched
+// @Accept json
+// @Produce json
+// @Param id path int true "ID сериала"
+// @Param progress body dto.WatchProgressRequest true "Отметка о просмотре"
+// @Success 200 {object} dto.WatchProgress
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/progress/content/{id} [put]
+// @Security _csrf
+func (h *WatchProgressEndpoints) UpdateProgress(ctx echo.Context) error {
+	contentID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id контента", nil)
+	}
+	progressRequest := new(dto.WatchProgressRequest)
+	if err = utils.ReadJSON(ctx, progressRequest); err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный запрос", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	progress, err := h.progressUC.UpdateProgress(ctx.Request().Context(), userID, int(contentID), *progressRequest)
+	switch {
+	case errors.Is(err, usecase.ErrWatchProgressInvalidRequest):
+		return utils.NewError(ctx, http.StatusBadRequest,
+			"Нужно указать ровно одно из полей episodeID, seasonID, upToEpisodeID", err)
+	case errors.Is(err, usecase.ErrWatchProgressEpisodeNotFound):
+		return utils.NewError(ctx, http.StatusNotFound, "Эпизод или сезон не найден в сериале", err)
+	case err != nil:
+		return progressError(ctx, err)
+	default:
+		return utils.WriteJSON(ctx, progress)
+	}
+}
+
+// GetNextEpisode
+// @Summary Продолжить просмотр
+// @Tags progress
+// @Description Следующий эпизод сериала: первый непросмотренный после последнего просмотренного, а если таких
+// нет - первый непросмотренный
+// @Produce json
+// @Param id path int true "ID сериала"
+// @Success 200 {object} dto.SeasonEpisode
+// @Failure 400 {object} echo.HTTPError
+// @Failure 401 {object} echo.HTTPError
+// @Failure 404 {object} echo.HTTPError
+// @Failure 500 {object} echo.HTTPError
+// @Router /api/progress/content/{id}/next [get]
+func (h *WatchProgressEndpoints) GetNextEpisode(ctx echo.Context) error {
+	contentID, err := strconv.ParseInt(ctx.Param("id"), 10, 64)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusBadRequest, "Невалидный id контента", nil)
+	}
+	userID, err := utils.GetUserIDFromSession(ctx, h.authUC)
+	if err != nil {
+		return utils.NewError(ctx, http.StatusUnauthorized, "Для этой операции нужно авторизоваться", err)
+	}
+	episode, err := h.progressUC.GetNextEpisode(ctx.Request().Context(), userID, int(contentID))
+	switch {
+	case errors.Is(err, usecase.ErrWatchProgressCompleted):
+		return utils.NewError(ctx, http.StatusNotFound, "Все эпизоды просмотрены", err)
+	case err != nil:
+		return progressError(ctx, err)
+	default:
+		return utils.WriteJSON(ctx, episode)
+	}
+}
@@ -0,0 +1,154 @@
+package http
+
+import (
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	mockusecase "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/mocks"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWatchProgressEndpoints_UpdateProgress(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                     string
+		Body                     string
+		ExpectedErr              error
+		SetupProgressUsecaseMock func(uc *mockusecase.MockWatchProgress)
+	}{
+		{
+			Name:        "Успешная отметка",
+			Body:        `{"watched":true,"seasonID":1}`,
+			ExpectedErr: nil,
+			SetupProgressUsecaseMock: func(uc *mockusecase.MockWatchProgress) {
+				uc.EXPECT().UpdateProgress(gomock.Any(), 1, 2, dto.WatchProgressRequest{Watched: true, SeasonID: 1}).
+					Return(&dto.WatchProgress{ContentID: 2}, nil)
+			},
+		},
+		{
+			Name: "Несколько полей в запросе",
+			Body: `{"watched":true,"seasonID":1,"episodeID":1}`,
+			ExpectedErr: &echo.HTTPError{
+				Code:    400,
+				Message: "Нужно указать ровно одно из полей episodeID, seasonID, upToEpisodeID",
+			},
+			SetupProgressUsecaseMock: func(uc *mockusecase.MockWatchProgress) {
+				uc.EXPECT().UpdateProgress(gomock.Any(), 1, 2, gomock.Any()).
+					Return(nil, usecase.ErrWatchProgressInvalidRequest)
+			},
+		},
+		{
+			Name:        "Эпизод не найден",
+			Body:        `{"watched":true,"episodeID":10}`,
+			ExpectedErr: &echo.HTTPError{Code: 404, Message: "Эпизод или сезон не найден в сериале"},
+			SetupProgressUsecaseMock: func(uc *mockusecase.MockWatchProgress) {
+				uc.EXPECT().UpdateProgress(gomock.Any(), 1, 2, gomock.Any()).
+					Return(nil, usecase.ErrWatchProgressEpisodeNotFound)
+			},
+		},
+		{
+			Name:        "Фильм",
+			Body:        `{"watched":true,"episodeID":1}`,
+			ExpectedErr: &echo.HTTPError{Code: 400, Message: "Прогресс просмотра есть только у сериалов"},
+			SetupProgressUsecaseMock: func(uc *mockusecase.MockWatchProgress) {
+				uc.EXPECT().UpdateProgress(gomock.Any(), 1, 2, gomock.Any()).
+					Return(nil, usecase.ErrWatchProgressNotSeries)
+			},
+		},
+		{
+			Name:        "Внутренняя ошибка",
+			Body:        `{"watched":true,"episodeID":1}`,
+			ExpectedErr: &echo.HTTPError{Code: 500, Message: "Внутренняя ошибка сервера", Internal: errors.New("ошибка")},
+			SetupProgressUsecaseMock: func(uc *mockusecase.MockWatchProgress) {
+				uc.EXPECT().UpdateProgress(gomock.Any(), 1, 2, gomock.Any()).Return(nil, errors.New("ошибка"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockProgressUsecase := mockusecase.NewMockWatchProgress(ctrl)
+			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
+			mockAuthUsecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			tc.SetupProgressUsecaseMock(mockProgressUsecase)
+			progressHandler := NewWatchProgressEndpoints(mockProgressUsecase, mockAuthUsecase)
+			req := httptest.NewRequest(http.MethodPut, "/progress/", strings.NewReader(tc.Body))
+			req.AddCookie(&http.Cookie{Name: "session", Value: "xxx"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/progress/content/:id")
+			c.SetParamNames("id")
+			c.SetParamValues("2")
+			err := progressHandler.UpdateProgress(c)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestWatchProgressEndpoints_GetNextEpisode(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                     string
+		ExpectedErr              error
+		SetupProgressUsecaseMock func(uc *mockusecase.MockWatchProgress)
+	}{
+		{
+			Name:        "Успешное получение",
+			ExpectedErr: nil,
+			SetupProgressUsecaseMock: func(uc *mockusecase.MockWatchProgress) {
+				uc.EXPECT().GetNextEpisode(gomock.Any(), 1, 2).Return(&dto.SeasonEpisode{SeasonID: 1}, nil)
+			},
+		},
+		{
+			Name:        "Все эпизоды просмотрены",
+			ExpectedErr: &echo.HTTPError{Code: 404, Message: "Все эпизоды просмотрены"},
+			SetupProgressUsecaseMock: func(uc *mockusecase.MockWatchProgress) {
+				uc.EXPECT().GetNextEpisode(gomock.Any(), 1, 2).Return(nil, usecase.ErrWatchProgressCompleted)
+			},
+		},
+		{
+			Name:        "Контент не найден",
+			ExpectedErr: &echo.HTTPError{Code: 404, Message: "Контент не найден"},
+			SetupProgressUsecaseMock: func(uc *mockusecase.MockWatchProgress) {
+				uc.EXPECT().GetNextEpisode(gomock.Any(), 1, 2).Return(nil, usecase.ErrWatchProgressContentNotFound)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			e := echo.New()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockProgressUsecase := mockusecase.NewMockWatchProgress(ctrl)
+			mockAuthUsecase := mockusecase.NewMockAuth(ctrl)
+			mockAuthUsecase.EXPECT().GetUserIDBySession(gomock.Any(), "xxx").Return(1, nil)
+			tc.SetupProgressUsecaseMock(mockProgressUsecase)
+			progressHandler := NewWatchProgressEndpoints(mockProgressUsecase, mockAuthUsecase)
+			req := httptest.NewRequest(http.MethodGet, "/progress/", nil)
+			req.AddCookie(&http.Cookie{Name: "session", Value: "xxx"})
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/progress/content/:id/next")
+			c.SetParamNames("id")
+			c.SetParamValues("2")
+			err := progressHandler.GetNextEpisode(c)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
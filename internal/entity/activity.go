@@ -0,0 +1,41 @@
+package entity
+
+import "time"
+
+// Типы событий в ленте подписок
+const (
+	ActivityReview    = "review"
+	ActivityRating    = "rating"
+	ActivityListItem  = "list_item"
+	ActivityFavourite = "favourite"
+)
+
+// Activity событие в журнале действий пользователя. Заполняются только поля, относящиеся к типу события:
+// ReviewID у рецензий, Rating у оценок, ListID у списков, Category у избранного
+type Activity struct {
+	ID        int       `db:"id"`
+	UserID    int       `db:"user_id"`
+	Type      string    `db:"type"`
+	ContentID int       `db:"content_id"`
+	ReviewID  int       `db:"review_id"`
+	ListID    int       `db:"list_id"`
+	Rating    int       `db:"rating"`
+	Category  string    `db:"category"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// ActivityPrivacySection возвращает раздел профиля, настройки приватности которого определяют видимость события
+func ActivityPrivacySection(activityType string) string {
+	switch activityType {
+	case ActivityReview:
+		return PrivacySectionReviews
+	case ActivityRating:
+		return PrivacySectionRatings
+	case ActivityListItem:
+		return PrivacySectionLists
+	case ActivityFavourite:
+		return PrivacySectionFavourites
+	default:
+		return ""
+	}
+}
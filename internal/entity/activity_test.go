@@ -0,0 +1,16 @@
+package entity
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestActivityPrivacySection(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, PrivacySectionReviews, ActivityPrivacySection(ActivityReview))
+	require.Equal(t, PrivacySectionRatings, ActivityPrivacySection(ActivityRating))
+	require.Equal(t, PrivacySectionLists, ActivityPrivacySection(ActivityListItem))
+	require.Equal(t, PrivacySectionFavourites, ActivityPrivacySection(ActivityFavourite))
+	require.Empty(t, ActivityPrivacySection("comment"))
+}
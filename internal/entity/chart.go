@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"strconv"
+	"time"
+)
+
+// Ключи вычисляемых подборок. Подборки жанров и десятилетий получают ключи через GenreChartKey и DecadeChartKey
+const (
+	ChartTrending  = "trending"
+	ChartTopMovies = "top_movies"
+	ChartTopSeries = "top_series"
+)
+
+// Chart - вычисляемая подборка, которая периодически пересчитывается
+type Chart struct {
+	Key         string
+	Title       string
+	RefreshedAt time.Time
+}
+
+// ChartLimits - сколько контента попадает в каждую вычисляемую подборку
+type ChartLimits struct {
+	Trending int
+	Top      int
+	Genre    int
+	Decade   int
+}
+
+// GenreChartKey возвращает ключ подборки лучшего контента жанра
+func GenreChartKey(genreID int) string {
+	return "genre:" + strconv.Itoa(genreID)
+}
+
+// DecadeChartKey возвращает ключ подборки лучшего контента десятилетия. Год округляется вниз до начала
+// десятилетия
+func DecadeChartKey(year int) string {
+	return "decade:" + strconv.Itoa(year/10*10)
+}
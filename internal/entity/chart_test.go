@@ -0,0 +1,14 @@
+package entity
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestChartKeys(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, "genre:3", GenreChartKey(3))
+	require.Equal(t, "decade:1990", DecadeChartKey(1990))
+	require.Equal(t, "decade:1990", DecadeChartKey(1997))
+}
@@ -54,17 +54,28 @@ type Series struct {
 
 // Episode представляет эпизод сериала
 type Episode struct {
-	ID            int    `json:"id"`             // Уникальный идентификатор
-	EpisodeNumber int    `json:"episode_number"` // Номер эпизода
-	Title         string `json:"title"`          // Название эпизода
-	Duration      int    `json:"duration"`       // Продолжительность
+	ID            int     `json:"id"`             // Уникальный идентификатор
+	EpisodeNumber int     `json:"episode_number"` // Номер эпизода
+	Title         string  `json:"title"`          // Название эпизода
+	Duration      int     `json:"duration"`       // Продолжительность
+	Rating        float64 `json:"rating"`         // Средняя оценка эпизода
+	RatingCount   int     `json:"rating_count"`   // Число оценок эпизода
+}
+
+// SeasonEpisode эпизод вместе с сезоном, к которому он относится
+type SeasonEpisode struct {
+	Episode
+	SeasonID    int    // Уникальный идентификатор сезона
+	SeasonTitle string // Название сезона
 }
 
 // Season представляет сезон сериала
 type Season struct {
-	ID       int       `json:"id"`       // Уникальный идентификатор
-	Title    string    `json:"title"`    // Название сезона
-	Episodes []Episode `json:"episodes"` // Эпизоды в сезоне
+	ID          int       `json:"id"`           // Уникальный идентификатор
+	Title       string    `json:"title"`        // Название сезона
+	Rating      float64   `json:"rating"`       // Средняя оценка сезона
+	RatingCount int       `json:"rating_count"` // Число оценок сезона
+	Episodes    []Episode `json:"episodes"`     // Эпизоды в сезоне
 }
 
 const (
@@ -0,0 +1,57 @@
+package entity
+
+// ContentRatingStats распределение оценок контента: оценки видимых рецензий и оценки без рецензии от тех, кто
+// рецензию не писал
+type ContentRatingStats struct {
+	ContentID int
+	// Histogram[i] - число оценок i+1
+	Histogram [10]int
+	// WeightedRating байесовская оценка контента, пересчитывается триггером update_content_rating
+	WeightedRating float64
+}
+
+// Count возвращает число учтенных оценок
+func (s ContentRatingStats) Count() int {
+	count := 0
+	for _, n := range s.Histogram {
+		count += n
+	}
+	return count
+}
+
+// Average возвращает среднюю оценку. Если оценок нет, возвращает 0
+func (s ContentRatingStats) Average() float64 {
+	count := s.Count()
+	if count == 0 {
+		return 0
+	}
+	sum := 0
+	for i, n := range s.Histogram {
+		sum += (i + 1) * n
+	}
+	return float64(sum) / float64(count)
+}
+
+// Median возвращает медиану оценок. При четном числе оценок - среднее двух центральных.
+// Если оценок нет, возвращает 0
+func (s ContentRatingStats) Median() float64 {
+	count := s.Count()
+	if count == 0 {
+		return 0
+	}
+	// позиции центральных оценок в отсортированном списке, при нечетном числе оценок совпадают
+	lower, upper := (count-1)/2, count/2
+	lowerRating, upperRating := 0, 0
+	seen := 0
+	for i, n := range s.Histogram {
+		if lowerRating == 0 && lower < seen+n {
+			lowerRating = i + 1
+		}
+		if upper < seen+n {
+			upperRating = i + 1
+			break
+		}
+		seen += n
+	}
+	return float64(lowerRating+upperRating) / 2
+}
@@ -0,0 +1,64 @@
+package entity
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestContentRatingStats(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name            string
+		Input           ContentRatingStats
+		ExpectedCount   int
+		ExpectedAverage float64
+		ExpectedMedian  float64
+	}{
+		{
+			Name:            "Нет рецензий",
+			Input:           ContentRatingStats{},
+			ExpectedCount:   0,
+			ExpectedAverage: 0,
+			ExpectedMedian:  0,
+		},
+		{
+			Name:            "Одна рецензия",
+			Input:           ContentRatingStats{Histogram: [10]int{9: 1}},
+			ExpectedCount:   1,
+			ExpectedAverage: 10,
+			ExpectedMedian:  10,
+		},
+		{
+			Name:            "Нечетное число рецензий",
+			Input:           ContentRatingStats{Histogram: [10]int{0: 1, 6: 1, 8: 1}},
+			ExpectedCount:   3,
+			ExpectedAverage: 17.0 / 3,
+			ExpectedMedian:  7,
+		},
+		{
+			Name:            "Четное число рецензий",
+			Input:           ContentRatingStats{Histogram: [10]int{3: 1, 5: 2, 9: 1}},
+			ExpectedCount:   4,
+			ExpectedAverage: 6.5,
+			ExpectedMedian:  6,
+		},
+		{
+			Name:            "Центральные оценки в разных столбцах",
+			Input:           ContentRatingStats{Histogram: [10]int{1: 2, 7: 2}},
+			ExpectedCount:   4,
+			ExpectedAverage: 5,
+			ExpectedMedian:  5,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.ExpectedCount, tc.Input.Count())
+			require.InDelta(t, tc.ExpectedAverage, tc.Input.Average(), 1e-9)
+			require.InDelta(t, tc.ExpectedMedian, tc.Input.Median(), 1e-9)
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package entity
+
+import (
+	"errors"
+	"time"
+	"unicode/utf8"
+)
+
+// DiaryEntry запись дневника просмотров - один просмотр контента в конкретный день
+type DiaryEntry struct {
+	ID        int       `db:"id"`
+	UserID    int       `db:"user_id"`
+	ContentID int       `db:"content_id"`
+	WatchedOn time.Time `db:"watched_on"`
+	// Rating личная оценка просмотра, 0 - без оценки. На рейтинг контента не влияет
+	Rating    int       `db:"rating"`
+	Note      string    `db:"note"`
+	Rewatch   bool      `db:"rewatch"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// DiaryDateLayout формат даты просмотра
+const DiaryDateLayout = time.DateOnly
+
+// ValidateDiaryEntry проверяет, что просмотр не в будущем, оценка, если есть, от 1 до 10, а заметка не длиннее
+// 1000 символов
+func ValidateDiaryEntry(watchedOn time.Time, rating int, note string, now time.Time) error {
+	if watchedOn.After(now) {
+		return errors.New("дата просмотра не может быть в будущем")
+	}
+	if rating != 0 {
+		if err := ValidateReviewRating(rating); err != nil {
+			return err
+		}
+	}
+	if utf8.RuneCountInString(note) > 1000 {
+		return errors.New("заметка должна быть не длиннее 1000 символов")
+	}
+	return nil
+}
+
+// DiaryYearStats статистика дневника просмотров за год
+type DiaryYearStats struct {
+	Year int
+	// Entries число просмотров
+	Entries int
+	// UniqueContents число разных просмотренных фильмов и сериалов
+	UniqueContents int
+	// Rewatches число повторных просмотров
+	Rewatches int
+	// AverageRating средняя оценка просмотров с оценкой, 0 - если оценок нет
+	AverageRating float64
+	// Months[i] - число просмотров в месяце i+1
+	Months [12]int
+}
+
+// NewDiaryYearStats считает статистику по записям дневника за год. Записи за другие годы пропускаются
+func NewDiaryYearStats(year int, entries []*DiaryEntry) DiaryYearStats {
+	stats := DiaryYearStats{Year: year}
+	contents := make(map[int]struct{})
+	rated, ratingSum := 0, 0
+	for _, entry := range entries {
+		if entry.WatchedOn.Year() != year {
+			continue
+		}
+		stats.Entries++
+		stats.Months[entry.WatchedOn.Month()-1]++
+		contents[entry.ContentID] = struct{}{}
+		if entry.Rewatch {
+			stats.Rewatches++
+		}
+		if entry.Rating != 0 {
+			rated++
+			ratingSum += entry.Rating
+		}
+	}
+	stats.UniqueContents = len(contents)
+	if rated != 0 {
+		stats.AverageRating = float64(ratingSum) / float64(rated)
+	}
+	return stats
+}
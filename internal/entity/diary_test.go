@@ -0,0 +1,85 @@
+package entity
+
+import (
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidateDiaryEntry(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		Name      string
+		WatchedOn time.Time
+		Rating    int
+		Note      string
+		WantErr   bool
+	}{
+		{
+			Name:      "Без оценки и заметки",
+			WatchedOn: time.Date(2024, 6, 10, 0, 0, 0, 0, time.UTC),
+			WantErr:   false,
+		},
+		{
+			Name:      "С оценкой и заметкой",
+			WatchedOn: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			Rating:    7,
+			Note:      "Пересмотрел с друзьями",
+			WantErr:   false,
+		},
+		{
+			Name:      "Дата в будущем",
+			WatchedOn: time.Date(2024, 6, 11, 0, 0, 0, 0, time.UTC),
+			WantErr:   true,
+		},
+		{
+			Name:      "Оценка вне диапазона",
+			WatchedOn: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+			Rating:    11,
+			WantErr:   true,
+		},
+		{
+			Name:      "Слишком длинная заметка",
+			WatchedOn: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+			Note:      strings.Repeat("а", 1001),
+			WantErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			err := ValidateDiaryEntry(tc.WatchedOn, tc.Rating, tc.Note, now)
+			if tc.WantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNewDiaryYearStats(t *testing.T) {
+	t.Parallel()
+
+	entries := []*DiaryEntry{
+		{ContentID: 1, WatchedOn: time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC), Rating: 8},
+		{ContentID: 1, WatchedOn: time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC), Rating: 9, Rewatch: true},
+		{ContentID: 2, WatchedOn: time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC)},
+		{ContentID: 3, WatchedOn: time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC), Rating: 1},
+	}
+	stats := NewDiaryYearStats(2024, entries)
+	require.Equal(t, DiaryYearStats{
+		Year:           2024,
+		Entries:        3,
+		UniqueContents: 2,
+		Rewatches:      1,
+		AverageRating:  8.5,
+		Months:         [12]int{0: 1, 2: 2},
+	}, stats)
+	require.Equal(t, DiaryYearStats{Year: 2022}, NewDiaryYearStats(2022, entries))
+}
@@ -54,15 +54,30 @@ type SeriesContent struct {
 }
 
 type Season struct {
-	ID       int       `json:"id"       example:"1"`
-	Episodes []Episode `json:"episodes"`
+	ID          int       `json:"id"          example:"1"`
+	Rating      float64   `json:"rating"      example:"8.4"`
+	RatingCount int       `json:"ratingCount" example:"12"`
+	Episodes    []Episode `json:"episodes"`
 }
 
 type Episode struct {
-	ID            int    `json:"id"            example:"1"`
-	EpisodeNumber int    `json:"episodeNumber" example:"1"`
-	Title         string `json:"title"         example:"Название серии"`
-	Duration      int    `json:"duration"      example:"45"`
+	ID            int     `json:"id"            example:"1"`
+	EpisodeNumber int     `json:"episodeNumber" example:"1"`
+	Title         string  `json:"title"         example:"Название серии"`
+	Duration      int     `json:"duration"      example:"45"`
+	Rating        float64 `json:"rating"        example:"8.9"`
+	RatingCount   int     `json:"ratingCount"   example:"30"`
+}
+
+// SeasonEpisode - эпизод вместе с сезоном, к которому он относится
+type SeasonEpisode struct {
+	Episode
+	SeasonID    int    `json:"seasonID"    example:"1"`
+	SeasonTitle string `json:"seasonTitle" example:"Сезон 1"`
+}
+
+type BestEpisodes struct {
+	Episodes []SeasonEpisode `json:"episodes"`
 }
 
 type PersonPreview struct {
@@ -49,7 +49,7 @@ func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 				in.Delim('[')
 				if out.Seasons == nil {
 					if !in.IsDelim(']') {
-						out.Seasons = make([]Season, 0, 2)
+						out.Seasons = make([]Season, 0, 1)
 					} else {
 						out.Seasons = []Season{}
 					}
@@ -130,7 +130,7 @@ func (v *SeriesContent) UnmarshalJSON(data []byte) error {
 func (v *SeriesContent) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(l, v)
 }
-func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(in *jlexer.Lexer, out *Season) {
+func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(in *jlexer.Lexer, out *SeasonEpisode) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -149,8 +149,127 @@ func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 			continue
 		}
 		switch key {
+		case "seasonID":
+			out.SeasonID = int(in.Int())
+		case "seasonTitle":
+			out.SeasonTitle = string(in.String())
 		case "id":
 			out.ID = int(in.Int())
+		case "episodeNumber":
+			out.EpisodeNumber = int(in.Int())
+		case "title":
+			out.Title = string(in.String())
+		case "duration":
+			out.Duration = int(in.Int())
+		case "rating":
+			out.Rating = float64(in.Float64())
+		case "ratingCount":
+			out.RatingCount = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(out *jwriter.Writer, in SeasonEpisode) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"seasonID\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.SeasonID))
+	}
+	{
+		const prefix string = ",\"seasonTitle\":"
+		out.RawString(prefix)
+		out.String(string(in.SeasonTitle))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"episodeNumber\":"
+		out.RawString(prefix)
+		out.Int(int(in.EpisodeNumber))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"duration\":"
+		out.RawString(prefix)
+		out.Int(int(in.Duration))
+	}
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Rating))
+	}
+	{
+		const prefix string = ",\"ratingCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.RatingCount))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SeasonEpisode) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SeasonEpisode) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SeasonEpisode) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SeasonEpisode) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(l, v)
+}
+func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(in *jlexer.Lexer, out *Season) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "rating":
+			out.Rating = float64(in.Float64())
+		case "ratingCount":
+			out.RatingCount = int(in.Int())
 		case "episodes":
 			if in.IsNull() {
 				in.Skip()
@@ -184,7 +303,7 @@ func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 		in.Consumed()
 	}
 }
-func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(out *jwriter.Writer, in Season) {
+func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(out *jwriter.Writer, in Season) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -193,6 +312,16 @@ func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 		out.RawString(prefix[1:])
 		out.Int(int(in.ID))
 	}
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Rating))
+	}
+	{
+		const prefix string = ",\"ratingCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.RatingCount))
+	}
 	{
 		const prefix string = ",\"episodes\":"
 		out.RawString(prefix)
@@ -215,27 +344,27 @@ func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 // MarshalJSON supports json.Marshaler interface
 func (v Season) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(&w, v)
+	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Season) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(w, v)
+	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Season) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(&r, v)
+	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Season) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(l, v)
+	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(l, v)
 }
-func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(in *jlexer.Lexer, out *PreviewContentCardVertical) {
+func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(in *jlexer.Lexer, out *PreviewContentCardVertical) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -303,7 +432,7 @@ func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 		in.Consumed()
 	}
 }
-func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(out *jwriter.Writer, in PreviewContentCardVertical) {
+func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(out *jwriter.Writer, in PreviewContentCardVertical) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -369,27 +498,27 @@ func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 // MarshalJSON supports json.Marshaler interface
 func (v PreviewContentCardVertical) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(&w, v)
+	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PreviewContentCardVertical) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(w, v)
+	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PreviewContentCardVertical) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(&r, v)
+	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PreviewContentCardVertical) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(l, v)
+	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(l, v)
 }
-func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(in *jlexer.Lexer, out *PreviewContent) {
+func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(in *jlexer.Lexer, out *PreviewContent) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -483,7 +612,7 @@ func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 		in.Consumed()
 	}
 }
-func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(out *jwriter.Writer, in PreviewContent) {
+func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(out *jwriter.Writer, in PreviewContent) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -589,27 +718,27 @@ func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 // MarshalJSON supports json.Marshaler interface
 func (v PreviewContent) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(&w, v)
+	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PreviewContent) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(w, v)
+	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PreviewContent) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(&r, v)
+	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PreviewContent) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(l, v)
+	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(l, v)
 }
-func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(in *jlexer.Lexer, out *PersonPreviewWithPhoto) {
+func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(in *jlexer.Lexer, out *PersonPreviewWithPhoto) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -646,7 +775,7 @@ func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 		in.Consumed()
 	}
 }
-func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(out *jwriter.Writer, in PersonPreviewWithPhoto) {
+func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(out *jwriter.Writer, in PersonPreviewWithPhoto) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -676,27 +805,27 @@ func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 // MarshalJSON supports json.Marshaler interface
 func (v PersonPreviewWithPhoto) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(&w, v)
+	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PersonPreviewWithPhoto) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(w, v)
+	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PersonPreviewWithPhoto) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(&r, v)
+	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PersonPreviewWithPhoto) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(l, v)
+	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(l, v)
 }
-func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(in *jlexer.Lexer, out *PersonPreview) {
+func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(in *jlexer.Lexer, out *PersonPreview) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -731,7 +860,7 @@ func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 		in.Consumed()
 	}
 }
-func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(out *jwriter.Writer, in PersonPreview) {
+func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(out *jwriter.Writer, in PersonPreview) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -756,27 +885,27 @@ func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 // MarshalJSON supports json.Marshaler interface
 func (v PersonPreview) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(&w, v)
+	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v PersonPreview) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(w, v)
+	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *PersonPreview) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(&r, v)
+	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *PersonPreview) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(l, v)
+	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(l, v)
 }
-func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(in *jlexer.Lexer, out *Person) {
+func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(in *jlexer.Lexer, out *Person) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -878,7 +1007,7 @@ func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 		in.Consumed()
 	}
 }
-func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(out *jwriter.Writer, in Person) {
+func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(out *jwriter.Writer, in Person) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -960,27 +1089,27 @@ func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 // MarshalJSON supports json.Marshaler interface
 func (v Person) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(&w, v)
+	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Person) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(w, v)
+	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Person) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(&r, v)
+	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Person) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(l, v)
+	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(l, v)
 }
-func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(in *jlexer.Lexer, out *MovieContent) {
+func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto8(in *jlexer.Lexer, out *MovieContent) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1023,7 +1152,7 @@ func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 		in.Consumed()
 	}
 }
-func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(out *jwriter.Writer, in MovieContent) {
+func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto8(out *jwriter.Writer, in MovieContent) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1049,27 +1178,27 @@ func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 // MarshalJSON supports json.Marshaler interface
 func (v MovieContent) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(&w, v)
+	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v MovieContent) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(w, v)
+	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *MovieContent) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(&r, v)
+	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *MovieContent) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(l, v)
+	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto8(l, v)
 }
-func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto8(in *jlexer.Lexer, out *Episode) {
+func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto9(in *jlexer.Lexer, out *Episode) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1096,6 +1225,10 @@ func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 			out.Title = string(in.String())
 		case "duration":
 			out.Duration = int(in.Int())
+		case "rating":
+			out.Rating = float64(in.Float64())
+		case "ratingCount":
+			out.RatingCount = int(in.Int())
 		default:
 			in.SkipRecursive()
 		}
@@ -1106,7 +1239,7 @@ func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 		in.Consumed()
 	}
 }
-func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto8(out *jwriter.Writer, in Episode) {
+func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto9(out *jwriter.Writer, in Episode) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1130,33 +1263,43 @@ func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 		out.RawString(prefix)
 		out.Int(int(in.Duration))
 	}
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Rating))
+	}
+	{
+		const prefix string = ",\"ratingCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.RatingCount))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v Episode) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto8(&w, v)
+	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Episode) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto8(w, v)
+	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Episode) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto8(&r, v)
+	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Episode) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto8(l, v)
+	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto9(l, v)
 }
-func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto9(in *jlexer.Lexer, out *Content) {
+func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto10(in *jlexer.Lexer, out *Content) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1505,7 +1648,7 @@ func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 		in.Consumed()
 	}
 }
-func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto9(out *jwriter.Writer, in Content) {
+func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto10(out *jwriter.Writer, in Content) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1790,23 +1933,121 @@ func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 // MarshalJSON supports json.Marshaler interface
 func (v Content) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto9(&w, v)
+	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto10(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Content) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto9(w, v)
+	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto10(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Content) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto9(&r, v)
+	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto10(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Content) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto9(l, v)
+	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto10(l, v)
+}
+func easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto11(in *jlexer.Lexer, out *BestEpisodes) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "episodes":
+			if in.IsNull() {
+				in.Skip()
+				out.Episodes = nil
+			} else {
+				in.Delim('[')
+				if out.Episodes == nil {
+					if !in.IsDelim(']') {
+						out.Episodes = make([]SeasonEpisode, 0, 0)
+					} else {
+						out.Episodes = []SeasonEpisode{}
+					}
+				} else {
+					out.Episodes = (out.Episodes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v54 SeasonEpisode
+					(v54).UnmarshalEasyJSON(in)
+					out.Episodes = append(out.Episodes, v54)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto11(out *jwriter.Writer, in BestEpisodes) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"episodes\":"
+		out.RawString(prefix[1:])
+		if in.Episodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v55, v56 := range in.Episodes {
+				if v55 > 0 {
+					out.RawByte(',')
+				}
+				(v56).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v BestEpisodes) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto11(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v BestEpisodes) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson344736e9EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto11(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *BestEpisodes) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto11(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *BestEpisodes) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson344736e9DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto11(l, v)
 }
@@ -0,0 +1,62 @@
+package dto
+
+// DiaryEntryRequest - данные записи дневника. При изменении записи contentID не учитывается
+type DiaryEntryRequest struct {
+	ContentID int    `json:"contentID"        example:"1"                        format:"int"`
+	WatchedOn string `json:"watchedOn"        example:"2024-06-01"               format:"string"`
+	Rating    int    `json:"rating,omitempty" example:"8"                        format:"int"`
+	Note      string `json:"note,omitempty"   example:"Пересмотрел в кинотеатре" format:"string"`
+	Rewatch   bool   `json:"rewatch"          example:"true"`
+}
+
+type DiaryEntry struct {
+	ID        int    `json:"id"               example:"1"                        format:"int"`
+	ContentID int    `json:"contentID"        example:"1"                        format:"int"`
+	WatchedOn string `json:"watchedOn"        example:"2024-06-01"               format:"string"`
+	Rating    int    `json:"rating,omitempty" example:"8"                        format:"int"`
+	Note      string `json:"note,omitempty"   example:"Пересмотрел в кинотеатре" format:"string"`
+	Rewatch   bool   `json:"rewatch"          example:"true"`
+	CreatedAt string `json:"createdAt"        example:"2022-01-02T15:04:05Z"     format:"string"`
+	UpdatedAt string `json:"updatedAt"        example:"2022-01-02T15:04:05Z"     format:"string"`
+}
+
+// DiaryEntryWithContent - запись дневника вместе с превью контента
+type DiaryEntryWithContent struct {
+	DiaryEntry
+	Content PreviewContent `json:"content"`
+}
+
+type DiaryDay struct {
+	Date    string                  `json:"date"    example:"2024-06-01" format:"string"`
+	Entries []DiaryEntryWithContent `json:"entries"`
+}
+
+// DiaryCalendar - записи дневника за месяц по дням. Дни без просмотров не включаются
+type DiaryCalendar struct {
+	Year    int        `json:"year"    example:"2024" format:"int"`
+	Month   int        `json:"month"   example:"6"    format:"int"`
+	Entries int        `json:"entries" example:"12"   format:"int"`
+	Days    []DiaryDay `json:"days"`
+}
+
+type DiaryMonthStats struct {
+	Month   int `json:"month"   example:"6"  format:"int"`
+	Entries int `json:"entries" example:"12" format:"int"`
+}
+
+type DiaryYearStats struct {
+	Year           int               `json:"year"           example:"2024" format:"int"`
+	Entries        int               `json:"entries"        example:"120"  format:"int"`
+	UniqueContents int               `json:"uniqueContents" example:"100"  format:"int"`
+	Rewatches      int               `json:"rewatches"      example:"20"   format:"int"`
+	AverageRating  float64           `json:"averageRating"  example:"7.5"  format:"float"`
+	Months         []DiaryMonthStats `json:"months"`
+}
+
+// DiaryContentHistory - все просмотры контента пользователем, начиная с последнего
+type DiaryContentHistory struct {
+	ContentID int          `json:"contentID" example:"1" format:"int"`
+	Views     int          `json:"views"     example:"3" format:"int"`
+	Rewatches int          `json:"rewatches" example:"2" format:"int"`
+	Entries   []DiaryEntry `json:"entries"`
+}
@@ -0,0 +1,899 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package dto
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson1ddc3ff7DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(in *jlexer.Lexer, out *DiaryYearStats) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "year":
+			out.Year = int(in.Int())
+		case "entries":
+			out.Entries = int(in.Int())
+		case "uniqueContents":
+			out.UniqueContents = int(in.Int())
+		case "rewatches":
+			out.Rewatches = int(in.Int())
+		case "averageRating":
+			out.AverageRating = float64(in.Float64())
+		case "months":
+			if in.IsNull() {
+				in.Skip()
+				out.Months = nil
+			} else {
+				in.Delim('[')
+				if out.Months == nil {
+					if !in.IsDelim(']') {
+						out.Months = make([]DiaryMonthStats, 0, 4)
+					} else {
+						out.Months = []DiaryMonthStats{}
+					}
+				} else {
+					out.Months = (out.Months)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 DiaryMonthStats
+					(v1).UnmarshalEasyJSON(in)
+					out.Months = append(out.Months, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1ddc3ff7EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(out *jwriter.Writer, in DiaryYearStats) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"year\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Year))
+	}
+	{
+		const prefix string = ",\"entries\":"
+		out.RawString(prefix)
+		out.Int(int(in.Entries))
+	}
+	{
+		const prefix string = ",\"uniqueContents\":"
+		out.RawString(prefix)
+		out.Int(int(in.UniqueContents))
+	}
+	{
+		const prefix string = ",\"rewatches\":"
+		out.RawString(prefix)
+		out.Int(int(in.Rewatches))
+	}
+	{
+		const prefix string = ",\"averageRating\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.AverageRating))
+	}
+	{
+		const prefix string = ",\"months\":"
+		out.RawString(prefix)
+		if in.Months == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Months {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DiaryYearStats) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1ddc3ff7EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DiaryYearStats) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1ddc3ff7EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DiaryYearStats) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1ddc3ff7DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DiaryYearStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1ddc3ff7DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(l, v)
+}
+func easyjson1ddc3ff7DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(in *jlexer.Lexer, out *DiaryMonthStats) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "month":
+			out.Month = int(in.Int())
+		case "entries":
+			out.Entries = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1ddc3ff7EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(out *jwriter.Writer, in DiaryMonthStats) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"month\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Month))
+	}
+	{
+		const prefix string = ",\"entries\":"
+		out.RawString(prefix)
+		out.Int(int(in.Entries))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DiaryMonthStats) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1ddc3ff7EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DiaryMonthStats) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1ddc3ff7EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DiaryMonthStats) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1ddc3ff7DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DiaryMonthStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1ddc3ff7DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(l, v)
+}
+func easyjson1ddc3ff7DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(in *jlexer.Lexer, out *DiaryEntryWithContent) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "content":
+			(out.Content).UnmarshalEasyJSON(in)
+		case "id":
+			out.ID = int(in.Int())
+		case "contentID":
+			out.ContentID = int(in.Int())
+		case "watchedOn":
+			out.WatchedOn = string(in.String())
+		case "rating":
+			out.Rating = int(in.Int())
+		case "note":
+			out.Note = string(in.String())
+		case "rewatch":
+			out.Rewatch = bool(in.Bool())
+		case "createdAt":
+			out.CreatedAt = string(in.String())
+		case "updatedAt":
+			out.UpdatedAt = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1ddc3ff7EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(out *jwriter.Writer, in DiaryEntryWithContent) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"content\":"
+		out.RawString(prefix[1:])
+		(in.Content).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"contentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ContentID))
+	}
+	{
+		const prefix string = ",\"watchedOn\":"
+		out.RawString(prefix)
+		out.String(string(in.WatchedOn))
+	}
+	if in.Rating != 0 {
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Int(int(in.Rating))
+	}
+	if in.Note != "" {
+		const prefix string = ",\"note\":"
+		out.RawString(prefix)
+		out.String(string(in.Note))
+	}
+	{
+		const prefix string = ",\"rewatch\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Rewatch))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatedAt))
+	}
+	{
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.String(string(in.UpdatedAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DiaryEntryWithContent) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1ddc3ff7EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DiaryEntryWithContent) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1ddc3ff7EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DiaryEntryWithContent) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1ddc3ff7DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DiaryEntryWithContent) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1ddc3ff7DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(l, v)
+}
+func easyjson1ddc3ff7DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(in *jlexer.Lexer, out *DiaryEntryRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "contentID":
+			out.ContentID = int(in.Int())
+		case "watchedOn":
+			out.WatchedOn = string(in.String())
+		case "rating":
+			out.Rating = int(in.Int())
+		case "note":
+			out.Note = string(in.String())
+		case "rewatch":
+			out.Rewatch = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1ddc3ff7EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(out *jwriter.Writer, in DiaryEntryRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"contentID\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ContentID))
+	}
+	{
+		const prefix string = ",\"watchedOn\":"
+		out.RawString(prefix)
+		out.String(string(in.WatchedOn))
+	}
+	if in.Rating != 0 {
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Int(int(in.Rating))
+	}
+	if in.Note != "" {
+		const prefix string = ",\"note\":"
+		out.RawString(prefix)
+		out.String(string(in.Note))
+	}
+	{
+		const prefix string = ",\"rewatch\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Rewatch))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DiaryEntryRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1ddc3ff7EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DiaryEntryRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1ddc3ff7EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DiaryEntryRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1ddc3ff7DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DiaryEntryRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1ddc3ff7DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(l, v)
+}
+func easyjson1ddc3ff7DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(in *jlexer.Lexer, out *DiaryEntry) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "contentID":
+			out.ContentID = int(in.Int())
+		case "watchedOn":
+			out.WatchedOn = string(in.String())
+		case "rating":
+			out.Rating = int(in.Int())
+		case "note":
+			out.Note = string(in.String())
+		case "rewatch":
+			out.Rewatch = bool(in.Bool())
+		case "createdAt":
+			out.CreatedAt = string(in.String())
+		case "updatedAt":
+			out.UpdatedAt = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1ddc3ff7EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(out *jwriter.Writer, in DiaryEntry) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"contentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ContentID))
+	}
+	{
+		const prefix string = ",\"watchedOn\":"
+		out.RawString(prefix)
+		out.String(string(in.WatchedOn))
+	}
+	if in.Rating != 0 {
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Int(int(in.Rating))
+	}
+	if in.Note != "" {
+		const prefix string = ",\"note\":"
+		out.RawString(prefix)
+		out.String(string(in.Note))
+	}
+	{
+		const prefix string = ",\"rewatch\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Rewatch))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatedAt))
+	}
+	{
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.String(string(in.UpdatedAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DiaryEntry) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1ddc3ff7EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DiaryEntry) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1ddc3ff7EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DiaryEntry) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1ddc3ff7DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DiaryEntry) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1ddc3ff7DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(l, v)
+}
+func easyjson1ddc3ff7DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(in *jlexer.Lexer, out *DiaryDay) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "date":
+			out.Date = string(in.String())
+		case "entries":
+			if in.IsNull() {
+				in.Skip()
+				out.Entries = nil
+			} else {
+				in.Delim('[')
+				if out.Entries == nil {
+					if !in.IsDelim(']') {
+						out.Entries = make([]DiaryEntryWithContent, 0, 0)
+					} else {
+						out.Entries = []DiaryEntryWithContent{}
+					}
+				} else {
+					out.Entries = (out.Entries)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 DiaryEntryWithContent
+					(v4).UnmarshalEasyJSON(in)
+					out.Entries = append(out.Entries, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1ddc3ff7EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(out *jwriter.Writer, in DiaryDay) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Date))
+	}
+	{
+		const prefix string = ",\"entries\":"
+		out.RawString(prefix)
+		if in.Entries == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Entries {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DiaryDay) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1ddc3ff7EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DiaryDay) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1ddc3ff7EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DiaryDay) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1ddc3ff7DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DiaryDay) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1ddc3ff7DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(l, v)
+}
+func easyjson1ddc3ff7DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(in *jlexer.Lexer, out *DiaryContentHistory) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "contentID":
+			out.ContentID = int(in.Int())
+		case "views":
+			out.Views = int(in.Int())
+		case "rewatches":
+			out.Rewatches = int(in.Int())
+		case "entries":
+			if in.IsNull() {
+				in.Skip()
+				out.Entries = nil
+			} else {
+				in.Delim('[')
+				if out.Entries == nil {
+					if !in.IsDelim(']') {
+						out.Entries = make([]DiaryEntry, 0, 0)
+					} else {
+						out.Entries = []DiaryEntry{}
+					}
+				} else {
+					out.Entries = (out.Entries)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v7 DiaryEntry
+					(v7).UnmarshalEasyJSON(in)
+					out.Entries = append(out.Entries, v7)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1ddc3ff7EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(out *jwriter.Writer, in DiaryContentHistory) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"contentID\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ContentID))
+	}
+	{
+		const prefix string = ",\"views\":"
+		out.RawString(prefix)
+		out.Int(int(in.Views))
+	}
+	{
+		const prefix string = ",\"rewatches\":"
+		out.RawString(prefix)
+		out.Int(int(in.Rewatches))
+	}
+	{
+		const prefix string = ",\"entries\":"
+		out.RawString(prefix)
+		if in.Entries == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v8, v9 := range in.Entries {
+				if v8 > 0 {
+					out.RawByte(',')
+				}
+				(v9).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DiaryContentHistory) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1ddc3ff7EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DiaryContentHistory) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1ddc3ff7EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DiaryContentHistory) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1ddc3ff7DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DiaryContentHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1ddc3ff7DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(l, v)
+}
+func easyjson1ddc3ff7DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(in *jlexer.Lexer, out *DiaryCalendar) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "year":
+			out.Year = int(in.Int())
+		case "month":
+			out.Month = int(in.Int())
+		case "entries":
+			out.Entries = int(in.Int())
+		case "days":
+			if in.IsNull() {
+				in.Skip()
+				out.Days = nil
+			} else {
+				in.Delim('[')
+				if out.Days == nil {
+					if !in.IsDelim(']') {
+						out.Days = make([]DiaryDay, 0, 1)
+					} else {
+						out.Days = []DiaryDay{}
+					}
+				} else {
+					out.Days = (out.Days)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 DiaryDay
+					(v10).UnmarshalEasyJSON(in)
+					out.Days = append(out.Days, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson1ddc3ff7EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(out *jwriter.Writer, in DiaryCalendar) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"year\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Year))
+	}
+	{
+		const prefix string = ",\"month\":"
+		out.RawString(prefix)
+		out.Int(int(in.Month))
+	}
+	{
+		const prefix string = ",\"entries\":"
+		out.RawString(prefix)
+		out.Int(int(in.Entries))
+	}
+	{
+		const prefix string = ",\"days\":"
+		out.RawString(prefix)
+		if in.Days == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v11, v12 := range in.Days {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				(v12).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v DiaryCalendar) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson1ddc3ff7EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v DiaryCalendar) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson1ddc3ff7EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DiaryCalendar) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson1ddc3ff7DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DiaryCalendar) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson1ddc3ff7DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(l, v)
+}
@@ -0,0 +1,23 @@
+package dto
+
+// ExportReview - рецензия пользователя в выгрузке истории
+type ExportReview struct {
+	Title     string `json:"title"     example:"Лучший фильм Манна"                    format:"string"`
+	Text      string `json:"text"      example:"Перестрелка в центре Лос-Анджелеса..." format:"string"`
+	Rating    int    `json:"rating"    example:"9"                                     format:"int"`
+	CreatedAt string `json:"createdAt" example:"2024-06-14 10:22:40 +0000 UTC"         format:"string"`
+}
+
+// ExportItem - элемент JSON-выгрузки истории пользователя. Отсутствующие части истории не выводятся
+type ExportItem struct {
+	ContentID     int           `json:"contentID"               example:"1"                             format:"int"`
+	KinopoiskID   int           `json:"kinopoiskID,omitempty"   example:"409"                           format:"int"`
+	Title         string        `json:"title"                   example:"Схватка"                       format:"string"`
+	OriginalTitle string        `json:"originalTitle,omitempty" example:"Heat"                          format:"string"`
+	Year          int           `json:"year,omitempty"          example:"1995"                          format:"int"`
+	Category      string        `json:"category,omitempty"      example:"watched"                       format:"string"`
+	AddedAt       string        `json:"addedAt,omitempty"       example:"2024-06-14 10:22:40 +0000 UTC" format:"string"`
+	Rating        int           `json:"rating,omitempty"        example:"9"                             format:"int"`
+	RatedAt       string        `json:"ratedAt,omitempty"       example:"2024-06-14 10:22:40 +0000 UTC" format:"string"`
+	Review        *ExportReview `json:"review,omitempty"`
+}
@@ -0,0 +1,243 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package dto
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson4bb85eceDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(in *jlexer.Lexer, out *ExportReview) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "title":
+			out.Title = string(in.String())
+		case "text":
+			out.Text = string(in.String())
+		case "rating":
+			out.Rating = int(in.Int())
+		case "createdAt":
+			out.CreatedAt = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4bb85eceEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(out *jwriter.Writer, in ExportReview) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Int(int(in.Rating))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatedAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExportReview) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4bb85eceEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExportReview) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4bb85eceEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExportReview) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4bb85eceDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExportReview) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4bb85eceDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(l, v)
+}
+func easyjson4bb85eceDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(in *jlexer.Lexer, out *ExportItem) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "contentID":
+			out.ContentID = int(in.Int())
+		case "kinopoiskID":
+			out.KinopoiskID = int(in.Int())
+		case "title":
+			out.Title = string(in.String())
+		case "originalTitle":
+			out.OriginalTitle = string(in.String())
+		case "year":
+			out.Year = int(in.Int())
+		case "category":
+			out.Category = string(in.String())
+		case "addedAt":
+			out.AddedAt = string(in.String())
+		case "rating":
+			out.Rating = int(in.Int())
+		case "ratedAt":
+			out.RatedAt = string(in.String())
+		case "review":
+			if in.IsNull() {
+				in.Skip()
+				out.Review = nil
+			} else {
+				if out.Review == nil {
+					out.Review = new(ExportReview)
+				}
+				(*out.Review).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson4bb85eceEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(out *jwriter.Writer, in ExportItem) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"contentID\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ContentID))
+	}
+	if in.KinopoiskID != 0 {
+		const prefix string = ",\"kinopoiskID\":"
+		out.RawString(prefix)
+		out.Int(int(in.KinopoiskID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	if in.OriginalTitle != "" {
+		const prefix string = ",\"originalTitle\":"
+		out.RawString(prefix)
+		out.String(string(in.OriginalTitle))
+	}
+	if in.Year != 0 {
+		const prefix string = ",\"year\":"
+		out.RawString(prefix)
+		out.Int(int(in.Year))
+	}
+	if in.Category != "" {
+		const prefix string = ",\"category\":"
+		out.RawString(prefix)
+		out.String(string(in.Category))
+	}
+	if in.AddedAt != "" {
+		const prefix string = ",\"addedAt\":"
+		out.RawString(prefix)
+		out.String(string(in.AddedAt))
+	}
+	if in.Rating != 0 {
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Int(int(in.Rating))
+	}
+	if in.RatedAt != "" {
+		const prefix string = ",\"ratedAt\":"
+		out.RawString(prefix)
+		out.String(string(in.RatedAt))
+	}
+	if in.Review != nil {
+		const prefix string = ",\"review\":"
+		out.RawString(prefix)
+		(*in.Review).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ExportItem) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson4bb85eceEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ExportItem) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson4bb85eceEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ExportItem) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson4bb85eceDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ExportItem) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson4bb85eceDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(l, v)
+}
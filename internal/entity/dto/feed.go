@@ -0,0 +1,23 @@
+package dto
+
+// FeedItem - событие в ленте подписок: review, rating, list_item или favourite. Заполняются только поля,
+// относящиеся к типу события
+type FeedItem struct {
+	ID        int            `json:"id"                 example:"1"                    format:"int"`
+	Type      string         `json:"type"               example:"rating"               format:"string"`
+	User      FollowUser     `json:"user"`
+	Content   PreviewContent `json:"content"`
+	ReviewID  int            `json:"reviewID,omitempty" example:"1"                    format:"int"`
+	ListID    int            `json:"listID,omitempty"   example:"1"                    format:"int"`
+	Rating    int            `json:"rating,omitempty"   example:"8"                    format:"int"`
+	Category  string         `json:"category,omitempty" example:"watching"             format:"string"`
+	CreatedAt string         `json:"createdAt"          example:"2022-01-02T15:04:05Z" format:"string"`
+}
+
+type Feed struct {
+	Items []FeedItem `json:"items"`
+	Page  int        `json:"page"  example:"1"  format:"int"`
+	Count int        `json:"count" example:"20" format:"int"`
+	Pages int        `json:"pages" example:"1"  format:"int"`
+	Total int        `json:"total" example:"1"  format:"int"`
+}
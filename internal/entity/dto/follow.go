@@ -0,0 +1,23 @@
+package dto
+
+// FollowUser - пользователь в списке подписчиков или подписок
+type FollowUser struct {
+	ID     int    `json:"id"     example:"1"                  format:"int"`
+	Name   string `json:"name"   example:"Егор"               format:"string"`
+	Avatar string `json:"avatar" example:"avatars/avatar.jpg" format:"string"`
+}
+
+type FollowUsers struct {
+	Users []FollowUser `json:"users"`
+	Page  int          `json:"page"  example:"1"  format:"int"`
+	Count int          `json:"count" example:"20" format:"int"`
+	Pages int          `json:"pages" example:"1"  format:"int"`
+	Total int          `json:"total" example:"1"  format:"int"`
+}
+
+// FollowStatus - счетчики подписок пользователя и подписан ли на него текущий пользователь
+type FollowStatus struct {
+	Following      bool `json:"following"      example:"true" format:"bool"`
+	FollowersCount int  `json:"followersCount" example:"10"   format:"int"`
+	FollowingCount int  `json:"followingCount" example:"5"    format:"int"`
+}
@@ -0,0 +1,7 @@
+package dto
+
+type HealthResponse struct {
+	Status string `json:"status" example:"ok" format:"string" description:"ok или fail"`
+	// nolint:lll
+	Checks map[string]string `json:"checks,omitempty" description:"статусы зависимостей (ok или fail), только для readyz"`
+}
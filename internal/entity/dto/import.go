@@ -0,0 +1,31 @@
+package dto
+
+// ImportRow - строка файла импорта, для которой не нашелся контент. ContentID равен 0, пока пользователь
+// не сопоставил строку вручную
+type ImportRow struct {
+	Row           int    `json:"row"                     example:"12"      format:"int"`
+	Title         string `json:"title"                   example:"Схватка" format:"string"`
+	OriginalTitle string `json:"originalTitle,omitempty" example:"Heat"    format:"string"`
+	Year          int    `json:"year,omitempty"          example:"1995"    format:"int"`
+	KinopoiskID   int    `json:"kinopoiskID,omitempty"   example:"409"     format:"int"`
+	Rating        int    `json:"rating,omitempty"        example:"9"       format:"int"`
+	ContentID     int    `json:"contentID"               example:"0"       format:"int"`
+}
+
+// ImportJob - задача импорта истории просмотров. Status принимает значения pending, running, done и failed
+type ImportJob struct {
+	ID          int         `json:"id"              example:"1"                             format:"int"`
+	Source      string      `json:"source"          example:"letterboxd"                    format:"string"`
+	Category    string      `json:"category"        example:"watched"                       format:"string"`
+	Status      string      `json:"status"          example:"done"                          format:"string"`
+	TotalRows   int         `json:"totalRows"       example:"120"                           format:"int"`
+	MatchedRows int         `json:"matchedRows"     example:"115"                           format:"int"`
+	Error       string      `json:"error,omitempty" example:"не удалось импортировать"      format:"string"`
+	CreatedAt   string      `json:"createdAt"       example:"2024-06-14 10:22:40 +0000 UTC" format:"string"`
+	UpdatedAt   string      `json:"updatedAt"       example:"2024-06-14 10:22:45 +0000 UTC" format:"string"`
+	Unmatched   []ImportRow `json:"unmatched"`
+}
+
+type ImportResolveRequest struct {
+	ContentID int `json:"contentID" example:"1" format:"int"`
+}
@@ -0,0 +1,354 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package dto
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson63a4a5efDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(in *jlexer.Lexer, out *ImportRow) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "row":
+			out.Row = int(in.Int())
+		case "title":
+			out.Title = string(in.String())
+		case "originalTitle":
+			out.OriginalTitle = string(in.String())
+		case "year":
+			out.Year = int(in.Int())
+		case "kinopoiskID":
+			out.KinopoiskID = int(in.Int())
+		case "rating":
+			out.Rating = int(in.Int())
+		case "contentID":
+			out.ContentID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson63a4a5efEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(out *jwriter.Writer, in ImportRow) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"row\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Row))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	if in.OriginalTitle != "" {
+		const prefix string = ",\"originalTitle\":"
+		out.RawString(prefix)
+		out.String(string(in.OriginalTitle))
+	}
+	if in.Year != 0 {
+		const prefix string = ",\"year\":"
+		out.RawString(prefix)
+		out.Int(int(in.Year))
+	}
+	if in.KinopoiskID != 0 {
+		const prefix string = ",\"kinopoiskID\":"
+		out.RawString(prefix)
+		out.Int(int(in.KinopoiskID))
+	}
+	if in.Rating != 0 {
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Int(int(in.Rating))
+	}
+	{
+		const prefix string = ",\"contentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ContentID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ImportRow) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson63a4a5efEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ImportRow) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson63a4a5efEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ImportRow) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson63a4a5efDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ImportRow) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson63a4a5efDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(l, v)
+}
+func easyjson63a4a5efDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(in *jlexer.Lexer, out *ImportResolveRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "contentID":
+			out.ContentID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson63a4a5efEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(out *jwriter.Writer, in ImportResolveRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"contentID\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ContentID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ImportResolveRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson63a4a5efEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ImportResolveRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson63a4a5efEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ImportResolveRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson63a4a5efDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ImportResolveRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson63a4a5efDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(l, v)
+}
+func easyjson63a4a5efDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(in *jlexer.Lexer, out *ImportJob) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "source":
+			out.Source = string(in.String())
+		case "category":
+			out.Category = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "totalRows":
+			out.TotalRows = int(in.Int())
+		case "matchedRows":
+			out.MatchedRows = int(in.Int())
+		case "error":
+			out.Error = string(in.String())
+		case "createdAt":
+			out.CreatedAt = string(in.String())
+		case "updatedAt":
+			out.UpdatedAt = string(in.String())
+		case "unmatched":
+			if in.IsNull() {
+				in.Skip()
+				out.Unmatched = nil
+			} else {
+				in.Delim('[')
+				if out.Unmatched == nil {
+					if !in.IsDelim(']') {
+						out.Unmatched = make([]ImportRow, 0, 0)
+					} else {
+						out.Unmatched = []ImportRow{}
+					}
+				} else {
+					out.Unmatched = (out.Unmatched)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 ImportRow
+					(v1).UnmarshalEasyJSON(in)
+					out.Unmatched = append(out.Unmatched, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson63a4a5efEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(out *jwriter.Writer, in ImportJob) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"source\":"
+		out.RawString(prefix)
+		out.String(string(in.Source))
+	}
+	{
+		const prefix string = ",\"category\":"
+		out.RawString(prefix)
+		out.String(string(in.Category))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"totalRows\":"
+		out.RawString(prefix)
+		out.Int(int(in.TotalRows))
+	}
+	{
+		const prefix string = ",\"matchedRows\":"
+		out.RawString(prefix)
+		out.Int(int(in.MatchedRows))
+	}
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatedAt))
+	}
+	{
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.String(string(in.UpdatedAt))
+	}
+	{
+		const prefix string = ",\"unmatched\":"
+		out.RawString(prefix)
+		if in.Unmatched == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Unmatched {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ImportJob) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson63a4a5efEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ImportJob) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson63a4a5efEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ImportJob) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson63a4a5efDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ImportJob) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson63a4a5efDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(l, v)
+}
@@ -0,0 +1,30 @@
+package dto
+
+// PrivacySettings - видимость разделов профиля: public, followers или private
+type PrivacySettings struct {
+	Favourites string `json:"favourites" example:"public"    format:"string"`
+	Reviews    string `json:"reviews"    example:"public"    format:"string"`
+	Ratings    string `json:"ratings"    example:"followers" format:"string"`
+	Lists      string `json:"lists"      example:"private"   format:"string"`
+}
+
+type GenreCount struct {
+	ID    int    `json:"id"    example:"1"     format:"int"`
+	Name  string `json:"name"  example:"Драма" format:"string"`
+	Count int    `json:"count" example:"12"    format:"int"`
+}
+
+// PublicProfile - профиль пользователя для других пользователей. Поля скрытых разделов не возвращаются
+type PublicProfile struct {
+	ID              int              `json:"id"                        example:"1"                  format:"int"`
+	Name            string           `json:"name"                      example:"Егор"               format:"string"`
+	Avatar          string           `json:"avatar"                    example:"avatars/avatar.jpg" format:"string"`
+	Rating          int              `json:"rating"                    example:"100"                format:"int"`
+	Me              bool             `json:"me"                        example:"false"              format:"bool"`
+	ReviewsCount    *int             `json:"reviewsCount,omitempty"    example:"10"                 format:"int"`
+	RatingsCount    *int             `json:"ratingsCount,omitempty"    example:"50"                 format:"int"`
+	FavouritesCount *int             `json:"favouritesCount,omitempty" example:"20"                 format:"int"`
+	ListsCount      *int             `json:"listsCount,omitempty"      example:"3"                  format:"int"`
+	RecentReviews   []ReviewResponse `json:"recentReviews,omitempty"`
+	TopGenres       []GenreCount     `json:"topGenres,omitempty"`
+}
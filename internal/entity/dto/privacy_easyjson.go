@@ -0,0 +1,414 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package dto
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson83ccb59aDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(in *jlexer.Lexer, out *PublicProfile) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "name":
+			out.Name = string(in.String())
+		case "avatar":
+			out.Avatar = string(in.String())
+		case "rating":
+			out.Rating = int(in.Int())
+		case "me":
+			out.Me = bool(in.Bool())
+		case "reviewsCount":
+			if in.IsNull() {
+				in.Skip()
+				out.ReviewsCount = nil
+			} else {
+				if out.ReviewsCount == nil {
+					out.ReviewsCount = new(int)
+				}
+				*out.ReviewsCount = int(in.Int())
+			}
+		case "ratingsCount":
+			if in.IsNull() {
+				in.Skip()
+				out.RatingsCount = nil
+			} else {
+				if out.RatingsCount == nil {
+					out.RatingsCount = new(int)
+				}
+				*out.RatingsCount = int(in.Int())
+			}
+		case "favouritesCount":
+			if in.IsNull() {
+				in.Skip()
+				out.FavouritesCount = nil
+			} else {
+				if out.FavouritesCount == nil {
+					out.FavouritesCount = new(int)
+				}
+				*out.FavouritesCount = int(in.Int())
+			}
+		case "listsCount":
+			if in.IsNull() {
+				in.Skip()
+				out.ListsCount = nil
+			} else {
+				if out.ListsCount == nil {
+					out.ListsCount = new(int)
+				}
+				*out.ListsCount = int(in.Int())
+			}
+		case "recentReviews":
+			if in.IsNull() {
+				in.Skip()
+				out.RecentReviews = nil
+			} else {
+				in.Delim('[')
+				if out.RecentReviews == nil {
+					if !in.IsDelim(']') {
+						out.RecentReviews = make([]ReviewResponse, 0, 0)
+					} else {
+						out.RecentReviews = []ReviewResponse{}
+					}
+				} else {
+					out.RecentReviews = (out.RecentReviews)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 ReviewResponse
+					(v1).UnmarshalEasyJSON(in)
+					out.RecentReviews = append(out.RecentReviews, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "topGenres":
+			if in.IsNull() {
+				in.Skip()
+				out.TopGenres = nil
+			} else {
+				in.Delim('[')
+				if out.TopGenres == nil {
+					if !in.IsDelim(']') {
+						out.TopGenres = make([]GenreCount, 0, 2)
+					} else {
+						out.TopGenres = []GenreCount{}
+					}
+				} else {
+					out.TopGenres = (out.TopGenres)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v2 GenreCount
+					(v2).UnmarshalEasyJSON(in)
+					out.TopGenres = append(out.TopGenres, v2)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson83ccb59aEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(out *jwriter.Writer, in PublicProfile) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"avatar\":"
+		out.RawString(prefix)
+		out.String(string(in.Avatar))
+	}
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Int(int(in.Rating))
+	}
+	{
+		const prefix string = ",\"me\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Me))
+	}
+	if in.ReviewsCount != nil {
+		const prefix string = ",\"reviewsCount\":"
+		out.RawString(prefix)
+		out.Int(int(*in.ReviewsCount))
+	}
+	if in.RatingsCount != nil {
+		const prefix string = ",\"ratingsCount\":"
+		out.RawString(prefix)
+		out.Int(int(*in.RatingsCount))
+	}
+	if in.FavouritesCount != nil {
+		const prefix string = ",\"favouritesCount\":"
+		out.RawString(prefix)
+		out.Int(int(*in.FavouritesCount))
+	}
+	if in.ListsCount != nil {
+		const prefix string = ",\"listsCount\":"
+		out.RawString(prefix)
+		out.Int(int(*in.ListsCount))
+	}
+	if len(in.RecentReviews) != 0 {
+		const prefix string = ",\"recentReviews\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v3, v4 := range in.RecentReviews {
+				if v3 > 0 {
+					out.RawByte(',')
+				}
+				(v4).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.TopGenres) != 0 {
+		const prefix string = ",\"topGenres\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v5, v6 := range in.TopGenres {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PublicProfile) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson83ccb59aEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PublicProfile) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson83ccb59aEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PublicProfile) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson83ccb59aDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PublicProfile) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson83ccb59aDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(l, v)
+}
+func easyjson83ccb59aDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(in *jlexer.Lexer, out *PrivacySettings) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "favourites":
+			out.Favourites = string(in.String())
+		case "reviews":
+			out.Reviews = string(in.String())
+		case "ratings":
+			out.Ratings = string(in.String())
+		case "lists":
+			out.Lists = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson83ccb59aEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(out *jwriter.Writer, in PrivacySettings) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"favourites\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Favourites))
+	}
+	{
+		const prefix string = ",\"reviews\":"
+		out.RawString(prefix)
+		out.String(string(in.Reviews))
+	}
+	{
+		const prefix string = ",\"ratings\":"
+		out.RawString(prefix)
+		out.String(string(in.Ratings))
+	}
+	{
+		const prefix string = ",\"lists\":"
+		out.RawString(prefix)
+		out.String(string(in.Lists))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PrivacySettings) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson83ccb59aEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PrivacySettings) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson83ccb59aEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PrivacySettings) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson83ccb59aDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PrivacySettings) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson83ccb59aDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(l, v)
+}
+func easyjson83ccb59aDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(in *jlexer.Lexer, out *GenreCount) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "name":
+			out.Name = string(in.String())
+		case "count":
+			out.Count = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson83ccb59aEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(out *jwriter.Writer, in GenreCount) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix)
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int(int(in.Count))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v GenreCount) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson83ccb59aEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v GenreCount) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson83ccb59aEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *GenreCount) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson83ccb59aDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *GenreCount) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson83ccb59aDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(l, v)
+}
@@ -0,0 +1,17 @@
+package dto
+
+// RecommendationSource - контент пользователя, из-за которого был рекомендован другой контент
+type RecommendationSource struct {
+	ID    int    `json:"id"    example:"1"      format:"int"`
+	Title string `json:"title" example:"Бэтмен" format:"string"`
+}
+
+type Recommendation struct {
+	Content   PreviewContent       `json:"content"`
+	BecauseOf RecommendationSource `json:"becauseOf"`
+	Reason    string               `json:"reason"    example:"Потому что вам понравился «Бэтмен»" format:"string"`
+}
+
+type Recommendations struct {
+	Items []Recommendation `json:"items"`
+}
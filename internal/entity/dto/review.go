@@ -1,15 +1,72 @@
 package dto
 
 type Review struct {
-	ID        int    `json:"id"        example:"1"                    format:"int"`
-	AuthorID  int    `json:"authorID"  example:"1"                    format:"int"`
-	ContentID int    `json:"contentID" example:"1"                    format:"int"`
-	Rating    int    `json:"rating"    example:"5"                    format:"int"`
-	Title     string `json:"title"     example:"Title"                format:"string"`
-	Text      string `json:"text"      example:"i like it"            format:"string"`
-	CreatedAt string `json:"createdAt" example:"2022-01-02T15:04:05Z" format:"int"`
-	Likes     int    `json:"likes"     example:"5"                    format:"int"`
-	Dislikes  int    `json:"dislikes"  example:"5"                    format:"int"`
+	ID          int    `json:"id"          example:"1"                    format:"int"`
+	AuthorID    int    `json:"authorID"    example:"1"                    format:"int"`
+	ContentID   int    `json:"contentID"   example:"1"                    format:"int"`
+	Rating      int    `json:"rating"      example:"5"                    format:"int"`
+	Title       string `json:"title"       example:"Title"                format:"string"`
+	Text        string `json:"text"        example:"i like it"            format:"string"`
+	CreatedAt   string `json:"createdAt"   example:"2022-01-02T15:04:05Z" format:"int"`
+	Likes       int    `json:"likes"       example:"5"                    format:"int"`
+	Dislikes    int    `json:"dislikes"    example:"5"                    format:"int"`
+	Comments    int    `json:"comments"    example:"3"                    format:"int"`
+	HasSpoilers bool   `json:"hasSpoilers" example:"true"                 format:"bool"`
+	EditCount   int    `json:"editCount"   example:"2"                    format:"int"`
+	// EditedAt время последней правки, пустое, если рецензия не редактировалась
+	EditedAt string `json:"editedAt" example:"2022-01-03T15:04:05Z" format:"string"`
+	// Spoilers диапазоны спойлеров в тексте рецензии, которые нужно скрыть
+	Spoilers []SpoilerRange `json:"spoilers"`
+}
+
+// ReviewRevision - версия рецензии до правки
+type ReviewRevision struct {
+	Rating      int            `json:"rating"      example:"5"                    format:"int"`
+	Title       string         `json:"title"       example:"Title"                format:"string"`
+	Text        string         `json:"text"        example:"i like it"            format:"string"`
+	HasSpoilers bool           `json:"hasSpoilers" example:"false"                format:"bool"`
+	Spoilers    []SpoilerRange `json:"spoilers"`
+	CreatedAt   string         `json:"createdAt"   example:"2022-01-02T15:04:05Z" format:"string"`
+	ReplacedAt  string         `json:"replacedAt"  example:"2022-01-03T15:04:05Z" format:"string"`
+}
+
+// ReviewHistory - текущая версия рецензии и ее предыдущие версии, начиная с первоначальной
+type ReviewHistory struct {
+	Review    ReviewResponse   `json:"review"`
+	Revisions []ReviewRevision `json:"revisions"`
+}
+
+// RatingHistogramBar - число оценок rating
+type RatingHistogramBar struct {
+	Rating int `json:"rating" example:"9"  format:"int"`
+	Count  int `json:"count"  example:"12" format:"int"`
+}
+
+// ContentRatingStats - распределение оценок контента. weightedRating - байесовская оценка, по которой
+// сортируются подборки: при малом числе оценок она ближе к априорной оценке, чем к средней
+type ContentRatingStats struct {
+	ContentID      int                  `json:"contentID"      example:"1"   format:"int"`
+	RatingCount    int                  `json:"ratingCount"    example:"40"  format:"int"`
+	Average        float64              `json:"average"        example:"7.9" format:"float"`
+	Median         float64              `json:"median"         example:"8"   format:"float"`
+	WeightedRating float64              `json:"weightedRating" example:"7.6" format:"float"`
+	Histogram      []RatingHistogramBar `json:"histogram"`
+}
+
+// SpoilerRange - диапазон спойлера в тексте рецензии в символах, start включительно, end не включительно
+type SpoilerRange struct {
+	Start int `json:"start" example:"8"  format:"int"`
+	End   int `json:"end"   example:"22" format:"int"`
+}
+
+// ReviewFilter - условия выборки рецензий для списков
+type ReviewFilter struct {
+	HideSpoilers bool `json:"hideSpoilers" example:"true" format:"bool"`
+	// Sort - newest, oldest, helpful, controversial, highest или lowest
+	Sort string `json:"sort" example:"helpful" format:"string"`
+	// Sentiment - positive (оценка 7-10), neutral (5-6) или negative (1-4)
+	Sentiment     string `json:"sentiment"     example:"positive" format:"string"`
+	MinTextLength int    `json:"minTextLength" example:"500"      format:"int"`
 }
 
 // ReviewResponse - структура для ответа на запросы
@@ -34,10 +91,11 @@ type UserReviewResponseList struct {
 }
 
 type ReviewCreateRequest struct {
-	ContentID int    `json:"contentID" example:"1"         format:"int"`
-	Rating    int    `json:"rating"    example:"5"         format:"int"`
-	Title     string `json:"title"     example:"Title"     format:"string"`
-	Text      string `json:"text"      example:"i like it" format:"string"`
+	ContentID   int    `json:"contentID"   example:"1"         format:"int"`
+	Rating      int    `json:"rating"      example:"5"         format:"int"`
+	Title       string `json:"title"       example:"Title"     format:"string"`
+	Text        string `json:"text"        example:"i like it" format:"string"`
+	HasSpoilers bool   `json:"hasSpoilers" example:"false"     format:"bool"`
 }
 
 type ReviewCreate struct {
@@ -46,10 +104,11 @@ type ReviewCreate struct {
 }
 
 type ReviewUpdateRequest struct {
-	ReviewID int    `json:"reviewID" example:"1"         format:"int"`
-	Rating   int    `json:"rating"   example:"5"         format:"int"`
-	Title    string `json:"title"    example:"Title"     format:"string"`
-	Text     string `json:"text"     example:"i like it" format:"string"`
+	ReviewID    int    `json:"reviewID"    example:"1"         format:"int"`
+	Rating      int    `json:"rating"      example:"5"         format:"int"`
+	Title       string `json:"title"       example:"Title"     format:"string"`
+	Text        string `json:"text"        example:"i like it" format:"string"`
+	HasSpoilers bool   `json:"hasSpoilers" example:"false"     format:"bool"`
 }
 
 type ReviewUpdate struct {
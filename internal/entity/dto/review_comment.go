@@ -0,0 +1,49 @@
+package dto
+
+type ReviewComment struct {
+	ID        int    `json:"id"        example:"1"                    format:"int"`
+	ReviewID  int    `json:"reviewID"  example:"1"                    format:"int"`
+	AuthorID  int    `json:"authorID"  example:"1"                    format:"int"`
+	ParentID  int    `json:"parentID"  example:"0"                    format:"int"`
+	Text      string `json:"text"      example:"согласен"             format:"string"`
+	CreatedAt string `json:"createdAt" example:"2022-01-02T15:04:05Z" format:"string"`
+	UpdatedAt string `json:"updatedAt" example:"2022-01-02T15:04:05Z" format:"string"`
+}
+
+// ReviewCommentResponse - комментарий с данными автора. У комментариев верхнего уровня заполнены ответы
+type ReviewCommentResponse struct {
+	ReviewComment
+	AuthorName   string                  `json:"authorName"        example:"Author"             format:"string"`
+	AuthorAvatar string                  `json:"authorAvatar"      example:"avatars/avatar.jpg" format:"string"`
+	Replies      []ReviewCommentResponse `json:"replies,omitempty"`
+}
+
+type ReviewCommentResponseList struct {
+	Comments []ReviewCommentResponse `json:"comments"`
+	Page     int                     `json:"page"     example:"1"  format:"int"`
+	Count    int                     `json:"count"    example:"10" format:"int"`
+	Pages    int                     `json:"pages"    example:"1"  format:"int"`
+	Total    int                     `json:"total"    example:"1"  format:"int"`
+}
+
+type ReviewCommentCreateRequest struct {
+	// ParentID - id комментария, на который дается ответ. 0 для комментария верхнего уровня
+	ParentID int    `json:"parentID" example:"0"        format:"int"`
+	Text     string `json:"text"     example:"согласен" format:"string"`
+}
+
+type ReviewCommentCreate struct {
+	ReviewCommentCreateRequest
+	ReviewID int `json:"reviewID" example:"1" format:"int"`
+	UserID   int `json:"userID"   example:"1" format:"int"`
+}
+
+type ReviewCommentUpdateRequest struct {
+	Text string `json:"text" example:"согласен" format:"string"`
+}
+
+type ReviewCommentUpdate struct {
+	ReviewCommentUpdateRequest
+	CommentID int `json:"commentID" example:"1" format:"int"`
+	UserID    int `json:"userID"    example:"1" format:"int"`
+}
@@ -0,0 +1,718 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package dto
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson8038457eDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(in *jlexer.Lexer, out *ReviewCommentUpdateRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "text":
+			out.Text = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson8038457eEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(out *jwriter.Writer, in ReviewCommentUpdateRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Text))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReviewCommentUpdateRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson8038457eEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReviewCommentUpdateRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson8038457eEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReviewCommentUpdateRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson8038457eDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReviewCommentUpdateRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson8038457eDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(l, v)
+}
+func easyjson8038457eDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(in *jlexer.Lexer, out *ReviewCommentUpdate) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "commentID":
+			out.CommentID = int(in.Int())
+		case "userID":
+			out.UserID = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson8038457eEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(out *jwriter.Writer, in ReviewCommentUpdate) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"commentID\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.CommentID))
+	}
+	{
+		const prefix string = ",\"userID\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReviewCommentUpdate) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson8038457eEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReviewCommentUpdate) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson8038457eEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReviewCommentUpdate) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson8038457eDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReviewCommentUpdate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson8038457eDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(l, v)
+}
+func easyjson8038457eDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(in *jlexer.Lexer, out *ReviewCommentResponseList) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "comments":
+			if in.IsNull() {
+				in.Skip()
+				out.Comments = nil
+			} else {
+				in.Delim('[')
+				if out.Comments == nil {
+					if !in.IsDelim(']') {
+						out.Comments = make([]ReviewCommentResponse, 0, 0)
+					} else {
+						out.Comments = []ReviewCommentResponse{}
+					}
+				} else {
+					out.Comments = (out.Comments)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 ReviewCommentResponse
+					(v1).UnmarshalEasyJSON(in)
+					out.Comments = append(out.Comments, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "page":
+			out.Page = int(in.Int())
+		case "count":
+			out.Count = int(in.Int())
+		case "pages":
+			out.Pages = int(in.Int())
+		case "total":
+			out.Total = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson8038457eEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(out *jwriter.Writer, in ReviewCommentResponseList) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"comments\":"
+		out.RawString(prefix[1:])
+		if in.Comments == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Comments {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"page\":"
+		out.RawString(prefix)
+		out.Int(int(in.Page))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int(int(in.Count))
+	}
+	{
+		const prefix string = ",\"pages\":"
+		out.RawString(prefix)
+		out.Int(int(in.Pages))
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Int(int(in.Total))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReviewCommentResponseList) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson8038457eEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReviewCommentResponseList) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson8038457eEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReviewCommentResponseList) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson8038457eDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReviewCommentResponseList) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson8038457eDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(l, v)
+}
+func easyjson8038457eDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(in *jlexer.Lexer, out *ReviewCommentResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "authorName":
+			out.AuthorName = string(in.String())
+		case "authorAvatar":
+			out.AuthorAvatar = string(in.String())
+		case "replies":
+			if in.IsNull() {
+				in.Skip()
+				out.Replies = nil
+			} else {
+				in.Delim('[')
+				if out.Replies == nil {
+					if !in.IsDelim(']') {
+						out.Replies = make([]ReviewCommentResponse, 0, 0)
+					} else {
+						out.Replies = []ReviewCommentResponse{}
+					}
+				} else {
+					out.Replies = (out.Replies)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 ReviewCommentResponse
+					(v4).UnmarshalEasyJSON(in)
+					out.Replies = append(out.Replies, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "id":
+			out.ID = int(in.Int())
+		case "reviewID":
+			out.ReviewID = int(in.Int())
+		case "authorID":
+			out.AuthorID = int(in.Int())
+		case "parentID":
+			out.ParentID = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		case "createdAt":
+			out.CreatedAt = string(in.String())
+		case "updatedAt":
+			out.UpdatedAt = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson8038457eEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(out *jwriter.Writer, in ReviewCommentResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"authorName\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.AuthorName))
+	}
+	{
+		const prefix string = ",\"authorAvatar\":"
+		out.RawString(prefix)
+		out.String(string(in.AuthorAvatar))
+	}
+	if len(in.Replies) != 0 {
+		const prefix string = ",\"replies\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v5, v6 := range in.Replies {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"reviewID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReviewID))
+	}
+	{
+		const prefix string = ",\"authorID\":"
+		out.RawString(prefix)
+		out.Int(int(in.AuthorID))
+	}
+	{
+		const prefix string = ",\"parentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatedAt))
+	}
+	{
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.String(string(in.UpdatedAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReviewCommentResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson8038457eEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReviewCommentResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson8038457eEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReviewCommentResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson8038457eDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReviewCommentResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson8038457eDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(l, v)
+}
+func easyjson8038457eDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(in *jlexer.Lexer, out *ReviewCommentCreateRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "parentID":
+			out.ParentID = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson8038457eEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(out *jwriter.Writer, in ReviewCommentCreateRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"parentID\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ParentID))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReviewCommentCreateRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson8038457eEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReviewCommentCreateRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson8038457eEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReviewCommentCreateRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson8038457eDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReviewCommentCreateRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson8038457eDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(l, v)
+}
+func easyjson8038457eDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(in *jlexer.Lexer, out *ReviewCommentCreate) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "reviewID":
+			out.ReviewID = int(in.Int())
+		case "userID":
+			out.UserID = int(in.Int())
+		case "parentID":
+			out.ParentID = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson8038457eEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(out *jwriter.Writer, in ReviewCommentCreate) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"reviewID\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ReviewID))
+	}
+	{
+		const prefix string = ",\"userID\":"
+		out.RawString(prefix)
+		out.Int(int(in.UserID))
+	}
+	{
+		const prefix string = ",\"parentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReviewCommentCreate) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson8038457eEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReviewCommentCreate) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson8038457eEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReviewCommentCreate) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson8038457eDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReviewCommentCreate) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson8038457eDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(l, v)
+}
+func easyjson8038457eDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(in *jlexer.Lexer, out *ReviewComment) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "reviewID":
+			out.ReviewID = int(in.Int())
+		case "authorID":
+			out.AuthorID = int(in.Int())
+		case "parentID":
+			out.ParentID = int(in.Int())
+		case "text":
+			out.Text = string(in.String())
+		case "createdAt":
+			out.CreatedAt = string(in.String())
+		case "updatedAt":
+			out.UpdatedAt = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson8038457eEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(out *jwriter.Writer, in ReviewComment) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"reviewID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ReviewID))
+	}
+	{
+		const prefix string = ",\"authorID\":"
+		out.RawString(prefix)
+		out.Int(int(in.AuthorID))
+	}
+	{
+		const prefix string = ",\"parentID\":"
+		out.RawString(prefix)
+		out.Int(int(in.ParentID))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatedAt))
+	}
+	{
+		const prefix string = ",\"updatedAt\":"
+		out.RawString(prefix)
+		out.String(string(in.UpdatedAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReviewComment) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson8038457eEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReviewComment) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson8038457eEncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReviewComment) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson8038457eDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReviewComment) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson8038457eDecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(l, v)
+}
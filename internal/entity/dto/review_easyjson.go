@@ -150,7 +150,80 @@ func (v *UserReviewResponseList) UnmarshalJSON(data []byte) error {
 func (v *UserReviewResponseList) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(l, v)
 }
-func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(in *jlexer.Lexer, out *ReviewUpdateRequest) {
+func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(in *jlexer.Lexer, out *SpoilerRange) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "start":
+			out.Start = int(in.Int())
+		case "end":
+			out.End = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(out *jwriter.Writer, in SpoilerRange) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"start\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Start))
+	}
+	{
+		const prefix string = ",\"end\":"
+		out.RawString(prefix)
+		out.Int(int(in.End))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SpoilerRange) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SpoilerRange) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SpoilerRange) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SpoilerRange) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(l, v)
+}
+func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(in *jlexer.Lexer, out *ReviewUpdateRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -177,6 +250,8 @@ func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 			out.Title = string(in.String())
 		case "text":
 			out.Text = string(in.String())
+		case "hasSpoilers":
+			out.HasSpoilers = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -187,7 +262,7 @@ func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 		in.Consumed()
 	}
 }
-func easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(out *jwriter.Writer, in ReviewUpdateRequest) {
+func easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(out *jwriter.Writer, in ReviewUpdateRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -211,33 +286,38 @@ func easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 		out.RawString(prefix)
 		out.String(string(in.Text))
 	}
+	{
+		const prefix string = ",\"hasSpoilers\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HasSpoilers))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v ReviewUpdateRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(&w, v)
+	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ReviewUpdateRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(w, v)
+	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ReviewUpdateRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(&r, v)
+	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ReviewUpdateRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(l, v)
+	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(l, v)
 }
-func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(in *jlexer.Lexer, out *ReviewUpdate) {
+func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(in *jlexer.Lexer, out *ReviewUpdate) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -266,6 +346,8 @@ func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 			out.Title = string(in.String())
 		case "text":
 			out.Text = string(in.String())
+		case "hasSpoilers":
+			out.HasSpoilers = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -276,7 +358,7 @@ func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 		in.Consumed()
 	}
 }
-func easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(out *jwriter.Writer, in ReviewUpdate) {
+func easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(out *jwriter.Writer, in ReviewUpdate) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -305,33 +387,178 @@ func easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 		out.RawString(prefix)
 		out.String(string(in.Text))
 	}
+	{
+		const prefix string = ",\"hasSpoilers\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HasSpoilers))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v ReviewUpdate) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(&w, v)
+	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ReviewUpdate) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(w, v)
+	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ReviewUpdate) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(&r, v)
+	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ReviewUpdate) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(l, v)
+	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(l, v)
+}
+func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(in *jlexer.Lexer, out *ReviewRevision) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "rating":
+			out.Rating = int(in.Int())
+		case "title":
+			out.Title = string(in.String())
+		case "text":
+			out.Text = string(in.String())
+		case "hasSpoilers":
+			out.HasSpoilers = bool(in.Bool())
+		case "spoilers":
+			if in.IsNull() {
+				in.Skip()
+				out.Spoilers = nil
+			} else {
+				in.Delim('[')
+				if out.Spoilers == nil {
+					if !in.IsDelim(']') {
+						out.Spoilers = make([]SpoilerRange, 0, 4)
+					} else {
+						out.Spoilers = []SpoilerRange{}
+					}
+				} else {
+					out.Spoilers = (out.Spoilers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 SpoilerRange
+					(v4).UnmarshalEasyJSON(in)
+					out.Spoilers = append(out.Spoilers, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "createdAt":
+			out.CreatedAt = string(in.String())
+		case "replacedAt":
+			out.ReplacedAt = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(out *jwriter.Writer, in ReviewRevision) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Rating))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"hasSpoilers\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HasSpoilers))
+	}
+	{
+		const prefix string = ",\"spoilers\":"
+		out.RawString(prefix)
+		if in.Spoilers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Spoilers {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.String(string(in.CreatedAt))
+	}
+	{
+		const prefix string = ",\"replacedAt\":"
+		out.RawString(prefix)
+		out.String(string(in.ReplacedAt))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReviewRevision) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReviewRevision) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReviewRevision) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(&r, v)
+	return r.Error()
 }
-func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(in *jlexer.Lexer, out *ReviewResponseList) {
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReviewRevision) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(l, v)
+}
+func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(in *jlexer.Lexer, out *ReviewResponseList) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -366,9 +593,9 @@ func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 					out.Reviews = (out.Reviews)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v4 ReviewResponse
-					(v4).UnmarshalEasyJSON(in)
-					out.Reviews = append(out.Reviews, v4)
+					var v7 ReviewResponse
+					(v7).UnmarshalEasyJSON(in)
+					out.Reviews = append(out.Reviews, v7)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -391,7 +618,7 @@ func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 		in.Consumed()
 	}
 }
-func easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(out *jwriter.Writer, in ReviewResponseList) {
+func easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(out *jwriter.Writer, in ReviewResponseList) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -402,11 +629,11 @@ func easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v5, v6 := range in.Reviews {
-				if v5 > 0 {
+			for v8, v9 := range in.Reviews {
+				if v8 > 0 {
 					out.RawByte(',')
 				}
-				(v6).MarshalEasyJSON(out)
+				(v9).MarshalEasyJSON(out)
 			}
 			out.RawByte(']')
 		}
@@ -437,27 +664,27 @@ func easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 // MarshalJSON supports json.Marshaler interface
 func (v ReviewResponseList) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(&w, v)
+	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ReviewResponseList) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(w, v)
+	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ReviewResponseList) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(&r, v)
+	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ReviewResponseList) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(l, v)
+	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(l, v)
 }
-func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(in *jlexer.Lexer, out *ReviewResponse) {
+func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(in *jlexer.Lexer, out *ReviewResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -500,6 +727,37 @@ func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 			out.Likes = int(in.Int())
 		case "dislikes":
 			out.Dislikes = int(in.Int())
+		case "comments":
+			out.Comments = int(in.Int())
+		case "hasSpoilers":
+			out.HasSpoilers = bool(in.Bool())
+		case "editCount":
+			out.EditCount = int(in.Int())
+		case "editedAt":
+			out.EditedAt = string(in.String())
+		case "spoilers":
+			if in.IsNull() {
+				in.Skip()
+				out.Spoilers = nil
+			} else {
+				in.Delim('[')
+				if out.Spoilers == nil {
+					if !in.IsDelim(']') {
+						out.Spoilers = make([]SpoilerRange, 0, 4)
+					} else {
+						out.Spoilers = []SpoilerRange{}
+					}
+				} else {
+					out.Spoilers = (out.Spoilers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v10 SpoilerRange
+					(v10).UnmarshalEasyJSON(in)
+					out.Spoilers = append(out.Spoilers, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -510,7 +768,7 @@ func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 		in.Consumed()
 	}
 }
-func easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(out *jwriter.Writer, in ReviewResponse) {
+func easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(out *jwriter.Writer, in ReviewResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -574,33 +832,69 @@ func easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 		out.RawString(prefix)
 		out.Int(int(in.Dislikes))
 	}
+	{
+		const prefix string = ",\"comments\":"
+		out.RawString(prefix)
+		out.Int(int(in.Comments))
+	}
+	{
+		const prefix string = ",\"hasSpoilers\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HasSpoilers))
+	}
+	{
+		const prefix string = ",\"editCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.EditCount))
+	}
+	{
+		const prefix string = ",\"editedAt\":"
+		out.RawString(prefix)
+		out.String(string(in.EditedAt))
+	}
+	{
+		const prefix string = ",\"spoilers\":"
+		out.RawString(prefix)
+		if in.Spoilers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v11, v12 := range in.Spoilers {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				(v12).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v ReviewResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(&w, v)
+	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ReviewResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(w, v)
+	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ReviewResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(&r, v)
+	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ReviewResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto4(l, v)
+	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(l, v)
 }
-func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(in *jlexer.Lexer, out *ReviewCreateRequest) {
+func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(in *jlexer.Lexer, out *ReviewHistory) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -619,14 +913,208 @@ func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 			continue
 		}
 		switch key {
-		case "contentID":
-			out.ContentID = int(in.Int())
-		case "rating":
-			out.Rating = int(in.Int())
+		case "review":
+			(out.Review).UnmarshalEasyJSON(in)
+		case "revisions":
+			if in.IsNull() {
+				in.Skip()
+				out.Revisions = nil
+			} else {
+				in.Delim('[')
+				if out.Revisions == nil {
+					if !in.IsDelim(']') {
+						out.Revisions = make([]ReviewRevision, 0, 0)
+					} else {
+						out.Revisions = []ReviewRevision{}
+					}
+				} else {
+					out.Revisions = (out.Revisions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 ReviewRevision
+					(v13).UnmarshalEasyJSON(in)
+					out.Revisions = append(out.Revisions, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(out *jwriter.Writer, in ReviewHistory) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"review\":"
+		out.RawString(prefix[1:])
+		(in.Review).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"revisions\":"
+		out.RawString(prefix)
+		if in.Revisions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v14, v15 := range in.Revisions {
+				if v14 > 0 {
+					out.RawByte(',')
+				}
+				(v15).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReviewHistory) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReviewHistory) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReviewHistory) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReviewHistory) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(l, v)
+}
+func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto8(in *jlexer.Lexer, out *ReviewFilter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "hideSpoilers":
+			out.HideSpoilers = bool(in.Bool())
+		case "sort":
+			out.Sort = string(in.String())
+		case "sentiment":
+			out.Sentiment = string(in.String())
+		case "minTextLength":
+			out.MinTextLength = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto8(out *jwriter.Writer, in ReviewFilter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"hideSpoilers\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.HideSpoilers))
+	}
+	{
+		const prefix string = ",\"sort\":"
+		out.RawString(prefix)
+		out.String(string(in.Sort))
+	}
+	{
+		const prefix string = ",\"sentiment\":"
+		out.RawString(prefix)
+		out.String(string(in.Sentiment))
+	}
+	{
+		const prefix string = ",\"minTextLength\":"
+		out.RawString(prefix)
+		out.Int(int(in.MinTextLength))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReviewFilter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto8(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReviewFilter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto8(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReviewFilter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto8(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReviewFilter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto8(l, v)
+}
+func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto9(in *jlexer.Lexer, out *ReviewCreateRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "contentID":
+			out.ContentID = int(in.Int())
+		case "rating":
+			out.Rating = int(in.Int())
 		case "title":
 			out.Title = string(in.String())
 		case "text":
 			out.Text = string(in.String())
+		case "hasSpoilers":
+			out.HasSpoilers = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -637,7 +1125,7 @@ func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 		in.Consumed()
 	}
 }
-func easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(out *jwriter.Writer, in ReviewCreateRequest) {
+func easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto9(out *jwriter.Writer, in ReviewCreateRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -661,33 +1149,38 @@ func easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 		out.RawString(prefix)
 		out.String(string(in.Text))
 	}
+	{
+		const prefix string = ",\"hasSpoilers\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HasSpoilers))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v ReviewCreateRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(&w, v)
+	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ReviewCreateRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(w, v)
+	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ReviewCreateRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(&r, v)
+	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ReviewCreateRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto5(l, v)
+	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto9(l, v)
 }
-func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(in *jlexer.Lexer, out *ReviewCreate) {
+func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto10(in *jlexer.Lexer, out *ReviewCreate) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -716,6 +1209,8 @@ func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 			out.Title = string(in.String())
 		case "text":
 			out.Text = string(in.String())
+		case "hasSpoilers":
+			out.HasSpoilers = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -726,7 +1221,7 @@ func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 		in.Consumed()
 	}
 }
-func easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(out *jwriter.Writer, in ReviewCreate) {
+func easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto10(out *jwriter.Writer, in ReviewCreate) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -755,33 +1250,38 @@ func easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 		out.RawString(prefix)
 		out.String(string(in.Text))
 	}
+	{
+		const prefix string = ",\"hasSpoilers\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HasSpoilers))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v ReviewCreate) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(&w, v)
+	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto10(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ReviewCreate) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(w, v)
+	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto10(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ReviewCreate) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(&r, v)
+	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto10(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ReviewCreate) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto6(l, v)
+	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto10(l, v)
 }
-func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(in *jlexer.Lexer, out *Review) {
+func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto11(in *jlexer.Lexer, out *Review) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -818,6 +1318,37 @@ func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 			out.Likes = int(in.Int())
 		case "dislikes":
 			out.Dislikes = int(in.Int())
+		case "comments":
+			out.Comments = int(in.Int())
+		case "hasSpoilers":
+			out.HasSpoilers = bool(in.Bool())
+		case "editCount":
+			out.EditCount = int(in.Int())
+		case "editedAt":
+			out.EditedAt = string(in.String())
+		case "spoilers":
+			if in.IsNull() {
+				in.Skip()
+				out.Spoilers = nil
+			} else {
+				in.Delim('[')
+				if out.Spoilers == nil {
+					if !in.IsDelim(']') {
+						out.Spoilers = make([]SpoilerRange, 0, 4)
+					} else {
+						out.Spoilers = []SpoilerRange{}
+					}
+				} else {
+					out.Spoilers = (out.Spoilers)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v16 SpoilerRange
+					(v16).UnmarshalEasyJSON(in)
+					out.Spoilers = append(out.Spoilers, v16)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -828,7 +1359,7 @@ func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 		in.Consumed()
 	}
 }
-func easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(out *jwriter.Writer, in Review) {
+func easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto11(out *jwriter.Writer, in Review) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -877,29 +1408,271 @@ func easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntity
 		out.RawString(prefix)
 		out.Int(int(in.Dislikes))
 	}
+	{
+		const prefix string = ",\"comments\":"
+		out.RawString(prefix)
+		out.Int(int(in.Comments))
+	}
+	{
+		const prefix string = ",\"hasSpoilers\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HasSpoilers))
+	}
+	{
+		const prefix string = ",\"editCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.EditCount))
+	}
+	{
+		const prefix string = ",\"editedAt\":"
+		out.RawString(prefix)
+		out.String(string(in.EditedAt))
+	}
+	{
+		const prefix string = ",\"spoilers\":"
+		out.RawString(prefix)
+		if in.Spoilers == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v17, v18 := range in.Spoilers {
+				if v17 > 0 {
+					out.RawByte(',')
+				}
+				(v18).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v Review) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(&w, v)
+	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto11(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Review) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(w, v)
+	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto11(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Review) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(&r, v)
+	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto11(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Review) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto7(l, v)
+	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto11(l, v)
+}
+func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto12(in *jlexer.Lexer, out *RatingHistogramBar) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "rating":
+			out.Rating = int(in.Int())
+		case "count":
+			out.Count = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto12(out *jwriter.Writer, in RatingHistogramBar) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Rating))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int(int(in.Count))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RatingHistogramBar) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto12(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RatingHistogramBar) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto12(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RatingHistogramBar) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto12(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RatingHistogramBar) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto12(l, v)
+}
+func easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto13(in *jlexer.Lexer, out *ContentRatingStats) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "contentID":
+			out.ContentID = int(in.Int())
+		case "ratingCount":
+			out.RatingCount = int(in.Int())
+		case "average":
+			out.Average = float64(in.Float64())
+		case "median":
+			out.Median = float64(in.Float64())
+		case "weightedRating":
+			out.WeightedRating = float64(in.Float64())
+		case "histogram":
+			if in.IsNull() {
+				in.Skip()
+				out.Histogram = nil
+			} else {
+				in.Delim('[')
+				if out.Histogram == nil {
+					if !in.IsDelim(']') {
+						out.Histogram = make([]RatingHistogramBar, 0, 4)
+					} else {
+						out.Histogram = []RatingHistogramBar{}
+					}
+				} else {
+					out.Histogram = (out.Histogram)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v19 RatingHistogramBar
+					(v19).UnmarshalEasyJSON(in)
+					out.Histogram = append(out.Histogram, v19)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto13(out *jwriter.Writer, in ContentRatingStats) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"contentID\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ContentID))
+	}
+	{
+		const prefix string = ",\"ratingCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.RatingCount))
+	}
+	{
+		const prefix string = ",\"average\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Average))
+	}
+	{
+		const prefix string = ",\"median\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Median))
+	}
+	{
+		const prefix string = ",\"weightedRating\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.WeightedRating))
+	}
+	{
+		const prefix string = ",\"histogram\":"
+		out.RawString(prefix)
+		if in.Histogram == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v20, v21 := range in.Histogram {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				(v21).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ContentRatingStats) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto13(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ContentRatingStats) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson2f096870EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto13(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ContentRatingStats) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto13(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ContentRatingStats) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson2f096870DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto13(l, v)
 }
@@ -0,0 +1,59 @@
+package dto
+
+type ReviewReportRequest struct {
+	Reason  string `json:"reason"  example:"spam"    format:"string" description:"spam/insult/spoiler/offtopic/other"`
+	Comment string `json:"comment" example:"реклама" format:"string"`
+}
+
+type ReviewReportCreate struct {
+	ReviewReportRequest
+	ReviewID int `json:"reviewID" example:"1" format:"int"`
+	UserID   int `json:"userID"   example:"1" format:"int"`
+}
+
+// ModerationQueueItem - рецензия в очереди модерации вместе с нерассмотренными жалобами на нее
+type ModerationQueueItem struct {
+	Review         ReviewResponse `json:"review"`
+	Hidden         bool           `json:"hidden"         example:"false"                format:"bool"`
+	Reports        int            `json:"reports"        example:"3"                    format:"int"`
+	Reasons        []string       `json:"reasons"        example:"spam,insult"`
+	LastReportedAt string         `json:"lastReportedAt" example:"2022-01-02T15:04:05Z" format:"string"`
+	// Original первоначальная версия рецензии, если автор редактировал ее
+	Original *ReviewRevision `json:"original,omitempty"`
+}
+
+type ModerationQueue struct {
+	Reviews []ModerationQueueItem `json:"reviews"`
+	Page    int                   `json:"page"    example:"1"  format:"int"`
+	Count   int                   `json:"count"   example:"10" format:"int"`
+	Pages   int                   `json:"pages"   example:"1"  format:"int"`
+	Total   int                   `json:"total"   example:"1"  format:"int"`
+}
+
+type ModerationDecisionRequest struct {
+	Action string `json:"action" example:"hide"        format:"string" description:"approve/hide/delete"`
+	Note   string `json:"note"   example:"оскорбления" format:"string"`
+}
+
+type ModerationDecisionCreate struct {
+	ModerationDecisionRequest
+	ReviewID    int `json:"reviewID"    example:"1" format:"int"`
+	ModeratorID int `json:"moderatorID" example:"1" format:"int"`
+}
+
+type ModerationLogEntry struct {
+	ID          int    `json:"id"          example:"1"                    format:"int"`
+	ReviewID    int    `json:"reviewID"    example:"1"                    format:"int"`
+	ModeratorID int    `json:"moderatorID" example:"1"                    format:"int"`
+	Action      string `json:"action"      example:"hide"                 format:"string"`
+	Note        string `json:"note"        example:"оскорбления в тексте" format:"string"`
+	CreatedAt   string `json:"createdAt"   example:"2022-01-02T15:04:05Z" format:"string"`
+}
+
+type ModerationLog struct {
+	Entries []ModerationLogEntry `json:"entries"`
+	Page    int                  `json:"page"    example:"1"  format:"int"`
+	Count   int                  `json:"count"   example:"10" format:"int"`
+	Pages   int                  `json:"pages"   example:"1"  format:"int"`
+	Total   int                  `json:"total"   example:"1"  format:"int"`
+}
@@ -0,0 +1,48 @@
+package dto
+
+// UserListRequest - данные для создания или изменения списка
+type UserListRequest struct {
+	Title       string `json:"title"                 example:"Лучшие фильмы 2023"         format:"string"`
+	Description string `json:"description,omitempty" example:"То, что стоит пересмотреть" format:"string"`
+	IsPublic    bool   `json:"isPublic"              example:"true"`
+}
+
+// UserListItemRequest - данные элемента списка. При изменении элемента contentID не учитывается
+type UserListItemRequest struct {
+	ContentID int    `json:"contentID"      example:"1"                   format:"int"`
+	Note      string `json:"note,omitempty" example:"Смотреть с друзьями" format:"string"`
+}
+
+// UserListOrderRequest - новый порядок элементов списка, должен содержать каждый элемент ровно один раз
+type UserListOrderRequest struct {
+	ContentIDs []int `json:"contentIDs" example:"3,1,2"`
+}
+
+type UserList struct {
+	ID          int    `json:"id"                    example:"1"                          format:"int"`
+	UserID      int    `json:"userID"                example:"1"                          format:"int"`
+	Title       string `json:"title"                 example:"Лучшие фильмы 2023"         format:"string"`
+	Description string `json:"description,omitempty" example:"То, что стоит пересмотреть" format:"string"`
+	IsPublic    bool   `json:"isPublic"              example:"true"`
+	ItemsCount  int    `json:"itemsCount"            example:"10"                         format:"int"`
+	CreatedAt   string `json:"createdAt"             example:"2022-01-02T15:04:05Z"       format:"string"`
+	UpdatedAt   string `json:"updatedAt"             example:"2022-01-02T15:04:05Z"       format:"string"`
+}
+
+type UserListItem struct {
+	Position int            `json:"position"       example:"1"                    format:"int"`
+	Note     string         `json:"note,omitempty" example:"Смотреть с друзьями"  format:"string"`
+	AddedAt  string         `json:"addedAt"        example:"2022-01-02T15:04:05Z" format:"string"`
+	Content  PreviewContent `json:"content"`
+}
+
+// UserListWithItems - список вместе с элементами по порядку
+type UserListWithItems struct {
+	UserList
+	Items []UserListItem `json:"items"`
+}
+
+// UserLists - списки пользователя, начиная с последних измененных
+type UserLists struct {
+	Lists []UserList `json:"lists"`
+}
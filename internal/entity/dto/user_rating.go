@@ -0,0 +1,40 @@
+package dto
+
+type UserRating struct {
+	ContentID int    `json:"contentID" example:"1"                    format:"int"`
+	Rating    int    `json:"rating"    example:"8"                    format:"int"`
+	CreatedAt string `json:"createdAt" example:"2022-01-02T15:04:05Z" format:"string"`
+	UpdatedAt string `json:"updatedAt" example:"2022-01-02T15:04:05Z" format:"string"`
+}
+
+// RatedContent - оценка пользователя вместе с превью контента
+type RatedContent struct {
+	UserRating
+	Content PreviewContent `json:"content"`
+}
+
+type UserRatingList struct {
+	Ratings []RatedContent `json:"ratings"`
+	Page    int            `json:"page"    example:"1"  format:"int"`
+	Count   int            `json:"count"   example:"10" format:"int"`
+	Pages   int            `json:"pages"   example:"1"  format:"int"`
+	Total   int            `json:"total"   example:"1"  format:"int"`
+}
+
+type UserRatingRequest struct {
+	Rating int `json:"rating" example:"8" format:"int"`
+}
+
+type UserRatingSet struct {
+	UserRatingRequest
+	ContentID int `json:"contentID" example:"1" format:"int"`
+	UserID    int `json:"userID"    example:"1" format:"int"`
+}
+
+// SeriesPartRatingSet - оценка сезона или эпизода. Part - season или episode
+type SeriesPartRatingSet struct {
+	UserRatingRequest
+	Part   string `json:"part"   example:"episode" format:"string"`
+	PartID int    `json:"partID" example:"1"       format:"int"`
+	UserID int    `json:"userID" example:"1"       format:"int"`
+}
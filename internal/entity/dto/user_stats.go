@@ -0,0 +1,47 @@
+package dto
+
+type CountryCount struct {
+	ID    int    `json:"id"    example:"1"   format:"int"`
+	Name  string `json:"name"  example:"США" format:"string"`
+	Count int    `json:"count" example:"12"  format:"int"`
+}
+
+type PersonCount struct {
+	ID     int    `json:"id"     example:"1"            format:"int"`
+	Name   string `json:"name"   example:"Майкл Манн"   format:"string"`
+	EnName string `json:"enName" example:"Michael Mann" format:"string"`
+	Count  int    `json:"count"  example:"3"            format:"int"`
+}
+
+// WatchedStats - статистика просмотренного контента. Runtime - время просмотра в минутах
+type WatchedStats struct {
+	Categories map[string]int `json:"categories"`
+	Runtime    int            `json:"runtime"    example:"5400" format:"int"`
+	Genres     []GenreCount   `json:"genres"`
+	Countries  []CountryCount `json:"countries"`
+	Directors  []PersonCount  `json:"directors"`
+	Actors     []PersonCount  `json:"actors"`
+}
+
+// RatingStats - средняя оценка пользователя и средний рейтинг сайта для того же контента
+type RatingStats struct {
+	Count       int     `json:"count"       example:"42"   format:"int"`
+	Average     float64 `json:"average"     example:"7.45" format:"float"`
+	SiteAverage float64 `json:"siteAverage" example:"6.9"  format:"float"`
+}
+
+// MonthActivity - активность пользователя за месяц. Счетчики скрытых разделов не возвращаются
+type MonthActivity struct {
+	Month      string `json:"month"                example:"2024-06" format:"string"`
+	Favourites *int   `json:"favourites,omitempty" example:"5"       format:"int"`
+	Ratings    *int   `json:"ratings,omitempty"    example:"3"       format:"int"`
+	Reviews    *int   `json:"reviews,omitempty"    example:"1"       format:"int"`
+	Episodes   *int   `json:"episodes,omitempty"   example:"12"      format:"int"`
+}
+
+// UserStats - статистика пользователя. Разделы, скрытые настройками приватности, не возвращаются
+type UserStats struct {
+	Watched  *WatchedStats   `json:"watched,omitempty"`
+	Ratings  *RatingStats    `json:"ratings,omitempty"`
+	Activity []MonthActivity `json:"activity"`
+}
@@ -0,0 +1,29 @@
+package dto
+
+// WatchProgressRequest - отметка о просмотре. Указывается ровно одно из полей episodeID, seasonID, upToEpisodeID:
+// отдельный эпизод, весь сезон или все эпизоды сериала до указанного включительно
+type WatchProgressRequest struct {
+	Watched       bool `json:"watched"                 example:"true"`
+	EpisodeID     int  `json:"episodeID,omitempty"     example:"1"    format:"int"`
+	SeasonID      int  `json:"seasonID,omitempty"      example:"1"    format:"int"`
+	UpToEpisodeID int  `json:"upToEpisodeID,omitempty" example:"1"    format:"int"`
+}
+
+type EpisodeProgress struct {
+	Episode
+	Watched bool `json:"watched" example:"true"`
+}
+
+type SeasonProgress struct {
+	ID       int               `json:"id"       example:"1"       format:"int"`
+	Title    string            `json:"title"    example:"Сезон 1" format:"string"`
+	Episodes []EpisodeProgress `json:"episodes"`
+}
+
+type WatchProgress struct {
+	ContentID int              `json:"contentID" example:"1"     format:"int"`
+	Watched   int              `json:"watched"   example:"5"     format:"int"`
+	Total     int              `json:"total"     example:"10"    format:"int"`
+	Completed bool             `json:"completed" example:"false"`
+	Seasons   []SeasonProgress `json:"seasons"`
+}
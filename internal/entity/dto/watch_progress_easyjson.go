@@ -0,0 +1,452 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package dto
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson85290385DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(in *jlexer.Lexer, out *WatchProgressRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "watched":
+			out.Watched = bool(in.Bool())
+		case "episodeID":
+			out.EpisodeID = int(in.Int())
+		case "seasonID":
+			out.SeasonID = int(in.Int())
+		case "upToEpisodeID":
+			out.UpToEpisodeID = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson85290385EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(out *jwriter.Writer, in WatchProgressRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"watched\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Watched))
+	}
+	if in.EpisodeID != 0 {
+		const prefix string = ",\"episodeID\":"
+		out.RawString(prefix)
+		out.Int(int(in.EpisodeID))
+	}
+	if in.SeasonID != 0 {
+		const prefix string = ",\"seasonID\":"
+		out.RawString(prefix)
+		out.Int(int(in.SeasonID))
+	}
+	if in.UpToEpisodeID != 0 {
+		const prefix string = ",\"upToEpisodeID\":"
+		out.RawString(prefix)
+		out.Int(int(in.UpToEpisodeID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WatchProgressRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson85290385EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WatchProgressRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson85290385EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WatchProgressRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson85290385DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WatchProgressRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson85290385DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto(l, v)
+}
+func easyjson85290385DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(in *jlexer.Lexer, out *WatchProgress) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "contentID":
+			out.ContentID = int(in.Int())
+		case "watched":
+			out.Watched = int(in.Int())
+		case "total":
+			out.Total = int(in.Int())
+		case "completed":
+			out.Completed = bool(in.Bool())
+		case "seasons":
+			if in.IsNull() {
+				in.Skip()
+				out.Seasons = nil
+			} else {
+				in.Delim('[')
+				if out.Seasons == nil {
+					if !in.IsDelim(']') {
+						out.Seasons = make([]SeasonProgress, 0, 1)
+					} else {
+						out.Seasons = []SeasonProgress{}
+					}
+				} else {
+					out.Seasons = (out.Seasons)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 SeasonProgress
+					(v1).UnmarshalEasyJSON(in)
+					out.Seasons = append(out.Seasons, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson85290385EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(out *jwriter.Writer, in WatchProgress) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"contentID\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ContentID))
+	}
+	{
+		const prefix string = ",\"watched\":"
+		out.RawString(prefix)
+		out.Int(int(in.Watched))
+	}
+	{
+		const prefix string = ",\"total\":"
+		out.RawString(prefix)
+		out.Int(int(in.Total))
+	}
+	{
+		const prefix string = ",\"completed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Completed))
+	}
+	{
+		const prefix string = ",\"seasons\":"
+		out.RawString(prefix)
+		if in.Seasons == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Seasons {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				(v3).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WatchProgress) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson85290385EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WatchProgress) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson85290385EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WatchProgress) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson85290385DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WatchProgress) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson85290385DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto1(l, v)
+}
+func easyjson85290385DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(in *jlexer.Lexer, out *SeasonProgress) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int(in.Int())
+		case "title":
+			out.Title = string(in.String())
+		case "episodes":
+			if in.IsNull() {
+				in.Skip()
+				out.Episodes = nil
+			} else {
+				in.Delim('[')
+				if out.Episodes == nil {
+					if !in.IsDelim(']') {
+						out.Episodes = make([]EpisodeProgress, 0, 1)
+					} else {
+						out.Episodes = []EpisodeProgress{}
+					}
+				} else {
+					out.Episodes = (out.Episodes)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 EpisodeProgress
+					(v4).UnmarshalEasyJSON(in)
+					out.Episodes = append(out.Episodes, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson85290385EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(out *jwriter.Writer, in SeasonProgress) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"episodes\":"
+		out.RawString(prefix)
+		if in.Episodes == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.Episodes {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				(v6).MarshalEasyJSON(out)
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SeasonProgress) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson85290385EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SeasonProgress) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson85290385EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SeasonProgress) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson85290385DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SeasonProgress) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson85290385DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto2(l, v)
+}
+func easyjson85290385DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(in *jlexer.Lexer, out *EpisodeProgress) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "watched":
+			out.Watched = bool(in.Bool())
+		case "id":
+			out.ID = int(in.Int())
+		case "episodeNumber":
+			out.EpisodeNumber = int(in.Int())
+		case "title":
+			out.Title = string(in.String())
+		case "duration":
+			out.Duration = int(in.Int())
+		case "rating":
+			out.Rating = float64(in.Float64())
+		case "ratingCount":
+			out.RatingCount = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson85290385EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(out *jwriter.Writer, in EpisodeProgress) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"watched\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.Watched))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int(int(in.ID))
+	}
+	{
+		const prefix string = ",\"episodeNumber\":"
+		out.RawString(prefix)
+		out.Int(int(in.EpisodeNumber))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"duration\":"
+		out.RawString(prefix)
+		out.Int(int(in.Duration))
+	}
+	{
+		const prefix string = ",\"rating\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.Rating))
+	}
+	{
+		const prefix string = ",\"ratingCount\":"
+		out.RawString(prefix)
+		out.Int(int(in.RatingCount))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v EpisodeProgress) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson85290385EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v EpisodeProgress) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson85290385EncodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *EpisodeProgress) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson85290385DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *EpisodeProgress) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson85290385DecodeGithubComGoParkMailRu20241CyberkotletkiInternalEntityDto3(l, v)
+}
@@ -0,0 +1,116 @@
+package entity
+
+import (
+	"database/sql"
+	"errors"
+	"strconv"
+)
+
+// Форматы выгрузки истории пользователя
+const (
+	ExportFormatCSV        = "csv"
+	ExportFormatJSON       = "json"
+	ExportFormatLetterboxd = "letterboxd"
+)
+
+// ExportItem контент, который пользователь добавил в избранное, оценил или о котором написал рецензию.
+// Незаполненные части имеют нулевые значения: пустую категорию, нулевую оценку, пустую рецензию
+type ExportItem struct {
+	ContentID     int          `db:"content_id"`
+	KinopoiskID   int          `db:"kinopoisk_id"`
+	Title         string       `db:"title"`
+	OriginalTitle string       `db:"original_title"`
+	Year          int          `db:"year"`
+	Category      string       `db:"category"`
+	AddedAt       sql.NullTime `db:"added_at"`
+	Rating        int          `db:"rating"`
+	RatedAt       sql.NullTime `db:"rated_at"`
+	ReviewTitle   string       `db:"review_title"`
+	ReviewText    string       `db:"review_text"`
+	ReviewRating  int          `db:"review_rating"`
+	ReviewedAt    sql.NullTime `db:"reviewed_at"`
+}
+
+// ValidateExportFormat проверяет, что формат выгрузки поддерживается
+func ValidateExportFormat(format string) error {
+	switch format {
+	case ExportFormatCSV, ExportFormatJSON, ExportFormatLetterboxd:
+		return nil
+	default:
+		return errors.New("формат выгрузки должен быть csv, json или letterboxd")
+	}
+}
+
+// ExportCSVHeader заголовок CSV-выгрузки, порядок столбцов совпадает с CSVRecord
+var ExportCSVHeader = []string{
+	"content_id", "kinopoisk_id", "title", "original_title", "year", "category", "added_at",
+	"rating", "rated_at", "review_title", "review_text", "review_rating", "reviewed_at",
+}
+
+// LetterboxdCSVHeader заголовок выгрузки в формате импорта Letterboxd
+var LetterboxdCSVHeader = []string{"Title", "Year", "Rating10", "WatchedDate", "Review"}
+
+// exportInt возвращает пустую строку для нулевых значений, чтобы незаполненные поля оставались пустыми
+func exportInt(value int) string {
+	if value == 0 {
+		return ""
+	}
+	return strconv.Itoa(value)
+}
+
+func exportTime(value sql.NullTime, layout string) string {
+	if !value.Valid {
+		return ""
+	}
+	return value.Time.Format(layout)
+}
+
+// CSVRecord строка CSV-выгрузки
+func (e *ExportItem) CSVRecord() []string {
+	const layout = "2006-01-02 15:04:05 -0700"
+	return []string{
+		strconv.Itoa(e.ContentID),
+		exportInt(e.KinopoiskID),
+		e.Title,
+		e.OriginalTitle,
+		exportInt(e.Year),
+		e.Category,
+		exportTime(e.AddedAt, layout),
+		exportInt(e.Rating),
+		exportTime(e.RatedAt, layout),
+		e.ReviewTitle,
+		e.ReviewText,
+		exportInt(e.ReviewRating),
+		exportTime(e.ReviewedAt, layout),
+	}
+}
+
+// Watched возвращает, посмотрел ли пользователь контент. Letterboxd считает просмотренным все, что
+// импортируется, поэтому запланированный и не оцененный контент в его выгрузку не попадает
+func (e *ExportItem) Watched() bool {
+	return e.Rating != 0 || e.ReviewTitle != "" || (e.Category != "" && e.Category != FavouriteCategoryPlanned)
+}
+
+// LetterboxdRecord строка выгрузки в формате Letterboxd. Если пользователь не ставил оценку отдельно,
+// берется оценка из рецензии. Датой просмотра считается дата добавления в избранное
+func (e *ExportItem) LetterboxdRecord() []string {
+	title := e.OriginalTitle
+	if title == "" {
+		title = e.Title
+	}
+	rating := e.Rating
+	if rating == 0 {
+		rating = e.ReviewRating
+	}
+	review := e.ReviewText
+	if e.ReviewTitle != "" {
+		review = e.ReviewTitle + "\n\n" + e.ReviewText
+	}
+	return []string{
+		title,
+		exportInt(e.Year),
+		exportInt(rating),
+		exportTime(e.AddedAt, "2006-01-02"),
+		review,
+	}
+}
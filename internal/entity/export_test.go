@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestExportItem_Watched(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, (&ExportItem{Category: FavouriteCategoryAbandoned}).Watched())
+	require.True(t, (&ExportItem{Category: FavouriteCategoryPlanned, Rating: 7}).Watched())
+	require.True(t, (&ExportItem{ReviewTitle: "Рецензия"}).Watched())
+	require.False(t, (&ExportItem{Category: FavouriteCategoryPlanned}).Watched())
+}
+
+func TestExportItem_LetterboxdRecord(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t,
+		[]string{"Схватка", "", "8", "", "Рецензия\n\nТекст"},
+		(&ExportItem{Title: "Схватка", ReviewTitle: "Рецензия", ReviewText: "Текст", ReviewRating: 8}).LetterboxdRecord(),
+	)
+	require.Equal(t,
+		[]string{"Heat", "1995", "9", "", ""},
+		(&ExportItem{Title: "Схватка", OriginalTitle: "Heat", Year: 1995, Rating: 9, ReviewRating: 8}).LetterboxdRecord(),
+	)
+}
+
+func TestValidateExportFormat(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ValidateExportFormat(ExportFormatLetterboxd))
+	require.Error(t, ValidateExportFormat("xml"))
+}
@@ -1,7 +1,29 @@
 package entity
 
+import "errors"
+
 type Favourite struct {
 	UserID    int
 	ContentID int
 	Category  string
 }
+
+const (
+	FavouriteCategoryFavourite  = "favourite"
+	FavouriteCategoryWatching   = "watching"
+	FavouriteCategoryWatched    = "watched"
+	FavouriteCategoryPlanned    = "planned"
+	FavouriteCategoryRewatching = "rewatching"
+	FavouriteCategoryAbandoned  = "abandoned"
+)
+
+// ValidateFavouriteCategory проверяет, что категория избранного существует
+func ValidateFavouriteCategory(category string) error {
+	switch category {
+	case FavouriteCategoryFavourite, FavouriteCategoryWatching, FavouriteCategoryWatched,
+		FavouriteCategoryPlanned, FavouriteCategoryRewatching, FavouriteCategoryAbandoned:
+		return nil
+	default:
+		return errors.New("неизвестная категория избранного")
+	}
+}
@@ -0,0 +1,212 @@
+package entity
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Сервисы, выгрузки которых можно импортировать
+const (
+	ImportSourceLetterboxd = "letterboxd"
+	ImportSourceIMDb       = "imdb"
+	ImportSourceKinopoisk  = "kinopoisk"
+)
+
+// Состояния задачи импорта
+const (
+	ImportStatusPending = "pending"
+	ImportStatusRunning = "running"
+	ImportStatusDone    = "done"
+	ImportStatusFailed  = "failed"
+)
+
+// ImportMaxRows максимальное количество строк в одном файле импорта
+const ImportMaxRows = 10000
+
+// ImportJob задача импорта истории просмотров. Category - категория избранного, в которую попадает
+// импортированный контент
+type ImportJob struct {
+	ID          int       `db:"id"`
+	UserID      int       `db:"user_id"`
+	Source      string    `db:"source"`
+	Category    string    `db:"category"`
+	Status      string    `db:"status"`
+	TotalRows   int       `db:"total_rows"`
+	MatchedRows int       `db:"matched_rows"`
+	Error       string    `db:"error"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
+
+// ImportRow строка файла импорта. Rating - оценка от 1 до 10 или 0, если оценки нет. Несопоставленные строки
+// сохраняются, чтобы пользователь мог указать контент вручную, тогда в ContentID записывается выбранный контент
+type ImportRow struct {
+	JobID         int    `db:"job_id"`
+	RowNumber     int    `db:"row_number"`
+	Title         string `db:"title"`
+	OriginalTitle string `db:"original_title"`
+	Year          int    `db:"year"`
+	KinopoiskID   int    `db:"kinopoisk_id"`
+	Rating        int    `db:"rating"`
+	ContentID     int    `db:"content_id"`
+}
+
+// Столбцы файла импорта, которые удается распознать
+const (
+	importColumnTitle         = "title"
+	importColumnOriginalTitle = "original_title"
+	importColumnYear          = "year"
+	importColumnRating        = "rating"
+	importColumnKinopoiskID   = "kinopoisk_id"
+)
+
+// importColumns - названия столбцов в выгрузках сервисов в нижнем регистре. Kinopoisk не выгружает историю сам,
+// поэтому поддерживаются распространенные названия столбцов сторонних выгрузок
+var importColumns = map[string]map[string][]string{
+	ImportSourceLetterboxd: {
+		importColumnTitle:  {"name"},
+		importColumnYear:   {"year"},
+		importColumnRating: {"rating"},
+	},
+	ImportSourceIMDb: {
+		importColumnTitle:         {"title"},
+		importColumnOriginalTitle: {"original title"},
+		importColumnYear:          {"year"},
+		importColumnRating:        {"your rating"},
+	},
+	ImportSourceKinopoisk: {
+		importColumnKinopoiskID:   {"kinopoisk_id", "kinopoisk id", "id кинопоиска", "id"},
+		importColumnTitle:         {"title", "название", "русское название"},
+		importColumnOriginalTitle: {"original_title", "original title", "оригинальное название"},
+		importColumnYear:          {"year", "год"},
+		importColumnRating:        {"rating", "my rating", "моя оценка", "оценка"},
+	},
+}
+
+// ValidateImportSource проверяет, что выгрузку сервиса можно импортировать
+func ValidateImportSource(source string) error {
+	if _, ok := importColumns[source]; !ok {
+		return errors.New("источник должен быть letterboxd, imdb или kinopoisk")
+	}
+	return nil
+}
+
+// importRating переводит оценку из файла в шкалу от 1 до 10. Letterboxd ставит от 0.5 до 5 звезд.
+// Для пустой или некорректной оценки возвращает 0
+func importRating(source, value string) int {
+	rating, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return 0
+	}
+	if source == ImportSourceLetterboxd {
+		rating *= 2
+	}
+	if ValidateReviewRating(int(math.Round(rating))) != nil {
+		return 0
+	}
+	return int(math.Round(rating))
+}
+
+// importDelimiter определяет разделитель по строке заголовков: кроме запятой встречается точка с запятой
+func importDelimiter(data []byte) rune {
+	header, _, _ := bytes.Cut(data, []byte("\n"))
+	if bytes.Count(header, []byte(";")) > bytes.Count(header, []byte(",")) {
+		return ';'
+	}
+	return ','
+}
+
+// importColumnIndexes находит в строке заголовков номера известных столбцов. Если под один столбец подходят
+// несколько заголовков, берется первый
+func importColumnIndexes(header []string, columns map[string][]string) map[string]int {
+	indexes := make(map[string]int, len(columns))
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		for column, aliases := range columns {
+			if _, found := indexes[column]; found {
+				continue
+			}
+			for _, alias := range aliases {
+				if name == alias {
+					indexes[column] = i
+				}
+			}
+		}
+	}
+	return indexes
+}
+
+// ParseImportFile разбирает CSV-выгрузку сервиса source. Столбцы определяются по строке заголовков,
+// обязателен только столбец с названием, а для Kinopoisk вместо него подойдет ID. Номера строк
+// совпадают с номерами строк в файле. Строки без названия пропускаются
+func ParseImportFile(source string, file io.Reader) ([]*ImportRow, error) {
+	columns, ok := importColumns[source]
+	if !ok {
+		return nil, ValidateImportSource(source)
+	}
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, errors.New("не удалось прочитать файл")
+	}
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.Comma = importDelimiter(data)
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.New("файл должен быть в формате CSV со строкой заголовков")
+	}
+	indexes := importColumnIndexes(header, columns)
+	_, hasTitle := indexes[importColumnTitle]
+	_, hasKinopoiskID := indexes[importColumnKinopoiskID]
+	if !hasTitle && !hasKinopoiskID {
+		return nil, errors.New("в файле нет столбца с названием")
+	}
+	value := func(record []string, column string) string {
+		if i, found := indexes[column]; found && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+	rows := make([]*ImportRow, 0)
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		line, _ := reader.FieldPos(0)
+		if err != nil {
+			return nil, fmt.Errorf("строка %d не в формате CSV", line)
+		}
+		row := &ImportRow{
+			RowNumber:     line,
+			Title:         value(record, importColumnTitle),
+			OriginalTitle: value(record, importColumnOriginalTitle),
+			Rating:        importRating(source, value(record, importColumnRating)),
+		}
+		// некорректные год и ID не мешают сопоставить контент по названию
+		// no-lint
+		row.Year, _ = strconv.Atoi(value(record, importColumnYear))
+		// no-lint
+		row.KinopoiskID, _ = strconv.Atoi(value(record, importColumnKinopoiskID))
+		if row.Title == "" && row.OriginalTitle == "" && row.KinopoiskID == 0 {
+			continue
+		}
+		if len(rows) == ImportMaxRows {
+			return nil, fmt.Errorf("в файле должно быть не больше %d строк", ImportMaxRows)
+		}
+		rows = append(rows, row)
+	}
+	if len(rows) == 0 {
+		return nil, errors.New("в файле нет ни одной строки")
+	}
+	return rows, nil
+}
@@ -0,0 +1,97 @@
+package entity
+
+import (
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func TestParseImportFile(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name    string
+		Source  string
+		File    string
+		Rows    []*ImportRow
+		WantErr bool
+	}{
+		{
+			Name:   "Letterboxd",
+			Source: ImportSourceLetterboxd,
+			File: "Date,Name,Year,Letterboxd URI,Rating\n" +
+				"2024-01-01,Heat,1995,https://boxd.it/1,4.5\n" +
+				"2024-01-02,Alien,1979,https://boxd.it/2,\n",
+			Rows: []*ImportRow{
+				{RowNumber: 2, Title: "Heat", Year: 1995, Rating: 9},
+				{RowNumber: 3, Title: "Alien", Year: 1979},
+			},
+		},
+		{
+			Name:   "IMDb с кавычками",
+			Source: ImportSourceIMDb,
+			File: "Const,Your Rating,Date Rated,Title,Original Title,Year\n" +
+				"tt0113277,8,2024-01-01,\"Heat, the movie\",Heat,1995\n",
+			Rows: []*ImportRow{
+				{RowNumber: 2, Title: "Heat, the movie", OriginalTitle: "Heat", Year: 1995, Rating: 8},
+			},
+		},
+		{
+			Name:   "Kinopoisk с точкой с запятой и BOM",
+			Source: ImportSourceKinopoisk,
+			File: "\xef\xbb\xbfID;Название;Год;Моя оценка\n" +
+				"409;Схватка;1995;10\n" +
+				";;;\n" +
+				"0;Чужой;год;11\n",
+			Rows: []*ImportRow{
+				{RowNumber: 2, Title: "Схватка", Year: 1995, KinopoiskID: 409, Rating: 10},
+				{RowNumber: 4, Title: "Чужой"},
+			},
+		},
+		{
+			Name:    "Неизвестный источник",
+			Source:  "netflix",
+			File:    "Title\nHeat\n",
+			WantErr: true,
+		},
+		{
+			Name:    "Нет столбца с названием",
+			Source:  ImportSourceIMDb,
+			File:    "Const,Year\ntt0113277,1995\n",
+			WantErr: true,
+		},
+		{
+			Name:    "Пустой файл",
+			Source:  ImportSourceLetterboxd,
+			File:    "",
+			WantErr: true,
+		},
+		{
+			Name:    "Только заголовок",
+			Source:  ImportSourceLetterboxd,
+			File:    "Name,Year\n",
+			WantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			rows, err := ParseImportFile(tc.Source, strings.NewReader(tc.File))
+			if tc.WantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.Rows, rows)
+			}
+		})
+	}
+}
+
+func TestValidateFavouriteCategory(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ValidateFavouriteCategory(FavouriteCategoryWatched))
+	require.Error(t, ValidateFavouriteCategory("liked"))
+}
@@ -0,0 +1,89 @@
+package entity
+
+import "errors"
+
+// Уровни видимости раздела профиля
+const (
+	PrivacyPublic    = "public"
+	PrivacyFollowers = "followers"
+	PrivacyPrivate   = "private"
+)
+
+// Разделы профиля, видимость которых настраивается отдельно
+const (
+	PrivacySectionFavourites = "favourites"
+	PrivacySectionReviews    = "reviews"
+	PrivacySectionRatings    = "ratings"
+	PrivacySectionLists      = "lists"
+)
+
+// PrivacySettings настройки приватности пользователя
+type PrivacySettings struct {
+	UserID     int    `db:"user_id"`
+	Favourites string `db:"favourites"`
+	Reviews    string `db:"reviews"`
+	Ratings    string `db:"ratings"`
+	Lists      string `db:"lists"`
+}
+
+// DefaultPrivacySettings возвращает настройки пользователя, который их не менял: все разделы публичные
+func DefaultPrivacySettings(userID int) *PrivacySettings {
+	return &PrivacySettings{
+		UserID:     userID,
+		Favourites: PrivacyPublic,
+		Reviews:    PrivacyPublic,
+		Ratings:    PrivacyPublic,
+		Lists:      PrivacyPublic,
+	}
+}
+
+// Level возвращает уровень видимости раздела. Для неизвестного раздела возвращает PrivacyPrivate
+func (s *PrivacySettings) Level(section string) string {
+	switch section {
+	case PrivacySectionFavourites:
+		return s.Favourites
+	case PrivacySectionReviews:
+		return s.Reviews
+	case PrivacySectionRatings:
+		return s.Ratings
+	case PrivacySectionLists:
+		return s.Lists
+	default:
+		return PrivacyPrivate
+	}
+}
+
+// Validate проверяет, что у всех разделов корректный уровень видимости
+func (s *PrivacySettings) Validate() error {
+	for _, level := range []string{s.Favourites, s.Reviews, s.Ratings, s.Lists} {
+		if level != PrivacyPublic && level != PrivacyFollowers && level != PrivacyPrivate {
+			return errors.New("уровень видимости должен быть public, followers или private")
+		}
+	}
+	return nil
+}
+
+// PrivacyAllows проверяет, виден ли раздел с уровнем видимости level пользователю. Владельцу видно все
+func PrivacyAllows(level string, isOwner, isFollower bool) bool {
+	switch {
+	case isOwner, level == PrivacyPublic:
+		return true
+	case level == PrivacyFollowers:
+		return isFollower
+	default:
+		return false
+	}
+}
+
+// ProfileStats счетчики публичного профиля
+type ProfileStats struct {
+	Ratings    int `db:"ratings"`
+	Favourites int `db:"favourites"`
+	Lists      int `db:"lists"`
+}
+
+// GenreCount жанр и число оцененного пользователем контента этого жанра
+type GenreCount struct {
+	Genre
+	Count int
+}
@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestPrivacyAllows(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name       string
+		Level      string
+		IsOwner    bool
+		IsFollower bool
+		Expected   bool
+	}{
+		{Name: "Публичный раздел", Level: PrivacyPublic, Expected: true},
+		{Name: "Приватный раздел владельцу", Level: PrivacyPrivate, IsOwner: true, Expected: true},
+		{Name: "Приватный раздел подписчику", Level: PrivacyPrivate, IsFollower: true, Expected: false},
+		{Name: "Раздел для подписчиков подписчику", Level: PrivacyFollowers, IsFollower: true, Expected: true},
+		{Name: "Раздел для подписчиков остальным", Level: PrivacyFollowers, Expected: false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.Expected, PrivacyAllows(tc.Level, tc.IsOwner, tc.IsFollower))
+		})
+	}
+}
+
+func TestPrivacySettings_Validate(t *testing.T) {
+	t.Parallel()
+
+	settings := DefaultPrivacySettings(1)
+	require.NoError(t, settings.Validate())
+	require.Equal(t, PrivacyPublic, settings.Level(PrivacySectionLists))
+	settings.Reviews = "friends"
+	require.Error(t, settings.Validate())
+	require.Equal(t, PrivacyPrivate, settings.Level("diary"))
+}
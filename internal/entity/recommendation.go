@@ -0,0 +1,10 @@
+package entity
+
+// Recommendation - рекомендованный пользователю контент. SourceID - контент пользователя, который внес
+// наибольший вклад в Score, SourceLiked - понравился ли он пользователю или был только просмотрен
+type Recommendation struct {
+	ContentID   int
+	Score       float64
+	SourceID    int
+	SourceLiked bool
+}
@@ -1,24 +1,139 @@
 package entity
 
 import (
+	"database/sql"
 	"errors"
+	"fmt"
+	"slices"
 	"strings"
 	"time"
 	"unicode/utf8"
 )
 
 type Review struct {
-	ID            int       `db:"id"`
-	AuthorID      int       `db:"user_id"`
-	ContentID     int       `db:"content_id"`
-	ContentRating int       `db:"content_rating"`
-	Title         string    `db:"title"`
-	Text          string    `db:"text"`
-	CreatedAt     time.Time `db:"created_at"`
-	UpdatedAt     time.Time `db:"updated_at"`
-	Rating        int       `db:"rating"`
-	Likes         int       `db:"likes"`
-	Dislikes      int       `db:"dislikes"`
+	ID            int          `db:"id"`
+	AuthorID      int          `db:"user_id"`
+	ContentID     int          `db:"content_id"`
+	ContentRating int          `db:"content_rating"`
+	Title         string       `db:"title"`
+	Text          string       `db:"text"`
+	CreatedAt     time.Time    `db:"created_at"`
+	UpdatedAt     time.Time    `db:"updated_at"`
+	Rating        int          `db:"rating"`
+	Likes         int          `db:"likes"`
+	Dislikes      int          `db:"dislikes"`
+	Comments      int          `db:"comments"`
+	HasSpoilers   bool         `db:"has_spoilers"`
+	EditCount     int          `db:"edit_count"`
+	EditedAt      sql.NullTime `db:"edited_at"`
+	Hidden        bool         `db:"hidden"`
+}
+
+// ReviewFilter условия выборки рецензий для списков
+type ReviewFilter struct {
+	// HideSpoilers исключает рецензии, содержащие спойлеры
+	HideSpoilers bool
+	// Sort режим сортировки, если не указан - используется сортировка списка по умолчанию
+	Sort string
+	// Sentiment тональность рецензии по оценке контента
+	Sentiment string
+	// MinTextLength минимальная длина текста рецензии, 0 - без ограничения
+	MinTextLength int
+}
+
+const (
+	ReviewSortNewest        = "newest"
+	ReviewSortOldest        = "oldest"
+	ReviewSortHelpful       = "helpful"
+	ReviewSortControversial = "controversial"
+	ReviewSortHighest       = "highest"
+	ReviewSortLowest        = "lowest"
+)
+
+const (
+	ReviewSentimentPositive = "positive"
+	ReviewSentimentNeutral  = "neutral"
+	ReviewSentimentNegative = "negative"
+)
+
+var (
+	reviewSorts = []string{
+		ReviewSortNewest, ReviewSortOldest, ReviewSortHelpful,
+		ReviewSortControversial, ReviewSortHighest, ReviewSortLowest,
+	}
+	reviewSentiments = []string{ReviewSentimentPositive, ReviewSentimentNeutral, ReviewSentimentNegative}
+)
+
+// ReviewSentimentRange возвращает диапазон оценок контента, соответствующий тональности рецензии
+func ReviewSentimentRange(sentiment string) (from, to int) {
+	switch sentiment {
+	case ReviewSentimentPositive:
+		return 7, 10
+	case ReviewSentimentNeutral:
+		return 5, 6
+	case ReviewSentimentNegative:
+		return 1, 4
+	default:
+		return 1, 10
+	}
+}
+
+// ValidateReviewFilter проверяет режим сортировки, тональность и минимальную длину текста рецензий.
+// Пустые режим сортировки и тональность допустимы
+func ValidateReviewFilter(filter ReviewFilter) error {
+	if filter.Sort != "" && !slices.Contains(reviewSorts, filter.Sort) {
+		return fmt.Errorf("сортировка должна быть одной из: %s", strings.Join(reviewSorts, ", "))
+	}
+	if filter.Sentiment != "" && !slices.Contains(reviewSentiments, filter.Sentiment) {
+		return fmt.Errorf("тональность рецензии должна быть одной из: %s", strings.Join(reviewSentiments, ", "))
+	}
+	if filter.MinTextLength < 0 {
+		return errors.New("минимальная длина текста рецензии не может быть отрицательной")
+	}
+	return nil
+}
+
+const (
+	SpoilerOpenTag  = "[spoiler]"
+	SpoilerCloseTag = "[/spoiler]"
+)
+
+// SpoilerRange диапазон спойлера в тексте рецензии в символах, Start включительно, End не включительно
+type SpoilerRange struct {
+	Start int
+	End   int
+}
+
+// ParseSpoilers вырезает из текста рецензии теги [spoiler]...[/spoiler] и возвращает очищенный текст и диапазоны
+// спойлеров в нем. Незакрытый спойлер продолжается до конца текста, вложенные и лишние закрывающие теги
+// остаются в тексте как есть
+func ParseSpoilers(text string) (string, []SpoilerRange) {
+	var clean strings.Builder
+	spoilers := make([]SpoilerRange, 0)
+	pos := 0
+	for {
+		open := strings.Index(text, SpoilerOpenTag)
+		if open == -1 {
+			break
+		}
+		clean.WriteString(text[:open])
+		pos += utf8.RuneCountInString(text[:open])
+		text = text[open+len(SpoilerOpenTag):]
+		spoiler := text
+		text = ""
+		if end := strings.Index(spoiler, SpoilerCloseTag); end != -1 {
+			spoiler, text = spoiler[:end], spoiler[end+len(SpoilerCloseTag):]
+		}
+		if spoiler == "" {
+			continue
+		}
+		length := utf8.RuneCountInString(spoiler)
+		spoilers = append(spoilers, SpoilerRange{Start: pos, End: pos + length})
+		clean.WriteString(spoiler)
+		pos += length
+	}
+	clean.WriteString(text)
+	return clean.String(), spoilers
 }
 
 // ValidateReviewRating проверяет, что рейтинг находится в диапазоне от 1 до 10
@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+type ReviewComment struct {
+	ID        int           `db:"id"`
+	ReviewID  int           `db:"review_id"`
+	AuthorID  int           `db:"user_id"`
+	ParentID  sql.NullInt64 `db:"parent_id"`
+	Text      string        `db:"text"`
+	CreatedAt time.Time     `db:"created_at"`
+	UpdatedAt time.Time     `db:"updated_at"`
+}
+
+// ValidateReviewCommentText проверяет, что длина текста комментария находится в диапазоне от 1 до 2000 символов
+func ValidateReviewCommentText(text string) error {
+	if utf8.RuneCountInString(strings.TrimSpace(text)) < 1 || utf8.RuneCountInString(strings.TrimSpace(text)) > 2000 {
+		return errors.New("количество символов в тексте комментария должно быть от 1 до 2000")
+	}
+	return nil
+}
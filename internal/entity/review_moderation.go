@@ -0,0 +1,84 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"github.com/lib/pq"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Причины жалоб на рецензии
+const (
+	ReviewReportSpam     = "spam"
+	ReviewReportInsult   = "insult"
+	ReviewReportSpoiler  = "spoiler"
+	ReviewReportOfftopic = "offtopic"
+	ReviewReportOther    = "other"
+)
+
+// Решения модератора по рецензии
+const (
+	ModerationApprove = "approve"
+	ModerationHide    = "hide"
+	ModerationDelete  = "delete"
+)
+
+var (
+	reviewReportReasons = []string{
+		ReviewReportSpam, ReviewReportInsult, ReviewReportSpoiler, ReviewReportOfftopic, ReviewReportOther,
+	}
+	moderationActions = []string{ModerationApprove, ModerationHide, ModerationDelete}
+)
+
+type ReviewReport struct {
+	ID        int       `db:"id"`
+	ReviewID  int       `db:"review_id"`
+	UserID    int       `db:"user_id"`
+	Reason    string    `db:"reason"`
+	Comment   string    `db:"comment"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// ReportedReview рецензия в очереди модерации с нерассмотренными жалобами
+type ReportedReview struct {
+	ReviewID       int            `db:"review_id"`
+	Hidden         bool           `db:"hidden"`
+	Reports        int            `db:"reports"`
+	Reasons        pq.StringArray `db:"reasons"`
+	LastReportedAt time.Time      `db:"last_reported_at"`
+}
+
+// ModerationDecision запись журнала решений модератора
+type ModerationDecision struct {
+	ID          int       `db:"id"`
+	ReviewID    int       `db:"review_id"`
+	ModeratorID int       `db:"moderator_id"`
+	Action      string    `db:"action"`
+	Note        string    `db:"note"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+// ValidateReviewReport проверяет причину жалобы и длину комментария к ней
+func ValidateReviewReport(reason, comment string) error {
+	if !slices.Contains(reviewReportReasons, reason) {
+		return fmt.Errorf("причина жалобы должна быть одной из: %s", strings.Join(reviewReportReasons, ", "))
+	}
+	if utf8.RuneCountInString(comment) > 500 {
+		return errors.New("комментарий к жалобе должен быть не длиннее 500 символов")
+	}
+	return nil
+}
+
+// ValidateModerationDecision проверяет решение модератора и длину примечания к нему
+func ValidateModerationDecision(action, note string) error {
+	if !slices.Contains(moderationActions, action) {
+		return fmt.Errorf("решение модератора должно быть одним из: %s", strings.Join(moderationActions, ", "))
+	}
+	if utf8.RuneCountInString(note) > 500 {
+		return errors.New("примечание к решению должно быть не длиннее 500 символов")
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+// ReviewRevision версия рецензии до правки. CreatedAt - время, когда версия была написана, ReplacedAt - время,
+// когда ее заменила следующая правка
+type ReviewRevision struct {
+	ID            int       `db:"id"`
+	ReviewID      int       `db:"review_id"`
+	Title         string    `db:"title"`
+	Text          string    `db:"text"`
+	ContentRating int       `db:"content_rating"`
+	HasSpoilers   bool      `db:"has_spoilers"`
+	CreatedAt     time.Time `db:"created_at"`
+	ReplacedAt    time.Time `db:"replaced_at"`
+}
@@ -0,0 +1,103 @@
+package entity
+
+import (
+	"errors"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestValidateReviewFilter(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name   string
+		Input  ReviewFilter
+		Output error
+	}{
+		{
+			Name:   "Пустой фильтр",
+			Input:  ReviewFilter{},
+			Output: nil,
+		},
+		{
+			Name:   "Валидный фильтр",
+			Input:  ReviewFilter{Sort: ReviewSortLowest, Sentiment: ReviewSentimentNeutral, MinTextLength: 100},
+			Output: nil,
+		},
+		{
+			Name:   "Неизвестная сортировка",
+			Input:  ReviewFilter{Sort: "random"},
+			Output: errors.New("сортировка должна быть одной из: newest, oldest, helpful, controversial, highest, lowest"),
+		},
+		{
+			Name:   "Неизвестная тональность",
+			Input:  ReviewFilter{Sentiment: "angry"},
+			Output: errors.New("тональность рецензии должна быть одной из: positive, neutral, negative"),
+		},
+		{
+			Name:   "Отрицательная длина текста",
+			Input:  ReviewFilter{MinTextLength: -1},
+			Output: errors.New("минимальная длина текста рецензии не может быть отрицательной"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.Output, ValidateReviewFilter(tc.Input))
+		})
+	}
+}
+
+func TestParseSpoilers(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name             string
+		Input            string
+		ExpectedText     string
+		ExpectedSpoilers []SpoilerRange
+	}{
+		{
+			Name:             "Без спойлеров",
+			Input:            "Отличный фильм",
+			ExpectedText:     "Отличный фильм",
+			ExpectedSpoilers: []SpoilerRange{},
+		},
+		{
+			Name:             "Один спойлер",
+			Input:            "В конце [spoiler]герой погибает[/spoiler], жаль",
+			ExpectedText:     "В конце герой погибает, жаль",
+			ExpectedSpoilers: []SpoilerRange{{Start: 8, End: 22}},
+		},
+		{
+			Name:             "Несколько спойлеров",
+			Input:            "[spoiler]a[/spoiler]b[spoiler]cd[/spoiler]",
+			ExpectedText:     "abcd",
+			ExpectedSpoilers: []SpoilerRange{{Start: 0, End: 1}, {Start: 2, End: 4}},
+		},
+		{
+			Name:             "Незакрытый спойлер",
+			Input:            "Итог: [spoiler]все выжили",
+			ExpectedText:     "Итог: все выжили",
+			ExpectedSpoilers: []SpoilerRange{{Start: 6, End: 16}},
+		},
+		{
+			Name:             "Пустой спойлер и лишний закрывающий тег",
+			Input:            "[spoiler][/spoiler]текст[/spoiler]",
+			ExpectedText:     "текст[/spoiler]",
+			ExpectedSpoilers: []SpoilerRange{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			text, spoilers := ParseSpoilers(tc.Input)
+			require.Equal(t, tc.ExpectedText, text)
+			require.Equal(t, tc.ExpectedSpoilers, spoilers)
+		})
+	}
+}
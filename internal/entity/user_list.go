@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// UserList пользовательский список контента
+type UserList struct {
+	ID          int       `db:"id"`
+	UserID      int       `db:"user_id"`
+	Title       string    `db:"title"`
+	Description string    `db:"description"`
+	IsPublic    bool      `db:"is_public"`
+	ItemsCount  int       `db:"items_count"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
+
+// UserListItem элемент пользовательского списка
+type UserListItem struct {
+	ListID    int       `db:"list_id"`
+	ContentID int       `db:"content_id"`
+	Position  int       `db:"position"`
+	Note      string    `db:"note"`
+	AddedAt   time.Time `db:"added_at"`
+}
+
+// ValidateUserList проверяет, что название списка от 1 до 100 символов, а описание не длиннее 1000 символов
+func ValidateUserList(title, description string) error {
+	titleLength := utf8.RuneCountInString(strings.TrimSpace(title))
+	if titleLength < 1 || titleLength > 100 {
+		return errors.New("название списка должно быть от 1 до 100 символов")
+	}
+	if utf8.RuneCountInString(description) > 1000 {
+		return errors.New("описание списка должно быть не длиннее 1000 символов")
+	}
+	return nil
+}
+
+// ValidateUserListItemNote проверяет, что заметка к элементу списка не длиннее 500 символов
+func ValidateUserListItemNote(note string) error {
+	if utf8.RuneCountInString(note) > 500 {
+		return errors.New("заметка должна быть не длиннее 500 символов")
+	}
+	return nil
+}
+
+// ValidateUserListOrder проверяет, что новый порядок содержит каждый элемент списка ровно один раз
+func ValidateUserListOrder(items []*UserListItem, contentIDs []int) error {
+	if len(items) != len(contentIDs) {
+		return errors.New("новый порядок должен содержать все элементы списка")
+	}
+	inList := make(map[int]bool, len(items))
+	for _, item := range items {
+		inList[item.ContentID] = true
+	}
+	for _, contentID := range contentIDs {
+		if !inList[contentID] {
+			return errors.New("новый порядок должен содержать каждый элемент списка ровно один раз")
+		}
+		inList[contentID] = false
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package entity
+
+import (
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func TestValidateUserList(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		Title       string
+		Description string
+		WantErr     bool
+	}{
+		{
+			Name:        "Корректный список",
+			Title:       "Лучший нуар",
+			Description: "Фильмы, которые стоит посмотреть",
+			WantErr:     false,
+		},
+		{
+			Name:    "Пустое название",
+			Title:   "   ",
+			WantErr: true,
+		},
+		{
+			Name:    "Слишком длинное название",
+			Title:   strings.Repeat("а", 101),
+			WantErr: true,
+		},
+		{
+			Name:        "Слишком длинное описание",
+			Title:       "Список",
+			Description: strings.Repeat("а", 1001),
+			WantErr:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			err := ValidateUserList(tc.Title, tc.Description)
+			if tc.WantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateUserListOrder(t *testing.T) {
+	t.Parallel()
+
+	items := []*UserListItem{{ContentID: 1}, {ContentID: 2}, {ContentID: 3}}
+	testCases := []struct {
+		Name       string
+		ContentIDs []int
+		WantErr    bool
+	}{
+		{
+			Name:       "Корректный порядок",
+			ContentIDs: []int{3, 1, 2},
+			WantErr:    false,
+		},
+		{
+			Name:       "Не хватает элемента",
+			ContentIDs: []int{3, 1},
+			WantErr:    true,
+		},
+		{
+			Name:       "Повторяющийся элемент",
+			ContentIDs: []int{3, 3, 1},
+			WantErr:    true,
+		},
+		{
+			Name:       "Элемент не из списка",
+			ContentIDs: []int{3, 1, 4},
+			WantErr:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			err := ValidateUserListOrder(items, tc.ContentIDs)
+			if tc.WantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
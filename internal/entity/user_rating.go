@@ -0,0 +1,21 @@
+package entity
+
+import "time"
+
+// UserRating оценка контента без рецензии. Если у пользователя есть рецензия на контент, в рейтинге контента
+// учитывается оценка рецензии
+type UserRating struct {
+	UserID    int       `db:"user_id"`
+	ContentID int       `db:"content_id"`
+	Rating    int       `db:"rating"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
+
+// SeriesPart часть сериала, которую можно оценить отдельно от самого сериала
+type SeriesPart string
+
+const (
+	SeriesPartSeason  SeriesPart = "season"
+	SeriesPartEpisode SeriesPart = "episode"
+)
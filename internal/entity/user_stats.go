@@ -0,0 +1,80 @@
+package entity
+
+import "time"
+
+// Разделы статистики пользователя. Разделы кэшируются отдельно, поэтому после изменения избранного, оценок
+// или рецензий пересчитываются только те из них, которые от этого зависят
+const (
+	UserStatsSectionWatched  = "watched"
+	UserStatsSectionRatings  = "ratings"
+	UserStatsSectionActivity = "activity"
+)
+
+// UserStatsSections все разделы статистики пользователя
+var UserStatsSections = []string{UserStatsSectionWatched, UserStatsSectionRatings, UserStatsSectionActivity}
+
+// CountryCount страна и число просмотренного пользователем контента из этой страны
+type CountryCount struct {
+	Country
+	Count int
+}
+
+// PersonCount персона и число просмотренного пользователем контента с ее участием
+type PersonCount struct {
+	ID     int
+	Name   string
+	EnName string
+	Count  int
+}
+
+// WatchedStats статистика просмотренного контента. Просмотренным считается контент из избранного в любой
+// категории, кроме planned. Время просмотра складывается из длительности просмотренных фильмов и отмеченных
+// серий сериалов, в минутах
+type WatchedStats struct {
+	Categories map[string]int
+	Runtime    int
+	Genres     []*GenreCount
+	Countries  []*CountryCount
+	Directors  []*PersonCount
+	Actors     []*PersonCount
+}
+
+// RatingSum число оценок пользователя, их сумма и сумма рейтингов сайта для того же контента
+type RatingSum struct {
+	Count   int     `db:"count"`
+	Sum     int     `db:"sum"`
+	SiteSum float64 `db:"site_sum"`
+}
+
+// RatingStats оценки пользователя. Оценки без рецензии и оценки из рецензий хранятся отдельно, так как их
+// видимость настраивается разными разделами приватности
+type RatingStats struct {
+	Ratings RatingSum
+	Reviews RatingSum
+}
+
+// Average возвращает число оценок, среднюю оценку пользователя и средний рейтинг сайта для того же контента.
+// fromRatings и fromReviews определяют, учитываются ли оценки без рецензии и оценки из рецензий
+func (s *RatingStats) Average(fromRatings, fromReviews bool) (count int, average, siteAverage float64) {
+	var sum int
+	var siteSum float64
+	if fromRatings {
+		count, sum, siteSum = count+s.Ratings.Count, sum+s.Ratings.Sum, siteSum+s.Ratings.SiteSum
+	}
+	if fromReviews {
+		count, sum, siteSum = count+s.Reviews.Count, sum+s.Reviews.Sum, siteSum+s.Reviews.SiteSum
+	}
+	if count == 0 {
+		return 0, 0, 0
+	}
+	return count, float64(sum) / float64(count), siteSum / float64(count)
+}
+
+// MonthActivity число действий пользователя за месяц. Month - первое число месяца
+type MonthActivity struct {
+	Month      time.Time
+	Favourites int
+	Ratings    int
+	Reviews    int
+	Episodes   int
+}
@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestRatingStats_Average(t *testing.T) {
+	t.Parallel()
+
+	stats := &RatingStats{
+		Ratings: RatingSum{Count: 2, Sum: 16, SiteSum: 14},
+		Reviews: RatingSum{Count: 2, Sum: 12, SiteSum: 15},
+	}
+	testCases := []struct {
+		Name                string
+		FromRatings         bool
+		FromReviews         bool
+		ExpectedCount       int
+		ExpectedAverage     float64
+		ExpectedSiteAverage float64
+	}{
+		{Name: "Все оценки", FromRatings: true, FromReviews: true, ExpectedCount: 4, ExpectedAverage: 7,
+			ExpectedSiteAverage: 7.25},
+		{Name: "Только оценки без рецензии", FromRatings: true, ExpectedCount: 2, ExpectedAverage: 8,
+			ExpectedSiteAverage: 7},
+		{Name: "Только рецензии", FromReviews: true, ExpectedCount: 2, ExpectedAverage: 6, ExpectedSiteAverage: 7.5},
+		{Name: "Оба раздела скрыты"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			count, average, siteAverage := stats.Average(tc.FromRatings, tc.FromReviews)
+			require.Equal(t, tc.ExpectedCount, count)
+			require.InDelta(t, tc.ExpectedAverage, average, 1e-9)
+			require.InDelta(t, tc.ExpectedSiteAverage, siteAverage, 1e-9)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package entity
+
+// EpisodeProgress эпизод сериала и отметка о его просмотре
+type EpisodeProgress struct {
+	SeasonEpisode
+	Watched bool
+}
+
+// WatchProgress прогресс просмотра сериала. Эпизоды упорядочены по сезонам и номерам
+type WatchProgress struct {
+	ContentID int
+	Episodes  []EpisodeProgress
+}
+
+// WatchedCount возвращает число просмотренных эпизодов
+func (p WatchProgress) WatchedCount() int {
+	count := 0
+	for _, episode := range p.Episodes {
+		if episode.Watched {
+			count++
+		}
+	}
+	return count
+}
+
+// Completed возвращает true, если просмотрены все эпизоды. У сериала без эпизодов просмотр не завершен
+func (p WatchProgress) Completed() bool {
+	return len(p.Episodes) > 0 && p.WatchedCount() == len(p.Episodes)
+}
+
+// NextEpisode возвращает эпизод, с которого стоит продолжить просмотр: первый непросмотренный после последнего
+// просмотренного, а если таких нет - первый непросмотренный. Если просмотрены все эпизоды, возвращает nil
+func (p WatchProgress) NextEpisode() *EpisodeProgress {
+	last := -1
+	for i, episode := range p.Episodes {
+		if episode.Watched {
+			last = i
+		}
+	}
+	for i := last + 1; i < len(p.Episodes); i++ {
+		if !p.Episodes[i].Watched {
+			return &p.Episodes[i]
+		}
+	}
+	for i := range p.Episodes {
+		if !p.Episodes[i].Watched {
+			return &p.Episodes[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,88 @@
+package entity
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func newWatchProgress(watched ...bool) WatchProgress {
+	progress := WatchProgress{ContentID: 1, Episodes: make([]EpisodeProgress, len(watched))}
+	for i, w := range watched {
+		progress.Episodes[i] = EpisodeProgress{
+			SeasonEpisode: SeasonEpisode{Episode: Episode{ID: i + 1, EpisodeNumber: i + 1}, SeasonID: 1},
+			Watched:       w,
+		}
+	}
+	return progress
+}
+
+func TestWatchProgress(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name              string
+		Input             WatchProgress
+		ExpectedWatched   int
+		ExpectedCompleted bool
+		ExpectedNextID    int
+	}{
+		{
+			Name:              "Нет эпизодов",
+			Input:             newWatchProgress(),
+			ExpectedWatched:   0,
+			ExpectedCompleted: false,
+			ExpectedNextID:    0,
+		},
+		{
+			Name:              "Ничего не просмотрено",
+			Input:             newWatchProgress(false, false, false),
+			ExpectedWatched:   0,
+			ExpectedCompleted: false,
+			ExpectedNextID:    1,
+		},
+		{
+			Name:              "Просмотр по порядку",
+			Input:             newWatchProgress(true, true, false),
+			ExpectedWatched:   2,
+			ExpectedCompleted: false,
+			ExpectedNextID:    3,
+		},
+		{
+			Name:              "Пропущенный эпизод в середине",
+			Input:             newWatchProgress(true, false, true, false),
+			ExpectedWatched:   2,
+			ExpectedCompleted: false,
+			ExpectedNextID:    4,
+		},
+		{
+			Name:              "Просмотрен последний эпизод",
+			Input:             newWatchProgress(false, true, true),
+			ExpectedWatched:   2,
+			ExpectedCompleted: false,
+			ExpectedNextID:    1,
+		},
+		{
+			Name:              "Все просмотрено",
+			Input:             newWatchProgress(true, true),
+			ExpectedWatched:   2,
+			ExpectedCompleted: true,
+			ExpectedNextID:    0,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.ExpectedWatched, tc.Input.WatchedCount())
+			require.Equal(t, tc.ExpectedCompleted, tc.Input.Completed())
+			next := tc.Input.NextEpisode()
+			if tc.ExpectedNextID == 0 {
+				require.Nil(t, next)
+			} else {
+				require.NotNil(t, next)
+				require.Equal(t, tc.ExpectedNextID, next.ID)
+			}
+		})
+	}
+}
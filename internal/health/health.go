@@ -0,0 +1,120 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"sync"
+	"time"
+)
+
+// Probe проверяет доступность одной зависимости
+type Probe func(ctx context.Context) error
+
+// Checker выполняет проверки зависимостей сервиса, каждую со своим таймаутом
+type Checker struct {
+	timeout time.Duration
+	probes  map[string]Probe
+}
+
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{
+		timeout: timeout,
+		probes:  make(map[string]Probe),
+	}
+}
+
+// Add добавляет проверку зависимости. Проверка с тем же именем заменяется
+func (c *Checker) Add(name string, probe Probe) *Checker {
+	c.probes[name] = probe
+	return c
+}
+
+// Check параллельно выполняет все проверки и возвращает ошибки по именам зависимостей.
+// Для успешных проверок значение nil
+func (c *Checker) Check(ctx context.Context) map[string]error {
+	results := make(map[string]error, len(c.probes))
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for name, probe := range c.probes {
+		wg.Add(1)
+		go func(name string, probe Probe) {
+			defer wg.Done()
+			probeCtx, cancel := context.WithTimeout(ctx, c.timeout)
+			defer cancel()
+			err := probe(probeCtx)
+			mu.Lock()
+			defer mu.Unlock()
+			results[name] = err
+		}(name, probe)
+	}
+	wg.Wait()
+	return results
+}
+
+// Serve периодически выполняет проверки и выставляет статус gRPC health сервера для перечисленных сервисов
+// (пустая строка означает сервер целиком). Возвращается после отмены контекста
+func (c *Checker) Serve(ctx context.Context, server *grpchealth.Server, interval time.Duration, services ...string) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		status := healthpb.HealthCheckResponse_SERVING
+		for _, err := range c.Check(ctx) {
+			if err != nil {
+				status = healthpb.HealthCheckResponse_NOT_SERVING
+				break
+			}
+		}
+		for _, service := range services {
+			server.SetServingStatus(service, status)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Postgres проверяет соединение с базой данных
+func Postgres(db *sql.DB) Probe {
+	return db.PingContext
+}
+
+// Redis проверяет соединение с Redis
+func Redis(client *redis.Client) Probe {
+	return func(ctx context.Context) error {
+		return client.Ping(ctx).Err()
+	}
+}
+
+// S3 проверяет доступность бакета
+func S3(client *s3.S3, bucket string) Probe {
+	return func(ctx context.Context) error {
+		_, err := client.HeadBucketWithContext(ctx, &s3.HeadBucketInput{Bucket: aws.String(bucket)})
+		return err
+	}
+}
+
+// GRPC проверяет сервис через стандартный grpc.health.v1
+func GRPC(conn *grpc.ClientConn) Probe {
+	client := healthpb.NewHealthClient(conn)
+	return func(ctx context.Context) error {
+		resp, err := client.Check(ctx, &healthpb.HealthCheckRequest{})
+		if err != nil {
+			return err
+		}
+		if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+			return fmt.Errorf("сервис в статусе %s", resp.GetStatus())
+		}
+		return nil
+	}
+}
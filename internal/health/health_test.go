@@ -0,0 +1,142 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/require"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"testing"
+	"time"
+)
+
+func okProbe(context.Context) error {
+	return nil
+}
+
+func downProbe(context.Context) error {
+	return errors.New("connection refused")
+}
+
+func hangingProbe(ctx context.Context) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func TestChecker_Check(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		Probes   map[string]Probe
+		Expected map[string]error
+	}{
+		{
+			Name:     "Все зависимости доступны",
+			Probes:   map[string]Probe{"postgres": okProbe, "redis": okProbe},
+			Expected: map[string]error{"postgres": nil, "redis": nil},
+		},
+		{
+			Name:     "Одна зависимость недоступна",
+			Probes:   map[string]Probe{"postgres": okProbe, "redis": downProbe},
+			Expected: map[string]error{"postgres": nil, "redis": errors.New("connection refused")},
+		},
+		{
+			Name:     "Зависимость не укладывается в таймаут",
+			Probes:   map[string]Probe{"postgres": okProbe, "s3": hangingProbe},
+			Expected: map[string]error{"postgres": nil, "s3": context.DeadlineExceeded},
+		},
+		{
+			Name:     "Нет зависимостей",
+			Probes:   map[string]Probe{},
+			Expected: map[string]error{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			checker := NewChecker(10 * time.Millisecond)
+			for name, probe := range tc.Probes {
+				checker.Add(name, probe)
+			}
+			require.Equal(t, tc.Expected, checker.Check(context.Background()))
+		})
+	}
+}
+
+func TestChecker_CheckParallel(t *testing.T) {
+	t.Parallel()
+
+	// проверки выполняются параллельно, поэтому несколько зависших зависимостей укладываются в один таймаут
+	checker := NewChecker(50*time.Millisecond).
+		Add("auth", hangingProbe).
+		Add("static", hangingProbe).
+		Add("s3", hangingProbe)
+	start := time.Now()
+	results := checker.Check(context.Background())
+	require.Less(t, time.Since(start), 140*time.Millisecond)
+	require.Len(t, results, 3)
+	for _, err := range results {
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	}
+}
+
+func TestChecker_Serve(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name     string
+		Probes   map[string]Probe
+		Expected healthpb.HealthCheckResponse_ServingStatus
+	}{
+		{
+			Name:     "Все зависимости доступны",
+			Probes:   map[string]Probe{"postgres": okProbe, "redis": okProbe},
+			Expected: healthpb.HealthCheckResponse_SERVING,
+		},
+		{
+			Name:     "Одна зависимость недоступна",
+			Probes:   map[string]Probe{"postgres": okProbe, "redis": downProbe},
+			Expected: healthpb.HealthCheckResponse_NOT_SERVING,
+		},
+		{
+			Name:     "Зависимость не укладывается в таймаут",
+			Probes:   map[string]Probe{"s3": hangingProbe},
+			Expected: healthpb.HealthCheckResponse_NOT_SERVING,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			checker := NewChecker(10 * time.Millisecond)
+			for name, probe := range tc.Probes {
+				checker.Add(name, probe)
+			}
+			server := grpchealth.NewServer()
+			ctx, cancel := context.WithCancel(context.Background())
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				checker.Serve(ctx, server, time.Hour, "", "auth")
+			}()
+			// первая проверка выполняется сразу, не дожидаясь интервала
+			require.Eventually(t, func() bool {
+				resp, err := server.Check(context.Background(), &healthpb.HealthCheckRequest{Service: "auth"})
+				return err == nil && resp.GetStatus() == tc.Expected
+			}, time.Second, 5*time.Millisecond)
+			resp, err := server.Check(context.Background(), &healthpb.HealthCheckRequest{})
+			require.NoError(t, err)
+			require.Equal(t, tc.Expected, resp.GetStatus())
+			cancel()
+			select {
+			case <-done:
+			case <-time.After(time.Second):
+				t.Fatal("Serve не завершился после отмены контекста")
+			}
+		})
+	}
+}
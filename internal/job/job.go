@@ -0,0 +1,35 @@
+package job
+
+import (
+	"context"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/logger"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
+	"time"
+)
+
+// Func выполняет одну итерацию фоновой задачи
+type Func func(ctx context.Context) error
+
+// Every выполняет задачу сразу после запуска, а затем каждые interval. Итерации не пересекаются: следующая
+// начинается не раньше, чем закончится предыдущая. Ошибка итерации логируется и не останавливает задачу.
+// Возвращается после отмены контекста
+func Every(ctx context.Context, name string, interval time.Duration, fn Func) {
+	log := logger.ForPackage("job")
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		start := time.Now()
+		err := fn(ctx)
+		metrics.ObserveJob(name, start, err)
+		if err != nil && ctx.Err() == nil {
+			log.ErrorContext(ctx, "ошибка при выполнении фоновой задачи", "job", name, "error", err)
+		} else if err == nil {
+			log.InfoContext(ctx, "фоновая задача выполнена", "job", name, "duration", time.Since(start))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
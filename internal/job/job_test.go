@@ -0,0 +1,93 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/require"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// runEvery запускает задачу в фоне и возвращает функцию, которая отменяет ее и дожидается возврата Every
+func runEvery(t *testing.T, interval time.Duration, fn Func) (stop func()) {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		Every(ctx, "test", interval, fn)
+		close(done)
+	}()
+	return func() {
+		cancel()
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("Every не вернулся после отмены контекста")
+		}
+	}
+}
+
+func TestEvery_RunsImmediately(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	stop := runEvery(t, time.Hour, func(ctx context.Context) error {
+		calls.Add(1)
+		return nil
+	})
+	defer stop()
+	// до первого тика еще час, значит первая итерация выполняется сразу после запуска
+	require.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, time.Millisecond)
+}
+
+func TestEvery_NoOverlap(t *testing.T) {
+	t.Parallel()
+
+	var calls, active, maxActive atomic.Int32
+	stop := runEvery(t, time.Millisecond, func(ctx context.Context) error {
+		current := active.Add(1)
+		defer active.Add(-1)
+		if current > maxActive.Load() {
+			maxActive.Store(current)
+		}
+		calls.Add(1)
+		// итерация дольше интервала: тики за это время не должны запускать новые итерации
+		time.Sleep(5 * time.Millisecond)
+		return nil
+	})
+	require.Eventually(t, func() bool { return calls.Load() >= 3 }, time.Second, time.Millisecond)
+	stop()
+	require.Equal(t, int32(1), maxActive.Load())
+}
+
+func TestEvery_SurvivesError(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	stop := runEvery(t, time.Millisecond, func(ctx context.Context) error {
+		if calls.Add(1) == 1 {
+			return errors.New("database error")
+		}
+		return nil
+	})
+	defer stop()
+	require.Eventually(t, func() bool { return calls.Load() >= 2 }, time.Second, time.Millisecond)
+}
+
+func TestEvery_ReturnsOnCancel(t *testing.T) {
+	t.Parallel()
+
+	started := make(chan struct{})
+	var calls atomic.Int32
+	stop := runEvery(t, time.Hour, func(ctx context.Context) error {
+		if calls.Add(1) == 1 {
+			close(started)
+		}
+		return nil
+	})
+	<-started
+	// stop падает, если Every не вернется после отмены
+	stop()
+	require.Equal(t, int32(1), calls.Load())
+}
@@ -0,0 +1,184 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// PackageKey ключ атрибута, по которому выбирается уровень логирования пакета
+	PackageKey = "package"
+	// RedactedValue значение, которым заменяются чувствительные данные
+	RedactedValue = "[REDACTED]"
+)
+
+// Config параметры логирования
+type Config struct {
+	// Level уровень логирования по умолчанию - debug, info, warn или error
+	Level string
+	// Packages уровни логирования для отдельных пакетов, например {"http": "debug"}
+	Packages map[string]string
+}
+
+// sensitiveKeys подстроки ключей атрибутов, значения которых нельзя писать в логи
+var sensitiveKeys = []string{"password", "passwd", "cookie", "csrf", "session", "authorization", "token", "secret"}
+
+// Init создает логгер сервиса и устанавливает его логгером по умолчанию
+func Init(service string, cfg Config) (*slog.Logger, error) {
+	logger, err := New(os.Stdout, cfg)
+	if err != nil {
+		return nil, err
+	}
+	logger = logger.With("service", service)
+	slog.SetDefault(logger)
+	return logger, nil
+}
+
+// New создает JSON-логгер, который пишет в writer, скрывает чувствительные данные и учитывает уровни пакетов
+func New(writer io.Writer, cfg Config) (*slog.Logger, error) {
+	level, err := parseLevel(cfg.Level)
+	if err != nil {
+		return nil, err
+	}
+	packages := make(map[string]slog.Level, len(cfg.Packages))
+	for pkg, pkgLevel := range cfg.Packages {
+		packages[pkg], err = parseLevel(pkgLevel)
+		if err != nil {
+			return nil, fmt.Errorf("уровень логирования пакета %s: %w", pkg, err)
+		}
+	}
+	inner := slog.NewJSONHandler(writer, &slog.HandlerOptions{
+		// фильтрация по уровню происходит в handler, здесь пропускаем всё
+		Level:       slog.Level(-100),
+		ReplaceAttr: redact,
+	})
+	return slog.New(&handler{inner: inner, level: level, packages: packages}), nil
+}
+
+// ForPackage возвращает логгер по умолчанию с уровнем, заданным для пакета в конфиге
+func ForPackage(name string) *slog.Logger {
+	return slog.Default().With(PackageKey, name)
+}
+
+// Fatal логирует ошибку и завершает процесс
+func Fatal(msg string, args ...any) {
+	slog.Error(msg, args...)
+	os.Exit(1)
+}
+
+func parseLevel(level string) (slog.Level, error) {
+	var parsed slog.Level
+	if level == "" {
+		return slog.LevelInfo, nil
+	}
+	if err := parsed.UnmarshalText([]byte(level)); err != nil {
+		return parsed, fmt.Errorf("неизвестный уровень логирования %q: %w", level, err)
+	}
+	return parsed, nil
+}
+
+// redact заменяет значения чувствительных атрибутов, в том числе вложенных в группы
+func redact(_ []string, attr slog.Attr) slog.Attr {
+	key := strings.ToLower(attr.Key)
+	for _, sensitive := range sensitiveKeys {
+		if strings.Contains(key, sensitive) {
+			return slog.String(attr.Key, RedactedValue)
+		}
+	}
+	return attr
+}
+
+// handler добавляет в каждую запись поля запроса из контекста и фильтрует записи по уровню пакета
+type handler struct {
+	inner    slog.Handler
+	level    slog.Level
+	packages map[string]slog.Level
+}
+
+func (h *handler) Enabled(_ context.Context, level slog.Level) bool {
+	return level >= h.level
+}
+
+func (h *handler) Handle(ctx context.Context, record slog.Record) error {
+	if fields := fieldsFromContext(ctx); fields != nil {
+		record.AddAttrs(fields.attrs()...)
+	}
+	return h.inner.Handle(ctx, record)
+}
+
+func (h *handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	level := h.level
+	for _, attr := range attrs {
+		if attr.Key != PackageKey {
+			continue
+		}
+		if pkgLevel, ok := h.packages[attr.Value.String()]; ok {
+			level = pkgLevel
+		}
+	}
+	return &handler{inner: h.inner.WithAttrs(attrs), level: level, packages: h.packages}
+}
+
+func (h *handler) WithGroup(name string) slog.Handler {
+	return &handler{inner: h.inner.WithGroup(name), level: h.level, packages: h.packages}
+}
+
+type fieldsKey struct{}
+
+// requestFields поля запроса, которые попадают в каждую запись лога. ID пользователя становится известен
+// только после проверки сессии, поэтому поля изменяемые
+type requestFields struct {
+	mu        sync.RWMutex
+	requestID string
+	route     string
+	userID    int
+	start     time.Time
+}
+
+func (f *requestFields) attrs() []slog.Attr {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	attrs := []slog.Attr{
+		slog.String("request_id", f.requestID),
+		slog.String("route", f.route),
+		slog.Float64("latency_ms", float64(time.Since(f.start).Microseconds())/1000),
+	}
+	if f.userID > 0 {
+		attrs = append(attrs, slog.Int("user_id", f.userID))
+	}
+	return attrs
+}
+
+func fieldsFromContext(ctx context.Context) *requestFields {
+	if ctx == nil {
+		return nil
+	}
+	fields, _ := ctx.Value(fieldsKey{}).(*requestFields)
+	return fields
+}
+
+// WithRequest добавляет в контекст поля запроса. Время начала запроса отсчитывается от момента вызова
+func WithRequest(ctx context.Context, requestID, route string) context.Context {
+	return context.WithValue(ctx, fieldsKey{}, &requestFields{
+		requestID: requestID,
+		route:     route,
+		start:     time.Now(),
+	})
+}
+
+// SetUserID запоминает ID пользователя в полях запроса, если они есть в контексте
+func SetUserID(ctx context.Context, userID int) {
+	fields := fieldsFromContext(ctx)
+	if fields == nil {
+		return
+	}
+	fields.mu.Lock()
+	defer fields.mu.Unlock()
+	fields.userID = userID
+}
@@ -0,0 +1,116 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestLoggerRedact(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name   string
+		Key    string
+		Value  string
+		Output string
+	}{
+		{
+			Name:   "Пароль",
+			Key:    "password",
+			Value:  "Amazing1!",
+			Output: RedactedValue,
+		},
+		{
+			Name:   "Кука",
+			Key:    "Cookie",
+			Value:  "session=abc",
+			Output: RedactedValue,
+		},
+		{
+			Name:   "CSRF токен",
+			Key:    "X-Csrf",
+			Value:  "token",
+			Output: RedactedValue,
+		},
+		{
+			Name:   "Обычное поле",
+			Key:    "method",
+			Value:  "GET",
+			Output: "GET",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			buf := &bytes.Buffer{}
+			logger, err := New(buf, Config{})
+			require.NoError(t, err)
+			logger.Info("test", tc.Key, tc.Value)
+			record := map[string]any{}
+			require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+			require.Equal(t, tc.Output, record[tc.Key])
+		})
+	}
+}
+
+func TestLoggerPackageLevel(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name    string
+		Package string
+		Written bool
+	}{
+		{
+			Name:    "Уровень пакета ниже общего",
+			Package: "http",
+			Written: true,
+		},
+		{
+			Name:    "Уровень пакета не задан",
+			Package: "grpc",
+			Written: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			buf := &bytes.Buffer{}
+			logger, err := New(buf, Config{Level: "warn", Packages: map[string]string{"http": "debug"}})
+			require.NoError(t, err)
+			logger.With(PackageKey, tc.Package).Debug("test")
+			require.Equal(t, tc.Written, buf.Len() > 0)
+		})
+	}
+}
+
+func TestLoggerRequestFields(t *testing.T) {
+	t.Parallel()
+
+	buf := &bytes.Buffer{}
+	logger, err := New(buf, Config{})
+	require.NoError(t, err)
+	ctx := WithRequest(context.Background(), "req-1", "/api/user/profile")
+	SetUserID(ctx, 42)
+	logger.InfoContext(ctx, "test")
+	record := map[string]any{}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &record))
+	require.Equal(t, "req-1", record["request_id"])
+	require.Equal(t, "/api/user/profile", record["route"])
+	require.Equal(t, float64(42), record["user_id"])
+	require.Contains(t, record, "latency_ms")
+}
+
+func TestLoggerUnknownLevel(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(&bytes.Buffer{}, Config{Packages: map[string]string{"http": "loud"}})
+	require.Error(t, err)
+}
@@ -0,0 +1,109 @@
+package logger
+
+import (
+	"context"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
+	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"log/slog"
+	"strings"
+)
+
+// probeRoutes маршруты проверок liveness и readiness, успешные запросы к ним логируются на уровне debug
+var probeRoutes = map[string]bool{"/healthz": true, "/readyz": true}
+
+// EchoMiddleware добавляет поля запроса в контекст и пишет по одной записи на каждый обработанный запрос.
+// Должен подключаться после middleware, которая выставляет ID запроса
+func EchoMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			logger := ForPackage("http")
+			reqID, _ := ctx.Get(echo.HeaderXRequestID).(string)
+			reqCtx := WithRequest(ctx.Request().Context(), reqID, ctx.Path())
+			ctx.SetRequest(ctx.Request().WithContext(reqCtx))
+			if err := next(ctx); err != nil {
+				// обрабатываем ошибку здесь, чтобы в лог попал итоговый статус ответа
+				ctx.Error(err)
+			}
+			respStatus := ctx.Response().Status
+			level := slog.LevelInfo
+			switch {
+			case respStatus >= 500:
+				level = slog.LevelError
+			case probeRoutes[ctx.Path()]:
+				// пробы оркестратора приходят каждые несколько секунд и забивают лог
+				level = slog.LevelDebug
+			}
+			logger.Log(reqCtx, level, "запрос обработан",
+				"method", ctx.Request().Method,
+				"status", respStatus,
+				"client_ip", ctx.RealIP(),
+				"bytes_out", ctx.Response().Size,
+			)
+			return nil
+		}
+	}
+}
+
+// ServerOptions опции gRPC сервера для логирования
+func ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(unaryServerInterceptor),
+		grpc.ChainStreamInterceptor(streamServerInterceptor),
+	}
+}
+
+func unaryServerInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	ctx = WithRequest(ctx, tracing.RequestIDFromContext(ctx), info.FullMethod)
+	resp, err := handler(ctx, req)
+	logRPC(ctx, info.FullMethod, err)
+	return resp, err
+}
+
+func streamServerInterceptor(
+	srv any,
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	ctx := WithRequest(stream.Context(), tracing.RequestIDFromContext(stream.Context()), info.FullMethod)
+	err := handler(srv, &serverStream{ServerStream: stream, ctx: ctx})
+	logRPC(ctx, info.FullMethod, err)
+	return err
+}
+
+func logRPC(ctx context.Context, method string, err error) {
+	code := status.Code(err)
+	level := slog.LevelInfo
+	switch code {
+	case codes.OK, codes.Canceled, codes.NotFound, codes.InvalidArgument, codes.AlreadyExists:
+		if strings.HasPrefix(method, "/"+healthpb.Health_ServiceDesc.ServiceName+"/") {
+			level = slog.LevelDebug
+		}
+	default:
+		level = slog.LevelError
+	}
+	args := []any{"code", code.String()}
+	if err != nil {
+		args = append(args, "error", err)
+	}
+	ForPackage("grpc").Log(ctx, level, "вызов обработан", args...)
+}
+
+// serverStream подменяет контекст потока, чтобы обработчик получил поля запроса
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,25 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	CacheHit  = "hit"
+	CacheMiss = "miss"
+	// CacheError кэш недоступен, данные получены из основного хранилища
+	CacheError = "error"
+)
+
+var cacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: Namespace,
+	Subsystem: "cache",
+	Name:      "requests_total",
+	Help:      "Количество обращений к кэшу по результату: hit, miss или error",
+}, []string{"cache", "result"})
+
+// ObserveCache учитывает обращение к кэшу с именем cache
+func ObserveCache(cache, result string) {
+	cacheRequests.WithLabelValues(cache, result).Inc()
+}
@@ -0,0 +1,28 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func TestObserveCache(t *testing.T) {
+	t.Parallel()
+
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(cacheRequests)
+	ObserveCache("test_cache", CacheHit)
+	ObserveCache("test_cache", CacheHit)
+	ObserveCache("test_cache", CacheMiss)
+	ObserveCache("test_cache", CacheError)
+	expected := `
+# HELP Kinoskop_cache_requests_total Количество обращений к кэшу по результату: hit, miss или error
+# TYPE Kinoskop_cache_requests_total counter
+Kinoskop_cache_requests_total{cache="test_cache",result="error"} 1
+Kinoskop_cache_requests_total{cache="test_cache",result="hit"} 2
+Kinoskop_cache_requests_total{cache="test_cache",result="miss"} 1
+`
+	require.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(expected), "Kinoskop_cache_requests_total"))
+}
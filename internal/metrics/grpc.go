@@ -0,0 +1,123 @@
+package metrics
+
+import (
+	"context"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"time"
+)
+
+var (
+	grpcServerHandled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "grpc_server",
+		Name:      "handled_total",
+		Help:      "Количество обработанных gRPC запросов",
+	}, []string{"method", "code"})
+	grpcServerDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "grpc_server",
+		Name:      "handling_seconds",
+		Help:      "Время обработки gRPC запроса",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+	grpcClientHandled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Subsystem: "grpc_client",
+		Name:      "handled_total",
+		Help:      "Количество выполненных gRPC вызовов",
+	}, []string{"method", "code"})
+	grpcClientDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Subsystem: "grpc_client",
+		Name:      "handling_seconds",
+		Help:      "Время выполнения gRPC вызова",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+)
+
+func observeGRPC(
+	handled *prometheus.CounterVec,
+	duration *prometheus.HistogramVec,
+	method string,
+	start time.Time,
+	err error,
+) {
+	handled.WithLabelValues(method, status.Code(err).String()).Inc()
+	duration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
+
+// UnaryServerInterceptor собирает метрики унарных вызовов gRPC сервера
+func UnaryServerInterceptor(
+	ctx context.Context,
+	req any,
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	observeGRPC(grpcServerHandled, grpcServerDuration, info.FullMethod, start, err)
+	return resp, err
+}
+
+// StreamServerInterceptor собирает метрики потоковых вызовов gRPC сервера
+func StreamServerInterceptor(
+	srv any,
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	start := time.Now()
+	err := handler(srv, stream)
+	observeGRPC(grpcServerHandled, grpcServerDuration, info.FullMethod, start, err)
+	return err
+}
+
+// UnaryClientInterceptor собирает метрики унарных вызовов gRPC клиента
+func UnaryClientInterceptor(
+	ctx context.Context,
+	method string,
+	req, reply any,
+	conn *grpc.ClientConn,
+	invoker grpc.UnaryInvoker,
+	opts ...grpc.CallOption,
+) error {
+	start := time.Now()
+	err := invoker(ctx, method, req, reply, conn, opts...)
+	observeGRPC(grpcClientHandled, grpcClientDuration, method, start, err)
+	return err
+}
+
+// StreamClientInterceptor собирает метрики потоковых вызовов gRPC клиента. Учитывается только время
+// установки потока, так как время его жизни определяется вызывающей стороной
+func StreamClientInterceptor(
+	ctx context.Context,
+	desc *grpc.StreamDesc,
+	conn *grpc.ClientConn,
+	method string,
+	streamer grpc.Streamer,
+	opts ...grpc.CallOption,
+) (grpc.ClientStream, error) {
+	start := time.Now()
+	stream, err := streamer(ctx, desc, conn, method, opts...)
+	observeGRPC(grpcClientHandled, grpcClientDuration, method, start, err)
+	return stream, err
+}
+
+// ServerOptions опции gRPC сервера для сбора метрик
+func ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(UnaryServerInterceptor),
+		grpc.ChainStreamInterceptor(StreamServerInterceptor),
+	}
+}
+
+// DialOptions опции gRPC клиента для сбора метрик
+func DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithChainUnaryInterceptor(UnaryClientInterceptor),
+		grpc.WithChainStreamInterceptor(StreamClientInterceptor),
+	}
+}
@@ -0,0 +1,146 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+// sampleCount возвращает количество наблюдений гистограммы name с заданными метками
+func sampleCount(t *testing.T, reg *prometheus.Registry, name string, labels map[string]string) uint64 {
+	t.Helper()
+	families, err := reg.Gather()
+	require.NoError(t, err)
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			matched := 0
+			for _, pair := range metric.GetLabel() {
+				if labels[pair.GetName()] == pair.GetValue() {
+					matched++
+				}
+			}
+			if matched == len(labels) {
+				return metric.GetHistogram().GetSampleCount()
+			}
+		}
+	}
+	return 0
+}
+
+func TestGRPCInterceptors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name         string
+		Err          error
+		ExpectedCode string
+	}{
+		{
+			Name:         "Успешный вызов",
+			ExpectedCode: "OK",
+		},
+		{
+			Name:         "Ошибка со статусом",
+			Err:          status.Error(codes.NotFound, "not found"),
+			ExpectedCode: "NotFound",
+		},
+		{
+			Name:         "Ошибка без статуса",
+			Err:          errors.New("connection refused"),
+			ExpectedCode: "Unknown",
+		},
+	}
+
+	interceptors := []struct {
+		Name     string
+		Handled  *prometheus.CounterVec
+		Duration *prometheus.HistogramVec
+		Prefix   string
+		Call     func(method string, err error) error
+	}{
+		{
+			Name:     "Unary server",
+			Handled:  grpcServerHandled,
+			Duration: grpcServerDuration,
+			Prefix:   "Kinoskop_grpc_server",
+			Call: func(method string, err error) error {
+				_, callErr := UnaryServerInterceptor(
+					context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method},
+					func(ctx context.Context, req any) (any, error) { return nil, err },
+				)
+				return callErr
+			},
+		},
+		{
+			Name:     "Stream server",
+			Handled:  grpcServerHandled,
+			Duration: grpcServerDuration,
+			Prefix:   "Kinoskop_grpc_server",
+			Call: func(method string, err error) error {
+				return StreamServerInterceptor(
+					nil, nil, &grpc.StreamServerInfo{FullMethod: method},
+					func(srv any, stream grpc.ServerStream) error { return err },
+				)
+			},
+		},
+		{
+			Name:     "Unary client",
+			Handled:  grpcClientHandled,
+			Duration: grpcClientDuration,
+			Prefix:   "Kinoskop_grpc_client",
+			Call: func(method string, err error) error {
+				return UnaryClientInterceptor(
+					context.Background(), method, nil, nil, nil,
+					func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn,
+						opts ...grpc.CallOption) error {
+						return err
+					},
+				)
+			},
+		},
+		{
+			Name:     "Stream client",
+			Handled:  grpcClientHandled,
+			Duration: grpcClientDuration,
+			Prefix:   "Kinoskop_grpc_client",
+			Call: func(method string, err error) error {
+				_, callErr := StreamClientInterceptor(
+					context.Background(), &grpc.StreamDesc{}, nil, method,
+					func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+						opts ...grpc.CallOption) (grpc.ClientStream, error) {
+						return nil, err
+					},
+				)
+				return callErr
+			},
+		},
+	}
+
+	for _, interceptor := range interceptors {
+		interceptor := interceptor
+		for _, tc := range testCases {
+			tc := tc
+			t.Run(interceptor.Name+"/"+tc.Name, func(t *testing.T) {
+				t.Parallel()
+				reg := prometheus.NewRegistry()
+				reg.MustRegister(interceptor.Handled, interceptor.Duration)
+				// коллекторы общие для всех тестов, поэтому у каждого случая свой метод
+				method := "/test." + interceptor.Name + "/" + tc.Name
+				err := interceptor.Call(method, tc.Err)
+				require.Equal(t, tc.Err, err)
+				require.Equal(t, 1.0, testutil.ToFloat64(interceptor.Handled.WithLabelValues(method, tc.ExpectedCode)))
+				require.Equal(t, uint64(1), sampleCount(t, reg, interceptor.Prefix+"_handling_seconds",
+					map[string]string{"method": method}))
+			})
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"time"
+)
+
+const (
+	JobSuccess = "success"
+	JobError   = "error"
+)
+
+var jobDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: Namespace,
+	Subsystem: "job",
+	Name:      "duration_seconds",
+	Help:      "Время выполнения фоновой задачи по результату: success или error",
+	Buckets:   []float64{0.1, 0.5, 1, 5, 15, 30, 60, 120, 300, 600},
+}, []string{"job", "result"})
+
+// ObserveJob записывает время выполнения фоновой задачи
+func ObserveJob(job string, start time.Time, err error) {
+	result := JobSuccess
+	if err != nil {
+		result = JobError
+	}
+	jobDuration.WithLabelValues(job, result).Observe(time.Since(start).Seconds())
+}
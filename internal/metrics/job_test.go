@@ -0,0 +1,59 @@
+package metrics
+
+import (
+	"errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestObserveJob(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		Job            string
+		Err            error
+		ExpectedResult string
+	}{
+		{
+			Name:           "Успешное выполнение",
+			Job:            "test_job_success",
+			ExpectedResult: JobSuccess,
+		},
+		{
+			Name:           "Ошибка",
+			Job:            "test_job_error",
+			Err:            errors.New("database error"),
+			ExpectedResult: JobError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			reg := prometheus.NewRegistry()
+			reg.MustRegister(jobDuration)
+			ObserveJob(tc.Job, time.Now().Add(-time.Second), tc.Err)
+			families, err := reg.Gather()
+			require.NoError(t, err)
+			require.Len(t, families, 1)
+			for _, metric := range families[0].GetMetric() {
+				labels := make(map[string]string)
+				for _, pair := range metric.GetLabel() {
+					labels[pair.GetName()] = pair.GetValue()
+				}
+				if labels["job"] != tc.Job {
+					continue
+				}
+				require.Equal(t, tc.ExpectedResult, labels["result"])
+				require.Equal(t, uint64(1), metric.GetHistogram().GetSampleCount())
+				require.GreaterOrEqual(t, metric.GetHistogram().GetSampleSum(), 1.0)
+				return
+			}
+			t.Fatal("наблюдение задачи не найдено")
+		})
+	}
+}
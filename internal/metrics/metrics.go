@@ -0,0 +1,80 @@
+package metrics
+
+import (
+	"errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+// Namespace общий префикс для всех метрик сервиса
+const Namespace = "Kinoskop"
+
+var (
+	// UserRegistrations количество успешных регистраций пользователей
+	UserRegistrations = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "user_registrations_total",
+		Help:      "Количество зарегистрированных пользователей",
+	})
+	// ReviewsCreated количество созданных рецензий
+	ReviewsCreated = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "reviews_created_total",
+		Help:      "Количество созданных рецензий",
+	})
+	// ReviewVotes количество оценок рецензий, vote = like | dislike
+	ReviewVotes = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "review_votes_total",
+		Help:      "Количество оценок рецензий",
+	}, []string{"vote"})
+	// StaticUploads количество загруженных файлов
+	StaticUploads = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: Namespace,
+		Name:      "static_uploads_total",
+		Help:      "Количество загруженных файлов",
+	}, []string{"path"})
+	// ProfanityFilterDuration время ответа сервиса фильтрации сообщений
+	ProfanityFilterDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: Namespace,
+		Name:      "profanity_filter_duration_seconds",
+		Help:      "Время фильтрации сообщения сервисом фильтрации",
+		Buckets:   prometheus.DefBuckets,
+	})
+)
+
+// VoteLabel возвращает значение метки для ReviewVotes
+func VoteLabel(vote bool) string {
+	if vote {
+		return "like"
+	}
+	return "dislike"
+}
+
+// ObserveSince записывает в гистограмму время, прошедшее с start
+func ObserveSince(observer prometheus.Observer, start time.Time) {
+	observer.Observe(time.Since(start).Seconds())
+}
+
+// NewServer создает http сервер, отдающий метрики по пути /metrics
+func NewServer(addr string) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	return &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+}
+
+// Run запускает сервер метрик, ошибка запуска не является фатальной для основного сервиса
+func Run(server *http.Server, logger *slog.Logger) {
+	logger.Info("метрики доступны", "addr", server.Addr)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		logger.Error("сервер метрик завершил свою работу", "error", err)
+	}
+}
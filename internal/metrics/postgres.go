@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"time"
+)
+
+var postgresQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: Namespace,
+	Subsystem: "postgres",
+	Name:      "query_duration_seconds",
+	Help:      "Время выполнения метода репозитория Postgres",
+	Buckets:   prometheus.DefBuckets,
+}, []string{"repository", "method"})
+
+// ObservePostgresQuery записывает время выполнения метода репозитория. Используется в виде
+// defer metrics.ObservePostgresQuery("content", "GetContent", time.Now())
+func ObservePostgresQuery(repository, method string, start time.Time) {
+	postgresQueryDuration.WithLabelValues(repository, method).Observe(time.Since(start).Seconds())
+}
+
+// RegisterPostgresPool регистрирует метрики пула соединений. Повторная регистрация для того же
+// имени игнорируется
+func RegisterPostgresPool(db *sql.DB, name string) error {
+	return registerPostgresPool(prometheus.DefaultRegisterer, db, name)
+}
+
+func registerPostgresPool(registerer prometheus.Registerer, db *sql.DB, name string) error {
+	err := registerer.Register(collectors.NewDBStatsCollector(db, name))
+	var alreadyRegistered prometheus.AlreadyRegisteredError
+	if errors.As(err, &alreadyRegistered) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,41 @@
+package metrics
+
+import (
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestObservePostgresQuery(t *testing.T) {
+	t.Parallel()
+
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(postgresQueryDuration)
+	labels := map[string]string{"repository": "test_postgres", "method": "GetContent"}
+	ObservePostgresQuery("test_postgres", "GetContent", time.Now())
+	ObservePostgresQuery("test_postgres", "GetContent", time.Now())
+	require.Equal(t, uint64(2), sampleCount(t, reg, "Kinoskop_postgres_query_duration_seconds", labels))
+}
+
+func TestRegisterPostgresPool(t *testing.T) {
+	t.Parallel()
+
+	db, _, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	reg := prometheus.NewRegistry()
+	require.NoError(t, registerPostgresPool(reg, db, "main"))
+	// повторная регистрация того же пула не считается ошибкой
+	require.NoError(t, registerPostgresPool(reg, db, "main"))
+	count, err := testutil.GatherAndCount(reg, "go_sql_open_connections", "go_sql_max_open_connections")
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	// пул с другим именем регистрируется отдельно
+	require.NoError(t, registerPostgresPool(reg, db, "replica"))
+	count, err = testutil.GatherAndCount(reg, "go_sql_open_connections")
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+}
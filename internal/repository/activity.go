@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_activity.go
+type Activity interface {
+	// AddActivity записывает событие в журнал действий пользователя
+	AddActivity(ctx context.Context, activity *entity.Activity) error
+	// GetFeed возвращает события пользователей, на которых подписан userID, начиная с последних.
+	// События разделов, скрытых авторами от подписчиков, события приватных списков и скрытых модератором
+	// рецензий не возвращаются
+	GetFeed(ctx context.Context, userID, page, limit int) ([]*entity.Activity, error)
+	// GetFeedCount возвращает количество событий в ленте пользователя
+	GetFeedCount(ctx context.Context, userID int) (int, error)
+}
@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_chart.go
+type Chart interface {
+	// GetChart возвращает вычисляемую подборку по ключу
+	// Если подборка не найдена, возвращает ErrChartNotFound
+	GetChart(ctx context.Context, key string) (*entity.Chart, error)
+	// GetChartContent возвращает ID контента подборки на странице page в порядке мест
+	GetChartContent(ctx context.Context, key string, page, limit int) ([]int, error)
+	// GetChartContentLength возвращает количество контента в подборке
+	GetChartContentLength(ctx context.Context, key string) (int, error)
+	// RefreshCharts пересчитывает все вычисляемые подборки и среднюю оценку по сайту в одной транзакции
+	RefreshCharts(ctx context.Context, limits entity.ChartLimits) error
+}
+
+var (
+	ErrChartNotFound = errors.New("подборка не найдена")
+)
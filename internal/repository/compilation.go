@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"errors"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
 )
@@ -9,16 +10,16 @@ import (
 type Compilation interface {
 	// GetCompilation возвращает подборку по ее ID
 	// Если подборка не найдена, возвращает ErrCompilationNotFound
-	GetCompilation(id int) (*entity.Compilation, error)
+	GetCompilation(ctx context.Context, id int) (*entity.Compilation, error)
 	// GetCompilationsByTypeID возвращает подборку по ее ID
-	GetCompilationsByTypeID(compilationTypeID int) ([]*entity.Compilation, error)
+	GetCompilationsByTypeID(ctx context.Context, compilationTypeID int) ([]*entity.Compilation, error)
 	// GetCompilationContentLength возвращает длину контента подборки по ее ID
-	GetCompilationContentLength(id int) (int, error)
+	GetCompilationContentLength(ctx context.Context, id int) (int, error)
 	// GetCompilationContent возвращает подборку по ее ID
 	// Если подборка не найдена, возвращает ErrCompilationNotFound
-	GetCompilationContent(id, page, limit int) ([]int, error)
+	GetCompilationContent(ctx context.Context, id, page, limit int) ([]int, error)
 	// GetAllCompilationTypes возвращает подборку по ее ID
-	GetAllCompilationTypes() ([]entity.CompilationType, error)
+	GetAllCompilationTypes(ctx context.Context) ([]entity.CompilationType, error)
 }
 
 var (
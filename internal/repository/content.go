@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"errors"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
 )
@@ -9,40 +10,49 @@ import (
 type Content interface {
 	// GetContent возвращает контент по его id
 	// Если контент не найден, возвращает ErrContentNotFound
-	GetContent(id int) (*entity.Content, error)
+	GetContent(ctx context.Context, id int) (*entity.Content, error)
 	// GetPreviewContent возвращает контент по его id, но только с минимальным набором полей
 	// Если контент не найден, возвращает ErrContentNotFound
-	GetPreviewContent(id int) (*entity.Content, error)
+	GetPreviewContent(ctx context.Context, id int) (*entity.Content, error)
+	// GetPreviewContents возвращает превью нескольких контентов в том же порядке, что и ids.
+	// Сезоны сериалов не заполняются. Если хотя бы один контент не найден, возвращает ErrContentNotFound
+	GetPreviewContents(ctx context.Context, ids []int) ([]*entity.Content, error)
 	// GetPerson возвращает роли контента
 	// Если контент не найден, возвращает ErrContentNotFound
-	GetPerson(id int) (*entity.Person, error)
+	GetPerson(ctx context.Context, id int) (*entity.Person, error)
 	// GetPersonRoles возвращает роли персоны
-	GetPersonRoles(personID int) ([]entity.PersonRole, error)
-	// GetSimilarContent возвращает похожий контент
-	GetSimilarContent(id int) ([]entity.Content, error)
+	GetPersonRoles(ctx context.Context, personID int) ([]entity.PersonRole, error)
+	// GetSimilarContent возвращает похожий контент, начиная с самого похожего. Похожий контент вычисляется
+	// заранее (см. ContentSimilarity), поэтому только что добавленный контент может его еще не иметь
+	GetSimilarContent(ctx context.Context, id int) ([]entity.Content, error)
+	// GetBestEpisodes возвращает оцененные эпизоды сериала, начиная с эпизодов с наибольшей взвешенной оценкой
+	GetBestEpisodes(ctx context.Context, contentID, limit int) ([]entity.SeasonEpisode, error)
 	// GetNearestOngoings возвращает ближайшие релизы
-	GetNearestOngoings(limit int) ([]int, error)
+	GetNearestOngoings(ctx context.Context, limit int) ([]int, error)
 	// GetOngoingContentByMonthAndYear возвращает релизы по месяцу и году
-	GetOngoingContentByMonthAndYear(month, year int) ([]int, error)
+	GetOngoingContentByMonthAndYear(ctx context.Context, month, year int) ([]int, error)
 	// GetAllOngoingsYears возвращает все года релизов
-	GetAllOngoingsYears() ([]int, error)
+	GetAllOngoingsYears(ctx context.Context) ([]int, error)
 	// IsOngoingContentReleased возвращает true, если контент вышел
 	// Если контент не найден, возвращает ErrContentNotFound
-	IsOngoingContentReleased(contentID int) (bool, error)
+	IsOngoingContentReleased(ctx context.Context, contentID int) (bool, error)
 	// SetReleasedState устанавливает состояние релиза
 	// Если контент не найден, возвращает ErrContentNotFound
-	SetReleasedState(contentID int, isReleased bool) error
+	SetReleasedState(ctx context.Context, contentID int, isReleased bool) error
 	// SubscribeOnContent подписывает пользователя на контент
 	// Если контент не найден, возвращает ErrContentNotFound
 	// Если пользователь не найден, возвращает ErrUserNotFound
-	SubscribeOnContent(userID, contentID int) error
+	SubscribeOnContent(ctx context.Context, userID, contentID int) error
 	// UnsubscribeFromContent отписывает пользователя от контента
 	// Если контент не найден, возвращает ErrContentNotFound
 	// Если пользователь не найден, возвращает ErrUserNotFound
-	UnsubscribeFromContent(userID, contentID int) error
+	UnsubscribeFromContent(ctx context.Context, userID, contentID int) error
 	// GetSubscribedContentIDs возвращает id контентов, на которые подписан пользователь
 	// Если пользователь не найден, возвращает ErrUserNotFound
-	GetSubscribedContentIDs(userID int) ([]int, error)
+	GetSubscribedContentIDs(ctx context.Context, userID int) ([]int, error)
+	// InvalidateContent сбрасывает закэшированные данные контента, например после изменения его рейтинга.
+	// Репозитории без кэша ничего не делают
+	InvalidateContent(ctx context.Context, contentID int) error
 }
 
 var (
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_content_similarity.go
+type ContentSimilarity interface {
+	// RefreshStale пересчитывает похожий контент для не более чем batch контентов из очереди измененных, а также
+	// для контента, у которого они были среди похожих. Для каждого контента сохраняется до limit похожих.
+	// Возвращает, сколько контентов было взято из очереди
+	RefreshStale(ctx context.Context, limit, batch int) (int, error)
+	// Rebuild пересчитывает похожий контент для всего контента и очищает очередь измененных
+	Rebuild(ctx context.Context, limit int) error
+}
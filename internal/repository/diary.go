@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"time"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_diary.go
+type Diary interface {
+	// CreateEntry добавляет запись в дневник. В случае успеха в entry записываются ID, CreatedAt и UpdatedAt
+	// Возможные ошибки:
+	// ErrDiaryContentNotFound - контент с таким id не существует
+	CreateEntry(ctx context.Context, entry *entity.DiaryEntry) (*entity.DiaryEntry, error)
+	// UpdateEntry изменяет дату, оценку, заметку и отметку о повторном просмотре записи пользователя
+	// Возможные ошибки:
+	// ErrDiaryEntryNotFound - у пользователя нет записи с таким id
+	UpdateEntry(ctx context.Context, entry *entity.DiaryEntry) (*entity.DiaryEntry, error)
+	// DeleteEntry удаляет запись пользователя
+	// Возможные ошибки:
+	// ErrDiaryEntryNotFound - у пользователя нет записи с таким id
+	DeleteEntry(ctx context.Context, userID, entryID int) error
+	// GetEntry возвращает запись пользователя
+	// Возможные ошибки:
+	// ErrDiaryEntryNotFound - у пользователя нет записи с таким id
+	GetEntry(ctx context.Context, userID, entryID int) (*entity.DiaryEntry, error)
+	// GetEntriesByPeriod возвращает записи пользователя с датой просмотра в [from, to) в порядке просмотра
+	GetEntriesByPeriod(ctx context.Context, userID int, from, to time.Time) ([]*entity.DiaryEntry, error)
+	// GetEntriesByContent возвращает все просмотры контента пользователем, начиная с последнего
+	GetEntriesByContent(ctx context.Context, userID, contentID int) ([]*entity.DiaryEntry, error)
+}
+
+var (
+	ErrDiaryEntryNotFound   = errors.New("запись дневника не найдена")
+	ErrDiaryContentNotFound = errors.New("контент не найден")
+)
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"errors"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
 )
@@ -10,15 +11,18 @@ type Favourite interface {
 	// CreateFavourite добавление в избранное. Если уже в избранном, то ошибка не возвращается (идемпотентный метод).
 	// Возможные ошибки:
 	// ErrFavouriteContentNotFound - контент не найден
-	CreateFavourite(userID, contentID int, category string) error
+	CreateFavourite(ctx context.Context, userID, contentID int, category string) error
 	// DeleteFavourite удаление из избранного.
 	// Возвращает ошибку ErrFavouriteNotFound, если контент не найден в избранном
-	DeleteFavourite(userID, contentID int) error
+	DeleteFavourite(ctx context.Context, userID, contentID int) error
 	// GetFavourites получение избранного контента пользователя.
 	// Возвращает ошибку ErrFavouriteUserNotFound, если пользователь не найден
-	GetFavourites(userID int) ([]*entity.Favourite, error)
+	GetFavourites(ctx context.Context, userID int) ([]*entity.Favourite, error)
 	// GetFavourite получение статуса контента в избранном
-	GetFavourite(userID, contentID int) (*entity.Favourite, error)
+	GetFavourite(ctx context.Context, userID, contentID int) (*entity.Favourite, error)
+	// GetExportItems возвращает до limit записей истории пользователя: избранное, оценки и рецензии,
+	// сгруппированные по контенту. Записи упорядочены по ID контента и начинаются после afterContentID
+	GetExportItems(ctx context.Context, userID, afterContentID, limit int) ([]*entity.ExportItem, error)
 }
 
 var (
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+	"errors"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_follow.go
+type Follow interface {
+	// Follow подписывает followerID на followeeID. Повторная подписка не считается ошибкой
+	// Возможные ошибки:
+	// ErrFollowUserNotFound - пользователь не найден
+	Follow(ctx context.Context, followerID, followeeID int) error
+	// Unfollow отменяет подписку followerID на followeeID
+	// Возможные ошибки:
+	// ErrFollowNotFound - подписки нет
+	Unfollow(ctx context.Context, followerID, followeeID int) error
+	// IsFollowing проверяет, подписан ли followerID на followeeID
+	IsFollowing(ctx context.Context, followerID, followeeID int) (bool, error)
+	// GetFollowers возвращает ID подписчиков пользователя, начиная с последних подписавшихся
+	GetFollowers(ctx context.Context, userID, page, limit int) ([]int, error)
+	// GetFollowersCount возвращает количество подписчиков пользователя
+	GetFollowersCount(ctx context.Context, userID int) (int, error)
+	// GetFollowing возвращает ID пользователей, на которых подписан пользователь, начиная с последних подписок
+	GetFollowing(ctx context.Context, userID, page, limit int) ([]int, error)
+	// GetFollowingCount возвращает количество подписок пользователя
+	GetFollowingCount(ctx context.Context, userID int) (int, error)
+}
+
+var (
+	ErrFollowUserNotFound = errors.New("пользователь не найден")
+	ErrFollowNotFound     = errors.New("подписка не найдена")
+)
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_import.go
+type Import interface {
+	// CreateJob создает задачу импорта и возвращает ее с заполненными ID, статусом и временем создания
+	CreateJob(ctx context.Context, job *entity.ImportJob) (*entity.ImportJob, error)
+	// UpdateJob сохраняет статус, количество строк и ошибку задачи импорта
+	// Возможные ошибки:
+	// ErrImportJobNotFound - задача не найдена
+	UpdateJob(ctx context.Context, job *entity.ImportJob) error
+	// FailUnfinishedJobs помечает неудавшимися все задачи в статусах pending и running и записывает в них ошибку
+	// errMessage. Возвращает количество таких задач
+	FailUnfinishedJobs(ctx context.Context, errMessage string) (int64, error)
+	// GetJob возвращает задачу импорта
+	// Возможные ошибки:
+	// ErrImportJobNotFound - задача не найдена
+	GetJob(ctx context.Context, jobID int) (*entity.ImportJob, error)
+	// AddUnmatchedRows сохраняет строки, для которых не нашелся контент
+	AddUnmatchedRows(ctx context.Context, rows []*entity.ImportRow) error
+	// GetUnmatchedRows возвращает несопоставленные строки задачи по порядку, включая уже сопоставленные вручную
+	GetUnmatchedRows(ctx context.Context, jobID int) ([]*entity.ImportRow, error)
+	// GetUnmatchedRow возвращает несопоставленную строку задачи
+	// Возможные ошибки:
+	// ErrImportRowNotFound - строка не найдена
+	GetUnmatchedRow(ctx context.Context, jobID, rowNumber int) (*entity.ImportRow, error)
+	// ResolveRow записывает в строку контент, выбранный пользователем
+	// Возможные ошибки:
+	// ErrImportRowNotFound - строка не найдена
+	// ErrImportContentNotFound - контент не найден
+	ResolveRow(ctx context.Context, jobID, rowNumber, contentID int) error
+	// FindContent ищет контент для строки файла: сначала по ID Кинопоиска, затем по названию или оригинальному
+	// названию без учета регистра и году выхода. Возвращает ID контента и признак того, что он еще не вышел
+	// Возможные ошибки:
+	// ErrImportContentNotFound - контент не найден или под строку подходит несколько вариантов
+	FindContent(ctx context.Context, row *entity.ImportRow) (contentID int, ongoing bool, err error)
+}
+
+var (
+	ErrImportJobNotFound     = errors.New("задача импорта не найдена")
+	ErrImportRowNotFound     = errors.New("строка импорта не найдена")
+	ErrImportContentNotFound = errors.New("контент не найден")
+)
@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: activity.go
+//
+// Generated by this command:
+//
+//	mockgen -source=activity.go -destination=mocks/mock_activity.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockActivity is a mock of Activity interface.
+type MockActivity struct {
+	ctrl     *gomock.Controller
+	recorder *MockActivityMockRecorder
+}
+
+// MockActivityMockRecorder is the mock recorder for MockActivity.
+type MockActivityMockRecorder struct {
+	mock *MockActivity
+}
+
+// NewMockActivity creates a new mock instance.
+func NewMockActivity(ctrl *gomock.Controller) *MockActivity {
+	mock := &MockActivity{ctrl: ctrl}
+	mock.recorder = &MockActivityMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockActivity) EXPECT() *MockActivityMockRecorder {
+	return m.recorder
+}
+
+// AddActivity mocks base method.
+func (m *MockActivity) AddActivity(ctx context.Context, activity *entity.Activity) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddActivity", ctx, activity)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddActivity indicates an expected call of AddActivity.
+func (mr *MockActivityMockRecorder) AddActivity(ctx, activity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddActivity", reflect.TypeOf((*MockActivity)(nil).AddActivity), ctx, activity)
+}
+
+// GetFeed mocks base method.
+func (m *MockActivity) GetFeed(ctx context.Context, userID, page, limit int) ([]*entity.Activity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeed", ctx, userID, page, limit)
+	ret0, _ := ret[0].([]*entity.Activity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeed indicates an expected call of GetFeed.
+func (mr *MockActivityMockRecorder) GetFeed(ctx, userID, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeed", reflect.TypeOf((*MockActivity)(nil).GetFeed), ctx, userID, page, limit)
+}
+
+// GetFeedCount mocks base method.
+func (m *MockActivity) GetFeedCount(ctx context.Context, userID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFeedCount", ctx, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFeedCount indicates an expected call of GetFeedCount.
+func (mr *MockActivityMockRecorder) GetFeedCount(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFeedCount", reflect.TypeOf((*MockActivity)(nil).GetFeedCount), ctx, userID)
+}
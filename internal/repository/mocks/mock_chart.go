@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: chart.go
+//
+// Generated by this command:
+//
+//	mockgen -source=chart.go -destination=mocks/mock_chart.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockChart is a mock of Chart interface.
+type MockChart struct {
+	ctrl     *gomock.Controller
+	recorder *MockChartMockRecorder
+}
+
+// MockChartMockRecorder is the mock recorder for MockChart.
+type MockChartMockRecorder struct {
+	mock *MockChart
+}
+
+// NewMockChart creates a new mock instance.
+func NewMockChart(ctrl *gomock.Controller) *MockChart {
+	mock := &MockChart{ctrl: ctrl}
+	mock.recorder = &MockChartMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChart) EXPECT() *MockChartMockRecorder {
+	return m.recorder
+}
+
+// GetChart mocks base method.
+func (m *MockChart) GetChart(ctx context.Context, key string) (*entity.Chart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChart", ctx, key)
+	ret0, _ := ret[0].(*entity.Chart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChart indicates an expected call of GetChart.
+func (mr *MockChartMockRecorder) GetChart(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChart", reflect.TypeOf((*MockChart)(nil).GetChart), ctx, key)
+}
+
+// GetChartContent mocks base method.
+func (m *MockChart) GetChartContent(ctx context.Context, key string, page, limit int) ([]int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChartContent", ctx, key, page, limit)
+	ret0, _ := ret[0].([]int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChartContent indicates an expected call of GetChartContent.
+func (mr *MockChartMockRecorder) GetChartContent(ctx, key, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChartContent", reflect.TypeOf((*MockChart)(nil).GetChartContent), ctx, key, page, limit)
+}
+
+// GetChartContentLength mocks base method.
+func (m *MockChart) GetChartContentLength(ctx context.Context, key string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChartContentLength", ctx, key)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChartContentLength indicates an expected call of GetChartContentLength.
+func (mr *MockChartMockRecorder) GetChartContentLength(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChartContentLength", reflect.TypeOf((*MockChart)(nil).GetChartContentLength), ctx, key)
+}
+
+// RefreshCharts mocks base method.
+func (m *MockChart) RefreshCharts(ctx context.Context, limits entity.ChartLimits) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshCharts", ctx, limits)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshCharts indicates an expected call of RefreshCharts.
+func (mr *MockChartMockRecorder) RefreshCharts(ctx, limits any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshCharts", reflect.TypeOf((*MockChart)(nil).RefreshCharts), ctx, limits)
+}
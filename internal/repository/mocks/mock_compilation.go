@@ -10,6 +10,7 @@
 package mock_repository
 
 import (
+	context "context"
 	reflect "reflect"
 
 	entity "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
@@ -40,76 +41,76 @@ func (m *MockCompilation) EXPECT() *MockCompilationMockRecorder {
 }
 
 // GetAllCompilationTypes mocks base method.
-func (m *MockCompilation) GetAllCompilationTypes() ([]entity.CompilationType, error) {
+func (m *MockCompilation) GetAllCompilationTypes(ctx context.Context) ([]entity.CompilationType, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllCompilationTypes")
+	ret := m.ctrl.Call(m, "GetAllCompilationTypes", ctx)
 	ret0, _ := ret[0].([]entity.CompilationType)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllCompilationTypes indicates an expected call of GetAllCompilationTypes.
-func (mr *MockCompilationMockRecorder) GetAllCompilationTypes() *gomock.Call {
+func (mr *MockCompilationMockRecorder) GetAllCompilationTypes(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllCompilationTypes", reflect.TypeOf((*MockCompilation)(nil).GetAllCompilationTypes))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllCompilationTypes", reflect.TypeOf((*MockCompilation)(nil).GetAllCompilationTypes), ctx)
 }
 
 // GetCompilation mocks base method.
-func (m *MockCompilation) GetCompilation(id int) (*entity.Compilation, error) {
+func (m *MockCompilation) GetCompilation(ctx context.Context, id int) (*entity.Compilation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCompilation", id)
+	ret := m.ctrl.Call(m, "GetCompilation", ctx, id)
 	ret0, _ := ret[0].(*entity.Compilation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCompilation indicates an expected call of GetCompilation.
-func (mr *MockCompilationMockRecorder) GetCompilation(id any) *gomock.Call {
+func (mr *MockCompilationMockRecorder) GetCompilation(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompilation", reflect.TypeOf((*MockCompilation)(nil).GetCompilation), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompilation", reflect.TypeOf((*MockCompilation)(nil).GetCompilation), ctx, id)
 }
 
 // GetCompilationContent mocks base method.
-func (m *MockCompilation) GetCompilationContent(id, page, limit int) ([]int, error) {
+func (m *MockCompilation) GetCompilationContent(ctx context.Context, id, page, limit int) ([]int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCompilationContent", id, page, limit)
+	ret := m.ctrl.Call(m, "GetCompilationContent", ctx, id, page, limit)
 	ret0, _ := ret[0].([]int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCompilationContent indicates an expected call of GetCompilationContent.
-func (mr *MockCompilationMockRecorder) GetCompilationContent(id, page, limit any) *gomock.Call {
+func (mr *MockCompilationMockRecorder) GetCompilationContent(ctx, id, page, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompilationContent", reflect.TypeOf((*MockCompilation)(nil).GetCompilationContent), id, page, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompilationContent", reflect.TypeOf((*MockCompilation)(nil).GetCompilationContent), ctx, id, page, limit)
 }
 
 // GetCompilationContentLength mocks base method.
-func (m *MockCompilation) GetCompilationContentLength(id int) (int, error) {
+func (m *MockCompilation) GetCompilationContentLength(ctx context.Context, id int) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCompilationContentLength", id)
+	ret := m.ctrl.Call(m, "GetCompilationContentLength", ctx, id)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCompilationContentLength indicates an expected call of GetCompilationContentLength.
-func (mr *MockCompilationMockRecorder) GetCompilationContentLength(id any) *gomock.Call {
+func (mr *MockCompilationMockRecorder) GetCompilationContentLength(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompilationContentLength", reflect.TypeOf((*MockCompilation)(nil).GetCompilationContentLength), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompilationContentLength", reflect.TypeOf((*MockCompilation)(nil).GetCompilationContentLength), ctx, id)
 }
 
 // GetCompilationsByTypeID mocks base method.
-func (m *MockCompilation) GetCompilationsByTypeID(compilationTypeID int) ([]*entity.Compilation, error) {
+func (m *MockCompilation) GetCompilationsByTypeID(ctx context.Context, compilationTypeID int) ([]*entity.Compilation, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCompilationsByTypeID", compilationTypeID)
+	ret := m.ctrl.Call(m, "GetCompilationsByTypeID", ctx, compilationTypeID)
 	ret0, _ := ret[0].([]*entity.Compilation)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCompilationsByTypeID indicates an expected call of GetCompilationsByTypeID.
-func (mr *MockCompilationMockRecorder) GetCompilationsByTypeID(compilationTypeID any) *gomock.Call {
+func (mr *MockCompilationMockRecorder) GetCompilationsByTypeID(ctx, compilationTypeID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompilationsByTypeID", reflect.TypeOf((*MockCompilation)(nil).GetCompilationsByTypeID), compilationTypeID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompilationsByTypeID", reflect.TypeOf((*MockCompilation)(nil).GetCompilationsByTypeID), ctx, compilationTypeID)
 }
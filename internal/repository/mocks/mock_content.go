@@ -10,6 +10,7 @@
 package mock_repository
 
 import (
+	context "context"
 	reflect "reflect"
 
 	entity "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
@@ -40,193 +41,237 @@ func (m *MockContent) EXPECT() *MockContentMockRecorder {
 }
 
 // GetAllOngoingsYears mocks base method.
-func (m *MockContent) GetAllOngoingsYears() ([]int, error) {
+func (m *MockContent) GetAllOngoingsYears(ctx context.Context) ([]int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllOngoingsYears")
+	ret := m.ctrl.Call(m, "GetAllOngoingsYears", ctx)
 	ret0, _ := ret[0].([]int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllOngoingsYears indicates an expected call of GetAllOngoingsYears.
-func (mr *MockContentMockRecorder) GetAllOngoingsYears() *gomock.Call {
+func (mr *MockContentMockRecorder) GetAllOngoingsYears(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllOngoingsYears", reflect.TypeOf((*MockContent)(nil).GetAllOngoingsYears))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllOngoingsYears", reflect.TypeOf((*MockContent)(nil).GetAllOngoingsYears), ctx)
+}
+
+// GetBestEpisodes mocks base method.
+func (m *MockContent) GetBestEpisodes(ctx context.Context, contentID, limit int) ([]entity.SeasonEpisode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBestEpisodes", ctx, contentID, limit)
+	ret0, _ := ret[0].([]entity.SeasonEpisode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBestEpisodes indicates an expected call of GetBestEpisodes.
+func (mr *MockContentMockRecorder) GetBestEpisodes(ctx, contentID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBestEpisodes", reflect.TypeOf((*MockContent)(nil).GetBestEpisodes), ctx, contentID, limit)
 }
 
 // GetContent mocks base method.
-func (m *MockContent) GetContent(id int) (*entity.Content, error) {
+func (m *MockContent) GetContent(ctx context.Context, id int) (*entity.Content, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetContent", id)
+	ret := m.ctrl.Call(m, "GetContent", ctx, id)
 	ret0, _ := ret[0].(*entity.Content)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetContent indicates an expected call of GetContent.
-func (mr *MockContentMockRecorder) GetContent(id any) *gomock.Call {
+func (mr *MockContentMockRecorder) GetContent(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContent", reflect.TypeOf((*MockContent)(nil).GetContent), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContent", reflect.TypeOf((*MockContent)(nil).GetContent), ctx, id)
 }
 
 // GetNearestOngoings mocks base method.
-func (m *MockContent) GetNearestOngoings(limit int) ([]int, error) {
+func (m *MockContent) GetNearestOngoings(ctx context.Context, limit int) ([]int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNearestOngoings", limit)
+	ret := m.ctrl.Call(m, "GetNearestOngoings", ctx, limit)
 	ret0, _ := ret[0].([]int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetNearestOngoings indicates an expected call of GetNearestOngoings.
-func (mr *MockContentMockRecorder) GetNearestOngoings(limit any) *gomock.Call {
+func (mr *MockContentMockRecorder) GetNearestOngoings(ctx, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNearestOngoings", reflect.TypeOf((*MockContent)(nil).GetNearestOngoings), limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNearestOngoings", reflect.TypeOf((*MockContent)(nil).GetNearestOngoings), ctx, limit)
 }
 
 // GetOngoingContentByMonthAndYear mocks base method.
-func (m *MockContent) GetOngoingContentByMonthAndYear(month, year int) ([]int, error) {
+func (m *MockContent) GetOngoingContentByMonthAndYear(ctx context.Context, month, year int) ([]int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOngoingContentByMonthAndYear", month, year)
+	ret := m.ctrl.Call(m, "GetOngoingContentByMonthAndYear", ctx, month, year)
 	ret0, _ := ret[0].([]int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOngoingContentByMonthAndYear indicates an expected call of GetOngoingContentByMonthAndYear.
-func (mr *MockContentMockRecorder) GetOngoingContentByMonthAndYear(month, year any) *gomock.Call {
+func (mr *MockContentMockRecorder) GetOngoingContentByMonthAndYear(ctx, month, year any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOngoingContentByMonthAndYear", reflect.TypeOf((*MockContent)(nil).GetOngoingContentByMonthAndYear), month, year)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOngoingContentByMonthAndYear", reflect.TypeOf((*MockContent)(nil).GetOngoingContentByMonthAndYear), ctx, month, year)
 }
 
 // GetPerson mocks base method.
-func (m *MockContent) GetPerson(id int) (*entity.Person, error) {
+func (m *MockContent) GetPerson(ctx context.Context, id int) (*entity.Person, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPerson", id)
+	ret := m.ctrl.Call(m, "GetPerson", ctx, id)
 	ret0, _ := ret[0].(*entity.Person)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPerson indicates an expected call of GetPerson.
-func (mr *MockContentMockRecorder) GetPerson(id any) *gomock.Call {
+func (mr *MockContentMockRecorder) GetPerson(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPerson", reflect.TypeOf((*MockContent)(nil).GetPerson), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPerson", reflect.TypeOf((*MockContent)(nil).GetPerson), ctx, id)
 }
 
 // GetPersonRoles mocks base method.
-func (m *MockContent) GetPersonRoles(personID int) ([]entity.PersonRole, error) {
+func (m *MockContent) GetPersonRoles(ctx context.Context, personID int) ([]entity.PersonRole, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPersonRoles", personID)
+	ret := m.ctrl.Call(m, "GetPersonRoles", ctx, personID)
 	ret0, _ := ret[0].([]entity.PersonRole)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPersonRoles indicates an expected call of GetPersonRoles.
-func (mr *MockContentMockRecorder) GetPersonRoles(personID any) *gomock.Call {
+func (mr *MockContentMockRecorder) GetPersonRoles(ctx, personID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonRoles", reflect.TypeOf((*MockContent)(nil).GetPersonRoles), personID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonRoles", reflect.TypeOf((*MockContent)(nil).GetPersonRoles), ctx, personID)
 }
 
 // GetPreviewContent mocks base method.
-func (m *MockContent) GetPreviewContent(id int) (*entity.Content, error) {
+func (m *MockContent) GetPreviewContent(ctx context.Context, id int) (*entity.Content, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPreviewContent", id)
+	ret := m.ctrl.Call(m, "GetPreviewContent", ctx, id)
 	ret0, _ := ret[0].(*entity.Content)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPreviewContent indicates an expected call of GetPreviewContent.
-func (mr *MockContentMockRecorder) GetPreviewContent(id any) *gomock.Call {
+func (mr *MockContentMockRecorder) GetPreviewContent(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreviewContent", reflect.TypeOf((*MockContent)(nil).GetPreviewContent), ctx, id)
+}
+
+// GetPreviewContents mocks base method.
+func (m *MockContent) GetPreviewContents(ctx context.Context, ids []int) ([]*entity.Content, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreviewContents", ctx, ids)
+	ret0, _ := ret[0].([]*entity.Content)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreviewContents indicates an expected call of GetPreviewContents.
+func (mr *MockContentMockRecorder) GetPreviewContents(ctx, ids any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreviewContent", reflect.TypeOf((*MockContent)(nil).GetPreviewContent), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreviewContents", reflect.TypeOf((*MockContent)(nil).GetPreviewContents), ctx, ids)
 }
 
 // GetSimilarContent mocks base method.
-func (m *MockContent) GetSimilarContent(id int) ([]entity.Content, error) {
+func (m *MockContent) GetSimilarContent(ctx context.Context, id int) ([]entity.Content, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSimilarContent", id)
+	ret := m.ctrl.Call(m, "GetSimilarContent", ctx, id)
 	ret0, _ := ret[0].([]entity.Content)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSimilarContent indicates an expected call of GetSimilarContent.
-func (mr *MockContentMockRecorder) GetSimilarContent(id any) *gomock.Call {
+func (mr *MockContentMockRecorder) GetSimilarContent(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSimilarContent", reflect.TypeOf((*MockContent)(nil).GetSimilarContent), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSimilarContent", reflect.TypeOf((*MockContent)(nil).GetSimilarContent), ctx, id)
 }
 
 // GetSubscribedContentIDs mocks base method.
-func (m *MockContent) GetSubscribedContentIDs(userID int) ([]int, error) {
+func (m *MockContent) GetSubscribedContentIDs(ctx context.Context, userID int) ([]int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSubscribedContentIDs", userID)
+	ret := m.ctrl.Call(m, "GetSubscribedContentIDs", ctx, userID)
 	ret0, _ := ret[0].([]int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSubscribedContentIDs indicates an expected call of GetSubscribedContentIDs.
-func (mr *MockContentMockRecorder) GetSubscribedContentIDs(userID any) *gomock.Call {
+func (mr *MockContentMockRecorder) GetSubscribedContentIDs(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscribedContentIDs", reflect.TypeOf((*MockContent)(nil).GetSubscribedContentIDs), ctx, userID)
+}
+
+// InvalidateContent mocks base method.
+func (m *MockContent) InvalidateContent(ctx context.Context, contentID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InvalidateContent", ctx, contentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvalidateContent indicates an expected call of InvalidateContent.
+func (mr *MockContentMockRecorder) InvalidateContent(ctx, contentID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscribedContentIDs", reflect.TypeOf((*MockContent)(nil).GetSubscribedContentIDs), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateContent", reflect.TypeOf((*MockContent)(nil).InvalidateContent), ctx, contentID)
 }
 
 // IsOngoingContentReleased mocks base method.
-func (m *MockContent) IsOngoingContentReleased(contentID int) (bool, error) {
+func (m *MockContent) IsOngoingContentReleased(ctx context.Context, contentID int) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsOngoingContentReleased", contentID)
+	ret := m.ctrl.Call(m, "IsOngoingContentReleased", ctx, contentID)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // IsOngoingContentReleased indicates an expected call of IsOngoingContentReleased.
-func (mr *MockContentMockRecorder) IsOngoingContentReleased(contentID any) *gomock.Call {
+func (mr *MockContentMockRecorder) IsOngoingContentReleased(ctx, contentID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOngoingContentReleased", reflect.TypeOf((*MockContent)(nil).IsOngoingContentReleased), contentID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOngoingContentReleased", reflect.TypeOf((*MockContent)(nil).IsOngoingContentReleased), ctx, contentID)
 }
 
 // SetReleasedState mocks base method.
-func (m *MockContent) SetReleasedState(contentID int, isReleased bool) error {
+func (m *MockContent) SetReleasedState(ctx context.Context, contentID int, isReleased bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetReleasedState", contentID, isReleased)
+	ret := m.ctrl.Call(m, "SetReleasedState", ctx, contentID, isReleased)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SetReleasedState indicates an expected call of SetReleasedState.
-func (mr *MockContentMockRecorder) SetReleasedState(contentID, isReleased any) *gomock.Call {
+func (mr *MockContentMockRecorder) SetReleasedState(ctx, contentID, isReleased any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReleasedState", reflect.TypeOf((*MockContent)(nil).SetReleasedState), contentID, isReleased)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReleasedState", reflect.TypeOf((*MockContent)(nil).SetReleasedState), ctx, contentID, isReleased)
 }
 
 // SubscribeOnContent mocks base method.
-func (m *MockContent) SubscribeOnContent(userID, contentID int) error {
+func (m *MockContent) SubscribeOnContent(ctx context.Context, userID, contentID int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SubscribeOnContent", userID, contentID)
+	ret := m.ctrl.Call(m, "SubscribeOnContent", ctx, userID, contentID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SubscribeOnContent indicates an expected call of SubscribeOnContent.
-func (mr *MockContentMockRecorder) SubscribeOnContent(userID, contentID any) *gomock.Call {
+func (mr *MockContentMockRecorder) SubscribeOnContent(ctx, userID, contentID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeOnContent", reflect.TypeOf((*MockContent)(nil).SubscribeOnContent), userID, contentID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeOnContent", reflect.TypeOf((*MockContent)(nil).SubscribeOnContent), ctx, userID, contentID)
 }
 
 // UnsubscribeFromContent mocks base method.
-func (m *MockContent) UnsubscribeFromContent(userID, contentID int) error {
+func (m *MockContent) UnsubscribeFromContent(ctx context.Context, userID, contentID int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UnsubscribeFromContent", userID, contentID)
+	ret := m.ctrl.Call(m, "UnsubscribeFromContent", ctx, userID, contentID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UnsubscribeFromContent indicates an expected call of UnsubscribeFromContent.
-func (mr *MockContentMockRecorder) UnsubscribeFromContent(userID, contentID any) *gomock.Call {
+func (mr *MockContentMockRecorder) UnsubscribeFromContent(ctx, userID, contentID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeFromContent", reflect.TypeOf((*MockContent)(nil).UnsubscribeFromContent), userID, contentID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeFromContent", reflect.TypeOf((*MockContent)(nil).UnsubscribeFromContent), ctx, userID, contentID)
 }
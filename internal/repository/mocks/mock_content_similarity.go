@@ -0,0 +1,69 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: content_similarity.go
+//
+// Generated by this command:
+//
+//	mockgen -source=content_similarity.go -destination=mocks/mock_content_similarity.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockContentSimilarity is a mock of ContentSimilarity interface.
+type MockContentSimilarity struct {
+	ctrl     *gomock.Controller
+	recorder *MockContentSimilarityMockRecorder
+}
+
+// MockContentSimilarityMockRecorder is the mock recorder for MockContentSimilarity.
+type MockContentSimilarityMockRecorder struct {
+	mock *MockContentSimilarity
+}
+
+// NewMockContentSimilarity creates a new mock instance.
+func NewMockContentSimilarity(ctrl *gomock.Controller) *MockContentSimilarity {
+	mock := &MockContentSimilarity{ctrl: ctrl}
+	mock.recorder = &MockContentSimilarityMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContentSimilarity) EXPECT() *MockContentSimilarityMockRecorder {
+	return m.recorder
+}
+
+// Rebuild mocks base method.
+func (m *MockContentSimilarity) Rebuild(ctx context.Context, limit int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rebuild", ctx, limit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Rebuild indicates an expected call of Rebuild.
+func (mr *MockContentSimilarityMockRecorder) Rebuild(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rebuild", reflect.TypeOf((*MockContentSimilarity)(nil).Rebuild), ctx, limit)
+}
+
+// RefreshStale mocks base method.
+func (m *MockContentSimilarity) RefreshStale(ctx context.Context, limit, batch int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshStale", ctx, limit, batch)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefreshStale indicates an expected call of RefreshStale.
+func (mr *MockContentSimilarityMockRecorder) RefreshStale(ctx, limit, batch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshStale", reflect.TypeOf((*MockContentSimilarity)(nil).RefreshStale), ctx, limit, batch)
+}
@@ -0,0 +1,131 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: diary.go
+//
+// Generated by this command:
+//
+//	mockgen -source=diary.go -destination=mocks/mock_diary.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDiary is a mock of Diary interface.
+type MockDiary struct {
+	ctrl     *gomock.Controller
+	recorder *MockDiaryMockRecorder
+}
+
+// MockDiaryMockRecorder is the mock recorder for MockDiary.
+type MockDiaryMockRecorder struct {
+	mock *MockDiary
+}
+
+// NewMockDiary creates a new mock instance.
+func NewMockDiary(ctrl *gomock.Controller) *MockDiary {
+	mock := &MockDiary{ctrl: ctrl}
+	mock.recorder = &MockDiaryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDiary) EXPECT() *MockDiaryMockRecorder {
+	return m.recorder
+}
+
+// CreateEntry mocks base method.
+func (m *MockDiary) CreateEntry(ctx context.Context, entry *entity.DiaryEntry) (*entity.DiaryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEntry", ctx, entry)
+	ret0, _ := ret[0].(*entity.DiaryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEntry indicates an expected call of CreateEntry.
+func (mr *MockDiaryMockRecorder) CreateEntry(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntry", reflect.TypeOf((*MockDiary)(nil).CreateEntry), ctx, entry)
+}
+
+// DeleteEntry mocks base method.
+func (m *MockDiary) DeleteEntry(ctx context.Context, userID, entryID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEntry", ctx, userID, entryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEntry indicates an expected call of DeleteEntry.
+func (mr *MockDiaryMockRecorder) DeleteEntry(ctx, userID, entryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEntry", reflect.TypeOf((*MockDiary)(nil).DeleteEntry), ctx, userID, entryID)
+}
+
+// GetEntriesByContent mocks base method.
+func (m *MockDiary) GetEntriesByContent(ctx context.Context, userID, contentID int) ([]*entity.DiaryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntriesByContent", ctx, userID, contentID)
+	ret0, _ := ret[0].([]*entity.DiaryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntriesByContent indicates an expected call of GetEntriesByContent.
+func (mr *MockDiaryMockRecorder) GetEntriesByContent(ctx, userID, contentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntriesByContent", reflect.TypeOf((*MockDiary)(nil).GetEntriesByContent), ctx, userID, contentID)
+}
+
+// GetEntriesByPeriod mocks base method.
+func (m *MockDiary) GetEntriesByPeriod(ctx context.Context, userID int, from, to time.Time) ([]*entity.DiaryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntriesByPeriod", ctx, userID, from, to)
+	ret0, _ := ret[0].([]*entity.DiaryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntriesByPeriod indicates an expected call of GetEntriesByPeriod.
+func (mr *MockDiaryMockRecorder) GetEntriesByPeriod(ctx, userID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntriesByPeriod", reflect.TypeOf((*MockDiary)(nil).GetEntriesByPeriod), ctx, userID, from, to)
+}
+
+// GetEntry mocks base method.
+func (m *MockDiary) GetEntry(ctx context.Context, userID, entryID int) (*entity.DiaryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntry", ctx, userID, entryID)
+	ret0, _ := ret[0].(*entity.DiaryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntry indicates an expected call of GetEntry.
+func (mr *MockDiaryMockRecorder) GetEntry(ctx, userID, entryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntry", reflect.TypeOf((*MockDiary)(nil).GetEntry), ctx, userID, entryID)
+}
+
+// UpdateEntry mocks base method.
+func (m *MockDiary) UpdateEntry(ctx context.Context, entry *entity.DiaryEntry) (*entity.DiaryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEntry", ctx, entry)
+	ret0, _ := ret[0].(*entity.DiaryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEntry indicates an expected call of UpdateEntry.
+func (mr *MockDiaryMockRecorder) UpdateEntry(ctx, entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEntry", reflect.TypeOf((*MockDiary)(nil).UpdateEntry), ctx, entry)
+}
@@ -10,6 +10,7 @@
 package mock_repository
 
 import (
+	context "context"
 	reflect "reflect"
 
 	entity "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
@@ -40,59 +41,74 @@ func (m *MockFavourite) EXPECT() *MockFavouriteMockRecorder {
 }
 
 // CreateFavourite mocks base method.
-func (m *MockFavourite) CreateFavourite(userID, contentID int, category string) error {
+func (m *MockFavourite) CreateFavourite(ctx context.Context, userID, contentID int, category string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateFavourite", userID, contentID, category)
+	ret := m.ctrl.Call(m, "CreateFavourite", ctx, userID, contentID, category)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateFavourite indicates an expected call of CreateFavourite.
-func (mr *MockFavouriteMockRecorder) CreateFavourite(userID, contentID, category any) *gomock.Call {
+func (mr *MockFavouriteMockRecorder) CreateFavourite(ctx, userID, contentID, category any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFavourite", reflect.TypeOf((*MockFavourite)(nil).CreateFavourite), userID, contentID, category)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFavourite", reflect.TypeOf((*MockFavourite)(nil).CreateFavourite), ctx, userID, contentID, category)
 }
 
 // DeleteFavourite mocks base method.
-func (m *MockFavourite) DeleteFavourite(userID, contentID int) error {
+func (m *MockFavourite) DeleteFavourite(ctx context.Context, userID, contentID int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteFavourite", userID, contentID)
+	ret := m.ctrl.Call(m, "DeleteFavourite", ctx, userID, contentID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteFavourite indicates an expected call of DeleteFavourite.
-func (mr *MockFavouriteMockRecorder) DeleteFavourite(userID, contentID any) *gomock.Call {
+func (mr *MockFavouriteMockRecorder) DeleteFavourite(ctx, userID, contentID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFavourite", reflect.TypeOf((*MockFavourite)(nil).DeleteFavourite), userID, contentID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFavourite", reflect.TypeOf((*MockFavourite)(nil).DeleteFavourite), ctx, userID, contentID)
+}
+
+// GetExportItems mocks base method.
+func (m *MockFavourite) GetExportItems(ctx context.Context, userID, afterContentID, limit int) ([]*entity.ExportItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExportItems", ctx, userID, afterContentID, limit)
+	ret0, _ := ret[0].([]*entity.ExportItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExportItems indicates an expected call of GetExportItems.
+func (mr *MockFavouriteMockRecorder) GetExportItems(ctx, userID, afterContentID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExportItems", reflect.TypeOf((*MockFavourite)(nil).GetExportItems), ctx, userID, afterContentID, limit)
 }
 
 // GetFavourite mocks base method.
-func (m *MockFavourite) GetFavourite(userID, contentID int) (*entity.Favourite, error) {
+func (m *MockFavourite) GetFavourite(ctx context.Context, userID, contentID int) (*entity.Favourite, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFavourite", userID, contentID)
+	ret := m.ctrl.Call(m, "GetFavourite", ctx, userID, contentID)
 	ret0, _ := ret[0].(*entity.Favourite)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetFavourite indicates an expected call of GetFavourite.
-func (mr *MockFavouriteMockRecorder) GetFavourite(userID, contentID any) *gomock.Call {
+func (mr *MockFavouriteMockRecorder) GetFavourite(ctx, userID, contentID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavourite", reflect.TypeOf((*MockFavourite)(nil).GetFavourite), userID, contentID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavourite", reflect.TypeOf((*MockFavourite)(nil).GetFavourite), ctx, userID, contentID)
 }
 
 // GetFavourites mocks base method.
-func (m *MockFavourite) GetFavourites(userID int) ([]*entity.Favourite, error) {
+func (m *MockFavourite) GetFavourites(ctx context.Context, userID int) ([]*entity.Favourite, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFavourites", userID)
+	ret := m.ctrl.Call(m, "GetFavourites", ctx, userID)
 	ret0, _ := ret[0].([]*entity.Favourite)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetFavourites indicates an expected call of GetFavourites.
-func (mr *MockFavouriteMockRecorder) GetFavourites(userID any) *gomock.Call {
+func (mr *MockFavouriteMockRecorder) GetFavourites(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavourites", reflect.TypeOf((*MockFavourite)(nil).GetFavourites), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavourites", reflect.TypeOf((*MockFavourite)(nil).GetFavourites), ctx, userID)
 }
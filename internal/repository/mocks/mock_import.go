@@ -0,0 +1,174 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: import.go
+//
+// Generated by this command:
+//
+//	mockgen -source=import.go -destination=mocks/mock_import.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockImport is a mock of Import interface.
+type MockImport struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportMockRecorder
+}
+
+// MockImportMockRecorder is the mock recorder for MockImport.
+type MockImportMockRecorder struct {
+	mock *MockImport
+}
+
+// NewMockImport creates a new mock instance.
+func NewMockImport(ctrl *gomock.Controller) *MockImport {
+	mock := &MockImport{ctrl: ctrl}
+	mock.recorder = &MockImportMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImport) EXPECT() *MockImportMockRecorder {
+	return m.recorder
+}
+
+// AddUnmatchedRows mocks base method.
+func (m *MockImport) AddUnmatchedRows(ctx context.Context, rows []*entity.ImportRow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUnmatchedRows", ctx, rows)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddUnmatchedRows indicates an expected call of AddUnmatchedRows.
+func (mr *MockImportMockRecorder) AddUnmatchedRows(ctx, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUnmatchedRows", reflect.TypeOf((*MockImport)(nil).AddUnmatchedRows), ctx, rows)
+}
+
+// CreateJob mocks base method.
+func (m *MockImport) CreateJob(ctx context.Context, job *entity.ImportJob) (*entity.ImportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJob", ctx, job)
+	ret0, _ := ret[0].(*entity.ImportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateJob indicates an expected call of CreateJob.
+func (mr *MockImportMockRecorder) CreateJob(ctx, job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJob", reflect.TypeOf((*MockImport)(nil).CreateJob), ctx, job)
+}
+
+// FailUnfinishedJobs mocks base method.
+func (m *MockImport) FailUnfinishedJobs(ctx context.Context, errMessage string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailUnfinishedJobs", ctx, errMessage)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FailUnfinishedJobs indicates an expected call of FailUnfinishedJobs.
+func (mr *MockImportMockRecorder) FailUnfinishedJobs(ctx, errMessage any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailUnfinishedJobs", reflect.TypeOf((*MockImport)(nil).FailUnfinishedJobs), ctx, errMessage)
+}
+
+// FindContent mocks base method.
+func (m *MockImport) FindContent(ctx context.Context, row *entity.ImportRow) (int, bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindContent", ctx, row)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindContent indicates an expected call of FindContent.
+func (mr *MockImportMockRecorder) FindContent(ctx, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindContent", reflect.TypeOf((*MockImport)(nil).FindContent), ctx, row)
+}
+
+// GetJob mocks base method.
+func (m *MockImport) GetJob(ctx context.Context, jobID int) (*entity.ImportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJob", ctx, jobID)
+	ret0, _ := ret[0].(*entity.ImportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJob indicates an expected call of GetJob.
+func (mr *MockImportMockRecorder) GetJob(ctx, jobID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockImport)(nil).GetJob), ctx, jobID)
+}
+
+// GetUnmatchedRow mocks base method.
+func (m *MockImport) GetUnmatchedRow(ctx context.Context, jobID, rowNumber int) (*entity.ImportRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnmatchedRow", ctx, jobID, rowNumber)
+	ret0, _ := ret[0].(*entity.ImportRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnmatchedRow indicates an expected call of GetUnmatchedRow.
+func (mr *MockImportMockRecorder) GetUnmatchedRow(ctx, jobID, rowNumber any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnmatchedRow", reflect.TypeOf((*MockImport)(nil).GetUnmatchedRow), ctx, jobID, rowNumber)
+}
+
+// GetUnmatchedRows mocks base method.
+func (m *MockImport) GetUnmatchedRows(ctx context.Context, jobID int) ([]*entity.ImportRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnmatchedRows", ctx, jobID)
+	ret0, _ := ret[0].([]*entity.ImportRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnmatchedRows indicates an expected call of GetUnmatchedRows.
+func (mr *MockImportMockRecorder) GetUnmatchedRows(ctx, jobID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnmatchedRows", reflect.TypeOf((*MockImport)(nil).GetUnmatchedRows), ctx, jobID)
+}
+
+// ResolveRow mocks base method.
+func (m *MockImport) ResolveRow(ctx context.Context, jobID, rowNumber, contentID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveRow", ctx, jobID, rowNumber, contentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveRow indicates an expected call of ResolveRow.
+func (mr *MockImportMockRecorder) ResolveRow(ctx, jobID, rowNumber, contentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveRow", reflect.TypeOf((*MockImport)(nil).ResolveRow), ctx, jobID, rowNumber, contentID)
+}
+
+// UpdateJob mocks base method.
+func (m *MockImport) UpdateJob(ctx context.Context, job *entity.ImportJob) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateJob", ctx, job)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateJob indicates an expected call of UpdateJob.
+func (mr *MockImportMockRecorder) UpdateJob(ctx, job any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJob", reflect.TypeOf((*MockImport)(nil).UpdateJob), ctx, job)
+}
@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: privacy.go
+//
+// Generated by this command:
+//
+//	mockgen -source=privacy.go -destination=mocks/mock_privacy.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPrivacy is a mock of Privacy interface.
+type MockPrivacy struct {
+	ctrl     *gomock.Controller
+	recorder *MockPrivacyMockRecorder
+}
+
+// MockPrivacyMockRecorder is the mock recorder for MockPrivacy.
+type MockPrivacyMockRecorder struct {
+	mock *MockPrivacy
+}
+
+// NewMockPrivacy creates a new mock instance.
+func NewMockPrivacy(ctrl *gomock.Controller) *MockPrivacy {
+	mock := &MockPrivacy{ctrl: ctrl}
+	mock.recorder = &MockPrivacyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPrivacy) EXPECT() *MockPrivacyMockRecorder {
+	return m.recorder
+}
+
+// GetPrivacySettings mocks base method.
+func (m *MockPrivacy) GetPrivacySettings(ctx context.Context, userID int) (*entity.PrivacySettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPrivacySettings", ctx, userID)
+	ret0, _ := ret[0].(*entity.PrivacySettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPrivacySettings indicates an expected call of GetPrivacySettings.
+func (mr *MockPrivacyMockRecorder) GetPrivacySettings(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivacySettings", reflect.TypeOf((*MockPrivacy)(nil).GetPrivacySettings), ctx, userID)
+}
+
+// UpdatePrivacySettings mocks base method.
+func (m *MockPrivacy) UpdatePrivacySettings(ctx context.Context, settings *entity.PrivacySettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePrivacySettings", ctx, settings)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePrivacySettings indicates an expected call of UpdatePrivacySettings.
+func (mr *MockPrivacyMockRecorder) UpdatePrivacySettings(ctx, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePrivacySettings", reflect.TypeOf((*MockPrivacy)(nil).UpdatePrivacySettings), ctx, settings)
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: profile.go
+//
+// Generated by this command:
+//
+//	mockgen -source=profile.go -destination=mocks/mock_profile.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProfile is a mock of Profile interface.
+type MockProfile struct {
+	ctrl     *gomock.Controller
+	recorder *MockProfileMockRecorder
+}
+
+// MockProfileMockRecorder is the mock recorder for MockProfile.
+type MockProfileMockRecorder struct {
+	mock *MockProfile
+}
+
+// NewMockProfile creates a new mock instance.
+func NewMockProfile(ctrl *gomock.Controller) *MockProfile {
+	mock := &MockProfile{ctrl: ctrl}
+	mock.recorder = &MockProfileMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProfile) EXPECT() *MockProfileMockRecorder {
+	return m.recorder
+}
+
+// GetProfileStats mocks base method.
+func (m *MockProfile) GetProfileStats(ctx context.Context, userID int, onlyPublicLists bool) (*entity.ProfileStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProfileStats", ctx, userID, onlyPublicLists)
+	ret0, _ := ret[0].(*entity.ProfileStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProfileStats indicates an expected call of GetProfileStats.
+func (mr *MockProfileMockRecorder) GetProfileStats(ctx, userID, onlyPublicLists any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProfileStats", reflect.TypeOf((*MockProfile)(nil).GetProfileStats), ctx, userID, onlyPublicLists)
+}
+
+// GetTopGenres mocks base method.
+func (m *MockProfile) GetTopGenres(ctx context.Context, userID, limit int, fromReviews, fromRatings bool) ([]*entity.GenreCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTopGenres", ctx, userID, limit, fromReviews, fromRatings)
+	ret0, _ := ret[0].([]*entity.GenreCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTopGenres indicates an expected call of GetTopGenres.
+func (mr *MockProfileMockRecorder) GetTopGenres(ctx, userID, limit, fromReviews, fromRatings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTopGenres", reflect.TypeOf((*MockProfile)(nil).GetTopGenres), ctx, userID, limit, fromReviews, fromRatings)
+}
@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: recommendation.go
+//
+// Generated by this command:
+//
+//	mockgen -source=recommendation.go -destination=mocks/mock_recommendation.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRecommendation is a mock of Recommendation interface.
+type MockRecommendation struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecommendationMockRecorder
+}
+
+// MockRecommendationMockRecorder is the mock recorder for MockRecommendation.
+type MockRecommendationMockRecorder struct {
+	mock *MockRecommendation
+}
+
+// NewMockRecommendation creates a new mock instance.
+func NewMockRecommendation(ctrl *gomock.Controller) *MockRecommendation {
+	mock := &MockRecommendation{ctrl: ctrl}
+	mock.recorder = &MockRecommendationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecommendation) EXPECT() *MockRecommendationMockRecorder {
+	return m.recorder
+}
+
+// GetRecommendations mocks base method.
+func (m *MockRecommendation) GetRecommendations(ctx context.Context, userID, limit int) ([]*entity.Recommendation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRecommendations", ctx, userID, limit)
+	ret0, _ := ret[0].([]*entity.Recommendation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRecommendations indicates an expected call of GetRecommendations.
+func (mr *MockRecommendationMockRecorder) GetRecommendations(ctx, userID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRecommendations", reflect.TypeOf((*MockRecommendation)(nil).GetRecommendations), ctx, userID, limit)
+}
+
+// RefreshNeighbors mocks base method.
+func (m *MockRecommendation) RefreshNeighbors(ctx context.Context, neighbors int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshNeighbors", ctx, neighbors)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshNeighbors indicates an expected call of RefreshNeighbors.
+func (mr *MockRecommendationMockRecorder) RefreshNeighbors(ctx, neighbors any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshNeighbors", reflect.TypeOf((*MockRecommendation)(nil).RefreshNeighbors), ctx, neighbors)
+}
@@ -10,6 +10,7 @@
 package mock_repository
 
 import (
+	context "context"
 	reflect "reflect"
 
 	entity "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
@@ -40,192 +41,222 @@ func (m *MockReview) EXPECT() *MockReviewMockRecorder {
 }
 
 // AddReview mocks base method.
-func (m *MockReview) AddReview(review *entity.Review) (*entity.Review, error) {
+func (m *MockReview) AddReview(ctx context.Context, review *entity.Review) (*entity.Review, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddReview", review)
+	ret := m.ctrl.Call(m, "AddReview", ctx, review)
 	ret0, _ := ret[0].(*entity.Review)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddReview indicates an expected call of AddReview.
-func (mr *MockReviewMockRecorder) AddReview(review any) *gomock.Call {
+func (mr *MockReviewMockRecorder) AddReview(ctx, review any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReview", reflect.TypeOf((*MockReview)(nil).AddReview), review)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReview", reflect.TypeOf((*MockReview)(nil).AddReview), ctx, review)
 }
 
 // DeleteReviewByID mocks base method.
-func (m *MockReview) DeleteReviewByID(id int) error {
+func (m *MockReview) DeleteReviewByID(ctx context.Context, id int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteReviewByID", id)
+	ret := m.ctrl.Call(m, "DeleteReviewByID", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteReviewByID indicates an expected call of DeleteReviewByID.
-func (mr *MockReviewMockRecorder) DeleteReviewByID(id any) *gomock.Call {
+func (mr *MockReviewMockRecorder) DeleteReviewByID(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReviewByID", reflect.TypeOf((*MockReview)(nil).DeleteReviewByID), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReviewByID", reflect.TypeOf((*MockReview)(nil).DeleteReviewByID), ctx, id)
+}
+
+// GetContentRatingStats mocks base method.
+func (m *MockReview) GetContentRatingStats(ctx context.Context, contentID int) (*entity.ContentRatingStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContentRatingStats", ctx, contentID)
+	ret0, _ := ret[0].(*entity.ContentRatingStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContentRatingStats indicates an expected call of GetContentRatingStats.
+func (mr *MockReviewMockRecorder) GetContentRatingStats(ctx, contentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContentRatingStats", reflect.TypeOf((*MockReview)(nil).GetContentRatingStats), ctx, contentID)
 }
 
 // GetContentReviewByAuthor mocks base method.
-func (m *MockReview) GetContentReviewByAuthor(authorID, contentID int) (*entity.Review, error) {
+func (m *MockReview) GetContentReviewByAuthor(ctx context.Context, authorID, contentID int) (*entity.Review, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetContentReviewByAuthor", authorID, contentID)
+	ret := m.ctrl.Call(m, "GetContentReviewByAuthor", ctx, authorID, contentID)
 	ret0, _ := ret[0].(*entity.Review)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetContentReviewByAuthor indicates an expected call of GetContentReviewByAuthor.
-func (mr *MockReviewMockRecorder) GetContentReviewByAuthor(authorID, contentID any) *gomock.Call {
+func (mr *MockReviewMockRecorder) GetContentReviewByAuthor(ctx, authorID, contentID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContentReviewByAuthor", reflect.TypeOf((*MockReview)(nil).GetContentReviewByAuthor), authorID, contentID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContentReviewByAuthor", reflect.TypeOf((*MockReview)(nil).GetContentReviewByAuthor), ctx, authorID, contentID)
 }
 
 // GetLatestReviews mocks base method.
-func (m *MockReview) GetLatestReviews(limit int) ([]*entity.Review, error) {
+func (m *MockReview) GetLatestReviews(ctx context.Context, limit int, filter entity.ReviewFilter) ([]*entity.Review, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLatestReviews", limit)
+	ret := m.ctrl.Call(m, "GetLatestReviews", ctx, limit, filter)
 	ret0, _ := ret[0].([]*entity.Review)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetLatestReviews indicates an expected call of GetLatestReviews.
-func (mr *MockReviewMockRecorder) GetLatestReviews(limit any) *gomock.Call {
+func (mr *MockReviewMockRecorder) GetLatestReviews(ctx, limit, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestReviews", reflect.TypeOf((*MockReview)(nil).GetLatestReviews), limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestReviews", reflect.TypeOf((*MockReview)(nil).GetLatestReviews), ctx, limit, filter)
 }
 
 // GetReviewByID mocks base method.
-func (m *MockReview) GetReviewByID(id int) (*entity.Review, error) {
+func (m *MockReview) GetReviewByID(ctx context.Context, id int) (*entity.Review, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetReviewByID", id)
+	ret := m.ctrl.Call(m, "GetReviewByID", ctx, id)
 	ret0, _ := ret[0].(*entity.Review)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetReviewByID indicates an expected call of GetReviewByID.
-func (mr *MockReviewMockRecorder) GetReviewByID(id any) *gomock.Call {
+func (mr *MockReviewMockRecorder) GetReviewByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewByID", reflect.TypeOf((*MockReview)(nil).GetReviewByID), ctx, id)
+}
+
+// GetReviewRevisions mocks base method.
+func (m *MockReview) GetReviewRevisions(ctx context.Context, reviewID int) ([]*entity.ReviewRevision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewRevisions", ctx, reviewID)
+	ret0, _ := ret[0].([]*entity.ReviewRevision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewRevisions indicates an expected call of GetReviewRevisions.
+func (mr *MockReviewMockRecorder) GetReviewRevisions(ctx, reviewID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewByID", reflect.TypeOf((*MockReview)(nil).GetReviewByID), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewRevisions", reflect.TypeOf((*MockReview)(nil).GetReviewRevisions), ctx, reviewID)
 }
 
 // GetReviewsByAuthorID mocks base method.
-func (m *MockReview) GetReviewsByAuthorID(authorID, page, limit int) ([]*entity.Review, error) {
+func (m *MockReview) GetReviewsByAuthorID(ctx context.Context, authorID, page, limit int, filter entity.ReviewFilter) ([]*entity.Review, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetReviewsByAuthorID", authorID, page, limit)
+	ret := m.ctrl.Call(m, "GetReviewsByAuthorID", ctx, authorID, page, limit, filter)
 	ret0, _ := ret[0].([]*entity.Review)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetReviewsByAuthorID indicates an expected call of GetReviewsByAuthorID.
-func (mr *MockReviewMockRecorder) GetReviewsByAuthorID(authorID, page, limit any) *gomock.Call {
+func (mr *MockReviewMockRecorder) GetReviewsByAuthorID(ctx, authorID, page, limit, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewsByAuthorID", reflect.TypeOf((*MockReview)(nil).GetReviewsByAuthorID), authorID, page, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewsByAuthorID", reflect.TypeOf((*MockReview)(nil).GetReviewsByAuthorID), ctx, authorID, page, limit, filter)
 }
 
 // GetReviewsByContentID mocks base method.
-func (m *MockReview) GetReviewsByContentID(contentID, page, limit int) ([]*entity.Review, error) {
+func (m *MockReview) GetReviewsByContentID(ctx context.Context, contentID, page, limit int, filter entity.ReviewFilter) ([]*entity.Review, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetReviewsByContentID", contentID, page, limit)
+	ret := m.ctrl.Call(m, "GetReviewsByContentID", ctx, contentID, page, limit, filter)
 	ret0, _ := ret[0].([]*entity.Review)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetReviewsByContentID indicates an expected call of GetReviewsByContentID.
-func (mr *MockReviewMockRecorder) GetReviewsByContentID(contentID, page, limit any) *gomock.Call {
+func (mr *MockReviewMockRecorder) GetReviewsByContentID(ctx, contentID, page, limit, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewsByContentID", reflect.TypeOf((*MockReview)(nil).GetReviewsByContentID), contentID, page, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewsByContentID", reflect.TypeOf((*MockReview)(nil).GetReviewsByContentID), ctx, contentID, page, limit, filter)
 }
 
 // GetReviewsCountByAuthorID mocks base method.
-func (m *MockReview) GetReviewsCountByAuthorID(authorID int) (int, error) {
+func (m *MockReview) GetReviewsCountByAuthorID(ctx context.Context, authorID int, filter entity.ReviewFilter) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetReviewsCountByAuthorID", authorID)
+	ret := m.ctrl.Call(m, "GetReviewsCountByAuthorID", ctx, authorID, filter)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetReviewsCountByAuthorID indicates an expected call of GetReviewsCountByAuthorID.
-func (mr *MockReviewMockRecorder) GetReviewsCountByAuthorID(authorID any) *gomock.Call {
+func (mr *MockReviewMockRecorder) GetReviewsCountByAuthorID(ctx, authorID, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewsCountByAuthorID", reflect.TypeOf((*MockReview)(nil).GetReviewsCountByAuthorID), authorID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewsCountByAuthorID", reflect.TypeOf((*MockReview)(nil).GetReviewsCountByAuthorID), ctx, authorID, filter)
 }
 
 // GetReviewsCountByContentID mocks base method.
-func (m *MockReview) GetReviewsCountByContentID(contentID int) (int, error) {
+func (m *MockReview) GetReviewsCountByContentID(ctx context.Context, contentID int, filter entity.ReviewFilter) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetReviewsCountByContentID", contentID)
+	ret := m.ctrl.Call(m, "GetReviewsCountByContentID", ctx, contentID, filter)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetReviewsCountByContentID indicates an expected call of GetReviewsCountByContentID.
-func (mr *MockReviewMockRecorder) GetReviewsCountByContentID(contentID any) *gomock.Call {
+func (mr *MockReviewMockRecorder) GetReviewsCountByContentID(ctx, contentID, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewsCountByContentID", reflect.TypeOf((*MockReview)(nil).GetReviewsCountByContentID), contentID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewsCountByContentID", reflect.TypeOf((*MockReview)(nil).GetReviewsCountByContentID), ctx, contentID, filter)
 }
 
 // IsVotedByUser mocks base method.
-func (m *MockReview) IsVotedByUser(reviewID, userID int) (int, error) {
+func (m *MockReview) IsVotedByUser(ctx context.Context, reviewID, userID int) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsVotedByUser", reviewID, userID)
+	ret := m.ctrl.Call(m, "IsVotedByUser", ctx, reviewID, userID)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // IsVotedByUser indicates an expected call of IsVotedByUser.
-func (mr *MockReviewMockRecorder) IsVotedByUser(reviewID, userID any) *gomock.Call {
+func (mr *MockReviewMockRecorder) IsVotedByUser(ctx, reviewID, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsVotedByUser", reflect.TypeOf((*MockReview)(nil).IsVotedByUser), reviewID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsVotedByUser", reflect.TypeOf((*MockReview)(nil).IsVotedByUser), ctx, reviewID, userID)
 }
 
 // UnVoteReview mocks base method.
-func (m *MockReview) UnVoteReview(reviewID, userID int) error {
+func (m *MockReview) UnVoteReview(ctx context.Context, reviewID, userID int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UnVoteReview", reviewID, userID)
+	ret := m.ctrl.Call(m, "UnVoteReview", ctx, reviewID, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UnVoteReview indicates an expected call of UnVoteReview.
-func (mr *MockReviewMockRecorder) UnVoteReview(reviewID, userID any) *gomock.Call {
+func (mr *MockReviewMockRecorder) UnVoteReview(ctx, reviewID, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnVoteReview", reflect.TypeOf((*MockReview)(nil).UnVoteReview), reviewID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnVoteReview", reflect.TypeOf((*MockReview)(nil).UnVoteReview), ctx, reviewID, userID)
 }
 
 // UpdateReview mocks base method.
-func (m *MockReview) UpdateReview(review *entity.Review) error {
+func (m *MockReview) UpdateReview(ctx context.Context, review *entity.Review) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateReview", review)
+	ret := m.ctrl.Call(m, "UpdateReview", ctx, review)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateReview indicates an expected call of UpdateReview.
-func (mr *MockReviewMockRecorder) UpdateReview(review any) *gomock.Call {
+func (mr *MockReviewMockRecorder) UpdateReview(ctx, review any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReview", reflect.TypeOf((*MockReview)(nil).UpdateReview), review)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReview", reflect.TypeOf((*MockReview)(nil).UpdateReview), ctx, review)
 }
 
 // VoteReview mocks base method.
-func (m *MockReview) VoteReview(reviewID, userID int, like bool) error {
+func (m *MockReview) VoteReview(ctx context.Context, reviewID, userID int, like bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "VoteReview", reviewID, userID, like)
+	ret := m.ctrl.Call(m, "VoteReview", ctx, reviewID, userID, like)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // VoteReview indicates an expected call of VoteReview.
-func (mr *MockReviewMockRecorder) VoteReview(reviewID, userID, like any) *gomock.Call {
+func (mr *MockReviewMockRecorder) VoteReview(ctx, reviewID, userID, like any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoteReview", reflect.TypeOf((*MockReview)(nil).VoteReview), reviewID, userID, like)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoteReview", reflect.TypeOf((*MockReview)(nil).VoteReview), ctx, reviewID, userID, like)
 }
@@ -0,0 +1,144 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: review_comment.go
+//
+// Generated by this command:
+//
+//	mockgen -source=review_comment.go -destination=mocks/mock_review_comment.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReviewComment is a mock of ReviewComment interface.
+type MockReviewComment struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewCommentMockRecorder
+}
+
+// MockReviewCommentMockRecorder is the mock recorder for MockReviewComment.
+type MockReviewCommentMockRecorder struct {
+	mock *MockReviewComment
+}
+
+// NewMockReviewComment creates a new mock instance.
+func NewMockReviewComment(ctrl *gomock.Controller) *MockReviewComment {
+	mock := &MockReviewComment{ctrl: ctrl}
+	mock.recorder = &MockReviewCommentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewComment) EXPECT() *MockReviewCommentMockRecorder {
+	return m.recorder
+}
+
+// AddComment mocks base method.
+func (m *MockReviewComment) AddComment(ctx context.Context, comment *entity.ReviewComment) (*entity.ReviewComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddComment", ctx, comment)
+	ret0, _ := ret[0].(*entity.ReviewComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddComment indicates an expected call of AddComment.
+func (mr *MockReviewCommentMockRecorder) AddComment(ctx, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddComment", reflect.TypeOf((*MockReviewComment)(nil).AddComment), ctx, comment)
+}
+
+// DeleteCommentByID mocks base method.
+func (m *MockReviewComment) DeleteCommentByID(ctx context.Context, id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCommentByID", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCommentByID indicates an expected call of DeleteCommentByID.
+func (mr *MockReviewCommentMockRecorder) DeleteCommentByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCommentByID", reflect.TypeOf((*MockReviewComment)(nil).DeleteCommentByID), ctx, id)
+}
+
+// GetCommentByID mocks base method.
+func (m *MockReviewComment) GetCommentByID(ctx context.Context, id int) (*entity.ReviewComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentByID", ctx, id)
+	ret0, _ := ret[0].(*entity.ReviewComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentByID indicates an expected call of GetCommentByID.
+func (mr *MockReviewCommentMockRecorder) GetCommentByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentByID", reflect.TypeOf((*MockReviewComment)(nil).GetCommentByID), ctx, id)
+}
+
+// GetCommentsByReviewID mocks base method.
+func (m *MockReviewComment) GetCommentsByReviewID(ctx context.Context, reviewID, page, limit int) ([]*entity.ReviewComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentsByReviewID", ctx, reviewID, page, limit)
+	ret0, _ := ret[0].([]*entity.ReviewComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentsByReviewID indicates an expected call of GetCommentsByReviewID.
+func (mr *MockReviewCommentMockRecorder) GetCommentsByReviewID(ctx, reviewID, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentsByReviewID", reflect.TypeOf((*MockReviewComment)(nil).GetCommentsByReviewID), ctx, reviewID, page, limit)
+}
+
+// GetCommentsCountByReviewID mocks base method.
+func (m *MockReviewComment) GetCommentsCountByReviewID(ctx context.Context, reviewID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCommentsCountByReviewID", ctx, reviewID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCommentsCountByReviewID indicates an expected call of GetCommentsCountByReviewID.
+func (mr *MockReviewCommentMockRecorder) GetCommentsCountByReviewID(ctx, reviewID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCommentsCountByReviewID", reflect.TypeOf((*MockReviewComment)(nil).GetCommentsCountByReviewID), ctx, reviewID)
+}
+
+// GetRepliesByParentIDs mocks base method.
+func (m *MockReviewComment) GetRepliesByParentIDs(ctx context.Context, parentIDs []int) ([]*entity.ReviewComment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRepliesByParentIDs", ctx, parentIDs)
+	ret0, _ := ret[0].([]*entity.ReviewComment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRepliesByParentIDs indicates an expected call of GetRepliesByParentIDs.
+func (mr *MockReviewCommentMockRecorder) GetRepliesByParentIDs(ctx, parentIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepliesByParentIDs", reflect.TypeOf((*MockReviewComment)(nil).GetRepliesByParentIDs), ctx, parentIDs)
+}
+
+// UpdateComment mocks base method.
+func (m *MockReviewComment) UpdateComment(ctx context.Context, comment *entity.ReviewComment) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateComment", ctx, comment)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateComment indicates an expected call of UpdateComment.
+func (mr *MockReviewCommentMockRecorder) UpdateComment(ctx, comment any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateComment", reflect.TypeOf((*MockReviewComment)(nil).UpdateComment), ctx, comment)
+}
@@ -0,0 +1,144 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: review_moderation.go
+//
+// Generated by this command:
+//
+//	mockgen -source=review_moderation.go -destination=mocks/mock_review_moderation.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReviewModeration is a mock of ReviewModeration interface.
+type MockReviewModeration struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewModerationMockRecorder
+}
+
+// MockReviewModerationMockRecorder is the mock recorder for MockReviewModeration.
+type MockReviewModerationMockRecorder struct {
+	mock *MockReviewModeration
+}
+
+// NewMockReviewModeration creates a new mock instance.
+func NewMockReviewModeration(ctrl *gomock.Controller) *MockReviewModeration {
+	mock := &MockReviewModeration{ctrl: ctrl}
+	mock.recorder = &MockReviewModerationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewModeration) EXPECT() *MockReviewModerationMockRecorder {
+	return m.recorder
+}
+
+// AddReport mocks base method.
+func (m *MockReviewModeration) AddReport(ctx context.Context, report *entity.ReviewReport) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReport", ctx, report)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddReport indicates an expected call of AddReport.
+func (mr *MockReviewModerationMockRecorder) AddReport(ctx, report any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReport", reflect.TypeOf((*MockReviewModeration)(nil).AddReport), ctx, report)
+}
+
+// GetModerationLog mocks base method.
+func (m *MockReviewModeration) GetModerationLog(ctx context.Context, page, limit int) ([]*entity.ModerationDecision, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModerationLog", ctx, page, limit)
+	ret0, _ := ret[0].([]*entity.ModerationDecision)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModerationLog indicates an expected call of GetModerationLog.
+func (mr *MockReviewModerationMockRecorder) GetModerationLog(ctx, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModerationLog", reflect.TypeOf((*MockReviewModeration)(nil).GetModerationLog), ctx, page, limit)
+}
+
+// GetModerationLogCount mocks base method.
+func (m *MockReviewModeration) GetModerationLogCount(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModerationLogCount", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModerationLogCount indicates an expected call of GetModerationLogCount.
+func (mr *MockReviewModerationMockRecorder) GetModerationLogCount(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModerationLogCount", reflect.TypeOf((*MockReviewModeration)(nil).GetModerationLogCount), ctx)
+}
+
+// GetReportedReviews mocks base method.
+func (m *MockReviewModeration) GetReportedReviews(ctx context.Context, page, limit int) ([]*entity.ReportedReview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReportedReviews", ctx, page, limit)
+	ret0, _ := ret[0].([]*entity.ReportedReview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReportedReviews indicates an expected call of GetReportedReviews.
+func (mr *MockReviewModerationMockRecorder) GetReportedReviews(ctx, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReportedReviews", reflect.TypeOf((*MockReviewModeration)(nil).GetReportedReviews), ctx, page, limit)
+}
+
+// GetReportedReviewsCount mocks base method.
+func (m *MockReviewModeration) GetReportedReviewsCount(ctx context.Context) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReportedReviewsCount", ctx)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReportedReviewsCount indicates an expected call of GetReportedReviewsCount.
+func (mr *MockReviewModerationMockRecorder) GetReportedReviewsCount(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReportedReviewsCount", reflect.TypeOf((*MockReviewModeration)(nil).GetReportedReviewsCount), ctx)
+}
+
+// IsModerator mocks base method.
+func (m *MockReviewModeration) IsModerator(ctx context.Context, userID int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsModerator", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsModerator indicates an expected call of IsModerator.
+func (mr *MockReviewModerationMockRecorder) IsModerator(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsModerator", reflect.TypeOf((*MockReviewModeration)(nil).IsModerator), ctx, userID)
+}
+
+// ResolveReview mocks base method.
+func (m *MockReviewModeration) ResolveReview(ctx context.Context, decision *entity.ModerationDecision) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveReview", ctx, decision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveReview indicates an expected call of ResolveReview.
+func (mr *MockReviewModerationMockRecorder) ResolveReview(ctx, decision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveReview", reflect.TypeOf((*MockReviewModeration)(nil).ResolveReview), ctx, decision)
+}
@@ -10,6 +10,7 @@
 package mock_repository
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -39,31 +40,31 @@ func (m *MockSearch) EXPECT() *MockSearchMockRecorder {
 }
 
 // SearchContent mocks base method.
-func (m *MockSearch) SearchContent(query string) ([]int, error) {
+func (m *MockSearch) SearchContent(ctx context.Context, query string) ([]int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchContent", query)
+	ret := m.ctrl.Call(m, "SearchContent", ctx, query)
 	ret0, _ := ret[0].([]int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchContent indicates an expected call of SearchContent.
-func (mr *MockSearchMockRecorder) SearchContent(query any) *gomock.Call {
+func (mr *MockSearchMockRecorder) SearchContent(ctx, query any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchContent", reflect.TypeOf((*MockSearch)(nil).SearchContent), query)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchContent", reflect.TypeOf((*MockSearch)(nil).SearchContent), ctx, query)
 }
 
 // SearchPerson mocks base method.
-func (m *MockSearch) SearchPerson(query string) ([]int, error) {
+func (m *MockSearch) SearchPerson(ctx context.Context, query string) ([]int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchPerson", query)
+	ret := m.ctrl.Call(m, "SearchPerson", ctx, query)
 	ret0, _ := ret[0].([]int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchPerson indicates an expected call of SearchPerson.
-func (mr *MockSearchMockRecorder) SearchPerson(query any) *gomock.Call {
+func (mr *MockSearchMockRecorder) SearchPerson(ctx, query any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPerson", reflect.TypeOf((*MockSearch)(nil).SearchPerson), query)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPerson", reflect.TypeOf((*MockSearch)(nil).SearchPerson), ctx, query)
 }
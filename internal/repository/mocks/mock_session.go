@@ -10,6 +10,7 @@
 package mock_repository
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -39,59 +40,59 @@ func (m *MockSession) EXPECT() *MockSessionMockRecorder {
 }
 
 // CheckSession mocks base method.
-func (m *MockSession) CheckSession(session string) (int, error) {
+func (m *MockSession) CheckSession(ctx context.Context, session string) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckSession", session)
+	ret := m.ctrl.Call(m, "CheckSession", ctx, session)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CheckSession indicates an expected call of CheckSession.
-func (mr *MockSessionMockRecorder) CheckSession(session any) *gomock.Call {
+func (mr *MockSessionMockRecorder) CheckSession(ctx, session any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSession", reflect.TypeOf((*MockSession)(nil).CheckSession), session)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckSession", reflect.TypeOf((*MockSession)(nil).CheckSession), ctx, session)
 }
 
 // DeleteAllSessions mocks base method.
-func (m *MockSession) DeleteAllSessions(userID int) error {
+func (m *MockSession) DeleteAllSessions(ctx context.Context, userID int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteAllSessions", userID)
+	ret := m.ctrl.Call(m, "DeleteAllSessions", ctx, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteAllSessions indicates an expected call of DeleteAllSessions.
-func (mr *MockSessionMockRecorder) DeleteAllSessions(userID any) *gomock.Call {
+func (mr *MockSessionMockRecorder) DeleteAllSessions(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllSessions", reflect.TypeOf((*MockSession)(nil).DeleteAllSessions), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllSessions", reflect.TypeOf((*MockSession)(nil).DeleteAllSessions), ctx, userID)
 }
 
 // DeleteSession mocks base method.
-func (m *MockSession) DeleteSession(session string) error {
+func (m *MockSession) DeleteSession(ctx context.Context, session string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSession", session)
+	ret := m.ctrl.Call(m, "DeleteSession", ctx, session)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteSession indicates an expected call of DeleteSession.
-func (mr *MockSessionMockRecorder) DeleteSession(session any) *gomock.Call {
+func (mr *MockSessionMockRecorder) DeleteSession(ctx, session any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockSession)(nil).DeleteSession), session)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockSession)(nil).DeleteSession), ctx, session)
 }
 
 // NewSession mocks base method.
-func (m *MockSession) NewSession(id int) (string, error) {
+func (m *MockSession) NewSession(ctx context.Context, id int) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewSession", id)
+	ret := m.ctrl.Call(m, "NewSession", ctx, id)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // NewSession indicates an expected call of NewSession.
-func (mr *MockSessionMockRecorder) NewSession(id any) *gomock.Call {
+func (mr *MockSessionMockRecorder) NewSession(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSession", reflect.TypeOf((*MockSession)(nil).NewSession), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSession", reflect.TypeOf((*MockSession)(nil).NewSession), ctx, id)
 }
@@ -10,6 +10,7 @@
 package mock_repository
 
 import (
+	context "context"
 	io "io"
 	reflect "reflect"
 
@@ -54,46 +55,46 @@ func (mr *MockStaticMockRecorder) GetMaxSize() *gomock.Call {
 }
 
 // GetStaticFile mocks base method.
-func (m *MockStatic) GetStaticFile(staticURI string) (io.ReadSeeker, error) {
+func (m *MockStatic) GetStaticFile(ctx context.Context, staticURI string) (io.ReadSeeker, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetStaticFile", staticURI)
+	ret := m.ctrl.Call(m, "GetStaticFile", ctx, staticURI)
 	ret0, _ := ret[0].(io.ReadSeeker)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetStaticFile indicates an expected call of GetStaticFile.
-func (mr *MockStaticMockRecorder) GetStaticFile(staticURI any) *gomock.Call {
+func (mr *MockStaticMockRecorder) GetStaticFile(ctx, staticURI any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStaticFile", reflect.TypeOf((*MockStatic)(nil).GetStaticFile), staticURI)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStaticFile", reflect.TypeOf((*MockStatic)(nil).GetStaticFile), ctx, staticURI)
 }
 
 // GetStaticURL mocks base method.
-func (m *MockStatic) GetStaticURL(staticID int) (string, error) {
+func (m *MockStatic) GetStaticURL(ctx context.Context, staticID int) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetStaticURL", staticID)
+	ret := m.ctrl.Call(m, "GetStaticURL", ctx, staticID)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetStaticURL indicates an expected call of GetStaticURL.
-func (mr *MockStaticMockRecorder) GetStaticURL(staticID any) *gomock.Call {
+func (mr *MockStaticMockRecorder) GetStaticURL(ctx, staticID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStaticURL", reflect.TypeOf((*MockStatic)(nil).GetStaticURL), staticID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStaticURL", reflect.TypeOf((*MockStatic)(nil).GetStaticURL), ctx, staticID)
 }
 
 // UploadStatic mocks base method.
-func (m *MockStatic) UploadStatic(path, filename string, reader io.ReadSeeker) (int, error) {
+func (m *MockStatic) UploadStatic(ctx context.Context, path, filename string, reader io.ReadSeeker) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UploadStatic", path, filename, reader)
+	ret := m.ctrl.Call(m, "UploadStatic", ctx, path, filename, reader)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UploadStatic indicates an expected call of UploadStatic.
-func (mr *MockStaticMockRecorder) UploadStatic(path, filename, reader any) *gomock.Call {
+func (mr *MockStaticMockRecorder) UploadStatic(ctx, path, filename, reader any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadStatic", reflect.TypeOf((*MockStatic)(nil).UploadStatic), path, filename, reader)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadStatic", reflect.TypeOf((*MockStatic)(nil).UploadStatic), ctx, path, filename, reader)
 }
@@ -10,6 +10,7 @@
 package mock_repository
 
 import (
+	context "context"
 	reflect "reflect"
 
 	entity "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
@@ -40,60 +41,60 @@ func (m *MockUser) EXPECT() *MockUserMockRecorder {
 }
 
 // AddUser mocks base method.
-func (m *MockUser) AddUser(email string, passwordHash, passwordSalt []byte) (*entity.User, error) {
+func (m *MockUser) AddUser(ctx context.Context, email string, passwordHash, passwordSalt []byte) (*entity.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddUser", email, passwordHash, passwordSalt)
+	ret := m.ctrl.Call(m, "AddUser", ctx, email, passwordHash, passwordSalt)
 	ret0, _ := ret[0].(*entity.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // AddUser indicates an expected call of AddUser.
-func (mr *MockUserMockRecorder) AddUser(email, passwordHash, passwordSalt any) *gomock.Call {
+func (mr *MockUserMockRecorder) AddUser(ctx, email, passwordHash, passwordSalt any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUser", reflect.TypeOf((*MockUser)(nil).AddUser), email, passwordHash, passwordSalt)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUser", reflect.TypeOf((*MockUser)(nil).AddUser), ctx, email, passwordHash, passwordSalt)
 }
 
 // GetUserByEmail mocks base method.
-func (m *MockUser) GetUserByEmail(userEmail string) (*entity.User, error) {
+func (m *MockUser) GetUserByEmail(ctx context.Context, userEmail string) (*entity.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserByEmail", userEmail)
+	ret := m.ctrl.Call(m, "GetUserByEmail", ctx, userEmail)
 	ret0, _ := ret[0].(*entity.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserByEmail indicates an expected call of GetUserByEmail.
-func (mr *MockUserMockRecorder) GetUserByEmail(userEmail any) *gomock.Call {
+func (mr *MockUserMockRecorder) GetUserByEmail(ctx, userEmail any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockUser)(nil).GetUserByEmail), userEmail)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByEmail", reflect.TypeOf((*MockUser)(nil).GetUserByEmail), ctx, userEmail)
 }
 
 // GetUserByID mocks base method.
-func (m *MockUser) GetUserByID(userID int) (*entity.User, error) {
+func (m *MockUser) GetUserByID(ctx context.Context, userID int) (*entity.User, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserByID", userID)
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, userID)
 	ret0, _ := ret[0].(*entity.User)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserByID indicates an expected call of GetUserByID.
-func (mr *MockUserMockRecorder) GetUserByID(userID any) *gomock.Call {
+func (mr *MockUserMockRecorder) GetUserByID(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUser)(nil).GetUserByID), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUser)(nil).GetUserByID), ctx, userID)
 }
 
 // UpdateUser mocks base method.
-func (m *MockUser) UpdateUser(user *entity.User) error {
+func (m *MockUser) UpdateUser(ctx context.Context, user *entity.User) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateUser", user)
+	ret := m.ctrl.Call(m, "UpdateUser", ctx, user)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateUser indicates an expected call of UpdateUser.
-func (mr *MockUserMockRecorder) UpdateUser(user any) *gomock.Call {
+func (mr *MockUserMockRecorder) UpdateUser(ctx, user any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUser)(nil).UpdateUser), user)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUser)(nil).UpdateUser), ctx, user)
 }
@@ -0,0 +1,187 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: user_list.go
+//
+// Generated by this command:
+//
+//	mockgen -source=user_list.go -destination=mocks/mock_user_list.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserList is a mock of UserList interface.
+type MockUserList struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserListMockRecorder
+}
+
+// MockUserListMockRecorder is the mock recorder for MockUserList.
+type MockUserListMockRecorder struct {
+	mock *MockUserList
+}
+
+// NewMockUserList creates a new mock instance.
+func NewMockUserList(ctrl *gomock.Controller) *MockUserList {
+	mock := &MockUserList{ctrl: ctrl}
+	mock.recorder = &MockUserListMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserList) EXPECT() *MockUserListMockRecorder {
+	return m.recorder
+}
+
+// AddItem mocks base method.
+func (m *MockUserList) AddItem(ctx context.Context, item *entity.UserListItem) (*entity.UserListItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddItem", ctx, item)
+	ret0, _ := ret[0].(*entity.UserListItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddItem indicates an expected call of AddItem.
+func (mr *MockUserListMockRecorder) AddItem(ctx, item any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItem", reflect.TypeOf((*MockUserList)(nil).AddItem), ctx, item)
+}
+
+// CreateList mocks base method.
+func (m *MockUserList) CreateList(ctx context.Context, list *entity.UserList) (*entity.UserList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateList", ctx, list)
+	ret0, _ := ret[0].(*entity.UserList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateList indicates an expected call of CreateList.
+func (mr *MockUserListMockRecorder) CreateList(ctx, list any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateList", reflect.TypeOf((*MockUserList)(nil).CreateList), ctx, list)
+}
+
+// DeleteList mocks base method.
+func (m *MockUserList) DeleteList(ctx context.Context, listID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteList", ctx, listID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteList indicates an expected call of DeleteList.
+func (mr *MockUserListMockRecorder) DeleteList(ctx, listID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteList", reflect.TypeOf((*MockUserList)(nil).DeleteList), ctx, listID)
+}
+
+// GetList mocks base method.
+func (m *MockUserList) GetList(ctx context.Context, listID int) (*entity.UserList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetList", ctx, listID)
+	ret0, _ := ret[0].(*entity.UserList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetList indicates an expected call of GetList.
+func (mr *MockUserListMockRecorder) GetList(ctx, listID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockUserList)(nil).GetList), ctx, listID)
+}
+
+// GetListItems mocks base method.
+func (m *MockUserList) GetListItems(ctx context.Context, listID int) ([]*entity.UserListItem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListItems", ctx, listID)
+	ret0, _ := ret[0].([]*entity.UserListItem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListItems indicates an expected call of GetListItems.
+func (mr *MockUserListMockRecorder) GetListItems(ctx, listID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListItems", reflect.TypeOf((*MockUserList)(nil).GetListItems), ctx, listID)
+}
+
+// GetListsByUserID mocks base method.
+func (m *MockUserList) GetListsByUserID(ctx context.Context, userID int, onlyPublic bool) ([]*entity.UserList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListsByUserID", ctx, userID, onlyPublic)
+	ret0, _ := ret[0].([]*entity.UserList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListsByUserID indicates an expected call of GetListsByUserID.
+func (mr *MockUserListMockRecorder) GetListsByUserID(ctx, userID, onlyPublic any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListsByUserID", reflect.TypeOf((*MockUserList)(nil).GetListsByUserID), ctx, userID, onlyPublic)
+}
+
+// RemoveItem mocks base method.
+func (m *MockUserList) RemoveItem(ctx context.Context, listID, contentID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveItem", ctx, listID, contentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveItem indicates an expected call of RemoveItem.
+func (mr *MockUserListMockRecorder) RemoveItem(ctx, listID, contentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveItem", reflect.TypeOf((*MockUserList)(nil).RemoveItem), ctx, listID, contentID)
+}
+
+// ReorderItems mocks base method.
+func (m *MockUserList) ReorderItems(ctx context.Context, listID int, contentIDs []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderItems", ctx, listID, contentIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderItems indicates an expected call of ReorderItems.
+func (mr *MockUserListMockRecorder) ReorderItems(ctx, listID, contentIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderItems", reflect.TypeOf((*MockUserList)(nil).ReorderItems), ctx, listID, contentIDs)
+}
+
+// UpdateItemNote mocks base method.
+func (m *MockUserList) UpdateItemNote(ctx context.Context, listID, contentID int, note string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateItemNote", ctx, listID, contentID, note)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateItemNote indicates an expected call of UpdateItemNote.
+func (mr *MockUserListMockRecorder) UpdateItemNote(ctx, listID, contentID, note any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItemNote", reflect.TypeOf((*MockUserList)(nil).UpdateItemNote), ctx, listID, contentID, note)
+}
+
+// UpdateList mocks base method.
+func (m *MockUserList) UpdateList(ctx context.Context, list *entity.UserList) (*entity.UserList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateList", ctx, list)
+	ret0, _ := ret[0].(*entity.UserList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateList indicates an expected call of UpdateList.
+func (mr *MockUserListMockRecorder) UpdateList(ctx, list any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateList", reflect.TypeOf((*MockUserList)(nil).UpdateList), ctx, list)
+}
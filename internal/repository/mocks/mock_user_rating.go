@@ -0,0 +1,158 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: user_rating.go
+//
+// Generated by this command:
+//
+//	mockgen -source=user_rating.go -destination=mocks/mock_user_rating.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserRating is a mock of UserRating interface.
+type MockUserRating struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserRatingMockRecorder
+}
+
+// MockUserRatingMockRecorder is the mock recorder for MockUserRating.
+type MockUserRatingMockRecorder struct {
+	mock *MockUserRating
+}
+
+// NewMockUserRating creates a new mock instance.
+func NewMockUserRating(ctrl *gomock.Controller) *MockUserRating {
+	mock := &MockUserRating{ctrl: ctrl}
+	mock.recorder = &MockUserRatingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserRating) EXPECT() *MockUserRatingMockRecorder {
+	return m.recorder
+}
+
+// DeleteRating mocks base method.
+func (m *MockUserRating) DeleteRating(ctx context.Context, userID, contentID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRating", ctx, userID, contentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRating indicates an expected call of DeleteRating.
+func (mr *MockUserRatingMockRecorder) DeleteRating(ctx, userID, contentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRating", reflect.TypeOf((*MockUserRating)(nil).DeleteRating), ctx, userID, contentID)
+}
+
+// DeleteSeriesPartRating mocks base method.
+func (m *MockUserRating) DeleteSeriesPartRating(ctx context.Context, part entity.SeriesPart, userID, partID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeriesPartRating", ctx, part, userID, partID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSeriesPartRating indicates an expected call of DeleteSeriesPartRating.
+func (mr *MockUserRatingMockRecorder) DeleteSeriesPartRating(ctx, part, userID, partID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeriesPartRating", reflect.TypeOf((*MockUserRating)(nil).DeleteSeriesPartRating), ctx, part, userID, partID)
+}
+
+// GetRating mocks base method.
+func (m *MockUserRating) GetRating(ctx context.Context, userID, contentID int) (*entity.UserRating, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRating", ctx, userID, contentID)
+	ret0, _ := ret[0].(*entity.UserRating)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRating indicates an expected call of GetRating.
+func (mr *MockUserRatingMockRecorder) GetRating(ctx, userID, contentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRating", reflect.TypeOf((*MockUserRating)(nil).GetRating), ctx, userID, contentID)
+}
+
+// GetRatingsByUserID mocks base method.
+func (m *MockUserRating) GetRatingsByUserID(ctx context.Context, userID, page, limit int) ([]*entity.UserRating, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRatingsByUserID", ctx, userID, page, limit)
+	ret0, _ := ret[0].([]*entity.UserRating)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRatingsByUserID indicates an expected call of GetRatingsByUserID.
+func (mr *MockUserRatingMockRecorder) GetRatingsByUserID(ctx, userID, page, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRatingsByUserID", reflect.TypeOf((*MockUserRating)(nil).GetRatingsByUserID), ctx, userID, page, limit)
+}
+
+// GetRatingsCountByUserID mocks base method.
+func (m *MockUserRating) GetRatingsCountByUserID(ctx context.Context, userID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRatingsCountByUserID", ctx, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRatingsCountByUserID indicates an expected call of GetRatingsCountByUserID.
+func (mr *MockUserRatingMockRecorder) GetRatingsCountByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRatingsCountByUserID", reflect.TypeOf((*MockUserRating)(nil).GetRatingsCountByUserID), ctx, userID)
+}
+
+// GetSeriesPartContentID mocks base method.
+func (m *MockUserRating) GetSeriesPartContentID(ctx context.Context, part entity.SeriesPart, partID int) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeriesPartContentID", ctx, part, partID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeriesPartContentID indicates an expected call of GetSeriesPartContentID.
+func (mr *MockUserRatingMockRecorder) GetSeriesPartContentID(ctx, part, partID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeriesPartContentID", reflect.TypeOf((*MockUserRating)(nil).GetSeriesPartContentID), ctx, part, partID)
+}
+
+// SetRating mocks base method.
+func (m *MockUserRating) SetRating(ctx context.Context, rating *entity.UserRating) (*entity.UserRating, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRating", ctx, rating)
+	ret0, _ := ret[0].(*entity.UserRating)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRating indicates an expected call of SetRating.
+func (mr *MockUserRatingMockRecorder) SetRating(ctx, rating any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRating", reflect.TypeOf((*MockUserRating)(nil).SetRating), ctx, rating)
+}
+
+// SetSeriesPartRating mocks base method.
+func (m *MockUserRating) SetSeriesPartRating(ctx context.Context, part entity.SeriesPart, userID, partID, rating int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSeriesPartRating", ctx, part, userID, partID, rating)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSeriesPartRating indicates an expected call of SetSeriesPartRating.
+func (mr *MockUserRatingMockRecorder) SetSeriesPartRating(ctx, part, userID, partID, rating any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSeriesPartRating", reflect.TypeOf((*MockUserRating)(nil).SetSeriesPartRating), ctx, part, userID, partID, rating)
+}
@@ -0,0 +1,105 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: user_stats.go
+//
+// Generated by this command:
+//
+//	mockgen -source=user_stats.go -destination=mocks/mock_user_stats.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserStats is a mock of UserStats interface.
+type MockUserStats struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserStatsMockRecorder
+}
+
+// MockUserStatsMockRecorder is the mock recorder for MockUserStats.
+type MockUserStatsMockRecorder struct {
+	mock *MockUserStats
+}
+
+// NewMockUserStats creates a new mock instance.
+func NewMockUserStats(ctrl *gomock.Controller) *MockUserStats {
+	mock := &MockUserStats{ctrl: ctrl}
+	mock.recorder = &MockUserStatsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserStats) EXPECT() *MockUserStatsMockRecorder {
+	return m.recorder
+}
+
+// GetMonthlyActivity mocks base method.
+func (m *MockUserStats) GetMonthlyActivity(ctx context.Context, userID, months int) ([]*entity.MonthActivity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMonthlyActivity", ctx, userID, months)
+	ret0, _ := ret[0].([]*entity.MonthActivity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMonthlyActivity indicates an expected call of GetMonthlyActivity.
+func (mr *MockUserStatsMockRecorder) GetMonthlyActivity(ctx, userID, months any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMonthlyActivity", reflect.TypeOf((*MockUserStats)(nil).GetMonthlyActivity), ctx, userID, months)
+}
+
+// GetRatingStats mocks base method.
+func (m *MockUserStats) GetRatingStats(ctx context.Context, userID int) (*entity.RatingStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRatingStats", ctx, userID)
+	ret0, _ := ret[0].(*entity.RatingStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRatingStats indicates an expected call of GetRatingStats.
+func (mr *MockUserStatsMockRecorder) GetRatingStats(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRatingStats", reflect.TypeOf((*MockUserStats)(nil).GetRatingStats), ctx, userID)
+}
+
+// GetWatchedStats mocks base method.
+func (m *MockUserStats) GetWatchedStats(ctx context.Context, userID, limit int) (*entity.WatchedStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWatchedStats", ctx, userID, limit)
+	ret0, _ := ret[0].(*entity.WatchedStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWatchedStats indicates an expected call of GetWatchedStats.
+func (mr *MockUserStatsMockRecorder) GetWatchedStats(ctx, userID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWatchedStats", reflect.TypeOf((*MockUserStats)(nil).GetWatchedStats), ctx, userID, limit)
+}
+
+// InvalidateUserStats mocks base method.
+func (m *MockUserStats) InvalidateUserStats(ctx context.Context, userID int, sections ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, userID}
+	for _, a := range sections {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "InvalidateUserStats", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InvalidateUserStats indicates an expected call of InvalidateUserStats.
+func (mr *MockUserStatsMockRecorder) InvalidateUserStats(ctx, userID any, sections ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, userID}, sections...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InvalidateUserStats", reflect.TypeOf((*MockUserStats)(nil).InvalidateUserStats), varargs...)
+}
@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: watch_progress.go
+//
+// Generated by this command:
+//
+//	mockgen -source=watch_progress.go -destination=mocks/mock_watch_progress.go
+//
+
+// Package mock_repository is a generated GoMock package.
+package mock_repository
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWatchProgress is a mock of WatchProgress interface.
+type MockWatchProgress struct {
+	ctrl     *gomock.Controller
+	recorder *MockWatchProgressMockRecorder
+}
+
+// MockWatchProgressMockRecorder is the mock recorder for MockWatchProgress.
+type MockWatchProgressMockRecorder struct {
+	mock *MockWatchProgress
+}
+
+// NewMockWatchProgress creates a new mock instance.
+func NewMockWatchProgress(ctrl *gomock.Controller) *MockWatchProgress {
+	mock := &MockWatchProgress{ctrl: ctrl}
+	mock.recorder = &MockWatchProgressMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWatchProgress) EXPECT() *MockWatchProgressMockRecorder {
+	return m.recorder
+}
+
+// GetWatchProgress mocks base method.
+func (m *MockWatchProgress) GetWatchProgress(ctx context.Context, userID, contentID int) (*entity.WatchProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWatchProgress", ctx, userID, contentID)
+	ret0, _ := ret[0].(*entity.WatchProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWatchProgress indicates an expected call of GetWatchProgress.
+func (mr *MockWatchProgressMockRecorder) GetWatchProgress(ctx, userID, contentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWatchProgress", reflect.TypeOf((*MockWatchProgress)(nil).GetWatchProgress), ctx, userID, contentID)
+}
+
+// MarkEpisodesWatched mocks base method.
+func (m *MockWatchProgress) MarkEpisodesWatched(ctx context.Context, userID int, episodeIDs []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkEpisodesWatched", ctx, userID, episodeIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkEpisodesWatched indicates an expected call of MarkEpisodesWatched.
+func (mr *MockWatchProgressMockRecorder) MarkEpisodesWatched(ctx, userID, episodeIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkEpisodesWatched", reflect.TypeOf((*MockWatchProgress)(nil).MarkEpisodesWatched), ctx, userID, episodeIDs)
+}
+
+// UnmarkEpisodesWatched mocks base method.
+func (m *MockWatchProgress) UnmarkEpisodesWatched(ctx context.Context, userID int, episodeIDs []int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnmarkEpisodesWatched", ctx, userID, episodeIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnmarkEpisodesWatched indicates an expected call of UnmarkEpisodesWatched.
+func (mr *MockWatchProgressMockRecorder) UnmarkEpisodesWatched(ctx, userID, episodeIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnmarkEpisodesWatched", reflect.TypeOf((*MockWatchProgress)(nil).UnmarkEpisodesWatched), ctx, userID, episodeIDs)
+}
@@ -0,0 +1,138 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+type ActivityDB struct {
+	DB *sqlx.DB
+}
+
+func NewActivityRepository(db *sqlx.DB) repository.Activity {
+	return &ActivityDB{
+		DB: db,
+	}
+}
+
+// AddActivity записывает событие. Незаполненные поля события сохраняются как NULL.
+// В activity записываются ID и CreatedAt
+func (a *ActivityDB) AddActivity(ctx context.Context, activity *entity.Activity) error {
+	defer metrics.ObservePostgresQuery("activity", "AddActivity", time.Now())
+	query, args, err := sq.Insert("activity").
+		Columns("user_id", "type", "content_id", "review_id", "list_id", "rating", "category").
+		Values(
+			activity.UserID,
+			activity.Type,
+			activity.ContentID,
+			sq.Expr("NULLIF(?, 0)", activity.ReviewID),
+			sq.Expr("NULLIF(?, 0)", activity.ListID),
+			sq.Expr("NULLIF(?, 0)", activity.Rating),
+			sq.Expr("NULLIF(?, '')", activity.Category),
+		).
+		Suffix("RETURNING id, created_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса AddActivity"))
+	}
+	err = a.DB.QueryRowContext(ctx, query, args...).Scan(&activity.ID, &activity.CreatedAt)
+	if err != nil {
+		return entity.PSQLQueryErr("AddActivity", err)
+	}
+	return nil
+}
+
+// whereFeed ограничивает запрос событиями ленты пользователя userID: событиями тех, на кого он подписан, кроме
+// событий разделов, скрытых от подписчиков, приватных списков и скрытых модератором рецензий
+func whereFeed(builder sq.SelectBuilder, userID int) sq.SelectBuilder {
+	visible := make(sq.Or, 0, 4)
+	for _, activityType := range []string{
+		entity.ActivityReview,
+		entity.ActivityRating,
+		entity.ActivityListItem,
+		entity.ActivityFavourite,
+	} {
+		// подписчику раздел не виден только на уровне private, см. entity.PrivacyAllows
+		section := "COALESCE(user_privacy." + entity.ActivityPrivacySection(activityType) + ", 'public')"
+		condition := sq.And{
+			sq.Eq{"activity.type": activityType},
+			sq.NotEq{section: entity.PrivacyPrivate},
+		}
+		switch activityType {
+		case entity.ActivityReview:
+			condition = append(condition, sq.Eq{"review.hidden": false})
+		case entity.ActivityListItem:
+			condition = append(condition, sq.Eq{"user_list.is_public": true})
+		}
+		visible = append(visible, condition)
+	}
+	return builder.
+		From("activity").
+		Join("user_follow ON user_follow.followee_id = activity.user_id AND user_follow.follower_id = ?", userID).
+		LeftJoin("user_privacy ON user_privacy.user_id = activity.user_id").
+		LeftJoin("review ON review.id = activity.review_id").
+		LeftJoin("user_list ON user_list.id = activity.list_id").
+		Where(visible)
+}
+
+// GetFeed возвращает события пользователей, на которых подписан userID, начиная с последних
+func (a *ActivityDB) GetFeed(ctx context.Context, userID, page, limit int) ([]*entity.Activity, error) {
+	defer metrics.ObservePostgresQuery("activity", "GetFeed", time.Now())
+	query, args, err := whereFeed(sq.Select(
+		"activity.id",
+		"activity.user_id",
+		"activity.type",
+		"activity.content_id",
+		"COALESCE(activity.review_id, 0) AS review_id",
+		"COALESCE(activity.list_id, 0) AS list_id",
+		"COALESCE(activity.rating, 0) AS rating",
+		"COALESCE(activity.category, '') AS category",
+		"activity.created_at",
+	), userID).
+		OrderBy("activity.created_at DESC", "activity.id DESC").
+		Limit(uint64(limit)).
+		Offset(uint64((page - 1) * limit)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetFeed"))
+	}
+	rows, err := a.DB.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, entity.PSQLQueryErr("GetFeed", err)
+	}
+	defer rows.Close()
+	activities := make([]*entity.Activity, 0)
+	for rows.Next() {
+		activity := new(entity.Activity)
+		if err = rows.StructScan(activity); err != nil {
+			return nil, entity.PSQLQueryErr("GetFeed при сканировании событий", err)
+		}
+		activities = append(activities, activity)
+	}
+	return activities, nil
+}
+
+// GetFeedCount возвращает количество событий в ленте пользователя
+func (a *ActivityDB) GetFeedCount(ctx context.Context, userID int) (int, error) {
+	defer metrics.ObservePostgresQuery("activity", "GetFeedCount", time.Now())
+	query, args, err := whereFeed(sq.Select("COUNT(*)"), userID).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetFeedCount"))
+	}
+	var count int
+	err = a.DB.QueryRowContext(ctx, query, args...).Scan(&count)
+	if err != nil {
+		return 0, entity.PSQLQueryErr("GetFeedCount", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestActivityDB_AddActivity(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Now()
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	repo := NewActivityRepository(sqlx.NewDb(db, "sqlmock"))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"INSERT INTO activity (user_id,type,content_id,review_id,list_id,rating,category) "+
+			"VALUES ($1,$2,$3,NULLIF($4, 0),NULLIF($5, 0),NULLIF($6, 0),NULLIF($7, '')) RETURNING id, created_at",
+	)).
+		WithArgs(1, entity.ActivityRating, 2, 0, 0, 8, "").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(5, fixedTime))
+	activity := &entity.Activity{UserID: 1, Type: entity.ActivityRating, ContentID: 2, Rating: 8}
+	err = repo.AddActivity(context.Background(), activity)
+	require.NoError(t, err)
+	require.Equal(t, &entity.Activity{
+		ID:        5,
+		UserID:    1,
+		Type:      entity.ActivityRating,
+		ContentID: 2,
+		Rating:    8,
+		CreatedAt: fixedTime,
+	}, activity)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestActivityDB_GetFeed(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Now()
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	repo := NewActivityRepository(sqlx.NewDb(db, "sqlmock"))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT activity.id, activity.user_id, activity.type, activity.content_id, "+
+			"COALESCE(activity.review_id, 0) AS review_id, COALESCE(activity.list_id, 0) AS list_id, "+
+			"COALESCE(activity.rating, 0) AS rating, COALESCE(activity.category, '') AS category, "+
+			"activity.created_at FROM activity "+
+			"JOIN user_follow ON user_follow.followee_id = activity.user_id AND user_follow.follower_id = $1 "+
+			"LEFT JOIN user_privacy ON user_privacy.user_id = activity.user_id "+
+			"LEFT JOIN review ON review.id = activity.review_id "+
+			"LEFT JOIN user_list ON user_list.id = activity.list_id "+
+			"WHERE ((activity.type = $2 AND COALESCE(user_privacy.reviews, 'public') <> $3 AND review.hidden = $4) "+
+			"OR (activity.type = $5 AND COALESCE(user_privacy.ratings, 'public') <> $6) "+
+			"OR (activity.type = $7 AND COALESCE(user_privacy.lists, 'public') <> $8 AND user_list.is_public = $9) "+
+			"OR (activity.type = $10 AND COALESCE(user_privacy.favourites, 'public') <> $11)) "+
+			"ORDER BY activity.created_at DESC, activity.id DESC LIMIT 20 OFFSET 0",
+	)).
+		WithArgs(
+			1,
+			entity.ActivityReview, entity.PrivacyPrivate, false,
+			entity.ActivityRating, entity.PrivacyPrivate,
+			entity.ActivityListItem, entity.PrivacyPrivate, true,
+			entity.ActivityFavourite, entity.PrivacyPrivate,
+		).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "user_id", "type", "content_id", "review_id", "list_id", "rating", "category", "created_at",
+		}).
+			AddRow(7, 2, entity.ActivityReview, 3, 4, 0, 0, "", fixedTime).
+			AddRow(6, 5, entity.ActivityFavourite, 3, 0, 0, 0, "watching", fixedTime))
+	activities, err := repo.GetFeed(context.Background(), 1, 1, 20)
+	require.NoError(t, err)
+	require.Equal(t, []*entity.Activity{
+		{ID: 7, UserID: 2, Type: entity.ActivityReview, ContentID: 3, ReviewID: 4, CreatedAt: fixedTime},
+		{ID: 6, UserID: 5, Type: entity.ActivityFavourite, ContentID: 3, Category: "watching", CreatedAt: fixedTime},
+	}, activities)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,215 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+// chartLock ключ advisory-блокировки, под которой пересчитываются подборки на разных экземплярах сервиса
+const chartLock = 20240617
+
+// Подборки заполняются запросами с оконными функциями, которые squirrel не собирает, поэтому они написаны
+// вручную. Во всех подборках, кроме trending, не участвуют еще не вышедшие онгоинги, а места распределяются по
+// взвешенному рейтингу, при равном рейтинге выше контент с большим числом оценок
+const (
+	// за неделю: рецензия весит 3, оценка - 2, добавление в избранное - 1. Вклад события линейно убывает от
+	// полного в момент события до нуля через неделю, поэтому вверху оказывается контент, который обсуждают сейчас
+	trendingChartQuery = `
+INSERT INTO chart_content (chart_key, position, content_id, score)
+WITH event AS (
+    SELECT content_id, 3 AS weight, created_at AS happened_at
+    FROM review
+    WHERE NOT hidden AND created_at >= NOW() - INTERVAL '7 days'
+    UNION ALL
+    SELECT content_id, 2, created_at
+    FROM user_rating
+    WHERE created_at >= NOW() - INTERVAL '7 days'
+    UNION ALL
+    SELECT content_id, 1, updated_at
+    FROM favourite
+    WHERE updated_at >= NOW() - INTERVAL '7 days'
+),
+scored AS (
+    SELECT content_id,
+           SUM(weight * (1 - EXTRACT(EPOCH FROM NOW() - happened_at)
+                             / EXTRACT(EPOCH FROM INTERVAL '7 days')))::FLOAT AS score
+    FROM event
+    GROUP BY content_id
+)
+SELECT $1, ROW_NUMBER() OVER (ORDER BY score DESC, content_id), content_id, score
+FROM scored
+ORDER BY score DESC, content_id
+LIMIT $2`
+
+	topChartQuery = `
+INSERT INTO chart_content (chart_key, position, content_id, score)
+SELECT $1, ROW_NUMBER() OVER (ORDER BY weighted_rating DESC, rating_count DESC, id), id, weighted_rating
+FROM content
+WHERE content_type = $2 AND NOT ongoing
+ORDER BY weighted_rating DESC, rating_count DESC, id
+LIMIT $3`
+
+	genreChartsQuery = `
+INSERT INTO chart_content (chart_key, position, content_id, score)
+SELECT chart_key, position, content_id, score
+FROM (SELECT 'genre:' || genre_content.genre_id AS chart_key,
+             ROW_NUMBER() OVER (
+                 PARTITION BY genre_content.genre_id
+                 ORDER BY content.weighted_rating DESC, content.rating_count DESC, content.id
+             ) AS position,
+             content.id AS content_id,
+             content.weighted_rating AS score
+      FROM genre_content
+      JOIN content ON content.id = genre_content.content_id
+      WHERE NOT content.ongoing) AS ranked
+WHERE position <= $1`
+
+	decadeChartsQuery = `
+INSERT INTO chart_content (chart_key, position, content_id, score)
+SELECT chart_key, position, content_id, score
+FROM (SELECT 'decade:' || content_release.year / 10 * 10 AS chart_key,
+             ROW_NUMBER() OVER (
+                 PARTITION BY content_release.year / 10
+                 ORDER BY content.weighted_rating DESC, content.rating_count DESC, content.id
+             ) AS position,
+             content.id AS content_id,
+             content.weighted_rating AS score
+      FROM content_release
+      JOIN content ON content.id = content_release.content_id
+      WHERE content_release.year IS NOT NULL AND NOT content.ongoing) AS ranked
+WHERE position <= $1`
+)
+
+type ChartDB struct {
+	DB *sqlx.DB
+}
+
+func NewChartRepository(db *sqlx.DB) repository.Chart {
+	return &ChartDB{
+		DB: db,
+	}
+}
+
+func (c *ChartDB) GetChart(ctx context.Context, key string) (*entity.Chart, error) {
+	defer metrics.ObservePostgresQuery("chart", "GetChart", time.Now())
+	query, args, err := sq.Select("key", "title", "refreshed_at").
+		From("chart").
+		Where(sq.Eq{"key": key}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetChart"))
+	}
+	chart := new(entity.Chart)
+	err = c.DB.QueryRowContext(ctx, query, args...).Scan(&chart.Key, &chart.Title, &chart.RefreshedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrChartNotFound
+	}
+	if err != nil {
+		return nil, entity.PSQLQueryErr("GetChart", err)
+	}
+	return chart, nil
+}
+
+func (c *ChartDB) GetChartContent(ctx context.Context, key string, page, limit int) ([]int, error) {
+	defer metrics.ObservePostgresQuery("chart", "GetChartContent", time.Now())
+	query, args, err := sq.Select("content_id").
+		From("chart_content").
+		Where(sq.Eq{"chart_key": key}).
+		OrderBy("position").
+		Limit(uint64(limit)).
+		Offset(uint64((page - 1) * limit)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetChartContent"))
+	}
+	contentIDs := make([]int, 0, limit)
+	if err = c.DB.SelectContext(ctx, &contentIDs, query, args...); err != nil {
+		return nil, entity.PSQLQueryErr("GetChartContent", err)
+	}
+	return contentIDs, nil
+}
+
+func (c *ChartDB) GetChartContentLength(ctx context.Context, key string) (int, error) {
+	defer metrics.ObservePostgresQuery("chart", "GetChartContentLength", time.Now())
+	query, args, err := sq.Select("COUNT(*)").
+		From("chart_content").
+		Where(sq.Eq{"chart_key": key}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetChartContentLength"))
+	}
+	var length int
+	if err = c.DB.QueryRowContext(ctx, query, args...).Scan(&length); err != nil {
+		return 0, entity.PSQLQueryErr("GetChartContentLength", err)
+	}
+	return length, nil
+}
+
+// RefreshCharts удаляет все подборки и создает их заново. Подборки жанров создаются для всех жанров, а
+// подборки десятилетий - только для десятилетий, в которых вышел хотя бы один контент. Заодно обновляется
+// средняя оценка по сайту, которую recalculate_content_rating берет как априорную оценку
+func (c *ChartDB) RefreshCharts(ctx context.Context, limits entity.ChartLimits) error {
+	defer metrics.ObservePostgresQuery("chart", "RefreshCharts", time.Now())
+	// sq.Expr возвращает написанные вручную запросы без изменений
+	statements := []sq.Sqlizer{
+		sq.Update("site_rating").
+			Set("average", sq.Expr("(SELECT COALESCE(AVG(rating), 0) FROM content_rating_vote)")).
+			Set("refreshed_at", sq.Expr("NOW()")).
+			PlaceholderFormat(sq.Dollar),
+		sq.Delete("chart").PlaceholderFormat(sq.Dollar),
+		sq.Insert("chart").
+			Columns("key", "title").
+			Values(entity.ChartTrending, "В тренде на этой неделе").
+			Values(entity.ChartTopMovies, "250 лучших фильмов").
+			Values(entity.ChartTopSeries, "250 лучших сериалов").
+			PlaceholderFormat(sq.Dollar),
+		sq.Insert("chart").
+			Columns("key", "title").
+			Select(sq.Select("'genre:' || id", "'Лучшее в жанре «' || name || '»'").From("genre")).
+			PlaceholderFormat(sq.Dollar),
+		sq.Insert("chart").
+			Columns("key", "title").
+			Select(sq.Select("'decade:' || year / 10 * 10", "'Лучшее ' || year / 10 * 10 || '-х'").
+				Distinct().
+				From("content_release").
+				Where("year IS NOT NULL")).
+			PlaceholderFormat(sq.Dollar),
+		sq.Expr(trendingChartQuery, entity.ChartTrending, limits.Trending),
+		sq.Expr(topChartQuery, entity.ChartTopMovies, entity.ContentTypeMovie, limits.Top),
+		sq.Expr(topChartQuery, entity.ChartTopSeries, entity.ContentTypeSeries, limits.Top),
+		sq.Expr(genreChartsQuery, limits.Genre),
+		sq.Expr(decadeChartsQuery, limits.Decade),
+	}
+	tx, err := c.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return entity.PSQLQueryErr("RefreshCharts при открытии транзакции", err)
+	}
+	// после успешного Commit откат ничего не делает
+	defer tx.Rollback() // nolint: errcheck
+	if err = advisoryXactLock(ctx, tx, chartLock, "RefreshCharts"); err != nil {
+		return err
+	}
+	for _, statement := range statements {
+		query, args, err := statement.ToSql()
+		if err != nil {
+			return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса RefreshCharts"))
+		}
+		if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+			return entity.PSQLQueryErr("RefreshCharts", err)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return entity.PSQLQueryErr("RefreshCharts при фиксации транзакции", err)
+	}
+	return nil
+}
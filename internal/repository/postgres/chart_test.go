@@ -0,0 +1,187 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestChartDB_GetChart(t *testing.T) {
+	t.Parallel()
+
+	refreshedAt := time.Date(2024, 6, 17, 12, 0, 0, 0, time.UTC)
+	query := regexp.QuoteMeta("SELECT key, title, refreshed_at FROM chart WHERE key = $1")
+	testCases := []struct {
+		Name        string
+		SetupMock   func(mock sqlmock.Sqlmock)
+		Expected    *entity.Chart
+		ExpectedErr error
+	}{
+		{
+			Name: "Подборка найдена",
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(entity.ChartTrending).
+					WillReturnRows(sqlmock.NewRows([]string{"key", "title", "refreshed_at"}).
+						AddRow(entity.ChartTrending, "В тренде на этой неделе", refreshedAt))
+			},
+			Expected: &entity.Chart{
+				Key:         entity.ChartTrending,
+				Title:       "В тренде на этой неделе",
+				RefreshedAt: refreshedAt,
+			},
+		},
+		{
+			Name: "Подборка не найдена",
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(query).
+					WithArgs(entity.ChartTrending).
+					WillReturnRows(sqlmock.NewRows([]string{"key", "title", "refreshed_at"}))
+			},
+			ExpectedErr: repository.ErrChartNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewChartRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			chart, err := repo.GetChart(context.Background(), entity.ChartTrending)
+			require.ErrorIs(t, err, tc.ExpectedErr)
+			require.Equal(t, tc.Expected, chart)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestChartDB_GetChartContent(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	repo := NewChartRepository(sqlx.NewDb(db, "sqlmock"))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT content_id FROM chart_content WHERE chart_key = $1 ORDER BY position LIMIT 10 OFFSET 10",
+	)).
+		WithArgs("genre:1").
+		WillReturnRows(sqlmock.NewRows([]string{"content_id"}).AddRow(3).AddRow(1))
+	contentIDs, err := repo.GetChartContent(context.Background(), "genre:1", 2, 10)
+	require.NoError(t, err)
+	require.Equal(t, []int{3, 1}, contentIDs)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChartDB_GetChartContentLength(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	repo := NewChartRepository(sqlx.NewDb(db, "sqlmock"))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM chart_content WHERE chart_key = $1")).
+		WithArgs(entity.ChartTopMovies).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(250))
+	length, err := repo.GetChartContentLength(context.Background(), entity.ChartTopMovies)
+	require.NoError(t, err)
+	require.Equal(t, 250, length)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChartDB_RefreshCharts(t *testing.T) {
+	t.Parallel()
+
+	limits := entity.ChartLimits{Trending: 100, Top: 250, Genre: 50, Decade: 30}
+	testCases := []struct {
+		Name        string
+		SetupMock   func(mock sqlmock.Sqlmock)
+		ExpectedErr bool
+	}{
+		{
+			Name: "Подборки пересчитаны",
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				result := sqlmock.NewResult(0, 1)
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_xact_lock($1)")).
+					WithArgs(chartLock).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(regexp.QuoteMeta(
+					"UPDATE site_rating SET average = (SELECT COALESCE(AVG(rating), 0) FROM content_rating_vote), " +
+						"refreshed_at = NOW()",
+				)).
+					WillReturnResult(result)
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM chart")).
+					WillReturnResult(result)
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO chart (key,title) VALUES ($1,$2),($3,$4),($5,$6)")).
+					WithArgs(
+						entity.ChartTrending, "В тренде на этой неделе",
+						entity.ChartTopMovies, "250 лучших фильмов",
+						entity.ChartTopSeries, "250 лучших сериалов",
+					).
+					WillReturnResult(result)
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO chart (key,title) SELECT 'genre:' || id")).
+					WillReturnResult(result)
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO chart (key,title) SELECT DISTINCT 'decade:'")).
+					WillReturnResult(result)
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO chart_content")).
+					WithArgs(entity.ChartTrending, 100).
+					WillReturnResult(result)
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO chart_content")).
+					WithArgs(entity.ChartTopMovies, entity.ContentTypeMovie, 250).
+					WillReturnResult(result)
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO chart_content")).
+					WithArgs(entity.ChartTopSeries, entity.ContentTypeSeries, 250).
+					WillReturnResult(result)
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO chart_content")).
+					WithArgs(50).
+					WillReturnResult(result)
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO chart_content")).
+					WithArgs(30).
+					WillReturnResult(result)
+				mock.ExpectCommit()
+			},
+		},
+		{
+			Name: "Ошибка при пересчете",
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_xact_lock($1)")).
+					WithArgs(chartLock).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE site_rating")).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM chart")).
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			ExpectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewChartRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			err = repo.RefreshCharts(context.Background(), limits)
+			if tc.ExpectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
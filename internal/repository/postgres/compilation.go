@@ -1,12 +1,15 @@
 package postgres
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	sq "github.com/Masterminds/squirrel"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
 	"github.com/jmoiron/sqlx"
+	"time"
 )
 
 type CompilationDB struct {
@@ -21,7 +24,8 @@ func NewCompilationRepository(db *sqlx.DB) repository.Compilation {
 }
 
 // GetCompilation получает подборку по ID
-func (c *CompilationDB) GetCompilation(id int) (*entity.Compilation, error) {
+func (c *CompilationDB) GetCompilation(ctx context.Context, id int) (*entity.Compilation, error) {
+	defer metrics.ObservePostgresQuery("compilation", "GetCompilation", time.Now())
 	query, args, err := sq.Select("id", "title", "compilation_type_id", "poster_upload_id").
 		From("compilation").
 		Where(sq.Eq{"id": id}).
@@ -30,7 +34,7 @@ func (c *CompilationDB) GetCompilation(id int) (*entity.Compilation, error) {
 	if err != nil {
 		return nil, errors.Join(errors.New("ошибка при составлении запроса GetCompilation"), err)
 	}
-	row := c.DB.QueryRow(query, args...)
+	row := c.DB.QueryRowContext(ctx, query, args...)
 	compilation := entity.Compilation{}
 	var posterUploadID sql.NullInt64
 	err = row.Scan(
@@ -52,7 +56,11 @@ func (c *CompilationDB) GetCompilation(id int) (*entity.Compilation, error) {
 }
 
 // GetCompilationsByTypeID получает все подборки по ID категории
-func (c *CompilationDB) GetCompilationsByTypeID(compilationTypeID int) ([]*entity.Compilation, error) {
+func (c *CompilationDB) GetCompilationsByTypeID(
+	ctx context.Context,
+	compilationTypeID int,
+) ([]*entity.Compilation, error) {
+	defer metrics.ObservePostgresQuery("compilation", "GetCompilationsByTypeID", time.Now())
 	query, args, err := sq.Select("id", "title", "compilation_type_id", "poster_upload_id").
 		From("compilation").
 		Where(sq.Eq{"compilation_type_id": compilationTypeID}).
@@ -62,7 +70,7 @@ func (c *CompilationDB) GetCompilationsByTypeID(compilationTypeID int) ([]*entit
 	if err != nil {
 		return nil, errors.Join(errors.New("ошибка при составлении запроса GetCompilationsByTypeID"))
 	}
-	rows, err := c.DB.Query(query, args...)
+	rows, err := c.DB.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, entity.PSQLQueryErr("GetCompilationsByTypeID", err)
 	}
@@ -89,7 +97,8 @@ func (c *CompilationDB) GetCompilationsByTypeID(compilationTypeID int) ([]*entit
 }
 
 // GetCompilationContentLength получает число контента в подборке
-func (c *CompilationDB) GetCompilationContentLength(id int) (int, error) {
+func (c *CompilationDB) GetCompilationContentLength(ctx context.Context, id int) (int, error) {
+	defer metrics.ObservePostgresQuery("compilation", "GetCompilationContentLength", time.Now())
 	query, args, err := sq.Select("count(*)").
 		From("compilation_content").
 		Where(sq.Eq{"compilation_id": id}).
@@ -99,7 +108,7 @@ func (c *CompilationDB) GetCompilationContentLength(id int) (int, error) {
 		return 0, entity.PSQLWrap(err, errors.New("ошибка при формировании sql-запроса GetCompilationContentLength"))
 	}
 	var length int
-	err = c.DB.QueryRow(query, args...).Scan(&length)
+	err = c.DB.QueryRowContext(ctx, query, args...).Scan(&length)
 	if err != nil {
 		return 0, entity.PSQLQueryErr("GetCompilationContentLength", err)
 	}
@@ -107,12 +116,13 @@ func (c *CompilationDB) GetCompilationContentLength(id int) (int, error) {
 }
 
 // GetCompilationContent получает список id контента из бд у конкретной подборки по ID
-func (c *CompilationDB) GetCompilationContent(id, page, limit int) ([]int, error) {
+func (c *CompilationDB) GetCompilationContent(ctx context.Context, id, page, limit int) ([]int, error) {
+	defer metrics.ObservePostgresQuery("compilation", "GetCompilationContent", time.Now())
 	query, args, err := sq.Select("content_id").
 		From("compilation_content").
 		Join("content ON compilation_content.content_id = content.id").
 		Where(sq.Eq{"compilation_id": id}).
-		OrderBy("content.rating DESC", "id ASC").
+		OrderBy("content.weighted_rating DESC", "id ASC").
 		Limit(uint64(limit)).
 		Offset(uint64((page - 1) * limit)).
 		PlaceholderFormat(sq.Dollar).
@@ -120,7 +130,7 @@ func (c *CompilationDB) GetCompilationContent(id, page, limit int) ([]int, error
 	if err != nil {
 		return nil, errors.Join(errors.New("ошибка при составлении запроса GetCompilationContent"), err)
 	}
-	rows, err := c.DB.Query(query, args...)
+	rows, err := c.DB.QueryContext(ctx, query, args...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, repository.ErrCompilationNotFound
@@ -141,7 +151,8 @@ func (c *CompilationDB) GetCompilationContent(id, page, limit int) ([]int, error
 }
 
 // GetAllCompilationTypes получает все категории подборок
-func (c *CompilationDB) GetAllCompilationTypes() ([]entity.CompilationType, error) {
+func (c *CompilationDB) GetAllCompilationTypes(ctx context.Context) ([]entity.CompilationType, error) {
+	defer metrics.ObservePostgresQuery("compilation", "GetAllCompilationTypes", time.Now())
 	query, args, err := sq.Select("id", "type").
 		From("compilation_type").
 		OrderBy("id ASC").
@@ -150,7 +161,7 @@ func (c *CompilationDB) GetAllCompilationTypes() ([]entity.CompilationType, erro
 	if err != nil {
 		return nil, errors.Join(errors.New("ошибка при составлении запроса GetAllCompilationTypes"), err)
 	}
-	rows, err := c.DB.Query(query, args...)
+	rows, err := c.DB.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, entity.PSQLQueryErr("GetAllCompilationTypes", err)
 	}
@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"context"
 	"database/sql"
 	"database/sql/driver"
 	"fmt"
@@ -68,7 +69,7 @@ func TestCompilationDB_GetCompilationsByTypeID(t *testing.T) {
 				driverValues[i] = v
 			}
 			tc.SetupMock(mock, query, driverValues)
-			output, err := repo.GetCompilationsByTypeID(tc.RequestID)
+			output, err := repo.GetCompilationsByTypeID(context.Background(), tc.RequestID)
 			require.Equal(t, tc.ExpectedOutput, output)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
@@ -128,7 +129,7 @@ func TestCompilationDB_GetCompilationContentLength(t *testing.T) {
 				driverValues[i] = v
 			}
 			tc.SetupMock(mock, query, driverValues)
-			output, err := repo.GetCompilationContentLength(tc.RequestID)
+			output, err := repo.GetCompilationContentLength(context.Background(), tc.RequestID)
 			require.Equal(t, tc.ExpectedOutput, output)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
@@ -205,7 +206,7 @@ func TestCompilationDB_GetCompilationContent(t *testing.T) {
 				From("compilation_content").
 				Join("content ON compilation_content.content_id = content.id").
 				Where(sq.Eq{"compilation_id": tc.RequestID}).
-				OrderBy("content.rating DESC", "id ASC").
+				OrderBy("content.weighted_rating DESC", "id ASC").
 				Limit(uint64(tc.Limit)).
 				Offset(uint64((tc.Page - 1) * tc.Limit)).
 				PlaceholderFormat(sq.Dollar).
@@ -216,7 +217,7 @@ func TestCompilationDB_GetCompilationContent(t *testing.T) {
 				driverValues[i] = v
 			}
 			tc.SetupMock(mock, query, driverValues)
-			output, err := repo.GetCompilationContent(tc.RequestID, tc.Page, tc.Limit)
+			output, err := repo.GetCompilationContent(context.Background(), tc.RequestID, tc.Page, tc.Limit)
 			require.Equal(t, tc.ExpectedOutput, output)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
@@ -266,7 +267,7 @@ func TestCompilationDB_GetAllCompilationTypes(t *testing.T) {
 				PlaceholderFormat(sq.Dollar).
 				ToSql()
 			tc.SetupMock(mock, query, nil)
-			output, err := repo.GetAllCompilationTypes()
+			output, err := repo.GetAllCompilationTypes(context.Background())
 			require.Equal(t, tc.ExpectedOutput, output)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
@@ -7,10 +7,12 @@ import (
 	"fmt"
 	sq "github.com/Masterminds/squirrel"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
 	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
 	"golang.org/x/sync/errgroup"
+	"time"
 )
 
 type ContentDB struct {
@@ -42,7 +44,7 @@ func NewContentRepository(db *sqlx.DB) repository.Content {
 }
 
 // getMovieData возвращает информацию о фильме по его ID
-func (c *ContentDB) getMovieData(id int) (*entity.Movie, error) {
+func (c *ContentDB) getMovieData(ctx context.Context, id int) (*entity.Movie, error) {
 	query, args, err := sq.Select("premiere", "duration").
 		From("movie").
 		Where(sq.Eq{"content_id": id}).
@@ -54,7 +56,7 @@ func (c *ContentDB) getMovieData(id int) (*entity.Movie, error) {
 	var movie entity.Movie
 	var premiere sql.NullTime
 	var duration sql.NullInt64
-	err = c.DB.QueryRow(query, args...).Scan(&premiere, &duration)
+	err = c.DB.QueryRowContext(ctx, query, args...).Scan(&premiere, &duration)
 	if err != nil {
 		return nil, entity.PSQLQueryErr("getMovieData", err)
 	}
@@ -64,7 +66,7 @@ func (c *ContentDB) getMovieData(id int) (*entity.Movie, error) {
 }
 
 // getSeriesData возвращает информацию о сериале по его ID
-func (c *ContentDB) getSeriesData(id int) (*entity.Series, error) {
+func (c *ContentDB) getSeriesData(ctx context.Context, id int) (*entity.Series, error) {
 	query, args, err := sq.Select("year_start", "year_end").
 		From("series").
 		Where(sq.Eq{"content_id": id}).
@@ -75,13 +77,13 @@ func (c *ContentDB) getSeriesData(id int) (*entity.Series, error) {
 	}
 	var series entity.Series
 	var yearStart, yearEnd sql.NullInt64
-	err = c.DB.QueryRow(query, args...).Scan(&yearStart, &yearEnd)
+	err = c.DB.QueryRowContext(ctx, query, args...).Scan(&yearStart, &yearEnd)
 	if err != nil {
 		return nil, entity.PSQLQueryErr("getSeriesData", err)
 	}
 	series.YearStart = int(yearStart.Int64)
 	series.YearEnd = int(yearEnd.Int64)
-	seasons, err := c.getSeasonsByContentID(id)
+	seasons, err := c.getSeasonsByContentID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
@@ -90,8 +92,8 @@ func (c *ContentDB) getSeriesData(id int) (*entity.Series, error) {
 }
 
 // getEpisodeData возвращает информацию об эпизоде по его ID
-func (c *ContentDB) getEpisodeData(id int) (*entity.Episode, error) {
-	query, args, err := sq.Select("id", "episode_number", "title", "duration").
+func (c *ContentDB) getEpisodeData(ctx context.Context, id int) (*entity.Episode, error) {
+	query, args, err := sq.Select("id", "episode_number", "title", "duration", "rating", "rating_count").
 		From("episode").
 		Where(sq.Eq{"id": id}).
 		PlaceholderFormat(sq.Dollar).
@@ -101,7 +103,14 @@ func (c *ContentDB) getEpisodeData(id int) (*entity.Episode, error) {
 	}
 	var duration sql.NullInt64
 	var episode entity.Episode
-	err = c.DB.QueryRow(query, args...).Scan(&episode.ID, &episode.EpisodeNumber, &episode.Title, &duration)
+	err = c.DB.QueryRowContext(ctx, query, args...).Scan(
+		&episode.ID,
+		&episode.EpisodeNumber,
+		&episode.Title,
+		&duration,
+		&episode.Rating,
+		&episode.RatingCount,
+	)
 	episode.Duration = int(duration.Int64)
 	if err != nil {
 		return nil, entity.PSQLQueryErr("getEpisodeData", err)
@@ -111,7 +120,7 @@ func (c *ContentDB) getEpisodeData(id int) (*entity.Episode, error) {
 
 // getEpisodesBySeasonID возвращает эпизоды сезона по его ID
 // nolint: dupl
-func (c *ContentDB) getEpisodesBySeasonID(seasonID int) ([]entity.Episode, error) {
+func (c *ContentDB) getEpisodesBySeasonID(ctx context.Context, seasonID int) ([]entity.Episode, error) {
 	query, args, err := sq.Select("id").
 		From("episode").
 		Where(sq.Eq{"season_id": seasonID}).
@@ -120,7 +129,7 @@ func (c *ContentDB) getEpisodesBySeasonID(seasonID int) ([]entity.Episode, error
 	if err != nil {
 		return nil, entity.PSQLWrap(err, fmt.Errorf("ошибка при формировании запроса getEpisodesBySeasonID"))
 	}
-	rows, err := c.DB.Query(query, args...)
+	rows, err := c.DB.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, entity.PSQLQueryErr("getEpisodesBySeasonID", err)
 	}
@@ -132,7 +141,7 @@ func (c *ContentDB) getEpisodesBySeasonID(seasonID int) ([]entity.Episode, error
 		if err != nil {
 			return nil, entity.PSQLQueryErr("getEpisodesBySeasonID при сканировании", err)
 		}
-		episode, err := c.getEpisodeData(episodeID)
+		episode, err := c.getEpisodeData(ctx, episodeID)
 		if err != nil {
 			return nil, err
 		}
@@ -142,8 +151,8 @@ func (c *ContentDB) getEpisodesBySeasonID(seasonID int) ([]entity.Episode, error
 }
 
 // getSeasonData возвращает информацию о сезоне по его ID
-func (c *ContentDB) getSeasonData(id int) (*entity.Season, error) {
-	query, args, err := sq.Select("id", "title").
+func (c *ContentDB) getSeasonData(ctx context.Context, id int) (*entity.Season, error) {
+	query, args, err := sq.Select("id", "title", "rating", "rating_count").
 		From("season").
 		Where(sq.Eq{"id": id}).
 		PlaceholderFormat(sq.Dollar).
@@ -152,7 +161,7 @@ func (c *ContentDB) getSeasonData(id int) (*entity.Season, error) {
 		return nil, entity.PSQLWrap(err, fmt.Errorf("ошибка при формировании запроса getSeasonData"))
 	}
 	var season entity.Season
-	err = c.DB.QueryRow(query, args...).Scan(&season.ID, &season.Title)
+	err = c.DB.QueryRowContext(ctx, query, args...).Scan(&season.ID, &season.Title, &season.Rating, &season.RatingCount)
 	if err != nil {
 		return nil, entity.PSQLQueryErr("getSeasonData при сканировании", err)
 	}
@@ -160,7 +169,7 @@ func (c *ContentDB) getSeasonData(id int) (*entity.Season, error) {
 }
 
 // getSeasonsByContentID возвращает сезоны контента по его ID
-func (c *ContentDB) getSeasonsByContentID(contentID int) ([]entity.Season, error) {
+func (c *ContentDB) getSeasonsByContentID(ctx context.Context, contentID int) ([]entity.Season, error) {
 	query, args, err := sq.Select("id").
 		From("season").
 		Where(sq.Eq{"series_id": contentID}).
@@ -169,7 +178,7 @@ func (c *ContentDB) getSeasonsByContentID(contentID int) ([]entity.Season, error
 	if err != nil {
 		return nil, entity.PSQLWrap(err, fmt.Errorf("ошибка при формировании запроса getSeasonsByContentID"))
 	}
-	rows, err := c.DB.Query(query, args...)
+	rows, err := c.DB.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, entity.PSQLQueryErr("getSeasonsByContentID", err)
 	}
@@ -181,11 +190,11 @@ func (c *ContentDB) getSeasonsByContentID(contentID int) ([]entity.Season, error
 		if err != nil {
 			return nil, entity.PSQLQueryErr("getSeasonsByContentID при сканировании", err)
 		}
-		season, err := c.getSeasonData(seasonID)
+		season, err := c.getSeasonData(ctx, seasonID)
 		if err != nil {
 			return nil, err
 		}
-		episodes, err := c.getEpisodesBySeasonID(seasonID)
+		episodes, err := c.getEpisodesBySeasonID(ctx, seasonID)
 		if err != nil {
 			return nil, err
 		}
@@ -196,7 +205,7 @@ func (c *ContentDB) getSeasonsByContentID(contentID int) ([]entity.Season, error
 }
 
 // getRoleIDByName возвращает айди роли по ее названию
-func (c *ContentDB) getRoleIDByName(role string) (int, error) {
+func (c *ContentDB) getRoleIDByName(ctx context.Context, role string) (int, error) {
 	query, args, err := sq.Select("id").
 		From("role").
 		Where(sq.Eq{"name_en": role}).
@@ -206,7 +215,7 @@ func (c *ContentDB) getRoleIDByName(role string) (int, error) {
 		return 0, entity.PSQLWrap(err, fmt.Errorf("ошибка при формировании запроса getRoleIDByName"))
 	}
 	var roleID int
-	err = c.DB.QueryRow(query, args...).Scan(&roleID)
+	err = c.DB.QueryRowContext(ctx, query, args...).Scan(&roleID)
 	if err != nil {
 		return 0, entity.PSQLQueryErr("getRoleIDByName", err)
 	}
@@ -214,8 +223,12 @@ func (c *ContentDB) getRoleIDByName(role string) (int, error) {
 }
 
 // getPersonsByRoleAndContentID возвращает персон контента по его ID и роли
-func (c *ContentDB) getPersonsByRoleAndContentID(role string, contentID int) ([]entity.Person, error) {
-	roleID, err := c.getRoleIDByName(role)
+func (c *ContentDB) getPersonsByRoleAndContentID(
+	ctx context.Context,
+	role string,
+	contentID int,
+) ([]entity.Person, error) {
+	roleID, err := c.getRoleIDByName(ctx, role)
 	if err != nil {
 		return nil, err
 	}
@@ -228,7 +241,7 @@ func (c *ContentDB) getPersonsByRoleAndContentID(role string, contentID int) ([]
 		return nil,
 			entity.PSQLWrap(err, fmt.Errorf("ошибка при формировании запроса getPersonsByRoleAndContentID"))
 	}
-	rows, err := c.DB.Query(query, args...)
+	rows, err := c.DB.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, entity.PSQLQueryErr("getPersonsByRoleAndContentID", err)
 	}
@@ -240,7 +253,7 @@ func (c *ContentDB) getPersonsByRoleAndContentID(role string, contentID int) ([]
 		if err != nil {
 			return nil, entity.PSQLQueryErr("getPersonsByRoleAndContentID при сканировании", err)
 		}
-		person, err := c.GetPerson(personID)
+		person, err := c.GetPerson(ctx, personID)
 		if err != nil {
 			return nil, err
 		}
@@ -250,14 +263,14 @@ func (c *ContentDB) getPersonsByRoleAndContentID(role string, contentID int) ([]
 }
 
 // getGenreByID возвращает жанр по его ID
-func (c *ContentDB) getGenreByID(id int) (*entity.Genre, error) {
+func (c *ContentDB) getGenreByID(ctx context.Context, id int) (*entity.Genre, error) {
 	query, args, _ := sq.Select("name").
 		From("genre").
 		Where(sq.Eq{"id": id}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	var genre entity.Genre
-	err := c.DB.QueryRow(query, args...).Scan(&genre.Name)
+	err := c.DB.QueryRowContext(ctx, query, args...).Scan(&genre.Name)
 	genre.ID = id
 	if err != nil {
 		return nil, entity.PSQLQueryErr("getGenreByID", err)
@@ -267,7 +280,7 @@ func (c *ContentDB) getGenreByID(id int) (*entity.Genre, error) {
 
 // getContentGenres возвращает жанры контента по его ID
 // nolint: dupl
-func (c *ContentDB) getContentGenres(id int) ([]entity.Genre, error) {
+func (c *ContentDB) getContentGenres(ctx context.Context, id int) ([]entity.Genre, error) {
 	query, args, err := sq.Select("genre_id").
 		From("genre_content").
 		Where(sq.Eq{"content_id": id}).
@@ -276,7 +289,7 @@ func (c *ContentDB) getContentGenres(id int) ([]entity.Genre, error) {
 	if err != nil {
 		return nil, entity.PSQLWrap(err, fmt.Errorf("ошибка при формировании запроса getContentGenres"))
 	}
-	rows, err := c.DB.Query(query, args...)
+	rows, err := c.DB.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, entity.PSQLQueryErr("getContentGenres", err)
 	}
@@ -288,7 +301,7 @@ func (c *ContentDB) getContentGenres(id int) ([]entity.Genre, error) {
 		if err != nil {
 			return nil, entity.PSQLQueryErr("getContentGenres при сканировании", err)
 		}
-		genre, err := c.getGenreByID(genreID)
+		genre, err := c.getGenreByID(ctx, genreID)
 		if err != nil {
 			return nil, err
 		}
@@ -299,7 +312,7 @@ func (c *ContentDB) getContentGenres(id int) ([]entity.Genre, error) {
 }
 
 // getCountryByID возвращает страну по ее ID
-func (c *ContentDB) getCountryByID(id int) (*entity.Country, error) {
+func (c *ContentDB) getCountryByID(ctx context.Context, id int) (*entity.Country, error) {
 	query, args, err := sq.Select("name").
 		From("country").
 		Where(sq.Eq{"id": id}).
@@ -309,7 +322,7 @@ func (c *ContentDB) getCountryByID(id int) (*entity.Country, error) {
 		return nil, entity.PSQLWrap(err, fmt.Errorf("ошибка при формировании запроса getCountryByID"))
 	}
 	var country entity.Country
-	err = c.DB.QueryRow(query, args...).Scan(&country.Name)
+	err = c.DB.QueryRowContext(ctx, query, args...).Scan(&country.Name)
 	country.ID = id
 	if err != nil {
 		return nil, entity.PSQLQueryErr("getCountryByID", err)
@@ -319,7 +332,7 @@ func (c *ContentDB) getCountryByID(id int) (*entity.Country, error) {
 
 // getContentProductionCountries возвращает страны производства контента по его ID
 // nolint: dupl
-func (c *ContentDB) getContentProductionCountries(id int) ([]entity.Country, error) {
+func (c *ContentDB) getContentProductionCountries(ctx context.Context, id int) ([]entity.Country, error) {
 	query, args, err := sq.Select("country_id").
 		From("country_content").
 		Where(sq.Eq{"content_id": id}).
@@ -329,7 +342,7 @@ func (c *ContentDB) getContentProductionCountries(id int) ([]entity.Country, err
 		return nil,
 			entity.PSQLWrap(err, fmt.Errorf("ошибка при формировании запроса getContentProductionCountries"))
 	}
-	rows, err := c.DB.Query(query, args...)
+	rows, err := c.DB.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, entity.PSQLQueryErr("getContentProductionCountries", err)
 	}
@@ -341,7 +354,7 @@ func (c *ContentDB) getContentProductionCountries(id int) ([]entity.Country, err
 		if err != nil {
 			return nil, entity.PSQLQueryErr("getContentProductionCountries при сканировании", err)
 		}
-		country, err := c.getCountryByID(countryID)
+		country, err := c.getCountryByID(ctx, countryID)
 		if err != nil {
 			return nil, err
 		}
@@ -351,7 +364,7 @@ func (c *ContentDB) getContentProductionCountries(id int) ([]entity.Country, err
 }
 
 // getContentFacts возвращает факты о контенте по его ID
-func (c *ContentDB) getContentFacts(id int) ([]string, error) {
+func (c *ContentDB) getContentFacts(ctx context.Context, id int) ([]string, error) {
 	query, args, err := sq.Select("fact").
 		From("content_fact").
 		Where(sq.Eq{"content_id": id}).
@@ -360,7 +373,7 @@ func (c *ContentDB) getContentFacts(id int) ([]string, error) {
 	if err != nil {
 		return nil, entity.PSQLWrap(err, fmt.Errorf("ошибка при формировании запроса getContentFacts"))
 	}
-	rows, err := c.DB.Query(query, args...)
+	rows, err := c.DB.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, entity.PSQLQueryErr("getContentFacts", err)
 	}
@@ -378,7 +391,7 @@ func (c *ContentDB) getContentFacts(id int) ([]string, error) {
 }
 
 // getContentPictures возвращает изображения контента по его ID
-func (c *ContentDB) getContentPictures(id int) ([]int, error) {
+func (c *ContentDB) getContentPictures(ctx context.Context, id int) ([]int, error) {
 	query, args, err := sq.Select("static_id").
 		From("content_image").
 		Where(sq.Eq{"content_id": id}).
@@ -387,7 +400,7 @@ func (c *ContentDB) getContentPictures(id int) ([]int, error) {
 	if err != nil {
 		return nil, entity.PSQLWrap(err, fmt.Errorf("ошибка при формировании запроса getContentPictures"))
 	}
-	rows, err := c.DB.Query(query, args...)
+	rows, err := c.DB.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, entity.PSQLQueryErr("getContentPictures", err)
 	}
@@ -404,7 +417,7 @@ func (c *ContentDB) getContentPictures(id int) ([]int, error) {
 	return pictures, nil
 }
 
-func (c *ContentDB) getContentInfo(id int) (*entity.Content, error) {
+func (c *ContentDB) getContentInfo(ctx context.Context, id int) (*entity.Content, error) {
 	query, args, err := sq.Select(
 		"id",
 		"content_type",
@@ -431,7 +444,7 @@ func (c *ContentDB) getContentInfo(id int) (*entity.Content, error) {
 	}
 	var scanContent ScanContent
 	var content entity.Content
-	err = c.DB.QueryRow(query, args...).Scan(
+	err = c.DB.QueryRowContext(ctx, query, args...).Scan(
 		&scanContent.ID,
 		&scanContent.ContentType,
 		&scanContent.Title,
@@ -473,18 +486,19 @@ func (c *ContentDB) getContentInfo(id int) (*entity.Content, error) {
 	return &content, nil
 }
 
-func (c *ContentDB) GetContent(id int) (*entity.Content, error) {
-	group, _ := errgroup.WithContext(context.Background())
+func (c *ContentDB) GetContent(ctx context.Context, id int) (*entity.Content, error) {
+	defer metrics.ObservePostgresQuery("content", "GetContent", time.Now())
+	group, ctx := errgroup.WithContext(ctx)
 
 	// получаем основную информацию о контенте
-	content, err := c.getContentInfo(id)
+	content, err := c.getContentInfo(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
 	// запрашиваем дополнительные данные
 	group.Go(func() error {
-		pictures, err := c.getContentPictures(id)
+		pictures, err := c.getContentPictures(ctx, id)
 		if err != nil {
 			return err
 		}
@@ -493,7 +507,7 @@ func (c *ContentDB) GetContent(id int) (*entity.Content, error) {
 	})
 
 	group.Go(func() error {
-		facts, err := c.getContentFacts(id)
+		facts, err := c.getContentFacts(ctx, id)
 		if err != nil {
 			return err
 		}
@@ -502,7 +516,7 @@ func (c *ContentDB) GetContent(id int) (*entity.Content, error) {
 	})
 
 	group.Go(func() error {
-		countries, err := c.getContentProductionCountries(id)
+		countries, err := c.getContentProductionCountries(ctx, id)
 		if err != nil {
 			return err
 		}
@@ -511,7 +525,7 @@ func (c *ContentDB) GetContent(id int) (*entity.Content, error) {
 	})
 
 	group.Go(func() error {
-		genres, err := c.getContentGenres(id)
+		genres, err := c.getContentGenres(ctx, id)
 		if err != nil {
 			return err
 		}
@@ -520,7 +534,7 @@ func (c *ContentDB) GetContent(id int) (*entity.Content, error) {
 	})
 
 	group.Go(func() error {
-		actors, err := c.getPersonsByRoleAndContentID(entity.RoleActor, id)
+		actors, err := c.getPersonsByRoleAndContentID(ctx, entity.RoleActor, id)
 		if err != nil {
 			return err
 		}
@@ -529,7 +543,7 @@ func (c *ContentDB) GetContent(id int) (*entity.Content, error) {
 	})
 
 	group.Go(func() error {
-		directors, err := c.getPersonsByRoleAndContentID(entity.RoleDirector, id)
+		directors, err := c.getPersonsByRoleAndContentID(ctx, entity.RoleDirector, id)
 		if err != nil {
 			return err
 		}
@@ -538,7 +552,7 @@ func (c *ContentDB) GetContent(id int) (*entity.Content, error) {
 	})
 
 	group.Go(func() error {
-		producers, err := c.getPersonsByRoleAndContentID(entity.RoleProducer, id)
+		producers, err := c.getPersonsByRoleAndContentID(ctx, entity.RoleProducer, id)
 		if err != nil {
 			return err
 		}
@@ -547,7 +561,7 @@ func (c *ContentDB) GetContent(id int) (*entity.Content, error) {
 	})
 
 	group.Go(func() error {
-		writers, err := c.getPersonsByRoleAndContentID(entity.RoleWriter, id)
+		writers, err := c.getPersonsByRoleAndContentID(ctx, entity.RoleWriter, id)
 		if err != nil {
 			return err
 		}
@@ -556,7 +570,7 @@ func (c *ContentDB) GetContent(id int) (*entity.Content, error) {
 	})
 
 	group.Go(func() error {
-		operators, err := c.getPersonsByRoleAndContentID(entity.RoleOperator, id)
+		operators, err := c.getPersonsByRoleAndContentID(ctx, entity.RoleOperator, id)
 		if err != nil {
 			return err
 		}
@@ -565,7 +579,7 @@ func (c *ContentDB) GetContent(id int) (*entity.Content, error) {
 	})
 
 	group.Go(func() error {
-		composers, err := c.getPersonsByRoleAndContentID(entity.RoleComposer, id)
+		composers, err := c.getPersonsByRoleAndContentID(ctx, entity.RoleComposer, id)
 		if err != nil {
 			return err
 		}
@@ -574,7 +588,7 @@ func (c *ContentDB) GetContent(id int) (*entity.Content, error) {
 	})
 
 	group.Go(func() error {
-		editors, err := c.getPersonsByRoleAndContentID(entity.RoleEditor, id)
+		editors, err := c.getPersonsByRoleAndContentID(ctx, entity.RoleEditor, id)
 		if err != nil {
 			return err
 		}
@@ -585,13 +599,13 @@ func (c *ContentDB) GetContent(id int) (*entity.Content, error) {
 	group.Go(func() error {
 		switch content.Type {
 		case entity.ContentTypeMovie:
-			movie, err := c.getMovieData(id)
+			movie, err := c.getMovieData(ctx, id)
 			if err != nil {
 				return err
 			}
 			content.Movie = movie
 		case entity.ContentTypeSeries:
-			series, err := c.getSeriesData(id)
+			series, err := c.getSeriesData(ctx, id)
 			if err != nil {
 				return err
 			}
@@ -607,7 +621,7 @@ func (c *ContentDB) GetContent(id int) (*entity.Content, error) {
 	return content, nil
 }
 
-func (c *ContentDB) getPreviewContentInfo(id int) (*entity.Content, error) {
+func (c *ContentDB) getPreviewContentInfo(ctx context.Context, id int) (*entity.Content, error) {
 	query, args, err := sq.Select(
 		"id",
 		"content_type",
@@ -627,7 +641,7 @@ func (c *ContentDB) getPreviewContentInfo(id int) (*entity.Content, error) {
 	}
 	var scanContent ScanContent
 	var content entity.Content
-	err = c.DB.QueryRow(query, args...).Scan(
+	err = c.DB.QueryRowContext(ctx, query, args...).Scan(
 		&scanContent.ID,
 		&scanContent.ContentType,
 		&scanContent.Title,
@@ -660,18 +674,19 @@ func (c *ContentDB) getPreviewContentInfo(id int) (*entity.Content, error) {
 // Заполняет только поля id, title, original_title, poster_upload_id, countries, genres, actors, directors.
 // Для фильма заполняет поля premiere, duration.
 // Для сериала заполняет поля year_start, year_end, seasons.
-func (c *ContentDB) GetPreviewContent(id int) (*entity.Content, error) {
-	group, _ := errgroup.WithContext(context.Background())
+func (c *ContentDB) GetPreviewContent(ctx context.Context, id int) (*entity.Content, error) {
+	defer metrics.ObservePostgresQuery("content", "GetPreviewContent", time.Now())
+	group, ctx := errgroup.WithContext(ctx)
 
 	// запрашиваем краткую информацию о контенте
-	content, err := c.getPreviewContentInfo(id)
+	content, err := c.getPreviewContentInfo(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
 	// запрашиваем дополнительные данные
 	group.Go(func() error {
-		countries, err := c.getContentProductionCountries(id)
+		countries, err := c.getContentProductionCountries(ctx, id)
 		if err != nil {
 			return err
 		}
@@ -680,7 +695,7 @@ func (c *ContentDB) GetPreviewContent(id int) (*entity.Content, error) {
 	})
 
 	group.Go(func() error {
-		genres, err := c.getContentGenres(id)
+		genres, err := c.getContentGenres(ctx, id)
 		if err != nil {
 			return err
 		}
@@ -689,7 +704,7 @@ func (c *ContentDB) GetPreviewContent(id int) (*entity.Content, error) {
 	})
 
 	group.Go(func() error {
-		actors, err := c.getPersonsByRoleAndContentID(entity.RoleActor, id)
+		actors, err := c.getPersonsByRoleAndContentID(ctx, entity.RoleActor, id)
 		if err != nil {
 			return err
 		}
@@ -698,7 +713,7 @@ func (c *ContentDB) GetPreviewContent(id int) (*entity.Content, error) {
 	})
 
 	group.Go(func() error {
-		directors, err := c.getPersonsByRoleAndContentID(entity.RoleDirector, id)
+		directors, err := c.getPersonsByRoleAndContentID(ctx, entity.RoleDirector, id)
 		if err != nil {
 			return err
 		}
@@ -709,13 +724,13 @@ func (c *ContentDB) GetPreviewContent(id int) (*entity.Content, error) {
 	group.Go(func() error {
 		switch content.Type {
 		case entity.ContentTypeMovie:
-			movie, err := c.getMovieData(id)
+			movie, err := c.getMovieData(ctx, id)
 			if err != nil {
 				return err
 			}
 			content.Movie = movie
 		case entity.ContentTypeSeries:
-			series, err := c.getSeriesData(id)
+			series, err := c.getSeriesData(ctx, id)
 			if err != nil {
 				return err
 			}
@@ -731,7 +746,299 @@ func (c *ContentDB) GetPreviewContent(id int) (*entity.Content, error) {
 	return content, nil
 }
 
-func (c *ContentDB) GetPerson(id int) (*entity.Person, error) {
+// GetPreviewContents возвращает краткую информацию о нескольких контентах в порядке ids.
+// Заполняет те же поля, что и GetPreviewContent, кроме сезонов сериала, и делает постоянное число запросов
+// независимо от количества контента. Если хотя бы один контент не найден, возвращает ErrContentNotFound
+func (c *ContentDB) GetPreviewContents(ctx context.Context, ids []int) ([]*entity.Content, error) {
+	defer metrics.ObservePostgresQuery("content", "GetPreviewContents", time.Now())
+	if len(ids) == 0 {
+		return []*entity.Content{}, nil
+	}
+	contents, err := c.getPreviewContentsInfo(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	// каждый запрос заполняет свои поля контента, поэтому их можно выполнять параллельно
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.Go(func() error {
+		return c.fillContentsProductionCountries(groupCtx, contents, ids)
+	})
+	group.Go(func() error {
+		return c.fillContentsGenres(groupCtx, contents, ids)
+	})
+	group.Go(func() error {
+		return c.fillContentsPreviewPersons(groupCtx, contents, ids)
+	})
+	group.Go(func() error {
+		return c.fillContentsMovieData(groupCtx, contents, ids)
+	})
+	group.Go(func() error {
+		return c.fillContentsSeriesData(groupCtx, contents, ids)
+	})
+	if err = group.Wait(); err != nil {
+		return nil, err
+	}
+
+	result := make([]*entity.Content, len(ids))
+	for index, id := range ids {
+		content, ok := contents[id]
+		if !ok {
+			return nil, repository.ErrContentNotFound
+		}
+		result[index] = content
+	}
+	return result, nil
+}
+
+// getPreviewContentsInfo возвращает краткую информацию о контентах по их ID
+func (c *ContentDB) getPreviewContentsInfo(ctx context.Context, ids []int) (map[int]*entity.Content, error) {
+	query, args, err := sq.Select(
+		"id",
+		"content_type",
+		"title",
+		"original_title",
+		"rating",
+		"poster_upload_id",
+		"ongoing",
+		"ongoing_date",
+	).
+		From("content").
+		Where(sq.Eq{"id": ids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, fmt.Errorf("ошибка при формировании запроса getPreviewContentsInfo"))
+	}
+	rows, err := c.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, entity.PSQLQueryErr("getPreviewContentsInfo", err)
+	}
+	defer rows.Close()
+	contents := make(map[int]*entity.Content, len(ids))
+	for rows.Next() {
+		var scanContent ScanContent
+		err = rows.Scan(
+			&scanContent.ID,
+			&scanContent.ContentType,
+			&scanContent.Title,
+			&scanContent.OriginalTitle,
+			&scanContent.Rating,
+			&scanContent.PosterStaticID,
+			&scanContent.Ongoing,
+			&scanContent.OngoingDate,
+		)
+		if err != nil {
+			return nil, entity.PSQLQueryErr("getPreviewContentsInfo при сканировании", err)
+		}
+		content := &entity.Content{
+			ID:             scanContent.ID,
+			Type:           scanContent.ContentType,
+			Title:          scanContent.Title,
+			OriginalTitle:  scanContent.OriginalTitle.String,
+			Rating:         scanContent.Rating,
+			PosterStaticID: int(scanContent.PosterStaticID.Int64),
+			Ongoing:        scanContent.Ongoing,
+		}
+		if scanContent.OngoingDate.Valid {
+			content.OngoingDate = &scanContent.OngoingDate.Time
+		}
+		contents[content.ID] = content
+	}
+	return contents, nil
+}
+
+// fillContentsProductionCountries заполняет страны производства контентов
+// nolint: dupl
+func (c *ContentDB) fillContentsProductionCountries(
+	ctx context.Context,
+	contents map[int]*entity.Content,
+	ids []int,
+) error {
+	query, args, err := sq.Select("country_content.content_id", "country.id", "country.name").
+		From("country_content").
+		Join("country ON country.id = country_content.country_id").
+		Where(sq.Eq{"country_content.content_id": ids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, fmt.Errorf("ошибка при формировании запроса fillContentsProductionCountries"))
+	}
+	rows, err := c.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return entity.PSQLQueryErr("fillContentsProductionCountries", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var contentID int
+		var country entity.Country
+		if err = rows.Scan(&contentID, &country.ID, &country.Name); err != nil {
+			return entity.PSQLQueryErr("fillContentsProductionCountries при сканировании", err)
+		}
+		if content, ok := contents[contentID]; ok {
+			content.Country = append(content.Country, country)
+		}
+	}
+	return nil
+}
+
+// fillContentsGenres заполняет жанры контентов
+// nolint: dupl
+func (c *ContentDB) fillContentsGenres(ctx context.Context, contents map[int]*entity.Content, ids []int) error {
+	query, args, err := sq.Select("genre_content.content_id", "genre.id", "genre.name").
+		From("genre_content").
+		Join("genre ON genre.id = genre_content.genre_id").
+		Where(sq.Eq{"genre_content.content_id": ids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, fmt.Errorf("ошибка при формировании запроса fillContentsGenres"))
+	}
+	rows, err := c.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return entity.PSQLQueryErr("fillContentsGenres", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var contentID int
+		var genre entity.Genre
+		if err = rows.Scan(&contentID, &genre.ID, &genre.Name); err != nil {
+			return entity.PSQLQueryErr("fillContentsGenres при сканировании", err)
+		}
+		if content, ok := contents[contentID]; ok {
+			content.Genres = append(content.Genres, genre)
+		}
+	}
+	return nil
+}
+
+// fillContentsPreviewPersons заполняет актеров и режиссеров контентов
+func (c *ContentDB) fillContentsPreviewPersons(
+	ctx context.Context,
+	contents map[int]*entity.Content,
+	ids []int,
+) error {
+	query, args, err := sq.Select(
+		"person_role.content_id",
+		"role.name_en",
+		"person.id",
+		"person.name",
+		"person.en_name",
+		"person.birth_date",
+		"person.death_date",
+		"person.sex",
+		"person.height",
+		"person.photo_upload_id",
+	).
+		From("person_role").
+		Join("role ON role.id = person_role.role_id").
+		Join("person ON person.id = person_role.person_id").
+		Where(sq.Eq{
+			"person_role.content_id": ids,
+			"role.name_en":           []string{entity.RoleActor, entity.RoleDirector},
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, fmt.Errorf("ошибка при формировании запроса fillContentsPreviewPersons"))
+	}
+	rows, err := c.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return entity.PSQLQueryErr("fillContentsPreviewPersons", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var contentID int
+		var role string
+		var person entity.Person
+		err = rows.Scan(
+			&contentID,
+			&role,
+			&person.ID,
+			&person.Name,
+			&person.EnName,
+			&person.BirthDate,
+			&person.DeathDate,
+			&person.Sex,
+			&person.Height,
+			&person.PhotoStaticID,
+		)
+		if err != nil {
+			return entity.PSQLQueryErr("fillContentsPreviewPersons при сканировании", err)
+		}
+		content, ok := contents[contentID]
+		if !ok {
+			continue
+		}
+		switch role {
+		case entity.RoleActor:
+			content.Actors = append(content.Actors, person)
+		case entity.RoleDirector:
+			content.Directors = append(content.Directors, person)
+		}
+	}
+	return nil
+}
+
+// fillContentsMovieData заполняет информацию о фильмах среди контентов
+func (c *ContentDB) fillContentsMovieData(ctx context.Context, contents map[int]*entity.Content, ids []int) error {
+	query, args, err := sq.Select("content_id", "premiere", "duration").
+		From("movie").
+		Where(sq.Eq{"content_id": ids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, fmt.Errorf("ошибка при формировании запроса fillContentsMovieData"))
+	}
+	rows, err := c.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return entity.PSQLQueryErr("fillContentsMovieData", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var contentID int
+		var premiere sql.NullTime
+		var duration sql.NullInt64
+		if err = rows.Scan(&contentID, &premiere, &duration); err != nil {
+			return entity.PSQLQueryErr("fillContentsMovieData при сканировании", err)
+		}
+		if content, ok := contents[contentID]; ok && content.Type == entity.ContentTypeMovie {
+			content.Movie = &entity.Movie{Premiere: premiere.Time, Duration: int(duration.Int64)}
+		}
+	}
+	return nil
+}
+
+// fillContentsSeriesData заполняет годы выхода сериалов среди контентов. Сезоны не загружаются
+func (c *ContentDB) fillContentsSeriesData(ctx context.Context, contents map[int]*entity.Content, ids []int) error {
+	query, args, err := sq.Select("content_id", "year_start", "year_end").
+		From("series").
+		Where(sq.Eq{"content_id": ids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, fmt.Errorf("ошибка при формировании запроса fillContentsSeriesData"))
+	}
+	rows, err := c.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return entity.PSQLQueryErr("fillContentsSeriesData", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var contentID int
+		var yearStart, yearEnd sql.NullInt64
+		if err = rows.Scan(&contentID, &yearStart, &yearEnd); err != nil {
+			return entity.PSQLQueryErr("fillContentsSeriesData при сканировании", err)
+		}
+		if content, ok := contents[contentID]; ok && content.Type == entity.ContentTypeSeries {
+			content.Series = &entity.Series{YearStart: int(yearStart.Int64), YearEnd: int(yearEnd.Int64)}
+		}
+	}
+	return nil
+}
+
+func (c *ContentDB) GetPerson(ctx context.Context, id int) (*entity.Person, error) {
+	defer metrics.ObservePostgresQuery("content", "GetPerson", time.Now())
 	query, args, err := sq.Select(
 		"id",
 		"name",
@@ -750,7 +1057,7 @@ func (c *ContentDB) GetPerson(id int) (*entity.Person, error) {
 		return nil, entity.PSQLWrap(err, fmt.Errorf("ошибка при формировании запроса GetPerson"))
 	}
 	person := new(entity.Person)
-	err = c.DB.QueryRowx(query, args...).StructScan(person)
+	err = c.DB.QueryRowxContext(ctx, query, args...).StructScan(person)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, repository.ErrPersonNotFound
@@ -762,14 +1069,15 @@ func (c *ContentDB) GetPerson(id int) (*entity.Person, error) {
 }
 
 // GetPersonRoles возвращает список контента, в создании которого персона принимала участие по ID персоны.
-func (c *ContentDB) GetPersonRoles(personID int) ([]entity.PersonRole, error) {
+func (c *ContentDB) GetPersonRoles(ctx context.Context, personID int) ([]entity.PersonRole, error) {
+	defer metrics.ObservePostgresQuery("content", "GetPersonRoles", time.Now())
 	// получаем всевозможные роли
 	var roles []entity.Role
 	query, args, err := sq.Select("id", "name", "name_en").From("role").ToSql()
 	if err != nil {
 		return nil, entity.PSQLWrap(err, fmt.Errorf("ошибка при формировании запроса GetPersonRoles"))
 	}
-	rows, err := c.DB.Query(query, args...)
+	rows, err := c.DB.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, entity.PSQLQueryErr("GetPersonRoles при получении списка ролей", err)
 	}
@@ -794,7 +1102,7 @@ func (c *ContentDB) GetPersonRoles(personID int) ([]entity.PersonRole, error) {
 		if err != nil {
 			return nil, entity.PSQLWrap(err, fmt.Errorf("ошибка при формировании запроса GetPersonRoles"))
 		}
-		rows, err := c.DB.Query(query, args...)
+		rows, err := c.DB.QueryContext(ctx, query, args...)
 		if err != nil {
 			return nil, entity.PSQLQueryErr("GetPersonRoles при получении ролей персоны", err)
 		}
@@ -815,65 +1123,103 @@ func (c *ContentDB) GetPersonRoles(personID int) ([]entity.PersonRole, error) {
 	return personRoles, nil
 }
 
-func (c *ContentDB) GetSimilarContent(id int) ([]entity.Content, error) {
-	// ну тут уж придётся обойтись без squirrel :)
-	query := `
-    WITH target_persons AS (
-        SELECT pr.person_id
-        FROM person_role pr
-        WHERE pr.content_id = $1
-    ),
-    target_genres AS (
-        SELECT gc.genre_id
-        FROM genre_content gc
-        WHERE gc.content_id = $1
-    ),
-    matched_contents AS (
-        SELECT pr.content_id, COUNT(*) AS person_match_count
-        FROM person_role pr
-        JOIN target_persons tp ON pr.person_id = tp.person_id
-        WHERE pr.content_id != $1
-        GROUP BY pr.content_id
-    ),
-    genre_matched_contents AS (
-        SELECT gc.content_id, COUNT(*) AS genre_match_count
-        FROM genre_content gc
-        JOIN target_genres tg ON gc.genre_id = tg.genre_id
-        WHERE gc.content_id != $1
-        GROUP BY gc.content_id
-    )
-    SELECT mc.content_id
-    FROM matched_contents mc
-    JOIN genre_matched_contents gmc ON mc.content_id = gmc.content_id
-    ORDER BY (mc.person_match_count + gmc.genre_match_count) DESC
-    LIMIT 10;
-    `
-
-	rows, err := c.DB.Query(query, id)
+// GetSimilarContent возвращает похожий контент, заранее вычисленный фоновой задачей в content_similarity
+func (c *ContentDB) GetSimilarContent(ctx context.Context, id int) ([]entity.Content, error) {
+	defer metrics.ObservePostgresQuery("content", "GetSimilarContent", time.Now())
+	query, args, err := sq.Select("similar_id").
+		From("content_similarity").
+		Where(sq.Eq{"content_id": id}).
+		OrderBy("score DESC", "similar_id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetSimilarContent"))
+	}
+	var ids []int
+	if err = c.DB.SelectContext(ctx, &ids, query, args...); err != nil {
+		return nil, entity.PSQLQueryErr("GetSimilarContent", err)
+	}
+	previews, err := c.GetPreviewContents(ctx, ids)
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
+	contents := make([]entity.Content, len(previews))
+	for index, preview := range previews {
+		contents[index] = *preview
+	}
+	return contents, nil
+}
+
+// bestEpisodesPriorVotes вес априорной оценки во взвешенной оценке эпизода: столько оценок нужно, чтобы средняя
+// оценка эпизода весила столько же, сколько средняя оценка эпизодов сериала
+const bestEpisodesPriorVotes = 5
 
-	// Собираем результаты
-	var contents []entity.Content
+// GetBestEpisodes возвращает оцененные эпизоды сериала, начиная с эпизодов с наибольшей взвешенной оценкой
+// (v * R + m * C) / (v + m), где v - число оценок эпизода, R - его средняя оценка, m - bestEpisodesPriorVotes,
+// C - средняя оценка всех оцененных эпизодов сериала. Так эпизод с единственной оценкой 10 не оказывается выше
+// эпизода с сотней оценок 9. При равной оценке выше эпизод с большим числом оценок
+func (c *ContentDB) GetBestEpisodes(ctx context.Context, contentID, limit int) ([]entity.SeasonEpisode, error) {
+	defer metrics.ObservePostgresQuery("content", "GetBestEpisodes", time.Now())
+	query, args, err := sq.Select(
+		"episode.id",
+		"episode.episode_number",
+		"episode.title",
+		"episode.duration",
+		"episode.rating",
+		"episode.rating_count",
+		"season.id",
+		"season.title",
+	).
+		From("episode").
+		Join("season ON season.id = episode.season_id").
+		Join("series ON series.id = season.series_id").
+		Where(sq.And{
+			sq.Eq{"series.content_id": contentID},
+			sq.Gt{"episode.rating_count": 0},
+		}).
+		OrderByClause(
+			"(episode.rating * episode.rating_count + "+
+				"? * SUM(episode.rating * episode.rating_count) OVER () / SUM(episode.rating_count) OVER ()) / "+
+				"(episode.rating_count + ?) DESC",
+			bestEpisodesPriorVotes, bestEpisodesPriorVotes,
+		).
+		OrderBy("episode.rating_count DESC", "episode.id ASC").
+		Limit(uint64(limit)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, fmt.Errorf("ошибка при формировании запроса GetBestEpisodes"))
+	}
+	rows, err := c.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, entity.PSQLQueryErr("GetBestEpisodes", err)
+	}
+	defer rows.Close()
+	episodes := make([]entity.SeasonEpisode, 0, limit)
 	for rows.Next() {
-		var contentID int
-		err := rows.Scan(&contentID)
+		var episode entity.SeasonEpisode
+		var duration sql.NullInt64
+		err = rows.Scan(
+			&episode.ID,
+			&episode.EpisodeNumber,
+			&episode.Title,
+			&duration,
+			&episode.Rating,
+			&episode.RatingCount,
+			&episode.SeasonID,
+			&episode.SeasonTitle,
+		)
 		if err != nil {
-			return nil, err
+			return nil, entity.PSQLQueryErr("GetBestEpisodes при сканировании", err)
 		}
-		content, err := c.GetPreviewContent(contentID)
-		if err != nil {
-			return nil, err
-		}
-		contents = append(contents, *content)
+		episode.Duration = int(duration.Int64)
+		episodes = append(episodes, episode)
 	}
-
-	return contents, nil
+	return episodes, nil
 }
 
-func (c *ContentDB) GetNearestOngoings(limit int) ([]int, error) {
+func (c *ContentDB) GetNearestOngoings(ctx context.Context, limit int) ([]int, error) {
+	defer metrics.ObservePostgresQuery("content", "GetNearestOngoings", time.Now())
 	query, args, err := sq.Select("id").
 		From("content").
 		Where(sq.Eq{"ongoing": true}).
@@ -885,7 +1231,7 @@ func (c *ContentDB) GetNearestOngoings(limit int) ([]int, error) {
 		return nil, entity.PSQLWrap(err, fmt.Errorf("ошибка при формировании запроса GetNearestOngoings"))
 	}
 
-	rows, err := c.DB.Query(query, args...)
+	rows, err := c.DB.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, entity.PSQLQueryErr("GetNearestOngoings", err)
 	}
@@ -904,7 +1250,8 @@ func (c *ContentDB) GetNearestOngoings(limit int) ([]int, error) {
 	return contentIDs, nil
 }
 
-func (c *ContentDB) GetOngoingContentByMonthAndYear(month, year int) ([]int, error) {
+func (c *ContentDB) GetOngoingContentByMonthAndYear(ctx context.Context, month, year int) ([]int, error) {
+	defer metrics.ObservePostgresQuery("content", "GetOngoingContentByMonthAndYear", time.Now())
 	query, args, err := sq.Select("id").
 		From("content").
 		Where(sq.Eq{"ongoing": true}).
@@ -916,7 +1263,7 @@ func (c *ContentDB) GetOngoingContentByMonthAndYear(month, year int) ([]int, err
 		return nil, entity.PSQLWrap(err, fmt.Errorf("ошибка при формировании запроса GetOngoingContentByMonthAndYear"))
 	}
 
-	rows, err := c.DB.Query(query, args...)
+	rows, err := c.DB.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, entity.PSQLQueryErr("GetOngoingContentByMonthAndYear", err)
 	}
@@ -935,7 +1282,8 @@ func (c *ContentDB) GetOngoingContentByMonthAndYear(month, year int) ([]int, err
 	return contentIDs, nil
 }
 
-func (c *ContentDB) GetAllOngoingsYears() ([]int, error) {
+func (c *ContentDB) GetAllOngoingsYears(ctx context.Context) ([]int, error) {
+	defer metrics.ObservePostgresQuery("content", "GetAllOngoingsYears", time.Now())
 	query, args, err := sq.Select("EXTRACT(YEAR FROM ongoing_date)").
 		From("content").
 		Where(sq.Eq{"ongoing": true}).
@@ -946,7 +1294,7 @@ func (c *ContentDB) GetAllOngoingsYears() ([]int, error) {
 		return nil, entity.PSQLWrap(err, fmt.Errorf("ошибка при формировании запроса GetAllOngoingsYears"))
 	}
 
-	rows, err := c.DB.Query(query, args...)
+	rows, err := c.DB.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, entity.PSQLQueryErr("GetAllOngoingsYears", err)
 	}
@@ -965,7 +1313,8 @@ func (c *ContentDB) GetAllOngoingsYears() ([]int, error) {
 	return years, nil
 }
 
-func (c *ContentDB) IsOngoingContentReleased(contentID int) (bool, error) {
+func (c *ContentDB) IsOngoingContentReleased(ctx context.Context, contentID int) (bool, error) {
+	defer metrics.ObservePostgresQuery("content", "IsOngoingContentReleased", time.Now())
 	query, args, err := sq.Select("ongoing").
 		From("content").
 		Where(sq.Eq{"id": contentID}).
@@ -976,7 +1325,7 @@ func (c *ContentDB) IsOngoingContentReleased(contentID int) (bool, error) {
 	}
 
 	var finished bool
-	err = c.DB.QueryRow(query, args...).Scan(&finished)
+	err = c.DB.QueryRowContext(ctx, query, args...).Scan(&finished)
 	if err != nil {
 		return false, entity.PSQLQueryErr("IsOngoingContentReleased", err)
 	}
@@ -984,7 +1333,8 @@ func (c *ContentDB) IsOngoingContentReleased(contentID int) (bool, error) {
 	return !finished, nil
 }
 
-func (c *ContentDB) SetReleasedState(contentID int, released bool) error {
+func (c *ContentDB) SetReleasedState(ctx context.Context, contentID int, released bool) error {
+	defer metrics.ObservePostgresQuery("content", "SetReleasedState", time.Now())
 	query, args, err := sq.Update("content").
 		Set("ongoing", !released).
 		Where(sq.Eq{"id": contentID}).
@@ -994,7 +1344,7 @@ func (c *ContentDB) SetReleasedState(contentID int, released bool) error {
 		return entity.PSQLWrap(err, fmt.Errorf("ошибка при формировании запроса SetReleasedState"))
 	}
 
-	rowsAffected, err := c.DB.Exec(query, args...)
+	rowsAffected, err := c.DB.ExecContext(ctx, query, args...)
 	if err != nil {
 		return entity.PSQLQueryErr("SetReleasedState", err)
 	}
@@ -1005,7 +1355,8 @@ func (c *ContentDB) SetReleasedState(contentID int, released bool) error {
 	return nil
 }
 
-func (c *ContentDB) SubscribeOnContent(userID, contentID int) error {
+func (c *ContentDB) SubscribeOnContent(ctx context.Context, userID, contentID int) error {
+	defer metrics.ObservePostgresQuery("content", "SubscribeOnContent", time.Now())
 	query, args, err := sq.Insert("ongoing_subscribe").
 		Columns("user_id", "content_id").
 		Values(userID, contentID).
@@ -1015,7 +1366,7 @@ func (c *ContentDB) SubscribeOnContent(userID, contentID int) error {
 		return entity.PSQLWrap(err, fmt.Errorf("ошибка при формировании запроса SubscribeOnContent"))
 	}
 
-	_, err = c.DB.Exec(query, args...)
+	_, err = c.DB.ExecContext(ctx, query, args...)
 	var pqErr *pq.Error
 	if errors.As(err, &pqErr) && pqErr.Code == entity.PSQLUniqueViolation {
 		return nil
@@ -1027,7 +1378,8 @@ func (c *ContentDB) SubscribeOnContent(userID, contentID int) error {
 	return nil
 }
 
-func (c *ContentDB) UnsubscribeFromContent(userID, contentID int) error {
+func (c *ContentDB) UnsubscribeFromContent(ctx context.Context, userID, contentID int) error {
+	defer metrics.ObservePostgresQuery("content", "UnsubscribeFromContent", time.Now())
 	query, args, err := sq.Delete("ongoing_subscribe").
 		Where(sq.Eq{"user_id": userID, "content_id": contentID}).
 		PlaceholderFormat(sq.Dollar).
@@ -1037,7 +1389,7 @@ func (c *ContentDB) UnsubscribeFromContent(userID, contentID int) error {
 	}
 
 	// Неважно сколько rows affected, т.к. пользователь может не быть подписан на контент
-	_, err = c.DB.Exec(query, args...)
+	_, err = c.DB.ExecContext(ctx, query, args...)
 	if err != nil {
 		return entity.PSQLQueryErr("UnsubscribeFromContent", err)
 	}
@@ -1045,7 +1397,8 @@ func (c *ContentDB) UnsubscribeFromContent(userID, contentID int) error {
 	return nil
 }
 
-func (c *ContentDB) GetSubscribedContentIDs(userID int) ([]int, error) {
+func (c *ContentDB) GetSubscribedContentIDs(ctx context.Context, userID int) ([]int, error) {
+	defer metrics.ObservePostgresQuery("content", "GetSubscribedContentIDs", time.Now())
 	query, args, err := sq.Select("content_id").
 		From("ongoing_subscribe").
 		Where(sq.Eq{"user_id": userID}).
@@ -1055,7 +1408,7 @@ func (c *ContentDB) GetSubscribedContentIDs(userID int) ([]int, error) {
 		return nil, entity.PSQLWrap(err, fmt.Errorf("ошибка при формировании запроса GetSubscribedContentIDs"))
 	}
 
-	rows, err := c.DB.Query(query, args...)
+	rows, err := c.DB.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, entity.PSQLQueryErr("GetSubscribedContentIDs", err)
 	}
@@ -1073,3 +1426,8 @@ func (c *ContentDB) GetSubscribedContentIDs(userID int) ([]int, error) {
 
 	return contentIDs, nil
 }
+
+// InvalidateContent ничего не делает: Postgres всегда отдает актуальные данные, сброс нужен только кэшу
+func (c *ContentDB) InvalidateContent(context.Context, int) error {
+	return nil
+}
@@ -0,0 +1,232 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"time"
+)
+
+// contentSimilarityLock ключ advisory-блокировки, под которой пересчитывается похожий контент. Без нее
+// инкрементальный пересчет и полная перестройка, в том числе на разных экземплярах сервиса, вставляли бы
+// одни и те же строки
+const contentSimilarityLock = 20240616
+
+// computeContentSimilarityQuery вычисляет похожий контент для контента из $1 (NULL - для всего контента) и
+// сохраняет до $2 самых похожих. Кандидаты - контент с общим режиссером, сценаристом или актером и контент
+// хотя бы с двумя общими жанрами (или единственным жанром, если он у контента один): пар только с одним общим
+// жанром слишком много. Score лежит в [0, 1] и складывается из:
+//   - 0.3 - коэффициент Жаккара по жанрам;
+//   - 0.1 - доля стран контента, которые есть у похожего;
+//   - 0.2, 0.1 и 0.15 - общий режиссер, общий сценарист и до трех общих актеров;
+//   - 0.1 - близость года выхода (премьера фильма или начало сериала), разница от 20 лет дает 0;
+//   - 0.05 - близость рейтинга, разница от 5 баллов дает 0.
+//
+// Запрос с CTE и оконными функциями squirrel не собирает, поэтому он написан вручную
+const computeContentSimilarityQuery = `
+INSERT INTO content_similarity (content_id, similar_id, score)
+WITH target AS (
+    SELECT id FROM content WHERE $1::INT[] IS NULL OR id = ANY($1)
+),
+feature AS (
+    SELECT content.id,
+           content.rating,
+           COALESCE(EXTRACT(YEAR FROM movie.premiere)::INT, series.year_start) AS year,
+           (SELECT COUNT(*) FROM genre_content WHERE genre_content.content_id = content.id) AS genres,
+           (SELECT COUNT(*) FROM country_content WHERE country_content.content_id = content.id) AS countries
+    FROM content
+    LEFT JOIN movie ON movie.content_id = content.id
+    LEFT JOIN series ON series.content_id = content.id
+),
+shared_genre AS (
+    SELECT a.content_id, b.content_id AS similar_id, COUNT(*) AS shared
+    FROM target
+    JOIN genre_content a ON a.content_id = target.id
+    JOIN genre_content b ON b.genre_id = a.genre_id AND b.content_id <> a.content_id
+    GROUP BY a.content_id, b.content_id
+),
+shared_person AS (
+    SELECT a.content_id,
+           b.content_id AS similar_id,
+           COUNT(DISTINCT a.person_id) FILTER (WHERE role.name_en = 'director') AS directors,
+           COUNT(DISTINCT a.person_id) FILTER (WHERE role.name_en = 'writer') AS writers,
+           COUNT(DISTINCT a.person_id) FILTER (WHERE role.name_en = 'actor') AS actors
+    FROM target
+    JOIN person_role a ON a.content_id = target.id
+    JOIN role ON role.id = a.role_id
+    JOIN person_role b ON b.person_id = a.person_id AND b.role_id = a.role_id AND b.content_id <> a.content_id
+    WHERE role.name_en IN ('director', 'writer', 'actor')
+    GROUP BY a.content_id, b.content_id
+),
+candidate AS (
+    SELECT content_id, similar_id FROM shared_person
+    UNION
+    SELECT shared_genre.content_id, shared_genre.similar_id
+    FROM shared_genre
+    JOIN feature ON feature.id = shared_genre.content_id
+    WHERE shared_genre.shared >= LEAST(feature.genres, 2)
+),
+scored AS (
+    SELECT candidate.content_id,
+           candidate.similar_id,
+           0.3 * COALESCE(shared_genre.shared, 0)::FLOAT
+               / GREATEST(fa.genres + fb.genres - COALESCE(shared_genre.shared, 0), 1)
+               + 0.1 * (SELECT COUNT(*)
+                        FROM country_content ca
+                        JOIN country_content cb ON cb.country_id = ca.country_id
+                        WHERE ca.content_id = candidate.content_id
+                          AND cb.content_id = candidate.similar_id)::FLOAT / GREATEST(fa.countries, 1)
+               + 0.2 * LEAST(COALESCE(shared_person.directors, 0), 1)
+               + 0.1 * LEAST(COALESCE(shared_person.writers, 0), 1)
+               + 0.15 * LEAST(COALESCE(shared_person.actors, 0), 3)::FLOAT / 3
+               + 0.1 * COALESCE(1 - LEAST(ABS(fa.year - fb.year), 20)::FLOAT / 20, 0)
+               + 0.05 * (1 - LEAST(ABS(fa.rating - fb.rating), 5)::FLOAT / 5) AS score
+    FROM candidate
+    JOIN feature fa ON fa.id = candidate.content_id
+    JOIN feature fb ON fb.id = candidate.similar_id
+    LEFT JOIN shared_genre
+        ON shared_genre.content_id = candidate.content_id AND shared_genre.similar_id = candidate.similar_id
+    LEFT JOIN shared_person
+        ON shared_person.content_id = candidate.content_id AND shared_person.similar_id = candidate.similar_id
+),
+ranked AS (
+    SELECT content_id, similar_id, score,
+           ROW_NUMBER() OVER (PARTITION BY content_id ORDER BY score DESC, similar_id) AS rank
+    FROM scored
+)
+SELECT content_id, similar_id, score
+FROM ranked
+WHERE rank <= $2`
+
+type ContentSimilarityDB struct {
+	DB *sqlx.DB
+}
+
+func NewContentSimilarityRepository(db *sqlx.DB) repository.ContentSimilarity {
+	return &ContentSimilarityDB{
+		DB: db,
+	}
+}
+
+// RefreshStale забирает из очереди самые давно измененные контенты. Контент, у которого измененный был среди
+// похожих, пересчитывается вместе с ними, а контент, у которого измененный только должен был появиться среди
+// похожих, получит его при следующей полной перестройке
+func (c *ContentSimilarityDB) RefreshStale(ctx context.Context, limit, batch int) (int, error) {
+	defer metrics.ObservePostgresQuery("content_similarity", "RefreshStale", time.Now())
+	tx, err := c.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, entity.PSQLQueryErr("RefreshStale при открытии транзакции", err)
+	}
+	// после успешного Commit откат ничего не делает
+	defer tx.Rollback() // nolint: errcheck
+	if err = advisoryXactLock(ctx, tx, contentSimilarityLock, "RefreshStale"); err != nil {
+		return 0, err
+	}
+	query, args, err := sq.Delete("content_similarity_stale").
+		Where(sq.Expr(
+			"content_id IN (SELECT content_id FROM content_similarity_stale ORDER BY marked_at LIMIT ?)", batch,
+		)).
+		Suffix("RETURNING content_id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса RefreshStale"))
+	}
+	var stale []int
+	if err = tx.SelectContext(ctx, &stale, query, args...); err != nil {
+		return 0, entity.PSQLQueryErr("RefreshStale при получении измененного контента", err)
+	}
+	if len(stale) == 0 {
+		return 0, nil
+	}
+	query, args, err = sq.Select("DISTINCT content_id").
+		From("content_similarity").
+		Where(sq.Eq{"similar_id": stale}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса RefreshStale"))
+	}
+	var affected []int
+	if err = tx.SelectContext(ctx, &affected, query, args...); err != nil {
+		return 0, entity.PSQLQueryErr("RefreshStale при получении затронутого контента", err)
+	}
+	targets := unionIDs(stale, affected)
+	query, args, err = sq.Delete("content_similarity").
+		Where(sq.Eq{"content_id": targets}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса RefreshStale"))
+	}
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+		return 0, entity.PSQLQueryErr("RefreshStale при удалении похожего контента", err)
+	}
+	if _, err = tx.ExecContext(ctx, computeContentSimilarityQuery, pq.Array(targets), limit); err != nil {
+		return 0, entity.PSQLQueryErr("RefreshStale при вычислении похожего контента", err)
+	}
+	if err = tx.Commit(); err != nil {
+		return 0, entity.PSQLQueryErr("RefreshStale при фиксации транзакции", err)
+	}
+	return len(stale), nil
+}
+
+// Rebuild заменяет весь похожий контент в одной транзакции, поэтому во время перестройки блок "похожее"
+// продолжает работать
+func (c *ContentSimilarityDB) Rebuild(ctx context.Context, limit int) error {
+	defer metrics.ObservePostgresQuery("content_similarity", "Rebuild", time.Now())
+	tx, err := c.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return entity.PSQLQueryErr("Rebuild при открытии транзакции", err)
+	}
+	// после успешного Commit откат ничего не делает
+	defer tx.Rollback() // nolint: errcheck
+	if err = advisoryXactLock(ctx, tx, contentSimilarityLock, "Rebuild"); err != nil {
+		return err
+	}
+	for _, table := range []string{"content_similarity_stale", "content_similarity"} {
+		query, args, err := sq.Delete(table).PlaceholderFormat(sq.Dollar).ToSql()
+		if err != nil {
+			return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса Rebuild"))
+		}
+		if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+			return entity.PSQLQueryErr("Rebuild при очистке "+table, err)
+		}
+	}
+	if _, err = tx.ExecContext(ctx, computeContentSimilarityQuery, nil, limit); err != nil {
+		return entity.PSQLQueryErr("Rebuild при вычислении похожего контента", err)
+	}
+	if err = tx.Commit(); err != nil {
+		return entity.PSQLQueryErr("Rebuild при фиксации транзакции", err)
+	}
+	return nil
+}
+
+// advisoryXactLock ждет, пока другие транзакции отпустят advisory-блокировку key, и захватывает ее. Блокировка
+// снимается вместе с завершением транзакции
+func advisoryXactLock(ctx context.Context, tx *sqlx.Tx, key int, method string) error {
+	if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock($1)", key); err != nil {
+		return entity.PSQLQueryErr(method+" при блокировке", err)
+	}
+	return nil
+}
+
+// unionIDs объединяет два списка ID без повторов, сохраняя порядок
+func unionIDs(first, second []int) []int {
+	seen := make(map[int]bool, len(first)+len(second))
+	union := make([]int, 0, len(first)+len(second))
+	for _, ids := range [][]int{first, second} {
+		for _, id := range ids {
+			if !seen[id] {
+				seen[id] = true
+				union = append(union, id)
+			}
+		}
+	}
+	return union
+}
@@ -0,0 +1,130 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+)
+
+func TestContentSimilarityDB_RefreshStale(t *testing.T) {
+	t.Parallel()
+
+	lockQuery := regexp.QuoteMeta("SELECT pg_advisory_xact_lock($1)")
+	staleQuery := regexp.QuoteMeta(
+		"DELETE FROM content_similarity_stale WHERE content_id IN " +
+			"(SELECT content_id FROM content_similarity_stale ORDER BY marked_at LIMIT $1) RETURNING content_id",
+	)
+	testCases := []struct {
+		Name          string
+		SetupMock     func(mock sqlmock.Sqlmock)
+		ExpectedCount int
+		ExpectedErr   bool
+	}{
+		{
+			Name: "Пересчет измененного и затронутого контента",
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(lockQuery).
+					WithArgs(contentSimilarityLock).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(staleQuery).
+					WithArgs(100).
+					WillReturnRows(sqlmock.NewRows([]string{"content_id"}).AddRow(1).AddRow(2))
+				mock.ExpectQuery(regexp.QuoteMeta(
+					"SELECT DISTINCT content_id FROM content_similarity WHERE similar_id IN ($1,$2)",
+				)).
+					WithArgs(1, 2).
+					WillReturnRows(sqlmock.NewRows([]string{"content_id"}).AddRow(2).AddRow(5))
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM content_similarity WHERE content_id IN ($1,$2,$3)")).
+					WithArgs(1, 2, 5).
+					WillReturnResult(sqlmock.NewResult(0, 25))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO content_similarity (content_id, similar_id, score)")).
+					WithArgs(pq.Array([]int{1, 2, 5}), 10).
+					WillReturnResult(sqlmock.NewResult(0, 30))
+				mock.ExpectCommit()
+			},
+			ExpectedCount: 2,
+		},
+		{
+			Name: "Очередь пуста",
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(lockQuery).
+					WithArgs(contentSimilarityLock).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(staleQuery).
+					WithArgs(100).
+					WillReturnRows(sqlmock.NewRows([]string{"content_id"}))
+				mock.ExpectRollback()
+			},
+		},
+		{
+			Name: "Ошибка при вычислении похожего контента",
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(lockQuery).
+					WithArgs(contentSimilarityLock).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectQuery(staleQuery).
+					WithArgs(100).
+					WillReturnRows(sqlmock.NewRows([]string{"content_id"}).AddRow(1))
+				mock.ExpectQuery(regexp.QuoteMeta("FROM content_similarity WHERE similar_id IN ($1)")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"content_id"}))
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM content_similarity WHERE content_id IN ($1)")).
+					WithArgs(1).
+					WillReturnResult(sqlmock.NewResult(0, 10))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO content_similarity")).
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			ExpectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewContentSimilarityRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			count, err := repo.RefreshStale(context.Background(), 10, 100)
+			if tc.ExpectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.ExpectedCount, count)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestContentSimilarityDB_Rebuild(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	repo := NewContentSimilarityRepository(sqlx.NewDb(db, "sqlmock"))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("SELECT pg_advisory_xact_lock($1)")).
+		WithArgs(contentSimilarityLock).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM content_similarity_stale")).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM content_similarity")).
+		WillReturnResult(sqlmock.NewResult(0, 100))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO content_similarity (content_id, similar_id, score)")).
+		WithArgs(nil, 10).
+		WillReturnResult(sqlmock.NewResult(0, 100))
+	mock.ExpectCommit()
+	require.NoError(t, repo.Rebuild(context.Background(), 10))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -1,10 +1,14 @@
 package postgres
 
 import (
+	"context"
+	"database/sql"
 	"database/sql/driver"
+	"errors"
 	"github.com/DATA-DOG/go-sqlmock"
 	sq "github.com/Masterminds/squirrel"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
 	"github.com/jmoiron/sqlx"
 	"github.com/stretchr/testify/require"
 	"regexp"
@@ -237,13 +241,13 @@ func setupGetSeasonsSuccess(mock sqlmock.Sqlmock, contentID int, seasons []int)
 }
 
 func setupGetSeasonSuccess(mock sqlmock.Sqlmock, seasonID int, title string) {
-	query, args, _ := sq.Select("id", "title").
+	query, args, _ := sq.Select("id", "title", "rating", "rating_count").
 		From("season").
 		Where(sq.Eq{"id": seasonID}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(getDriverValues(args)...).WillReturnRows(
-		sqlmock.NewRows([]string{"id", "title"}).AddRow(seasonID, title))
+		sqlmock.NewRows([]string{"id", "title", "rating", "rating_count"}).AddRow(seasonID, title, 0, 0))
 }
 
 func setupGetEpisodesBySeasonIDSuccess(mock sqlmock.Sqlmock, seasonID int, episodes []int) {
@@ -260,13 +264,14 @@ func setupGetEpisodesBySeasonIDSuccess(mock sqlmock.Sqlmock, seasonID int, episo
 }
 
 func setupGetEpisodeSuccess(mock sqlmock.Sqlmock, episodeID int, episodeNumber int, title string) {
-	query, args, _ := sq.Select("id", "episode_number", "title", "duration").
+	query, args, _ := sq.Select("id", "episode_number", "title", "duration", "rating", "rating_count").
 		From("episode").
 		Where(sq.Eq{"id": episodeID}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(getDriverValues(args)...).WillReturnRows(
-		sqlmock.NewRows([]string{"id", "episode_number", "title", "duration"}).AddRow(episodeID, episodeNumber, title, 100))
+		sqlmock.NewRows([]string{"id", "episode_number", "title", "duration", "rating", "rating_count"}).
+			AddRow(episodeID, episodeNumber, title, 100, 0, 0))
 }
 
 func setupGetFactsSuccess(mock sqlmock.Sqlmock, contentID int, facts []string) {
@@ -489,9 +494,337 @@ func TestContentDB_GetContent(t *testing.T) {
 			require.NoError(t, err)
 			repo := NewContentRepository(dbx)
 			tc.SetupMock(mock)
-			output, err := repo.GetContent(tc.Request)
+			output, err := repo.GetContent(context.Background(), tc.Request)
 			require.Equal(t, tc.ExpectedErr, err)
 			require.Equal(t, tc.ExpectedOut, output)
 		})
 	}
 }
+
+func setupGetPreviewContentsSatellites(mock sqlmock.Sqlmock, ids []int) {
+	query, args, _ := sq.Select("country_content.content_id", "country.id", "country.name").
+		From("country_content").
+		Join("country ON country.id = country_content.country_id").
+		Where(sq.Eq{"country_content.content_id": ids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(getDriverValues(args)...).WillReturnRows(
+		sqlmock.NewRows([]string{"content_id", "id", "name"}).AddRow(1, 1, "Russia"),
+	)
+	query, args, _ = sq.Select("genre_content.content_id", "genre.id", "genre.name").
+		From("genre_content").
+		Join("genre ON genre.id = genre_content.genre_id").
+		Where(sq.Eq{"genre_content.content_id": ids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(getDriverValues(args)...).WillReturnRows(
+		sqlmock.NewRows([]string{"content_id", "id", "name"}).AddRow(2, 1, "Action"),
+	)
+	query, args, _ = sq.Select(
+		"person_role.content_id",
+		"role.name_en",
+		"person.id",
+		"person.name",
+		"person.en_name",
+		"person.birth_date",
+		"person.death_date",
+		"person.sex",
+		"person.height",
+		"person.photo_upload_id",
+	).
+		From("person_role").
+		Join("role ON role.id = person_role.role_id").
+		Join("person ON person.id = person_role.person_id").
+		Where(sq.Eq{
+			"person_role.content_id": ids,
+			"role.name_en":           []string{entity.RoleActor, entity.RoleDirector},
+		}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	person := entity.GetExamplePerson()
+	mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(getDriverValues(args)...).WillReturnRows(
+		sqlmock.NewRows([]string{
+			"content_id", "name_en", "id", "name", "en_name", "birth_date", "death_date", "sex", "height",
+			"photo_upload_id",
+		}).AddRow(
+			1, entity.RoleDirector, person.ID, person.Name, person.EnName, person.BirthDate.Time,
+			person.DeathDate.Time, person.Sex, person.Height.Int64, person.PhotoStaticID.Int64,
+		),
+	)
+	query, args, _ = sq.Select("content_id", "premiere", "duration").
+		From("movie").
+		Where(sq.Eq{"content_id": ids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(getDriverValues(args)...).WillReturnRows(
+		sqlmock.NewRows([]string{"content_id", "premiere", "duration"}).AddRow(1, time.Time{}, 100),
+	)
+	query, args, _ = sq.Select("content_id", "year_start", "year_end").
+		From("series").
+		Where(sq.Eq{"content_id": ids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(getDriverValues(args)...).WillReturnRows(
+		sqlmock.NewRows([]string{"content_id", "year_start", "year_end"}).AddRow(2, 2000, 2005),
+	)
+}
+
+func setupGetPreviewContentsInfo(mock sqlmock.Sqlmock, ids []int, rows *sqlmock.Rows) {
+	query, args, _ := sq.Select(
+		"id",
+		"content_type",
+		"title",
+		"original_title",
+		"rating",
+		"poster_upload_id",
+		"ongoing",
+		"ongoing_date",
+	).
+		From("content").
+		Where(sq.Eq{"id": ids}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(getDriverValues(args)...).WillReturnRows(rows)
+}
+
+func TestContentDB_GetPreviewContents(t *testing.T) {
+	t.Parallel()
+
+	previewColumns := []string{
+		"id", "content_type", "title", "original_title", "rating", "poster_upload_id", "ongoing", "ongoing_date",
+	}
+	testCases := []struct {
+		Name        string
+		Request     []int
+		ExpectedOut []*entity.Content
+		ExpectedErr error
+		SetupMock   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			Name:    "Порядок контента сохраняется",
+			Request: []int{2, 1},
+			ExpectedOut: []*entity.Content{
+				{
+					ID:            2,
+					Type:          entity.ContentTypeSeries,
+					Title:         "series",
+					OriginalTitle: "original series",
+					Rating:        7,
+					Genres:        []entity.Genre{{ID: 1, Name: "Action"}},
+					Series:        &entity.Series{YearStart: 2000, YearEnd: 2005},
+				},
+				{
+					ID:             1,
+					Type:           entity.ContentTypeMovie,
+					Title:          "movie",
+					OriginalTitle:  "original movie",
+					Rating:         8,
+					PosterStaticID: 501,
+					Country:        []entity.Country{{ID: 1, Name: "Russia"}},
+					Directors:      []entity.Person{entity.GetExamplePerson()},
+					Movie:          &entity.Movie{Premiere: time.Time{}, Duration: 100},
+				},
+			},
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				setupGetPreviewContentsInfo(mock, []int{2, 1}, sqlmock.NewRows(previewColumns).
+					AddRow(1, entity.ContentTypeMovie, "movie", "original movie", 8, 501, false, nil).
+					AddRow(2, entity.ContentTypeSeries, "series", "original series", 7, nil, false, nil),
+				)
+				setupGetPreviewContentsSatellites(mock, []int{2, 1})
+			},
+		},
+		{
+			Name:        "Один из контентов не найден",
+			Request:     []int{1, 3},
+			ExpectedErr: repository.ErrContentNotFound,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				setupGetPreviewContentsInfo(mock, []int{1, 3}, sqlmock.NewRows(previewColumns).
+					AddRow(1, entity.ContentTypeMovie, "movie", "original movie", 8, 501, false, nil),
+				)
+				setupGetPreviewContentsSatellites(mock, []int{1, 3})
+			},
+		},
+		{
+			Name:        "Пустой список",
+			Request:     []int{},
+			ExpectedOut: []*entity.Content{},
+			SetupMock:   func(mock sqlmock.Sqlmock) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			dbx := sqlx.NewDb(db, "sqlmock")
+			mock.MatchExpectationsInOrder(false)
+			require.NoError(t, err)
+			repo := NewContentRepository(dbx)
+			tc.SetupMock(mock)
+			output, err := repo.GetPreviewContents(context.Background(), tc.Request)
+			require.Equal(t, tc.ExpectedErr, err)
+			require.Equal(t, tc.ExpectedOut, output)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestContentDB_GetSimilarContent(t *testing.T) {
+	t.Parallel()
+
+	previewColumns := []string{
+		"id", "content_type", "title", "original_title", "rating", "poster_upload_id", "ongoing", "ongoing_date",
+	}
+	similarQuery := regexp.QuoteMeta(
+		"SELECT similar_id FROM content_similarity WHERE content_id = $1 ORDER BY score DESC, similar_id",
+	)
+	testCases := []struct {
+		Name        string
+		ExpectedOut []entity.Content
+		ExpectedErr bool
+		SetupMock   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			Name: "Порядок похожего контента сохраняется",
+			ExpectedOut: []entity.Content{
+				{
+					ID:            2,
+					Type:          entity.ContentTypeSeries,
+					Title:         "series",
+					OriginalTitle: "original series",
+					Rating:        7,
+					Genres:        []entity.Genre{{ID: 1, Name: "Action"}},
+					Series:        &entity.Series{YearStart: 2000, YearEnd: 2005},
+				},
+				{
+					ID:             1,
+					Type:           entity.ContentTypeMovie,
+					Title:          "movie",
+					OriginalTitle:  "original movie",
+					Rating:         8,
+					PosterStaticID: 501,
+					Country:        []entity.Country{{ID: 1, Name: "Russia"}},
+					Directors:      []entity.Person{entity.GetExamplePerson()},
+					Movie:          &entity.Movie{Premiere: time.Time{}, Duration: 100},
+				},
+			},
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(similarQuery).
+					WithArgs(3).
+					WillReturnRows(sqlmock.NewRows([]string{"similar_id"}).AddRow(2).AddRow(1))
+				setupGetPreviewContentsInfo(mock, []int{2, 1}, sqlmock.NewRows(previewColumns).
+					AddRow(1, entity.ContentTypeMovie, "movie", "original movie", 8, 501, false, nil).
+					AddRow(2, entity.ContentTypeSeries, "series", "original series", 7, nil, false, nil),
+				)
+				setupGetPreviewContentsSatellites(mock, []int{2, 1})
+			},
+		},
+		{
+			Name:        "Похожий контент еще не вычислен",
+			ExpectedOut: []entity.Content{},
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(similarQuery).
+					WithArgs(3).
+					WillReturnRows(sqlmock.NewRows([]string{"similar_id"}))
+			},
+		},
+		{
+			Name:        "Ошибка базы данных",
+			ExpectedErr: true,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(similarQuery).
+					WithArgs(3).
+					WillReturnError(errors.New("database error"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			mock.MatchExpectationsInOrder(false)
+			repo := NewContentRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			output, err := repo.GetSimilarContent(context.Background(), 3)
+			if tc.ExpectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.ExpectedOut, output)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestContentDB_GetBestEpisodes(t *testing.T) {
+	t.Parallel()
+
+	episodeColumns := []string{
+		"id", "episode_number", "title", "duration", "rating", "rating_count", "season_id", "season_title",
+	}
+	testCases := []struct {
+		Name        string
+		ExpectedOut []entity.SeasonEpisode
+		ExpectedErr error
+		SetupMock   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			Name: "Успешное получение",
+			ExpectedOut: []entity.SeasonEpisode{
+				{
+					Episode:     entity.Episode{ID: 3, EpisodeNumber: 2, Title: "Финал", Duration: 50, Rating: 9.5, RatingCount: 4},
+					SeasonID:    1,
+					SeasonTitle: "Сезон 1",
+				},
+				{
+					Episode:     entity.Episode{ID: 1, EpisodeNumber: 1, Title: "Пилот", Rating: 7, RatingCount: 10},
+					SeasonID:    1,
+					SeasonTitle: "Сезон 1",
+				},
+			},
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					"FROM episode JOIN season ON season.id = episode.season_id "+
+						"JOIN series ON series.id = season.series_id "+
+						"WHERE (series.content_id = $1 AND episode.rating_count > $2) "+
+						"ORDER BY (episode.rating * episode.rating_count + "+
+						"$3 * SUM(episode.rating * episode.rating_count) OVER () / SUM(episode.rating_count) OVER ()) / "+
+						"(episode.rating_count + $4) DESC, episode.rating_count DESC, episode.id ASC LIMIT 10",
+				)).
+					WithArgs(1, 0, bestEpisodesPriorVotes, bestEpisodesPriorVotes).
+					WillReturnRows(sqlmock.NewRows(episodeColumns).
+						AddRow(3, 2, "Финал", 50, 9.5, 4, 1, "Сезон 1").
+						AddRow(1, 1, "Пилот", nil, 7, 10, 1, "Сезон 1"))
+			},
+		},
+		{
+			Name:        "Ошибка при выполнении запроса",
+			ExpectedErr: entity.PSQLQueryErr("GetBestEpisodes", sql.ErrConnDone),
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("FROM episode")).
+					WithArgs(1, 0, bestEpisodesPriorVotes, bestEpisodesPriorVotes).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewContentRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			output, err := repo.GetBestEpisodes(context.Background(), 1, 10)
+			require.Equal(t, tc.ExpectedErr, err)
+			require.Equal(t, tc.ExpectedOut, output)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
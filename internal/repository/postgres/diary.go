@@ -0,0 +1,195 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"time"
+)
+
+type DiaryDB struct {
+	DB *sqlx.DB
+}
+
+func NewDiaryRepository(db *sqlx.DB) repository.Diary {
+	return &DiaryDB{
+		DB: db,
+	}
+}
+
+func selectDiaryEntryFields() sq.SelectBuilder {
+	return sq.Select(
+		"id",
+		"user_id",
+		"content_id",
+		"watched_on",
+		// запись без оценки хранит NULL, в entity.DiaryEntry это 0
+		"COALESCE(rating, 0) AS rating",
+		"note",
+		"rewatch",
+		"created_at",
+		"updated_at",
+	)
+}
+
+// CreateEntry добавляет запись в дневник. В случае успеха в entry записываются ID, CreatedAt и UpdatedAt
+func (d *DiaryDB) CreateEntry(ctx context.Context, entry *entity.DiaryEntry) (*entity.DiaryEntry, error) {
+	defer metrics.ObservePostgresQuery("diary", "CreateEntry", time.Now())
+	query, args, err := sq.Insert("diary_entry").
+		Columns("user_id", "content_id", "watched_on", "rating", "note", "rewatch").
+		Values(
+			entry.UserID,
+			entry.ContentID,
+			entry.WatchedOn,
+			sq.Expr("NULLIF(?, 0)", entry.Rating),
+			entry.Note,
+			entry.Rewatch,
+		).
+		Suffix("RETURNING id, created_at, updated_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса CreateEntry"))
+	}
+	err = d.DB.QueryRowContext(ctx, query, args...).Scan(&entry.ID, &entry.CreatedAt, &entry.UpdatedAt)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == entity.PSQLForeignKeyViolation {
+		return nil, repository.ErrDiaryContentNotFound
+	}
+	if err != nil {
+		return nil, entity.PSQLQueryErr("CreateEntry", err)
+	}
+	return entry, nil
+}
+
+// UpdateEntry изменяет запись пользователя. Контент записи не меняется, в entry записываются ContentID,
+// CreatedAt и UpdatedAt
+func (d *DiaryDB) UpdateEntry(ctx context.Context, entry *entity.DiaryEntry) (*entity.DiaryEntry, error) {
+	defer metrics.ObservePostgresQuery("diary", "UpdateEntry", time.Now())
+	query, args, err := sq.Update("diary_entry").
+		SetMap(map[string]any{
+			"watched_on": entry.WatchedOn,
+			"rating":     sq.Expr("NULLIF(?, 0)", entry.Rating),
+			"note":       entry.Note,
+			"rewatch":    entry.Rewatch,
+		}).
+		Where(sq.Eq{"id": entry.ID, "user_id": entry.UserID}).
+		Suffix("RETURNING content_id, created_at, updated_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса UpdateEntry"))
+	}
+	err = d.DB.QueryRowContext(ctx, query, args...).Scan(&entry.ContentID, &entry.CreatedAt, &entry.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrDiaryEntryNotFound
+	}
+	if err != nil {
+		return nil, entity.PSQLQueryErr("UpdateEntry", err)
+	}
+	return entry, nil
+}
+
+// DeleteEntry удаляет запись пользователя
+func (d *DiaryDB) DeleteEntry(ctx context.Context, userID, entryID int) error {
+	defer metrics.ObservePostgresQuery("diary", "DeleteEntry", time.Now())
+	query, args, err := sq.Delete("diary_entry").
+		Where(sq.Eq{"id": entryID, "user_id": userID}).
+		Suffix("RETURNING id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса DeleteEntry"))
+	}
+	var deletedID int
+	err = d.DB.QueryRowContext(ctx, query, args...).Scan(&deletedID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return repository.ErrDiaryEntryNotFound
+	}
+	if err != nil {
+		return entity.PSQLQueryErr("DeleteEntry", err)
+	}
+	return nil
+}
+
+// GetEntry возвращает запись пользователя
+func (d *DiaryDB) GetEntry(ctx context.Context, userID, entryID int) (*entity.DiaryEntry, error) {
+	defer metrics.ObservePostgresQuery("diary", "GetEntry", time.Now())
+	query, args, err := selectDiaryEntryFields().
+		From("diary_entry").
+		Where(sq.Eq{"id": entryID, "user_id": userID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetEntry"))
+	}
+	entry := new(entity.DiaryEntry)
+	err = d.DB.QueryRowxContext(ctx, query, args...).StructScan(entry)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrDiaryEntryNotFound
+		}
+		return nil, entity.PSQLQueryErr("GetEntry", err)
+	}
+	return entry, nil
+}
+
+// getEntries выполняет запрос записей дневника
+func (d *DiaryDB) getEntries(
+	ctx context.Context,
+	queryName string,
+	builder sq.SelectBuilder,
+) ([]*entity.DiaryEntry, error) {
+	query, args, err := builder.PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса "+queryName))
+	}
+	rows, err := d.DB.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, entity.PSQLQueryErr(queryName, err)
+	}
+	defer rows.Close()
+	entries := make([]*entity.DiaryEntry, 0)
+	for rows.Next() {
+		entry := new(entity.DiaryEntry)
+		if err = rows.StructScan(entry); err != nil {
+			return nil, entity.PSQLQueryErr(queryName+" при сканировании записей", err)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// GetEntriesByPeriod возвращает записи пользователя с датой просмотра в [from, to) в порядке просмотра
+func (d *DiaryDB) GetEntriesByPeriod(
+	ctx context.Context,
+	userID int,
+	from, to time.Time,
+) ([]*entity.DiaryEntry, error) {
+	defer metrics.ObservePostgresQuery("diary", "GetEntriesByPeriod", time.Now())
+	return d.getEntries(ctx, "GetEntriesByPeriod", selectDiaryEntryFields().
+		From("diary_entry").
+		Where(sq.And{
+			sq.Eq{"user_id": userID},
+			sq.GtOrEq{"watched_on": from},
+			sq.Lt{"watched_on": to},
+		}).
+		OrderBy("watched_on ASC", "id ASC"),
+	)
+}
+
+// GetEntriesByContent возвращает все просмотры контента пользователем, начиная с последнего
+func (d *DiaryDB) GetEntriesByContent(ctx context.Context, userID, contentID int) ([]*entity.DiaryEntry, error) {
+	defer metrics.ObservePostgresQuery("diary", "GetEntriesByContent", time.Now())
+	return d.getEntries(ctx, "GetEntriesByContent", selectDiaryEntryFields().
+		From("diary_entry").
+		Where(sq.Eq{"user_id": userID, "content_id": contentID}).
+		OrderBy("watched_on DESC", "id DESC"),
+	)
+}
@@ -0,0 +1,197 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestDiaryDB_CreateEntry(t *testing.T) {
+	t.Parallel()
+
+	watchedOn := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	fixedTime := time.Now()
+	testCases := []struct {
+		Name        string
+		ExpectedOut *entity.DiaryEntry
+		ExpectedErr error
+		SetupMock   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			Name: "Успешное добавление",
+			ExpectedOut: &entity.DiaryEntry{
+				ID:        5,
+				UserID:    1,
+				ContentID: 2,
+				WatchedOn: watchedOn,
+				Note:      "заметка",
+				Rewatch:   true,
+				CreatedAt: fixedTime,
+				UpdatedAt: fixedTime,
+			},
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					"INSERT INTO diary_entry (user_id,content_id,watched_on,rating,note,rewatch) "+
+						"VALUES ($1,$2,$3,NULLIF($4, 0),$5,$6) RETURNING id, created_at, updated_at",
+				)).
+					WithArgs(1, 2, watchedOn, 0, "заметка", true).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).
+						AddRow(5, fixedTime, fixedTime))
+			},
+		},
+		{
+			Name:        "Контент не найден",
+			ExpectedErr: repository.ErrDiaryContentNotFound,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO diary_entry")).
+					WithArgs(1, 2, watchedOn, 0, "заметка", true).
+					WillReturnError(&pq.Error{Code: entity.PSQLForeignKeyViolation})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewDiaryRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			output, err := repo.CreateEntry(context.Background(), &entity.DiaryEntry{
+				UserID:    1,
+				ContentID: 2,
+				WatchedOn: watchedOn,
+				Note:      "заметка",
+				Rewatch:   true,
+			})
+			require.Equal(t, tc.ExpectedErr, err)
+			require.Equal(t, tc.ExpectedOut, output)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDiaryDB_UpdateEntry(t *testing.T) {
+	t.Parallel()
+
+	watchedOn := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		Name        string
+		ExpectedErr error
+		SetupMock   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			Name:        "Успешное изменение",
+			ExpectedErr: nil,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					"UPDATE diary_entry SET note = $1, rating = NULLIF($2, 0), rewatch = $3, watched_on = $4 "+
+						"WHERE id = $5 AND user_id = $6 RETURNING content_id, created_at, updated_at",
+				)).
+					WithArgs("", 8, false, watchedOn, 5, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"content_id", "created_at", "updated_at"}).
+						AddRow(2, time.Now(), time.Now()))
+			},
+		},
+		{
+			Name:        "Чужая или несуществующая запись",
+			ExpectedErr: repository.ErrDiaryEntryNotFound,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("UPDATE diary_entry")).
+					WithArgs("", 8, false, watchedOn, 5, 1).
+					WillReturnError(sql.ErrNoRows)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewDiaryRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			_, err = repo.UpdateEntry(context.Background(), &entity.DiaryEntry{
+				ID:        5,
+				UserID:    1,
+				WatchedOn: watchedOn,
+				Rating:    8,
+			})
+			require.Equal(t, tc.ExpectedErr, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDiaryDB_GetEntriesByPeriod(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+	fixedTime := time.Now()
+	entryColumns := []string{
+		"id", "user_id", "content_id", "watched_on", "rating", "note", "rewatch", "created_at", "updated_at",
+	}
+	testCases := []struct {
+		Name        string
+		ExpectedOut []*entity.DiaryEntry
+		ExpectedErr error
+		SetupMock   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			Name: "Успешное получение",
+			ExpectedOut: []*entity.DiaryEntry{
+				{ID: 1, UserID: 1, ContentID: 2, WatchedOn: from, CreatedAt: fixedTime, UpdatedAt: fixedTime},
+				{
+					ID: 2, UserID: 1, ContentID: 2, WatchedOn: from.AddDate(0, 0, 3), Rating: 9, Rewatch: true,
+					CreatedAt: fixedTime, UpdatedAt: fixedTime,
+				},
+			},
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					"SELECT id, user_id, content_id, watched_on, COALESCE(rating, 0) AS rating, note, rewatch, "+
+						"created_at, updated_at FROM diary_entry "+
+						"WHERE (user_id = $1 AND watched_on >= $2 AND watched_on < $3) ORDER BY watched_on ASC, id ASC",
+				)).
+					WithArgs(1, from, to).
+					WillReturnRows(sqlmock.NewRows(entryColumns).
+						AddRow(1, 1, 2, from, 0, "", false, fixedTime, fixedTime).
+						AddRow(2, 1, 2, from.AddDate(0, 0, 3), 9, "", true, fixedTime, fixedTime))
+			},
+		},
+		{
+			Name:        "Ошибка при выполнении запроса",
+			ExpectedErr: entity.PSQLQueryErr("GetEntriesByPeriod", sql.ErrConnDone),
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("FROM diary_entry")).
+					WithArgs(1, from, to).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewDiaryRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			output, err := repo.GetEntriesByPeriod(context.Background(), 1, from, to)
+			require.Equal(t, tc.ExpectedErr, err)
+			require.Equal(t, tc.ExpectedOut, output)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -1,14 +1,16 @@
 package postgres
 
 import (
+	"context"
 	"database/sql"
 	"errors"
-	"fmt"
 	sq "github.com/Masterminds/squirrel"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
 	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
+	"time"
 )
 
 type FavouriteDB struct {
@@ -21,7 +23,8 @@ func NewFavouriteRepository(db *sqlx.DB) repository.Favourite {
 	}
 }
 
-func (f FavouriteDB) CreateFavourite(userID, contentID int, category string) error {
+func (f FavouriteDB) CreateFavourite(ctx context.Context, userID, contentID int, category string) error {
+	defer metrics.ObservePostgresQuery("favourite", "CreateFavourite", time.Now())
 	query, args, err := sq.
 		Insert("favourite").
 		Columns("user_id", "content_id", "category").
@@ -31,7 +34,7 @@ func (f FavouriteDB) CreateFavourite(userID, contentID int, category string) err
 	if err != nil {
 		return entity.PSQLWrap(err, errors.New("ошибка при формировании запроса CreateFavourite"))
 	}
-	_, err = f.DB.Exec(query, args...)
+	_, err = f.DB.ExecContext(ctx, query, args...)
 	var pqErr *pq.Error
 	if errors.As(err, &pqErr) {
 		switch pqErr.Code {
@@ -39,8 +42,6 @@ func (f FavouriteDB) CreateFavourite(userID, contentID int, category string) err
 			// такой кейс игнорируем
 			return nil
 		case entity.PSQLForeignKeyViolation:
-			fmt.Println(userID)
-			fmt.Println(pqErr)
 			return repository.ErrFavouriteContentNotFound
 		}
 	}
@@ -50,7 +51,8 @@ func (f FavouriteDB) CreateFavourite(userID, contentID int, category string) err
 	return nil
 }
 
-func (f FavouriteDB) DeleteFavourite(userID, contentID int) error {
+func (f FavouriteDB) DeleteFavourite(ctx context.Context, userID, contentID int) error {
+	defer metrics.ObservePostgresQuery("favourite", "DeleteFavourite", time.Now())
 	query, args, err := sq.
 		Delete("favourite").
 		Where(sq.Eq{"user_id": userID, "content_id": contentID}).
@@ -60,7 +62,7 @@ func (f FavouriteDB) DeleteFavourite(userID, contentID int) error {
 	if err != nil {
 		return entity.PSQLWrap(err, errors.New("ошибка при формировании запроса DeleteFavourite"))
 	}
-	row := f.DB.QueryRow(query, args...)
+	row := f.DB.QueryRowContext(ctx, query, args...)
 	var deletedContentID int
 	err = row.Scan(&deletedContentID)
 	if errors.Is(err, sql.ErrNoRows) {
@@ -72,7 +74,8 @@ func (f FavouriteDB) DeleteFavourite(userID, contentID int) error {
 	return nil
 }
 
-func (f FavouriteDB) GetFavourites(userID int) ([]*entity.Favourite, error) {
+func (f FavouriteDB) GetFavourites(ctx context.Context, userID int) ([]*entity.Favourite, error) {
+	defer metrics.ObservePostgresQuery("favourite", "GetFavourites", time.Now())
 	query, args, err := sq.
 		Select("content_id", "category").
 		From("favourite").
@@ -82,7 +85,7 @@ func (f FavouriteDB) GetFavourites(userID int) ([]*entity.Favourite, error) {
 	if err != nil {
 		return nil, entity.PSQLWrap(err, errors.New("ошибка при формировании запроса GetFavourites"))
 	}
-	rows, err := f.DB.Query(query, args...)
+	rows, err := f.DB.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, entity.PSQLQueryErr("GetFavourites", err)
 	}
@@ -100,7 +103,8 @@ func (f FavouriteDB) GetFavourites(userID int) ([]*entity.Favourite, error) {
 	return favourites, nil
 }
 
-func (f FavouriteDB) GetFavourite(userID, contentID int) (*entity.Favourite, error) {
+func (f FavouriteDB) GetFavourite(ctx context.Context, userID, contentID int) (*entity.Favourite, error) {
+	defer metrics.ObservePostgresQuery("favourite", "GetFavourite", time.Now())
 	query, args, err := sq.
 		Select("category").
 		From("favourite").
@@ -110,7 +114,7 @@ func (f FavouriteDB) GetFavourite(userID, contentID int) (*entity.Favourite, err
 	if err != nil {
 		return nil, entity.PSQLWrap(err, errors.New("ошибка при формировании запроса GetStatus"))
 	}
-	row := f.DB.QueryRow(query, args...)
+	row := f.DB.QueryRowContext(ctx, query, args...)
 	var favourite entity.Favourite
 	err = row.Scan(&favourite.Category)
 	if err != nil {
@@ -123,3 +127,61 @@ func (f FavouriteDB) GetFavourite(userID, contentID int) (*entity.Favourite, err
 	favourite.ContentID = contentID
 	return &favourite, nil
 }
+
+// GetExportItems возвращает историю пользователя по контенту с ID больше afterContentID. Вместо смещения
+// используется последний выгруженный ID, чтобы изменения во время выгрузки не сдвигали страницы
+func (f FavouriteDB) GetExportItems(
+	ctx context.Context,
+	userID, afterContentID, limit int,
+) ([]*entity.ExportItem, error) {
+	defer metrics.ObservePostgresQuery("favourite", "GetExportItems", time.Now())
+	query, args, err := sq.
+		Select(
+			"content.id AS content_id",
+			"COALESCE(content.kinopoisk_id, 0) AS kinopoisk_id",
+			"content.title",
+			"COALESCE(content.original_title, '') AS original_title",
+			"COALESCE(EXTRACT(YEAR FROM movie.premiere)::INT, series.year_start, 0) AS year",
+			"COALESCE(favourite.category, '') AS category",
+			"favourite.updated_at AS added_at",
+			"COALESCE(user_rating.rating, 0) AS rating",
+			"user_rating.updated_at AS rated_at",
+			"COALESCE(review.title, '') AS review_title",
+			"COALESCE(review.text, '') AS review_text",
+			"COALESCE(review.content_rating, 0) AS review_rating",
+			"review.created_at AS reviewed_at",
+		).
+		From("content").
+		LeftJoin("favourite ON favourite.content_id = content.id AND favourite.user_id = ?", userID).
+		LeftJoin("user_rating ON user_rating.content_id = content.id AND user_rating.user_id = ?", userID).
+		LeftJoin("review ON review.content_id = content.id AND review.user_id = ?", userID).
+		LeftJoin("movie ON movie.content_id = content.id").
+		LeftJoin("series ON series.content_id = content.id").
+		Where(sq.Gt{"content.id": afterContentID}).
+		Where(sq.Or{
+			sq.NotEq{"favourite.user_id": nil},
+			sq.NotEq{"user_rating.user_id": nil},
+			sq.NotEq{"review.id": nil},
+		}).
+		OrderBy("content.id ASC").
+		Limit(uint64(limit)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при формировании запроса GetExportItems"))
+	}
+	rows, err := f.DB.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, entity.PSQLQueryErr("GetExportItems", err)
+	}
+	defer rows.Close()
+	items := make([]*entity.ExportItem, 0)
+	for rows.Next() {
+		item := new(entity.ExportItem)
+		if err = rows.StructScan(item); err != nil {
+			return nil, entity.PSQLQueryErr("GetExportItems при сканировании", err)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
@@ -0,0 +1,53 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestFavouriteDB_GetExportItems(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	repo := NewFavouriteRepository(sqlx.NewDb(db, "sqlmock"))
+	fixedTime := time.Now()
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"FROM content "+
+			"LEFT JOIN favourite ON favourite.content_id = content.id AND favourite.user_id = $1 "+
+			"LEFT JOIN user_rating ON user_rating.content_id = content.id AND user_rating.user_id = $2 "+
+			"LEFT JOIN review ON review.content_id = content.id AND review.user_id = $3 "+
+			"LEFT JOIN movie ON movie.content_id = content.id "+
+			"LEFT JOIN series ON series.content_id = content.id "+
+			"WHERE content.id > $4 "+
+			"AND (favourite.user_id IS NOT NULL OR user_rating.user_id IS NOT NULL OR review.id IS NOT NULL) "+
+			"ORDER BY content.id ASC LIMIT 100",
+	)).
+		WithArgs(1, 1, 1, 10).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"content_id", "kinopoisk_id", "title", "original_title", "year", "category", "added_at",
+			"rating", "rated_at", "review_title", "review_text", "review_rating", "reviewed_at",
+		}).
+			AddRow(11, 409, "Схватка", "Heat", 1995, "watched", fixedTime, 9, fixedTime, "", "", 0, nil))
+	items, err := repo.GetExportItems(context.Background(), 1, 10, 100)
+	require.NoError(t, err)
+	require.Equal(t, []*entity.ExportItem{{
+		ContentID:     11,
+		KinopoiskID:   409,
+		Title:         "Схватка",
+		OriginalTitle: "Heat",
+		Year:          1995,
+		Category:      "watched",
+		AddedAt:       sql.NullTime{Time: fixedTime, Valid: true},
+		Rating:        9,
+		RatedAt:       sql.NullTime{Time: fixedTime, Valid: true},
+	}}, items)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
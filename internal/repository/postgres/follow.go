@@ -0,0 +1,164 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"time"
+)
+
+type FollowDB struct {
+	DB *sqlx.DB
+}
+
+func NewFollowRepository(db *sqlx.DB) repository.Follow {
+	return &FollowDB{
+		DB: db,
+	}
+}
+
+// Follow подписывает followerID на followeeID. Повторная подписка ничего не меняет
+func (f *FollowDB) Follow(ctx context.Context, followerID, followeeID int) error {
+	defer metrics.ObservePostgresQuery("follow", "Follow", time.Now())
+	query, args, err := sq.Insert("user_follow").
+		Columns("follower_id", "followee_id").
+		Values(followerID, followeeID).
+		Suffix("ON CONFLICT (follower_id, followee_id) DO NOTHING").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса Follow"))
+	}
+	_, err = f.DB.ExecContext(ctx, query, args...)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == entity.PSQLForeignKeyViolation {
+		return repository.ErrFollowUserNotFound
+	}
+	if err != nil {
+		return entity.PSQLQueryErr("Follow", err)
+	}
+	return nil
+}
+
+// Unfollow отменяет подписку followerID на followeeID
+func (f *FollowDB) Unfollow(ctx context.Context, followerID, followeeID int) error {
+	defer metrics.ObservePostgresQuery("follow", "Unfollow", time.Now())
+	query, args, err := sq.Delete("user_follow").
+		Where(sq.Eq{"follower_id": followerID, "followee_id": followeeID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса Unfollow"))
+	}
+	result, err := f.DB.ExecContext(ctx, query, args...)
+	if err != nil {
+		return entity.PSQLQueryErr("Unfollow", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return entity.PSQLQueryErr("Unfollow при получении числа удаленных строк", err)
+	}
+	if affected == 0 {
+		return repository.ErrFollowNotFound
+	}
+	return nil
+}
+
+// IsFollowing проверяет, подписан ли followerID на followeeID
+func (f *FollowDB) IsFollowing(ctx context.Context, followerID, followeeID int) (bool, error) {
+	defer metrics.ObservePostgresQuery("follow", "IsFollowing", time.Now())
+	query, args, err := sq.Select("COUNT(*) > 0").
+		From("user_follow").
+		Where(sq.Eq{"follower_id": followerID, "followee_id": followeeID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса IsFollowing"))
+	}
+	var following bool
+	err = f.DB.QueryRowContext(ctx, query, args...).Scan(&following)
+	if err != nil {
+		return false, entity.PSQLQueryErr("IsFollowing", err)
+	}
+	return following, nil
+}
+
+// getFollowUserIDs возвращает значения столбца column у подписок, в которых столбец by равен userID
+func (f *FollowDB) getFollowUserIDs(
+	ctx context.Context,
+	method, column, by string,
+	userID, page, limit int,
+) ([]int, error) {
+	query, args, err := sq.Select(column).
+		From("user_follow").
+		Where(sq.Eq{by: userID}).
+		OrderBy("created_at DESC", column+" DESC").
+		Limit(uint64(limit)).
+		Offset(uint64((page - 1) * limit)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса "+method))
+	}
+	rows, err := f.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, entity.PSQLQueryErr(method, err)
+	}
+	defer rows.Close()
+	userIDs := make([]int, 0)
+	for rows.Next() {
+		var id int
+		if err = rows.Scan(&id); err != nil {
+			return nil, entity.PSQLQueryErr(method+" при сканировании пользователей", err)
+		}
+		userIDs = append(userIDs, id)
+	}
+	return userIDs, nil
+}
+
+// getFollowCount возвращает количество подписок, в которых столбец by равен userID
+func (f *FollowDB) getFollowCount(ctx context.Context, method, by string, userID int) (int, error) {
+	query, args, err := sq.Select("COUNT(*)").
+		From("user_follow").
+		Where(sq.Eq{by: userID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса "+method))
+	}
+	var count int
+	err = f.DB.QueryRowContext(ctx, query, args...).Scan(&count)
+	if err != nil {
+		return 0, entity.PSQLQueryErr(method, err)
+	}
+	return count, nil
+}
+
+// GetFollowers возвращает ID подписчиков пользователя, начиная с последних подписавшихся
+func (f *FollowDB) GetFollowers(ctx context.Context, userID, page, limit int) ([]int, error) {
+	defer metrics.ObservePostgresQuery("follow", "GetFollowers", time.Now())
+	return f.getFollowUserIDs(ctx, "GetFollowers", "follower_id", "followee_id", userID, page, limit)
+}
+
+// GetFollowersCount возвращает количество подписчиков пользователя
+func (f *FollowDB) GetFollowersCount(ctx context.Context, userID int) (int, error) {
+	defer metrics.ObservePostgresQuery("follow", "GetFollowersCount", time.Now())
+	return f.getFollowCount(ctx, "GetFollowersCount", "followee_id", userID)
+}
+
+// GetFollowing возвращает ID пользователей, на которых подписан пользователь, начиная с последних подписок
+func (f *FollowDB) GetFollowing(ctx context.Context, userID, page, limit int) ([]int, error) {
+	defer metrics.ObservePostgresQuery("follow", "GetFollowing", time.Now())
+	return f.getFollowUserIDs(ctx, "GetFollowing", "followee_id", "follower_id", userID, page, limit)
+}
+
+// GetFollowingCount возвращает количество подписок пользователя
+func (f *FollowDB) GetFollowingCount(ctx context.Context, userID int) (int, error) {
+	defer metrics.ObservePostgresQuery("follow", "GetFollowingCount", time.Now())
+	return f.getFollowCount(ctx, "GetFollowingCount", "follower_id", userID)
+}
@@ -0,0 +1,139 @@
+package postgres
+
+import (
+	"context"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+)
+
+func TestFollowDB_Follow(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		ExpectedErr error
+		SetupMock   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			Name:        "Успешная подписка",
+			ExpectedErr: nil,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(
+					"INSERT INTO user_follow (follower_id,followee_id) VALUES ($1,$2) "+
+						"ON CONFLICT (follower_id, followee_id) DO NOTHING",
+				)).
+					WithArgs(1, 2).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			Name:        "Пользователь не найден",
+			ExpectedErr: repository.ErrFollowUserNotFound,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO user_follow")).
+					WithArgs(1, 2).
+					WillReturnError(&pq.Error{Code: entity.PSQLForeignKeyViolation})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewFollowRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			err = repo.Follow(context.Background(), 1, 2)
+			require.Equal(t, tc.ExpectedErr, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestFollowDB_Unfollow(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		ExpectedErr error
+		SetupMock   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			Name:        "Успешная отписка",
+			ExpectedErr: nil,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(
+					"DELETE FROM user_follow WHERE followee_id = $1 AND follower_id = $2",
+				)).
+					WithArgs(2, 1).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			Name:        "Подписки нет",
+			ExpectedErr: repository.ErrFollowNotFound,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM user_follow")).
+					WithArgs(2, 1).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewFollowRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			err = repo.Unfollow(context.Background(), 1, 2)
+			require.Equal(t, tc.ExpectedErr, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestFollowDB_GetFollowers(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	repo := NewFollowRepository(sqlx.NewDb(db, "sqlmock"))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT follower_id FROM user_follow WHERE followee_id = $1 " +
+			"ORDER BY created_at DESC, follower_id DESC LIMIT 20 OFFSET 20",
+	)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"follower_id"}).AddRow(3).AddRow(2))
+	followers, err := repo.GetFollowers(context.Background(), 1, 2, 20)
+	require.NoError(t, err)
+	require.Equal(t, []int{3, 2}, followers)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFollowDB_IsFollowing(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	repo := NewFollowRepository(sqlx.NewDb(db, "sqlmock"))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT COUNT(*) > 0 FROM user_follow WHERE followee_id = $1 AND follower_id = $2",
+	)).
+		WithArgs(2, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"?column?"}).AddRow(true))
+	following, err := repo.IsFollowing(context.Background(), 1, 2)
+	require.NoError(t, err)
+	require.True(t, following)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,322 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"time"
+)
+
+// importRowsBatch количество строк в одном INSERT. У postgres есть ограничение на число параметров запроса,
+// поэтому большие файлы сохраняются частями
+const importRowsBatch = 1000
+
+type ImportDB struct {
+	DB *sqlx.DB
+}
+
+func NewImportRepository(db *sqlx.DB) repository.Import {
+	return &ImportDB{
+		DB: db,
+	}
+}
+
+func selectImportRowFields() sq.SelectBuilder {
+	return sq.Select(
+		"job_id",
+		"row_number",
+		"title",
+		"original_title",
+		"year",
+		"kinopoisk_id",
+		"rating",
+		"COALESCE(content_id, 0) AS content_id",
+	)
+}
+
+// CreateJob создает задачу импорта. В job записываются ID, Status, CreatedAt и UpdatedAt
+func (i *ImportDB) CreateJob(ctx context.Context, job *entity.ImportJob) (*entity.ImportJob, error) {
+	defer metrics.ObservePostgresQuery("import", "CreateJob", time.Now())
+	query, args, err := sq.Insert("import_job").
+		Columns("user_id", "source", "category").
+		Values(job.UserID, job.Source, job.Category).
+		Suffix("RETURNING id, status, created_at, updated_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса CreateJob"))
+	}
+	err = i.DB.QueryRowContext(ctx, query, args...).Scan(&job.ID, &job.Status, &job.CreatedAt, &job.UpdatedAt)
+	if err != nil {
+		return nil, entity.PSQLQueryErr("CreateJob", err)
+	}
+	return job, nil
+}
+
+// UpdateJob сохраняет статус, количество строк и ошибку задачи импорта
+func (i *ImportDB) UpdateJob(ctx context.Context, job *entity.ImportJob) error {
+	defer metrics.ObservePostgresQuery("import", "UpdateJob", time.Now())
+	query, args, err := sq.Update("import_job").
+		SetMap(map[string]any{
+			"status":       job.Status,
+			"total_rows":   job.TotalRows,
+			"matched_rows": job.MatchedRows,
+			"error":        job.Error,
+		}).
+		Where(sq.Eq{"id": job.ID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса UpdateJob"))
+	}
+	result, err := i.DB.ExecContext(ctx, query, args...)
+	if err != nil {
+		return entity.PSQLQueryErr("UpdateJob", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return entity.PSQLQueryErr("UpdateJob при получении числа измененных строк", err)
+	}
+	if affected == 0 {
+		return repository.ErrImportJobNotFound
+	}
+	return nil
+}
+
+// FailUnfinishedJobs помечает неудавшимися задачи, которые ожидают запуска или выполняются
+func (i *ImportDB) FailUnfinishedJobs(ctx context.Context, errMessage string) (int64, error) {
+	defer metrics.ObservePostgresQuery("import", "FailUnfinishedJobs", time.Now())
+	query, args, err := sq.Update("import_job").
+		SetMap(map[string]any{
+			"status": entity.ImportStatusFailed,
+			"error":  errMessage,
+		}).
+		Where(sq.Eq{"status": []string{entity.ImportStatusPending, entity.ImportStatusRunning}}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса FailUnfinishedJobs"))
+	}
+	result, err := i.DB.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, entity.PSQLQueryErr("FailUnfinishedJobs", err)
+	}
+	failed, err := result.RowsAffected()
+	if err != nil {
+		return 0, entity.PSQLQueryErr("FailUnfinishedJobs при получении числа измененных строк", err)
+	}
+	return failed, nil
+}
+
+// GetJob возвращает задачу импорта
+func (i *ImportDB) GetJob(ctx context.Context, jobID int) (*entity.ImportJob, error) {
+	defer metrics.ObservePostgresQuery("import", "GetJob", time.Now())
+	query, args, err := sq.Select(
+		"id",
+		"user_id",
+		"source",
+		"category",
+		"status",
+		"total_rows",
+		"matched_rows",
+		"error",
+		"created_at",
+		"updated_at",
+	).
+		From("import_job").
+		Where(sq.Eq{"id": jobID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetJob"))
+	}
+	job := new(entity.ImportJob)
+	err = i.DB.QueryRowxContext(ctx, query, args...).StructScan(job)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrImportJobNotFound
+		}
+		return nil, entity.PSQLQueryErr("GetJob", err)
+	}
+	return job, nil
+}
+
+// AddUnmatchedRows сохраняет несопоставленные строки частями по importRowsBatch
+func (i *ImportDB) AddUnmatchedRows(ctx context.Context, rows []*entity.ImportRow) error {
+	defer metrics.ObservePostgresQuery("import", "AddUnmatchedRows", time.Now())
+	for start := 0; start < len(rows); start += importRowsBatch {
+		builder := sq.Insert("import_row").
+			Columns("job_id", "row_number", "title", "original_title", "year", "kinopoisk_id", "rating")
+		for _, row := range rows[start:min(start+importRowsBatch, len(rows))] {
+			builder = builder.Values(
+				row.JobID,
+				row.RowNumber,
+				row.Title,
+				row.OriginalTitle,
+				row.Year,
+				row.KinopoiskID,
+				row.Rating,
+			)
+		}
+		query, args, err := builder.PlaceholderFormat(sq.Dollar).ToSql()
+		if err != nil {
+			return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса AddUnmatchedRows"))
+		}
+		if _, err = i.DB.ExecContext(ctx, query, args...); err != nil {
+			return entity.PSQLQueryErr("AddUnmatchedRows", err)
+		}
+	}
+	return nil
+}
+
+// GetUnmatchedRows возвращает несопоставленные строки задачи в порядке их следования в файле
+func (i *ImportDB) GetUnmatchedRows(ctx context.Context, jobID int) ([]*entity.ImportRow, error) {
+	defer metrics.ObservePostgresQuery("import", "GetUnmatchedRows", time.Now())
+	query, args, err := selectImportRowFields().
+		From("import_row").
+		Where(sq.Eq{"job_id": jobID}).
+		OrderBy("row_number ASC").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetUnmatchedRows"))
+	}
+	rows, err := i.DB.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, entity.PSQLQueryErr("GetUnmatchedRows", err)
+	}
+	defer rows.Close()
+	importRows := make([]*entity.ImportRow, 0)
+	for rows.Next() {
+		row := new(entity.ImportRow)
+		if err = rows.StructScan(row); err != nil {
+			return nil, entity.PSQLQueryErr("GetUnmatchedRows при сканировании строк", err)
+		}
+		importRows = append(importRows, row)
+	}
+	return importRows, nil
+}
+
+// GetUnmatchedRow возвращает несопоставленную строку задачи
+func (i *ImportDB) GetUnmatchedRow(ctx context.Context, jobID, rowNumber int) (*entity.ImportRow, error) {
+	defer metrics.ObservePostgresQuery("import", "GetUnmatchedRow", time.Now())
+	query, args, err := selectImportRowFields().
+		From("import_row").
+		Where(sq.Eq{"job_id": jobID, "row_number": rowNumber}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetUnmatchedRow"))
+	}
+	row := new(entity.ImportRow)
+	err = i.DB.QueryRowxContext(ctx, query, args...).StructScan(row)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrImportRowNotFound
+		}
+		return nil, entity.PSQLQueryErr("GetUnmatchedRow", err)
+	}
+	return row, nil
+}
+
+// ResolveRow записывает в строку контент, выбранный пользователем
+func (i *ImportDB) ResolveRow(ctx context.Context, jobID, rowNumber, contentID int) error {
+	defer metrics.ObservePostgresQuery("import", "ResolveRow", time.Now())
+	query, args, err := sq.Update("import_row").
+		Set("content_id", contentID).
+		Where(sq.Eq{"job_id": jobID, "row_number": rowNumber}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса ResolveRow"))
+	}
+	result, err := i.DB.ExecContext(ctx, query, args...)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == entity.PSQLForeignKeyViolation {
+		return repository.ErrImportContentNotFound
+	}
+	if err != nil {
+		return entity.PSQLQueryErr("ResolveRow", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return entity.PSQLQueryErr("ResolveRow при получении числа измененных строк", err)
+	}
+	if affected == 0 {
+		return repository.ErrImportRowNotFound
+	}
+	return nil
+}
+
+// FindContent ищет контент сначала по ID Кинопоиска, а если его нет в строке или в базе - по названию и году
+func (i *ImportDB) FindContent(ctx context.Context, row *entity.ImportRow) (int, bool, error) {
+	defer metrics.ObservePostgresQuery("import", "FindContent", time.Now())
+	if row.KinopoiskID != 0 {
+		contentID, ongoing, err := i.findContent(ctx, sq.Eq{"content.kinopoisk_id": row.KinopoiskID})
+		if !errors.Is(err, repository.ErrImportContentNotFound) {
+			return contentID, ongoing, err
+		}
+	}
+	titles := sq.Or{}
+	for _, title := range []string{row.Title, row.OriginalTitle} {
+		if title != "" {
+			titles = append(titles,
+				sq.Expr("LOWER(content.title) = LOWER(?)", title),
+				sq.Expr("LOWER(content.original_title) = LOWER(?)", title),
+			)
+		}
+	}
+	if len(titles) == 0 {
+		return 0, false, repository.ErrImportContentNotFound
+	}
+	where := sq.And{titles}
+	if row.Year != 0 {
+		// у фильмов год берется из даты премьеры, у сериалов - год начала
+		where = append(where,
+			sq.Expr("COALESCE(EXTRACT(YEAR FROM movie.premiere)::INT, series.year_start) = ?", row.Year),
+		)
+	}
+	return i.findContent(ctx, where)
+}
+
+// findContent возвращает контент, подходящий под условие where. Если подходит несколько, контент
+// не считается найденным, чтобы не оценить что-то за пользователя по ошибке
+func (i *ImportDB) findContent(ctx context.Context, where sq.Sqlizer) (int, bool, error) {
+	query, args, err := sq.Select("content.id", "content.ongoing").
+		From("content").
+		LeftJoin("movie ON movie.content_id = content.id").
+		LeftJoin("series ON series.content_id = content.id").
+		Where(where).
+		Limit(2).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, false, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса FindContent"))
+	}
+	rows, err := i.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return 0, false, entity.PSQLQueryErr("FindContent", err)
+	}
+	defer rows.Close()
+	var (
+		contentID int
+		ongoing   bool
+		found     int
+	)
+	for rows.Next() {
+		if err = rows.Scan(&contentID, &ongoing); err != nil {
+			return 0, false, entity.PSQLQueryErr("FindContent при сканировании контента", err)
+		}
+		found++
+	}
+	if found != 1 {
+		return 0, false, repository.ErrImportContentNotFound
+	}
+	return contentID, ongoing, nil
+}
@@ -0,0 +1,199 @@
+package postgres
+
+import (
+	"context"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+)
+
+func TestImportDB_FindContent(t *testing.T) {
+	t.Parallel()
+
+	const byKinopoiskID = "SELECT content.id, content.ongoing FROM content " +
+		"LEFT JOIN movie ON movie.content_id = content.id " +
+		"LEFT JOIN series ON series.content_id = content.id " +
+		"WHERE content.kinopoisk_id = $1 LIMIT 2"
+	const byTitle = "SELECT content.id, content.ongoing FROM content " +
+		"LEFT JOIN movie ON movie.content_id = content.id " +
+		"LEFT JOIN series ON series.content_id = content.id " +
+		"WHERE ((LOWER(content.title) = LOWER($1) OR LOWER(content.original_title) = LOWER($2)) " +
+		"AND COALESCE(EXTRACT(YEAR FROM movie.premiere)::INT, series.year_start) = $3) LIMIT 2"
+
+	testCases := []struct {
+		Name              string
+		Row               *entity.ImportRow
+		ExpectedContentID int
+		ExpectedOngoing   bool
+		ExpectedErr       error
+		SetupMock         func(mock sqlmock.Sqlmock)
+	}{
+		{
+			Name:              "Найден по ID Кинопоиска",
+			Row:               &entity.ImportRow{KinopoiskID: 409, Title: "Схватка"},
+			ExpectedContentID: 1,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(byKinopoiskID)).
+					WithArgs(409).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "ongoing"}).AddRow(1, false))
+			},
+		},
+		{
+			Name:              "Нет ID Кинопоиска в базе, найден по названию",
+			Row:               &entity.ImportRow{KinopoiskID: 409, Title: "Heat", Year: 1995},
+			ExpectedContentID: 2,
+			ExpectedOngoing:   true,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(byKinopoiskID)).
+					WithArgs(409).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "ongoing"}))
+				mock.ExpectQuery(regexp.QuoteMeta(byTitle)).
+					WithArgs("Heat", "Heat", 1995).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "ongoing"}).AddRow(2, true))
+			},
+		},
+		{
+			Name:        "Несколько подходящих",
+			Row:         &entity.ImportRow{Title: "Heat", Year: 1995},
+			ExpectedErr: repository.ErrImportContentNotFound,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(byTitle)).
+					WithArgs("Heat", "Heat", 1995).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "ongoing"}).AddRow(2, false).AddRow(3, false))
+			},
+		},
+		{
+			Name:        "Нет названия",
+			Row:         &entity.ImportRow{},
+			ExpectedErr: repository.ErrImportContentNotFound,
+			SetupMock:   func(mock sqlmock.Sqlmock) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewImportRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			contentID, ongoing, err := repo.FindContent(context.Background(), tc.Row)
+			require.Equal(t, tc.ExpectedErr, err)
+			require.Equal(t, tc.ExpectedContentID, contentID)
+			require.Equal(t, tc.ExpectedOngoing, ongoing)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestImportDB_AddUnmatchedRows(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	repo := NewImportRepository(sqlx.NewDb(db, "sqlmock"))
+	rows := make([]*entity.ImportRow, importRowsBatch+1)
+	for i := range rows {
+		rows[i] = &entity.ImportRow{JobID: 1, RowNumber: i + 2, Title: "Heat"}
+	}
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO import_row")).WillReturnResult(sqlmock.NewResult(0, importRowsBatch))
+	mock.ExpectExec(regexp.QuoteMeta(
+		"INSERT INTO import_row (job_id,row_number,title,original_title,year,kinopoisk_id,rating) "+
+			"VALUES ($1,$2,$3,$4,$5,$6,$7)",
+	)).
+		WithArgs(1, importRowsBatch+2, "Heat", "", 0, 0, 0).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	require.NoError(t, repo.AddUnmatchedRows(context.Background(), rows))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestImportDB_ResolveRow(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		ExpectedErr error
+		SetupMock   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			Name:        "Успешное сопоставление",
+			ExpectedErr: nil,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(
+					"UPDATE import_row SET content_id = $1 WHERE job_id = $2 AND row_number = $3",
+				)).
+					WithArgs(5, 1, 2).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			Name:        "Строка не найдена",
+			ExpectedErr: repository.ErrImportRowNotFound,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE import_row")).
+					WithArgs(5, 1, 2).
+					WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+		},
+		{
+			Name:        "Контент не найден",
+			ExpectedErr: repository.ErrImportContentNotFound,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta("UPDATE import_row")).
+					WithArgs(5, 1, 2).
+					WillReturnError(&pq.Error{Code: entity.PSQLForeignKeyViolation})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewImportRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			err = repo.ResolveRow(context.Background(), 1, 2, 5)
+			require.Equal(t, tc.ExpectedErr, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestImportDB_GetJob(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	repo := NewImportRepository(sqlx.NewDb(db, "sqlmock"))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id, user_id, source, category, status")).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	_, err = repo.GetJob(context.Background(), 1)
+	require.Equal(t, repository.ErrImportJobNotFound, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestImportDB_FailUnfinishedJobs(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	repo := NewImportRepository(sqlx.NewDb(db, "sqlmock"))
+	mock.ExpectExec(regexp.QuoteMeta(
+		"UPDATE import_job SET error = $1, status = $2 WHERE status IN ($3,$4)",
+	)).
+		WithArgs("прервано", entity.ImportStatusFailed, entity.ImportStatusPending, entity.ImportStatusRunning).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	failed, err := repo.FailUnfinishedJobs(context.Background(), "прервано")
+	require.NoError(t, err)
+	require.Equal(t, int64(2), failed)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
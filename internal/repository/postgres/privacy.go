@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"time"
+)
+
+type PrivacyDB struct {
+	DB *sqlx.DB
+}
+
+func NewPrivacyRepository(db *sqlx.DB) repository.Privacy {
+	return &PrivacyDB{
+		DB: db,
+	}
+}
+
+// GetPrivacySettings возвращает настройки приватности пользователя или настройки по умолчанию
+func (p *PrivacyDB) GetPrivacySettings(ctx context.Context, userID int) (*entity.PrivacySettings, error) {
+	defer metrics.ObservePostgresQuery("privacy", "GetPrivacySettings", time.Now())
+	query, args, err := sq.Select("user_id", "favourites", "reviews", "ratings", "lists").
+		From("user_privacy").
+		Where(sq.Eq{"user_id": userID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetPrivacySettings"))
+	}
+	settings := new(entity.PrivacySettings)
+	err = p.DB.QueryRowxContext(ctx, query, args...).StructScan(settings)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return entity.DefaultPrivacySettings(userID), nil
+		}
+		return nil, entity.PSQLQueryErr("GetPrivacySettings", err)
+	}
+	return settings, nil
+}
+
+// UpdatePrivacySettings сохраняет настройки приватности пользователя
+func (p *PrivacyDB) UpdatePrivacySettings(ctx context.Context, settings *entity.PrivacySettings) error {
+	defer metrics.ObservePostgresQuery("privacy", "UpdatePrivacySettings", time.Now())
+	query, args, err := sq.Insert("user_privacy").
+		Columns("user_id", "favourites", "reviews", "ratings", "lists").
+		Values(settings.UserID, settings.Favourites, settings.Reviews, settings.Ratings, settings.Lists).
+		Suffix("ON CONFLICT (user_id) DO UPDATE SET favourites = EXCLUDED.favourites, " +
+			"reviews = EXCLUDED.reviews, ratings = EXCLUDED.ratings, lists = EXCLUDED.lists").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса UpdatePrivacySettings"))
+	}
+	_, err = p.DB.ExecContext(ctx, query, args...)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == entity.PSQLForeignKeyViolation {
+		return repository.ErrPrivacyUserNotFound
+	}
+	if err != nil {
+		return entity.PSQLQueryErr("UpdatePrivacySettings", err)
+	}
+	return nil
+}
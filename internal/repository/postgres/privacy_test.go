@@ -0,0 +1,116 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+)
+
+func TestPrivacyDB_GetPrivacySettings(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		ExpectedOut *entity.PrivacySettings
+		SetupMock   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			Name: "Настройки сохранены",
+			ExpectedOut: &entity.PrivacySettings{
+				UserID:     1,
+				Favourites: entity.PrivacyPrivate,
+				Reviews:    entity.PrivacyPublic,
+				Ratings:    entity.PrivacyFollowers,
+				Lists:      entity.PrivacyPublic,
+			},
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					"SELECT user_id, favourites, reviews, ratings, lists FROM user_privacy WHERE user_id = $1",
+				)).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"user_id", "favourites", "reviews", "ratings", "lists"}).
+						AddRow(1, "private", "public", "followers", "public"))
+			},
+		},
+		{
+			Name:        "Настройки не менялись",
+			ExpectedOut: entity.DefaultPrivacySettings(1),
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("FROM user_privacy")).
+					WithArgs(1).
+					WillReturnError(sql.ErrNoRows)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewPrivacyRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			output, err := repo.GetPrivacySettings(context.Background(), 1)
+			require.NoError(t, err)
+			require.Equal(t, tc.ExpectedOut, output)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPrivacyDB_UpdatePrivacySettings(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		ExpectedErr error
+		SetupMock   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			Name:        "Успешное сохранение",
+			ExpectedErr: nil,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(
+					"INSERT INTO user_privacy (user_id,favourites,reviews,ratings,lists) VALUES ($1,$2,$3,$4,$5) "+
+						"ON CONFLICT (user_id) DO UPDATE SET favourites = EXCLUDED.favourites, "+
+						"reviews = EXCLUDED.reviews, ratings = EXCLUDED.ratings, lists = EXCLUDED.lists",
+				)).
+					WithArgs(1, "public", "public", "public", "private").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			Name:        "Пользователь не найден",
+			ExpectedErr: repository.ErrPrivacyUserNotFound,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO user_privacy")).
+					WithArgs(1, "public", "public", "public", "private").
+					WillReturnError(&pq.Error{Code: entity.PSQLForeignKeyViolation})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewPrivacyRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			settings := entity.DefaultPrivacySettings(1)
+			settings.Lists = entity.PrivacyPrivate
+			err = repo.UpdatePrivacySettings(context.Background(), settings)
+			require.Equal(t, tc.ExpectedErr, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
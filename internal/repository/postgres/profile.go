@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+type ProfileDB struct {
+	DB *sqlx.DB
+}
+
+func NewProfileRepository(db *sqlx.DB) repository.Profile {
+	return &ProfileDB{
+		DB: db,
+	}
+}
+
+// GetProfileStats возвращает число оценок без рецензии, контента в избранном и списков пользователя
+func (p *ProfileDB) GetProfileStats(
+	ctx context.Context,
+	userID int,
+	onlyPublicLists bool,
+) (*entity.ProfileStats, error) {
+	defer metrics.ObservePostgresQuery("profile", "GetProfileStats", time.Now())
+	lists := sq.Select("COUNT(*)").From("user_list").Where(sq.Eq{"user_id": userID})
+	if onlyPublicLists {
+		lists = lists.Where(sq.Eq{"is_public": true})
+	}
+	query, args, err := sq.Select().
+		Column(sq.Alias(sq.Select("COUNT(*)").From("user_rating").Where(sq.Eq{"user_id": userID}), "ratings")).
+		Column(sq.Alias(sq.Select("COUNT(*)").From("favourite").Where(sq.Eq{"user_id": userID}), "favourites")).
+		Column(sq.Alias(lists, "lists")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetProfileStats"))
+	}
+	stats := new(entity.ProfileStats)
+	if err = p.DB.QueryRowxContext(ctx, query, args...).StructScan(stats); err != nil {
+		return nil, entity.PSQLQueryErr("GetProfileStats", err)
+	}
+	return stats, nil
+}
+
+// GetTopGenres возвращает жанры, которые чаще всего встречаются среди оцененного пользователем контента.
+// Каждый контент учитывается один раз, даже если у него есть и рецензия, и оценка
+func (p *ProfileDB) GetTopGenres(
+	ctx context.Context,
+	userID, limit int,
+	fromReviews, fromRatings bool,
+) ([]*entity.GenreCount, error) {
+	defer metrics.ObservePostgresQuery("profile", "GetTopGenres", time.Now())
+	rated := sq.Or{}
+	if fromReviews {
+		// скрытые модератором рецензии в профиле не показываются
+		rated = append(rated, sq.Expr(
+			"EXISTS (SELECT 1 FROM review WHERE review.content_id = genre_content.content_id "+
+				"AND review.user_id = ? AND NOT review.hidden)",
+			userID,
+		))
+	}
+	if fromRatings {
+		rated = append(rated, sq.Expr(
+			"EXISTS (SELECT 1 FROM user_rating WHERE user_rating.content_id = genre_content.content_id "+
+				"AND user_rating.user_id = ?)",
+			userID,
+		))
+	}
+	if len(rated) == 0 {
+		return make([]*entity.GenreCount, 0), nil
+	}
+	query, args, err := sq.Select("genre.id", "genre.name", "COUNT(*)").
+		From("genre_content").
+		Join("genre ON genre.id = genre_content.genre_id").
+		Where(rated).
+		GroupBy("genre.id", "genre.name").
+		OrderBy("COUNT(*) DESC", "genre.id ASC").
+		Limit(uint64(limit)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetTopGenres"))
+	}
+	rows, err := p.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, entity.PSQLQueryErr("GetTopGenres", err)
+	}
+	defer rows.Close()
+	genres := make([]*entity.GenreCount, 0)
+	for rows.Next() {
+		genre := new(entity.GenreCount)
+		if err = rows.Scan(&genre.ID, &genre.Name, &genre.Count); err != nil {
+			return nil, entity.PSQLQueryErr("GetTopGenres при сканировании жанров", err)
+		}
+		genres = append(genres, genre)
+	}
+	return genres, nil
+}
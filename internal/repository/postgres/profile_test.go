@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+)
+
+func TestProfileDB_GetProfileStats(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	repo := NewProfileRepository(sqlx.NewDb(db, "sqlmock"))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT (SELECT COUNT(*) FROM user_rating WHERE user_id = $1) AS ratings, "+
+			"(SELECT COUNT(*) FROM favourite WHERE user_id = $2) AS favourites, "+
+			"(SELECT COUNT(*) FROM user_list WHERE user_id = $3 AND is_public = $4) AS lists",
+	)).
+		WithArgs(1, 1, 1, true).
+		WillReturnRows(sqlmock.NewRows([]string{"ratings", "favourites", "lists"}).AddRow(10, 5, 2))
+	stats, err := repo.GetProfileStats(context.Background(), 1, true)
+	require.NoError(t, err)
+	require.Equal(t, &entity.ProfileStats{Ratings: 10, Favourites: 5, Lists: 2}, stats)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestProfileDB_GetTopGenres(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		FromReviews bool
+		FromRatings bool
+		ExpectedOut []*entity.GenreCount
+		SetupMock   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			Name:        "Рецензии и оценки",
+			FromReviews: true,
+			FromRatings: true,
+			ExpectedOut: []*entity.GenreCount{
+				{Genre: entity.Genre{ID: 3, Name: "Драма"}, Count: 7},
+				{Genre: entity.Genre{ID: 1, Name: "Комедия"}, Count: 2},
+			},
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					"SELECT genre.id, genre.name, COUNT(*) FROM genre_content "+
+						"JOIN genre ON genre.id = genre_content.genre_id WHERE "+
+						"(EXISTS (SELECT 1 FROM review WHERE review.content_id = genre_content.content_id "+
+						"AND review.user_id = $1 AND NOT review.hidden) OR "+
+						"EXISTS (SELECT 1 FROM user_rating WHERE user_rating.content_id = genre_content.content_id "+
+						"AND user_rating.user_id = $2)) "+
+						"GROUP BY genre.id, genre.name ORDER BY COUNT(*) DESC, genre.id ASC LIMIT 5",
+				)).
+					WithArgs(1, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "name", "count"}).
+						AddRow(3, "Драма", 7).
+						AddRow(1, "Комедия", 2))
+			},
+		},
+		{
+			Name:        "Все разделы скрыты",
+			ExpectedOut: []*entity.GenreCount{},
+			SetupMock:   func(mock sqlmock.Sqlmock) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewProfileRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			output, err := repo.GetTopGenres(context.Background(), 1, 5, tc.FromReviews, tc.FromRatings)
+			require.NoError(t, err)
+			require.Equal(t, tc.ExpectedOut, output)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
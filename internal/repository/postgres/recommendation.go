@@ -0,0 +1,190 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+// Вклад признаков в близость контента. Сумма весов равна 1, поэтому score соседа лежит в [0, 1]
+const (
+	// косинусная близость по пользователям, которым понравились или которые посмотрели оба контента
+	neighborPreferenceWeight = 0.6
+	// доля общих участников, не больше neighborSharedPersonsCap
+	neighborPersonWeight = 0.25
+	// доля жанров контента, которые есть у соседа
+	neighborGenreWeight = 0.15
+	// столько общих участников считается полным совпадением состава
+	neighborSharedPersonsCap = 5
+)
+
+// Кандидаты в соседи - контент, который нравится тем же пользователям, и контент с общими участниками. Пары
+// только с общими жанрами не рассматриваются: их слишком много, а по отдельности они почти ничего не говорят.
+// Запросы с CTE и оконными функциями squirrel не собирает, поэтому они написаны вручную
+const (
+	refreshNeighborsQuery = `
+INSERT INTO content_neighbor (content_id, neighbor_id, score)
+WITH preference_norm AS (
+    SELECT content_id, SQRT(SUM(weight * weight)) AS norm
+    FROM content_preference
+    GROUP BY content_id
+),
+co_preference AS (
+    SELECT a.content_id, b.content_id AS neighbor_id, SUM(a.weight * b.weight) AS dot
+    FROM content_preference a
+    JOIN content_preference b ON b.user_id = a.user_id AND b.content_id <> a.content_id
+    GROUP BY a.content_id, b.content_id
+),
+shared_person AS (
+    SELECT a.content_id, b.content_id AS neighbor_id, COUNT(DISTINCT a.person_id) AS shared
+    FROM person_role a
+    JOIN person_role b ON b.person_id = a.person_id AND b.content_id <> a.content_id
+    GROUP BY a.content_id, b.content_id
+),
+candidate AS (
+    SELECT content_id, neighbor_id FROM co_preference
+    UNION
+    SELECT content_id, neighbor_id FROM shared_person
+),
+scored AS (
+    SELECT candidate.content_id,
+           candidate.neighbor_id,
+           ($1 * COALESCE(co_preference.dot / (na.norm * nb.norm), 0)
+               + $2 * LEAST(COALESCE(shared_person.shared, 0), $3)::FLOAT / $3
+               + $4 * (SELECT COUNT(*)
+                       FROM genre_content ga
+                       JOIN genre_content gb ON gb.genre_id = ga.genre_id
+                       WHERE ga.content_id = candidate.content_id
+                         AND gb.content_id = candidate.neighbor_id)::FLOAT
+                   / GREATEST((SELECT COUNT(*) FROM genre_content WHERE content_id = candidate.content_id), 1)
+           )::FLOAT AS score
+    FROM candidate
+    LEFT JOIN co_preference
+        ON co_preference.content_id = candidate.content_id AND co_preference.neighbor_id = candidate.neighbor_id
+    LEFT JOIN shared_person
+        ON shared_person.content_id = candidate.content_id AND shared_person.neighbor_id = candidate.neighbor_id
+    LEFT JOIN preference_norm na ON na.content_id = candidate.content_id
+    LEFT JOIN preference_norm nb ON nb.content_id = candidate.neighbor_id
+),
+ranked AS (
+    SELECT content_id, neighbor_id, score,
+           ROW_NUMBER() OVER (PARTITION BY content_id ORDER BY score DESC, neighbor_id) AS rank
+    FROM scored
+)
+SELECT content_id, neighbor_id, score
+FROM ranked
+WHERE rank <= $5`
+
+	// вклад каждого контента пользователя в рекомендацию запоминается, чтобы объяснить ее самым весомым из них
+	getRecommendationsQuery = `
+WITH seed AS (
+    SELECT content_id, weight
+    FROM content_preference
+    WHERE user_id = $1
+),
+seen AS (
+    SELECT content_id FROM favourite WHERE user_id = $1
+    UNION
+    SELECT content_id FROM user_rating WHERE user_id = $1
+    UNION
+    SELECT content_id FROM review WHERE user_id = $1
+),
+contribution AS (
+    SELECT content_neighbor.neighbor_id,
+           seed.content_id AS source_id,
+           seed.weight >= 1 AS source_liked,
+           seed.weight * content_neighbor.score AS score
+    FROM seed
+    JOIN content_neighbor ON content_neighbor.content_id = seed.content_id
+    WHERE content_neighbor.neighbor_id NOT IN (SELECT content_id FROM seen)
+)
+SELECT neighbor_id,
+       SUM(score)::FLOAT,
+       (ARRAY_AGG(source_id ORDER BY score DESC, source_id))[1],
+       (ARRAY_AGG(source_liked ORDER BY score DESC, source_id))[1]
+FROM contribution
+GROUP BY neighbor_id
+ORDER BY SUM(score) DESC, neighbor_id
+LIMIT $2`
+)
+
+type RecommendationDB struct {
+	DB *sqlx.DB
+}
+
+func NewRecommendationRepository(db *sqlx.DB) repository.Recommendation {
+	return &RecommendationDB{
+		DB: db,
+	}
+}
+
+// GetRecommendations складывает score соседей по всему контенту, который понравился пользователю или был им
+// просмотрен. Просмотренный контент весит вдвое меньше понравившегося
+func (r *RecommendationDB) GetRecommendations(
+	ctx context.Context,
+	userID, limit int,
+) ([]*entity.Recommendation, error) {
+	defer metrics.ObservePostgresQuery("recommendation", "GetRecommendations", time.Now())
+	rows, err := r.DB.QueryContext(ctx, getRecommendationsQuery, userID, limit)
+	if err != nil {
+		return nil, entity.PSQLQueryErr("GetRecommendations", err)
+	}
+	defer rows.Close()
+	recommendations := make([]*entity.Recommendation, 0)
+	for rows.Next() {
+		recommendation := new(entity.Recommendation)
+		err = rows.Scan(
+			&recommendation.ContentID,
+			&recommendation.Score,
+			&recommendation.SourceID,
+			&recommendation.SourceLiked,
+		)
+		if err != nil {
+			return nil, entity.PSQLQueryErr("GetRecommendations при сканировании", err)
+		}
+		recommendations = append(recommendations, recommendation)
+	}
+	if err = rows.Err(); err != nil {
+		return nil, entity.PSQLQueryErr("GetRecommendations", err)
+	}
+	return recommendations, nil
+}
+
+// RefreshNeighbors удаляет старых соседей и вычисляет новых в одной транзакции
+func (r *RecommendationDB) RefreshNeighbors(ctx context.Context, neighbors int) error {
+	defer metrics.ObservePostgresQuery("recommendation", "RefreshNeighbors", time.Now())
+	query, args, err := sq.Delete("content_neighbor").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса RefreshNeighbors"))
+	}
+	tx, err := r.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return entity.PSQLQueryErr("RefreshNeighbors при открытии транзакции", err)
+	}
+	// после успешного Commit откат ничего не делает
+	defer tx.Rollback() // nolint: errcheck
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+		return entity.PSQLQueryErr("RefreshNeighbors при удалении соседей", err)
+	}
+	_, err = tx.ExecContext(ctx, refreshNeighborsQuery,
+		neighborPreferenceWeight,
+		neighborPersonWeight,
+		neighborSharedPersonsCap,
+		neighborGenreWeight,
+		neighbors,
+	)
+	if err != nil {
+		return entity.PSQLQueryErr("RefreshNeighbors при вычислении соседей", err)
+	}
+	if err = tx.Commit(); err != nil {
+		return entity.PSQLQueryErr("RefreshNeighbors при фиксации транзакции", err)
+	}
+	return nil
+}
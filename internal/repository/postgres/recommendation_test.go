@@ -0,0 +1,129 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+)
+
+func TestRecommendationDB_GetRecommendations(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		SetupMock   func(mock sqlmock.Sqlmock)
+		Expected    []*entity.Recommendation
+		ExpectedErr bool
+	}{
+		{
+			Name: "Успешное получение",
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("FROM content_preference")).
+					WithArgs(1, 20).
+					WillReturnRows(sqlmock.NewRows([]string{"neighbor_id", "score", "source_id", "source_liked"}).
+						AddRow(5, 1.2, 2, true).
+						AddRow(6, 0.4, 3, false))
+			},
+			Expected: []*entity.Recommendation{
+				{ContentID: 5, Score: 1.2, SourceID: 2, SourceLiked: true},
+				{ContentID: 6, Score: 0.4, SourceID: 3, SourceLiked: false},
+			},
+		},
+		{
+			Name: "Нет рекомендаций",
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("FROM content_preference")).
+					WithArgs(1, 20).
+					WillReturnRows(sqlmock.NewRows([]string{"neighbor_id", "score", "source_id", "source_liked"}))
+			},
+			Expected: []*entity.Recommendation{},
+		},
+		{
+			Name: "Ошибка базы данных",
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("FROM content_preference")).
+					WithArgs(1, 20).
+					WillReturnError(errors.New("database error"))
+			},
+			ExpectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewRecommendationRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			recommendations, err := repo.GetRecommendations(context.Background(), 1, 20)
+			if tc.ExpectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.Expected, recommendations)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestRecommendationDB_RefreshNeighbors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		SetupMock   func(mock sqlmock.Sqlmock)
+		ExpectedErr bool
+	}{
+		{
+			Name: "Успешный пересчет",
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM content_neighbor")).
+					WillReturnResult(sqlmock.NewResult(0, 40))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO content_neighbor (content_id, neighbor_id, score)")).
+					WithArgs(neighborPreferenceWeight, neighborPersonWeight, neighborSharedPersonsCap,
+						neighborGenreWeight, 20).
+					WillReturnResult(sqlmock.NewResult(0, 42))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			Name: "Ошибка при вычислении соседей",
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectExec(regexp.QuoteMeta("DELETE FROM content_neighbor")).
+					WillReturnResult(sqlmock.NewResult(0, 40))
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO content_neighbor")).
+					WillReturnError(errors.New("database error"))
+				mock.ExpectRollback()
+			},
+			ExpectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewRecommendationRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			err = repo.RefreshNeighbors(context.Background(), 20)
+			if tc.ExpectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
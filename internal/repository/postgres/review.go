@@ -1,13 +1,16 @@
 package postgres
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	sq "github.com/Masterminds/squirrel"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
 	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
+	"time"
 )
 
 type ReviewDB struct {
@@ -33,6 +36,11 @@ func selectAllFields() sq.SelectBuilder {
 		"likes",
 		"dislikes",
 		"rating",
+		"comments",
+		"has_spoilers",
+		"edit_count",
+		"edited_at",
+		"hidden",
 	)
 }
 
@@ -50,10 +58,60 @@ func scanAllFields(row sq.RowScanner) (*entity.Review, error) {
 		&review.Likes,
 		&review.Dislikes,
 		&review.Rating,
+		&review.Comments,
+		&review.HasSpoilers,
+		&review.EditCount,
+		&review.EditedAt,
+		&review.Hidden,
 	)
 	return review, err
 }
 
+// reviewControversy выражение спорности рецензии: чем больше голосов и чем ближе число лайков к числу дизлайков,
+// тем оно больше. Совпадает с выражением индекса idx_review_content_id_controversial
+const reviewControversy = "POWER(likes + dislikes, LEAST(likes, dislikes)::FLOAT / GREATEST(likes, dislikes, 1))"
+
+// reviewTextLength выражение длины текста рецензии без разметки спойлеров, то есть длины текста, который видит
+// пользователь
+const reviewTextLength = `LENGTH(regexp_replace(text, '\[/{0,1}spoiler\]', '', 'g'))`
+
+// whereReviewFilter добавляет к запросу условия выборки рецензий eq и условия фильтра
+func whereReviewFilter(query sq.SelectBuilder, eq sq.Eq, filter entity.ReviewFilter) sq.SelectBuilder {
+	if filter.HideSpoilers {
+		eq["has_spoilers"] = false
+	}
+	query = query.Where(eq)
+	if filter.Sentiment != "" {
+		from, to := entity.ReviewSentimentRange(filter.Sentiment)
+		query = query.Where("content_rating BETWEEN ? AND ?", from, to)
+	}
+	if filter.MinTextLength > 0 {
+		query = query.Where(reviewTextLength+" >= ?", filter.MinTextLength)
+	}
+	return query
+}
+
+// reviewOrderBy возвращает порядок сортировки рецензий для режима sort. Если режим не указан, используется defaultSort
+func reviewOrderBy(sort, defaultSort string) []string {
+	if sort == "" {
+		sort = defaultSort
+	}
+	switch sort {
+	case entity.ReviewSortOldest:
+		return []string{"created_at ASC", "id ASC"}
+	case entity.ReviewSortHelpful:
+		return []string{"rating DESC", "id ASC"}
+	case entity.ReviewSortControversial:
+		return []string{reviewControversy + " DESC", "id ASC"}
+	case entity.ReviewSortHighest:
+		return []string{"content_rating DESC", "id ASC"}
+	case entity.ReviewSortLowest:
+		return []string{"content_rating ASC", "id ASC"}
+	default:
+		return []string{"created_at DESC", "id ASC"}
+	}
+}
+
 func scanRows(rows *sqlx.Rows) ([]*entity.Review, error) {
 	reviews := make([]*entity.Review, 0)
 	for rows.Next() {
@@ -67,10 +125,15 @@ func scanRows(rows *sqlx.Rows) ([]*entity.Review, error) {
 	return reviews, nil
 }
 
-// GetLatestReviews возвращает последние добавленные рецензии
-func (r *ReviewDB) GetLatestReviews(limit int) ([]*entity.Review, error) {
-	query, args, err := selectAllFields().
-		From("review").
+// GetLatestReviews возвращает последние добавленные рецензии, кроме скрытых модератором.
+// Режим сортировки из фильтра не учитывается
+func (r *ReviewDB) GetLatestReviews(
+	ctx context.Context,
+	limit int,
+	filter entity.ReviewFilter,
+) ([]*entity.Review, error) {
+	defer metrics.ObservePostgresQuery("review", "GetLatestReviews", time.Now())
+	query, args, err := whereReviewFilter(selectAllFields().From("review"), sq.Eq{"hidden": false}, filter).
 		OrderBy("created_at DESC", "id ASC").
 		Limit(uint64(limit)).
 		PlaceholderFormat(sq.Dollar).
@@ -78,7 +141,7 @@ func (r *ReviewDB) GetLatestReviews(limit int) ([]*entity.Review, error) {
 	if err != nil {
 		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetLatestReviews"))
 	}
-	rows, err := r.DB.Queryx(query, args...)
+	rows, err := r.DB.QueryxContext(ctx, query, args...)
 	if err != nil {
 		return nil, entity.PSQLQueryErr("GetLatestReviews", err)
 	}
@@ -87,20 +150,21 @@ func (r *ReviewDB) GetLatestReviews(limit int) ([]*entity.Review, error) {
 }
 
 // AddReview добавляет отзыв в базу данных.
-// У переданного entity.Review должны быть заполнены поля: AuthorID, ContentID, Title, Text, ContentRating.
+// У переданного entity.Review должны быть заполнены поля: AuthorID, ContentID, Title, Text, ContentRating, HasSpoilers.
 // Если операция происходит успешно, то в переданный по указателю review будут записаны ID, CreatedAt, UpdatedAt, затем
 // вернется указатель на эту же рецензию
-func (r *ReviewDB) AddReview(review *entity.Review) (*entity.Review, error) {
+func (r *ReviewDB) AddReview(ctx context.Context, review *entity.Review) (*entity.Review, error) {
+	defer metrics.ObservePostgresQuery("review", "AddReview", time.Now())
 	query, args, err := sq.Insert("review").
-		Columns("user_id", "content_id", "title", "text", "content_rating").
-		Values(review.AuthorID, review.ContentID, review.Title, review.Text, review.ContentRating).
+		Columns("user_id", "content_id", "title", "text", "content_rating", "has_spoilers").
+		Values(review.AuthorID, review.ContentID, review.Title, review.Text, review.ContentRating, review.HasSpoilers).
 		Suffix("RETURNING id, created_at, updated_at").
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
 		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса AddReview"))
 	}
-	err = r.DB.QueryRow(query, args...).Scan(&review.ID, &review.CreatedAt, &review.UpdatedAt)
+	err = r.DB.QueryRowContext(ctx, query, args...).Scan(&review.ID, &review.CreatedAt, &review.UpdatedAt)
 	var pqErr *pq.Error
 	if errors.As(err, &pqErr) {
 		switch pqErr.Code {
@@ -119,7 +183,8 @@ func (r *ReviewDB) AddReview(review *entity.Review) (*entity.Review, error) {
 }
 
 // GetReviewByID возвращает рецензию по ее ID
-func (r *ReviewDB) GetReviewByID(id int) (*entity.Review, error) {
+func (r *ReviewDB) GetReviewByID(ctx context.Context, id int) (*entity.Review, error) {
+	defer metrics.ObservePostgresQuery("review", "GetReviewByID", time.Now())
 	query, args, err := selectAllFields().
 		From("review").
 		Where(sq.Eq{"id": id}).
@@ -128,7 +193,7 @@ func (r *ReviewDB) GetReviewByID(id int) (*entity.Review, error) {
 	if err != nil {
 		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetReviewByID"))
 	}
-	review, err := scanAllFields(r.DB.QueryRow(query, args...))
+	review, err := scanAllFields(r.DB.QueryRowContext(ctx, query, args...))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, repository.ErrReviewNotFound
@@ -138,30 +203,45 @@ func (r *ReviewDB) GetReviewByID(id int) (*entity.Review, error) {
 	return review, nil
 }
 
-// GetReviewsCountByContentID возвращает количество рецензий по ID контента
-func (r *ReviewDB) GetReviewsCountByContentID(contentID int) (int, error) {
-	query, args, err := sq.Select("COUNT(*)").
-		From("review").
-		Where(sq.Eq{"content_id": contentID}).
+// GetReviewsCountByContentID возвращает количество рецензий по ID контента без учета скрытых модератором
+func (r *ReviewDB) GetReviewsCountByContentID(
+	ctx context.Context,
+	contentID int,
+	filter entity.ReviewFilter,
+) (int, error) {
+	defer metrics.ObservePostgresQuery("review", "GetReviewsCountByContentID", time.Now())
+	query, args, err := whereReviewFilter(
+		sq.Select("COUNT(*)").From("review"),
+		sq.Eq{"content_id": contentID, "hidden": false},
+		filter,
+	).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
 		return 0, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetReviewsCountByContentID"))
 	}
 	var count int
-	err = r.DB.QueryRow(query, args...).Scan(&count)
+	err = r.DB.QueryRowContext(ctx, query, args...).Scan(&count)
 	if err != nil {
 		return 0, entity.PSQLQueryErr("GetReviewsCountByContentID", err)
 	}
 	return count, nil
 }
 
-// GetReviewsByContentID возвращает все рецензии по ID контента, сортируя их по рейтингу
-func (r *ReviewDB) GetReviewsByContentID(contentID, page, limit int) ([]*entity.Review, error) {
-	query, args, err := selectAllFields().
-		From("review").
-		Where(sq.Eq{"content_id": contentID}).
-		OrderBy("rating DESC", "id ASC").
+// GetReviewsByContentID возвращает рецензии по ID контента, по умолчанию сортируя их по рейтингу. Скрытые модератором
+// рецензии не возвращаются
+func (r *ReviewDB) GetReviewsByContentID(
+	ctx context.Context,
+	contentID, page, limit int,
+	filter entity.ReviewFilter,
+) ([]*entity.Review, error) {
+	defer metrics.ObservePostgresQuery("review", "GetReviewsByContentID", time.Now())
+	query, args, err := whereReviewFilter(
+		selectAllFields().From("review"),
+		sq.Eq{"content_id": contentID, "hidden": false},
+		filter,
+	).
+		OrderBy(reviewOrderBy(filter.Sort, entity.ReviewSortHelpful)...).
 		Limit(uint64(limit)).
 		Offset(uint64((page - 1) * limit)).
 		PlaceholderFormat(sq.Dollar).
@@ -169,7 +249,7 @@ func (r *ReviewDB) GetReviewsByContentID(contentID, page, limit int) ([]*entity.
 	if err != nil {
 		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetReviewsByContentID"))
 	}
-	rows, err := r.DB.Queryx(query, args...)
+	rows, err := r.DB.QueryxContext(ctx, query, args...)
 	if err != nil {
 		return nil, entity.PSQLQueryErr("GetReviewsByContentID", err)
 	}
@@ -178,20 +258,22 @@ func (r *ReviewDB) GetReviewsByContentID(contentID, page, limit int) ([]*entity.
 }
 
 // UpdateReview обновляет отзыв в базе данных.
-// У переданного entity.Review должны быть заполнены поля: ID, Title, Text, ContentRating.
+// У переданного entity.Review должны быть заполнены поля: ID, Title, Text, ContentRating, HasSpoilers.
 // Возвращает repository.ErrReviewBadRequest, если обновлённых строк нет
-func (r *ReviewDB) UpdateReview(review *entity.Review) error {
+func (r *ReviewDB) UpdateReview(ctx context.Context, review *entity.Review) error {
+	defer metrics.ObservePostgresQuery("review", "UpdateReview", time.Now())
 	query, args, err := sq.Update("review").
 		Set("title", review.Title).
 		Set("text", review.Text).
 		Set("content_rating", review.ContentRating).
+		Set("has_spoilers", review.HasSpoilers).
 		Where(sq.Eq{"id": review.ID}).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
 		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса UpdateReview"))
 	}
-	_, err = r.DB.Exec(query, args...)
+	_, err = r.DB.ExecContext(ctx, query, args...)
 	var pqErr *pq.Error
 	if errors.As(err, &pqErr) {
 		switch pqErr.Code {
@@ -208,7 +290,8 @@ func (r *ReviewDB) UpdateReview(review *entity.Review) error {
 }
 
 // DeleteReviewByID удаляет отзыв по его ID
-func (r *ReviewDB) DeleteReviewByID(id int) error {
+func (r *ReviewDB) DeleteReviewByID(ctx context.Context, id int) error {
+	defer metrics.ObservePostgresQuery("review", "DeleteReviewByID", time.Now())
 	query, args, err := sq.Delete("review").
 		Where(sq.Eq{"id": id}).
 		PlaceholderFormat(sq.Dollar).
@@ -216,7 +299,7 @@ func (r *ReviewDB) DeleteReviewByID(id int) error {
 	if err != nil {
 		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса DeleteReviewByID"))
 	}
-	_, err = r.DB.Exec(query, args...)
+	_, err = r.DB.ExecContext(ctx, query, args...)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return repository.ErrReviewNotFound
@@ -226,30 +309,45 @@ func (r *ReviewDB) DeleteReviewByID(id int) error {
 	return nil
 }
 
-// GetReviewsCountByAuthorID возвращает количество отзывов по ID автора
-func (r *ReviewDB) GetReviewsCountByAuthorID(authorID int) (int, error) {
-	query, args, err := sq.Select("COUNT(*)").
-		From("review").
-		Where(sq.Eq{"user_id": authorID}).
+// GetReviewsCountByAuthorID возвращает количество отзывов по ID автора без учета скрытых модератором
+func (r *ReviewDB) GetReviewsCountByAuthorID(
+	ctx context.Context,
+	authorID int,
+	filter entity.ReviewFilter,
+) (int, error) {
+	defer metrics.ObservePostgresQuery("review", "GetReviewsCountByAuthorID", time.Now())
+	query, args, err := whereReviewFilter(
+		sq.Select("COUNT(*)").From("review"),
+		sq.Eq{"user_id": authorID, "hidden": false},
+		filter,
+	).
 		PlaceholderFormat(sq.Dollar).
 		ToSql()
 	if err != nil {
 		return 0, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetReviewsCountByAuthorID"))
 	}
 	var count int
-	err = r.DB.QueryRow(query, args...).Scan(&count)
+	err = r.DB.QueryRowContext(ctx, query, args...).Scan(&count)
 	if err != nil {
 		return 0, entity.PSQLQueryErr("GetReviewsCountByAuthorID", err)
 	}
 	return count, nil
 }
 
-// GetReviewsByAuthorID возвращает отзывы по ID автора, сортируя их по дате добавления
-func (r *ReviewDB) GetReviewsByAuthorID(authorID, page, limit int) ([]*entity.Review, error) {
-	query, args, err := selectAllFields().
-		From("review").
-		Where(sq.Eq{"user_id": authorID}).
-		OrderBy("created_at DESC", "id ASC").
+// GetReviewsByAuthorID возвращает отзывы по ID автора, по умолчанию сортируя их по дате добавления. Скрытые
+// модератором рецензии не возвращаются
+func (r *ReviewDB) GetReviewsByAuthorID(
+	ctx context.Context,
+	authorID, page, limit int,
+	filter entity.ReviewFilter,
+) ([]*entity.Review, error) {
+	defer metrics.ObservePostgresQuery("review", "GetReviewsByAuthorID", time.Now())
+	query, args, err := whereReviewFilter(
+		selectAllFields().From("review"),
+		sq.Eq{"user_id": authorID, "hidden": false},
+		filter,
+	).
+		OrderBy(reviewOrderBy(filter.Sort, entity.ReviewSortNewest)...).
 		Limit(uint64(limit)).
 		Offset(uint64((page - 1) * limit)).
 		PlaceholderFormat(sq.Dollar).
@@ -257,7 +355,7 @@ func (r *ReviewDB) GetReviewsByAuthorID(authorID, page, limit int) ([]*entity.Re
 	if err != nil {
 		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetReviewsByAuthorID"))
 	}
-	rows, err := r.DB.Queryx(query, args...)
+	rows, err := r.DB.QueryxContext(ctx, query, args...)
 	if err != nil {
 		return nil, entity.PSQLQueryErr("GetReviewsByAuthorID", err)
 	}
@@ -265,7 +363,8 @@ func (r *ReviewDB) GetReviewsByAuthorID(authorID, page, limit int) ([]*entity.Re
 	return scanRows(rows)
 }
 
-func (r *ReviewDB) GetContentReviewByAuthor(authorID, contentID int) (*entity.Review, error) {
+func (r *ReviewDB) GetContentReviewByAuthor(ctx context.Context, authorID, contentID int) (*entity.Review, error) {
+	defer metrics.ObservePostgresQuery("review", "GetContentReviewByAuthor", time.Now())
 	query, args, err := selectAllFields().
 		From("review").
 		Where(sq.Eq{"user_id": authorID, "content_id": contentID}).
@@ -274,7 +373,7 @@ func (r *ReviewDB) GetContentReviewByAuthor(authorID, contentID int) (*entity.Re
 	if err != nil {
 		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetContentReviewByAuthor"))
 	}
-	review, err := scanAllFields(r.DB.QueryRow(query, args...))
+	review, err := scanAllFields(r.DB.QueryRowContext(ctx, query, args...))
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, repository.ErrReviewNotFound
@@ -285,7 +384,8 @@ func (r *ReviewDB) GetContentReviewByAuthor(authorID, contentID int) (*entity.Re
 }
 
 // VoteReview добавляет оценку к отзыву
-func (r *ReviewDB) VoteReview(reviewID, userID int, vote bool) error {
+func (r *ReviewDB) VoteReview(ctx context.Context, reviewID, userID int, vote bool) error {
+	defer metrics.ObservePostgresQuery("review", "VoteReview", time.Now())
 	query, args, err := sq.Insert("review_vote").
 		Columns("review_id", "user_id", "value").
 		Values(reviewID, userID, vote).
@@ -294,7 +394,7 @@ func (r *ReviewDB) VoteReview(reviewID, userID int, vote bool) error {
 	if err != nil {
 		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса VoteReview"))
 	}
-	_, err = r.DB.Exec(query, args...)
+	_, err = r.DB.ExecContext(ctx, query, args...)
 	var pqErr *pq.Error
 	if errors.As(err, &pqErr) {
 		switch pqErr.Code {
@@ -311,7 +411,8 @@ func (r *ReviewDB) VoteReview(reviewID, userID int, vote bool) error {
 }
 
 // UnVoteReview удаляет оценку с отзыва
-func (r *ReviewDB) UnVoteReview(reviewID, userID int) error {
+func (r *ReviewDB) UnVoteReview(ctx context.Context, reviewID, userID int) error {
+	defer metrics.ObservePostgresQuery("review", "UnVoteReview", time.Now())
 	query, args, err := sq.Delete("review_vote").
 		Where(sq.Eq{"review_id": reviewID, "user_id": userID}).
 		PlaceholderFormat(sq.Dollar).
@@ -319,7 +420,7 @@ func (r *ReviewDB) UnVoteReview(reviewID, userID int) error {
 	if err != nil {
 		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса UnVoteReview"))
 	}
-	_, err = r.DB.Exec(query, args...)
+	_, err = r.DB.ExecContext(ctx, query, args...)
 	var pqErr *pq.Error
 	if errors.As(err, &pqErr) {
 		switch pqErr.Code {
@@ -333,9 +434,48 @@ func (r *ReviewDB) UnVoteReview(reviewID, userID int) error {
 	return nil
 }
 
+// GetReviewRevisions возвращает предыдущие версии рецензии в порядке правок. Версии сохраняет триггер
+// save_review_revision при изменении заголовка, текста, оценки или отметки о спойлерах
+func (r *ReviewDB) GetReviewRevisions(ctx context.Context, reviewID int) ([]*entity.ReviewRevision, error) {
+	defer metrics.ObservePostgresQuery("review", "GetReviewRevisions", time.Now())
+	query, args, err := sq.Select(
+		"id",
+		"review_id",
+		"title",
+		"text",
+		"content_rating",
+		"has_spoilers",
+		"created_at",
+		"replaced_at",
+	).
+		From("review_revision").
+		Where(sq.Eq{"review_id": reviewID}).
+		OrderBy("replaced_at ASC", "id ASC").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetReviewRevisions"))
+	}
+	rows, err := r.DB.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, entity.PSQLQueryErr("GetReviewRevisions", err)
+	}
+	defer rows.Close()
+	revisions := make([]*entity.ReviewRevision, 0)
+	for rows.Next() {
+		revision := new(entity.ReviewRevision)
+		if err = rows.StructScan(revision); err != nil {
+			return nil, entity.PSQLQueryErr("GetReviewRevisions при сканировании версий рецензии", err)
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions, nil
+}
+
 // IsVotedByUser возвращает 1, если на отзыв поставлен лайк, и возвращает -1, если на отзыв поставлен дизлайк.
 // Если пользователь не оценивал отзыв, возвращает 0
-func (r *ReviewDB) IsVotedByUser(reviewID, userID int) (int, error) {
+func (r *ReviewDB) IsVotedByUser(ctx context.Context, reviewID, userID int) (int, error) {
+	defer metrics.ObservePostgresQuery("review", "IsVotedByUser", time.Now())
 	query, args, err := sq.Select("value").
 		From("review_vote").
 		Where(sq.Eq{"review_id": reviewID, "user_id": userID}).
@@ -345,7 +485,7 @@ func (r *ReviewDB) IsVotedByUser(reviewID, userID int) (int, error) {
 		return 0, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса IsVotedByUser"))
 	}
 	var value bool
-	err = r.DB.QueryRow(query, args...).Scan(&value)
+	err = r.DB.QueryRowContext(ctx, query, args...).Scan(&value)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return 0, nil
@@ -357,3 +497,49 @@ func (r *ReviewDB) IsVotedByUser(reviewID, userID int) (int, error) {
 	}
 	return -1, nil
 }
+
+// GetContentRatingStats возвращает число оценок контента с каждым значением и его взвешенную оценку. Оценки берутся
+// из представления content_rating_vote, как и в триггере update_content_rating
+func (r *ReviewDB) GetContentRatingStats(ctx context.Context, contentID int) (*entity.ContentRatingStats, error) {
+	defer metrics.ObservePostgresQuery("review", "GetContentRatingStats", time.Now())
+	query, args, err := sq.Select("weighted_rating").
+		From("content").
+		Where(sq.Eq{"id": contentID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetContentRatingStats"))
+	}
+	stats := &entity.ContentRatingStats{ContentID: contentID}
+	if err = r.DB.QueryRowContext(ctx, query, args...).Scan(&stats.WeightedRating); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrContentNotFound
+		}
+		return nil, entity.PSQLQueryErr("GetContentRatingStats", err)
+	}
+
+	query, args, err = sq.Select("rating", "COUNT(*)").
+		From("content_rating_vote").
+		Where(sq.Eq{"content_id": contentID}).
+		GroupBy("rating").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetContentRatingStats"))
+	}
+	rows, err := r.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, entity.PSQLQueryErr("GetContentRatingStats", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var rating, count int
+		if err = rows.Scan(&rating, &count); err != nil {
+			return nil, entity.PSQLQueryErr("GetContentRatingStats при сканировании оценок", err)
+		}
+		if rating >= 1 && rating <= len(stats.Histogram) {
+			stats.Histogram[rating-1] = count
+		}
+	}
+	return stats, nil
+}
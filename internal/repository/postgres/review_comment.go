@@ -0,0 +1,220 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"time"
+)
+
+type ReviewCommentDB struct {
+	DB *sqlx.DB
+}
+
+func NewReviewCommentRepository(db *sqlx.DB) repository.ReviewComment {
+	return &ReviewCommentDB{
+		DB: db,
+	}
+}
+
+func selectCommentFields() sq.SelectBuilder {
+	return sq.Select(
+		"id",
+		"review_id",
+		"user_id",
+		"parent_id",
+		"text",
+		"created_at",
+		"updated_at",
+	)
+}
+
+func scanComments(rows *sqlx.Rows) ([]*entity.ReviewComment, error) {
+	comments := make([]*entity.ReviewComment, 0)
+	for rows.Next() {
+		comment := new(entity.ReviewComment)
+		if err := rows.StructScan(comment); err != nil {
+			return nil, entity.PSQLQueryErr("scanComments при сканировании комментариев", err)
+		}
+		comments = append(comments, comment)
+	}
+	return comments, nil
+}
+
+// AddComment добавляет комментарий к рецензии.
+// У переданного entity.ReviewComment должны быть заполнены поля: ReviewID, AuthorID, Text и, для ответа, ParentID.
+// В случае успеха в comment записываются ID, CreatedAt и UpdatedAt
+func (r *ReviewCommentDB) AddComment(
+	ctx context.Context,
+	comment *entity.ReviewComment,
+) (*entity.ReviewComment, error) {
+	defer metrics.ObservePostgresQuery("review_comment", "AddComment", time.Now())
+	query, args, err := sq.Insert("review_comment").
+		Columns("review_id", "user_id", "parent_id", "text").
+		Values(comment.ReviewID, comment.AuthorID, comment.ParentID, comment.Text).
+		Suffix("RETURNING id, created_at, updated_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса AddComment"))
+	}
+	err = r.DB.QueryRowContext(ctx, query, args...).Scan(&comment.ID, &comment.CreatedAt, &comment.UpdatedAt)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case entity.PSQLCheckViolation:
+			return nil, repository.ErrReviewCommentBadRequest
+		case entity.PSQLForeignKeyViolation:
+			return nil, repository.ErrReviewCommentViolation
+		}
+	}
+	if err != nil {
+		return nil, entity.PSQLQueryErr("AddComment", err)
+	}
+	return comment, nil
+}
+
+// GetCommentByID возвращает комментарий по его ID
+func (r *ReviewCommentDB) GetCommentByID(ctx context.Context, id int) (*entity.ReviewComment, error) {
+	defer metrics.ObservePostgresQuery("review_comment", "GetCommentByID", time.Now())
+	query, args, err := selectCommentFields().
+		From("review_comment").
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetCommentByID"))
+	}
+	comment := new(entity.ReviewComment)
+	err = r.DB.QueryRowxContext(ctx, query, args...).StructScan(comment)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrReviewCommentNotFound
+		}
+		return nil, entity.PSQLQueryErr("GetCommentByID", err)
+	}
+	return comment, nil
+}
+
+// GetCommentsCountByReviewID возвращает количество комментариев верхнего уровня к рецензии
+func (r *ReviewCommentDB) GetCommentsCountByReviewID(ctx context.Context, reviewID int) (int, error) {
+	defer metrics.ObservePostgresQuery("review_comment", "GetCommentsCountByReviewID", time.Now())
+	query, args, err := sq.Select("COUNT(*)").
+		From("review_comment").
+		Where(sq.Eq{"review_id": reviewID, "parent_id": nil}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetCommentsCountByReviewID"))
+	}
+	var count int
+	err = r.DB.QueryRowContext(ctx, query, args...).Scan(&count)
+	if err != nil {
+		return 0, entity.PSQLQueryErr("GetCommentsCountByReviewID", err)
+	}
+	return count, nil
+}
+
+// GetCommentsByReviewID возвращает комментарии верхнего уровня к рецензии, сначала старые
+func (r *ReviewCommentDB) GetCommentsByReviewID(
+	ctx context.Context,
+	reviewID, page, limit int,
+) ([]*entity.ReviewComment, error) {
+	defer metrics.ObservePostgresQuery("review_comment", "GetCommentsByReviewID", time.Now())
+	query, args, err := selectCommentFields().
+		From("review_comment").
+		Where(sq.Eq{"review_id": reviewID, "parent_id": nil}).
+		OrderBy("created_at ASC", "id ASC").
+		Limit(uint64(limit)).
+		Offset(uint64((page - 1) * limit)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetCommentsByReviewID"))
+	}
+	rows, err := r.DB.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, entity.PSQLQueryErr("GetCommentsByReviewID", err)
+	}
+	defer rows.Close()
+	return scanComments(rows)
+}
+
+// GetRepliesByParentIDs возвращает ответы на комментарии одним запросом, сначала старые
+func (r *ReviewCommentDB) GetRepliesByParentIDs(ctx context.Context, parentIDs []int) ([]*entity.ReviewComment, error) {
+	defer metrics.ObservePostgresQuery("review_comment", "GetRepliesByParentIDs", time.Now())
+	if len(parentIDs) == 0 {
+		return make([]*entity.ReviewComment, 0), nil
+	}
+	query, args, err := selectCommentFields().
+		From("review_comment").
+		Where(sq.Eq{"parent_id": parentIDs}).
+		OrderBy("created_at ASC", "id ASC").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetRepliesByParentIDs"))
+	}
+	rows, err := r.DB.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, entity.PSQLQueryErr("GetRepliesByParentIDs", err)
+	}
+	defer rows.Close()
+	return scanComments(rows)
+}
+
+// UpdateComment обновляет текст комментария.
+// У переданного entity.ReviewComment должны быть заполнены поля: ID, Text
+func (r *ReviewCommentDB) UpdateComment(ctx context.Context, comment *entity.ReviewComment) error {
+	defer metrics.ObservePostgresQuery("review_comment", "UpdateComment", time.Now())
+	query, args, err := sq.Update("review_comment").
+		Set("text", comment.Text).
+		Where(sq.Eq{"id": comment.ID}).
+		Suffix("RETURNING id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса UpdateComment"))
+	}
+	var updatedID int
+	err = r.DB.QueryRowContext(ctx, query, args...).Scan(&updatedID)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == entity.PSQLCheckViolation {
+		return repository.ErrReviewCommentBadRequest
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return repository.ErrReviewCommentNotFound
+	}
+	if err != nil {
+		return entity.PSQLQueryErr("UpdateComment", err)
+	}
+	return nil
+}
+
+// DeleteCommentByID удаляет комментарий по его ID. Ответы на комментарий удаляются каскадно
+func (r *ReviewCommentDB) DeleteCommentByID(ctx context.Context, id int) error {
+	defer metrics.ObservePostgresQuery("review_comment", "DeleteCommentByID", time.Now())
+	query, args, err := sq.Delete("review_comment").
+		Where(sq.Eq{"id": id}).
+		Suffix("RETURNING id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса DeleteCommentByID"))
+	}
+	var deletedID int
+	err = r.DB.QueryRowContext(ctx, query, args...).Scan(&deletedID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return repository.ErrReviewCommentNotFound
+	}
+	if err != nil {
+		return entity.PSQLQueryErr("DeleteCommentByID", err)
+	}
+	return nil
+}
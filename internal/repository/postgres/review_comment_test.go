@@ -0,0 +1,250 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReviewCommentDB_AddComment(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Now()
+
+	testCases := []struct {
+		Name           string
+		Comment        *entity.ReviewComment
+		ExpectedOutput *entity.ReviewComment
+		ExpectedErr    error
+		SetupMock      func(mock sqlmock.Sqlmock, query string, args []driver.Value)
+	}{
+		{
+			Name: "Успешное добавление ответа",
+			Comment: &entity.ReviewComment{
+				ReviewID: 1,
+				AuthorID: 1,
+				ParentID: sql.NullInt64{Int64: 1, Valid: true},
+				Text:     "text",
+			},
+			ExpectedOutput: &entity.ReviewComment{
+				ID:        2,
+				ReviewID:  1,
+				AuthorID:  1,
+				ParentID:  sql.NullInt64{Int64: 1, Valid: true},
+				Text:      "text",
+				CreatedAt: fixedTime,
+				UpdatedAt: fixedTime,
+			},
+			ExpectedErr: nil,
+			SetupMock: func(mock sqlmock.Sqlmock, query string, args []driver.Value) {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(args...).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).
+						AddRow(2, fixedTime, fixedTime))
+			},
+		},
+		{
+			Name:           "Рецензия не существует",
+			Comment:        &entity.ReviewComment{ReviewID: 1, AuthorID: 1, Text: "text"},
+			ExpectedOutput: nil,
+			ExpectedErr:    repository.ErrReviewCommentViolation,
+			SetupMock: func(mock sqlmock.Sqlmock, query string, args []driver.Value) {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(args...).
+					WillReturnError(&pq.Error{Code: entity.PSQLForeignKeyViolation})
+			},
+		},
+		{
+			Name:           "Слишком длинный текст",
+			Comment:        &entity.ReviewComment{ReviewID: 1, AuthorID: 1, Text: "text"},
+			ExpectedOutput: nil,
+			ExpectedErr:    repository.ErrReviewCommentBadRequest,
+			SetupMock: func(mock sqlmock.Sqlmock, query string, args []driver.Value) {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(args...).
+					WillReturnError(&pq.Error{Code: entity.PSQLCheckViolation})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewReviewCommentRepository(sqlx.NewDb(db, "sqlmock"))
+			query, args, err := sq.Insert("review_comment").
+				Columns("review_id", "user_id", "parent_id", "text").
+				Values(tc.Comment.ReviewID, tc.Comment.AuthorID, tc.Comment.ParentID, tc.Comment.Text).
+				Suffix("RETURNING id, created_at, updated_at").
+				PlaceholderFormat(sq.Dollar).
+				ToSql()
+			require.NoError(t, err)
+			driverValues := make([]driver.Value, len(args))
+			for i, v := range args {
+				driverValues[i] = v
+			}
+			tc.SetupMock(mock, query, driverValues)
+			output, err := repo.AddComment(context.Background(), tc.Comment)
+			require.Equal(t, tc.ExpectedOutput, output)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestReviewCommentDB_GetRepliesByParentIDs(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Now()
+
+	testCases := []struct {
+		Name           string
+		ParentIDs      []int
+		ExpectedOutput []*entity.ReviewComment
+		ExpectedErr    error
+		SetupMock      func(mock sqlmock.Sqlmock, query string, args []driver.Value)
+	}{
+		{
+			Name:      "Ответы найдены",
+			ParentIDs: []int{1, 2},
+			ExpectedOutput: []*entity.ReviewComment{
+				{
+					ID:        3,
+					ReviewID:  1,
+					AuthorID:  1,
+					ParentID:  sql.NullInt64{Int64: 1, Valid: true},
+					Text:      "text",
+					CreatedAt: fixedTime,
+					UpdatedAt: fixedTime,
+				},
+			},
+			ExpectedErr: nil,
+			SetupMock: func(mock sqlmock.Sqlmock, query string, args []driver.Value) {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(args...).
+					WillReturnRows(sqlmock.NewRows([]string{
+						"id",
+						"review_id",
+						"user_id",
+						"parent_id",
+						"text",
+						"created_at",
+						"updated_at",
+					}).
+						AddRow(3, 1, 1, 1, "text", fixedTime, fixedTime))
+			},
+		},
+		{
+			Name:           "Пустой список родителей",
+			ParentIDs:      []int{},
+			ExpectedOutput: []*entity.ReviewComment{},
+			ExpectedErr:    nil,
+			SetupMock:      func(mock sqlmock.Sqlmock, query string, args []driver.Value) {},
+		},
+		{
+			Name:           "Неизвестная ошибка",
+			ParentIDs:      []int{1},
+			ExpectedOutput: nil,
+			ExpectedErr:    entity.PSQLQueryErr("GetRepliesByParentIDs", fmt.Errorf("ошибка")),
+			SetupMock: func(mock sqlmock.Sqlmock, query string, args []driver.Value) {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(args...).
+					WillReturnError(fmt.Errorf("ошибка"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewReviewCommentRepository(sqlx.NewDb(db, "sqlmock"))
+			query, args, err := selectCommentFields().
+				From("review_comment").
+				Where(sq.Eq{"parent_id": tc.ParentIDs}).
+				OrderBy("created_at ASC", "id ASC").
+				PlaceholderFormat(sq.Dollar).
+				ToSql()
+			require.NoError(t, err)
+			driverValues := make([]driver.Value, len(args))
+			for i, v := range args {
+				driverValues[i] = v
+			}
+			tc.SetupMock(mock, query, driverValues)
+			output, err := repo.GetRepliesByParentIDs(context.Background(), tc.ParentIDs)
+			require.Equal(t, tc.ExpectedOutput, output)
+			require.Equal(t, tc.ExpectedErr, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestReviewCommentDB_DeleteCommentByID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		CommentID   int
+		ExpectedErr error
+		SetupMock   func(mock sqlmock.Sqlmock, query string, args []driver.Value)
+	}{
+		{
+			Name:        "Успешное удаление",
+			CommentID:   1,
+			ExpectedErr: nil,
+			SetupMock: func(mock sqlmock.Sqlmock, query string, args []driver.Value) {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(args...).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+			},
+		},
+		{
+			Name:        "Комментарий не найден",
+			CommentID:   1,
+			ExpectedErr: repository.ErrReviewCommentNotFound,
+			SetupMock: func(mock sqlmock.Sqlmock, query string, args []driver.Value) {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).
+					WithArgs(args...).
+					WillReturnError(sql.ErrNoRows)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewReviewCommentRepository(sqlx.NewDb(db, "sqlmock"))
+			query, args, err := sq.Delete("review_comment").
+				Where(sq.Eq{"id": tc.CommentID}).
+				Suffix("RETURNING id").
+				PlaceholderFormat(sq.Dollar).
+				ToSql()
+			require.NoError(t, err)
+			driverValues := make([]driver.Value, len(args))
+			for i, v := range args {
+				driverValues[i] = v
+			}
+			tc.SetupMock(mock, query, driverValues)
+			err = repo.DeleteCommentByID(context.Background(), tc.CommentID)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
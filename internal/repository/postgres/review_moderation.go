@@ -0,0 +1,270 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"time"
+)
+
+type ReviewModerationDB struct {
+	DB *sqlx.DB
+}
+
+func NewReviewModerationRepository(db *sqlx.DB) repository.ReviewModeration {
+	return &ReviewModerationDB{
+		DB: db,
+	}
+}
+
+// IsModerator проверяет, назначен ли пользователь модератором
+func (r *ReviewModerationDB) IsModerator(ctx context.Context, userID int) (bool, error) {
+	defer metrics.ObservePostgresQuery("review_moderation", "IsModerator", time.Now())
+	query, args, err := sq.Select("user_id").
+		From("moderator").
+		Where(sq.Eq{"user_id": userID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return false, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса IsModerator"))
+	}
+	var moderatorID int
+	err = r.DB.QueryRowContext(ctx, query, args...).Scan(&moderatorID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, entity.PSQLQueryErr("IsModerator", err)
+	}
+	return true, nil
+}
+
+// AddReport добавляет жалобу на рецензию.
+// У переданного entity.ReviewReport должны быть заполнены поля: ReviewID, UserID, Reason, Comment
+func (r *ReviewModerationDB) AddReport(ctx context.Context, report *entity.ReviewReport) error {
+	defer metrics.ObservePostgresQuery("review_moderation", "AddReport", time.Now())
+	query, args, err := sq.Insert("review_report").
+		Columns("review_id", "user_id", "reason", "comment").
+		Values(report.ReviewID, report.UserID, report.Reason, report.Comment).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса AddReport"))
+	}
+	_, err = r.DB.ExecContext(ctx, query, args...)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case entity.PSQLCheckViolation:
+			return repository.ErrReviewReportBadRequest
+		case entity.PSQLUniqueViolation:
+			return repository.ErrReviewReportAlreadyExists
+		case entity.PSQLForeignKeyViolation:
+			return repository.ErrReviewNotFound
+		}
+	}
+	if err != nil {
+		return entity.PSQLQueryErr("AddReport", err)
+	}
+	return nil
+}
+
+// GetReportedReviewsCount возвращает количество рецензий с нерассмотренными жалобами
+func (r *ReviewModerationDB) GetReportedReviewsCount(ctx context.Context) (int, error) {
+	defer metrics.ObservePostgresQuery("review_moderation", "GetReportedReviewsCount", time.Now())
+	query, args, err := sq.Select("COUNT(DISTINCT review_id)").
+		From("review_report").
+		Where(sq.Eq{"resolved": false}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetReportedReviewsCount"))
+	}
+	var count int
+	err = r.DB.QueryRowContext(ctx, query, args...).Scan(&count)
+	if err != nil {
+		return 0, entity.PSQLQueryErr("GetReportedReviewsCount", err)
+	}
+	return count, nil
+}
+
+// GetReportedReviews возвращает очередь модерации: рецензии с нерассмотренными жалобами, их количество и причины.
+// Первыми идут рецензии с наибольшим числом жалоб, при равенстве - те, на которые пожаловались раньше
+func (r *ReviewModerationDB) GetReportedReviews(
+	ctx context.Context,
+	page, limit int,
+) ([]*entity.ReportedReview, error) {
+	defer metrics.ObservePostgresQuery("review_moderation", "GetReportedReviews", time.Now())
+	query, args, err := sq.Select(
+		"rr.review_id",
+		"r.hidden",
+		"COUNT(*) AS reports",
+		"ARRAY_AGG(DISTINCT rr.reason) AS reasons",
+		"MAX(rr.created_at) AS last_reported_at",
+	).
+		From("review_report rr").
+		Join("review r ON r.id = rr.review_id").
+		Where(sq.Eq{"rr.resolved": false}).
+		GroupBy("rr.review_id", "r.hidden").
+		OrderBy("reports DESC", "last_reported_at ASC", "rr.review_id ASC").
+		Limit(uint64(limit)).
+		Offset(uint64((page - 1) * limit)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetReportedReviews"))
+	}
+	rows, err := r.DB.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, entity.PSQLQueryErr("GetReportedReviews", err)
+	}
+	defer rows.Close()
+	reviews := make([]*entity.ReportedReview, 0)
+	for rows.Next() {
+		review := new(entity.ReportedReview)
+		if err = rows.StructScan(review); err != nil {
+			return nil, entity.PSQLQueryErr("GetReportedReviews при сканировании", err)
+		}
+		reviews = append(reviews, review)
+	}
+	return reviews, nil
+}
+
+// applyModerationAction применяет решение модератора к рецензии внутри транзакции
+func applyModerationAction(ctx context.Context, tx *sqlx.Tx, reviewID int, action string) error {
+	var builder sq.Sqlizer
+	switch action {
+	case entity.ModerationDelete:
+		builder = sq.Delete("review").
+			Where(sq.Eq{"id": reviewID}).
+			Suffix("RETURNING id").
+			PlaceholderFormat(sq.Dollar)
+	default:
+		builder = sq.Update("review").
+			Set("hidden", action == entity.ModerationHide).
+			Where(sq.Eq{"id": reviewID}).
+			Suffix("RETURNING id").
+			PlaceholderFormat(sq.Dollar)
+	}
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса ResolveReview"))
+	}
+	var id int
+	err = tx.QueryRowContext(ctx, query, args...).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return repository.ErrReviewNotFound
+	}
+	if err != nil {
+		return entity.PSQLQueryErr("ResolveReview", err)
+	}
+	return nil
+}
+
+// ResolveReview в одной транзакции применяет решение модератора, закрывает жалобы на рецензию и записывает
+// решение в журнал. При удалении рецензии жалобы удаляются каскадно.
+// В случае успеха в decision записываются ID и CreatedAt
+func (r *ReviewModerationDB) ResolveReview(ctx context.Context, decision *entity.ModerationDecision) error {
+	defer metrics.ObservePostgresQuery("review_moderation", "ResolveReview", time.Now())
+	tx, err := r.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return entity.PSQLQueryErr("ResolveReview при открытии транзакции", err)
+	}
+	// после успешного Commit откат ничего не делает
+	defer tx.Rollback() // nolint: errcheck
+	if err = applyModerationAction(ctx, tx, decision.ReviewID, decision.Action); err != nil {
+		return err
+	}
+	if decision.Action != entity.ModerationDelete {
+		query, args, err := sq.Update("review_report").
+			Set("resolved", true).
+			Where(sq.Eq{"review_id": decision.ReviewID, "resolved": false}).
+			PlaceholderFormat(sq.Dollar).
+			ToSql()
+		if err != nil {
+			return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса ResolveReview"))
+		}
+		if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+			return entity.PSQLQueryErr("ResolveReview", err)
+		}
+	}
+	query, args, err := sq.Insert("review_moderation_log").
+		Columns("review_id", "moderator_id", "action", "note").
+		Values(decision.ReviewID, decision.ModeratorID, decision.Action, decision.Note).
+		Suffix("RETURNING id, created_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса ResolveReview"))
+	}
+	if err = tx.QueryRowContext(ctx, query, args...).Scan(&decision.ID, &decision.CreatedAt); err != nil {
+		return entity.PSQLQueryErr("ResolveReview", err)
+	}
+	if err = tx.Commit(); err != nil {
+		return entity.PSQLQueryErr("ResolveReview при фиксации транзакции", err)
+	}
+	return nil
+}
+
+// GetModerationLogCount возвращает количество записей в журнале решений модераторов
+func (r *ReviewModerationDB) GetModerationLogCount(ctx context.Context) (int, error) {
+	defer metrics.ObservePostgresQuery("review_moderation", "GetModerationLogCount", time.Now())
+	query, args, err := sq.Select("COUNT(*)").
+		From("review_moderation_log").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetModerationLogCount"))
+	}
+	var count int
+	err = r.DB.QueryRowContext(ctx, query, args...).Scan(&count)
+	if err != nil {
+		return 0, entity.PSQLQueryErr("GetModerationLogCount", err)
+	}
+	return count, nil
+}
+
+// GetModerationLog возвращает журнал решений модераторов, сначала новые. Если модератор удален, ModeratorID равен 0
+func (r *ReviewModerationDB) GetModerationLog(
+	ctx context.Context,
+	page, limit int,
+) ([]*entity.ModerationDecision, error) {
+	defer metrics.ObservePostgresQuery("review_moderation", "GetModerationLog", time.Now())
+	query, args, err := sq.Select(
+		"id",
+		"review_id",
+		"COALESCE(moderator_id, 0) AS moderator_id",
+		"action",
+		"note",
+		"created_at",
+	).
+		From("review_moderation_log").
+		OrderBy("created_at DESC", "id DESC").
+		Limit(uint64(limit)).
+		Offset(uint64((page - 1) * limit)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetModerationLog"))
+	}
+	rows, err := r.DB.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, entity.PSQLQueryErr("GetModerationLog", err)
+	}
+	defer rows.Close()
+	decisions := make([]*entity.ModerationDecision, 0)
+	for rows.Next() {
+		decision := new(entity.ModerationDecision)
+		if err = rows.StructScan(decision); err != nil {
+			return nil, entity.PSQLQueryErr("GetModerationLog при сканировании", err)
+		}
+		decisions = append(decisions, decision)
+	}
+	return decisions, nil
+}
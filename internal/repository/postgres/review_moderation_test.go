@@ -0,0 +1,151 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReviewModerationDB_IsModerator(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		ExpectedOutput bool
+		ExpectedErr    error
+		SetupMock      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			Name:           "Модератор",
+			ExpectedOutput: true,
+			ExpectedErr:    nil,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT user_id FROM moderator WHERE user_id = $1")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(1))
+			},
+		},
+		{
+			Name:           "Не модератор",
+			ExpectedOutput: false,
+			ExpectedErr:    nil,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT user_id FROM moderator WHERE user_id = $1")).
+					WithArgs(1).
+					WillReturnError(sql.ErrNoRows)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewReviewModerationRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			output, err := repo.IsModerator(context.Background(), 1)
+			require.Equal(t, tc.ExpectedOutput, output)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestReviewModerationDB_ResolveReview(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Now()
+
+	testCases := []struct {
+		Name        string
+		Decision    *entity.ModerationDecision
+		ExpectedErr error
+		SetupMock   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			Name:        "Рецензия скрыта",
+			Decision:    &entity.ModerationDecision{ReviewID: 1, ModeratorID: 10, Action: entity.ModerationHide},
+			ExpectedErr: nil,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("UPDATE review SET hidden = $1 WHERE id = $2 RETURNING id")).
+					WithArgs(true, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectExec(regexp.QuoteMeta(
+					"UPDATE review_report SET resolved = $1 WHERE resolved = $2 AND review_id = $3",
+				)).
+					WithArgs(true, false, 1).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO review_moderation_log")).
+					WithArgs(1, 10, entity.ModerationHide, "").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(1, fixedTime))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			Name:        "Рецензия удалена",
+			Decision:    &entity.ModerationDecision{ReviewID: 1, ModeratorID: 10, Action: entity.ModerationDelete},
+			ExpectedErr: nil,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("DELETE FROM review WHERE id = $1 RETURNING id")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO review_moderation_log")).
+					WithArgs(1, 10, entity.ModerationDelete, "").
+					WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(1, fixedTime))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			Name:        "Рецензия не найдена",
+			Decision:    &entity.ModerationDecision{ReviewID: 1, ModeratorID: 10, Action: entity.ModerationApprove},
+			ExpectedErr: repository.ErrReviewNotFound,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("UPDATE review SET hidden = $1 WHERE id = $2 RETURNING id")).
+					WithArgs(false, 1).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+		},
+		{
+			Name:        "Ошибка записи в журнал",
+			Decision:    &entity.ModerationDecision{ReviewID: 1, ModeratorID: 10, Action: entity.ModerationDelete},
+			ExpectedErr: entity.PSQLQueryErr("ResolveReview", fmt.Errorf("ошибка")),
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("DELETE FROM review WHERE id = $1 RETURNING id")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+				mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO review_moderation_log")).
+					WillReturnError(fmt.Errorf("ошибка"))
+				mock.ExpectRollback()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewReviewModerationRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			err = repo.ResolveReview(context.Background(), tc.Decision)
+			require.Equal(t, tc.ExpectedErr, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"context"
 	"database/sql"
 	"database/sql/driver"
 	"fmt"
@@ -177,8 +178,15 @@ func TestReviewDB_AddReview(t *testing.T) {
 			require.NoError(t, err)
 			repo := NewReviewRepository(dbx)
 			query, args, err := sq.Insert("review").
-				Columns("user_id", "content_id", "title", "text", "content_rating").
-				Values(tc.RequestReview.AuthorID, tc.RequestReview.ContentID, tc.RequestReview.Title, tc.RequestReview.Text, tc.RequestReview.ContentRating).
+				Columns("user_id", "content_id", "title", "text", "content_rating", "has_spoilers").
+				Values(
+					tc.RequestReview.AuthorID,
+					tc.RequestReview.ContentID,
+					tc.RequestReview.Title,
+					tc.RequestReview.Text,
+					tc.RequestReview.ContentRating,
+					tc.RequestReview.HasSpoilers,
+				).
 				Suffix("RETURNING id, created_at, updated_at").
 				PlaceholderFormat(sq.Dollar).
 				ToSql()
@@ -189,7 +197,7 @@ func TestReviewDB_AddReview(t *testing.T) {
 				driverValues[i] = v
 			}
 			tc.SetupMock(mock, query, driverValues)
-			output, err := repo.AddReview(tc.RequestReview)
+			output, err := repo.AddReview(context.Background(), tc.RequestReview)
 			require.Equal(t, tc.ExpectedOutput, output)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
@@ -223,6 +231,7 @@ func TestReviewDB_GetReviewByID(t *testing.T) {
 				Rating:        -95,
 				Likes:         5,
 				Dislikes:      100,
+				Comments:      3,
 			},
 			ExpectedErr: nil,
 			SetupMock: func(mock sqlmock.Sqlmock, query string, args []driver.Value) {
@@ -240,8 +249,13 @@ func TestReviewDB_GetReviewByID(t *testing.T) {
 						"likes",
 						"dislikes",
 						"rating",
+						"comments",
+						"has_spoilers",
+						"edit_count",
+						"edited_at",
+						"hidden",
 					}).
-						AddRow(1, 1, 1, "title", "text", 5, fixedTime, fixedTime, 5, 100, -95))
+						AddRow(1, 1, 1, "title", "text", 5, fixedTime, fixedTime, 5, 100, -95, 3, false, 0, nil, false))
 			},
 		},
 		{
@@ -287,7 +301,7 @@ func TestReviewDB_GetReviewByID(t *testing.T) {
 				driverValues[i] = v
 			}
 			tc.SetupMock(mock, query, driverValues)
-			output, err := repo.GetReviewByID(tc.RequestID)
+			output, err := repo.GetReviewByID(context.Background(), tc.RequestID)
 			require.Equal(t, tc.ExpectedOutput, output)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
@@ -339,7 +353,7 @@ func TestReviewDB_GetReviewsCountByContentID(t *testing.T) {
 			repo := NewReviewRepository(dbx)
 			query, args, err := sq.Select("COUNT(*)").
 				From("review").
-				Where(sq.Eq{"content_id": tc.RequestContentID}).
+				Where(sq.Eq{"content_id": tc.RequestContentID, "hidden": false}).
 				PlaceholderFormat(sq.Dollar).
 				ToSql()
 			require.NoError(t, err)
@@ -348,7 +362,7 @@ func TestReviewDB_GetReviewsCountByContentID(t *testing.T) {
 				driverValues[i] = v
 			}
 			tc.SetupMock(mock, query, driverValues)
-			output, err := repo.GetReviewsCountByContentID(tc.RequestContentID)
+			output, err := repo.GetReviewsCountByContentID(context.Background(), tc.RequestContentID, entity.ReviewFilter{})
 			require.Equal(t, tc.ExpectedOutput, output)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
@@ -400,7 +414,7 @@ func TestReviewDB_GetReviewsCountByAuthorID(t *testing.T) {
 			repo := NewReviewRepository(dbx)
 			query, args, err := sq.Select("COUNT(*)").
 				From("review").
-				Where(sq.Eq{"user_id": tc.RequestAuthorID}).
+				Where(sq.Eq{"user_id": tc.RequestAuthorID, "hidden": false}).
 				PlaceholderFormat(sq.Dollar).
 				ToSql()
 			require.NoError(t, err)
@@ -409,7 +423,7 @@ func TestReviewDB_GetReviewsCountByAuthorID(t *testing.T) {
 				driverValues[i] = v
 			}
 			tc.SetupMock(mock, query, driverValues)
-			output, err := repo.GetReviewsCountByAuthorID(tc.RequestAuthorID)
+			output, err := repo.GetReviewsCountByAuthorID(context.Background(), tc.RequestAuthorID, entity.ReviewFilter{})
 			require.Equal(t, tc.ExpectedOutput, output)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
@@ -428,6 +442,7 @@ func TestReviewDB_GetReviewsByContentID(t *testing.T) {
 			Page      int
 			Limit     int
 		}
+		Filter         entity.ReviewFilter
 		ExpectedOutput []*entity.Review
 		ExpectedErr    error
 		SetupMock      func(mock sqlmock.Sqlmock, query string, args []driver.Value)
@@ -474,8 +489,31 @@ func TestReviewDB_GetReviewsByContentID(t *testing.T) {
 						"likes",
 						"dislikes",
 						"rating",
+						"comments",
+						"has_spoilers",
+						"edit_count",
+						"edited_at",
+						"hidden",
 					}).
-						AddRows([]driver.Value{1, 1, 1, "title", "text", 5, fixedTime, fixedTime, 10, 100, -90}))
+						AddRows([]driver.Value{1, 1, 1, "title", "text", 5, fixedTime, fixedTime, 10, 100, -90, 0, false, 0, nil, false}))
+			},
+		},
+		{
+			Name: "Без рецензий со спойлерами",
+			Request: struct {
+				ContentID int
+				Page      int
+				Limit     int
+			}{
+				ContentID: 1,
+				Page:      1,
+				Limit:     1,
+			},
+			Filter:         entity.ReviewFilter{HideSpoilers: true},
+			ExpectedOutput: []*entity.Review{},
+			ExpectedErr:    nil,
+			SetupMock: func(mock sqlmock.Sqlmock, query string, args []driver.Value) {
+				mock.ExpectQuery(regexp.QuoteMeta(query)).WithArgs(args...).WillReturnRows(sqlmock.NewRows([]string{}))
 			},
 		},
 		{
@@ -524,9 +562,13 @@ func TestReviewDB_GetReviewsByContentID(t *testing.T) {
 			dbx := sqlx.NewDb(db, "sqlmock")
 			require.NoError(t, err)
 			repo := NewReviewRepository(dbx)
+			where := sq.Eq{"content_id": tc.Request.ContentID, "hidden": false}
+			if tc.Filter.HideSpoilers {
+				where["has_spoilers"] = false
+			}
 			query, args, err := selectAllFields().
 				From("review").
-				Where(sq.Eq{"content_id": tc.Request.ContentID}).
+				Where(where).
 				OrderBy("rating DESC", "id ASC").
 				Limit(uint64(tc.Request.Limit)).
 				Offset(uint64((tc.Request.Page - 1) * tc.Request.Limit)).
@@ -538,13 +580,91 @@ func TestReviewDB_GetReviewsByContentID(t *testing.T) {
 				driverValues[i] = v
 			}
 			tc.SetupMock(mock, query, driverValues)
-			output, err := repo.GetReviewsByContentID(tc.Request.ContentID, tc.Request.Page, tc.Request.Limit)
+			output, err := repo.GetReviewsByContentID(
+				context.Background(),
+				tc.Request.ContentID,
+				tc.Request.Page,
+				tc.Request.Limit,
+				tc.Filter,
+			)
 			require.Equal(t, tc.ExpectedOutput, output)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
 	}
 }
 
+func TestReviewDB_GetReviewsByContentID_Filter(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name          string
+		Filter        entity.ReviewFilter
+		ExpectedQuery string
+		ExpectedArgs  []driver.Value
+	}{
+		{
+			Name:          "Сначала новые",
+			Filter:        entity.ReviewFilter{Sort: entity.ReviewSortNewest},
+			ExpectedQuery: "WHERE content_id = $1 AND hidden = $2 ORDER BY created_at DESC, id ASC",
+			ExpectedArgs:  []driver.Value{1, false},
+		},
+		{
+			Name:          "Сначала старые",
+			Filter:        entity.ReviewFilter{Sort: entity.ReviewSortOldest},
+			ExpectedQuery: "WHERE content_id = $1 AND hidden = $2 ORDER BY created_at ASC, id ASC",
+			ExpectedArgs:  []driver.Value{1, false},
+		},
+		{
+			Name:   "Самые спорные",
+			Filter: entity.ReviewFilter{Sort: entity.ReviewSortControversial},
+			ExpectedQuery: "WHERE content_id = $1 AND hidden = $2 ORDER BY " +
+				"POWER(likes + dislikes, LEAST(likes, dislikes)::FLOAT / GREATEST(likes, dislikes, 1)) DESC, id ASC",
+			ExpectedArgs: []driver.Value{1, false},
+		},
+		{
+			Name:          "Положительные с низкой оценкой первыми",
+			Filter:        entity.ReviewFilter{Sort: entity.ReviewSortLowest, Sentiment: entity.ReviewSentimentPositive},
+			ExpectedQuery: "WHERE content_id = $1 AND hidden = $2 AND content_rating BETWEEN $3 AND $4 ORDER BY content_rating ASC, id ASC",
+			ExpectedArgs:  []driver.Value{1, false, 7, 10},
+		},
+		{
+			Name: "Длинные нейтральные без спойлеров",
+			Filter: entity.ReviewFilter{
+				Sort:          entity.ReviewSortHighest,
+				Sentiment:     entity.ReviewSentimentNeutral,
+				MinTextLength: 500,
+				HideSpoilers:  true,
+			},
+			ExpectedQuery: "WHERE content_id = $1 AND has_spoilers = $2 AND hidden = $3 AND content_rating BETWEEN $4 AND $5 " +
+				"AND LENGTH(regexp_replace(text, '\\[/{0,1}spoiler\\]', '', 'g')) >= $6 ORDER BY content_rating DESC, id ASC",
+			ExpectedArgs: []driver.Value{1, false, false, 5, 6, 500},
+		},
+		{
+			Name:   "Длина текста без разметки спойлеров",
+			Filter: entity.ReviewFilter{MinTextLength: 100},
+			ExpectedQuery: "WHERE content_id = $1 AND hidden = $2 " +
+				"AND LENGTH(regexp_replace(text, '\\[/{0,1}spoiler\\]', '', 'g')) >= $3 ORDER BY rating DESC, id ASC",
+			ExpectedArgs: []driver.Value{1, false, 100},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewReviewRepository(sqlx.NewDb(db, "sqlmock"))
+			mock.ExpectQuery(regexp.QuoteMeta(tc.ExpectedQuery)).
+				WithArgs(tc.ExpectedArgs...).
+				WillReturnRows(sqlmock.NewRows([]string{}))
+			_, err = repo.GetReviewsByContentID(context.Background(), 1, 1, 10, tc.Filter)
+			require.NoError(t, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
 func TestReviewDB_UpdateReview(t *testing.T) {
 	t.Parallel()
 
@@ -651,6 +771,7 @@ func TestReviewDB_UpdateReview(t *testing.T) {
 				Set("title", tc.RequestReview.Title).
 				Set("text", tc.RequestReview.Text).
 				Set("content_rating", tc.RequestReview.ContentRating).
+				Set("has_spoilers", tc.RequestReview.HasSpoilers).
 				Where(sq.Eq{"id": tc.RequestReview.ContentID}).
 				PlaceholderFormat(sq.Dollar).
 				ToSql()
@@ -660,7 +781,7 @@ func TestReviewDB_UpdateReview(t *testing.T) {
 				driverValues[i] = v
 			}
 			tc.SetupMock(mock, query, driverValues)
-			err = repo.UpdateReview(tc.RequestReview)
+			err = repo.UpdateReview(context.Background(), tc.RequestReview)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
 	}
@@ -719,7 +840,7 @@ func TestReviewDB_DeleteReviewByID(t *testing.T) {
 				driverValues[i] = v
 			}
 			tc.SetupMock(mock, query, driverValues)
-			err = repo.DeleteReviewByID(tc.RequestID)
+			err = repo.DeleteReviewByID(context.Background(), tc.RequestID)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
 	}
@@ -783,8 +904,13 @@ func TestReviewDB_GetReviewsByAuthorID(t *testing.T) {
 						"likes",
 						"dislikes",
 						"rating",
+						"comments",
+						"has_spoilers",
+						"edit_count",
+						"edited_at",
+						"hidden",
 					}).
-						AddRows([]driver.Value{1, 1, 1, "title", "text", 5, fixedTime, fixedTime, 10, 100, -90}))
+						AddRows([]driver.Value{1, 1, 1, "title", "text", 5, fixedTime, fixedTime, 10, 100, -90, 0, false, 0, nil, false}))
 			},
 		},
 		{
@@ -835,7 +961,7 @@ func TestReviewDB_GetReviewsByAuthorID(t *testing.T) {
 			repo := NewReviewRepository(dbx)
 			query, args, err := selectAllFields().
 				From("review").
-				Where(sq.Eq{"user_id": tc.Request.AuthorID}).
+				Where(sq.Eq{"user_id": tc.Request.AuthorID, "hidden": false}).
 				OrderBy("created_at DESC", "id ASC").
 				Limit(uint64(tc.Request.Limit)).
 				Offset(uint64((tc.Request.Page - 1) * tc.Request.Limit)).
@@ -847,7 +973,13 @@ func TestReviewDB_GetReviewsByAuthorID(t *testing.T) {
 				driverValues[i] = v
 			}
 			tc.SetupMock(mock, query, driverValues)
-			output, err := repo.GetReviewsByAuthorID(tc.Request.AuthorID, tc.Request.Page, tc.Request.Limit)
+			output, err := repo.GetReviewsByAuthorID(
+				context.Background(),
+				tc.Request.AuthorID,
+				tc.Request.Page,
+				tc.Request.Limit,
+				entity.ReviewFilter{},
+			)
 			require.Equal(t, tc.ExpectedOutput, output)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
@@ -907,8 +1039,13 @@ func TestReviewDB_GetContentReviewByAuthor(t *testing.T) {
 						"likes",
 						"dislikes",
 						"rating",
+						"comments",
+						"has_spoilers",
+						"edit_count",
+						"edited_at",
+						"hidden",
 					}).
-						AddRows([]driver.Value{1, 1, 1, "title", "text", 5, fixedTime, fixedTime, 10, 100, -90}))
+						AddRows([]driver.Value{1, 1, 1, "title", "text", 5, fixedTime, fixedTime, 10, 100, -90, 0, false, 0, nil, false}))
 			},
 		},
 		{
@@ -964,7 +1101,7 @@ func TestReviewDB_GetContentReviewByAuthor(t *testing.T) {
 				driverValues[i] = v
 			}
 			tc.SetupMock(mock, query, driverValues)
-			output, err := repo.GetContentReviewByAuthor(tc.Request.AuthorID, tc.Request.ContentID)
+			output, err := repo.GetContentReviewByAuthor(context.Background(), tc.Request.AuthorID, tc.Request.ContentID)
 			require.Equal(t, tc.ExpectedOutput, output)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
@@ -1017,8 +1154,13 @@ func TestReviewDB_GetLatestReviews(t *testing.T) {
 						"likes",
 						"dislikes",
 						"rating",
+						"comments",
+						"has_spoilers",
+						"edit_count",
+						"edited_at",
+						"hidden",
 					}).
-						AddRows([]driver.Value{1, 1, 1, "title", "text", 5, fixedTime, fixedTime, 10, 100, -90}))
+						AddRows([]driver.Value{1, 1, 1, "title", "text", 5, fixedTime, fixedTime, 10, 100, -90, 0, false, 0, nil, false}))
 			},
 		},
 		{
@@ -1053,6 +1195,7 @@ func TestReviewDB_GetLatestReviews(t *testing.T) {
 			repo := NewReviewRepository(dbx)
 			query, args, err := selectAllFields().
 				From("review").
+				Where(sq.Eq{"hidden": false}).
 				OrderBy("created_at DESC", "id ASC").
 				Limit(uint64(tc.RequestLimit)).
 				PlaceholderFormat(sq.Dollar).
@@ -1063,7 +1206,7 @@ func TestReviewDB_GetLatestReviews(t *testing.T) {
 				driverValues[i] = v
 			}
 			tc.SetupMock(mock, query, driverValues)
-			output, err := repo.GetLatestReviews(tc.RequestLimit)
+			output, err := repo.GetLatestReviews(context.Background(), tc.RequestLimit, entity.ReviewFilter{})
 			require.Equal(t, tc.ExpectedOutput, output)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
@@ -1186,7 +1329,7 @@ func TestReviewDB_VoteReview(t *testing.T) {
 				driverValues[i] = v
 			}
 			tc.SetupMock(mock, query, driverValues)
-			err = repo.VoteReview(tc.Request.ReviewID, tc.Request.UserID, tc.Request.Value)
+			err = repo.VoteReview(context.Background(), tc.Request.ReviewID, tc.Request.UserID, tc.Request.Value)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
 	}
@@ -1270,7 +1413,7 @@ func TestReviewDB_UnVoteReview(t *testing.T) {
 				driverValues[i] = v
 			}
 			tc.SetupMock(mock, query, driverValues)
-			err = repo.UnVoteReview(tc.Request.ReviewID, tc.Request.UserID)
+			err = repo.UnVoteReview(context.Background(), tc.Request.ReviewID, tc.Request.UserID)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
 	}
@@ -1376,9 +1519,159 @@ func TestReviewDB_IsVotedByUser(t *testing.T) {
 				driverValues[i] = v
 			}
 			tc.SetupMock(mock, query, driverValues)
-			output, err := repo.IsVotedByUser(tc.Request.ReviewID, tc.Request.UserID)
+			output, err := repo.IsVotedByUser(context.Background(), tc.Request.ReviewID, tc.Request.UserID)
 			require.Equal(t, tc.Expected, output)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
 	}
 }
+
+func TestReviewDB_GetReviewRevisions(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Now()
+
+	testCases := []struct {
+		Name           string
+		ExpectedOutput []*entity.ReviewRevision
+		ExpectedErr    error
+		SetupMock      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			Name: "Рецензия редактировалась",
+			ExpectedOutput: []*entity.ReviewRevision{
+				{
+					ID:            1,
+					ReviewID:      1,
+					Title:         "title",
+					Text:          "text",
+					ContentRating: 5,
+					HasSpoilers:   false,
+					CreatedAt:     fixedTime,
+					ReplacedAt:    fixedTime,
+				},
+			},
+			ExpectedErr: nil,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					"FROM review_revision WHERE review_id = $1 ORDER BY replaced_at ASC, id ASC",
+				)).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{
+						"id",
+						"review_id",
+						"title",
+						"text",
+						"content_rating",
+						"has_spoilers",
+						"created_at",
+						"replaced_at",
+					}).
+						AddRow(1, 1, "title", "text", 5, false, fixedTime, fixedTime))
+			},
+		},
+		{
+			Name:           "Рецензия не редактировалась",
+			ExpectedOutput: []*entity.ReviewRevision{},
+			ExpectedErr:    nil,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("FROM review_revision")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{}))
+			},
+		},
+		{
+			Name:           "Неизвестная ошибка",
+			ExpectedOutput: nil,
+			ExpectedErr:    entity.PSQLQueryErr("GetReviewRevisions", fmt.Errorf("ошибка")),
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("FROM review_revision")).
+					WithArgs(1).
+					WillReturnError(fmt.Errorf("ошибка"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewReviewRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			output, err := repo.GetReviewRevisions(context.Background(), 1)
+			require.Equal(t, tc.ExpectedOutput, output)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestReviewDB_GetContentRatingStats(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		ExpectedOutput *entity.ContentRatingStats
+		ExpectedErr    error
+		SetupMock      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			Name: "Успешное получение",
+			ExpectedOutput: &entity.ContentRatingStats{
+				ContentID:      1,
+				Histogram:      [10]int{6: 2, 9: 1},
+				WeightedRating: 6.85,
+			},
+			ExpectedErr: nil,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT weighted_rating FROM content WHERE id = $1")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"weighted_rating"}).AddRow(6.85))
+				mock.ExpectQuery(regexp.QuoteMeta(
+					"SELECT rating, COUNT(*) FROM content_rating_vote WHERE content_id = $1 GROUP BY rating",
+				)).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"rating", "count"}).AddRow(7, 2).AddRow(10, 1))
+			},
+		},
+		{
+			Name:           "Контент не найден",
+			ExpectedOutput: nil,
+			ExpectedErr:    repository.ErrContentNotFound,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT weighted_rating FROM content")).
+					WithArgs(1).
+					WillReturnError(sql.ErrNoRows)
+			},
+		},
+		{
+			Name:           "Ошибка при получении оценок",
+			ExpectedOutput: nil,
+			ExpectedErr:    entity.PSQLQueryErr("GetContentRatingStats", fmt.Errorf("ошибка")),
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("SELECT weighted_rating FROM content")).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{"weighted_rating"}).AddRow(0))
+				mock.ExpectQuery(regexp.QuoteMeta("FROM content_rating_vote")).
+					WithArgs(1).
+					WillReturnError(fmt.Errorf("ошибка"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewReviewRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			output, err := repo.GetContentRatingStats(context.Background(), 1)
+			require.Equal(t, tc.ExpectedOutput, output)
+			require.Equal(t, tc.ExpectedErr, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -1,12 +1,15 @@
 package postgres
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	sq "github.com/Masterminds/squirrel"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
 	"github.com/jmoiron/sqlx"
+	"time"
 )
 
 type SearchDB struct {
@@ -23,7 +26,8 @@ func NewSearchRepository(db *sqlx.DB, contentDB repository.Content) repository.S
 
 // SearchContent ищет контент по запросу
 // nolint: dupl
-func (s SearchDB) SearchContent(query string) ([]int, error) {
+func (s SearchDB) SearchContent(ctx context.Context, query string) ([]int, error) {
+	defer metrics.ObservePostgresQuery("search", "SearchContent", time.Now())
 	sqlQuery, args, err := sq.
 		Select("id").
 		From("content").
@@ -41,7 +45,7 @@ THEN similarity(title, '%s') ELSE similarity(original_title, '%s') END DESC`, qu
 		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса SearchContent"))
 	}
 
-	rows, err := s.DB.Query(sqlQuery, args...)
+	rows, err := s.DB.QueryContext(ctx, sqlQuery, args...)
 	if err != nil {
 		return nil, entity.PSQLQueryErr("SearchContent", err)
 	}
@@ -62,7 +66,8 @@ THEN similarity(title, '%s') ELSE similarity(original_title, '%s') END DESC`, qu
 
 // SearchPerson ищет персону по запросу
 // nolint: dupl
-func (s SearchDB) SearchPerson(query string) ([]int, error) {
+func (s SearchDB) SearchPerson(ctx context.Context, query string) ([]int, error) {
+	defer metrics.ObservePostgresQuery("search", "SearchPerson", time.Now())
 	sqlQuery, args, err := sq.
 		Select("id").
 		From("person").
@@ -80,7 +85,7 @@ THEN similarity(Name, '%s') ELSE similarity(en_name, '%s') END DESC`, query, que
 		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса SearchPerson"))
 	}
 
-	rows, err := s.DB.Query(sqlQuery, args...)
+	rows, err := s.DB.QueryContext(ctx, sqlQuery, args...)
 	if err != nil {
 		return nil, entity.PSQLQueryErr("SearchPerson", err)
 	}
@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"context"
 	"fmt"
 	"github.com/DATA-DOG/go-sqlmock"
 	sq "github.com/Masterminds/squirrel"
@@ -61,7 +62,7 @@ THEN similarity(title, '%s') ELSE similarity(original_title, '%s') END DESC`, tc
 				t.Fatalf("ошибка при составлении запроса SearchContent: %s", err)
 			}
 			tc.SetupMock(mock, query)
-			contents, err := repo.SearchContent(tc.Query)
+			contents, err := repo.SearchContent(context.Background(), tc.Query)
 			require.Equal(t, tc.ExpectedErr, err)
 			require.EqualValues(t, tc.ExpectedOut, contents)
 		})
@@ -117,7 +118,7 @@ THEN similarity(Name, '%s') ELSE similarity(en_name, '%s') END DESC`, tc.Query,
 				t.Fatalf("ошибка при составлении запроса SearchPerson: %s", err)
 			}
 			tc.SetupMock(mock, query)
-			persons, err := repo.SearchPerson(tc.Query)
+			persons, err := repo.SearchPerson(context.Background(), tc.Query)
 			require.Equal(t, tc.ExpectedErr, err)
 			require.EqualValues(t, tc.ExpectedOut, persons)
 		})
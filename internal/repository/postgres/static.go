@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
@@ -9,10 +10,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
 	"github.com/jmoiron/sqlx"
 	_ "github.com/lib/pq" // Драйвер для работы с PostgreSQL
 	"io"
+	"time"
 )
 
 type StaticDB struct {
@@ -36,7 +39,8 @@ func (s StaticDB) GetMaxSize() int {
 }
 
 // GetStaticURL возвращает путь к статике по его ID
-func (s StaticDB) GetStaticURL(staticID int) (string, error) {
+func (s StaticDB) GetStaticURL(ctx context.Context, staticID int) (string, error) {
+	defer metrics.ObservePostgresQuery("static", "GetStaticURL", time.Now())
 	query, args, err := sq.
 		Select("path", "Name").
 		From("static").
@@ -46,7 +50,7 @@ func (s StaticDB) GetStaticURL(staticID int) (string, error) {
 		return "", entity.PSQLWrap(errors.New("ошибка при составлении запроса GetStaticURL"), err)
 	}
 	var path, name string
-	err = s.DB.QueryRow(query, args...).Scan(&path, &name)
+	err = s.DB.QueryRowContext(ctx, query, args...).Scan(&path, &name)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return "", repository.ErrStaticNotFound
@@ -57,7 +61,8 @@ func (s StaticDB) GetStaticURL(staticID int) (string, error) {
 }
 
 // UploadStatic загружает статику на сервер
-func (s StaticDB) UploadStatic(path, filename string, reader io.ReadSeeker) (int, error) {
+func (s StaticDB) UploadStatic(ctx context.Context, path, filename string, reader io.ReadSeeker) (int, error) {
+	defer metrics.ObservePostgresQuery("static", "UploadStatic", time.Now())
 	// Проверка размера файла
 	size, err := reader.Seek(0, io.SeekEnd)
 	if err != nil {
@@ -72,7 +77,7 @@ func (s StaticDB) UploadStatic(path, filename string, reader io.ReadSeeker) (int
 	}
 
 	// Загрузка файла в S3
-	_, err = s.s3.PutObject(&s3.PutObjectInput{
+	_, err = s.s3.PutObjectWithContext(ctx, &s3.PutObjectInput{
 		Bucket: aws.String(s.bucketName),
 		Key:    aws.String(fmt.Sprintf("%s/%s", path, filename)),
 		Body:   reader,
@@ -92,15 +97,15 @@ func (s StaticDB) UploadStatic(path, filename string, reader io.ReadSeeker) (int
 		return -1, entity.PSQLWrap(errors.New("ошибка при составлении запроса UploadStatic"), err)
 	}
 	var id int
-	if err = s.DB.QueryRow(query, args...).Scan(&id); err != nil {
+	if err = s.DB.QueryRowContext(ctx, query, args...).Scan(&id); err != nil {
 		return -1, entity.PSQLQueryErr("UploadStatic", err)
 	}
 	return id, nil
 }
 
-func (s StaticDB) GetStaticFile(staticURI string) (io.ReadSeeker, error) {
+func (s StaticDB) GetStaticFile(ctx context.Context, staticURI string) (io.ReadSeeker, error) {
 	// Получаем статику из S3
-	output, err := s.s3.GetObject(&s3.GetObjectInput{
+	output, err := s.s3.GetObjectWithContext(ctx, &s3.GetObjectInput{
 		Bucket: aws.String(s.bucketName),
 		Key:    aws.String(staticURI),
 	})
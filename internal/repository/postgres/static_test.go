@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"context"
 	"database/sql"
 	"github.com/DATA-DOG/go-sqlmock"
 	sq "github.com/Masterminds/squirrel"
@@ -74,7 +75,7 @@ func TestStaticDB_GetStatic(t *testing.T) {
 				t.Fatalf("ошибка при формировании sql-запроса GetStaticURL: %s", err)
 			}
 			tc.setupMock(mock, query)
-			result, err := repo.GetStaticURL(tc.requestId)
+			result, err := repo.GetStaticURL(context.Background(), tc.requestId)
 			require.Equal(t, tc.expectedErr, err)
 			require.Equal(t, tc.expectedOutput, result)
 		})
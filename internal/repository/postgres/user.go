@@ -1,13 +1,16 @@
 package postgres
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	sq "github.com/Masterminds/squirrel"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
 	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
+	"time"
 )
 
 type UsersDB struct {
@@ -43,7 +46,8 @@ func NewUserRepository(db *sqlx.DB) repository.User {
 
 // AddUser добавляет пользователя в базу данных.
 // Если операция происходит успешно, то в переданный по указателю user будет записан id нового пользователя.
-func (u *UsersDB) AddUser(email string, passwordHash, passwordSalt []byte) (*entity.User, error) {
+func (u *UsersDB) AddUser(ctx context.Context, email string, passwordHash, passwordSalt []byte) (*entity.User, error) {
+	defer metrics.ObservePostgresQuery("user", "AddUser", time.Now())
 	query, args, err := sq.Insert("\"user\"").
 		Columns("email", "password_hashed", "salt_password").
 		Values(email, passwordHash, passwordSalt).
@@ -54,7 +58,7 @@ func (u *UsersDB) AddUser(email string, passwordHash, passwordSalt []byte) (*ent
 		return nil, entity.PSQLWrap(errors.New("ошибка при составлении запроса AddUser"), err)
 	}
 	var lastID int
-	err = u.DB.QueryRow(query, args...).Scan(&lastID)
+	err = u.DB.QueryRowContext(ctx, query, args...).Scan(&lastID)
 	var pqErr *pq.Error
 	if errors.As(err, &pqErr) {
 		switch pqErr.Code {
@@ -76,7 +80,7 @@ func (u *UsersDB) AddUser(email string, passwordHash, passwordSalt []byte) (*ent
 	return user, nil
 }
 
-func (u *UsersDB) getUser(where map[string]any) (*entity.User, error) {
+func (u *UsersDB) getUser(ctx context.Context, where map[string]any) (*entity.User, error) {
 	query, args, err := sq.
 		Select("\"id\"", "email", "Name", "password_hashed", "salt_password", "avatar_upload_id", "rating").
 		From("\"user\"").
@@ -88,7 +92,7 @@ func (u *UsersDB) getUser(where map[string]any) (*entity.User, error) {
 	}
 	user := DBUser{}
 	err = u.DB.
-		QueryRow(query, args...).
+		QueryRowContext(ctx, query, args...).
 		Scan(
 			&user.ID,
 			&user.Email,
@@ -108,17 +112,20 @@ func (u *UsersDB) getUser(where map[string]any) (*entity.User, error) {
 }
 
 // GetUserByID возвращает пользователя из базы данных по айди
-func (u *UsersDB) GetUserByID(userID int) (*entity.User, error) {
-	return u.getUser(map[string]any{"id": userID})
+func (u *UsersDB) GetUserByID(ctx context.Context, userID int) (*entity.User, error) {
+	defer metrics.ObservePostgresQuery("user", "GetUserByID", time.Now())
+	return u.getUser(ctx, map[string]any{"id": userID})
 }
 
 // GetUserByEmail возвращает пользователя из базы данных по почте
-func (u *UsersDB) GetUserByEmail(userEmail string) (*entity.User, error) {
-	return u.getUser(map[string]any{"email": userEmail})
+func (u *UsersDB) GetUserByEmail(ctx context.Context, userEmail string) (*entity.User, error) {
+	defer metrics.ObservePostgresQuery("user", "GetUserByEmail", time.Now())
+	return u.getUser(ctx, map[string]any{"email": userEmail})
 }
 
 // UpdateUser обновляет пользователя в базе данных по переданной структуре
-func (u *UsersDB) UpdateUser(user *entity.User) error {
+func (u *UsersDB) UpdateUser(ctx context.Context, user *entity.User) error {
+	defer metrics.ObservePostgresQuery("user", "UpdateUser", time.Now())
 	setMap := make(map[string]any)
 	if user.Email != "" {
 		setMap["email"] = user.Email
@@ -144,7 +151,7 @@ func (u *UsersDB) UpdateUser(user *entity.User) error {
 		return entity.PSQLWrap(errors.New("ошибка при составлении запроса UpdateUser"), err)
 	}
 	// ни одна колонка может быть не изменена, но будем считать, что это успешное обновление
-	if _, err = u.DB.Exec(query, args...); err != nil {
+	if _, err = u.DB.ExecContext(ctx, query, args...); err != nil {
 		return entity.PSQLQueryErr("UpdateUser", err)
 	}
 	return nil
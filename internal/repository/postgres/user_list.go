@@ -0,0 +1,315 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"time"
+)
+
+type UserListDB struct {
+	DB *sqlx.DB
+}
+
+func NewUserListRepository(db *sqlx.DB) repository.UserList {
+	return &UserListDB{
+		DB: db,
+	}
+}
+
+func selectUserListFields() sq.SelectBuilder {
+	return sq.Select(
+		"id",
+		"user_id",
+		"title",
+		"description",
+		"is_public",
+		"(SELECT COUNT(*) FROM user_list_item WHERE user_list_item.list_id = user_list.id) AS items_count",
+		"created_at",
+		"updated_at",
+	)
+}
+
+// CreateList создает список. В случае успеха в list записываются ID, CreatedAt и UpdatedAt
+func (u *UserListDB) CreateList(ctx context.Context, list *entity.UserList) (*entity.UserList, error) {
+	defer metrics.ObservePostgresQuery("user_list", "CreateList", time.Now())
+	query, args, err := sq.Insert("user_list").
+		Columns("user_id", "title", "description", "is_public").
+		Values(list.UserID, list.Title, list.Description, list.IsPublic).
+		Suffix("RETURNING id, created_at, updated_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса CreateList"))
+	}
+	err = u.DB.QueryRowContext(ctx, query, args...).Scan(&list.ID, &list.CreatedAt, &list.UpdatedAt)
+	if err != nil {
+		return nil, entity.PSQLQueryErr("CreateList", err)
+	}
+	return list, nil
+}
+
+// UpdateList изменяет название, описание и видимость списка. В list записываются CreatedAt и UpdatedAt
+func (u *UserListDB) UpdateList(ctx context.Context, list *entity.UserList) (*entity.UserList, error) {
+	defer metrics.ObservePostgresQuery("user_list", "UpdateList", time.Now())
+	query, args, err := sq.Update("user_list").
+		SetMap(map[string]any{
+			"title":       list.Title,
+			"description": list.Description,
+			"is_public":   list.IsPublic,
+		}).
+		Where(sq.Eq{"id": list.ID}).
+		Suffix("RETURNING created_at, updated_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса UpdateList"))
+	}
+	err = u.DB.QueryRowContext(ctx, query, args...).Scan(&list.CreatedAt, &list.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, repository.ErrUserListNotFound
+	}
+	if err != nil {
+		return nil, entity.PSQLQueryErr("UpdateList", err)
+	}
+	return list, nil
+}
+
+// DeleteList удаляет список, элементы удаляются каскадно
+func (u *UserListDB) DeleteList(ctx context.Context, listID int) error {
+	defer metrics.ObservePostgresQuery("user_list", "DeleteList", time.Now())
+	query, args, err := sq.Delete("user_list").
+		Where(sq.Eq{"id": listID}).
+		Suffix("RETURNING id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса DeleteList"))
+	}
+	var deletedID int
+	err = u.DB.QueryRowContext(ctx, query, args...).Scan(&deletedID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return repository.ErrUserListNotFound
+	}
+	if err != nil {
+		return entity.PSQLQueryErr("DeleteList", err)
+	}
+	return nil
+}
+
+// GetList возвращает список
+func (u *UserListDB) GetList(ctx context.Context, listID int) (*entity.UserList, error) {
+	defer metrics.ObservePostgresQuery("user_list", "GetList", time.Now())
+	query, args, err := selectUserListFields().
+		From("user_list").
+		Where(sq.Eq{"id": listID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetList"))
+	}
+	list := new(entity.UserList)
+	err = u.DB.QueryRowxContext(ctx, query, args...).StructScan(list)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrUserListNotFound
+		}
+		return nil, entity.PSQLQueryErr("GetList", err)
+	}
+	return list, nil
+}
+
+// GetListsByUserID возвращает списки пользователя, начиная с последних измененных
+func (u *UserListDB) GetListsByUserID(
+	ctx context.Context,
+	userID int,
+	onlyPublic bool,
+) ([]*entity.UserList, error) {
+	defer metrics.ObservePostgresQuery("user_list", "GetListsByUserID", time.Now())
+	where := sq.Eq{"user_id": userID}
+	if onlyPublic {
+		where["is_public"] = true
+	}
+	query, args, err := selectUserListFields().
+		From("user_list").
+		Where(where).
+		OrderBy("updated_at DESC", "id DESC").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetListsByUserID"))
+	}
+	rows, err := u.DB.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, entity.PSQLQueryErr("GetListsByUserID", err)
+	}
+	defer rows.Close()
+	lists := make([]*entity.UserList, 0)
+	for rows.Next() {
+		list := new(entity.UserList)
+		if err = rows.StructScan(list); err != nil {
+			return nil, entity.PSQLQueryErr("GetListsByUserID при сканировании списков", err)
+		}
+		lists = append(lists, list)
+	}
+	return lists, nil
+}
+
+// GetListItems возвращает элементы списка по порядку
+func (u *UserListDB) GetListItems(ctx context.Context, listID int) ([]*entity.UserListItem, error) {
+	defer metrics.ObservePostgresQuery("user_list", "GetListItems", time.Now())
+	query, args, err := sq.Select("list_id", "content_id", "position", "note", "added_at").
+		From("user_list_item").
+		Where(sq.Eq{"list_id": listID}).
+		OrderBy("position ASC", "added_at ASC").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetListItems"))
+	}
+	rows, err := u.DB.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, entity.PSQLQueryErr("GetListItems", err)
+	}
+	defer rows.Close()
+	items := make([]*entity.UserListItem, 0)
+	for rows.Next() {
+		item := new(entity.UserListItem)
+		if err = rows.StructScan(item); err != nil {
+			return nil, entity.PSQLQueryErr("GetListItems при сканировании элементов", err)
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// AddItem добавляет контент в конец списка. В item записываются Position и AddedAt
+func (u *UserListDB) AddItem(ctx context.Context, item *entity.UserListItem) (*entity.UserListItem, error) {
+	defer metrics.ObservePostgresQuery("user_list", "AddItem", time.Now())
+	query, args, err := sq.Insert("user_list_item").
+		Columns("list_id", "content_id", "position", "note").
+		Values(
+			item.ListID,
+			item.ContentID,
+			sq.Expr("(SELECT COALESCE(MAX(position), 0) + 1 FROM user_list_item WHERE list_id = ?)", item.ListID),
+			item.Note,
+		).
+		Suffix("RETURNING position, added_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса AddItem"))
+	}
+	err = u.DB.QueryRowContext(ctx, query, args...).Scan(&item.Position, &item.AddedAt)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case entity.PSQLUniqueViolation:
+			return nil, repository.ErrUserListItemAlreadyExists
+		case entity.PSQLForeignKeyViolation:
+			return nil, repository.ErrUserListContentNotFound
+		}
+	}
+	if err != nil {
+		return nil, entity.PSQLQueryErr("AddItem", err)
+	}
+	return item, nil
+}
+
+// UpdateItemNote изменяет заметку к элементу списка
+func (u *UserListDB) UpdateItemNote(ctx context.Context, listID, contentID int, note string) error {
+	defer metrics.ObservePostgresQuery("user_list", "UpdateItemNote", time.Now())
+	query, args, err := sq.Update("user_list_item").
+		Set("note", note).
+		Where(sq.Eq{"list_id": listID, "content_id": contentID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса UpdateItemNote"))
+	}
+	result, err := u.DB.ExecContext(ctx, query, args...)
+	if err != nil {
+		return entity.PSQLQueryErr("UpdateItemNote", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return entity.PSQLQueryErr("UpdateItemNote при получении числа измененных строк", err)
+	}
+	if affected == 0 {
+		return repository.ErrUserListItemNotFound
+	}
+	return nil
+}
+
+// RemoveItem в одной транзакции удаляет контент из списка и сдвигает следующие элементы, чтобы в позициях не
+// было пропусков
+func (u *UserListDB) RemoveItem(ctx context.Context, listID, contentID int) error {
+	defer metrics.ObservePostgresQuery("user_list", "RemoveItem", time.Now())
+	tx, err := u.DB.BeginTxx(ctx, nil)
+	if err != nil {
+		return entity.PSQLQueryErr("RemoveItem при открытии транзакции", err)
+	}
+	// после успешного Commit откат ничего не делает
+	defer tx.Rollback() // nolint: errcheck
+	query, args, err := sq.Delete("user_list_item").
+		Where(sq.Eq{"list_id": listID, "content_id": contentID}).
+		Suffix("RETURNING position").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса RemoveItem"))
+	}
+	var position int
+	err = tx.QueryRowContext(ctx, query, args...).Scan(&position)
+	if errors.Is(err, sql.ErrNoRows) {
+		return repository.ErrUserListItemNotFound
+	}
+	if err != nil {
+		return entity.PSQLQueryErr("RemoveItem", err)
+	}
+	query, args, err = sq.Update("user_list_item").
+		Set("position", sq.Expr("position - 1")).
+		Where(sq.And{sq.Eq{"list_id": listID}, sq.Gt{"position": position}}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса RemoveItem"))
+	}
+	if _, err = tx.ExecContext(ctx, query, args...); err != nil {
+		return entity.PSQLQueryErr("RemoveItem", err)
+	}
+	if err = tx.Commit(); err != nil {
+		return entity.PSQLQueryErr("RemoveItem при фиксации транзакции", err)
+	}
+	return nil
+}
+
+// ReorderItems одним запросом расставляет элементы списка в порядке contentIDs, начиная с позиции 1
+func (u *UserListDB) ReorderItems(ctx context.Context, listID int, contentIDs []int) error {
+	defer metrics.ObservePostgresQuery("user_list", "ReorderItems", time.Now())
+	if len(contentIDs) == 0 {
+		return nil
+	}
+	positions := sq.Case("content_id")
+	for i, contentID := range contentIDs {
+		positions = positions.When(sq.Expr("?", contentID), sq.Expr("?", i+1))
+	}
+	query, args, err := sq.Update("user_list_item").
+		Set("position", positions).
+		Where(sq.Eq{"list_id": listID, "content_id": contentIDs}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса ReorderItems"))
+	}
+	if _, err = u.DB.ExecContext(ctx, query, args...); err != nil {
+		return entity.PSQLQueryErr("ReorderItems", err)
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestUserListDB_GetListsByUserID(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Now()
+	testCases := []struct {
+		Name        string
+		OnlyPublic  bool
+		ExpectedOut []*entity.UserList
+		SetupMock   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			Name: "Все списки",
+			ExpectedOut: []*entity.UserList{
+				{ID: 2, UserID: 1, Title: "Приватный", ItemsCount: 0, CreatedAt: fixedTime, UpdatedAt: fixedTime},
+				{ID: 1, UserID: 1, Title: "Публичный", IsPublic: true, ItemsCount: 3, CreatedAt: fixedTime, UpdatedAt: fixedTime},
+			},
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					"SELECT id, user_id, title, description, is_public, " +
+						"(SELECT COUNT(*) FROM user_list_item WHERE user_list_item.list_id = user_list.id) AS items_count, " +
+						"created_at, updated_at FROM user_list WHERE user_id = $1 ORDER BY updated_at DESC, id DESC",
+				)).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{
+						"id", "user_id", "title", "description", "is_public", "items_count", "created_at", "updated_at",
+					}).
+						AddRow(2, 1, "Приватный", "", false, 0, fixedTime, fixedTime).
+						AddRow(1, 1, "Публичный", "", true, 3, fixedTime, fixedTime))
+			},
+		},
+		{
+			Name:        "Только публичные",
+			OnlyPublic:  true,
+			ExpectedOut: []*entity.UserList{},
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("FROM user_list WHERE is_public = $1 AND user_id = $2")).
+					WithArgs(true, 1).
+					WillReturnRows(sqlmock.NewRows([]string{
+						"id", "user_id", "title", "description", "is_public", "items_count", "created_at", "updated_at",
+					}))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewUserListRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			output, err := repo.GetListsByUserID(context.Background(), 1, tc.OnlyPublic)
+			require.NoError(t, err)
+			require.Equal(t, tc.ExpectedOut, output)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUserListDB_AddItem(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Now()
+	testCases := []struct {
+		Name        string
+		ExpectedOut *entity.UserListItem
+		ExpectedErr error
+		SetupMock   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			Name:        "Успешное добавление",
+			ExpectedOut: &entity.UserListItem{ListID: 1, ContentID: 2, Position: 4, Note: "заметка", AddedAt: fixedTime},
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					"INSERT INTO user_list_item (list_id,content_id,position,note) VALUES "+
+						"($1,$2,(SELECT COALESCE(MAX(position), 0) + 1 FROM user_list_item WHERE list_id = $3),$4) "+
+						"RETURNING position, added_at",
+				)).
+					WithArgs(1, 2, 1, "заметка").
+					WillReturnRows(sqlmock.NewRows([]string{"position", "added_at"}).AddRow(4, fixedTime))
+			},
+		},
+		{
+			Name:        "Контент уже в списке",
+			ExpectedErr: repository.ErrUserListItemAlreadyExists,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO user_list_item")).
+					WithArgs(1, 2, 1, "заметка").
+					WillReturnError(&pq.Error{Code: entity.PSQLUniqueViolation})
+			},
+		},
+		{
+			Name:        "Контент не найден",
+			ExpectedErr: repository.ErrUserListContentNotFound,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO user_list_item")).
+					WithArgs(1, 2, 1, "заметка").
+					WillReturnError(&pq.Error{Code: entity.PSQLForeignKeyViolation})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewUserListRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			output, err := repo.AddItem(context.Background(), &entity.UserListItem{
+				ListID:    1,
+				ContentID: 2,
+				Note:      "заметка",
+			})
+			require.Equal(t, tc.ExpectedErr, err)
+			require.Equal(t, tc.ExpectedOut, output)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUserListDB_RemoveItem(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		ExpectedErr error
+		SetupMock   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			Name:        "Успешное удаление",
+			ExpectedErr: nil,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta(
+					"DELETE FROM user_list_item WHERE content_id = $1 AND list_id = $2 RETURNING position",
+				)).
+					WithArgs(2, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"position"}).AddRow(3))
+				mock.ExpectExec(regexp.QuoteMeta(
+					"UPDATE user_list_item SET position = position - 1 WHERE (list_id = $1 AND position > $2)",
+				)).
+					WithArgs(1, 3).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+				mock.ExpectCommit()
+			},
+		},
+		{
+			Name:        "Контента нет в списке",
+			ExpectedErr: repository.ErrUserListItemNotFound,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectBegin()
+				mock.ExpectQuery(regexp.QuoteMeta("DELETE FROM user_list_item")).
+					WithArgs(2, 1).
+					WillReturnError(sql.ErrNoRows)
+				mock.ExpectRollback()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewUserListRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			err = repo.RemoveItem(context.Background(), 1, 2)
+			require.Equal(t, tc.ExpectedErr, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUserListDB_ReorderItems(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	repo := NewUserListRepository(sqlx.NewDb(db, "sqlmock"))
+	mock.ExpectExec(regexp.QuoteMeta(
+		"UPDATE user_list_item SET position = CASE content_id WHEN $1 THEN $2 WHEN $3 THEN $4 END "+
+			"WHERE content_id IN ($5,$6) AND list_id = $7",
+	)).
+		WithArgs(3, 1, 2, 2, 3, 2, 1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	require.NoError(t, repo.ReorderItems(context.Background(), 1, []int{3, 2}))
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,265 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"time"
+)
+
+type UserRatingDB struct {
+	DB *sqlx.DB
+}
+
+func NewUserRatingRepository(db *sqlx.DB) repository.UserRating {
+	return &UserRatingDB{
+		DB: db,
+	}
+}
+
+func selectUserRatingFields() sq.SelectBuilder {
+	return sq.Select(
+		"user_id",
+		"content_id",
+		"rating",
+		"created_at",
+		"updated_at",
+	)
+}
+
+// SetRating ставит оценку или заменяет уже поставленную. Рейтинг контента пересчитывает триггер
+// update_content_rating. В случае успеха в rating записываются CreatedAt и UpdatedAt
+func (r *UserRatingDB) SetRating(ctx context.Context, rating *entity.UserRating) (*entity.UserRating, error) {
+	defer metrics.ObservePostgresQuery("user_rating", "SetRating", time.Now())
+	query, args, err := sq.Insert("user_rating").
+		Columns("user_id", "content_id", "rating").
+		Values(rating.UserID, rating.ContentID, rating.Rating).
+		Suffix("ON CONFLICT (user_id, content_id) DO UPDATE SET rating = EXCLUDED.rating " +
+			"RETURNING created_at, updated_at").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса SetRating"))
+	}
+	err = r.DB.QueryRowContext(ctx, query, args...).Scan(&rating.CreatedAt, &rating.UpdatedAt)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == entity.PSQLForeignKeyViolation {
+		return nil, repository.ErrUserRatingViolation
+	}
+	if err != nil {
+		return nil, entity.PSQLQueryErr("SetRating", err)
+	}
+	return rating, nil
+}
+
+// DeleteRating удаляет оценку пользователя
+func (r *UserRatingDB) DeleteRating(ctx context.Context, userID, contentID int) error {
+	defer metrics.ObservePostgresQuery("user_rating", "DeleteRating", time.Now())
+	query, args, err := sq.Delete("user_rating").
+		Where(sq.Eq{"user_id": userID, "content_id": contentID}).
+		Suffix("RETURNING content_id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса DeleteRating"))
+	}
+	var deletedContentID int
+	err = r.DB.QueryRowContext(ctx, query, args...).Scan(&deletedContentID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return repository.ErrUserRatingNotFound
+	}
+	if err != nil {
+		return entity.PSQLQueryErr("DeleteRating", err)
+	}
+	return nil
+}
+
+// GetRating возвращает оценку пользователя
+func (r *UserRatingDB) GetRating(ctx context.Context, userID, contentID int) (*entity.UserRating, error) {
+	defer metrics.ObservePostgresQuery("user_rating", "GetRating", time.Now())
+	query, args, err := selectUserRatingFields().
+		From("user_rating").
+		Where(sq.Eq{"user_id": userID, "content_id": contentID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetRating"))
+	}
+	rating := new(entity.UserRating)
+	err = r.DB.QueryRowxContext(ctx, query, args...).StructScan(rating)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, repository.ErrUserRatingNotFound
+		}
+		return nil, entity.PSQLQueryErr("GetRating", err)
+	}
+	return rating, nil
+}
+
+// GetRatingsCountByUserID возвращает количество оценок пользователя
+func (r *UserRatingDB) GetRatingsCountByUserID(ctx context.Context, userID int) (int, error) {
+	defer metrics.ObservePostgresQuery("user_rating", "GetRatingsCountByUserID", time.Now())
+	query, args, err := sq.Select("COUNT(*)").
+		From("user_rating").
+		Where(sq.Eq{"user_id": userID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetRatingsCountByUserID"))
+	}
+	var count int
+	err = r.DB.QueryRowContext(ctx, query, args...).Scan(&count)
+	if err != nil {
+		return 0, entity.PSQLQueryErr("GetRatingsCountByUserID", err)
+	}
+	return count, nil
+}
+
+// GetRatingsByUserID возвращает оценки пользователя, начиная с последних измененных
+func (r *UserRatingDB) GetRatingsByUserID(
+	ctx context.Context,
+	userID, page, limit int,
+) ([]*entity.UserRating, error) {
+	defer metrics.ObservePostgresQuery("user_rating", "GetRatingsByUserID", time.Now())
+	query, args, err := selectUserRatingFields().
+		From("user_rating").
+		Where(sq.Eq{"user_id": userID}).
+		OrderBy("updated_at DESC", "content_id ASC").
+		Limit(uint64(limit)).
+		Offset(uint64((page - 1) * limit)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetRatingsByUserID"))
+	}
+	rows, err := r.DB.QueryxContext(ctx, query, args...)
+	if err != nil {
+		return nil, entity.PSQLQueryErr("GetRatingsByUserID", err)
+	}
+	defer rows.Close()
+	ratings := make([]*entity.UserRating, 0)
+	for rows.Next() {
+		rating := new(entity.UserRating)
+		if err = rows.StructScan(rating); err != nil {
+			return nil, entity.PSQLQueryErr("GetRatingsByUserID при сканировании оценок", err)
+		}
+		ratings = append(ratings, rating)
+	}
+	return ratings, nil
+}
+
+// seriesPartRatingTable возвращает таблицу оценок части сериала и столбец с id части
+func seriesPartRatingTable(part entity.SeriesPart) (string, string, error) {
+	switch part {
+	case entity.SeriesPartSeason:
+		return "season_rating", "season_id", nil
+	case entity.SeriesPartEpisode:
+		return "episode_rating", "episode_id", nil
+	default:
+		return "", "", fmt.Errorf("неизвестная часть сериала %q", part)
+	}
+}
+
+// GetSeriesPartContentID возвращает id сериала, к которому относится сезон или эпизод
+func (r *UserRatingDB) GetSeriesPartContentID(ctx context.Context, part entity.SeriesPart, partID int) (int, error) {
+	defer metrics.ObservePostgresQuery("user_rating", "GetSeriesPartContentID", time.Now())
+	builder := sq.Select("series.content_id")
+	switch part {
+	case entity.SeriesPartSeason:
+		builder = builder.From("season").
+			Join("series ON series.id = season.series_id").
+			Where(sq.Eq{"season.id": partID})
+	case entity.SeriesPartEpisode:
+		builder = builder.From("episode").
+			Join("season ON season.id = episode.season_id").
+			Join("series ON series.id = season.series_id").
+			Where(sq.Eq{"episode.id": partID})
+	default:
+		return 0, entity.PSQLWrap(
+			fmt.Errorf("неизвестная часть сериала %q", part),
+			errors.New("ошибка при составлении запроса GetSeriesPartContentID"),
+		)
+	}
+	query, args, err := builder.PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return 0, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetSeriesPartContentID"))
+	}
+	var contentID int
+	err = r.DB.QueryRowContext(ctx, query, args...).Scan(&contentID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, repository.ErrUserRatingSeriesPartNotFound
+	}
+	if err != nil {
+		return 0, entity.PSQLQueryErr("GetSeriesPartContentID", err)
+	}
+	return contentID, nil
+}
+
+// SetSeriesPartRating ставит оценку сезону или эпизоду. Среднюю оценку пересчитывают триггеры
+// update_season_rating и update_episode_rating
+func (r *UserRatingDB) SetSeriesPartRating(
+	ctx context.Context,
+	part entity.SeriesPart,
+	userID, partID, rating int,
+) error {
+	defer metrics.ObservePostgresQuery("user_rating", "SetSeriesPartRating", time.Now())
+	table, column, err := seriesPartRatingTable(part)
+	if err != nil {
+		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса SetSeriesPartRating"))
+	}
+	query, args, err := sq.Insert(table).
+		Columns("user_id", column, "rating").
+		Values(userID, partID, rating).
+		Suffix(fmt.Sprintf("ON CONFLICT (user_id, %s) DO UPDATE SET rating = EXCLUDED.rating", column)).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса SetSeriesPartRating"))
+	}
+	_, err = r.DB.ExecContext(ctx, query, args...)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) && pqErr.Code == entity.PSQLForeignKeyViolation {
+		return repository.ErrUserRatingSeriesPartNotFound
+	}
+	if err != nil {
+		return entity.PSQLQueryErr("SetSeriesPartRating", err)
+	}
+	return nil
+}
+
+// DeleteSeriesPartRating удаляет оценку сезона или эпизода
+func (r *UserRatingDB) DeleteSeriesPartRating(
+	ctx context.Context,
+	part entity.SeriesPart,
+	userID, partID int,
+) error {
+	defer metrics.ObservePostgresQuery("user_rating", "DeleteSeriesPartRating", time.Now())
+	table, column, err := seriesPartRatingTable(part)
+	if err != nil {
+		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса DeleteSeriesPartRating"))
+	}
+	query, args, err := sq.Delete(table).
+		Where(sq.Eq{"user_id": userID, column: partID}).
+		Suffix("RETURNING " + column).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса DeleteSeriesPartRating"))
+	}
+	var deletedPartID int
+	err = r.DB.QueryRowContext(ctx, query, args...).Scan(&deletedPartID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return repository.ErrUserRatingNotFound
+	}
+	if err != nil {
+		return entity.PSQLQueryErr("DeleteSeriesPartRating", err)
+	}
+	return nil
+}
@@ -0,0 +1,293 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserRatingDB_SetRating(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Now()
+
+	testCases := []struct {
+		Name           string
+		ExpectedOutput *entity.UserRating
+		ExpectedErr    error
+		SetupMock      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			Name: "Успешная оценка",
+			ExpectedOutput: &entity.UserRating{
+				UserID:    1,
+				ContentID: 2,
+				Rating:    8,
+				CreatedAt: fixedTime,
+				UpdatedAt: fixedTime,
+			},
+			ExpectedErr: nil,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					"INSERT INTO user_rating (user_id,content_id,rating) VALUES ($1,$2,$3) "+
+						"ON CONFLICT (user_id, content_id) DO UPDATE SET rating = EXCLUDED.rating "+
+						"RETURNING created_at, updated_at",
+				)).
+					WithArgs(1, 2, 8).
+					WillReturnRows(sqlmock.NewRows([]string{"created_at", "updated_at"}).AddRow(fixedTime, fixedTime))
+			},
+		},
+		{
+			Name:           "Контент не существует",
+			ExpectedOutput: nil,
+			ExpectedErr:    repository.ErrUserRatingViolation,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO user_rating")).
+					WithArgs(1, 2, 8).
+					WillReturnError(&pq.Error{Code: entity.PSQLForeignKeyViolation})
+			},
+		},
+		{
+			Name:           "Неизвестная ошибка",
+			ExpectedOutput: nil,
+			ExpectedErr:    entity.PSQLQueryErr("SetRating", fmt.Errorf("ошибка")),
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO user_rating")).
+					WithArgs(1, 2, 8).
+					WillReturnError(fmt.Errorf("ошибка"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewUserRatingRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			output, err := repo.SetRating(context.Background(), &entity.UserRating{UserID: 1, ContentID: 2, Rating: 8})
+			require.Equal(t, tc.ExpectedOutput, output)
+			require.Equal(t, tc.ExpectedErr, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUserRatingDB_DeleteRating(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		ExpectedErr error
+		SetupMock   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			Name:        "Успешное удаление",
+			ExpectedErr: nil,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					"DELETE FROM user_rating WHERE content_id = $1 AND user_id = $2 RETURNING content_id",
+				)).
+					WithArgs(2, 1).
+					WillReturnRows(sqlmock.NewRows([]string{"content_id"}).AddRow(2))
+			},
+		},
+		{
+			Name:        "Оценка не найдена",
+			ExpectedErr: repository.ErrUserRatingNotFound,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("DELETE FROM user_rating")).
+					WithArgs(2, 1).
+					WillReturnError(sql.ErrNoRows)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewUserRatingRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			err = repo.DeleteRating(context.Background(), 1, 2)
+			require.Equal(t, tc.ExpectedErr, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUserRatingDB_GetRatingsByUserID(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Now()
+
+	testCases := []struct {
+		Name           string
+		ExpectedOutput []*entity.UserRating
+		ExpectedErr    error
+		SetupMock      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			Name: "Успешное получение",
+			ExpectedOutput: []*entity.UserRating{
+				{UserID: 1, ContentID: 2, Rating: 8, CreatedAt: fixedTime, UpdatedAt: fixedTime},
+			},
+			ExpectedErr: nil,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					"FROM user_rating WHERE user_id = $1 ORDER BY updated_at DESC, content_id ASC LIMIT 10 OFFSET 10",
+				)).
+					WithArgs(1).
+					WillReturnRows(sqlmock.NewRows([]string{
+						"user_id",
+						"content_id",
+						"rating",
+						"created_at",
+						"updated_at",
+					}).AddRow(1, 2, 8, fixedTime, fixedTime))
+			},
+		},
+		{
+			Name:           "Неизвестная ошибка",
+			ExpectedOutput: nil,
+			ExpectedErr:    entity.PSQLQueryErr("GetRatingsByUserID", fmt.Errorf("ошибка")),
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("FROM user_rating")).
+					WithArgs(1).
+					WillReturnError(fmt.Errorf("ошибка"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewUserRatingRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			output, err := repo.GetRatingsByUserID(context.Background(), 1, 2, 10)
+			require.Equal(t, tc.ExpectedOutput, output)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestUserRatingDB_SetSeriesPartRating(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		Part        entity.SeriesPart
+		ExpectedErr error
+		SetupMock   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			Name:        "Оценка эпизода",
+			Part:        entity.SeriesPartEpisode,
+			ExpectedErr: nil,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(
+					"INSERT INTO episode_rating (user_id,episode_id,rating) VALUES ($1,$2,$3) "+
+						"ON CONFLICT (user_id, episode_id) DO UPDATE SET rating = EXCLUDED.rating",
+				)).
+					WithArgs(1, 5, 9).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		{
+			Name:        "Сезон не найден",
+			Part:        entity.SeriesPartSeason,
+			ExpectedErr: repository.ErrUserRatingSeriesPartNotFound,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO season_rating (user_id,season_id,rating)")).
+					WithArgs(1, 5, 9).
+					WillReturnError(&pq.Error{Code: entity.PSQLForeignKeyViolation})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewUserRatingRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			err = repo.SetSeriesPartRating(context.Background(), tc.Part, 1, 5, 9)
+			require.Equal(t, tc.ExpectedErr, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUserRatingDB_GetSeriesPartContentID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		Part           entity.SeriesPart
+		ExpectedOutput int
+		ExpectedErr    error
+		SetupMock      func(mock sqlmock.Sqlmock)
+	}{
+		{
+			Name:           "Эпизод",
+			Part:           entity.SeriesPartEpisode,
+			ExpectedOutput: 7,
+			ExpectedErr:    nil,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					"SELECT series.content_id FROM episode JOIN season ON season.id = episode.season_id " +
+						"JOIN series ON series.id = season.series_id WHERE episode.id = $1",
+				)).
+					WithArgs(5).
+					WillReturnRows(sqlmock.NewRows([]string{"content_id"}).AddRow(7))
+			},
+		},
+		{
+			Name:           "Сезон не найден",
+			Part:           entity.SeriesPartSeason,
+			ExpectedOutput: 0,
+			ExpectedErr:    repository.ErrUserRatingSeriesPartNotFound,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					"SELECT series.content_id FROM season JOIN series ON series.id = season.series_id " +
+						"WHERE season.id = $1",
+				)).
+					WithArgs(5).
+					WillReturnError(sql.ErrNoRows)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewUserRatingRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			output, err := repo.GetSeriesPartContentID(context.Background(), tc.Part, 5)
+			require.Equal(t, tc.ExpectedOutput, output)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
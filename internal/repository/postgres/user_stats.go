@@ -0,0 +1,333 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"sort"
+	"time"
+)
+
+type UserStatsDB struct {
+	DB *sqlx.DB
+}
+
+func NewUserStatsRepository(db *sqlx.DB) repository.UserStats {
+	return &UserStatsDB{
+		DB: db,
+	}
+}
+
+// watchedBy условие на просмотренный пользователем контент из избранного, к которому присоединена таблица favourite
+func watchedBy(userID int) sq.And {
+	return sq.And{
+		sq.Eq{"favourite.user_id": userID},
+		sq.NotEq{"favourite.category": entity.FavouriteCategoryPlanned},
+	}
+}
+
+// GetWatchedStats возвращает категории избранного, время просмотра и самые частые жанры, страны, режиссеров
+// и актеров среди просмотренного контента
+func (u *UserStatsDB) GetWatchedStats(ctx context.Context, userID, limit int) (*entity.WatchedStats, error) {
+	defer metrics.ObservePostgresQuery("user_stats", "GetWatchedStats", time.Now())
+	stats := new(entity.WatchedStats)
+	var err error
+	if stats.Categories, err = u.getCategories(ctx, userID); err != nil {
+		return nil, err
+	}
+	if stats.Runtime, err = u.getRuntime(ctx, userID); err != nil {
+		return nil, err
+	}
+	if stats.Genres, err = u.getGenres(ctx, userID, limit); err != nil {
+		return nil, err
+	}
+	if stats.Countries, err = u.getCountries(ctx, userID, limit); err != nil {
+		return nil, err
+	}
+	if stats.Directors, err = u.getPeople(ctx, userID, limit, entity.RoleDirector); err != nil {
+		return nil, err
+	}
+	if stats.Actors, err = u.getPeople(ctx, userID, limit, entity.RoleActor); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// selectRows выполняет запрос и вызывает scan для каждой строки результата
+func (u *UserStatsDB) selectRows(
+	ctx context.Context,
+	method string,
+	builder sq.SelectBuilder,
+	scan func(rows *sql.Rows) error,
+) error {
+	query, args, err := builder.PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса "+method))
+	}
+	rows, err := u.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return entity.PSQLQueryErr(method, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if err = scan(rows); err != nil {
+			return entity.PSQLQueryErr(method+" при сканировании", err)
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return entity.PSQLQueryErr(method, err)
+	}
+	return nil
+}
+
+func (u *UserStatsDB) getCategories(ctx context.Context, userID int) (map[string]int, error) {
+	categories := make(map[string]int)
+	err := u.selectRows(ctx, "GetWatchedStats",
+		sq.Select("category", "COUNT(*)").
+			From("favourite").
+			Where(sq.Eq{"user_id": userID}).
+			GroupBy("category"),
+		func(rows *sql.Rows) error {
+			var category string
+			var count int
+			if err := rows.Scan(&category, &count); err != nil {
+				return err
+			}
+			categories[category] = count
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
+
+// getRuntime возвращает время просмотра в минутах. Для сериалов учитываются только отмеченные серии, так как
+// сериал в избранном может быть просмотрен не целиком
+func (u *UserStatsDB) getRuntime(ctx context.Context, userID int) (int, error) {
+	movies := sq.Select("COALESCE(SUM(movie.duration), 0)").
+		From("favourite").
+		Join("movie ON movie.content_id = favourite.content_id").
+		Where(watchedBy(userID))
+	episodes := sq.Select("COALESCE(SUM(episode.duration), 0)").
+		From("watched_episode").
+		Join("episode ON episode.id = watched_episode.episode_id").
+		Where(sq.Eq{"watched_episode.user_id": userID})
+	query, args, err := sq.Select().
+		Column(sq.Alias(movies, "movies")).
+		Column(sq.Alias(episodes, "episodes")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetWatchedStats"))
+	}
+	var moviesRuntime, episodesRuntime int
+	if err = u.DB.QueryRowContext(ctx, query, args...).Scan(&moviesRuntime, &episodesRuntime); err != nil {
+		return 0, entity.PSQLQueryErr("GetWatchedStats", err)
+	}
+	return moviesRuntime + episodesRuntime, nil
+}
+
+func (u *UserStatsDB) getGenres(ctx context.Context, userID, limit int) ([]*entity.GenreCount, error) {
+	genres := make([]*entity.GenreCount, 0)
+	err := u.selectRows(ctx, "GetWatchedStats",
+		sq.Select("genre.id", "genre.name", "COUNT(*)").
+			From("genre_content").
+			Join("genre ON genre.id = genre_content.genre_id").
+			Join("favourite ON favourite.content_id = genre_content.content_id").
+			Where(watchedBy(userID)).
+			GroupBy("genre.id", "genre.name").
+			OrderBy("COUNT(*) DESC", "genre.id ASC").
+			Limit(uint64(limit)),
+		func(rows *sql.Rows) error {
+			genre := new(entity.GenreCount)
+			if err := rows.Scan(&genre.ID, &genre.Name, &genre.Count); err != nil {
+				return err
+			}
+			genres = append(genres, genre)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return genres, nil
+}
+
+func (u *UserStatsDB) getCountries(ctx context.Context, userID, limit int) ([]*entity.CountryCount, error) {
+	countries := make([]*entity.CountryCount, 0)
+	err := u.selectRows(ctx, "GetWatchedStats",
+		sq.Select("country.id", "country.name", "COUNT(*)").
+			From("country_content").
+			Join("country ON country.id = country_content.country_id").
+			Join("favourite ON favourite.content_id = country_content.content_id").
+			Where(watchedBy(userID)).
+			GroupBy("country.id", "country.name").
+			OrderBy("COUNT(*) DESC", "country.id ASC").
+			Limit(uint64(limit)),
+		func(rows *sql.Rows) error {
+			country := new(entity.CountryCount)
+			if err := rows.Scan(&country.ID, &country.Name, &country.Count); err != nil {
+				return err
+			}
+			countries = append(countries, country)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return countries, nil
+}
+
+// getPeople возвращает персон с ролью role (entity.Role*), которые чаще всего встречаются в просмотренном контенте
+func (u *UserStatsDB) getPeople(ctx context.Context, userID, limit int, role string) ([]*entity.PersonCount, error) {
+	people := make([]*entity.PersonCount, 0)
+	err := u.selectRows(ctx, "GetWatchedStats",
+		sq.Select("person.id", "person.name", "person.en_name", "COUNT(*)").
+			From("person_role").
+			Join("role ON role.id = person_role.role_id").
+			Join("person ON person.id = person_role.person_id").
+			Join("favourite ON favourite.content_id = person_role.content_id").
+			Where(watchedBy(userID)).
+			Where(sq.Eq{"role.name_en": role}).
+			GroupBy("person.id", "person.name", "person.en_name").
+			OrderBy("COUNT(*) DESC", "person.id ASC").
+			Limit(uint64(limit)),
+		func(rows *sql.Rows) error {
+			person := new(entity.PersonCount)
+			if err := rows.Scan(&person.ID, &person.Name, &person.EnName, &person.Count); err != nil {
+				return err
+			}
+			people = append(people, person)
+			return nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	return people, nil
+}
+
+// GetRatingStats возвращает число и сумму оценок без рецензии и оценок из рецензий вместе с суммой рейтингов
+// сайта для того же контента
+func (u *UserStatsDB) GetRatingStats(ctx context.Context, userID int) (*entity.RatingStats, error) {
+	defer metrics.ObservePostgresQuery("user_stats", "GetRatingStats", time.Now())
+	ratingsQuery, ratingsArgs, err := sq.Select(
+		"COUNT(*) AS count",
+		"COALESCE(SUM(user_rating.rating), 0) AS sum",
+		"COALESCE(SUM(content.rating), 0) AS site_sum",
+	).
+		From("user_rating").
+		Join("content ON content.id = user_rating.content_id").
+		Where(sq.Eq{"user_rating.user_id": userID}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetRatingStats"))
+	}
+	reviewsQuery, reviewsArgs, err := sq.Select(
+		"COUNT(*) AS count",
+		"COALESCE(SUM(review.content_rating), 0) AS sum",
+		"COALESCE(SUM(content.rating), 0) AS site_sum",
+	).
+		From("review").
+		Join("content ON content.id = review.content_id").
+		Where(sq.Eq{"review.user_id": userID}).
+		Where("NOT review.hidden").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetRatingStats"))
+	}
+	stats := new(entity.RatingStats)
+	if err = u.DB.QueryRowxContext(ctx, ratingsQuery, ratingsArgs...).StructScan(&stats.Ratings); err != nil {
+		return nil, entity.PSQLQueryErr("GetRatingStats", err)
+	}
+	if err = u.DB.QueryRowxContext(ctx, reviewsQuery, reviewsArgs...).StructScan(&stats.Reviews); err != nil {
+		return nil, entity.PSQLQueryErr("GetRatingStats", err)
+	}
+	return stats, nil
+}
+
+// GetMonthlyActivity возвращает число добавлений в избранное, оценок, рецензий и отмеченных серий по месяцам
+func (u *UserStatsDB) GetMonthlyActivity(ctx context.Context, userID, months int) ([]*entity.MonthActivity, error) {
+	defer metrics.ObservePostgresQuery("user_stats", "GetMonthlyActivity", time.Now())
+	now := time.Now()
+	since := time.Date(now.Year(), now.Month()-time.Month(months-1), 1, 0, 0, 0, 0, now.Location())
+	activity := make(map[int64]*entity.MonthActivity)
+	sources := []struct {
+		table  string
+		column string
+		where  sq.Sqlizer
+		field  func(month *entity.MonthActivity) *int
+	}{
+		{
+			table:  "favourite",
+			column: "updated_at",
+			field:  func(month *entity.MonthActivity) *int { return &month.Favourites },
+		},
+		{
+			table:  "user_rating",
+			column: "created_at",
+			field:  func(month *entity.MonthActivity) *int { return &month.Ratings },
+		},
+		{
+			table:  "review",
+			column: "created_at",
+			where:  sq.Expr("NOT hidden"),
+			field:  func(month *entity.MonthActivity) *int { return &month.Reviews },
+		},
+		{
+			table:  "watched_episode",
+			column: "watched_at",
+			field:  func(month *entity.MonthActivity) *int { return &month.Episodes },
+		},
+	}
+	for _, source := range sources {
+		builder := sq.Select("DATE_TRUNC('month', "+source.column+") AS month", "COUNT(*)").
+			From(source.table).
+			Where(sq.Eq{"user_id": userID}).
+			Where(sq.GtOrEq{source.column: since}).
+			GroupBy("month")
+		if source.where != nil {
+			builder = builder.Where(source.where)
+		}
+		field := source.field
+		err := u.selectRows(ctx, "GetMonthlyActivity", builder, func(rows *sql.Rows) error {
+			var month time.Time
+			var count int
+			if err := rows.Scan(&month, &count); err != nil {
+				return err
+			}
+			key := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC).Unix()
+			if _, ok := activity[key]; !ok {
+				activity[key] = &entity.MonthActivity{Month: time.Unix(key, 0).UTC()}
+			}
+			*field(activity[key]) = count
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	result := make([]*entity.MonthActivity, 0, len(activity))
+	for _, month := range activity {
+		result = append(result, month)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Month.Before(result[j].Month)
+	})
+	return result, nil
+}
+
+// InvalidateUserStats ничего не делает: Postgres всегда отдает актуальные данные, сброс нужен только кэшу
+func (u *UserStatsDB) InvalidateUserStats(context.Context, int, ...string) error {
+	return nil
+}
@@ -0,0 +1,162 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestUserStatsDB_GetWatchedStats(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	repo := NewUserStatsRepository(sqlx.NewDb(db, "sqlmock"))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT category, COUNT(*) FROM favourite WHERE user_id = $1 GROUP BY category",
+	)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"category", "count"}).AddRow("watched", 3).AddRow("planned", 1))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT (SELECT COALESCE(SUM(movie.duration), 0) FROM favourite "+
+			"JOIN movie ON movie.content_id = favourite.content_id "+
+			"WHERE (favourite.user_id = $1 AND favourite.category <> $2)) AS movies, "+
+			"(SELECT COALESCE(SUM(episode.duration), 0) FROM watched_episode "+
+			"JOIN episode ON episode.id = watched_episode.episode_id WHERE watched_episode.user_id = $3) AS episodes",
+	)).
+		WithArgs(1, "planned", 1).
+		WillReturnRows(sqlmock.NewRows([]string{"movies", "episodes"}).AddRow(300, 90))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT genre.id, genre.name, COUNT(*) FROM genre_content "+
+			"JOIN genre ON genre.id = genre_content.genre_id "+
+			"JOIN favourite ON favourite.content_id = genre_content.content_id "+
+			"WHERE (favourite.user_id = $1 AND favourite.category <> $2) "+
+			"GROUP BY genre.id, genre.name ORDER BY COUNT(*) DESC, genre.id ASC LIMIT 5",
+	)).
+		WithArgs(1, "planned").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "count"}).AddRow(3, "Драма", 2))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT country.id, country.name, COUNT(*) FROM country_content "+
+			"JOIN country ON country.id = country_content.country_id "+
+			"JOIN favourite ON favourite.content_id = country_content.content_id "+
+			"WHERE (favourite.user_id = $1 AND favourite.category <> $2) "+
+			"GROUP BY country.id, country.name ORDER BY COUNT(*) DESC, country.id ASC LIMIT 5",
+	)).
+		WithArgs(1, "planned").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "count"}).AddRow(1, "США", 3))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT person.id, person.name, person.en_name, COUNT(*) FROM person_role "+
+			"JOIN role ON role.id = person_role.role_id "+
+			"JOIN person ON person.id = person_role.person_id "+
+			"JOIN favourite ON favourite.content_id = person_role.content_id "+
+			"WHERE (favourite.user_id = $1 AND favourite.category <> $2) AND role.name_en = $3 "+
+			"GROUP BY person.id, person.name, person.en_name ORDER BY COUNT(*) DESC, person.id ASC LIMIT 5",
+	)).
+		WithArgs(1, "planned", "director").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "en_name", "count"}).
+			AddRow(7, "Майкл Манн", "Michael Mann", 2))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM person_role")).
+		WithArgs(1, "planned", "actor").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "name", "en_name", "count"}))
+	stats, err := repo.GetWatchedStats(context.Background(), 1, 5)
+	require.NoError(t, err)
+	require.Equal(t, &entity.WatchedStats{
+		Categories: map[string]int{"watched": 3, "planned": 1},
+		Runtime:    390,
+		Genres:     []*entity.GenreCount{{Genre: entity.Genre{ID: 3, Name: "Драма"}, Count: 2}},
+		Countries:  []*entity.CountryCount{{Country: entity.Country{ID: 1, Name: "США"}, Count: 3}},
+		Directors:  []*entity.PersonCount{{ID: 7, Name: "Майкл Манн", EnName: "Michael Mann", Count: 2}},
+		Actors:     []*entity.PersonCount{},
+	}, stats)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserStatsDB_GetWatchedStats_Error(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	repo := NewUserStatsRepository(sqlx.NewDb(db, "sqlmock"))
+	mock.ExpectQuery(regexp.QuoteMeta("FROM favourite")).
+		WithArgs(1).
+		WillReturnError(errors.New("database error"))
+	_, err = repo.GetWatchedStats(context.Background(), 1, 5)
+	require.Error(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserStatsDB_GetRatingStats(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	repo := NewUserStatsRepository(sqlx.NewDb(db, "sqlmock"))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT COUNT(*) AS count, COALESCE(SUM(user_rating.rating), 0) AS sum, " +
+			"COALESCE(SUM(content.rating), 0) AS site_sum FROM user_rating " +
+			"JOIN content ON content.id = user_rating.content_id WHERE user_rating.user_id = $1",
+	)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count", "sum", "site_sum"}).AddRow(2, 16, 14.5))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT COUNT(*) AS count, COALESCE(SUM(review.content_rating), 0) AS sum, " +
+			"COALESCE(SUM(content.rating), 0) AS site_sum FROM review " +
+			"JOIN content ON content.id = review.content_id WHERE review.user_id = $1 AND NOT review.hidden",
+	)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"count", "sum", "site_sum"}).AddRow(1, 6, 7.5))
+	stats, err := repo.GetRatingStats(context.Background(), 1)
+	require.NoError(t, err)
+	require.Equal(t, &entity.RatingStats{
+		Ratings: entity.RatingSum{Count: 2, Sum: 16, SiteSum: 14.5},
+		Reviews: entity.RatingSum{Count: 1, Sum: 6, SiteSum: 7.5},
+	}, stats)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUserStatsDB_GetMonthlyActivity(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	repo := NewUserStatsRepository(sqlx.NewDb(db, "sqlmock"))
+	may := time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC)
+	june := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT DATE_TRUNC('month', updated_at) AS month, COUNT(*) FROM favourite "+
+			"WHERE user_id = $1 AND updated_at >= $2 GROUP BY month",
+	)).
+		WithArgs(1, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"month", "count"}).AddRow(june, 4).AddRow(may, 2))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT DATE_TRUNC('month', created_at) AS month, COUNT(*) FROM user_rating "+
+			"WHERE user_id = $1 AND created_at >= $2 GROUP BY month",
+	)).
+		WithArgs(1, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"month", "count"}).AddRow(june, 3))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT DATE_TRUNC('month', created_at) AS month, COUNT(*) FROM review "+
+			"WHERE user_id = $1 AND created_at >= $2 AND NOT hidden GROUP BY month",
+	)).
+		WithArgs(1, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"month", "count"}))
+	mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT DATE_TRUNC('month', watched_at) AS month, COUNT(*) FROM watched_episode "+
+			"WHERE user_id = $1 AND watched_at >= $2 GROUP BY month",
+	)).
+		WithArgs(1, sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"month", "count"}).AddRow(may, 8))
+	activity, err := repo.GetMonthlyActivity(context.Background(), 1, 12)
+	require.NoError(t, err)
+	require.Equal(t, []*entity.MonthActivity{
+		{Month: may, Favourites: 2, Episodes: 8},
+		{Month: june, Favourites: 4, Ratings: 3},
+	}, activity)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"context"
 	"database/sql"
 	"database/sql/driver"
 	"errors"
@@ -135,7 +136,7 @@ func TestUsersDB_AddUser(t *testing.T) {
 				Suffix("RETURNING id").
 				ToSql()
 			tc.setupMock(mock, query)
-			user, err := repo.AddUser(tc.user.Email, tc.user.PasswordHash, tc.user.PasswordSalt)
+			user, err := repo.AddUser(context.Background(), tc.user.Email, tc.user.PasswordHash, tc.user.PasswordSalt)
 			require.Equal(t, tc.expectedErr, err)
 			require.EqualValues(t, tc.expectedOut, user)
 		})
@@ -220,7 +221,7 @@ func TestUsersDB_GetUserByID(t *testing.T) {
 				driverValues[i] = v
 			}
 			tc.SetupMock(mock, query, driverValues)
-			user, err := repo.GetUserByID(tc.Request)
+			user, err := repo.GetUserByID(context.Background(), tc.Request)
 			require.Equal(t, tc.ExpectedErr, err)
 			require.EqualValues(t, tc.ExpectedOut, user)
 		})
@@ -305,7 +306,7 @@ func TestUsersDB_GetUserByEmail(t *testing.T) {
 				driverValues[i] = v
 			}
 			tc.SetupMock(mock, query, driverValues)
-			user, err := repo.GetUserByEmail(tc.Request)
+			user, err := repo.GetUserByEmail(context.Background(), tc.Request)
 			require.Equal(t, tc.ExpectedErr, err)
 			require.EqualValues(t, tc.ExpectedOut, user)
 		})
@@ -385,7 +386,7 @@ func TestUsersDB_UpdateUser(t *testing.T) {
 				driverValues[i] = v
 			}
 			tc.SetupMock(mock, query, driverValues)
-			err = repo.UpdateUser(tc.User)
+			err = repo.UpdateUser(context.Background(), tc.User)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
 	}
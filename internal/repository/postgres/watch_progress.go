@@ -0,0 +1,116 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+type WatchProgressDB struct {
+	DB *sqlx.DB
+}
+
+func NewWatchProgressRepository(db *sqlx.DB) repository.WatchProgress {
+	return &WatchProgressDB{
+		DB: db,
+	}
+}
+
+func (w *WatchProgressDB) GetWatchProgress(ctx context.Context, userID, contentID int) (*entity.WatchProgress, error) {
+	defer metrics.ObservePostgresQuery("watch_progress", "GetWatchProgress", time.Now())
+	query, args, err := sq.Select(
+		"episode.id",
+		"episode.episode_number",
+		"episode.title",
+		"episode.duration",
+		"episode.rating",
+		"episode.rating_count",
+		"season.id",
+		"season.title",
+		"watched_episode.episode_id IS NOT NULL",
+	).
+		From("episode").
+		Join("season ON season.id = episode.season_id").
+		Join("series ON series.id = season.series_id").
+		LeftJoin("watched_episode ON watched_episode.episode_id = episode.id AND watched_episode.user_id = ?", userID).
+		Where(sq.Eq{"series.content_id": contentID}).
+		OrderBy("season.id ASC", "episode.episode_number ASC", "episode.id ASC").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, entity.PSQLWrap(err, errors.New("ошибка при составлении запроса GetWatchProgress"))
+	}
+	rows, err := w.DB.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, entity.PSQLQueryErr("GetWatchProgress", err)
+	}
+	defer rows.Close()
+	progress := &entity.WatchProgress{ContentID: contentID, Episodes: make([]entity.EpisodeProgress, 0)}
+	for rows.Next() {
+		var episode entity.EpisodeProgress
+		var duration sql.NullInt64
+		err = rows.Scan(
+			&episode.ID,
+			&episode.EpisodeNumber,
+			&episode.Title,
+			&duration,
+			&episode.Rating,
+			&episode.RatingCount,
+			&episode.SeasonID,
+			&episode.SeasonTitle,
+			&episode.Watched,
+		)
+		if err != nil {
+			return nil, entity.PSQLQueryErr("GetWatchProgress при сканировании", err)
+		}
+		episode.Duration = int(duration.Int64)
+		progress.Episodes = append(progress.Episodes, episode)
+	}
+	return progress, nil
+}
+
+func (w *WatchProgressDB) MarkEpisodesWatched(ctx context.Context, userID int, episodeIDs []int) error {
+	defer metrics.ObservePostgresQuery("watch_progress", "MarkEpisodesWatched", time.Now())
+	if len(episodeIDs) == 0 {
+		return nil
+	}
+	builder := sq.Insert("watched_episode").Columns("user_id", "episode_id")
+	for _, episodeID := range episodeIDs {
+		builder = builder.Values(userID, episodeID)
+	}
+	query, args, err := builder.
+		Suffix("ON CONFLICT (user_id, episode_id) DO NOTHING").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса MarkEpisodesWatched"))
+	}
+	if _, err = w.DB.ExecContext(ctx, query, args...); err != nil {
+		return entity.PSQLQueryErr("MarkEpisodesWatched", err)
+	}
+	return nil
+}
+
+func (w *WatchProgressDB) UnmarkEpisodesWatched(ctx context.Context, userID int, episodeIDs []int) error {
+	defer metrics.ObservePostgresQuery("watch_progress", "UnmarkEpisodesWatched", time.Now())
+	if len(episodeIDs) == 0 {
+		return nil
+	}
+	query, args, err := sq.Delete("watched_episode").
+		Where(sq.Eq{"user_id": userID, "episode_id": episodeIDs}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return entity.PSQLWrap(err, errors.New("ошибка при составлении запроса UnmarkEpisodesWatched"))
+	}
+	if _, err = w.DB.ExecContext(ctx, query, args...); err != nil {
+		return entity.PSQLQueryErr("UnmarkEpisodesWatched", err)
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"regexp"
+	"testing"
+)
+
+func TestWatchProgressDB_GetWatchProgress(t *testing.T) {
+	t.Parallel()
+
+	progressColumns := []string{
+		"id", "episode_number", "title", "duration", "rating", "rating_count", "season_id", "season_title", "watched",
+	}
+	testCases := []struct {
+		Name        string
+		ExpectedOut *entity.WatchProgress
+		ExpectedErr error
+		SetupMock   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			Name: "Успешное получение",
+			ExpectedOut: &entity.WatchProgress{
+				ContentID: 2,
+				Episodes: []entity.EpisodeProgress{
+					{
+						SeasonEpisode: entity.SeasonEpisode{
+							Episode:     entity.Episode{ID: 1, EpisodeNumber: 1, Title: "Пилот", Duration: 45},
+							SeasonID:    1,
+							SeasonTitle: "Сезон 1",
+						},
+						Watched: true,
+					},
+					{
+						SeasonEpisode: entity.SeasonEpisode{
+							Episode:     entity.Episode{ID: 2, EpisodeNumber: 2, Title: "Второй"},
+							SeasonID:    1,
+							SeasonTitle: "Сезон 1",
+						},
+						Watched: false,
+					},
+				},
+			},
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta(
+					"FROM episode JOIN season ON season.id = episode.season_id "+
+						"JOIN series ON series.id = season.series_id "+
+						"LEFT JOIN watched_episode ON watched_episode.episode_id = episode.id "+
+						"AND watched_episode.user_id = $1 WHERE series.content_id = $2 "+
+						"ORDER BY season.id ASC, episode.episode_number ASC, episode.id ASC",
+				)).
+					WithArgs(1, 2).
+					WillReturnRows(sqlmock.NewRows(progressColumns).
+						AddRow(1, 1, "Пилот", 45, 0, 0, 1, "Сезон 1", true).
+						AddRow(2, 2, "Второй", nil, 0, 0, 1, "Сезон 1", false))
+			},
+		},
+		{
+			Name:        "Ошибка при выполнении запроса",
+			ExpectedErr: entity.PSQLQueryErr("GetWatchProgress", sql.ErrConnDone),
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(regexp.QuoteMeta("FROM episode")).
+					WithArgs(1, 2).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewWatchProgressRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			output, err := repo.GetWatchProgress(context.Background(), 1, 2)
+			require.Equal(t, tc.ExpectedErr, err)
+			require.Equal(t, tc.ExpectedOut, output)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestWatchProgressDB_MarkEpisodesWatched(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		EpisodeIDs  []int
+		ExpectedErr error
+		SetupMock   func(mock sqlmock.Sqlmock)
+	}{
+		{
+			Name:        "Несколько эпизодов",
+			EpisodeIDs:  []int{3, 4},
+			ExpectedErr: nil,
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta(
+					"INSERT INTO watched_episode (user_id,episode_id) VALUES ($1,$2),($3,$4) "+
+						"ON CONFLICT (user_id, episode_id) DO NOTHING",
+				)).
+					WithArgs(1, 3, 1, 4).
+					WillReturnResult(sqlmock.NewResult(0, 2))
+			},
+		},
+		{
+			Name:        "Пустой список",
+			EpisodeIDs:  []int{},
+			ExpectedErr: nil,
+			SetupMock:   func(mock sqlmock.Sqlmock) {},
+		},
+		{
+			Name:        "Ошибка при выполнении запроса",
+			EpisodeIDs:  []int{3},
+			ExpectedErr: entity.PSQLQueryErr("MarkEpisodesWatched", sql.ErrConnDone),
+			SetupMock: func(mock sqlmock.Sqlmock) {
+				mock.ExpectExec(regexp.QuoteMeta("INSERT INTO watched_episode")).
+					WithArgs(1, 3).
+					WillReturnError(sql.ErrConnDone)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			repo := NewWatchProgressRepository(sqlx.NewDb(db, "sqlmock"))
+			tc.SetupMock(mock)
+			err = repo.MarkEpisodesWatched(context.Background(), 1, tc.EpisodeIDs)
+			require.Equal(t, tc.ExpectedErr, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestWatchProgressDB_UnmarkEpisodesWatched(t *testing.T) {
+	t.Parallel()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	repo := NewWatchProgressRepository(sqlx.NewDb(db, "sqlmock"))
+	mock.ExpectExec(regexp.QuoteMeta(
+		"DELETE FROM watched_episode WHERE episode_id IN ($1,$2) AND user_id = $3",
+	)).
+		WithArgs(3, 4, 1).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	err = repo.UnmarkEpisodesWatched(context.Background(), 1, []int{3, 4})
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
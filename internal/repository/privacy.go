@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_privacy.go
+type Privacy interface {
+	// GetPrivacySettings возвращает настройки приватности пользователя. Если пользователь их не менял,
+	// возвращает entity.DefaultPrivacySettings
+	GetPrivacySettings(ctx context.Context, userID int) (*entity.PrivacySettings, error)
+	// UpdatePrivacySettings сохраняет настройки приватности пользователя
+	// Возможные ошибки:
+	// ErrPrivacyUserNotFound - пользователь не найден
+	UpdatePrivacySettings(ctx context.Context, settings *entity.PrivacySettings) error
+}
+
+var (
+	ErrPrivacyUserNotFound = errors.New("пользователь не найден")
+)
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_profile.go
+type Profile interface {
+	// GetProfileStats возвращает счетчики профиля. Если onlyPublicLists = true, учитываются только публичные списки
+	GetProfileStats(ctx context.Context, userID int, onlyPublicLists bool) (*entity.ProfileStats, error)
+	// GetTopGenres возвращает жанры, которые чаще всего встречаются среди оцененного пользователем контента.
+	// fromReviews и fromRatings определяют, учитываются ли рецензии и оценки без рецензии
+	GetTopGenres(ctx context.Context, userID, limit int, fromReviews, fromRatings bool) ([]*entity.GenreCount, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_recommendation.go
+type Recommendation interface {
+	// GetRecommendations возвращает до limit рекомендаций для пользователя по соседям контента, который ему
+	// понравился или был им просмотрен, начиная с наиболее подходящих. Контент из избранного пользователя,
+	// оцененный или с его рецензией не рекомендуется
+	GetRecommendations(ctx context.Context, userID, limit int) ([]*entity.Recommendation, error)
+	// RefreshNeighbors пересчитывает до neighbors ближайших соседей для каждого контента. Старые соседи
+	// заменяются в одной транзакции, поэтому во время пересчета рекомендации продолжают работать
+	RefreshNeighbors(ctx context.Context, neighbors int) error
+}
@@ -0,0 +1,188 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/logger"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/redis/go-redis/v9"
+	"strconv"
+	"time"
+)
+
+const (
+	contentCachePlaceholder        = "content:"
+	previewContentCachePlaceholder = "content_preview:"
+)
+
+// contentCacheDB кэширует контент и его превью поверх другого репозитория. Методы, которые не кэшируются,
+// вызываются у обернутого репозитория напрямую
+type contentCacheDB struct {
+	repository.Content
+	rdb        *redis.Client
+	contentTTL time.Duration
+	previewTTL time.Duration
+}
+
+// NewContentCacheRepository оборачивает репозиторий контента read-through кэшем в Redis. TTL указываются в секундах
+func NewContentCacheRepository(
+	rdb *redis.Client,
+	content repository.Content,
+	contentTTL, previewTTL int,
+) repository.Content {
+	return &contentCacheDB{
+		Content:    content,
+		rdb:        rdb,
+		contentTTL: time.Duration(contentTTL) * time.Second,
+		previewTTL: time.Duration(previewTTL) * time.Second,
+	}
+}
+
+// GetContent возвращает контент из кэша, а при промахе - из обернутого репозитория, сохраняя результат в кэш
+func (c *contentCacheDB) GetContent(ctx context.Context, id int) (*entity.Content, error) {
+	return c.readThrough(ctx, "content", contentCachePlaceholder+strconv.Itoa(id), c.contentTTL,
+		func() (*entity.Content, error) {
+			return c.Content.GetContent(ctx, id)
+		},
+	)
+}
+
+// GetPreviewContent возвращает превью контента из кэша, а при промахе - из обернутого репозитория
+func (c *contentCacheDB) GetPreviewContent(ctx context.Context, id int) (*entity.Content, error) {
+	return c.readThrough(ctx, "content_preview", previewContentCachePlaceholder+strconv.Itoa(id), c.previewTTL,
+		func() (*entity.Content, error) {
+			return c.Content.GetPreviewContent(ctx, id)
+		},
+	)
+}
+
+// SetReleasedState меняет состояние релиза и сбрасывает кэш контента
+func (c *contentCacheDB) SetReleasedState(ctx context.Context, contentID int, isReleased bool) error {
+	if err := c.Content.SetReleasedState(ctx, contentID, isReleased); err != nil {
+		return err
+	}
+	return c.InvalidateContent(ctx, contentID)
+}
+
+func (c *contentCacheDB) InvalidateContent(ctx context.Context, contentID int) error {
+	err := c.rdb.Del(ctx,
+		contentCachePlaceholder+strconv.Itoa(contentID),
+		previewContentCachePlaceholder+strconv.Itoa(contentID),
+	).Err()
+	if err != nil {
+		return entity.RedisWrap(errors.New("не удалось сбросить кэш контента"), err)
+	}
+	return nil
+}
+
+// GetPreviewContents достает превью из кэша одним запросом и догружает промахи из обернутого репозитория
+func (c *contentCacheDB) GetPreviewContents(ctx context.Context, ids []int) ([]*entity.Content, error) {
+	if len(ids) == 0 {
+		return []*entity.Content{}, nil
+	}
+	keys := make([]string, len(ids))
+	for index, id := range ids {
+		keys[index] = previewContentCachePlaceholder + strconv.Itoa(id)
+	}
+	values, err := c.rdb.MGet(ctx, keys...).Result()
+	if err != nil {
+		logger.ForPackage("cache").WarnContext(ctx, "кэш недоступен", "key", previewContentCachePlaceholder, "error", err)
+		values = make([]any, len(ids))
+	}
+
+	result := make([]*entity.Content, len(ids))
+	missed := make([]int, 0, len(ids))
+	for index, value := range values {
+		data, ok := value.(string)
+		if !ok {
+			metrics.ObserveCache("content_preview", cacheResult(err))
+			missed = append(missed, ids[index])
+			continue
+		}
+		if result[index] = c.decode(ctx, "content_preview", keys[index], []byte(data)); result[index] == nil {
+			missed = append(missed, ids[index])
+		}
+	}
+	if len(missed) == 0 {
+		return result, nil
+	}
+
+	loaded, err := c.Content.GetPreviewContents(ctx, missed)
+	if err != nil {
+		return nil, err
+	}
+	loadedByID := make(map[int]*entity.Content, len(loaded))
+	for _, content := range loaded {
+		loadedByID[content.ID] = content
+		c.store(ctx, previewContentCachePlaceholder+strconv.Itoa(content.ID), content, c.previewTTL)
+	}
+	for index, id := range ids {
+		if result[index] == nil {
+			result[index] = loadedByID[id]
+		}
+	}
+	return result, nil
+}
+
+// readThrough достает значение из кэша или загружает его через load. Недоступность Redis не ломает чтение:
+// в этом случае данные просто берутся из обернутого репозитория
+func (c *contentCacheDB) readThrough(
+	ctx context.Context,
+	cache, key string,
+	ttl time.Duration,
+	load func() (*entity.Content, error),
+) (*entity.Content, error) {
+	data, err := c.rdb.Get(ctx, key).Bytes()
+	if err == nil {
+		if content := c.decode(ctx, cache, key, data); content != nil {
+			return content, nil
+		}
+	} else {
+		metrics.ObserveCache(cache, cacheResult(err))
+		if !errors.Is(err, redis.Nil) {
+			logger.ForPackage("cache").WarnContext(ctx, "кэш недоступен", "key", key, "error", err)
+		}
+	}
+
+	content, err := load()
+	if err != nil {
+		return nil, err
+	}
+	c.store(ctx, key, content, ttl)
+	return content, nil
+}
+
+// decode разбирает значение из кэша. Возвращает nil, если значение повреждено
+func (c *contentCacheDB) decode(ctx context.Context, cache, key string, data []byte) *entity.Content {
+	content := new(entity.Content)
+	if err := json.Unmarshal(data, content); err != nil {
+		metrics.ObserveCache(cache, metrics.CacheError)
+		logger.ForPackage("cache").WarnContext(ctx, "не удалось разобрать значение из кэша", "key", key, "error", err)
+		return nil
+	}
+	metrics.ObserveCache(cache, metrics.CacheHit)
+	return content
+}
+
+// store сохраняет значение в кэш. Ошибки только логируются, так как данные уже получены
+func (c *contentCacheDB) store(ctx context.Context, key string, content *entity.Content, ttl time.Duration) {
+	data, err := json.Marshal(content)
+	if err != nil {
+		logger.ForPackage("cache").WarnContext(ctx, "не удалось сериализовать значение для кэша", "key", key, "error", err)
+		return
+	}
+	if err = c.rdb.Set(ctx, key, data, ttl).Err(); err != nil {
+		logger.ForPackage("cache").WarnContext(ctx, "не удалось записать значение в кэш", "key", key, "error", err)
+	}
+}
+
+// cacheResult возвращает результат обращения к кэшу для метрик по ошибке Redis
+func cacheResult(err error) string {
+	if err == nil || errors.Is(err, redis.Nil) {
+		return metrics.CacheMiss
+	}
+	return metrics.CacheError
+}
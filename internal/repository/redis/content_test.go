@@ -0,0 +1,285 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	mockrepo "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository/mocks"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+)
+
+const (
+	testContentTTL = 60
+	testPreviewTTL = 30
+)
+
+func newTestContentCache(t *testing.T) (*miniredis.Miniredis, *mockrepo.MockContent, *contentCacheDB) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	ctrl := gomock.NewController(t)
+	repo := mockrepo.NewMockContent(ctrl)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	cache, ok := NewContentCacheRepository(rdb, repo, testContentTTL, testPreviewTTL).(*contentCacheDB)
+	require.True(t, ok)
+	return mr, repo, cache
+}
+
+func setCachedContent(t *testing.T, mr *miniredis.Miniredis, key string, content *entity.Content) {
+	t.Helper()
+	data, err := json.Marshal(content)
+	require.NoError(t, err)
+	require.NoError(t, mr.Set(key, string(data)))
+}
+
+func getCachedContent(t *testing.T, mr *miniredis.Miniredis, key string) *entity.Content {
+	t.Helper()
+	data, err := mr.Get(key)
+	require.NoError(t, err)
+	content := new(entity.Content)
+	require.NoError(t, json.Unmarshal([]byte(data), content))
+	return content
+}
+
+func TestContentCacheDB_GetContent(t *testing.T) {
+	t.Parallel()
+
+	content := &entity.Content{ID: 1, Title: "Бэтмен", Rating: 8.5}
+	testCases := []struct {
+		Name        string
+		Setup       func(t *testing.T, mr *miniredis.Miniredis, repo *mockrepo.MockContent)
+		Check       func(t *testing.T, mr *miniredis.Miniredis)
+		Expected    *entity.Content
+		ExpectedErr error
+	}{
+		{
+			Name: "Попадание в кэш",
+			Setup: func(t *testing.T, mr *miniredis.Miniredis, repo *mockrepo.MockContent) {
+				setCachedContent(t, mr, "content:1", content)
+			},
+			Expected: content,
+		},
+		{
+			Name: "Промах сохраняет контент в кэш",
+			Setup: func(t *testing.T, mr *miniredis.Miniredis, repo *mockrepo.MockContent) {
+				repo.EXPECT().GetContent(gomock.Any(), 1).Return(content, nil)
+			},
+			Check: func(t *testing.T, mr *miniredis.Miniredis) {
+				require.Equal(t, content, getCachedContent(t, mr, "content:1"))
+				require.Equal(t, testContentTTL*time.Second, mr.TTL("content:1"))
+			},
+			Expected: content,
+		},
+		{
+			Name: "Поврежденное значение перезаписывается",
+			Setup: func(t *testing.T, mr *miniredis.Miniredis, repo *mockrepo.MockContent) {
+				require.NoError(t, mr.Set("content:1", "{не json"))
+				repo.EXPECT().GetContent(gomock.Any(), 1).Return(content, nil)
+			},
+			Check: func(t *testing.T, mr *miniredis.Miniredis) {
+				require.Equal(t, content, getCachedContent(t, mr, "content:1"))
+			},
+			Expected: content,
+		},
+		{
+			Name: "Redis недоступен",
+			Setup: func(t *testing.T, mr *miniredis.Miniredis, repo *mockrepo.MockContent) {
+				mr.SetError("connection refused")
+				repo.EXPECT().GetContent(gomock.Any(), 1).Return(content, nil)
+			},
+			Expected: content,
+		},
+		{
+			Name: "Ошибка обернутого репозитория не кэшируется",
+			Setup: func(t *testing.T, mr *miniredis.Miniredis, repo *mockrepo.MockContent) {
+				repo.EXPECT().GetContent(gomock.Any(), 1).Return(nil, errors.New("database error"))
+			},
+			Check: func(t *testing.T, mr *miniredis.Miniredis) {
+				require.False(t, mr.Exists("content:1"))
+			},
+			ExpectedErr: errors.New("database error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			mr, repo, cache := newTestContentCache(t)
+			tc.Setup(t, mr, repo)
+			output, err := cache.GetContent(context.Background(), 1)
+			require.Equal(t, tc.ExpectedErr, err)
+			require.Equal(t, tc.Expected, output)
+			mr.SetError("")
+			if tc.Check != nil {
+				tc.Check(t, mr)
+			}
+		})
+	}
+}
+
+func TestContentCacheDB_GetPreviewContent(t *testing.T) {
+	t.Parallel()
+
+	mr, repo, cache := newTestContentCache(t)
+	preview := &entity.Content{ID: 2, Title: "Зеленая миля"}
+	repo.EXPECT().GetPreviewContent(gomock.Any(), 2).Return(preview, nil).Times(1)
+	for i := 0; i < 2; i++ {
+		output, err := cache.GetPreviewContent(context.Background(), 2)
+		require.NoError(t, err)
+		require.Equal(t, preview, output)
+	}
+	require.Equal(t, testPreviewTTL*time.Second, mr.TTL("content_preview:2"))
+}
+
+func TestContentCacheDB_GetPreviewContents(t *testing.T) {
+	t.Parallel()
+
+	previews := []*entity.Content{
+		{ID: 1, Title: "Бэтмен"},
+		{ID: 2, Title: "Зеленая миля"},
+		{ID: 3, Title: "Побег из Шоушенка"},
+	}
+	testCases := []struct {
+		Name     string
+		IDs      []int
+		Setup    func(t *testing.T, mr *miniredis.Miniredis, repo *mockrepo.MockContent)
+		Check    func(t *testing.T, mr *miniredis.Miniredis)
+		Expected []*entity.Content
+	}{
+		{
+			Name:     "Пустой список",
+			IDs:      []int{},
+			Setup:    func(t *testing.T, mr *miniredis.Miniredis, repo *mockrepo.MockContent) {},
+			Expected: []*entity.Content{},
+		},
+		{
+			Name: "Все превью в кэше",
+			IDs:  []int{3, 1},
+			Setup: func(t *testing.T, mr *miniredis.Miniredis, repo *mockrepo.MockContent) {
+				setCachedContent(t, mr, "content_preview:1", previews[0])
+				setCachedContent(t, mr, "content_preview:3", previews[2])
+			},
+			Expected: []*entity.Content{previews[2], previews[0]},
+		},
+		{
+			Name: "Частичный промах догружает только недостающие",
+			IDs:  []int{3, 2, 1},
+			Setup: func(t *testing.T, mr *miniredis.Miniredis, repo *mockrepo.MockContent) {
+				setCachedContent(t, mr, "content_preview:1", previews[0])
+				setCachedContent(t, mr, "content_preview:3", previews[2])
+				repo.EXPECT().GetPreviewContents(gomock.Any(), []int{2}).Return(previews[1:2], nil)
+			},
+			Check: func(t *testing.T, mr *miniredis.Miniredis) {
+				require.Equal(t, previews[1], getCachedContent(t, mr, "content_preview:2"))
+				require.Equal(t, testPreviewTTL*time.Second, mr.TTL("content_preview:2"))
+			},
+			Expected: []*entity.Content{previews[2], previews[1], previews[0]},
+		},
+		{
+			Name: "Поврежденное значение считается промахом",
+			IDs:  []int{1, 2},
+			Setup: func(t *testing.T, mr *miniredis.Miniredis, repo *mockrepo.MockContent) {
+				setCachedContent(t, mr, "content_preview:1", previews[0])
+				require.NoError(t, mr.Set("content_preview:2", "{не json"))
+				repo.EXPECT().GetPreviewContents(gomock.Any(), []int{2}).Return(previews[1:2], nil)
+			},
+			Expected: previews[:2],
+		},
+		{
+			Name: "Redis недоступен",
+			IDs:  []int{1, 2},
+			Setup: func(t *testing.T, mr *miniredis.Miniredis, repo *mockrepo.MockContent) {
+				mr.SetError("connection refused")
+				repo.EXPECT().GetPreviewContents(gomock.Any(), []int{1, 2}).Return(previews[:2], nil)
+			},
+			Expected: previews[:2],
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			mr, repo, cache := newTestContentCache(t)
+			tc.Setup(t, mr, repo)
+			output, err := cache.GetPreviewContents(context.Background(), tc.IDs)
+			require.NoError(t, err)
+			require.Equal(t, tc.Expected, output)
+			mr.SetError("")
+			if tc.Check != nil {
+				tc.Check(t, mr)
+			}
+		})
+	}
+}
+
+func TestContentCacheDB_GetPreviewContentsError(t *testing.T) {
+	t.Parallel()
+
+	_, repo, cache := newTestContentCache(t)
+	repo.EXPECT().GetPreviewContents(gomock.Any(), []int{1}).Return(nil, errors.New("database error"))
+	_, err := cache.GetPreviewContents(context.Background(), []int{1})
+	require.Error(t, err)
+}
+
+func TestContentCacheDB_InvalidateContent(t *testing.T) {
+	t.Parallel()
+
+	mr, _, cache := newTestContentCache(t)
+	setCachedContent(t, mr, "content:1", &entity.Content{ID: 1})
+	setCachedContent(t, mr, "content_preview:1", &entity.Content{ID: 1})
+	setCachedContent(t, mr, "content:2", &entity.Content{ID: 2})
+	require.NoError(t, cache.InvalidateContent(context.Background(), 1))
+	require.False(t, mr.Exists("content:1"))
+	require.False(t, mr.Exists("content_preview:1"))
+	require.True(t, mr.Exists("content:2"))
+
+	mr.SetError("connection refused")
+	require.Error(t, cache.InvalidateContent(context.Background(), 2))
+}
+
+func TestContentCacheDB_SetReleasedState(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name          string
+		RepoErr       error
+		ExpectedErr   bool
+		ExpectedCache bool
+	}{
+		{
+			Name:          "Кэш сбрасывается после изменения",
+			ExpectedCache: false,
+		},
+		{
+			Name:          "При ошибке кэш не трогается",
+			RepoErr:       errors.New("database error"),
+			ExpectedErr:   true,
+			ExpectedCache: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			mr, repo, cache := newTestContentCache(t)
+			setCachedContent(t, mr, "content:1", &entity.Content{ID: 1})
+			repo.EXPECT().SetReleasedState(gomock.Any(), 1, true).Return(tc.RepoErr)
+			err := cache.SetReleasedState(context.Background(), 1, true)
+			if tc.ExpectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.ExpectedCache, mr.Exists("content:1"))
+		})
+	}
+}
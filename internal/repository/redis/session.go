@@ -16,34 +16,32 @@ const userSessionsPlaceholder = "user_sessions:"
 type sessionsDB struct {
 	rdb              *redis.Client
 	sessionAliveTime int
-	ctx              context.Context
 }
 
 func NewSessionRepository(rdb *redis.Client, sessionAliveTime int) repository.Session {
 	db := &sessionsDB{
 		rdb:              rdb,
 		sessionAliveTime: sessionAliveTime,
-		ctx:              context.Background(),
 	}
 	return db
 }
 
-func (SDB *sessionsDB) NewSession(id int) (string, error) {
+func (SDB *sessionsDB) NewSession(ctx context.Context, id int) (string, error) {
 	sessionID := uuid.NewString()
 	// сначала нужно убедиться, что сессии с таким ключом нет
 	for {
-		_, err := SDB.rdb.Get(SDB.ctx, strconv.Itoa(id)).Result()
+		_, err := SDB.rdb.Get(ctx, strconv.Itoa(id)).Result()
 		if errors.Is(err, redis.Nil) {
 			break
 		}
 		sessionID = uuid.NewString()
 	}
-	err := SDB.rdb.Set(SDB.ctx, sessionID, id, time.Duration(SDB.sessionAliveTime)*time.Second).Err()
+	err := SDB.rdb.Set(ctx, sessionID, id, time.Duration(SDB.sessionAliveTime)*time.Second).Err()
 	if err != nil {
 		return "", entity.RedisWrap(errors.New("не удалось создать сессию"), err)
 	}
 	// Добавляем сессию в список сессий пользователя
-	err = SDB.rdb.SAdd(SDB.ctx, userSessionsPlaceholder+strconv.Itoa(id), sessionID).Err()
+	err = SDB.rdb.SAdd(ctx, userSessionsPlaceholder+strconv.Itoa(id), sessionID).Err()
 	if err != nil {
 		return "", entity.RedisWrap(errors.New("не удалось создать сессию"), err)
 	}
@@ -51,8 +49,8 @@ func (SDB *sessionsDB) NewSession(id int) (string, error) {
 }
 
 // CheckSession проверяет сессию и возвращает id пользователя
-func (SDB *sessionsDB) CheckSession(session string) (int, error) {
-	userID, err := SDB.rdb.Get(SDB.ctx, session).Result()
+func (SDB *sessionsDB) CheckSession(ctx context.Context, session string) (int, error) {
+	userID, err := SDB.rdb.Get(ctx, session).Result()
 	if errors.Is(err, redis.Nil) {
 		return 0, repository.ErrSessionNotFound
 	}
@@ -62,41 +60,41 @@ func (SDB *sessionsDB) CheckSession(session string) (int, error) {
 	return strconv.Atoi(userID)
 }
 
-func (SDB *sessionsDB) DeleteAllSessions(userID int) error {
+func (SDB *sessionsDB) DeleteAllSessions(ctx context.Context, userID int) error {
 	// Получаем список всех сессий пользователя
-	sessionIDs, err := SDB.rdb.SMembers(SDB.ctx, userSessionsPlaceholder+strconv.Itoa(userID)).Result()
+	sessionIDs, err := SDB.rdb.SMembers(ctx, userSessionsPlaceholder+strconv.Itoa(userID)).Result()
 	if err != nil {
 		return entity.RedisWrap(errors.New("не удалось получить список сессий пользователя"), err)
 	}
 	// Удаляем каждую сессию
 	for _, sessionID := range sessionIDs {
-		err = SDB.rdb.Del(SDB.ctx, sessionID).Err()
+		err = SDB.rdb.Del(ctx, sessionID).Err()
 		if err != nil {
 			return entity.RedisWrap(errors.New("не удалось удалить сессию"), err)
 		}
 	}
 	// Удаляем список сессий пользователя
-	err = SDB.rdb.Del(SDB.ctx, userSessionsPlaceholder+strconv.Itoa(userID)).Err()
+	err = SDB.rdb.Del(ctx, userSessionsPlaceholder+strconv.Itoa(userID)).Err()
 	if err != nil {
 		return entity.RedisWrap(errors.New("не удалось удалить список сессий пользователя"), err)
 	}
 	return nil
 }
 
-func (SDB *sessionsDB) DeleteSession(session string) error {
-	id, err := SDB.rdb.Get(SDB.ctx, session).Result()
+func (SDB *sessionsDB) DeleteSession(ctx context.Context, session string) error {
+	id, err := SDB.rdb.Get(ctx, session).Result()
 	if errors.Is(err, redis.Nil) {
 		return nil
 	}
 	if err != nil {
 		return entity.RedisWrap(errors.New("не удалось удалить сессию"), err)
 	}
-	err = SDB.rdb.Del(SDB.ctx, session).Err()
+	err = SDB.rdb.Del(ctx, session).Err()
 	if err != nil {
 		return entity.RedisWrap(errors.New("не удалось удалить сессию"), err)
 	}
 	// Удаляем сессию из списка сессий пользователя
-	err = SDB.rdb.SRem(SDB.ctx, userSessionsPlaceholder+id, session).Err()
+	err = SDB.rdb.SRem(ctx, userSessionsPlaceholder+id, session).Err()
 	if err != nil {
 		return entity.RedisWrap(errors.New("не удалось удалить сессию"), err)
 	}
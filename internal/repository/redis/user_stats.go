@@ -0,0 +1,124 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/logger"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/redis/go-redis/v9"
+	"strconv"
+	"time"
+)
+
+const userStatsCachePlaceholder = "user_stats:"
+
+// userStatsCacheDB кэширует разделы статистики пользователя поверх другого репозитория. Каждый раздел хранится
+// под своим ключом, поэтому сброс одного раздела не заставляет пересчитывать остальные
+type userStatsCacheDB struct {
+	repository.UserStats
+	rdb *redis.Client
+	ttl time.Duration
+}
+
+// NewUserStatsCacheRepository оборачивает репозиторий статистики read-through кэшем в Redis. TTL указывается
+// в секундах
+func NewUserStatsCacheRepository(rdb *redis.Client, stats repository.UserStats, ttl int) repository.UserStats {
+	return &userStatsCacheDB{
+		UserStats: stats,
+		rdb:       rdb,
+		ttl:       time.Duration(ttl) * time.Second,
+	}
+}
+
+func userStatsKey(section string, userID int) string {
+	return userStatsCachePlaceholder + section + ":" + strconv.Itoa(userID)
+}
+
+// GetWatchedStats возвращает статистику просмотренного контента из кэша, а при промахе - из обернутого репозитория
+func (c *userStatsCacheDB) GetWatchedStats(ctx context.Context, userID, limit int) (*entity.WatchedStats, error) {
+	return readThroughStats(ctx, c, userStatsKey(entity.UserStatsSectionWatched, userID),
+		func() (*entity.WatchedStats, error) {
+			return c.UserStats.GetWatchedStats(ctx, userID, limit)
+		},
+	)
+}
+
+// GetRatingStats возвращает статистику оценок из кэша, а при промахе - из обернутого репозитория
+func (c *userStatsCacheDB) GetRatingStats(ctx context.Context, userID int) (*entity.RatingStats, error) {
+	return readThroughStats(ctx, c, userStatsKey(entity.UserStatsSectionRatings, userID),
+		func() (*entity.RatingStats, error) {
+			return c.UserStats.GetRatingStats(ctx, userID)
+		},
+	)
+}
+
+// GetMonthlyActivity возвращает активность по месяцам из кэша, а при промахе - из обернутого репозитория
+func (c *userStatsCacheDB) GetMonthlyActivity(
+	ctx context.Context,
+	userID, months int,
+) ([]*entity.MonthActivity, error) {
+	return readThroughStats(ctx, c, userStatsKey(entity.UserStatsSectionActivity, userID),
+		func() ([]*entity.MonthActivity, error) {
+			return c.UserStats.GetMonthlyActivity(ctx, userID, months)
+		},
+	)
+}
+
+// InvalidateUserStats сбрасывает перечисленные разделы статистики, а если разделы не указаны - все
+func (c *userStatsCacheDB) InvalidateUserStats(ctx context.Context, userID int, sections ...string) error {
+	if len(sections) == 0 {
+		sections = entity.UserStatsSections
+	}
+	keys := make([]string, len(sections))
+	for index, section := range sections {
+		keys[index] = userStatsKey(section, userID)
+	}
+	if err := c.rdb.Del(ctx, keys...).Err(); err != nil {
+		return entity.RedisWrap(errors.New("не удалось сбросить кэш статистики пользователя"), err)
+	}
+	return nil
+}
+
+// readThroughStats достает раздел статистики из кэша или загружает его через load. Как и для контента,
+// недоступность Redis не ломает чтение
+func readThroughStats[T any](
+	ctx context.Context,
+	c *userStatsCacheDB,
+	key string,
+	load func() (T, error),
+) (T, error) {
+	data, err := c.rdb.Get(ctx, key).Bytes()
+	if err == nil {
+		var value T
+		if err = json.Unmarshal(data, &value); err == nil {
+			metrics.ObserveCache("user_stats", metrics.CacheHit)
+			return value, nil
+		}
+		metrics.ObserveCache("user_stats", metrics.CacheError)
+		logger.ForPackage("cache").WarnContext(ctx, "не удалось разобрать значение из кэша", "key", key, "error", err)
+	} else {
+		metrics.ObserveCache("user_stats", cacheResult(err))
+		if !errors.Is(err, redis.Nil) {
+			logger.ForPackage("cache").WarnContext(ctx, "кэш недоступен", "key", key, "error", err)
+		}
+	}
+
+	value, err := load()
+	if err != nil {
+		return value, err
+	}
+	if data, err = json.Marshal(value); err != nil {
+		logger.ForPackage("cache").WarnContext(ctx, "не удалось сериализовать значение для кэша",
+			"key", key,
+			"error", err,
+		)
+		return value, nil
+	}
+	if err = c.rdb.Set(ctx, key, data, c.ttl).Err(); err != nil {
+		logger.ForPackage("cache").WarnContext(ctx, "не удалось записать значение в кэш", "key", key, "error", err)
+	}
+	return value, nil
+}
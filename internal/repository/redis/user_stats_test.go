@@ -0,0 +1,203 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	mockrepo "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository/mocks"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+)
+
+const testStatsTTL = 120
+
+func newTestUserStatsCache(t *testing.T) (*miniredis.Miniredis, *mockrepo.MockUserStats, *userStatsCacheDB) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	ctrl := gomock.NewController(t)
+	repo := mockrepo.NewMockUserStats(ctrl)
+	rdb := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { _ = rdb.Close() })
+	cache, ok := NewUserStatsCacheRepository(rdb, repo, testStatsTTL).(*userStatsCacheDB)
+	require.True(t, ok)
+	return mr, repo, cache
+}
+
+func setCachedStats(t *testing.T, mr *miniredis.Miniredis, key string, value any) {
+	t.Helper()
+	data, err := json.Marshal(value)
+	require.NoError(t, err)
+	require.NoError(t, mr.Set(key, string(data)))
+}
+
+func getCachedRatingStats(t *testing.T, mr *miniredis.Miniredis, key string) *entity.RatingStats {
+	t.Helper()
+	data, err := mr.Get(key)
+	require.NoError(t, err)
+	stats := new(entity.RatingStats)
+	require.NoError(t, json.Unmarshal([]byte(data), stats))
+	return stats
+}
+
+func TestUserStatsCacheDB_GetRatingStats(t *testing.T) {
+	t.Parallel()
+
+	stats := &entity.RatingStats{
+		Ratings: entity.RatingSum{Count: 3, Sum: 24, SiteSum: 21.5},
+		Reviews: entity.RatingSum{Count: 1, Sum: 9, SiteSum: 7.8},
+	}
+	testCases := []struct {
+		Name        string
+		Setup       func(t *testing.T, mr *miniredis.Miniredis, repo *mockrepo.MockUserStats)
+		Check       func(t *testing.T, mr *miniredis.Miniredis)
+		Expected    *entity.RatingStats
+		ExpectedErr error
+	}{
+		{
+			Name: "Попадание в кэш",
+			Setup: func(t *testing.T, mr *miniredis.Miniredis, repo *mockrepo.MockUserStats) {
+				setCachedStats(t, mr, "user_stats:ratings:1", stats)
+			},
+			Expected: stats,
+		},
+		{
+			Name: "Промах сохраняет раздел в кэш",
+			Setup: func(t *testing.T, mr *miniredis.Miniredis, repo *mockrepo.MockUserStats) {
+				repo.EXPECT().GetRatingStats(gomock.Any(), 1).Return(stats, nil)
+			},
+			Check: func(t *testing.T, mr *miniredis.Miniredis) {
+				require.Equal(t, stats, getCachedRatingStats(t, mr, "user_stats:ratings:1"))
+				require.Equal(t, testStatsTTL*time.Second, mr.TTL("user_stats:ratings:1"))
+			},
+			Expected: stats,
+		},
+		{
+			Name: "Поврежденное значение перезаписывается",
+			Setup: func(t *testing.T, mr *miniredis.Miniredis, repo *mockrepo.MockUserStats) {
+				require.NoError(t, mr.Set("user_stats:ratings:1", "{не json"))
+				repo.EXPECT().GetRatingStats(gomock.Any(), 1).Return(stats, nil)
+			},
+			Check: func(t *testing.T, mr *miniredis.Miniredis) {
+				require.Equal(t, stats, getCachedRatingStats(t, mr, "user_stats:ratings:1"))
+			},
+			Expected: stats,
+		},
+		{
+			Name: "Redis недоступен",
+			Setup: func(t *testing.T, mr *miniredis.Miniredis, repo *mockrepo.MockUserStats) {
+				mr.SetError("connection refused")
+				repo.EXPECT().GetRatingStats(gomock.Any(), 1).Return(stats, nil)
+			},
+			Expected: stats,
+		},
+		{
+			Name: "Ошибка обернутого репозитория не кэшируется",
+			Setup: func(t *testing.T, mr *miniredis.Miniredis, repo *mockrepo.MockUserStats) {
+				repo.EXPECT().GetRatingStats(gomock.Any(), 1).Return(nil, errors.New("database error"))
+			},
+			Check: func(t *testing.T, mr *miniredis.Miniredis) {
+				require.False(t, mr.Exists("user_stats:ratings:1"))
+			},
+			ExpectedErr: errors.New("database error"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			mr, repo, cache := newTestUserStatsCache(t)
+			tc.Setup(t, mr, repo)
+			output, err := cache.GetRatingStats(context.Background(), 1)
+			require.Equal(t, tc.ExpectedErr, err)
+			require.Equal(t, tc.Expected, output)
+			mr.SetError("")
+			if tc.Check != nil {
+				tc.Check(t, mr)
+			}
+		})
+	}
+}
+
+func TestUserStatsCacheDB_Sections(t *testing.T) {
+	t.Parallel()
+
+	// каждый раздел хранится под своим ключом: второй запрос раздела берется из кэша
+	mr, repo, cache := newTestUserStatsCache(t)
+	watched := &entity.WatchedStats{Categories: map[string]int{"watched": 2}, Runtime: 250}
+	activity := []*entity.MonthActivity{{Month: time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), Favourites: 2}}
+	repo.EXPECT().GetWatchedStats(gomock.Any(), 1, 5).Return(watched, nil).Times(1)
+	repo.EXPECT().GetMonthlyActivity(gomock.Any(), 1, 12).Return(activity, nil).Times(1)
+	for range 2 {
+		watchedOutput, err := cache.GetWatchedStats(context.Background(), 1, 5)
+		require.NoError(t, err)
+		require.Equal(t, watched, watchedOutput)
+		activityOutput, err := cache.GetMonthlyActivity(context.Background(), 1, 12)
+		require.NoError(t, err)
+		require.Equal(t, activity, activityOutput)
+	}
+	require.True(t, mr.Exists("user_stats:watched:1"))
+	require.True(t, mr.Exists("user_stats:activity:1"))
+}
+
+func TestUserStatsCacheDB_InvalidateUserStats(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		Sections    []string
+		SetError    bool
+		Remaining   []string
+		ExpectedErr bool
+	}{
+		{
+			Name:      "Сброс одного раздела",
+			Sections:  []string{entity.UserStatsSectionRatings},
+			Remaining: []string{"user_stats:watched:1", "user_stats:activity:1", "user_stats:ratings:2"},
+		},
+		{
+			Name:      "Сброс нескольких разделов",
+			Sections:  []string{entity.UserStatsSectionWatched, entity.UserStatsSectionActivity},
+			Remaining: []string{"user_stats:ratings:1", "user_stats:ratings:2"},
+		},
+		{
+			Name:      "Без разделов сбрасываются все",
+			Remaining: []string{"user_stats:ratings:2"},
+		},
+		{
+			Name:     "Redis недоступен",
+			Sections: []string{entity.UserStatsSectionRatings},
+			SetError: true,
+			Remaining: []string{
+				"user_stats:watched:1", "user_stats:ratings:1", "user_stats:activity:1", "user_stats:ratings:2",
+			},
+			ExpectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			mr, _, cache := newTestUserStatsCache(t)
+			keys := []string{
+				"user_stats:watched:1", "user_stats:ratings:1", "user_stats:activity:1", "user_stats:ratings:2",
+			}
+			for _, key := range keys {
+				require.NoError(t, mr.Set(key, "{}"))
+			}
+			if tc.SetError {
+				mr.SetError("connection refused")
+			}
+			err := cache.InvalidateUserStats(context.Background(), 1, tc.Sections...)
+			require.Equal(t, tc.ExpectedErr, err != nil)
+			mr.SetError("")
+			require.ElementsMatch(t, tc.Remaining, mr.Keys())
+		})
+	}
+}
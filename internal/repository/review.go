@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"errors"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
 )
@@ -8,50 +9,65 @@ import (
 //go:generate mockgen -source=$GOFILE -destination=mocks/mock_review.go
 type Review interface {
 	// GetLatestReviews возвращает последние рецензии
-	GetLatestReviews(limit int) ([]*entity.Review, error)
+	GetLatestReviews(ctx context.Context, limit int, filter entity.ReviewFilter) ([]*entity.Review, error)
 	// AddReview добавляет рецензию
 	// Возможные ошибки:
 	// ErrReviewViolation - контент с таким id не существует, либо такого пользователя не существует
 	// ErrReviewBadRequest - некорректные данные для создания рецензии
 	// ErrReviewAlreadyExists - рецензия уже существует
-	AddReview(review *entity.Review) (*entity.Review, error)
+	AddReview(ctx context.Context, review *entity.Review) (*entity.Review, error)
 	// GetReviewByID возвращает рецензию по id
 	// Возможные ошибки:
 	// ErrReviewNotFound - рецензия не найдена
-	GetReviewByID(id int) (*entity.Review, error)
+	GetReviewByID(ctx context.Context, id int) (*entity.Review, error)
 	// GetReviewsCountByContentID возвращает количество рецензий по id контента
-	GetReviewsCountByContentID(contentID int) (int, error)
+	GetReviewsCountByContentID(ctx context.Context, contentID int, filter entity.ReviewFilter) (int, error)
 	// GetReviewsByContentID возвращает рецензии по id контента
-	GetReviewsByContentID(contentID, page, limit int) ([]*entity.Review, error)
+	GetReviewsByContentID(
+		ctx context.Context,
+		contentID, page, limit int,
+		filter entity.ReviewFilter,
+	) ([]*entity.Review, error)
 	// UpdateReview обновляет рецензию
 	// Возможные ошибки:
 	// ErrReviewNotFound - рецензия не найдена
 	// ErrReviewBadRequest - некорректные данные для обновления рецензии
-	UpdateReview(review *entity.Review) error
+	UpdateReview(ctx context.Context, review *entity.Review) error
 	// DeleteReviewByID удаляет рецензию по id
 	// Возможные ошибки:
 	// ErrReviewNotFound - рецензия не найдена
-	DeleteReviewByID(id int) error
-	// GetReviewsCountByAuthorID возвращает количество рецензий по id автора
-	GetReviewsCountByAuthorID(authorID int) (int, error)
-	// GetReviewsByAuthorID возвращает рецензии по id автора
-	GetReviewsByAuthorID(authorID, page, limit int) ([]*entity.Review, error)
+	DeleteReviewByID(ctx context.Context, id int) error
+	// GetReviewsCountByAuthorID возвращает количество рецензий по id автора без учета скрытых модератором
+	GetReviewsCountByAuthorID(ctx context.Context, authorID int, filter entity.ReviewFilter) (int, error)
+	// GetReviewsByAuthorID возвращает рецензии по id автора, кроме скрытых модератором
+	GetReviewsByAuthorID(
+		ctx context.Context,
+		authorID, page, limit int,
+		filter entity.ReviewFilter,
+	) ([]*entity.Review, error)
 	// GetContentReviewByAuthor возвращает рецензию по id автора и id контента
 	// Возможные ошибки:
 	// ErrReviewNotFound - рецензия не найдена
-	GetContentReviewByAuthor(authorID, contentID int) (*entity.Review, error)
+	GetContentReviewByAuthor(ctx context.Context, authorID, contentID int) (*entity.Review, error)
 	// VoteReview оценивает рецензию
 	// Возможные ошибки:
 	// ErrReviewNotFound - рецензия не найдена
 	// ErrReviewVoteAlreadyExists - пользователь уже оценил рецензию
-	VoteReview(reviewID, userID int, like bool) error
+	VoteReview(ctx context.Context, reviewID, userID int, like bool) error
 	// UnVoteReview убирает оценку рецензии
 	// Возможные ошибки:
 	// ErrReviewVoteNotFound - оценка не найдена
-	UnVoteReview(reviewID, userID int) error
+	UnVoteReview(ctx context.Context, reviewID, userID int) error
+	// GetReviewRevisions возвращает предыдущие версии рецензии, начиная с первоначальной.
+	// Если рецензия не редактировалась, возвращает пустой список
+	GetReviewRevisions(ctx context.Context, reviewID int) ([]*entity.ReviewRevision, error)
 	// IsVotedByUser проверяет, оценил ли пользователь рецензию.
 	// Возвращает 1, если оценил положительно, -1, если отрицательно, 0, если не оценил
-	IsVotedByUser(reviewID, userID int) (int, error)
+	IsVotedByUser(ctx context.Context, reviewID, userID int) (int, error)
+	// GetContentRatingStats возвращает распределение оценок контента (из рецензий и без них) и его взвешенную оценку
+	// Возможные ошибки:
+	// ErrContentNotFound - контент не найден
+	GetContentRatingStats(ctx context.Context, contentID int) (*entity.ContentRatingStats, error)
 }
 
 var (
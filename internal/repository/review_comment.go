@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_review_comment.go
+type ReviewComment interface {
+	// AddComment добавляет комментарий к рецензии
+	// Возможные ошибки:
+	// ErrReviewCommentViolation - рецензия, родительский комментарий или пользователь не существуют
+	// ErrReviewCommentBadRequest - некорректные данные для создания комментария
+	AddComment(ctx context.Context, comment *entity.ReviewComment) (*entity.ReviewComment, error)
+	// GetCommentByID возвращает комментарий по id
+	// Возможные ошибки:
+	// ErrReviewCommentNotFound - комментарий не найден
+	GetCommentByID(ctx context.Context, id int) (*entity.ReviewComment, error)
+	// GetCommentsCountByReviewID возвращает количество комментариев верхнего уровня к рецензии
+	GetCommentsCountByReviewID(ctx context.Context, reviewID int) (int, error)
+	// GetCommentsByReviewID возвращает комментарии верхнего уровня к рецензии
+	GetCommentsByReviewID(ctx context.Context, reviewID, page, limit int) ([]*entity.ReviewComment, error)
+	// GetRepliesByParentIDs возвращает ответы на комментарии с переданными id
+	GetRepliesByParentIDs(ctx context.Context, parentIDs []int) ([]*entity.ReviewComment, error)
+	// UpdateComment обновляет текст комментария
+	// Возможные ошибки:
+	// ErrReviewCommentNotFound - комментарий не найден
+	// ErrReviewCommentBadRequest - некорректные данные для обновления комментария
+	UpdateComment(ctx context.Context, comment *entity.ReviewComment) error
+	// DeleteCommentByID удаляет комментарий вместе с ответами на него
+	// Возможные ошибки:
+	// ErrReviewCommentNotFound - комментарий не найден
+	DeleteCommentByID(ctx context.Context, id int) error
+}
+
+var (
+	ErrReviewCommentViolation  = errors.New("рецензия, родительский комментарий или пользователь не существуют")
+	ErrReviewCommentBadRequest = errors.New("некорректные данные для комментария")
+	ErrReviewCommentNotFound   = errors.New("комментарий не найден")
+)
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_review_moderation.go
+type ReviewModeration interface {
+	// IsModerator проверяет, является ли пользователь модератором
+	IsModerator(ctx context.Context, userID int) (bool, error)
+	// AddReport добавляет жалобу на рецензию
+	// Возможные ошибки:
+	// ErrReviewNotFound - рецензия не найдена
+	// ErrReviewReportAlreadyExists - пользователь уже пожаловался на рецензию
+	// ErrReviewReportBadRequest - некорректные данные жалобы
+	AddReport(ctx context.Context, report *entity.ReviewReport) error
+	// GetReportedReviewsCount возвращает количество рецензий с нерассмотренными жалобами
+	GetReportedReviewsCount(ctx context.Context) (int, error)
+	// GetReportedReviews возвращает рецензии с нерассмотренными жалобами, сначала с наибольшим числом жалоб
+	GetReportedReviews(ctx context.Context, page, limit int) ([]*entity.ReportedReview, error)
+	// ResolveReview применяет решение модератора к рецензии, закрывает жалобы на нее и записывает решение в журнал
+	// Возможные ошибки:
+	// ErrReviewNotFound - рецензия не найдена
+	ResolveReview(ctx context.Context, decision *entity.ModerationDecision) error
+	// GetModerationLogCount возвращает количество записей в журнале решений модераторов
+	GetModerationLogCount(ctx context.Context) (int, error)
+	// GetModerationLog возвращает журнал решений модераторов, сначала новые
+	GetModerationLog(ctx context.Context, page, limit int) ([]*entity.ModerationDecision, error)
+}
+
+var (
+	ErrReviewReportAlreadyExists = errors.New("пользователь уже пожаловался на рецензию")
+	ErrReviewReportBadRequest    = errors.New("некорректные данные жалобы")
+)
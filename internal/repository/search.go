@@ -1,7 +1,9 @@
 package repository
 
+import "context"
+
 //go:generate mockgen -source=$GOFILE -destination=mocks/mock_search.go
 type Search interface {
-	SearchContent(query string) ([]int, error)
-	SearchPerson(query string) ([]int, error)
+	SearchContent(ctx context.Context, query string) ([]int, error)
+	SearchPerson(ctx context.Context, query string) ([]int, error)
 }
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"errors"
 	"io"
 )
@@ -10,15 +11,15 @@ type Static interface {
 	// GetStaticURL возвращает путь к статике по его ID
 	// Возможные ошибки:
 	// ErrStaticNotFound - статика с таким id не найдена
-	GetStaticURL(staticID int) (string, error)
+	GetStaticURL(ctx context.Context, staticID int) (string, error)
 	// UploadStatic загружает статику на сервер
 	// Возможные ошибки:
 	// ErrStaticTooBigFile - файл слишком большой
-	UploadStatic(path, filename string, reader io.ReadSeeker) (int, error)
+	UploadStatic(ctx context.Context, path, filename string, reader io.ReadSeeker) (int, error)
 	// GetStaticFile возвращает статику по ID
 	// Возможные ошибки:
 	// ErrStaticNotFound - статика с таким id не найдена
-	GetStaticFile(staticURI string) (io.ReadSeeker, error)
+	GetStaticFile(ctx context.Context, staticURI string) (io.ReadSeeker, error)
 	// GetMaxSize возвращает максимальный размер файла
 	GetMaxSize() int
 }
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"context"
 	"errors"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
 )
@@ -11,17 +12,17 @@ type User interface {
 	// Возможные ошибки:
 	// ErrUserAlreadyExists - пользователь с такой почтой уже существует
 	// ErrUserIncorrectData - поля заполнены некорректно
-	AddUser(email string, passwordHash, passwordSalt []byte) (*entity.User, error)
+	AddUser(ctx context.Context, email string, passwordHash, passwordSalt []byte) (*entity.User, error)
 	// GetUserByID возвращает пользователя по его id.
 	// Возможные ошибки:
 	// ErrUserNotFound - пользователь не найден
-	GetUserByID(userID int) (*entity.User, error)
+	GetUserByID(ctx context.Context, userID int) (*entity.User, error)
 	// GetUserByEmail возвращает пользователя по его почте.
 	// Возможные ошибки:
 	// ErrUserNotFound - пользователь не найден
-	GetUserByEmail(userEmail string) (*entity.User, error)
+	GetUserByEmail(ctx context.Context, userEmail string) (*entity.User, error)
 	// UpdateUser обновляет данные пользователя.
-	UpdateUser(user *entity.User) error
+	UpdateUser(ctx context.Context, user *entity.User) error
 }
 
 var (
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_user_list.go
+type UserList interface {
+	// CreateList создает список. В случае успеха в list записываются ID, CreatedAt и UpdatedAt
+	CreateList(ctx context.Context, list *entity.UserList) (*entity.UserList, error)
+	// UpdateList изменяет название, описание и видимость списка
+	// Возможные ошибки:
+	// ErrUserListNotFound - список не найден
+	UpdateList(ctx context.Context, list *entity.UserList) (*entity.UserList, error)
+	// DeleteList удаляет список вместе с элементами
+	// Возможные ошибки:
+	// ErrUserListNotFound - список не найден
+	DeleteList(ctx context.Context, listID int) error
+	// GetList возвращает список
+	// Возможные ошибки:
+	// ErrUserListNotFound - список не найден
+	GetList(ctx context.Context, listID int) (*entity.UserList, error)
+	// GetListsByUserID возвращает списки пользователя, начиная с последних измененных. Если onlyPublic = true,
+	// возвращаются только публичные списки
+	GetListsByUserID(ctx context.Context, userID int, onlyPublic bool) ([]*entity.UserList, error)
+	// GetListItems возвращает элементы списка по порядку
+	GetListItems(ctx context.Context, listID int) ([]*entity.UserListItem, error)
+	// AddItem добавляет контент в конец списка
+	// Возможные ошибки:
+	// ErrUserListItemAlreadyExists - контент уже есть в списке
+	// ErrUserListContentNotFound - контент не найден
+	AddItem(ctx context.Context, item *entity.UserListItem) (*entity.UserListItem, error)
+	// UpdateItemNote изменяет заметку к элементу списка
+	// Возможные ошибки:
+	// ErrUserListItemNotFound - контента нет в списке
+	UpdateItemNote(ctx context.Context, listID, contentID int, note string) error
+	// RemoveItem удаляет контент из списка, сдвигая следующие элементы
+	// Возможные ошибки:
+	// ErrUserListItemNotFound - контента нет в списке
+	RemoveItem(ctx context.Context, listID, contentID int) error
+	// ReorderItems расставляет элементы списка в порядке contentIDs. contentIDs должен содержать все элементы списка
+	ReorderItems(ctx context.Context, listID int, contentIDs []int) error
+}
+
+var (
+	ErrUserListNotFound          = errors.New("список не найден")
+	ErrUserListItemNotFound      = errors.New("контента нет в списке")
+	ErrUserListItemAlreadyExists = errors.New("контент уже есть в списке")
+	ErrUserListContentNotFound   = errors.New("контент не найден")
+)
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_user_rating.go
+type UserRating interface {
+	// SetRating ставит оценку или заменяет уже поставленную
+	// Возможные ошибки:
+	// ErrUserRatingViolation - контент с таким id не существует, либо такого пользователя не существует
+	SetRating(ctx context.Context, rating *entity.UserRating) (*entity.UserRating, error)
+	// DeleteRating удаляет оценку
+	// Возможные ошибки:
+	// ErrUserRatingNotFound - оценка не найдена
+	DeleteRating(ctx context.Context, userID, contentID int) error
+	// GetRating возвращает оценку пользователя
+	// Возможные ошибки:
+	// ErrUserRatingNotFound - оценка не найдена
+	GetRating(ctx context.Context, userID, contentID int) (*entity.UserRating, error)
+	// GetRatingsCountByUserID возвращает количество оценок пользователя
+	GetRatingsCountByUserID(ctx context.Context, userID int) (int, error)
+	// GetRatingsByUserID возвращает оценки пользователя, начиная с последних измененных
+	GetRatingsByUserID(ctx context.Context, userID, page, limit int) ([]*entity.UserRating, error)
+	// GetSeriesPartContentID возвращает id сериала, к которому относится сезон или эпизод
+	// Возможные ошибки:
+	// ErrUserRatingSeriesPartNotFound - сезон или эпизод не найден
+	GetSeriesPartContentID(ctx context.Context, part entity.SeriesPart, partID int) (int, error)
+	// SetSeriesPartRating ставит оценку сезону или эпизоду или заменяет уже поставленную
+	// Возможные ошибки:
+	// ErrUserRatingSeriesPartNotFound - сезон или эпизод не найден
+	SetSeriesPartRating(ctx context.Context, part entity.SeriesPart, userID, partID, rating int) error
+	// DeleteSeriesPartRating удаляет оценку сезона или эпизода
+	// Возможные ошибки:
+	// ErrUserRatingNotFound - оценка не найдена
+	DeleteSeriesPartRating(ctx context.Context, part entity.SeriesPart, userID, partID int) error
+}
+
+var (
+	ErrUserRatingViolation = errors.New("контент с таким id не существует, либо такого пользователя не существует")
+	ErrUserRatingNotFound  = errors.New("оценка не найдена")
+
+	ErrUserRatingSeriesPartNotFound = errors.New("сезон или эпизод не найден")
+)
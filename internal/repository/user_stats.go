@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_user_stats.go
+type UserStats interface {
+	// GetWatchedStats возвращает статистику просмотренного контента. limit ограничивает число жанров, стран,
+	// режиссеров и актеров
+	GetWatchedStats(ctx context.Context, userID, limit int) (*entity.WatchedStats, error)
+	// GetRatingStats возвращает число и сумму оценок пользователя. Скрытые модератором рецензии не учитываются
+	GetRatingStats(ctx context.Context, userID int) (*entity.RatingStats, error)
+	// GetMonthlyActivity возвращает активность пользователя по месяцам за последние months месяцев, включая
+	// текущий. Месяцы без активности не возвращаются
+	GetMonthlyActivity(ctx context.Context, userID, months int) ([]*entity.MonthActivity, error)
+	// InvalidateUserStats сбрасывает закэшированные разделы статистики пользователя (entity.UserStatsSection*)
+	InvalidateUserStats(ctx context.Context, userID int, sections ...string) error
+}
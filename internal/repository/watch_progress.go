@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_watch_progress.go
+type WatchProgress interface {
+	// GetWatchProgress возвращает все эпизоды сериала с отметками о просмотре пользователем. Эпизоды упорядочены
+	// по сезонам и номерам. У фильмов и несуществующего контента список эпизодов пустой
+	GetWatchProgress(ctx context.Context, userID, contentID int) (*entity.WatchProgress, error)
+	// MarkEpisodesWatched отмечает эпизоды просмотренными. Уже отмеченные эпизоды пропускаются
+	MarkEpisodesWatched(ctx context.Context, userID int, episodeIDs []int) error
+	// UnmarkEpisodesWatched снимает отметки о просмотре эпизодов
+	UnmarkEpisodesWatched(ctx context.Context, userID int, episodeIDs []int) error
+}
@@ -0,0 +1,153 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+const (
+	tracerName = "github.com/go-park-mail-ru/2024_1_Cyberkotletki"
+	// RequestIDKey ключ, под которым ID запроса передается в baggage и в атрибутах спанов
+	RequestIDKey = "request_id"
+)
+
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterOTLP   = "otlp"
+)
+
+// Config параметры трассировки
+type Config struct {
+	// Exporter - none, stdout или otlp
+	Exporter string
+	// Endpoint адрес OTLP коллектора (gRPC), используется только с otlp
+	Endpoint string
+	// SampleRatio доля трассируемых запросов, от 0 до 1
+	SampleRatio float64
+}
+
+// Init настраивает глобальный TracerProvider и W3C propagator. Возвращает функцию, которую нужно вызвать
+// при завершении работы сервиса, чтобы отправить оставшиеся спаны
+func Init(ctx context.Context, serviceName string, cfg Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	exporter, err := newExporter(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if exporter == nil {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceName(serviceName),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// newExporter создает экспортер по cfg.Exporter. Для none возвращает nil: трассировка выключена
+func newExporter(ctx context.Context, cfg Config) (sdktrace.SpanExporter, error) {
+	switch cfg.Exporter {
+	case ExporterNone, "":
+		return nil, nil
+	case ExporterStdout:
+		return stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case ExporterOTLP:
+		return otlptracegrpc.New(ctx,
+			otlptracegrpc.WithEndpoint(cfg.Endpoint),
+			otlptracegrpc.WithInsecure(),
+		)
+	default:
+		return nil, fmt.Errorf("неизвестный экспортер трассировки: %s", cfg.Exporter)
+	}
+}
+
+// Start создает дочерний спан. Используется в usecase слое в виде
+// ctx, span := tracing.Start(ctx, "ReviewService.CreateReview")
+// defer span.End()
+func Start(ctx context.Context, spanName string) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, spanName)
+}
+
+// WithRequestID добавляет ID запроса в текущий спан и в baggage, чтобы он дошел до gRPC сервисов
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String(RequestIDKey, requestID))
+	member, err := baggage.NewMember(RequestIDKey, requestID)
+	if err != nil {
+		return ctx
+	}
+	bag, err := baggage.FromContext(ctx).SetMember(member)
+	if err != nil {
+		return ctx
+	}
+	return baggage.ContextWithBaggage(ctx, bag)
+}
+
+// RequestIDFromContext возвращает ID запроса из baggage или пустую строку
+func RequestIDFromContext(ctx context.Context) string {
+	return baggage.FromContext(ctx).Member(RequestIDKey).Value()
+}
+
+// requestIDUnaryInterceptor переносит ID запроса из baggage в атрибуты серверного спана
+func requestIDUnaryInterceptor(
+	ctx context.Context,
+	req any,
+	_ *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (any, error) {
+	if requestID := RequestIDFromContext(ctx); requestID != "" {
+		trace.SpanFromContext(ctx).SetAttributes(attribute.String(RequestIDKey, requestID))
+	}
+	return handler(ctx, req)
+}
+
+// requestIDStreamInterceptor переносит ID запроса из baggage в атрибуты серверного спана
+func requestIDStreamInterceptor(
+	srv any,
+	stream grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if requestID := RequestIDFromContext(stream.Context()); requestID != "" {
+		trace.SpanFromContext(stream.Context()).SetAttributes(attribute.String(RequestIDKey, requestID))
+	}
+	return handler(srv, stream)
+}
+
+// ServerOptions опции gRPC сервера для трассировки
+func ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(requestIDUnaryInterceptor),
+		grpc.ChainStreamInterceptor(requestIDStreamInterceptor),
+	}
+}
+
+// DialOptions опции gRPC клиента для трассировки
+func DialOptions() []grpc.DialOption {
+	return []grpc.DialOption{
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+	}
+}
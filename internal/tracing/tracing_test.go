@@ -0,0 +1,157 @@
+package tracing
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"testing"
+)
+
+func TestRequestID(t *testing.T) {
+	t.Parallel()
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	ctx, span := provider.Tracer("test").Start(context.Background(), "request")
+	ctx = WithRequestID(ctx, "3f2c1a")
+	span.End()
+	require.Equal(t, "3f2c1a", RequestIDFromContext(ctx))
+	require.Contains(t, recorder.Ended()[0].Attributes(), attribute.String(RequestIDKey, "3f2c1a"))
+
+	// ID запроса доходит до другого сервиса через заголовок baggage
+	propagator := propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{})
+	carrier := propagation.MapCarrier{}
+	propagator.Inject(ctx, carrier)
+	require.Equal(t, "request_id=3f2c1a", carrier.Get("baggage"))
+	remote := propagator.Extract(context.Background(), carrier)
+	require.Equal(t, "3f2c1a", RequestIDFromContext(remote))
+
+	require.Empty(t, RequestIDFromContext(context.Background()))
+}
+
+// Init меняет глобальный TracerProvider, поэтому тест не параллельный
+func TestInit(t *testing.T) {
+	testCases := []struct {
+		Name        string
+		Config      Config
+		ExpectedErr bool
+	}{
+		{
+			Name:   "Трассировка выключена",
+			Config: Config{Exporter: ExporterNone},
+		},
+		{
+			Name:   "Экспортер не указан",
+			Config: Config{},
+		},
+		{
+			Name:   "Stdout",
+			Config: Config{Exporter: ExporterStdout, SampleRatio: 1},
+		},
+		{
+			Name:   "OTLP",
+			Config: Config{Exporter: ExporterOTLP, Endpoint: "localhost:4317", SampleRatio: 1},
+		},
+		{
+			Name:        "Неизвестный экспортер",
+			Config:      Config{Exporter: "jaeger"},
+			ExpectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			previous := otel.GetTracerProvider()
+			shutdown, err := Init(context.Background(), "test", tc.Config)
+			if tc.ExpectedErr {
+				require.Error(t, err)
+				require.Nil(t, shutdown)
+				require.True(t, previous == otel.GetTracerProvider())
+				return
+			}
+			require.NoError(t, err)
+			require.ElementsMatch(t, []string{"traceparent", "tracestate", "baggage"}, otel.GetTextMapPropagator().Fields())
+			switch tc.Config.Exporter {
+			case ExporterNone, "":
+				// провайдер не подменяется, спаны никуда не отправляются
+				require.True(t, previous == otel.GetTracerProvider())
+			default:
+				require.IsType(t, &sdktrace.TracerProvider{}, otel.GetTracerProvider())
+			}
+			require.NoError(t, shutdown(context.Background()))
+		})
+	}
+}
+
+func TestNewExporter(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		Config      Config
+		Expected    sdktrace.SpanExporter
+		ExpectedErr bool
+	}{
+		{
+			Name:     "Трассировка выключена",
+			Config:   Config{Exporter: ExporterNone},
+			Expected: nil,
+		},
+		{
+			Name:     "Stdout",
+			Config:   Config{Exporter: ExporterStdout},
+			Expected: &stdouttrace.Exporter{},
+		},
+		{
+			Name:     "OTLP",
+			Config:   Config{Exporter: ExporterOTLP, Endpoint: "localhost:4317"},
+			Expected: &otlptrace.Exporter{},
+		},
+		{
+			Name:        "Неизвестный экспортер",
+			Config:      Config{Exporter: "jaeger"},
+			ExpectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			exporter, err := newExporter(context.Background(), tc.Config)
+			require.Equal(t, tc.ExpectedErr, err != nil)
+			if tc.Expected == nil {
+				require.Nil(t, exporter)
+				return
+			}
+			require.IsType(t, tc.Expected, exporter)
+			require.NoError(t, exporter.Shutdown(context.Background()))
+		})
+	}
+}
+
+// Start использует глобальный TracerProvider, поэтому тест не параллельный
+func TestStart(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	ctx, parent := Start(context.Background(), "ReviewService.CreateReview")
+	_, child := Start(ctx, "ReviewService.GetReview")
+	child.End()
+	parent.End()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	require.Equal(t, "ReviewService.GetReview", spans[0].Name())
+	require.Equal(t, parent.SpanContext().TraceID(), spans[0].SpanContext().TraceID())
+	require.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	// спан без родителя в контексте начинает новую трассу
+	require.Equal(t, "ReviewService.CreateReview", spans[1].Name())
+	require.False(t, spans[1].Parent().IsValid())
+}
@@ -1,18 +1,21 @@
 package usecase
 
-import "errors"
+import (
+	"context"
+	"errors"
+)
 
 //go:generate mockgen -source=$GOFILE -destination=mocks/mock_auth.go
 type Auth interface {
 	// Logout - выход из сессии
-	Logout(session string) error
+	Logout(ctx context.Context, session string) error
 	// LogoutAll - выход из всех сессий
-	LogoutAll(userID int) error
+	LogoutAll(ctx context.Context, userID int) error
 	// GetUserIDBySession - получение ID пользователя по сессии.
 	// Возвращает ErrSessionNotFound, если сессия не найдена
-	GetUserIDBySession(session string) (int, error)
+	GetUserIDBySession(ctx context.Context, session string) (int, error)
 	// CreateSession - создание новой сессии
-	CreateSession(userID int) (string, error)
+	CreateSession(ctx context.Context, userID int) (string, error)
 }
 
 var (
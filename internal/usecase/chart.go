@@ -0,0 +1,20 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_chart.go
+type Chart interface {
+	// GetChart возвращает контент вычисляемой подборки по ее ключу в том же виде, что и GetCompilationContent
+	// Если подборка не найдена, возвращает ErrChartNotFound
+	GetChart(ctx context.Context, key string, page int) (*dto.CompilationResponse, error)
+	// RefreshCharts пересчитывает все вычисляемые подборки. Вызывается фоновой задачей
+	RefreshCharts(ctx context.Context) error
+}
+
+var (
+	ErrChartNotFound = errors.New("подборка не найдена")
+)
@@ -1,6 +1,7 @@
 package usecase
 
 import (
+	"context"
 	"errors"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
 )
@@ -8,12 +9,12 @@ import (
 //go:generate mockgen -source=$GOFILE -destination=mocks/mock_compilation.go
 type Compilation interface {
 	// GetCompilationTypes возвращает список типов подборок
-	GetCompilationTypes() (*dto.CompilationTypeResponseList, error)
+	GetCompilationTypes(ctx context.Context) (*dto.CompilationTypeResponseList, error)
 	// GetCompilationsByCompilationType возвращает список подборок по типу подборок
-	GetCompilationsByCompilationType(compTypeID int) (*dto.CompilationResponseList, error)
+	GetCompilationsByCompilationType(ctx context.Context, compTypeID int) (*dto.CompilationResponseList, error)
 	// GetCompilationContent возвращает контент подборки по ее ID
 	// Если подборка не найдена, возвращает ErrCompilationNotFound
-	GetCompilationContent(compID, page int) (*dto.CompilationResponse, error)
+	GetCompilationContent(ctx context.Context, compID, page int) (*dto.CompilationResponse, error)
 }
 
 var (
@@ -1,6 +1,7 @@
 package usecase
 
 import (
+	"context"
 	"errors"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
 )
@@ -9,38 +10,44 @@ import (
 type Content interface {
 	// GetContentByID возвращает контент по его ID
 	// Если контент не найден, возвращает ErrContentNotFound
-	GetContentByID(id int) (*dto.Content, error)
+	GetContentByID(ctx context.Context, id int) (*dto.Content, error)
 	// GetPreviewPersonByID возвращает персону по ее ID, но только с минимальным набором полей
 	// Если персона не найдена, возвращает ErrPersonNotFound
-	GetPreviewPersonByID(id int) (*dto.PersonPreviewWithPhoto, error)
+	GetPreviewPersonByID(ctx context.Context, id int) (*dto.PersonPreviewWithPhoto, error)
 	// GetPersonByID возвращает персону по ее ID
 	// Если персона не найдена, возвращает ErrPersonNotFound
-	GetPersonByID(id int) (*dto.Person, error)
+	GetPersonByID(ctx context.Context, id int) (*dto.Person, error)
 	// GetPreviewContentByID возвращает контент по его ID, но только с минимальным набором полей
 	// Если контент не найден, возвращает ErrContentNotFound
-	GetPreviewContentByID(id int) (*dto.PreviewContent, error)
+	GetPreviewContentByID(ctx context.Context, id int) (*dto.PreviewContent, error)
+	// GetPreviewContents возвращает превью нескольких контентов в том же порядке, что и ids
+	// Если хотя бы один контент не найден, возвращает ErrContentNotFound
+	GetPreviewContents(ctx context.Context, ids []int) ([]*dto.PreviewContent, error)
+	// GetBestEpisodes возвращает лучшие по взвешенной оценке эпизоды сериала
+	// Если контент не найден, возвращает ErrContentNotFound
+	GetBestEpisodes(ctx context.Context, contentID int) (*dto.BestEpisodes, error)
 	// GetNearestOngoings возвращает 10 ближайших релизов
-	GetNearestOngoings() (*dto.PreviewOngoingContentList, error)
+	GetNearestOngoings(ctx context.Context) (*dto.PreviewOngoingContentList, error)
 	// GetOngoingContentByMonthAndYear возвращает релизы по месяцу и году
-	GetOngoingContentByMonthAndYear(month, year int) (*dto.PreviewOngoingContentList, error)
+	GetOngoingContentByMonthAndYear(ctx context.Context, month, year int) (*dto.PreviewOngoingContentList, error)
 	// GetAllOngoingsYears возвращает все года релизов
-	GetAllOngoingsYears() (*dto.ReleaseYearsResponse, error)
+	GetAllOngoingsYears(ctx context.Context) (*dto.ReleaseYearsResponse, error)
 	// IsOngoingContentReleased возвращает true, если контент вышел
 	// Если контент не найден, возвращает ErrContentNotFound
-	IsOngoingContentReleased(contentID int, releasedCh chan<- bool, errCh chan<- error)
+	IsOngoingContentReleased(ctx context.Context, contentID int, releasedCh chan<- bool, errCh chan<- error)
 	// SetReleasedState устанавливает состояние релиза
-	SetReleasedState(secretKey string, contentID int, isReleased bool) error
+	SetReleasedState(ctx context.Context, secretKey string, contentID int, isReleased bool) error
 	// SubscribeOnContent подписывает пользователя на контент
 	// Если контент не найден, возвращает ErrContentNotFound
 	// Если пользователь не найден, возвращает ErrUserNotFound
-	SubscribeOnContent(userID, contentID int) error
+	SubscribeOnContent(ctx context.Context, userID, contentID int) error
 	// UnsubscribeFromContent отписывает пользователя от контента
 	// Если контент не найден, возвращает ErrContentNotFound
 	// Если пользователь не найден, возвращает ErrUserNotFound
-	UnsubscribeFromContent(userID, contentID int) error
+	UnsubscribeFromContent(ctx context.Context, userID, contentID int) error
 	// GetSubscribedContentIDs возвращает id контентов, на которые подписан пользователь
 	// Если пользователь не найден, возвращает ErrUserNotFound
-	GetSubscribedContentIDs(userID int) (*dto.SubscriptionsResponse, error)
+	GetSubscribedContentIDs(ctx context.Context, userID int) (*dto.SubscriptionsResponse, error)
 }
 
 var (
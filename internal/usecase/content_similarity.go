@@ -0,0 +1,14 @@
+package usecase
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_content_similarity.go
+type ContentSimilarity interface {
+	// RefreshSimilarContent пересчитывает похожий контент для всего контента, измененного с прошлого пересчета.
+	// Вызывается фоновой задачей
+	RefreshSimilarContent(ctx context.Context) error
+	// RebuildSimilarContent пересчитывает похожий контент для всего контента. Вызывается фоновой задачей
+	RebuildSimilarContent(ctx context.Context) error
+}
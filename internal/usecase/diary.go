@@ -0,0 +1,48 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_diary.go
+type Diary interface {
+	// CreateEntry добавляет просмотр в дневник
+	// Возможные ошибки:
+	// ErrDiaryContentNotFound - контент не найден или еще не вышел
+	// DiaryErrorIncorrectData - некорректная дата, оценка или заметка
+	CreateEntry(ctx context.Context, userID int, req dto.DiaryEntryRequest) (*dto.DiaryEntry, error)
+	// UpdateEntry изменяет дату, оценку, заметку и отметку о повторном просмотре
+	// Возможные ошибки:
+	// ErrDiaryEntryNotFound - у пользователя нет такой записи
+	// DiaryErrorIncorrectData - некорректная дата, оценка или заметка
+	UpdateEntry(ctx context.Context, userID, entryID int, req dto.DiaryEntryRequest) (*dto.DiaryEntry, error)
+	// DeleteEntry удаляет запись.
+	// Возвращает ошибку ErrDiaryEntryNotFound, если у пользователя нет такой записи
+	DeleteEntry(ctx context.Context, userID, entryID int) error
+	// GetEntry возвращает запись вместе с превью контента.
+	// Возвращает ошибку ErrDiaryEntryNotFound, если у пользователя нет такой записи
+	GetEntry(ctx context.Context, userID, entryID int) (*dto.DiaryEntryWithContent, error)
+	// GetCalendar возвращает записи за месяц по дням.
+	// Возвращает ошибку DiaryErrorIncorrectData, если год или месяц некорректны
+	GetCalendar(ctx context.Context, userID, year, month int) (*dto.DiaryCalendar, error)
+	// GetYearStats возвращает статистику просмотров за год.
+	// Возвращает ошибку DiaryErrorIncorrectData, если год некорректен
+	GetYearStats(ctx context.Context, userID, year int) (*dto.DiaryYearStats, error)
+	// GetContentHistory возвращает все просмотры контента пользователем
+	GetContentHistory(ctx context.Context, userID, contentID int) (*dto.DiaryContentHistory, error)
+}
+
+type DiaryErrorIncorrectData struct {
+	Err error
+}
+
+func (e DiaryErrorIncorrectData) Error() string {
+	return e.Err.Error()
+}
+
+var (
+	ErrDiaryEntryNotFound   = errors.New("запись дневника не найдена")
+	ErrDiaryContentNotFound = errors.New("контент не найден")
+)
@@ -1,8 +1,10 @@
 package usecase
 
 import (
+	"context"
 	"errors"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"io"
 )
 
 //go:generate mockgen -source=$GOFILE -destination=mocks/mock_favourite.go
@@ -10,19 +12,25 @@ type Favourite interface {
 	// CreateFavourite добавление в избранное. Если уже в избранном, то ошибка не возвращается (идемпотентный метод).
 	// Возможные ошибки:
 	// ErrFavouriteContentNotFound - контент не найден
-	CreateFavourite(userID, contentID int, category string) error
+	CreateFavourite(ctx context.Context, userID, contentID int, category string) error
 	// DeleteFavourite удаление из избранного.
 	// Возвращает ошибку ErrFavouriteNotFound, если контент не найден в избранном
-	DeleteFavourite(userID, contentID int) error
+	DeleteFavourite(ctx context.Context, userID, contentID int) error
 	// GetFavourites получение избранного контента пользователя.
 	// Возвращает ошибку ErrFavouriteUserNotFound, если пользователь не найден
-	GetFavourites(userID int) (*dto.FavouritesResponse, error)
+	GetFavourites(ctx context.Context, userID int) (*dto.FavouritesResponse, error)
 	// GetStatus получение статуса контента в избранном
-	GetStatus(userID, contentID int) (*dto.FavouriteStatusResponse, error)
+	GetStatus(ctx context.Context, userID, contentID int) (*dto.FavouriteStatusResponse, error)
+	// ExportHistory записывает в w избранное, оценки и рецензии пользователя в формате csv, json или letterboxd.
+	// История читается и записывается частями, поэтому целиком в памяти не хранится
+	// Возможные ошибки:
+	// ErrFavouriteExportFormat - неизвестный формат выгрузки
+	ExportHistory(ctx context.Context, userID int, format string, w io.Writer) error
 }
 
 var (
 	ErrFavouriteNotFound        = errors.New("избранное не найдено")
 	ErrFavouriteContentNotFound = errors.New("контент не найден")
 	ErrFavouriteUserNotFound    = errors.New("пользователь не найден")
+	ErrFavouriteExportFormat    = errors.New("формат выгрузки должен быть csv, json или letterboxd")
 )
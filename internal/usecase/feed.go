@@ -0,0 +1,13 @@
+package usecase
+
+import (
+	"context"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_feed.go
+type Feed interface {
+	// GetFeed возвращает ленту пользователя: новые рецензии, оценки, добавления в списки и изменения избранного
+	// тех, на кого он подписан, начиная с последних. События скрытых от подписчиков разделов не возвращаются
+	GetFeed(ctx context.Context, userID, count, page int) (*dto.Feed, error)
+}
@@ -0,0 +1,32 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_follow.go
+type Follow interface {
+	// Follow подписывает followerID на followeeID. Повторная подписка не считается ошибкой
+	// Возможные ошибки:
+	// ErrFollowSelf - попытка подписаться на себя
+	// ErrFollowUserNotFound - пользователь не найден
+	Follow(ctx context.Context, followerID, followeeID int) error
+	// Unfollow отменяет подписку followerID на followeeID
+	// Возможные ошибки:
+	// ErrFollowNotFound - подписки нет
+	Unfollow(ctx context.Context, followerID, followeeID int) error
+	// GetFollowStatus возвращает счетчики подписок пользователя userID и подписан ли на него viewerID
+	GetFollowStatus(ctx context.Context, viewerID, userID int) (*dto.FollowStatus, error)
+	// GetFollowers возвращает подписчиков пользователя, начиная с последних подписавшихся
+	GetFollowers(ctx context.Context, userID, count, page int) (*dto.FollowUsers, error)
+	// GetFollowing возвращает пользователей, на которых подписан пользователь, начиная с последних подписок
+	GetFollowing(ctx context.Context, userID, count, page int) (*dto.FollowUsers, error)
+}
+
+var (
+	ErrFollowSelf         = errors.New("нельзя подписаться на себя")
+	ErrFollowUserNotFound = errors.New("пользователь не найден")
+	ErrFollowNotFound     = errors.New("подписка не найдена")
+)
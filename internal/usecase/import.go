@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"io"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_import.go
+type Import interface {
+	// StartImport разбирает файл выгрузки сервиса source и запускает импорт в фоне. Найденный контент
+	// добавляется в избранное с категорией category, а оценки из файла ставятся как оценки пользователя.
+	// Возвращает задачу, по которой можно следить за ходом импорта
+	// Возможные ошибки:
+	// ImportErrorIncorrectData - неизвестный источник или категория, некорректный файл
+	// ErrImportTooManyJobs - одновременно выполняется максимальное число импортов
+	StartImport(ctx context.Context, userID int, source, category string, file io.Reader) (*dto.ImportJob, error)
+	// GetJob возвращает состояние задачи импорта и строки, для которых не нашелся контент
+	// Возможные ошибки:
+	// ErrImportJobNotFound - задача не найдена или принадлежит другому пользователю
+	GetJob(ctx context.Context, userID, jobID int) (*dto.ImportJob, error)
+	// ResolveRow вручную сопоставляет строку импорта с контентом и импортирует ее
+	// Возможные ошибки:
+	// ErrImportJobNotFound - задача не найдена или принадлежит другому пользователю
+	// ErrImportRowNotFound - строка не найдена среди несопоставленных
+	// ErrImportContentNotFound - контент не найден
+	ResolveRow(ctx context.Context, userID, jobID, rowNumber int, req dto.ImportResolveRequest) error
+	// FailInterruptedJobs помечает неудавшимися задачи, которые не успели завершиться до остановки сервера.
+	// Вызывается при запуске сервера, до приема новых импортов
+	FailInterruptedJobs(ctx context.Context) error
+	// Wait дожидается завершения запущенных импортов. Если ctx отменяется раньше, возвращает его ошибку
+	Wait(ctx context.Context) error
+}
+
+type ImportErrorIncorrectData struct {
+	Err error
+}
+
+func (e ImportErrorIncorrectData) Error() string {
+	return e.Err.Error()
+}
+
+var (
+	ErrImportJobNotFound     = errors.New("задача импорта не найдена")
+	ErrImportRowNotFound     = errors.New("строка импорта не найдена")
+	ErrImportContentNotFound = errors.New("контент не найден")
+	ErrImportTooManyJobs     = errors.New("сейчас выполняется слишком много импортов, попробуйте позже")
+)
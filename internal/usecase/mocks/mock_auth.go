@@ -10,6 +10,7 @@
 package mock_usecase
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -39,59 +40,59 @@ func (m *MockAuth) EXPECT() *MockAuthMockRecorder {
 }
 
 // CreateSession mocks base method.
-func (m *MockAuth) CreateSession(userID int) (string, error) {
+func (m *MockAuth) CreateSession(ctx context.Context, userID int) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSession", userID)
+	ret := m.ctrl.Call(m, "CreateSession", ctx, userID)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateSession indicates an expected call of CreateSession.
-func (mr *MockAuthMockRecorder) CreateSession(userID any) *gomock.Call {
+func (mr *MockAuthMockRecorder) CreateSession(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockAuth)(nil).CreateSession), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockAuth)(nil).CreateSession), ctx, userID)
 }
 
 // GetUserIDBySession mocks base method.
-func (m *MockAuth) GetUserIDBySession(session string) (int, error) {
+func (m *MockAuth) GetUserIDBySession(ctx context.Context, session string) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserIDBySession", session)
+	ret := m.ctrl.Call(m, "GetUserIDBySession", ctx, session)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserIDBySession indicates an expected call of GetUserIDBySession.
-func (mr *MockAuthMockRecorder) GetUserIDBySession(session any) *gomock.Call {
+func (mr *MockAuthMockRecorder) GetUserIDBySession(ctx, session any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDBySession", reflect.TypeOf((*MockAuth)(nil).GetUserIDBySession), session)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserIDBySession", reflect.TypeOf((*MockAuth)(nil).GetUserIDBySession), ctx, session)
 }
 
 // Logout mocks base method.
-func (m *MockAuth) Logout(session string) error {
+func (m *MockAuth) Logout(ctx context.Context, session string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Logout", session)
+	ret := m.ctrl.Call(m, "Logout", ctx, session)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Logout indicates an expected call of Logout.
-func (mr *MockAuthMockRecorder) Logout(session any) *gomock.Call {
+func (mr *MockAuthMockRecorder) Logout(ctx, session any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuth)(nil).Logout), session)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuth)(nil).Logout), ctx, session)
 }
 
 // LogoutAll mocks base method.
-func (m *MockAuth) LogoutAll(userID int) error {
+func (m *MockAuth) LogoutAll(ctx context.Context, userID int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LogoutAll", userID)
+	ret := m.ctrl.Call(m, "LogoutAll", ctx, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // LogoutAll indicates an expected call of LogoutAll.
-func (mr *MockAuthMockRecorder) LogoutAll(userID any) *gomock.Call {
+func (mr *MockAuthMockRecorder) LogoutAll(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogoutAll", reflect.TypeOf((*MockAuth)(nil).LogoutAll), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogoutAll", reflect.TypeOf((*MockAuth)(nil).LogoutAll), ctx, userID)
 }
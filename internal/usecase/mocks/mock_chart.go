@@ -0,0 +1,70 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: chart.go
+//
+// Generated by this command:
+//
+//	mockgen -source=chart.go -destination=mocks/mock_chart.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockChart is a mock of Chart interface.
+type MockChart struct {
+	ctrl     *gomock.Controller
+	recorder *MockChartMockRecorder
+}
+
+// MockChartMockRecorder is the mock recorder for MockChart.
+type MockChartMockRecorder struct {
+	mock *MockChart
+}
+
+// NewMockChart creates a new mock instance.
+func NewMockChart(ctrl *gomock.Controller) *MockChart {
+	mock := &MockChart{ctrl: ctrl}
+	mock.recorder = &MockChartMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChart) EXPECT() *MockChartMockRecorder {
+	return m.recorder
+}
+
+// GetChart mocks base method.
+func (m *MockChart) GetChart(ctx context.Context, key string, page int) (*dto.CompilationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChart", ctx, key, page)
+	ret0, _ := ret[0].(*dto.CompilationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChart indicates an expected call of GetChart.
+func (mr *MockChartMockRecorder) GetChart(ctx, key, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChart", reflect.TypeOf((*MockChart)(nil).GetChart), ctx, key, page)
+}
+
+// RefreshCharts mocks base method.
+func (m *MockChart) RefreshCharts(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshCharts", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshCharts indicates an expected call of RefreshCharts.
+func (mr *MockChartMockRecorder) RefreshCharts(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshCharts", reflect.TypeOf((*MockChart)(nil).RefreshCharts), ctx)
+}
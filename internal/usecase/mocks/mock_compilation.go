@@ -10,6 +10,7 @@
 package mock_usecase
 
 import (
+	context "context"
 	reflect "reflect"
 
 	dto "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
@@ -40,46 +41,46 @@ func (m *MockCompilation) EXPECT() *MockCompilationMockRecorder {
 }
 
 // GetCompilationContent mocks base method.
-func (m *MockCompilation) GetCompilationContent(compID, page int) (*dto.CompilationResponse, error) {
+func (m *MockCompilation) GetCompilationContent(ctx context.Context, compID, page int) (*dto.CompilationResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCompilationContent", compID, page)
+	ret := m.ctrl.Call(m, "GetCompilationContent", ctx, compID, page)
 	ret0, _ := ret[0].(*dto.CompilationResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCompilationContent indicates an expected call of GetCompilationContent.
-func (mr *MockCompilationMockRecorder) GetCompilationContent(compID, page any) *gomock.Call {
+func (mr *MockCompilationMockRecorder) GetCompilationContent(ctx, compID, page any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompilationContent", reflect.TypeOf((*MockCompilation)(nil).GetCompilationContent), compID, page)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompilationContent", reflect.TypeOf((*MockCompilation)(nil).GetCompilationContent), ctx, compID, page)
 }
 
 // GetCompilationTypes mocks base method.
-func (m *MockCompilation) GetCompilationTypes() (*dto.CompilationTypeResponseList, error) {
+func (m *MockCompilation) GetCompilationTypes(ctx context.Context) (*dto.CompilationTypeResponseList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCompilationTypes")
+	ret := m.ctrl.Call(m, "GetCompilationTypes", ctx)
 	ret0, _ := ret[0].(*dto.CompilationTypeResponseList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCompilationTypes indicates an expected call of GetCompilationTypes.
-func (mr *MockCompilationMockRecorder) GetCompilationTypes() *gomock.Call {
+func (mr *MockCompilationMockRecorder) GetCompilationTypes(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompilationTypes", reflect.TypeOf((*MockCompilation)(nil).GetCompilationTypes))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompilationTypes", reflect.TypeOf((*MockCompilation)(nil).GetCompilationTypes), ctx)
 }
 
 // GetCompilationsByCompilationType mocks base method.
-func (m *MockCompilation) GetCompilationsByCompilationType(compTypeID int) (*dto.CompilationResponseList, error) {
+func (m *MockCompilation) GetCompilationsByCompilationType(ctx context.Context, compTypeID int) (*dto.CompilationResponseList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCompilationsByCompilationType", compTypeID)
+	ret := m.ctrl.Call(m, "GetCompilationsByCompilationType", ctx, compTypeID)
 	ret0, _ := ret[0].(*dto.CompilationResponseList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetCompilationsByCompilationType indicates an expected call of GetCompilationsByCompilationType.
-func (mr *MockCompilationMockRecorder) GetCompilationsByCompilationType(compTypeID any) *gomock.Call {
+func (mr *MockCompilationMockRecorder) GetCompilationsByCompilationType(ctx, compTypeID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompilationsByCompilationType", reflect.TypeOf((*MockCompilation)(nil).GetCompilationsByCompilationType), compTypeID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCompilationsByCompilationType", reflect.TypeOf((*MockCompilation)(nil).GetCompilationsByCompilationType), ctx, compTypeID)
 }
@@ -10,6 +10,7 @@
 package mock_usecase
 
 import (
+	context "context"
 	reflect "reflect"
 
 	dto "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
@@ -40,175 +41,205 @@ func (m *MockContent) EXPECT() *MockContentMockRecorder {
 }
 
 // GetAllOngoingsYears mocks base method.
-func (m *MockContent) GetAllOngoingsYears() (*dto.ReleaseYearsResponse, error) {
+func (m *MockContent) GetAllOngoingsYears(ctx context.Context) (*dto.ReleaseYearsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllOngoingsYears")
+	ret := m.ctrl.Call(m, "GetAllOngoingsYears", ctx)
 	ret0, _ := ret[0].(*dto.ReleaseYearsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAllOngoingsYears indicates an expected call of GetAllOngoingsYears.
-func (mr *MockContentMockRecorder) GetAllOngoingsYears() *gomock.Call {
+func (mr *MockContentMockRecorder) GetAllOngoingsYears(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllOngoingsYears", reflect.TypeOf((*MockContent)(nil).GetAllOngoingsYears))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllOngoingsYears", reflect.TypeOf((*MockContent)(nil).GetAllOngoingsYears), ctx)
+}
+
+// GetBestEpisodes mocks base method.
+func (m *MockContent) GetBestEpisodes(ctx context.Context, contentID int) (*dto.BestEpisodes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBestEpisodes", ctx, contentID)
+	ret0, _ := ret[0].(*dto.BestEpisodes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBestEpisodes indicates an expected call of GetBestEpisodes.
+func (mr *MockContentMockRecorder) GetBestEpisodes(ctx, contentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBestEpisodes", reflect.TypeOf((*MockContent)(nil).GetBestEpisodes), ctx, contentID)
 }
 
 // GetContentByID mocks base method.
-func (m *MockContent) GetContentByID(id int) (*dto.Content, error) {
+func (m *MockContent) GetContentByID(ctx context.Context, id int) (*dto.Content, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetContentByID", id)
+	ret := m.ctrl.Call(m, "GetContentByID", ctx, id)
 	ret0, _ := ret[0].(*dto.Content)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetContentByID indicates an expected call of GetContentByID.
-func (mr *MockContentMockRecorder) GetContentByID(id any) *gomock.Call {
+func (mr *MockContentMockRecorder) GetContentByID(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContentByID", reflect.TypeOf((*MockContent)(nil).GetContentByID), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContentByID", reflect.TypeOf((*MockContent)(nil).GetContentByID), ctx, id)
 }
 
 // GetNearestOngoings mocks base method.
-func (m *MockContent) GetNearestOngoings() (*dto.PreviewOngoingContentList, error) {
+func (m *MockContent) GetNearestOngoings(ctx context.Context) (*dto.PreviewOngoingContentList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNearestOngoings")
+	ret := m.ctrl.Call(m, "GetNearestOngoings", ctx)
 	ret0, _ := ret[0].(*dto.PreviewOngoingContentList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetNearestOngoings indicates an expected call of GetNearestOngoings.
-func (mr *MockContentMockRecorder) GetNearestOngoings() *gomock.Call {
+func (mr *MockContentMockRecorder) GetNearestOngoings(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNearestOngoings", reflect.TypeOf((*MockContent)(nil).GetNearestOngoings))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNearestOngoings", reflect.TypeOf((*MockContent)(nil).GetNearestOngoings), ctx)
 }
 
 // GetOngoingContentByMonthAndYear mocks base method.
-func (m *MockContent) GetOngoingContentByMonthAndYear(month, year int) (*dto.PreviewOngoingContentList, error) {
+func (m *MockContent) GetOngoingContentByMonthAndYear(ctx context.Context, month, year int) (*dto.PreviewOngoingContentList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetOngoingContentByMonthAndYear", month, year)
+	ret := m.ctrl.Call(m, "GetOngoingContentByMonthAndYear", ctx, month, year)
 	ret0, _ := ret[0].(*dto.PreviewOngoingContentList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetOngoingContentByMonthAndYear indicates an expected call of GetOngoingContentByMonthAndYear.
-func (mr *MockContentMockRecorder) GetOngoingContentByMonthAndYear(month, year any) *gomock.Call {
+func (mr *MockContentMockRecorder) GetOngoingContentByMonthAndYear(ctx, month, year any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOngoingContentByMonthAndYear", reflect.TypeOf((*MockContent)(nil).GetOngoingContentByMonthAndYear), month, year)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOngoingContentByMonthAndYear", reflect.TypeOf((*MockContent)(nil).GetOngoingContentByMonthAndYear), ctx, month, year)
 }
 
 // GetPersonByID mocks base method.
-func (m *MockContent) GetPersonByID(id int) (*dto.Person, error) {
+func (m *MockContent) GetPersonByID(ctx context.Context, id int) (*dto.Person, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPersonByID", id)
+	ret := m.ctrl.Call(m, "GetPersonByID", ctx, id)
 	ret0, _ := ret[0].(*dto.Person)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPersonByID indicates an expected call of GetPersonByID.
-func (mr *MockContentMockRecorder) GetPersonByID(id any) *gomock.Call {
+func (mr *MockContentMockRecorder) GetPersonByID(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonByID", reflect.TypeOf((*MockContent)(nil).GetPersonByID), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPersonByID", reflect.TypeOf((*MockContent)(nil).GetPersonByID), ctx, id)
 }
 
 // GetPreviewContentByID mocks base method.
-func (m *MockContent) GetPreviewContentByID(id int) (*dto.PreviewContent, error) {
+func (m *MockContent) GetPreviewContentByID(ctx context.Context, id int) (*dto.PreviewContent, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPreviewContentByID", id)
+	ret := m.ctrl.Call(m, "GetPreviewContentByID", ctx, id)
 	ret0, _ := ret[0].(*dto.PreviewContent)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPreviewContentByID indicates an expected call of GetPreviewContentByID.
-func (mr *MockContentMockRecorder) GetPreviewContentByID(id any) *gomock.Call {
+func (mr *MockContentMockRecorder) GetPreviewContentByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreviewContentByID", reflect.TypeOf((*MockContent)(nil).GetPreviewContentByID), ctx, id)
+}
+
+// GetPreviewContents mocks base method.
+func (m *MockContent) GetPreviewContents(ctx context.Context, ids []int) ([]*dto.PreviewContent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreviewContents", ctx, ids)
+	ret0, _ := ret[0].([]*dto.PreviewContent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreviewContents indicates an expected call of GetPreviewContents.
+func (mr *MockContentMockRecorder) GetPreviewContents(ctx, ids any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreviewContentByID", reflect.TypeOf((*MockContent)(nil).GetPreviewContentByID), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreviewContents", reflect.TypeOf((*MockContent)(nil).GetPreviewContents), ctx, ids)
 }
 
 // GetPreviewPersonByID mocks base method.
-func (m *MockContent) GetPreviewPersonByID(id int) (*dto.PersonPreviewWithPhoto, error) {
+func (m *MockContent) GetPreviewPersonByID(ctx context.Context, id int) (*dto.PersonPreviewWithPhoto, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPreviewPersonByID", id)
+	ret := m.ctrl.Call(m, "GetPreviewPersonByID", ctx, id)
 	ret0, _ := ret[0].(*dto.PersonPreviewWithPhoto)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetPreviewPersonByID indicates an expected call of GetPreviewPersonByID.
-func (mr *MockContentMockRecorder) GetPreviewPersonByID(id any) *gomock.Call {
+func (mr *MockContentMockRecorder) GetPreviewPersonByID(ctx, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreviewPersonByID", reflect.TypeOf((*MockContent)(nil).GetPreviewPersonByID), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreviewPersonByID", reflect.TypeOf((*MockContent)(nil).GetPreviewPersonByID), ctx, id)
 }
 
 // GetSubscribedContentIDs mocks base method.
-func (m *MockContent) GetSubscribedContentIDs(userID int) (*dto.SubscriptionsResponse, error) {
+func (m *MockContent) GetSubscribedContentIDs(ctx context.Context, userID int) (*dto.SubscriptionsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSubscribedContentIDs", userID)
+	ret := m.ctrl.Call(m, "GetSubscribedContentIDs", ctx, userID)
 	ret0, _ := ret[0].(*dto.SubscriptionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSubscribedContentIDs indicates an expected call of GetSubscribedContentIDs.
-func (mr *MockContentMockRecorder) GetSubscribedContentIDs(userID any) *gomock.Call {
+func (mr *MockContentMockRecorder) GetSubscribedContentIDs(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscribedContentIDs", reflect.TypeOf((*MockContent)(nil).GetSubscribedContentIDs), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSubscribedContentIDs", reflect.TypeOf((*MockContent)(nil).GetSubscribedContentIDs), ctx, userID)
 }
 
 // IsOngoingContentReleased mocks base method.
-func (m *MockContent) IsOngoingContentReleased(contentID int, releasedCh chan<- bool, errCh chan<- error) {
+func (m *MockContent) IsOngoingContentReleased(ctx context.Context, contentID int, releasedCh chan<- bool, errCh chan<- error) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "IsOngoingContentReleased", contentID, releasedCh, errCh)
+	m.ctrl.Call(m, "IsOngoingContentReleased", ctx, contentID, releasedCh, errCh)
 }
 
 // IsOngoingContentReleased indicates an expected call of IsOngoingContentReleased.
-func (mr *MockContentMockRecorder) IsOngoingContentReleased(contentID, releasedCh, errCh any) *gomock.Call {
+func (mr *MockContentMockRecorder) IsOngoingContentReleased(ctx, contentID, releasedCh, errCh any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOngoingContentReleased", reflect.TypeOf((*MockContent)(nil).IsOngoingContentReleased), contentID, releasedCh, errCh)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsOngoingContentReleased", reflect.TypeOf((*MockContent)(nil).IsOngoingContentReleased), ctx, contentID, releasedCh, errCh)
 }
 
 // SetReleasedState mocks base method.
-func (m *MockContent) SetReleasedState(secretKey string, contentID int, isReleased bool) error {
+func (m *MockContent) SetReleasedState(ctx context.Context, secretKey string, contentID int, isReleased bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetReleasedState", secretKey, contentID, isReleased)
+	ret := m.ctrl.Call(m, "SetReleasedState", ctx, secretKey, contentID, isReleased)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SetReleasedState indicates an expected call of SetReleasedState.
-func (mr *MockContentMockRecorder) SetReleasedState(secretKey, contentID, isReleased any) *gomock.Call {
+func (mr *MockContentMockRecorder) SetReleasedState(ctx, secretKey, contentID, isReleased any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReleasedState", reflect.TypeOf((*MockContent)(nil).SetReleasedState), secretKey, contentID, isReleased)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReleasedState", reflect.TypeOf((*MockContent)(nil).SetReleasedState), ctx, secretKey, contentID, isReleased)
 }
 
 // SubscribeOnContent mocks base method.
-func (m *MockContent) SubscribeOnContent(userID, contentID int) error {
+func (m *MockContent) SubscribeOnContent(ctx context.Context, userID, contentID int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SubscribeOnContent", userID, contentID)
+	ret := m.ctrl.Call(m, "SubscribeOnContent", ctx, userID, contentID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SubscribeOnContent indicates an expected call of SubscribeOnContent.
-func (mr *MockContentMockRecorder) SubscribeOnContent(userID, contentID any) *gomock.Call {
+func (mr *MockContentMockRecorder) SubscribeOnContent(ctx, userID, contentID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeOnContent", reflect.TypeOf((*MockContent)(nil).SubscribeOnContent), userID, contentID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeOnContent", reflect.TypeOf((*MockContent)(nil).SubscribeOnContent), ctx, userID, contentID)
 }
 
 // UnsubscribeFromContent mocks base method.
-func (m *MockContent) UnsubscribeFromContent(userID, contentID int) error {
+func (m *MockContent) UnsubscribeFromContent(ctx context.Context, userID, contentID int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UnsubscribeFromContent", userID, contentID)
+	ret := m.ctrl.Call(m, "UnsubscribeFromContent", ctx, userID, contentID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UnsubscribeFromContent indicates an expected call of UnsubscribeFromContent.
-func (mr *MockContentMockRecorder) UnsubscribeFromContent(userID, contentID any) *gomock.Call {
+func (mr *MockContentMockRecorder) UnsubscribeFromContent(ctx, userID, contentID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeFromContent", reflect.TypeOf((*MockContent)(nil).UnsubscribeFromContent), userID, contentID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnsubscribeFromContent", reflect.TypeOf((*MockContent)(nil).UnsubscribeFromContent), ctx, userID, contentID)
 }
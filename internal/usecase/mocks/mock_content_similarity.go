@@ -0,0 +1,68 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: content_similarity.go
+//
+// Generated by this command:
+//
+//	mockgen -source=content_similarity.go -destination=mocks/mock_content_similarity.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockContentSimilarity is a mock of ContentSimilarity interface.
+type MockContentSimilarity struct {
+	ctrl     *gomock.Controller
+	recorder *MockContentSimilarityMockRecorder
+}
+
+// MockContentSimilarityMockRecorder is the mock recorder for MockContentSimilarity.
+type MockContentSimilarityMockRecorder struct {
+	mock *MockContentSimilarity
+}
+
+// NewMockContentSimilarity creates a new mock instance.
+func NewMockContentSimilarity(ctrl *gomock.Controller) *MockContentSimilarity {
+	mock := &MockContentSimilarity{ctrl: ctrl}
+	mock.recorder = &MockContentSimilarityMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContentSimilarity) EXPECT() *MockContentSimilarityMockRecorder {
+	return m.recorder
+}
+
+// RebuildSimilarContent mocks base method.
+func (m *MockContentSimilarity) RebuildSimilarContent(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildSimilarContent", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RebuildSimilarContent indicates an expected call of RebuildSimilarContent.
+func (mr *MockContentSimilarityMockRecorder) RebuildSimilarContent(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildSimilarContent", reflect.TypeOf((*MockContentSimilarity)(nil).RebuildSimilarContent), ctx)
+}
+
+// RefreshSimilarContent mocks base method.
+func (m *MockContentSimilarity) RefreshSimilarContent(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshSimilarContent", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshSimilarContent indicates an expected call of RefreshSimilarContent.
+func (mr *MockContentSimilarityMockRecorder) RefreshSimilarContent(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshSimilarContent", reflect.TypeOf((*MockContentSimilarity)(nil).RefreshSimilarContent), ctx)
+}
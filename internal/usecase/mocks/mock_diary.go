@@ -0,0 +1,145 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: diary.go
+//
+// Generated by this command:
+//
+//	mockgen -source=diary.go -destination=mocks/mock_diary.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDiary is a mock of Diary interface.
+type MockDiary struct {
+	ctrl     *gomock.Controller
+	recorder *MockDiaryMockRecorder
+}
+
+// MockDiaryMockRecorder is the mock recorder for MockDiary.
+type MockDiaryMockRecorder struct {
+	mock *MockDiary
+}
+
+// NewMockDiary creates a new mock instance.
+func NewMockDiary(ctrl *gomock.Controller) *MockDiary {
+	mock := &MockDiary{ctrl: ctrl}
+	mock.recorder = &MockDiaryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDiary) EXPECT() *MockDiaryMockRecorder {
+	return m.recorder
+}
+
+// CreateEntry mocks base method.
+func (m *MockDiary) CreateEntry(ctx context.Context, userID int, req dto.DiaryEntryRequest) (*dto.DiaryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEntry", ctx, userID, req)
+	ret0, _ := ret[0].(*dto.DiaryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEntry indicates an expected call of CreateEntry.
+func (mr *MockDiaryMockRecorder) CreateEntry(ctx, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEntry", reflect.TypeOf((*MockDiary)(nil).CreateEntry), ctx, userID, req)
+}
+
+// DeleteEntry mocks base method.
+func (m *MockDiary) DeleteEntry(ctx context.Context, userID, entryID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEntry", ctx, userID, entryID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEntry indicates an expected call of DeleteEntry.
+func (mr *MockDiaryMockRecorder) DeleteEntry(ctx, userID, entryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEntry", reflect.TypeOf((*MockDiary)(nil).DeleteEntry), ctx, userID, entryID)
+}
+
+// GetCalendar mocks base method.
+func (m *MockDiary) GetCalendar(ctx context.Context, userID, year, month int) (*dto.DiaryCalendar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCalendar", ctx, userID, year, month)
+	ret0, _ := ret[0].(*dto.DiaryCalendar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCalendar indicates an expected call of GetCalendar.
+func (mr *MockDiaryMockRecorder) GetCalendar(ctx, userID, year, month any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCalendar", reflect.TypeOf((*MockDiary)(nil).GetCalendar), ctx, userID, year, month)
+}
+
+// GetContentHistory mocks base method.
+func (m *MockDiary) GetContentHistory(ctx context.Context, userID, contentID int) (*dto.DiaryContentHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContentHistory", ctx, userID, contentID)
+	ret0, _ := ret[0].(*dto.DiaryContentHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContentHistory indicates an expected call of GetContentHistory.
+func (mr *MockDiaryMockRecorder) GetContentHistory(ctx, userID, contentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContentHistory", reflect.TypeOf((*MockDiary)(nil).GetContentHistory), ctx, userID, contentID)
+}
+
+// GetEntry mocks base method.
+func (m *MockDiary) GetEntry(ctx context.Context, userID, entryID int) (*dto.DiaryEntryWithContent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEntry", ctx, userID, entryID)
+	ret0, _ := ret[0].(*dto.DiaryEntryWithContent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEntry indicates an expected call of GetEntry.
+func (mr *MockDiaryMockRecorder) GetEntry(ctx, userID, entryID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEntry", reflect.TypeOf((*MockDiary)(nil).GetEntry), ctx, userID, entryID)
+}
+
+// GetYearStats mocks base method.
+func (m *MockDiary) GetYearStats(ctx context.Context, userID, year int) (*dto.DiaryYearStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetYearStats", ctx, userID, year)
+	ret0, _ := ret[0].(*dto.DiaryYearStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetYearStats indicates an expected call of GetYearStats.
+func (mr *MockDiaryMockRecorder) GetYearStats(ctx, userID, year any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetYearStats", reflect.TypeOf((*MockDiary)(nil).GetYearStats), ctx, userID, year)
+}
+
+// UpdateEntry mocks base method.
+func (m *MockDiary) UpdateEntry(ctx context.Context, userID, entryID int, req dto.DiaryEntryRequest) (*dto.DiaryEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEntry", ctx, userID, entryID, req)
+	ret0, _ := ret[0].(*dto.DiaryEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEntry indicates an expected call of UpdateEntry.
+func (mr *MockDiaryMockRecorder) UpdateEntry(ctx, userID, entryID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEntry", reflect.TypeOf((*MockDiary)(nil).UpdateEntry), ctx, userID, entryID, req)
+}
@@ -10,6 +10,8 @@
 package mock_usecase
 
 import (
+	context "context"
+	io "io"
 	reflect "reflect"
 
 	dto "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
@@ -40,59 +42,73 @@ func (m *MockFavourite) EXPECT() *MockFavouriteMockRecorder {
 }
 
 // CreateFavourite mocks base method.
-func (m *MockFavourite) CreateFavourite(userID, contentID int, category string) error {
+func (m *MockFavourite) CreateFavourite(ctx context.Context, userID, contentID int, category string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateFavourite", userID, contentID, category)
+	ret := m.ctrl.Call(m, "CreateFavourite", ctx, userID, contentID, category)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateFavourite indicates an expected call of CreateFavourite.
-func (mr *MockFavouriteMockRecorder) CreateFavourite(userID, contentID, category any) *gomock.Call {
+func (mr *MockFavouriteMockRecorder) CreateFavourite(ctx, userID, contentID, category any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFavourite", reflect.TypeOf((*MockFavourite)(nil).CreateFavourite), userID, contentID, category)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFavourite", reflect.TypeOf((*MockFavourite)(nil).CreateFavourite), ctx, userID, contentID, category)
 }
 
 // DeleteFavourite mocks base method.
-func (m *MockFavourite) DeleteFavourite(userID, contentID int) error {
+func (m *MockFavourite) DeleteFavourite(ctx context.Context, userID, contentID int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteFavourite", userID, contentID)
+	ret := m.ctrl.Call(m, "DeleteFavourite", ctx, userID, contentID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteFavourite indicates an expected call of DeleteFavourite.
-func (mr *MockFavouriteMockRecorder) DeleteFavourite(userID, contentID any) *gomock.Call {
+func (mr *MockFavouriteMockRecorder) DeleteFavourite(ctx, userID, contentID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFavourite", reflect.TypeOf((*MockFavourite)(nil).DeleteFavourite), userID, contentID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFavourite", reflect.TypeOf((*MockFavourite)(nil).DeleteFavourite), ctx, userID, contentID)
+}
+
+// ExportHistory mocks base method.
+func (m *MockFavourite) ExportHistory(ctx context.Context, userID int, format string, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportHistory", ctx, userID, format, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportHistory indicates an expected call of ExportHistory.
+func (mr *MockFavouriteMockRecorder) ExportHistory(ctx, userID, format, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportHistory", reflect.TypeOf((*MockFavourite)(nil).ExportHistory), ctx, userID, format, w)
 }
 
 // GetFavourites mocks base method.
-func (m *MockFavourite) GetFavourites(userID int) (*dto.FavouritesResponse, error) {
+func (m *MockFavourite) GetFavourites(ctx context.Context, userID int) (*dto.FavouritesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFavourites", userID)
+	ret := m.ctrl.Call(m, "GetFavourites", ctx, userID)
 	ret0, _ := ret[0].(*dto.FavouritesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetFavourites indicates an expected call of GetFavourites.
-func (mr *MockFavouriteMockRecorder) GetFavourites(userID any) *gomock.Call {
+func (mr *MockFavouriteMockRecorder) GetFavourites(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavourites", reflect.TypeOf((*MockFavourite)(nil).GetFavourites), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavourites", reflect.TypeOf((*MockFavourite)(nil).GetFavourites), ctx, userID)
 }
 
 // GetStatus mocks base method.
-func (m *MockFavourite) GetStatus(userID, contentID int) (*dto.FavouriteStatusResponse, error) {
+func (m *MockFavourite) GetStatus(ctx context.Context, userID, contentID int) (*dto.FavouriteStatusResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetStatus", userID, contentID)
+	ret := m.ctrl.Call(m, "GetStatus", ctx, userID, contentID)
 	ret0, _ := ret[0].(*dto.FavouriteStatusResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetStatus indicates an expected call of GetStatus.
-func (mr *MockFavouriteMockRecorder) GetStatus(userID, contentID any) *gomock.Call {
+func (mr *MockFavouriteMockRecorder) GetStatus(ctx, userID, contentID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockFavourite)(nil).GetStatus), userID, contentID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockFavourite)(nil).GetStatus), ctx, userID, contentID)
 }
@@ -0,0 +1,114 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: follow.go
+//
+// Generated by this command:
+//
+//	mockgen -source=follow.go -destination=mocks/mock_follow.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockFollow is a mock of Follow interface.
+type MockFollow struct {
+	ctrl     *gomock.Controller
+	recorder *MockFollowMockRecorder
+}
+
+// MockFollowMockRecorder is the mock recorder for MockFollow.
+type MockFollowMockRecorder struct {
+	mock *MockFollow
+}
+
+// NewMockFollow creates a new mock instance.
+func NewMockFollow(ctrl *gomock.Controller) *MockFollow {
+	mock := &MockFollow{ctrl: ctrl}
+	mock.recorder = &MockFollowMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFollow) EXPECT() *MockFollowMockRecorder {
+	return m.recorder
+}
+
+// Follow mocks base method.
+func (m *MockFollow) Follow(ctx context.Context, followerID, followeeID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Follow", ctx, followerID, followeeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Follow indicates an expected call of Follow.
+func (mr *MockFollowMockRecorder) Follow(ctx, followerID, followeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Follow", reflect.TypeOf((*MockFollow)(nil).Follow), ctx, followerID, followeeID)
+}
+
+// GetFollowStatus mocks base method.
+func (m *MockFollow) GetFollowStatus(ctx context.Context, viewerID, userID int) (*dto.FollowStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowStatus", ctx, viewerID, userID)
+	ret0, _ := ret[0].(*dto.FollowStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowStatus indicates an expected call of GetFollowStatus.
+func (mr *MockFollowMockRecorder) GetFollowStatus(ctx, viewerID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowStatus", reflect.TypeOf((*MockFollow)(nil).GetFollowStatus), ctx, viewerID, userID)
+}
+
+// GetFollowers mocks base method.
+func (m *MockFollow) GetFollowers(ctx context.Context, userID, count, page int) (*dto.FollowUsers, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowers", ctx, userID, count, page)
+	ret0, _ := ret[0].(*dto.FollowUsers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowers indicates an expected call of GetFollowers.
+func (mr *MockFollowMockRecorder) GetFollowers(ctx, userID, count, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowers", reflect.TypeOf((*MockFollow)(nil).GetFollowers), ctx, userID, count, page)
+}
+
+// GetFollowing mocks base method.
+func (m *MockFollow) GetFollowing(ctx context.Context, userID, count, page int) (*dto.FollowUsers, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowing", ctx, userID, count, page)
+	ret0, _ := ret[0].(*dto.FollowUsers)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowing indicates an expected call of GetFollowing.
+func (mr *MockFollowMockRecorder) GetFollowing(ctx, userID, count, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowing", reflect.TypeOf((*MockFollow)(nil).GetFollowing), ctx, userID, count, page)
+}
+
+// Unfollow mocks base method.
+func (m *MockFollow) Unfollow(ctx context.Context, followerID, followeeID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unfollow", ctx, followerID, followeeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unfollow indicates an expected call of Unfollow.
+func (mr *MockFollowMockRecorder) Unfollow(ctx, followerID, followeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unfollow", reflect.TypeOf((*MockFollow)(nil).Unfollow), ctx, followerID, followeeID)
+}
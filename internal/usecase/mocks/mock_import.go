@@ -0,0 +1,114 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: import.go
+//
+// Generated by this command:
+//
+//	mockgen -source=import.go -destination=mocks/mock_import.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+
+	dto "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockImport is a mock of Import interface.
+type MockImport struct {
+	ctrl     *gomock.Controller
+	recorder *MockImportMockRecorder
+}
+
+// MockImportMockRecorder is the mock recorder for MockImport.
+type MockImportMockRecorder struct {
+	mock *MockImport
+}
+
+// NewMockImport creates a new mock instance.
+func NewMockImport(ctrl *gomock.Controller) *MockImport {
+	mock := &MockImport{ctrl: ctrl}
+	mock.recorder = &MockImportMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockImport) EXPECT() *MockImportMockRecorder {
+	return m.recorder
+}
+
+// FailInterruptedJobs mocks base method.
+func (m *MockImport) FailInterruptedJobs(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailInterruptedJobs", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailInterruptedJobs indicates an expected call of FailInterruptedJobs.
+func (mr *MockImportMockRecorder) FailInterruptedJobs(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailInterruptedJobs", reflect.TypeOf((*MockImport)(nil).FailInterruptedJobs), ctx)
+}
+
+// GetJob mocks base method.
+func (m *MockImport) GetJob(ctx context.Context, userID, jobID int) (*dto.ImportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJob", ctx, userID, jobID)
+	ret0, _ := ret[0].(*dto.ImportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJob indicates an expected call of GetJob.
+func (mr *MockImportMockRecorder) GetJob(ctx, userID, jobID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJob", reflect.TypeOf((*MockImport)(nil).GetJob), ctx, userID, jobID)
+}
+
+// ResolveRow mocks base method.
+func (m *MockImport) ResolveRow(ctx context.Context, userID, jobID, rowNumber int, req dto.ImportResolveRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveRow", ctx, userID, jobID, rowNumber, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveRow indicates an expected call of ResolveRow.
+func (mr *MockImportMockRecorder) ResolveRow(ctx, userID, jobID, rowNumber, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveRow", reflect.TypeOf((*MockImport)(nil).ResolveRow), ctx, userID, jobID, rowNumber, req)
+}
+
+// StartImport mocks base method.
+func (m *MockImport) StartImport(ctx context.Context, userID int, source, category string, file io.Reader) (*dto.ImportJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartImport", ctx, userID, source, category, file)
+	ret0, _ := ret[0].(*dto.ImportJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartImport indicates an expected call of StartImport.
+func (mr *MockImportMockRecorder) StartImport(ctx, userID, source, category, file any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartImport", reflect.TypeOf((*MockImport)(nil).StartImport), ctx, userID, source, category, file)
+}
+
+// Wait mocks base method.
+func (m *MockImport) Wait(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Wait", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Wait indicates an expected call of Wait.
+func (mr *MockImportMockRecorder) Wait(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Wait", reflect.TypeOf((*MockImport)(nil).Wait), ctx)
+}
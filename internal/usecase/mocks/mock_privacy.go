@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: privacy.go
+//
+// Generated by this command:
+//
+//	mockgen -source=privacy.go -destination=mocks/mock_privacy.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPrivacy is a mock of Privacy interface.
+type MockPrivacy struct {
+	ctrl     *gomock.Controller
+	recorder *MockPrivacyMockRecorder
+}
+
+// MockPrivacyMockRecorder is the mock recorder for MockPrivacy.
+type MockPrivacyMockRecorder struct {
+	mock *MockPrivacy
+}
+
+// NewMockPrivacy creates a new mock instance.
+func NewMockPrivacy(ctrl *gomock.Controller) *MockPrivacy {
+	mock := &MockPrivacy{ctrl: ctrl}
+	mock.recorder = &MockPrivacyMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPrivacy) EXPECT() *MockPrivacyMockRecorder {
+	return m.recorder
+}
+
+// CanView mocks base method.
+func (m *MockPrivacy) CanView(ctx context.Context, viewerID, ownerID int, section string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanView", ctx, viewerID, ownerID, section)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CanView indicates an expected call of CanView.
+func (mr *MockPrivacyMockRecorder) CanView(ctx, viewerID, ownerID, section any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanView", reflect.TypeOf((*MockPrivacy)(nil).CanView), ctx, viewerID, ownerID, section)
+}
+
+// GetSettings mocks base method.
+func (m *MockPrivacy) GetSettings(ctx context.Context, userID int) (*dto.PrivacySettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSettings", ctx, userID)
+	ret0, _ := ret[0].(*dto.PrivacySettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSettings indicates an expected call of GetSettings.
+func (mr *MockPrivacyMockRecorder) GetSettings(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSettings", reflect.TypeOf((*MockPrivacy)(nil).GetSettings), ctx, userID)
+}
+
+// GetVisibleSections mocks base method.
+func (m *MockPrivacy) GetVisibleSections(ctx context.Context, viewerID, ownerID int) (map[string]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVisibleSections", ctx, viewerID, ownerID)
+	ret0, _ := ret[0].(map[string]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVisibleSections indicates an expected call of GetVisibleSections.
+func (mr *MockPrivacyMockRecorder) GetVisibleSections(ctx, viewerID, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVisibleSections", reflect.TypeOf((*MockPrivacy)(nil).GetVisibleSections), ctx, viewerID, ownerID)
+}
+
+// UpdateSettings mocks base method.
+func (m *MockPrivacy) UpdateSettings(ctx context.Context, userID int, settings dto.PrivacySettings) (*dto.PrivacySettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSettings", ctx, userID, settings)
+	ret0, _ := ret[0].(*dto.PrivacySettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSettings indicates an expected call of UpdateSettings.
+func (mr *MockPrivacyMockRecorder) UpdateSettings(ctx, userID, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSettings", reflect.TypeOf((*MockPrivacy)(nil).UpdateSettings), ctx, userID, settings)
+}
@@ -10,6 +10,7 @@
 package mock_usecase
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -39,16 +40,16 @@ func (m *MockProfanity) EXPECT() *MockProfanityMockRecorder {
 }
 
 // FilterMessage mocks base method.
-func (m *MockProfanity) FilterMessage(text string) (string, error) {
+func (m *MockProfanity) FilterMessage(ctx context.Context, text string) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FilterMessage", text)
+	ret := m.ctrl.Call(m, "FilterMessage", ctx, text)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // FilterMessage indicates an expected call of FilterMessage.
-func (mr *MockProfanityMockRecorder) FilterMessage(text any) *gomock.Call {
+func (mr *MockProfanityMockRecorder) FilterMessage(ctx, text any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterMessage", reflect.TypeOf((*MockProfanity)(nil).FilterMessage), text)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FilterMessage", reflect.TypeOf((*MockProfanity)(nil).FilterMessage), ctx, text)
 }
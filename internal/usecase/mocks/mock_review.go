@@ -10,6 +10,7 @@
 package mock_usecase
 
 import (
+	context "context"
 	reflect "reflect"
 
 	dto "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
@@ -40,163 +41,193 @@ func (m *MockReview) EXPECT() *MockReviewMockRecorder {
 }
 
 // CreateReview mocks base method.
-func (m *MockReview) CreateReview(create dto.ReviewCreate) (*dto.ReviewResponse, error) {
+func (m *MockReview) CreateReview(ctx context.Context, create dto.ReviewCreate) (*dto.ReviewResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateReview", create)
+	ret := m.ctrl.Call(m, "CreateReview", ctx, create)
 	ret0, _ := ret[0].(*dto.ReviewResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateReview indicates an expected call of CreateReview.
-func (mr *MockReviewMockRecorder) CreateReview(create any) *gomock.Call {
+func (mr *MockReviewMockRecorder) CreateReview(ctx, create any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReview", reflect.TypeOf((*MockReview)(nil).CreateReview), create)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReview", reflect.TypeOf((*MockReview)(nil).CreateReview), ctx, create)
 }
 
 // DeleteReview mocks base method.
-func (m *MockReview) DeleteReview(reviewID, userID int) error {
+func (m *MockReview) DeleteReview(ctx context.Context, reviewID, userID int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteReview", reviewID, userID)
+	ret := m.ctrl.Call(m, "DeleteReview", ctx, reviewID, userID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // DeleteReview indicates an expected call of DeleteReview.
-func (mr *MockReviewMockRecorder) DeleteReview(reviewID, userID any) *gomock.Call {
+func (mr *MockReviewMockRecorder) DeleteReview(ctx, reviewID, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReview", reflect.TypeOf((*MockReview)(nil).DeleteReview), reviewID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReview", reflect.TypeOf((*MockReview)(nil).DeleteReview), ctx, reviewID, userID)
 }
 
 // EditReview mocks base method.
-func (m *MockReview) EditReview(update dto.ReviewUpdate) (*dto.ReviewResponse, error) {
+func (m *MockReview) EditReview(ctx context.Context, update dto.ReviewUpdate) (*dto.ReviewResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EditReview", update)
+	ret := m.ctrl.Call(m, "EditReview", ctx, update)
 	ret0, _ := ret[0].(*dto.ReviewResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // EditReview indicates an expected call of EditReview.
-func (mr *MockReviewMockRecorder) EditReview(update any) *gomock.Call {
+func (mr *MockReviewMockRecorder) EditReview(ctx, update any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditReview", reflect.TypeOf((*MockReview)(nil).EditReview), update)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditReview", reflect.TypeOf((*MockReview)(nil).EditReview), ctx, update)
+}
+
+// GetContentRatingStats mocks base method.
+func (m *MockReview) GetContentRatingStats(ctx context.Context, contentID int) (*dto.ContentRatingStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContentRatingStats", ctx, contentID)
+	ret0, _ := ret[0].(*dto.ContentRatingStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContentRatingStats indicates an expected call of GetContentRatingStats.
+func (mr *MockReviewMockRecorder) GetContentRatingStats(ctx, contentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContentRatingStats", reflect.TypeOf((*MockReview)(nil).GetContentRatingStats), ctx, contentID)
 }
 
 // GetContentReviewByAuthor mocks base method.
-func (m *MockReview) GetContentReviewByAuthor(authorID, contentID int) (*dto.ReviewResponse, error) {
+func (m *MockReview) GetContentReviewByAuthor(ctx context.Context, authorID, contentID int) (*dto.ReviewResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetContentReviewByAuthor", authorID, contentID)
+	ret := m.ctrl.Call(m, "GetContentReviewByAuthor", ctx, authorID, contentID)
 	ret0, _ := ret[0].(*dto.ReviewResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetContentReviewByAuthor indicates an expected call of GetContentReviewByAuthor.
-func (mr *MockReviewMockRecorder) GetContentReviewByAuthor(authorID, contentID any) *gomock.Call {
+func (mr *MockReviewMockRecorder) GetContentReviewByAuthor(ctx, authorID, contentID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContentReviewByAuthor", reflect.TypeOf((*MockReview)(nil).GetContentReviewByAuthor), authorID, contentID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContentReviewByAuthor", reflect.TypeOf((*MockReview)(nil).GetContentReviewByAuthor), ctx, authorID, contentID)
 }
 
 // GetContentReviews mocks base method.
-func (m *MockReview) GetContentReviews(contentID, count, page int) (*dto.ReviewResponseList, error) {
+func (m *MockReview) GetContentReviews(ctx context.Context, contentID, count, page int, filter dto.ReviewFilter) (*dto.ReviewResponseList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetContentReviews", contentID, count, page)
+	ret := m.ctrl.Call(m, "GetContentReviews", ctx, contentID, count, page, filter)
 	ret0, _ := ret[0].(*dto.ReviewResponseList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetContentReviews indicates an expected call of GetContentReviews.
-func (mr *MockReviewMockRecorder) GetContentReviews(contentID, count, page any) *gomock.Call {
+func (mr *MockReviewMockRecorder) GetContentReviews(ctx, contentID, count, page, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContentReviews", reflect.TypeOf((*MockReview)(nil).GetContentReviews), contentID, count, page)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContentReviews", reflect.TypeOf((*MockReview)(nil).GetContentReviews), ctx, contentID, count, page, filter)
 }
 
 // GetLatestReviews mocks base method.
-func (m *MockReview) GetLatestReviews(count int) (*dto.ReviewResponseList, error) {
+func (m *MockReview) GetLatestReviews(ctx context.Context, count int, filter dto.ReviewFilter) (*dto.ReviewResponseList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLatestReviews", count)
+	ret := m.ctrl.Call(m, "GetLatestReviews", ctx, count, filter)
 	ret0, _ := ret[0].(*dto.ReviewResponseList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetLatestReviews indicates an expected call of GetLatestReviews.
-func (mr *MockReviewMockRecorder) GetLatestReviews(count any) *gomock.Call {
+func (mr *MockReviewMockRecorder) GetLatestReviews(ctx, count, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestReviews", reflect.TypeOf((*MockReview)(nil).GetLatestReviews), count)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestReviews", reflect.TypeOf((*MockReview)(nil).GetLatestReviews), ctx, count, filter)
 }
 
 // GetReview mocks base method.
-func (m *MockReview) GetReview(reviewID int) (*dto.ReviewResponse, error) {
+func (m *MockReview) GetReview(ctx context.Context, reviewID, viewerID int) (*dto.ReviewResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetReview", reviewID)
+	ret := m.ctrl.Call(m, "GetReview", ctx, reviewID, viewerID)
 	ret0, _ := ret[0].(*dto.ReviewResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetReview indicates an expected call of GetReview.
-func (mr *MockReviewMockRecorder) GetReview(reviewID any) *gomock.Call {
+func (mr *MockReviewMockRecorder) GetReview(ctx, reviewID, viewerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReview", reflect.TypeOf((*MockReview)(nil).GetReview), ctx, reviewID, viewerID)
+}
+
+// GetReviewHistory mocks base method.
+func (m *MockReview) GetReviewHistory(ctx context.Context, reviewID, viewerID int) (*dto.ReviewHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewHistory", ctx, reviewID, viewerID)
+	ret0, _ := ret[0].(*dto.ReviewHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewHistory indicates an expected call of GetReviewHistory.
+func (mr *MockReviewMockRecorder) GetReviewHistory(ctx, reviewID, viewerID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReview", reflect.TypeOf((*MockReview)(nil).GetReview), reviewID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewHistory", reflect.TypeOf((*MockReview)(nil).GetReviewHistory), ctx, reviewID, viewerID)
 }
 
 // GetUserReviews mocks base method.
-func (m *MockReview) GetUserReviews(userID, count, page int) (*dto.ReviewResponseList, error) {
+func (m *MockReview) GetUserReviews(ctx context.Context, userID, count, page int, filter dto.ReviewFilter) (*dto.ReviewResponseList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserReviews", userID, count, page)
+	ret := m.ctrl.Call(m, "GetUserReviews", ctx, userID, count, page, filter)
 	ret0, _ := ret[0].(*dto.ReviewResponseList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUserReviews indicates an expected call of GetUserReviews.
-func (mr *MockReviewMockRecorder) GetUserReviews(userID, count, page any) *gomock.Call {
+func (mr *MockReviewMockRecorder) GetUserReviews(ctx, userID, count, page, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserReviews", reflect.TypeOf((*MockReview)(nil).GetUserReviews), userID, count, page)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserReviews", reflect.TypeOf((*MockReview)(nil).GetUserReviews), ctx, userID, count, page, filter)
 }
 
 // IsVotedByUser mocks base method.
-func (m *MockReview) IsVotedByUser(userID, reviewID int) (int, error) {
+func (m *MockReview) IsVotedByUser(ctx context.Context, userID, reviewID int) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "IsVotedByUser", userID, reviewID)
+	ret := m.ctrl.Call(m, "IsVotedByUser", ctx, userID, reviewID)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // IsVotedByUser indicates an expected call of IsVotedByUser.
-func (mr *MockReviewMockRecorder) IsVotedByUser(userID, reviewID any) *gomock.Call {
+func (mr *MockReviewMockRecorder) IsVotedByUser(ctx, userID, reviewID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsVotedByUser", reflect.TypeOf((*MockReview)(nil).IsVotedByUser), userID, reviewID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsVotedByUser", reflect.TypeOf((*MockReview)(nil).IsVotedByUser), ctx, userID, reviewID)
 }
 
 // UnVoteReview mocks base method.
-func (m *MockReview) UnVoteReview(userID, reviewID int) error {
+func (m *MockReview) UnVoteReview(ctx context.Context, userID, reviewID int) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UnVoteReview", userID, reviewID)
+	ret := m.ctrl.Call(m, "UnVoteReview", ctx, userID, reviewID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UnVoteReview indicates an expected call of UnVoteReview.
-func (mr *MockReviewMockRecorder) UnVoteReview(userID, reviewID any) *gomock.Call {
+func (mr *MockReviewMockRecorder) UnVoteReview(ctx, userID, reviewID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnVoteReview", reflect.TypeOf((*MockReview)(nil).UnVoteReview), userID, reviewID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnVoteReview", reflect.TypeOf((*MockReview)(nil).UnVoteReview), ctx, userID, reviewID)
 }
 
 // VoteReview mocks base method.
-func (m *MockReview) VoteReview(userID, reviewID int, vote bool) error {
+func (m *MockReview) VoteReview(ctx context.Context, userID, reviewID int, vote bool) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "VoteReview", userID, reviewID, vote)
+	ret := m.ctrl.Call(m, "VoteReview", ctx, userID, reviewID, vote)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // VoteReview indicates an expected call of VoteReview.
-func (mr *MockReviewMockRecorder) VoteReview(userID, reviewID, vote any) *gomock.Call {
+func (mr *MockReviewMockRecorder) VoteReview(ctx, userID, reviewID, vote any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoteReview", reflect.TypeOf((*MockReview)(nil).VoteReview), userID, reviewID, vote)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoteReview", reflect.TypeOf((*MockReview)(nil).VoteReview), ctx, userID, reviewID, vote)
 }
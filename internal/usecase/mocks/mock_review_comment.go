@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: review_comment.go
+//
+// Generated by this command:
+//
+//	mockgen -source=review_comment.go -destination=mocks/mock_review_comment.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReviewComment is a mock of ReviewComment interface.
+type MockReviewComment struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewCommentMockRecorder
+}
+
+// MockReviewCommentMockRecorder is the mock recorder for MockReviewComment.
+type MockReviewCommentMockRecorder struct {
+	mock *MockReviewComment
+}
+
+// NewMockReviewComment creates a new mock instance.
+func NewMockReviewComment(ctrl *gomock.Controller) *MockReviewComment {
+	mock := &MockReviewComment{ctrl: ctrl}
+	mock.recorder = &MockReviewCommentMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewComment) EXPECT() *MockReviewCommentMockRecorder {
+	return m.recorder
+}
+
+// CreateComment mocks base method.
+func (m *MockReviewComment) CreateComment(ctx context.Context, create dto.ReviewCommentCreate) (*dto.ReviewCommentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateComment", ctx, create)
+	ret0, _ := ret[0].(*dto.ReviewCommentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateComment indicates an expected call of CreateComment.
+func (mr *MockReviewCommentMockRecorder) CreateComment(ctx, create any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateComment", reflect.TypeOf((*MockReviewComment)(nil).CreateComment), ctx, create)
+}
+
+// DeleteComment mocks base method.
+func (m *MockReviewComment) DeleteComment(ctx context.Context, commentID, userID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteComment", ctx, commentID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteComment indicates an expected call of DeleteComment.
+func (mr *MockReviewCommentMockRecorder) DeleteComment(ctx, commentID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteComment", reflect.TypeOf((*MockReviewComment)(nil).DeleteComment), ctx, commentID, userID)
+}
+
+// EditComment mocks base method.
+func (m *MockReviewComment) EditComment(ctx context.Context, update dto.ReviewCommentUpdate) (*dto.ReviewCommentResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditComment", ctx, update)
+	ret0, _ := ret[0].(*dto.ReviewCommentResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditComment indicates an expected call of EditComment.
+func (mr *MockReviewCommentMockRecorder) EditComment(ctx, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditComment", reflect.TypeOf((*MockReviewComment)(nil).EditComment), ctx, update)
+}
+
+// GetReviewComments mocks base method.
+func (m *MockReviewComment) GetReviewComments(ctx context.Context, reviewID, viewerID, count, page int) (*dto.ReviewCommentResponseList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewComments", ctx, reviewID, viewerID, count, page)
+	ret0, _ := ret[0].(*dto.ReviewCommentResponseList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewComments indicates an expected call of GetReviewComments.
+func (mr *MockReviewCommentMockRecorder) GetReviewComments(ctx, reviewID, viewerID, count, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewComments", reflect.TypeOf((*MockReviewComment)(nil).GetReviewComments), ctx, reviewID, viewerID, count, page)
+}
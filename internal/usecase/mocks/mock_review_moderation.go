@@ -0,0 +1,99 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: review_moderation.go
+//
+// Generated by this command:
+//
+//	mockgen -source=review_moderation.go -destination=mocks/mock_review_moderation.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReviewModeration is a mock of ReviewModeration interface.
+type MockReviewModeration struct {
+	ctrl     *gomock.Controller
+	recorder *MockReviewModerationMockRecorder
+}
+
+// MockReviewModerationMockRecorder is the mock recorder for MockReviewModeration.
+type MockReviewModerationMockRecorder struct {
+	mock *MockReviewModeration
+}
+
+// NewMockReviewModeration creates a new mock instance.
+func NewMockReviewModeration(ctrl *gomock.Controller) *MockReviewModeration {
+	mock := &MockReviewModeration{ctrl: ctrl}
+	mock.recorder = &MockReviewModerationMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReviewModeration) EXPECT() *MockReviewModerationMockRecorder {
+	return m.recorder
+}
+
+// GetModerationLog mocks base method.
+func (m *MockReviewModeration) GetModerationLog(ctx context.Context, moderatorID, count, page int) (*dto.ModerationLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModerationLog", ctx, moderatorID, count, page)
+	ret0, _ := ret[0].(*dto.ModerationLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModerationLog indicates an expected call of GetModerationLog.
+func (mr *MockReviewModerationMockRecorder) GetModerationLog(ctx, moderatorID, count, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModerationLog", reflect.TypeOf((*MockReviewModeration)(nil).GetModerationLog), ctx, moderatorID, count, page)
+}
+
+// GetModerationQueue mocks base method.
+func (m *MockReviewModeration) GetModerationQueue(ctx context.Context, moderatorID, count, page int) (*dto.ModerationQueue, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModerationQueue", ctx, moderatorID, count, page)
+	ret0, _ := ret[0].(*dto.ModerationQueue)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModerationQueue indicates an expected call of GetModerationQueue.
+func (mr *MockReviewModerationMockRecorder) GetModerationQueue(ctx, moderatorID, count, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModerationQueue", reflect.TypeOf((*MockReviewModeration)(nil).GetModerationQueue), ctx, moderatorID, count, page)
+}
+
+// ModerateReview mocks base method.
+func (m *MockReviewModeration) ModerateReview(ctx context.Context, decision dto.ModerationDecisionCreate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModerateReview", ctx, decision)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ModerateReview indicates an expected call of ModerateReview.
+func (mr *MockReviewModerationMockRecorder) ModerateReview(ctx, decision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModerateReview", reflect.TypeOf((*MockReviewModeration)(nil).ModerateReview), ctx, decision)
+}
+
+// ReportReview mocks base method.
+func (m *MockReviewModeration) ReportReview(ctx context.Context, report dto.ReviewReportCreate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReportReview", ctx, report)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReportReview indicates an expected call of ReportReview.
+func (mr *MockReviewModerationMockRecorder) ReportReview(ctx, report any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportReview", reflect.TypeOf((*MockReviewModeration)(nil).ReportReview), ctx, report)
+}
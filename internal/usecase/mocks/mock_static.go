@@ -10,6 +10,7 @@
 package mock_usecase
 
 import (
+	context "context"
 	io "io"
 	reflect "reflect"
 
@@ -40,46 +41,46 @@ func (m *MockStatic) EXPECT() *MockStaticMockRecorder {
 }
 
 // GetStatic mocks base method.
-func (m *MockStatic) GetStatic(staticID int) (string, error) {
+func (m *MockStatic) GetStatic(ctx context.Context, staticID int) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetStatic", staticID)
+	ret := m.ctrl.Call(m, "GetStatic", ctx, staticID)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetStatic indicates an expected call of GetStatic.
-func (mr *MockStaticMockRecorder) GetStatic(staticID any) *gomock.Call {
+func (mr *MockStaticMockRecorder) GetStatic(ctx, staticID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatic", reflect.TypeOf((*MockStatic)(nil).GetStatic), staticID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatic", reflect.TypeOf((*MockStatic)(nil).GetStatic), ctx, staticID)
 }
 
 // GetStaticFile mocks base method.
-func (m *MockStatic) GetStaticFile(staticURI string) (io.ReadSeeker, error) {
+func (m *MockStatic) GetStaticFile(ctx context.Context, staticURI string) (io.ReadSeeker, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetStaticFile", staticURI)
+	ret := m.ctrl.Call(m, "GetStaticFile", ctx, staticURI)
 	ret0, _ := ret[0].(io.ReadSeeker)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetStaticFile indicates an expected call of GetStaticFile.
-func (mr *MockStaticMockRecorder) GetStaticFile(staticURI any) *gomock.Call {
+func (mr *MockStaticMockRecorder) GetStaticFile(ctx, staticURI any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStaticFile", reflect.TypeOf((*MockStatic)(nil).GetStaticFile), staticURI)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStaticFile", reflect.TypeOf((*MockStatic)(nil).GetStaticFile), ctx, staticURI)
 }
 
 // UploadAvatar mocks base method.
-func (m *MockStatic) UploadAvatar(reader io.ReadSeeker) (int, error) {
+func (m *MockStatic) UploadAvatar(ctx context.Context, reader io.ReadSeeker) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UploadAvatar", reader)
+	ret := m.ctrl.Call(m, "UploadAvatar", ctx, reader)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UploadAvatar indicates an expected call of UploadAvatar.
-func (mr *MockStaticMockRecorder) UploadAvatar(reader any) *gomock.Call {
+func (mr *MockStaticMockRecorder) UploadAvatar(ctx, reader any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAvatar", reflect.TypeOf((*MockStatic)(nil).UploadAvatar), reader)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAvatar", reflect.TypeOf((*MockStatic)(nil).UploadAvatar), ctx, reader)
 }
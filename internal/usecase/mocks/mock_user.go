@@ -10,6 +10,7 @@
 package mock_usecase
 
 import (
+	context "context"
 	io "io"
 	reflect "reflect"
 
@@ -41,88 +42,88 @@ func (m *MockUser) EXPECT() *MockUserMockRecorder {
 }
 
 // GetUser mocks base method.
-func (m *MockUser) GetUser(userID int) (*dto.UserProfile, error) {
+func (m *MockUser) GetUser(ctx context.Context, userID int) (*dto.UserProfile, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUser", userID)
+	ret := m.ctrl.Call(m, "GetUser", ctx, userID)
 	ret0, _ := ret[0].(*dto.UserProfile)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetUser indicates an expected call of GetUser.
-func (mr *MockUserMockRecorder) GetUser(userID any) *gomock.Call {
+func (mr *MockUserMockRecorder) GetUser(ctx, userID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockUser)(nil).GetUser), userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockUser)(nil).GetUser), ctx, userID)
 }
 
 // Login mocks base method.
-func (m *MockUser) Login(arg0 *dto.Login) (int, error) {
+func (m *MockUser) Login(ctx context.Context, login *dto.Login) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Login", arg0)
+	ret := m.ctrl.Call(m, "Login", ctx, login)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Login indicates an expected call of Login.
-func (mr *MockUserMockRecorder) Login(arg0 any) *gomock.Call {
+func (mr *MockUserMockRecorder) Login(ctx, login any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockUser)(nil).Login), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockUser)(nil).Login), ctx, login)
 }
 
 // Register mocks base method.
-func (m *MockUser) Register(arg0 *dto.Register) (int, error) {
+func (m *MockUser) Register(ctx context.Context, register *dto.Register) (int, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Register", arg0)
+	ret := m.ctrl.Call(m, "Register", ctx, register)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Register indicates an expected call of Register.
-func (mr *MockUserMockRecorder) Register(arg0 any) *gomock.Call {
+func (mr *MockUserMockRecorder) Register(ctx, register any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockUser)(nil).Register), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockUser)(nil).Register), ctx, register)
 }
 
 // UpdateAvatar mocks base method.
-func (m *MockUser) UpdateAvatar(userID int, reader io.ReadSeeker) error {
+func (m *MockUser) UpdateAvatar(ctx context.Context, userID int, reader io.ReadSeeker) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateAvatar", userID, reader)
+	ret := m.ctrl.Call(m, "UpdateAvatar", ctx, userID, reader)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateAvatar indicates an expected call of UpdateAvatar.
-func (mr *MockUserMockRecorder) UpdateAvatar(userID, reader any) *gomock.Call {
+func (mr *MockUserMockRecorder) UpdateAvatar(ctx, userID, reader any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAvatar", reflect.TypeOf((*MockUser)(nil).UpdateAvatar), userID, reader)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAvatar", reflect.TypeOf((*MockUser)(nil).UpdateAvatar), ctx, userID, reader)
 }
 
 // UpdateInfo mocks base method.
-func (m *MockUser) UpdateInfo(userID int, update *dto.UserUpdate) error {
+func (m *MockUser) UpdateInfo(ctx context.Context, userID int, update *dto.UserUpdate) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateInfo", userID, update)
+	ret := m.ctrl.Call(m, "UpdateInfo", ctx, userID, update)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateInfo indicates an expected call of UpdateInfo.
-func (mr *MockUserMockRecorder) UpdateInfo(userID, update any) *gomock.Call {
+func (mr *MockUserMockRecorder) UpdateInfo(ctx, userID, update any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInfo", reflect.TypeOf((*MockUser)(nil).UpdateInfo), userID, update)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInfo", reflect.TypeOf((*MockUser)(nil).UpdateInfo), ctx, userID, update)
 }
 
 // UpdatePassword mocks base method.
-func (m *MockUser) UpdatePassword(userID int, update *dto.UpdatePassword) error {
+func (m *MockUser) UpdatePassword(ctx context.Context, userID int, update *dto.UpdatePassword) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdatePassword", userID, update)
+	ret := m.ctrl.Call(m, "UpdatePassword", ctx, userID, update)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdatePassword indicates an expected call of UpdatePassword.
-func (mr *MockUserMockRecorder) UpdatePassword(userID, update any) *gomock.Call {
+func (mr *MockUserMockRecorder) UpdatePassword(ctx, userID, update any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockUser)(nil).UpdatePassword), userID, update)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockUser)(nil).UpdatePassword), ctx, userID, update)
 }
@@ -0,0 +1,171 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: user_list.go
+//
+// Generated by this command:
+//
+//	mockgen -source=user_list.go -destination=mocks/mock_user_list.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserList is a mock of UserList interface.
+type MockUserList struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserListMockRecorder
+}
+
+// MockUserListMockRecorder is the mock recorder for MockUserList.
+type MockUserListMockRecorder struct {
+	mock *MockUserList
+}
+
+// NewMockUserList creates a new mock instance.
+func NewMockUserList(ctrl *gomock.Controller) *MockUserList {
+	mock := &MockUserList{ctrl: ctrl}
+	mock.recorder = &MockUserListMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserList) EXPECT() *MockUserListMockRecorder {
+	return m.recorder
+}
+
+// AddItem mocks base method.
+func (m *MockUserList) AddItem(ctx context.Context, userID, listID int, req dto.UserListItemRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddItem", ctx, userID, listID, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddItem indicates an expected call of AddItem.
+func (mr *MockUserListMockRecorder) AddItem(ctx, userID, listID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddItem", reflect.TypeOf((*MockUserList)(nil).AddItem), ctx, userID, listID, req)
+}
+
+// CreateList mocks base method.
+func (m *MockUserList) CreateList(ctx context.Context, userID int, req dto.UserListRequest) (*dto.UserList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateList", ctx, userID, req)
+	ret0, _ := ret[0].(*dto.UserList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateList indicates an expected call of CreateList.
+func (mr *MockUserListMockRecorder) CreateList(ctx, userID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateList", reflect.TypeOf((*MockUserList)(nil).CreateList), ctx, userID, req)
+}
+
+// DeleteList mocks base method.
+func (m *MockUserList) DeleteList(ctx context.Context, userID, listID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteList", ctx, userID, listID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteList indicates an expected call of DeleteList.
+func (mr *MockUserListMockRecorder) DeleteList(ctx, userID, listID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteList", reflect.TypeOf((*MockUserList)(nil).DeleteList), ctx, userID, listID)
+}
+
+// GetList mocks base method.
+func (m *MockUserList) GetList(ctx context.Context, viewerID, listID int) (*dto.UserListWithItems, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetList", ctx, viewerID, listID)
+	ret0, _ := ret[0].(*dto.UserListWithItems)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetList indicates an expected call of GetList.
+func (mr *MockUserListMockRecorder) GetList(ctx, viewerID, listID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetList", reflect.TypeOf((*MockUserList)(nil).GetList), ctx, viewerID, listID)
+}
+
+// GetUserLists mocks base method.
+func (m *MockUserList) GetUserLists(ctx context.Context, viewerID, ownerID int) (*dto.UserLists, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserLists", ctx, viewerID, ownerID)
+	ret0, _ := ret[0].(*dto.UserLists)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserLists indicates an expected call of GetUserLists.
+func (mr *MockUserListMockRecorder) GetUserLists(ctx, viewerID, ownerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserLists", reflect.TypeOf((*MockUserList)(nil).GetUserLists), ctx, viewerID, ownerID)
+}
+
+// RemoveItem mocks base method.
+func (m *MockUserList) RemoveItem(ctx context.Context, userID, listID, contentID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveItem", ctx, userID, listID, contentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveItem indicates an expected call of RemoveItem.
+func (mr *MockUserListMockRecorder) RemoveItem(ctx, userID, listID, contentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveItem", reflect.TypeOf((*MockUserList)(nil).RemoveItem), ctx, userID, listID, contentID)
+}
+
+// ReorderItems mocks base method.
+func (m *MockUserList) ReorderItems(ctx context.Context, userID, listID int, req dto.UserListOrderRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReorderItems", ctx, userID, listID, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReorderItems indicates an expected call of ReorderItems.
+func (mr *MockUserListMockRecorder) ReorderItems(ctx, userID, listID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReorderItems", reflect.TypeOf((*MockUserList)(nil).ReorderItems), ctx, userID, listID, req)
+}
+
+// UpdateItem mocks base method.
+func (m *MockUserList) UpdateItem(ctx context.Context, userID, listID, contentID int, req dto.UserListItemRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateItem", ctx, userID, listID, contentID, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateItem indicates an expected call of UpdateItem.
+func (mr *MockUserListMockRecorder) UpdateItem(ctx, userID, listID, contentID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateItem", reflect.TypeOf((*MockUserList)(nil).UpdateItem), ctx, userID, listID, contentID, req)
+}
+
+// UpdateList mocks base method.
+func (m *MockUserList) UpdateList(ctx context.Context, userID, listID int, req dto.UserListRequest) (*dto.UserList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateList", ctx, userID, listID, req)
+	ret0, _ := ret[0].(*dto.UserList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateList indicates an expected call of UpdateList.
+func (mr *MockUserListMockRecorder) UpdateList(ctx, userID, listID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateList", reflect.TypeOf((*MockUserList)(nil).UpdateList), ctx, userID, listID, req)
+}
@@ -0,0 +1,128 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: user_rating.go
+//
+// Generated by this command:
+//
+//	mockgen -source=user_rating.go -destination=mocks/mock_user_rating.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserRating is a mock of UserRating interface.
+type MockUserRating struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserRatingMockRecorder
+}
+
+// MockUserRatingMockRecorder is the mock recorder for MockUserRating.
+type MockUserRatingMockRecorder struct {
+	mock *MockUserRating
+}
+
+// NewMockUserRating creates a new mock instance.
+func NewMockUserRating(ctrl *gomock.Controller) *MockUserRating {
+	mock := &MockUserRating{ctrl: ctrl}
+	mock.recorder = &MockUserRatingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserRating) EXPECT() *MockUserRatingMockRecorder {
+	return m.recorder
+}
+
+// DeleteRating mocks base method.
+func (m *MockUserRating) DeleteRating(ctx context.Context, userID, contentID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRating", ctx, userID, contentID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteRating indicates an expected call of DeleteRating.
+func (mr *MockUserRatingMockRecorder) DeleteRating(ctx, userID, contentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRating", reflect.TypeOf((*MockUserRating)(nil).DeleteRating), ctx, userID, contentID)
+}
+
+// DeleteSeriesPartRating mocks base method.
+func (m *MockUserRating) DeleteSeriesPartRating(ctx context.Context, part string, userID, partID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSeriesPartRating", ctx, part, userID, partID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSeriesPartRating indicates an expected call of DeleteSeriesPartRating.
+func (mr *MockUserRatingMockRecorder) DeleteSeriesPartRating(ctx, part, userID, partID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSeriesPartRating", reflect.TypeOf((*MockUserRating)(nil).DeleteSeriesPartRating), ctx, part, userID, partID)
+}
+
+// GetRating mocks base method.
+func (m *MockUserRating) GetRating(ctx context.Context, userID, contentID int) (*dto.UserRating, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRating", ctx, userID, contentID)
+	ret0, _ := ret[0].(*dto.UserRating)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRating indicates an expected call of GetRating.
+func (mr *MockUserRatingMockRecorder) GetRating(ctx, userID, contentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRating", reflect.TypeOf((*MockUserRating)(nil).GetRating), ctx, userID, contentID)
+}
+
+// GetUserRatings mocks base method.
+func (m *MockUserRating) GetUserRatings(ctx context.Context, userID, count, page int) (*dto.UserRatingList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRatings", ctx, userID, count, page)
+	ret0, _ := ret[0].(*dto.UserRatingList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserRatings indicates an expected call of GetUserRatings.
+func (mr *MockUserRatingMockRecorder) GetUserRatings(ctx, userID, count, page any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRatings", reflect.TypeOf((*MockUserRating)(nil).GetUserRatings), ctx, userID, count, page)
+}
+
+// SetRating mocks base method.
+func (m *MockUserRating) SetRating(ctx context.Context, set dto.UserRatingSet) (*dto.UserRating, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetRating", ctx, set)
+	ret0, _ := ret[0].(*dto.UserRating)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetRating indicates an expected call of SetRating.
+func (mr *MockUserRatingMockRecorder) SetRating(ctx, set any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetRating", reflect.TypeOf((*MockUserRating)(nil).SetRating), ctx, set)
+}
+
+// SetSeriesPartRating mocks base method.
+func (m *MockUserRating) SetSeriesPartRating(ctx context.Context, set dto.SeriesPartRatingSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSeriesPartRating", ctx, set)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetSeriesPartRating indicates an expected call of SetSeriesPartRating.
+func (mr *MockUserRatingMockRecorder) SetSeriesPartRating(ctx, set any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSeriesPartRating", reflect.TypeOf((*MockUserRating)(nil).SetSeriesPartRating), ctx, set)
+}
@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: watch_progress.go
+//
+// Generated by this command:
+//
+//	mockgen -source=watch_progress.go -destination=mocks/mock_watch_progress.go
+//
+
+// Package mock_usecase is a generated GoMock package.
+package mock_usecase
+
+import (
+	context "context"
+	reflect "reflect"
+
+	dto "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockWatchProgress is a mock of WatchProgress interface.
+type MockWatchProgress struct {
+	ctrl     *gomock.Controller
+	recorder *MockWatchProgressMockRecorder
+}
+
+// MockWatchProgressMockRecorder is the mock recorder for MockWatchProgress.
+type MockWatchProgressMockRecorder struct {
+	mock *MockWatchProgress
+}
+
+// NewMockWatchProgress creates a new mock instance.
+func NewMockWatchProgress(ctrl *gomock.Controller) *MockWatchProgress {
+	mock := &MockWatchProgress{ctrl: ctrl}
+	mock.recorder = &MockWatchProgressMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWatchProgress) EXPECT() *MockWatchProgressMockRecorder {
+	return m.recorder
+}
+
+// GetNextEpisode mocks base method.
+func (m *MockWatchProgress) GetNextEpisode(ctx context.Context, userID, contentID int) (*dto.SeasonEpisode, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNextEpisode", ctx, userID, contentID)
+	ret0, _ := ret[0].(*dto.SeasonEpisode)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNextEpisode indicates an expected call of GetNextEpisode.
+func (mr *MockWatchProgressMockRecorder) GetNextEpisode(ctx, userID, contentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNextEpisode", reflect.TypeOf((*MockWatchProgress)(nil).GetNextEpisode), ctx, userID, contentID)
+}
+
+// GetProgress mocks base method.
+func (m *MockWatchProgress) GetProgress(ctx context.Context, userID, contentID int) (*dto.WatchProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProgress", ctx, userID, contentID)
+	ret0, _ := ret[0].(*dto.WatchProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProgress indicates an expected call of GetProgress.
+func (mr *MockWatchProgressMockRecorder) GetProgress(ctx, userID, contentID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProgress", reflect.TypeOf((*MockWatchProgress)(nil).GetProgress), ctx, userID, contentID)
+}
+
+// UpdateProgress mocks base method.
+func (m *MockWatchProgress) UpdateProgress(ctx context.Context, userID, contentID int, req dto.WatchProgressRequest) (*dto.WatchProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProgress", ctx, userID, contentID, req)
+	ret0, _ := ret[0].(*dto.WatchProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProgress indicates an expected call of UpdateProgress.
+func (mr *MockWatchProgressMockRecorder) UpdateProgress(ctx, userID, contentID, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProgress", reflect.TypeOf((*MockWatchProgress)(nil).UpdateProgress), ctx, userID, contentID, req)
+}
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_privacy.go
+type Privacy interface {
+	// GetSettings возвращает настройки приватности пользователя
+	GetSettings(ctx context.Context, userID int) (*dto.PrivacySettings, error)
+	// UpdateSettings изменяет настройки приватности пользователя
+	// Возможные ошибки:
+	// ErrPrivacyUserNotFound - пользователь не найден
+	// PrivacyErrorIncorrectData - некорректный уровень видимости
+	UpdateSettings(ctx context.Context, userID int, settings dto.PrivacySettings) (*dto.PrivacySettings, error)
+	// CanView проверяет, виден ли раздел section профиля ownerID пользователю viewerID.
+	// section - один из entity.PrivacySection*
+	CanView(ctx context.Context, viewerID, ownerID int, section string) (bool, error)
+	// GetVisibleSections возвращает видимость всех разделов профиля ownerID для пользователя viewerID
+	GetVisibleSections(ctx context.Context, viewerID, ownerID int) (map[string]bool, error)
+}
+
+type PrivacyErrorIncorrectData struct {
+	Err error
+}
+
+func (e PrivacyErrorIncorrectData) Error() string {
+	return e.Err.Error()
+}
+
+var (
+	ErrPrivacyUserNotFound = errors.New("пользователь не найден")
+)
@@ -1,7 +1,9 @@
 package usecase
 
+import "context"
+
 //go:generate mockgen -source=$GOFILE -destination=mocks/mock_profanity.go
 type Profanity interface {
 	// FilterMessage фильтрует сообщение от ненормативной лексики
-	FilterMessage(text string) (string, error)
+	FilterMessage(ctx context.Context, text string) (string, error)
 }
@@ -0,0 +1,23 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_profile.go
+type Profile interface {
+	// GetPublicProfile возвращает публичный профиль пользователя userID так, как его видит viewerID.
+	// Счетчики и рецензии скрытых разделов не возвращаются.
+	// Возвращает ошибку ErrProfileUserNotFound, если пользователь не найден
+	GetPublicProfile(ctx context.Context, viewerID, userID int) (*dto.PublicProfile, error)
+	// GetUserStats возвращает статистику пользователя userID так, как ее видит viewerID: просмотренный контент
+	// виден вместе с избранным, средняя оценка складывается из видимых оценок и рецензий.
+	// Возвращает ошибку ErrProfileUserNotFound, если пользователь не найден
+	GetUserStats(ctx context.Context, viewerID, userID int) (*dto.UserStats, error)
+}
+
+var (
+	ErrProfileUserNotFound = errors.New("пользователь не найден")
+)
@@ -0,0 +1,16 @@
+package usecase
+
+import (
+	"context"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_recommendation.go
+type Recommendation interface {
+	// GetRecommendations возвращает до limit рекомендаций для пользователя с объяснением, из-за какого
+	// понравившегося или просмотренного контента они появились. Если пользователь еще ничего не оценил и не
+	// добавил в избранное, рекомендаций нет
+	GetRecommendations(ctx context.Context, userID, limit int) (*dto.Recommendations, error)
+	// RefreshNeighbors пересчитывает похожий контент, по которому строятся рекомендации. Вызывается фоновой задачей
+	RefreshNeighbors(ctx context.Context) error
+}
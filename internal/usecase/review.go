@@ -1,52 +1,70 @@
 package usecase
 
 import (
+	"context"
 	"errors"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
 )
 
 //go:generate mockgen -source=$GOFILE -destination=mocks/mock_review.go
 type Review interface {
-	// GetLatestReviews получение последних рецензий
-	GetLatestReviews(count int) (*dto.ReviewResponseList, error)
-	// GetUserReviews получение рецензий пользователя
-	GetUserReviews(userID, count, page int) (*dto.ReviewResponseList, error)
-	// GetContentReviews получение рецензий на контент
-	GetContentReviews(contentID, count, page int) (*dto.ReviewResponseList, error)
-	// GetReview получение рецензии.
-	// Возвращает ошибку ErrReviewNotFound, если рецензия не найдена
-	GetReview(reviewID int) (*dto.ReviewResponse, error)
+	// GetLatestReviews получение последних рецензий.
+	// Возвращает ошибку ReviewErrorIncorrectData, если фильтр некорректен
+	GetLatestReviews(ctx context.Context, count int, filter dto.ReviewFilter) (*dto.ReviewResponseList, error)
+	// GetUserReviews получение рецензий пользователя.
+	// Возвращает ошибку ReviewErrorIncorrectData, если режим сортировки или фильтр некорректны
+	GetUserReviews(
+		ctx context.Context,
+		userID, count, page int,
+		filter dto.ReviewFilter,
+	) (*dto.ReviewResponseList, error)
+	// GetContentReviews получение рецензий на контент.
+	// Возвращает ошибку ReviewErrorIncorrectData, если режим сортировки или фильтр некорректны
+	GetContentReviews(
+		ctx context.Context,
+		contentID, count, page int,
+		filter dto.ReviewFilter,
+	) (*dto.ReviewResponseList, error)
+	// GetReview получение рецензии. Скрытую модератором рецензию видят только ее автор и модераторы.
+	// Возвращает ошибку ErrReviewNotFound, если рецензия не найдена или скрыта от viewerID
+	GetReview(ctx context.Context, reviewID, viewerID int) (*dto.ReviewResponse, error)
+	// GetReviewHistory получение рецензии вместе с ее предыдущими версиями.
+	// Возвращает ошибку ErrReviewNotFound, если рецензия не найдена или скрыта от viewerID
+	GetReviewHistory(ctx context.Context, reviewID, viewerID int) (*dto.ReviewHistory, error)
+	// GetContentRatingStats получение распределения оценок контента, медианы и взвешенной оценки.
+	// Возвращает ошибку ErrContentNotFound, если контент не найден
+	GetContentRatingStats(ctx context.Context, contentID int) (*dto.ContentRatingStats, error)
 	// GetContentReviewByAuthor получение рецензии на контент от автора.
 	// Возвращает ошибку ErrReviewNotFound, если рецензия не найдена
-	GetContentReviewByAuthor(authorID, contentID int) (*dto.ReviewResponse, error)
+	GetContentReviewByAuthor(ctx context.Context, authorID, contentID int) (*dto.ReviewResponse, error)
 	// CreateReview создание рецензии.
 	// Возможные ошибки:
 	// ErrReviewContentNotFound - контент не найден
 	// ErrReviewAlreadyExists - рецензия уже существует
 	// ReviewErrorIncorrectData - некорректные данные
-	CreateReview(create dto.ReviewCreate) (*dto.ReviewResponse, error)
+	CreateReview(ctx context.Context, create dto.ReviewCreate) (*dto.ReviewResponse, error)
 	// EditReview редактирование рецензии.
 	// Возможные ошибки:
 	// ErrReviewNotFound - рецензия не найдена
 	// ErrReviewForbidden - недостаточно прав для выполнения операции
 	// ReviewErrorIncorrectData - некорректные данные
-	EditReview(update dto.ReviewUpdate) (*dto.ReviewResponse, error)
+	EditReview(ctx context.Context, update dto.ReviewUpdate) (*dto.ReviewResponse, error)
 	// DeleteReview удаление рецензии.
 	// Возможные ошибки:
 	// ErrReviewNotFound - рецензия не найдена
 	// ErrReviewForbidden - недостаточно прав для выполнения операции
-	DeleteReview(reviewID, userID int) error
+	DeleteReview(ctx context.Context, reviewID, userID int) error
 	// VoteReview голосование за рецензию.
 	// Возможные ошибки:
-	// ErrReviewNotFound - рецензия не найдена
+	// ErrReviewNotFound - рецензия не найдена или скрыта модератором
 	// ErrReviewVoteAlreadyExists - голос уже учтен
-	VoteReview(userID, reviewID int, vote bool) error
+	VoteReview(ctx context.Context, userID, reviewID int, vote bool) error
 	// UnVoteReview отмена голоса за рецензию.
 	// Возможные ошибки:
 	// ErrReviewVoteNotFound - рецензия не найдена
-	UnVoteReview(userID, reviewID int) error
+	UnVoteReview(ctx context.Context, userID, reviewID int) error
 	// IsVotedByUser проверка, голосовал ли пользователь за рецензию
-	IsVotedByUser(userID, reviewID int) (int, error)
+	IsVotedByUser(ctx context.Context, userID, reviewID int) (int, error)
 }
 
 // ReviewErrorIncorrectData ошибка некорректных данных
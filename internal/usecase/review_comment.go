@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_review_comment.go
+type ReviewComment interface {
+	// GetReviewComments получение комментариев верхнего уровня к рецензии вместе с ответами на них.
+	// Возвращает ошибку ErrReviewNotFound, если рецензия не найдена или скрыта модератором, а viewerID не ее автор
+	// и не модератор
+	GetReviewComments(ctx context.Context, reviewID, viewerID, count, page int) (*dto.ReviewCommentResponseList, error)
+	// CreateComment создание комментария или ответа на комментарий.
+	// Возможные ошибки:
+	// ErrReviewNotFound - рецензия не найдена или скрыта модератором, а create.UserID не ее автор и не модератор
+	// ErrReviewCommentNotFound - комментарий, на который дается ответ, не найден
+	// ReviewErrorIncorrectData - некорректные данные
+	CreateComment(ctx context.Context, create dto.ReviewCommentCreate) (*dto.ReviewCommentResponse, error)
+	// EditComment редактирование комментария.
+	// Возможные ошибки:
+	// ErrReviewCommentNotFound - комментарий не найден
+	// ErrReviewCommentForbidden - недостаточно прав для выполнения операции
+	// ReviewErrorIncorrectData - некорректные данные
+	EditComment(ctx context.Context, update dto.ReviewCommentUpdate) (*dto.ReviewCommentResponse, error)
+	// DeleteComment удаление комментария вместе с ответами на него.
+	// Возможные ошибки:
+	// ErrReviewCommentNotFound - комментарий не найден
+	// ErrReviewCommentForbidden - недостаточно прав для выполнения операции
+	DeleteComment(ctx context.Context, commentID, userID int) error
+}
+
+var (
+	ErrReviewCommentNotFound  = errors.New("комментарий не найден")
+	ErrReviewCommentForbidden = errors.New("недостаточно прав для выполнения операции")
+	ErrReviewCommentTooDeep   = errors.New("отвечать можно только на комментарии верхнего уровня")
+)
@@ -0,0 +1,35 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_review_moderation.go
+type ReviewModeration interface {
+	// ReportReview жалоба пользователя на рецензию.
+	// Возможные ошибки:
+	// ErrReviewNotFound - рецензия не найдена
+	// ErrReviewReportAlreadyExists - пользователь уже пожаловался на рецензию
+	// ReviewErrorIncorrectData - некорректные данные
+	ReportReview(ctx context.Context, report dto.ReviewReportCreate) error
+	// GetModerationQueue получение рецензий с нерассмотренными жалобами.
+	// Возвращает ошибку ErrModerationForbidden, если пользователь не модератор
+	GetModerationQueue(ctx context.Context, moderatorID, count, page int) (*dto.ModerationQueue, error)
+	// ModerateReview применение решения модератора к рецензии: approve оставляет рецензию видимой, hide скрывает,
+	// delete удаляет. Все жалобы на рецензию при этом считаются рассмотренными.
+	// Возможные ошибки:
+	// ErrModerationForbidden - пользователь не модератор
+	// ErrReviewNotFound - рецензия не найдена
+	// ReviewErrorIncorrectData - некорректные данные
+	ModerateReview(ctx context.Context, decision dto.ModerationDecisionCreate) error
+	// GetModerationLog получение журнала решений модераторов.
+	// Возвращает ошибку ErrModerationForbidden, если пользователь не модератор
+	GetModerationLog(ctx context.Context, moderatorID, count, page int) (*dto.ModerationLog, error)
+}
+
+var (
+	ErrReviewReportAlreadyExists = errors.New("жалоба уже отправлена")
+	ErrModerationForbidden       = errors.New("операция доступна только модераторам")
+)
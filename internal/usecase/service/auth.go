@@ -1,8 +1,10 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
 )
 
@@ -17,24 +19,30 @@ func NewAuthService(sessionRepo repository.Session) usecase.Auth {
 }
 
 // Logout - выход из сессии
-func (a AuthService) Logout(s string) error {
-	if err := a.sessionRepo.DeleteSession(s); err != nil {
+func (a AuthService) Logout(ctx context.Context, s string) error {
+	ctx, span := tracing.Start(ctx, "AuthService.Logout")
+	defer span.End()
+	if err := a.sessionRepo.DeleteSession(ctx, s); err != nil {
 		return err
 	}
 	return nil
 }
 
 // LogoutAll - выход из всех сессий
-func (a AuthService) LogoutAll(userID int) error {
-	if err := a.sessionRepo.DeleteAllSessions(userID); err != nil {
+func (a AuthService) LogoutAll(ctx context.Context, userID int) error {
+	ctx, span := tracing.Start(ctx, "AuthService.LogoutAll")
+	defer span.End()
+	if err := a.sessionRepo.DeleteAllSessions(ctx, userID); err != nil {
 		return err
 	}
 	return nil
 }
 
 // GetUserIDBySession - получение ID пользователя по сессии
-func (a AuthService) GetUserIDBySession(session string) (int, error) {
-	userID, err := a.sessionRepo.CheckSession(session)
+func (a AuthService) GetUserIDBySession(ctx context.Context, session string) (int, error) {
+	ctx, span := tracing.Start(ctx, "AuthService.GetUserIDBySession")
+	defer span.End()
+	userID, err := a.sessionRepo.CheckSession(ctx, session)
 	switch {
 	case errors.Is(err, repository.ErrSessionNotFound):
 		return 0, usecase.ErrSessionNotFound
@@ -46,8 +54,10 @@ func (a AuthService) GetUserIDBySession(session string) (int, error) {
 }
 
 // CreateSession - создание новой сессии
-func (a AuthService) CreateSession(userID int) (string, error) {
-	session, err := a.sessionRepo.NewSession(userID)
+func (a AuthService) CreateSession(ctx context.Context, userID int) (string, error) {
+	ctx, span := tracing.Start(ctx, "AuthService.CreateSession")
+	defer span.End()
+	session, err := a.sessionRepo.NewSession(ctx, userID)
 	if err != nil {
 		return "", err
 	}
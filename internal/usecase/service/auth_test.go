@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	mockrepo "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository/mocks"
 	"github.com/stretchr/testify/require"
@@ -22,7 +23,7 @@ func TestAuth_Logout(t *testing.T) {
 			Input:       "session1",
 			ExpectedErr: nil,
 			SetupSessionRepoMock: func(repo *mockrepo.MockSession) {
-				repo.EXPECT().DeleteSession("session1").Return(nil)
+				repo.EXPECT().DeleteSession(gomock.Any(), "session1").Return(nil)
 			},
 		},
 		{
@@ -30,7 +31,7 @@ func TestAuth_Logout(t *testing.T) {
 			Input:       "session2",
 			ExpectedErr: fmt.Errorf("не удалось удалить сессию"),
 			SetupSessionRepoMock: func(repo *mockrepo.MockSession) {
-				repo.EXPECT().DeleteSession("session2").Return(fmt.Errorf("не удалось удалить сессию"))
+				repo.EXPECT().DeleteSession(gomock.Any(), "session2").Return(fmt.Errorf("не удалось удалить сессию"))
 			},
 		},
 	}
@@ -46,7 +47,7 @@ func TestAuth_Logout(t *testing.T) {
 				sessionRepo: mockSessionRepo,
 			}
 			tc.SetupSessionRepoMock(mockSessionRepo)
-			err := authService.Logout(tc.Input)
+			err := authService.Logout(context.Background(), tc.Input)
 			require.EqualValues(t, tc.ExpectedErr, err)
 		})
 	}
@@ -66,7 +67,7 @@ func TestAuth_LogoutAll(t *testing.T) {
 			Input:       1,
 			ExpectedErr: nil,
 			SetupSessionRepoMock: func(repo *mockrepo.MockSession) {
-				repo.EXPECT().DeleteAllSessions(1).Return(nil)
+				repo.EXPECT().DeleteAllSessions(gomock.Any(), 1).Return(nil)
 			},
 		},
 		{
@@ -74,7 +75,7 @@ func TestAuth_LogoutAll(t *testing.T) {
 			Input:       2,
 			ExpectedErr: fmt.Errorf("не удалось удалить сессии"),
 			SetupSessionRepoMock: func(repo *mockrepo.MockSession) {
-				repo.EXPECT().DeleteAllSessions(2).Return(fmt.Errorf("не удалось удалить сессии"))
+				repo.EXPECT().DeleteAllSessions(gomock.Any(), 2).Return(fmt.Errorf("не удалось удалить сессии"))
 			},
 		},
 	}
@@ -90,7 +91,7 @@ func TestAuth_LogoutAll(t *testing.T) {
 				sessionRepo: mockSessionRepo,
 			}
 			tc.SetupSessionRepoMock(mockSessionRepo)
-			err := authService.LogoutAll(tc.Input)
+			err := authService.LogoutAll(context.Background(), tc.Input)
 			require.EqualValues(t, tc.ExpectedErr, err)
 		})
 	}
@@ -112,7 +113,7 @@ func TestAuth_GetUserIDBySession(t *testing.T) {
 			ExpectedUserID: 1,
 			ExpectedErr:    nil,
 			SetupSessionRepoMock: func(repo *mockrepo.MockSession) {
-				repo.EXPECT().CheckSession("session1").Return(1, nil)
+				repo.EXPECT().CheckSession(gomock.Any(), "session1").Return(1, nil)
 			},
 		},
 		{
@@ -121,7 +122,7 @@ func TestAuth_GetUserIDBySession(t *testing.T) {
 			ExpectedUserID: 0,
 			ExpectedErr:    fmt.Errorf("не удалось проверить сессию"),
 			SetupSessionRepoMock: func(repo *mockrepo.MockSession) {
-				repo.EXPECT().CheckSession("session2").Return(0, fmt.Errorf("не удалось проверить сессию"))
+				repo.EXPECT().CheckSession(gomock.Any(), "session2").Return(0, fmt.Errorf("не удалось проверить сессию"))
 			},
 		},
 	}
@@ -137,7 +138,7 @@ func TestAuth_GetUserIDBySession(t *testing.T) {
 				sessionRepo: mockSessionRepo,
 			}
 			tc.SetupSessionRepoMock(mockSessionRepo)
-			userID, err := authService.GetUserIDBySession(tc.Input)
+			userID, err := authService.GetUserIDBySession(context.Background(), tc.Input)
 			require.EqualValues(t, tc.ExpectedUserID, userID)
 			require.EqualValues(t, tc.ExpectedErr, err)
 		})
@@ -160,7 +161,7 @@ func TestAuth_CreateSession(t *testing.T) {
 			ExpectedSession: "session1",
 			ExpectedErr:     nil,
 			SetupSessionRepoMock: func(repo *mockrepo.MockSession) {
-				repo.EXPECT().NewSession(1).Return("session1", nil)
+				repo.EXPECT().NewSession(gomock.Any(), 1).Return("session1", nil)
 			},
 		},
 		{
@@ -169,7 +170,7 @@ func TestAuth_CreateSession(t *testing.T) {
 			ExpectedSession: "",
 			ExpectedErr:     fmt.Errorf("не удалось создать сессию"),
 			SetupSessionRepoMock: func(repo *mockrepo.MockSession) {
-				repo.EXPECT().NewSession(2).Return("", fmt.Errorf("не удалось создать сессию"))
+				repo.EXPECT().NewSession(gomock.Any(), 2).Return("", fmt.Errorf("не удалось создать сессию"))
 			},
 		},
 	}
@@ -185,7 +186,7 @@ func TestAuth_CreateSession(t *testing.T) {
 				sessionRepo: mockSessionRepo,
 			}
 			tc.SetupSessionRepoMock(mockSessionRepo)
-			session, err := authService.CreateSession(tc.Input)
+			session, err := authService.CreateSession(context.Background(), tc.Input)
 			require.EqualValues(t, tc.ExpectedSession, session)
 			require.EqualValues(t, tc.ExpectedErr, err)
 		})
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+)
+
+const (
+	chartContentLimit = 10
+)
+
+// chartLimits - размеры вычисляемых подборок
+var chartLimits = entity.ChartLimits{
+	Trending: 100,
+	Top:      250,
+	Genre:    100,
+	Decade:   100,
+}
+
+type ChartService struct {
+	chartRepo repository.Chart
+	contentUC usecase.Content
+}
+
+func NewChartService(chartRepo repository.Chart, contentUC usecase.Content) usecase.Chart {
+	return &ChartService{
+		chartRepo: chartRepo,
+		contentUC: contentUC,
+	}
+}
+
+// GetChart возвращает страницу вычисляемой подборки. У вычисляемых подборок нет своего постера, поэтому постером
+// подборки становится постер первого контента на странице
+func (c *ChartService) GetChart(ctx context.Context, key string, page int) (*dto.CompilationResponse, error) {
+	ctx, span := tracing.Start(ctx, "ChartService.GetChart")
+	defer span.End()
+	chart, err := c.chartRepo.GetChart(ctx, key)
+	switch {
+	case errors.Is(err, repository.ErrChartNotFound):
+		return nil, usecase.ErrChartNotFound
+	case err != nil:
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении подборки"), err)
+	}
+	contentIDs, err := c.chartRepo.GetChartContent(ctx, key, page, chartContentLimit)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении контента подборки"), err)
+	}
+	content, err := c.contentUC.GetPreviewContents(ctx, contentIDs)
+	switch {
+	case errors.Is(err, usecase.ErrContentNotFound):
+		return nil, usecase.ErrContentNotFound
+	case err != nil:
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении контента"), err)
+	}
+	contentTotal, err := c.chartRepo.GetChartContentLength(ctx, key)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении количества контента подборки"), err)
+	}
+	compilationDTO := dto.Compilation{
+		Title: chart.Title,
+	}
+	if len(content) > 0 {
+		compilationDTO.PosterURL = content[0].Poster
+	}
+	return &dto.CompilationResponse{
+		Compilation:   compilationDTO,
+		Content:       content,
+		ContentLength: contentTotal,
+		Page:          page,
+		PerPage:       chartContentLimit,
+		TotalPages:    (contentTotal + chartContentLimit - 1) / chartContentLimit,
+	}, nil
+}
+
+func (c *ChartService) RefreshCharts(ctx context.Context) error {
+	ctx, span := tracing.Start(ctx, "ChartService.RefreshCharts")
+	defer span.End()
+	if err := c.chartRepo.RefreshCharts(ctx, chartLimits); err != nil {
+		return entity.UsecaseWrap(errors.New("ошибка при пересчете подборок"), err)
+	}
+	return nil
+}
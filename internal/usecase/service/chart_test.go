@@ -0,0 +1,140 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	mockrepo "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository/mocks"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	mock_usecase "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestChartService_GetChart(t *testing.T) {
+	t.Parallel()
+
+	chart := &entity.Chart{Key: entity.ChartTopMovies, Title: "250 лучших фильмов"}
+	previews := []*dto.PreviewContent{
+		{ID: 2, Title: "Побег из Шоушенка", Poster: "/static/shawshank.jpg"},
+		{ID: 1, Title: "Зеленая миля", Poster: "/static/green_mile.jpg"},
+	}
+	testCases := []struct {
+		Name               string
+		ExpectedErr        error
+		ExpectedOutput     *dto.CompilationResponse
+		SetupChartRepoMock func(repo *mockrepo.MockChart)
+		SetupContentUCMock func(uc *mock_usecase.MockContent)
+	}{
+		{
+			Name: "Страница подборки",
+			ExpectedOutput: &dto.CompilationResponse{
+				Compilation:   dto.Compilation{Title: "250 лучших фильмов", PosterURL: "/static/shawshank.jpg"},
+				Content:       previews,
+				ContentLength: 250,
+				Page:          1,
+				PerPage:       chartContentLimit,
+				TotalPages:    25,
+			},
+			SetupChartRepoMock: func(repo *mockrepo.MockChart) {
+				repo.EXPECT().GetChart(gomock.Any(), entity.ChartTopMovies).Return(chart, nil)
+				repo.EXPECT().GetChartContent(gomock.Any(), entity.ChartTopMovies, 1, chartContentLimit).
+					Return([]int{2, 1}, nil)
+				repo.EXPECT().GetChartContentLength(gomock.Any(), entity.ChartTopMovies).Return(250, nil)
+			},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {
+				uc.EXPECT().GetPreviewContents(gomock.Any(), []int{2, 1}).Return(previews, nil)
+			},
+		},
+		{
+			Name: "Пустая страница",
+			ExpectedOutput: &dto.CompilationResponse{
+				Compilation:   dto.Compilation{Title: "250 лучших фильмов"},
+				Content:       []*dto.PreviewContent{},
+				ContentLength: 250,
+				Page:          1,
+				PerPage:       chartContentLimit,
+				TotalPages:    25,
+			},
+			SetupChartRepoMock: func(repo *mockrepo.MockChart) {
+				repo.EXPECT().GetChart(gomock.Any(), entity.ChartTopMovies).Return(chart, nil)
+				repo.EXPECT().GetChartContent(gomock.Any(), entity.ChartTopMovies, 1, chartContentLimit).
+					Return([]int{}, nil)
+				repo.EXPECT().GetChartContentLength(gomock.Any(), entity.ChartTopMovies).Return(250, nil)
+			},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {
+				uc.EXPECT().GetPreviewContents(gomock.Any(), []int{}).Return([]*dto.PreviewContent{}, nil)
+			},
+		},
+		{
+			Name:        "Подборка не найдена",
+			ExpectedErr: usecase.ErrChartNotFound,
+			SetupChartRepoMock: func(repo *mockrepo.MockChart) {
+				repo.EXPECT().GetChart(gomock.Any(), entity.ChartTopMovies).Return(nil, repository.ErrChartNotFound)
+			},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockChartRepo := mockrepo.NewMockChart(ctrl)
+			mockContentUC := mock_usecase.NewMockContent(ctrl)
+			tc.SetupChartRepoMock(mockChartRepo)
+			tc.SetupContentUCMock(mockContentUC)
+			output, err := NewChartService(mockChartRepo, mockContentUC).
+				GetChart(context.Background(), entity.ChartTopMovies, 1)
+			require.ErrorIs(t, err, tc.ExpectedErr)
+			require.Equal(t, tc.ExpectedOutput, output)
+		})
+	}
+}
+
+func TestChartService_RefreshCharts(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name               string
+		ExpectedErr        bool
+		SetupChartRepoMock func(repo *mockrepo.MockChart)
+	}{
+		{
+			Name: "Подборки пересчитаны",
+			SetupChartRepoMock: func(repo *mockrepo.MockChart) {
+				repo.EXPECT().RefreshCharts(gomock.Any(), chartLimits).Return(nil)
+			},
+		},
+		{
+			Name:        "Ошибка при пересчете",
+			ExpectedErr: true,
+			SetupChartRepoMock: func(repo *mockrepo.MockChart) {
+				repo.EXPECT().RefreshCharts(gomock.Any(), chartLimits).Return(errors.New("database error"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockChartRepo := mockrepo.NewMockChart(ctrl)
+			tc.SetupChartRepoMock(mockChartRepo)
+			err := NewChartService(mockChartRepo, mock_usecase.NewMockContent(ctrl)).RefreshCharts(context.Background())
+			if tc.ExpectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
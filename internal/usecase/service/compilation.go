@@ -1,10 +1,12 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
 )
 
@@ -31,8 +33,11 @@ func NewCompilationService(
 }
 
 // compilationEntityToDTO конвертирует entity.Compilation в dto.Compilation добавляя поле длины контента в подборке
-func (c *CompilationService) compilationEntityToDTO(compEntity *entity.Compilation) (*dto.Compilation, error) {
-	posterURL, err := c.staticUC.GetStatic(compEntity.PosterUploadID)
+func (c *CompilationService) compilationEntityToDTO(
+	ctx context.Context,
+	compEntity *entity.Compilation,
+) (*dto.Compilation, error) {
+	posterURL, err := c.staticUC.GetStatic(ctx, compEntity.PosterUploadID)
 	switch {
 	case errors.Is(err, usecase.ErrStaticNotFound):
 		posterURL = ""
@@ -59,13 +64,13 @@ func (c *CompilationService) compilationTypeDTOToEntity(
 }
 
 // compilationEntitiesToDTO конвертирует массив entity.Compilation в массив dto.Compilation
-func (c *CompilationService) compilationEntitiesToDTO(compEntities []*entity.Compilation) (
+func (c *CompilationService) compilationEntitiesToDTO(ctx context.Context, compEntities []*entity.Compilation) (
 	*dto.CompilationResponseList,
 	error,
 ) {
 	compDTOs := make([]dto.Compilation, len(compEntities))
 	for i, compEntity := range compEntities {
-		compDTO, err := c.compilationEntityToDTO(compEntity)
+		compDTO, err := c.compilationEntityToDTO(ctx, compEntity)
 		if err != nil {
 			return nil, err
 		}
@@ -85,56 +90,61 @@ func (c *CompilationService) compilationTypeEntitiesToDTO(
 }
 
 // GetCompilationTypes возвращает список типов подборок
-func (c *CompilationService) GetCompilationTypes() (*dto.CompilationTypeResponseList, error) {
-	compTypes, err := c.compilationRepo.GetAllCompilationTypes()
+func (c *CompilationService) GetCompilationTypes(ctx context.Context) (*dto.CompilationTypeResponseList, error) {
+	ctx, span := tracing.Start(ctx, "CompilationService.GetCompilationTypes")
+	defer span.End()
+	compTypes, err := c.compilationRepo.GetAllCompilationTypes(ctx)
 	if err != nil {
 		return nil, entity.UsecaseWrap(errors.New("ошибка при получении типов подборок"), err)
 	}
 	return c.compilationTypeEntitiesToDTO(compTypes), nil
 }
 
-func (c *CompilationService) GetCompilationsByCompilationType(compTypeID int) (
+func (c *CompilationService) GetCompilationsByCompilationType(ctx context.Context, compTypeID int) (
 	*dto.CompilationResponseList,
 	error,
 ) {
-	compEntities, err := c.compilationRepo.GetCompilationsByTypeID(compTypeID)
+	ctx, span := tracing.Start(ctx, "CompilationService.GetCompilationsByCompilationType")
+	defer span.End()
+	compEntities, err := c.compilationRepo.GetCompilationsByTypeID(ctx, compTypeID)
 	if err != nil {
 		return nil, entity.UsecaseWrap(errors.New("ошибка при получении подборок по типу"), err)
 	}
-	return c.compilationEntitiesToDTO(compEntities)
+	return c.compilationEntitiesToDTO(ctx, compEntities)
 }
 
-func (c *CompilationService) GetCompilationContent(compID, page int) (*dto.CompilationResponse, error) {
-	compilation, err := c.compilationRepo.GetCompilation(compID)
+func (c *CompilationService) GetCompilationContent(
+	ctx context.Context,
+	compID, page int,
+) (*dto.CompilationResponse, error) {
+	ctx, span := tracing.Start(ctx, "CompilationService.GetCompilationContent")
+	defer span.End()
+	compilation, err := c.compilationRepo.GetCompilation(ctx, compID)
 	switch {
 	case errors.Is(err, repository.ErrCompilationNotFound):
 		return nil, usecase.ErrCompilationNotFound
 	case err != nil:
 		return nil, entity.UsecaseWrap(errors.New("ошибка при получении подборки"), err)
 	}
-	contentIDs, err := c.compilationRepo.GetCompilationContent(compID, page, compilationContentLimit)
+	contentIDs, err := c.compilationRepo.GetCompilationContent(ctx, compID, page, compilationContentLimit)
 	switch {
 	case errors.Is(err, repository.ErrCompilationNotFound):
 		return nil, usecase.ErrCompilationNotFound
 	case err != nil:
 		return nil, entity.UsecaseWrap(errors.New("ошибка при получении контента подборки"), err)
 	}
-	content := make([]*dto.PreviewContent, len(contentIDs))
-	for index, contentID := range contentIDs {
-		contentEntity, err := c.contentUC.GetPreviewContentByID(contentID)
-		switch {
-		case errors.Is(err, usecase.ErrContentNotFound):
-			return nil, usecase.ErrContentNotFound
-		case err != nil:
-			return nil, entity.UsecaseWrap(errors.New("ошибка при получении контента"), err)
-		}
-		content[index] = contentEntity
+	content, err := c.contentUC.GetPreviewContents(ctx, contentIDs)
+	switch {
+	case errors.Is(err, usecase.ErrContentNotFound):
+		return nil, usecase.ErrContentNotFound
+	case err != nil:
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении контента"), err)
 	}
-	contentTotal, err := c.compilationRepo.GetCompilationContentLength(compID)
+	contentTotal, err := c.compilationRepo.GetCompilationContentLength(ctx, compID)
 	if err != nil {
 		return nil, entity.UsecaseWrap(errors.New("ошибка при получении количества контента подборки"), err)
 	}
-	compilationDTO, err := c.compilationEntityToDTO(compilation)
+	compilationDTO, err := c.compilationEntityToDTO(ctx, compilation)
 	if err != nil {
 		return nil, err
 	}
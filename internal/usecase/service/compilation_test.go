@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
@@ -33,7 +34,7 @@ func TestCompilationService_GetCompilationTypes(t *testing.T) {
 				},
 			},
 			SetupCompilationRepoMock: func(repo *mockrepo.MockCompilation) {
-				repo.EXPECT().GetAllCompilationTypes().Return([]entity.CompilationType{
+				repo.EXPECT().GetAllCompilationTypes(gomock.Any()).Return([]entity.CompilationType{
 					{ID: 1, Name: "Type1"},
 					{ID: 2, Name: "Type2"},
 				}, nil)
@@ -44,7 +45,7 @@ func TestCompilationService_GetCompilationTypes(t *testing.T) {
 			ExpectedErr:    entity.UsecaseWrap(errors.New("ошибка при получении типов подборок"), errors.New("unexpected error")),
 			ExpectedOutput: nil,
 			SetupCompilationRepoMock: func(repo *mockrepo.MockCompilation) {
-				repo.EXPECT().GetAllCompilationTypes().Return(nil, errors.New("unexpected error"))
+				repo.EXPECT().GetAllCompilationTypes(gomock.Any()).Return(nil, errors.New("unexpected error"))
 			},
 		},
 	}
@@ -60,7 +61,7 @@ func TestCompilationService_GetCompilationTypes(t *testing.T) {
 			mockStaticUC := mock_usecase.NewMockStatic(ctrl)
 			tc.SetupCompilationRepoMock(mockCompilationRepo)
 			compService := NewCompilationService(mockCompilationRepo, mockStaticUC, mockContentUC)
-			result, err := compService.GetCompilationTypes()
+			result, err := compService.GetCompilationTypes(context.Background())
 			require.Equal(t, tc.ExpectedErr, err)
 			require.Equal(t, tc.ExpectedOutput, result)
 		})
@@ -83,7 +84,7 @@ func TestCompilationService_GetCompilationsByCompilationType(t *testing.T) {
 			ExpectedErr:    entity.UsecaseWrap(errors.New("ошибка при получении подборок по типу"), errors.New("unexpected error")),
 			ExpectedOutput: nil,
 			SetupCompilationRepoMock: func(repo *mockrepo.MockCompilation) {
-				repo.EXPECT().GetCompilationsByTypeID(1).Return(nil, errors.New("unexpected error"))
+				repo.EXPECT().GetCompilationsByTypeID(gomock.Any(), 1).Return(nil, errors.New("unexpected error"))
 			},
 		},
 	}
@@ -99,7 +100,7 @@ func TestCompilationService_GetCompilationsByCompilationType(t *testing.T) {
 			mockStaticUC := mock_usecase.NewMockStatic(ctrl)
 			tc.SetupCompilationRepoMock(mockCompilationRepo)
 			compService := NewCompilationService(mockCompilationRepo, mockStaticUC, mockContentUC)
-			result, err := compService.GetCompilationsByCompilationType(tc.CompTypeID)
+			result, err := compService.GetCompilationsByCompilationType(context.Background(), tc.CompTypeID)
 			require.Equal(t, tc.ExpectedErr, err)
 			if tc.ExpectedErr == nil {
 				require.Equal(t, tc.ExpectedOutput, result)
@@ -126,7 +127,7 @@ func TestCompilationService_GetCompilationContent(t *testing.T) {
 			ExpectedErr:    usecase.ErrCompilationNotFound,
 			ExpectedOutput: nil,
 			SetupMock: func(compRepo *mockrepo.MockCompilation, contentUC *mock_usecase.MockContent, staticUC *mock_usecase.MockStatic) {
-				compRepo.EXPECT().GetCompilation(1).Return(nil, repository.ErrCompilationNotFound)
+				compRepo.EXPECT().GetCompilation(gomock.Any(), 1).Return(nil, repository.ErrCompilationNotFound)
 			},
 		},
 		{
@@ -136,7 +137,7 @@ func TestCompilationService_GetCompilationContent(t *testing.T) {
 			ExpectedErr:    entity.UsecaseWrap(errors.New("ошибка при получении подборки"), errors.New("unexpected error")),
 			ExpectedOutput: nil,
 			SetupMock: func(compRepo *mockrepo.MockCompilation, contentUC *mock_usecase.MockContent, staticUC *mock_usecase.MockStatic) {
-				compRepo.EXPECT().GetCompilation(1).Return(nil, errors.New("unexpected error"))
+				compRepo.EXPECT().GetCompilation(gomock.Any(), 1).Return(nil, errors.New("unexpected error"))
 			},
 		},
 		{
@@ -146,8 +147,8 @@ func TestCompilationService_GetCompilationContent(t *testing.T) {
 			ExpectedErr:    usecase.ErrCompilationNotFound,
 			ExpectedOutput: nil,
 			SetupMock: func(compRepo *mockrepo.MockCompilation, contentUC *mock_usecase.MockContent, staticUC *mock_usecase.MockStatic) {
-				compRepo.EXPECT().GetCompilation(1).Return(&entity.Compilation{}, nil)
-				compRepo.EXPECT().GetCompilationContent(1, 1, compilationContentLimit).Return(nil, repository.ErrCompilationNotFound)
+				compRepo.EXPECT().GetCompilation(gomock.Any(), 1).Return(&entity.Compilation{}, nil)
+				compRepo.EXPECT().GetCompilationContent(gomock.Any(), 1, 1, compilationContentLimit).Return(nil, repository.ErrCompilationNotFound)
 			},
 		},
 		{
@@ -157,8 +158,8 @@ func TestCompilationService_GetCompilationContent(t *testing.T) {
 			ExpectedErr:    entity.UsecaseWrap(errors.New("ошибка при получении контента подборки"), errors.New("unexpected error")),
 			ExpectedOutput: nil,
 			SetupMock: func(compRepo *mockrepo.MockCompilation, contentUC *mock_usecase.MockContent, staticUC *mock_usecase.MockStatic) {
-				compRepo.EXPECT().GetCompilation(1).Return(&entity.Compilation{}, nil)
-				compRepo.EXPECT().GetCompilationContent(1, 1, compilationContentLimit).Return(nil, errors.New("unexpected error"))
+				compRepo.EXPECT().GetCompilation(gomock.Any(), 1).Return(&entity.Compilation{}, nil)
+				compRepo.EXPECT().GetCompilationContent(gomock.Any(), 1, 1, compilationContentLimit).Return(nil, errors.New("unexpected error"))
 			},
 		},
 		{
@@ -168,9 +169,9 @@ func TestCompilationService_GetCompilationContent(t *testing.T) {
 			ExpectedErr:    usecase.ErrContentNotFound,
 			ExpectedOutput: nil,
 			SetupMock: func(compRepo *mockrepo.MockCompilation, contentUC *mock_usecase.MockContent, staticUC *mock_usecase.MockStatic) {
-				compRepo.EXPECT().GetCompilation(1).Return(&entity.Compilation{}, nil)
-				compRepo.EXPECT().GetCompilationContent(1, 1, compilationContentLimit).Return([]int{1}, nil)
-				contentUC.EXPECT().GetPreviewContentByID(1).Return(nil, usecase.ErrContentNotFound)
+				compRepo.EXPECT().GetCompilation(gomock.Any(), 1).Return(&entity.Compilation{}, nil)
+				compRepo.EXPECT().GetCompilationContent(gomock.Any(), 1, 1, compilationContentLimit).Return([]int{1}, nil)
+				contentUC.EXPECT().GetPreviewContents(gomock.Any(), []int{1}).Return(nil, usecase.ErrContentNotFound)
 			},
 		},
 		{
@@ -180,9 +181,9 @@ func TestCompilationService_GetCompilationContent(t *testing.T) {
 			ExpectedErr:    entity.UsecaseWrap(errors.New("ошибка при получении контента"), errors.New("unexpected error")),
 			ExpectedOutput: nil,
 			SetupMock: func(compRepo *mockrepo.MockCompilation, contentUC *mock_usecase.MockContent, staticUC *mock_usecase.MockStatic) {
-				compRepo.EXPECT().GetCompilation(1).Return(&entity.Compilation{}, nil)
-				compRepo.EXPECT().GetCompilationContent(1, 1, compilationContentLimit).Return([]int{1}, nil)
-				contentUC.EXPECT().GetPreviewContentByID(1).Return(nil, errors.New("unexpected error"))
+				compRepo.EXPECT().GetCompilation(gomock.Any(), 1).Return(&entity.Compilation{}, nil)
+				compRepo.EXPECT().GetCompilationContent(gomock.Any(), 1, 1, compilationContentLimit).Return([]int{1}, nil)
+				contentUC.EXPECT().GetPreviewContents(gomock.Any(), []int{1}).Return(nil, errors.New("unexpected error"))
 			},
 		},
 		{
@@ -192,10 +193,10 @@ func TestCompilationService_GetCompilationContent(t *testing.T) {
 			ExpectedErr:    entity.UsecaseWrap(errors.New("ошибка при получении количества контента подборки"), errors.New("unexpected error")),
 			ExpectedOutput: nil,
 			SetupMock: func(compRepo *mockrepo.MockCompilation, contentUC *mock_usecase.MockContent, staticUC *mock_usecase.MockStatic) {
-				compRepo.EXPECT().GetCompilation(1).Return(&entity.Compilation{}, nil)
-				compRepo.EXPECT().GetCompilationContent(1, 1, compilationContentLimit).Return([]int{1}, nil)
-				contentUC.EXPECT().GetPreviewContentByID(1).Return(&dto.PreviewContent{}, nil)
-				compRepo.EXPECT().GetCompilationContentLength(1).Return(1, errors.New("unexpected error"))
+				compRepo.EXPECT().GetCompilation(gomock.Any(), 1).Return(&entity.Compilation{}, nil)
+				compRepo.EXPECT().GetCompilationContent(gomock.Any(), 1, 1, compilationContentLimit).Return([]int{1}, nil)
+				contentUC.EXPECT().GetPreviewContents(gomock.Any(), []int{1}).Return([]*dto.PreviewContent{{}}, nil)
+				compRepo.EXPECT().GetCompilationContentLength(gomock.Any(), 1).Return(1, errors.New("unexpected error"))
 			},
 		},
 		{
@@ -219,11 +220,11 @@ func TestCompilationService_GetCompilationContent(t *testing.T) {
 				TotalPages:    1,
 			},
 			SetupMock: func(compRepo *mockrepo.MockCompilation, contentUC *mock_usecase.MockContent, staticUC *mock_usecase.MockStatic) {
-				compRepo.EXPECT().GetCompilation(1).Return(&entity.Compilation{ID: 1, CompilationTypeID: 1, PosterUploadID: 1}, nil)
-				compRepo.EXPECT().GetCompilationContent(1, 1, compilationContentLimit).Return([]int{1}, nil)
-				contentUC.EXPECT().GetPreviewContentByID(1).Return(&dto.PreviewContent{ID: 1}, nil)
-				compRepo.EXPECT().GetCompilationContentLength(1).Return(1, nil)
-				staticUC.EXPECT().GetStatic(1).Return("", nil)
+				compRepo.EXPECT().GetCompilation(gomock.Any(), 1).Return(&entity.Compilation{ID: 1, CompilationTypeID: 1, PosterUploadID: 1}, nil)
+				compRepo.EXPECT().GetCompilationContent(gomock.Any(), 1, 1, compilationContentLimit).Return([]int{1}, nil)
+				contentUC.EXPECT().GetPreviewContents(gomock.Any(), []int{1}).Return([]*dto.PreviewContent{{ID: 1}}, nil)
+				compRepo.EXPECT().GetCompilationContentLength(gomock.Any(), 1).Return(1, nil)
+				staticUC.EXPECT().GetStatic(gomock.Any(), 1).Return("", nil)
 			},
 		},
 	}
@@ -239,7 +240,7 @@ func TestCompilationService_GetCompilationContent(t *testing.T) {
 			mockStaticUC := mock_usecase.NewMockStatic(ctrl)
 			tc.SetupMock(mockCompilationRepo, mockContentUC, mockStaticUC)
 			compService := NewCompilationService(mockCompilationRepo, mockStaticUC, mockContentUC)
-			result, err := compService.GetCompilationContent(tc.CompID, tc.Page)
+			result, err := compService.GetCompilationContent(context.Background(), tc.CompID, tc.Page)
 			require.Equal(t, tc.ExpectedErr, err)
 			if tc.ExpectedErr == nil {
 				require.Equal(t, tc.ExpectedOutput, result)
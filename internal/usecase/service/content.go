@@ -1,10 +1,12 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
 	"time"
 )
@@ -74,11 +76,15 @@ func seriesEntityToDTO(seriesEntity entity.Series) dto.SeriesContent {
 				EpisodeNumber: episode.EpisodeNumber,
 				Title:         episode.Title,
 				Duration:      episode.Duration,
+				Rating:        episode.Rating,
+				RatingCount:   episode.RatingCount,
 			}
 		}
 		seasons[seasonIndex] = dto.Season{
-			ID:       season.ID,
-			Episodes: episodes,
+			ID:          season.ID,
+			Rating:      season.Rating,
+			RatingCount: season.RatingCount,
+			Episodes:    episodes,
 		}
 	}
 	return dto.SeriesContent{
@@ -90,15 +96,17 @@ func seriesEntityToDTO(seriesEntity entity.Series) dto.SeriesContent {
 }
 
 // GetContentByID возвращает dto.Content по его ID
-func (c *ContentService) GetContentByID(id int) (*dto.Content, error) {
-	contentEntity, err := c.contentRepo.GetContent(id)
+func (c *ContentService) GetContentByID(ctx context.Context, id int) (*dto.Content, error) {
+	ctx, span := tracing.Start(ctx, "ContentService.GetContentByID")
+	defer span.End()
+	contentEntity, err := c.contentRepo.GetContent(ctx, id)
 	switch {
 	case errors.Is(err, repository.ErrContentNotFound):
 		return nil, usecase.ErrContentNotFound
 	case err != nil:
 		return nil, entity.UsecaseWrap(errors.New("ошибка при получении контента"), err)
 	}
-	posterURL, err := c.staticUC.GetStatic(contentEntity.PosterStaticID)
+	posterURL, err := c.staticUC.GetStatic(ctx, contentEntity.PosterStaticID)
 	switch {
 	case errors.Is(err, usecase.ErrStaticNotFound):
 		// Если постер не найден, возвращаем пустую строку
@@ -106,7 +114,7 @@ func (c *ContentService) GetContentByID(id int) (*dto.Content, error) {
 	case err != nil:
 		return nil, entity.UsecaseWrap(errors.New("ошибка при получении постера"), err)
 	}
-	backdropURL, err := c.staticUC.GetStatic(contentEntity.BackdropStaticID)
+	backdropURL, err := c.staticUC.GetStatic(ctx, contentEntity.BackdropStaticID)
 	switch {
 	case errors.Is(err, usecase.ErrStaticNotFound):
 		// Если фоновое изображение не найдено, возвращаем пустую строку
@@ -116,7 +124,7 @@ func (c *ContentService) GetContentByID(id int) (*dto.Content, error) {
 	}
 	pictures := make([]string, len(contentEntity.PicturesStaticID))
 	for index, pictureID := range contentEntity.PicturesStaticID {
-		pictureURL, err := c.staticUC.GetStatic(pictureID)
+		pictureURL, err := c.staticUC.GetStatic(ctx, pictureID)
 		switch {
 		case errors.Is(err, usecase.ErrStaticNotFound):
 			// Если изображение не найдено, возвращаем пустую строку
@@ -126,13 +134,13 @@ func (c *ContentService) GetContentByID(id int) (*dto.Content, error) {
 		}
 		pictures[index] = pictureURL
 	}
-	similarContentEntities, err := c.contentRepo.GetSimilarContent(contentEntity.ID)
+	similarContentEntities, err := c.contentRepo.GetSimilarContent(ctx, contentEntity.ID)
 	if err != nil {
 		return nil, entity.UsecaseWrap(errors.New("ошибка при получении похожего контента"), err)
 	}
 	similarContent := make([]dto.PreviewContentCardVertical, len(similarContentEntities))
 	for index, similarContentEntity := range similarContentEntities {
-		posterURL, err := c.staticUC.GetStatic(similarContentEntity.PosterStaticID)
+		posterURL, err := c.staticUC.GetStatic(ctx, similarContentEntity.PosterStaticID)
 		switch {
 		case errors.Is(err, usecase.ErrStaticNotFound):
 			// Если постер не найден, возвращаем пустую строку
@@ -189,15 +197,17 @@ func (c *ContentService) GetContentByID(id int) (*dto.Content, error) {
 }
 
 // GetPersonByID возвращает dto.Person по его ID
-func (c *ContentService) GetPersonByID(id int) (*dto.Person, error) {
-	personEntity, err := c.contentRepo.GetPerson(id)
+func (c *ContentService) GetPersonByID(ctx context.Context, id int) (*dto.Person, error) {
+	ctx, span := tracing.Start(ctx, "ContentService.GetPersonByID")
+	defer span.End()
+	personEntity, err := c.contentRepo.GetPerson(ctx, id)
 	switch {
 	case errors.Is(err, repository.ErrPersonNotFound):
 		return nil, usecase.ErrPersonNotFound
 	case err != nil:
 		return nil, entity.UsecaseWrap(errors.New("ошибка при получении персоны"), err)
 	}
-	photo, err := c.staticUC.GetStatic(personEntity.GetPhotoStaticID())
+	photo, err := c.staticUC.GetStatic(ctx, personEntity.GetPhotoStaticID())
 	switch {
 	case errors.Is(err, usecase.ErrStaticNotFound):
 		// Если фото не найдено, возвращаем пустую строку
@@ -221,20 +231,20 @@ func (c *ContentService) GetPersonByID(id int) (*dto.Person, error) {
 	if personEntity.Height.Valid {
 		personDTO.Height = int(personEntity.Height.Int64)
 	}
-	contentRoles, err := c.contentRepo.GetPersonRoles(personEntity.ID)
+	contentRoles, err := c.contentRepo.GetPersonRoles(ctx, personEntity.ID)
 	if err != nil {
 		return nil, entity.UsecaseWrap(errors.New("ошибка при получении ролей персоны"), err)
 	}
 	personDTO.Roles = make(map[string][]dto.PreviewContentCardVertical, len(contentRoles))
 	for _, role := range contentRoles {
-		content, err := c.contentRepo.GetPreviewContent(role.ContentID)
+		content, err := c.contentRepo.GetPreviewContent(ctx, role.ContentID)
 		switch {
 		case errors.Is(err, repository.ErrContentNotFound):
 			continue
 		case err != nil:
 			return nil, entity.UsecaseWrap(errors.New("ошибка при получении контента"), err)
 		}
-		posterURL, err := c.staticUC.GetStatic(content.PosterStaticID)
+		posterURL, err := c.staticUC.GetStatic(ctx, content.PosterStaticID)
 		switch {
 		case errors.Is(err, usecase.ErrStaticNotFound):
 			// Если постер не найден, возвращаем пустую строку
@@ -265,15 +275,17 @@ func (c *ContentService) GetPersonByID(id int) (*dto.Person, error) {
 	return &personDTO, nil
 }
 
-func (c *ContentService) GetPreviewPersonByID(id int) (*dto.PersonPreviewWithPhoto, error) {
-	personEntity, err := c.contentRepo.GetPerson(id)
+func (c *ContentService) GetPreviewPersonByID(ctx context.Context, id int) (*dto.PersonPreviewWithPhoto, error) {
+	ctx, span := tracing.Start(ctx, "ContentService.GetPreviewPersonByID")
+	defer span.End()
+	personEntity, err := c.contentRepo.GetPerson(ctx, id)
 	switch {
 	case errors.Is(err, repository.ErrPersonNotFound):
 		return nil, usecase.ErrPersonNotFound
 	case err != nil:
 		return nil, entity.UsecaseWrap(errors.New("ошибка при получении персоны"), err)
 	}
-	photo, err := c.staticUC.GetStatic(personEntity.GetPhotoStaticID())
+	photo, err := c.staticUC.GetStatic(ctx, personEntity.GetPhotoStaticID())
 	switch {
 	case errors.Is(err, usecase.ErrStaticNotFound):
 		// Если фото не найдено, возвращаем пустую строку
@@ -291,15 +303,79 @@ func (c *ContentService) GetPreviewPersonByID(id int) (*dto.PersonPreviewWithPho
 }
 
 // GetPreviewContentByID возвращает dto.PreviewContent по его ID
-func (c *ContentService) GetPreviewContentByID(id int) (*dto.PreviewContent, error) {
-	contentEntity, err := c.contentRepo.GetPreviewContent(id)
+func (c *ContentService) GetPreviewContentByID(ctx context.Context, id int) (*dto.PreviewContent, error) {
+	ctx, span := tracing.Start(ctx, "ContentService.GetPreviewContentByID")
+	defer span.End()
+	contentEntity, err := c.contentRepo.GetPreviewContent(ctx, id)
 	switch {
 	case errors.Is(err, repository.ErrContentNotFound):
 		return nil, usecase.ErrContentNotFound
 	case err != nil:
 		return nil, entity.UsecaseWrap(errors.New("ошибка при получении контента"), err)
 	}
-	posterURL, err := c.staticUC.GetStatic(contentEntity.PosterStaticID)
+	return c.previewContentEntityToDTO(ctx, contentEntity)
+}
+
+// GetBestEpisodes возвращает до 10 оцененных эпизодов сериала, начиная с лучших. У фильмов и сериалов без
+// оценок эпизодов список пустой
+func (c *ContentService) GetBestEpisodes(ctx context.Context, contentID int) (*dto.BestEpisodes, error) {
+	ctx, span := tracing.Start(ctx, "ContentService.GetBestEpisodes")
+	defer span.End()
+	if _, err := c.contentRepo.GetPreviewContent(ctx, contentID); err != nil {
+		if errors.Is(err, repository.ErrContentNotFound) {
+			return nil, usecase.ErrContentNotFound
+		}
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении контента"), err)
+	}
+	episodes, err := c.contentRepo.GetBestEpisodes(ctx, contentID, 10)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении лучших эпизодов"), err)
+	}
+	best := &dto.BestEpisodes{Episodes: make([]dto.SeasonEpisode, len(episodes))}
+	for i, episode := range episodes {
+		best.Episodes[i] = dto.SeasonEpisode{
+			Episode: dto.Episode{
+				ID:            episode.ID,
+				EpisodeNumber: episode.EpisodeNumber,
+				Title:         episode.Title,
+				Duration:      episode.Duration,
+				Rating:        episode.Rating,
+				RatingCount:   episode.RatingCount,
+			},
+			SeasonID:    episode.SeasonID,
+			SeasonTitle: episode.SeasonTitle,
+		}
+	}
+	return best, nil
+}
+
+// GetPreviewContents возвращает dto.PreviewContent для нескольких ID в том же порядке
+func (c *ContentService) GetPreviewContents(ctx context.Context, ids []int) ([]*dto.PreviewContent, error) {
+	ctx, span := tracing.Start(ctx, "ContentService.GetPreviewContents")
+	defer span.End()
+	contentEntities, err := c.contentRepo.GetPreviewContents(ctx, ids)
+	switch {
+	case errors.Is(err, repository.ErrContentNotFound):
+		return nil, usecase.ErrContentNotFound
+	case err != nil:
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении контента"), err)
+	}
+	previews := make([]*dto.PreviewContent, len(contentEntities))
+	for index, contentEntity := range contentEntities {
+		previews[index], err = c.previewContentEntityToDTO(ctx, contentEntity)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return previews, nil
+}
+
+// previewContentEntityToDTO конвертирует превью контента в dto.PreviewContent, добавляя ссылку на постер
+func (c *ContentService) previewContentEntityToDTO(
+	ctx context.Context,
+	contentEntity *entity.Content,
+) (*dto.PreviewContent, error) {
+	posterURL, err := c.staticUC.GetStatic(ctx, contentEntity.PosterStaticID)
 	switch {
 	case errors.Is(err, usecase.ErrStaticNotFound):
 		// Если постер не найден, возвращаем пустую строку
@@ -359,14 +435,16 @@ func (c *ContentService) GetPreviewContentByID(id int) (*dto.PreviewContent, err
 	return &previewContentDTO, nil
 }
 
-func (c *ContentService) GetNearestOngoings() (*dto.PreviewOngoingContentList, error) {
-	nearestOngoings, err := c.contentRepo.GetNearestOngoings(10)
+func (c *ContentService) GetNearestOngoings(ctx context.Context) (*dto.PreviewOngoingContentList, error) {
+	ctx, span := tracing.Start(ctx, "ContentService.GetNearestOngoings")
+	defer span.End()
+	nearestOngoings, err := c.contentRepo.GetNearestOngoings(ctx, 10)
 	if err != nil {
 		return nil, entity.UsecaseWrap(errors.New("ошибка при получении ближайших релизов"), err)
 	}
 	previewContents := make([]*dto.PreviewContent, len(nearestOngoings))
 	for index, ongoing := range nearestOngoings {
-		preview, err := c.GetPreviewContentByID(ongoing)
+		preview, err := c.GetPreviewContentByID(ctx, ongoing)
 		if err != nil {
 			return nil, errors.Join(errors.New("ошибка при получении превью контента в GetNearestOngoings"), err)
 		}
@@ -377,14 +455,19 @@ func (c *ContentService) GetNearestOngoings() (*dto.PreviewOngoingContentList, e
 	}, nil
 }
 
-func (c *ContentService) GetOngoingContentByMonthAndYear(month, year int) (*dto.PreviewOngoingContentList, error) {
-	ongoingContentEntities, err := c.contentRepo.GetOngoingContentByMonthAndYear(month, year)
+func (c *ContentService) GetOngoingContentByMonthAndYear(
+	ctx context.Context,
+	month, year int,
+) (*dto.PreviewOngoingContentList, error) {
+	ctx, span := tracing.Start(ctx, "ContentService.GetOngoingContentByMonthAndYear")
+	defer span.End()
+	ongoingContentEntities, err := c.contentRepo.GetOngoingContentByMonthAndYear(ctx, month, year)
 	if err != nil {
 		return nil, entity.UsecaseWrap(errors.New("ошибка при получении релизов по месяцу и году"), err)
 	}
 	ongoingContent := make([]*dto.PreviewContent, len(ongoingContentEntities))
 	for index, ongoingContentEntity := range ongoingContentEntities {
-		preview, err := c.GetPreviewContentByID(ongoingContentEntity)
+		preview, err := c.GetPreviewContentByID(ctx, ongoingContentEntity)
 		if err != nil {
 			return nil, errors.Join(
 				errors.New("ошибка при получении превью контента в GetOngoingContentByMonthAndYear"),
@@ -398,8 +481,10 @@ func (c *ContentService) GetOngoingContentByMonthAndYear(month, year int) (*dto.
 	}, nil
 }
 
-func (c *ContentService) GetAllOngoingsYears() (*dto.ReleaseYearsResponse, error) {
-	years, err := c.contentRepo.GetAllOngoingsYears()
+func (c *ContentService) GetAllOngoingsYears(ctx context.Context) (*dto.ReleaseYearsResponse, error) {
+	ctx, span := tracing.Start(ctx, "ContentService.GetAllOngoingsYears")
+	defer span.End()
+	years, err := c.contentRepo.GetAllOngoingsYears(ctx)
 	if err != nil {
 		return nil, entity.UsecaseWrap(errors.New("ошибка при получении всех годов релизов"), err)
 	}
@@ -408,9 +493,19 @@ func (c *ContentService) GetAllOngoingsYears() (*dto.ReleaseYearsResponse, error
 	}, nil
 }
 
-func (c *ContentService) IsOngoingContentReleased(contentID int, releasedCh chan<- bool, errCh chan<- error) {
+func (c *ContentService) IsOngoingContentReleased(
+	ctx context.Context,
+	contentID int,
+	releasedCh chan<- bool,
+	errCh chan<- error,
+) {
+	ctx, span := tracing.Start(ctx, "ContentService.IsOngoingContentReleased")
+	defer span.End()
 	for {
-		isReleased, err := c.contentRepo.IsOngoingContentReleased(contentID)
+		isReleased, err := c.contentRepo.IsOngoingContentReleased(ctx, contentID)
+		if err != nil && ctx.Err() != nil {
+			return
+		}
 		if err != nil {
 			errCh <- entity.UsecaseWrap(errors.New("ошибка при проверке релиза"), err)
 			return
@@ -419,16 +514,22 @@ func (c *ContentService) IsOngoingContentReleased(contentID int, releasedCh chan
 			releasedCh <- true
 			return
 		}
-		// Задержка перед следующим вызовом функции
-		time.Sleep(time.Duration(10) * time.Second)
+		// Задержка перед следующим вызовом функции, прерываемая закрытием соединения
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Duration(10) * time.Second):
+		}
 	}
 }
 
-func (c *ContentService) SetReleasedState(secretKey string, contentID int, isReleased bool) error {
+func (c *ContentService) SetReleasedState(ctx context.Context, secretKey string, contentID int, isReleased bool) error {
+	ctx, span := tracing.Start(ctx, "ContentService.SetReleasedState")
+	defer span.End()
 	if secretKey != c.secretKey {
 		return usecase.ErrContentInvalidSecretKey
 	}
-	err := c.contentRepo.SetReleasedState(contentID, isReleased)
+	err := c.contentRepo.SetReleasedState(ctx, contentID, isReleased)
 	switch {
 	case errors.Is(err, repository.ErrContentNotFound):
 		return usecase.ErrContentNotFound
@@ -438,8 +539,10 @@ func (c *ContentService) SetReleasedState(secretKey string, contentID int, isRel
 	return nil
 }
 
-func (c *ContentService) SubscribeOnContent(userID, contentID int) error {
-	err := c.contentRepo.SubscribeOnContent(userID, contentID)
+func (c *ContentService) SubscribeOnContent(ctx context.Context, userID, contentID int) error {
+	ctx, span := tracing.Start(ctx, "ContentService.SubscribeOnContent")
+	defer span.End()
+	err := c.contentRepo.SubscribeOnContent(ctx, userID, contentID)
 	switch {
 	case errors.Is(err, repository.ErrContentNotFound):
 		return usecase.ErrContentNotFound
@@ -451,8 +554,10 @@ func (c *ContentService) SubscribeOnContent(userID, contentID int) error {
 	return nil
 }
 
-func (c *ContentService) UnsubscribeFromContent(userID, contentID int) error {
-	err := c.contentRepo.UnsubscribeFromContent(userID, contentID)
+func (c *ContentService) UnsubscribeFromContent(ctx context.Context, userID, contentID int) error {
+	ctx, span := tracing.Start(ctx, "ContentService.UnsubscribeFromContent")
+	defer span.End()
+	err := c.contentRepo.UnsubscribeFromContent(ctx, userID, contentID)
 	switch {
 	case errors.Is(err, repository.ErrContentNotFound):
 		return usecase.ErrContentNotFound
@@ -464,8 +569,10 @@ func (c *ContentService) UnsubscribeFromContent(userID, contentID int) error {
 	return nil
 }
 
-func (c *ContentService) GetSubscribedContentIDs(userID int) (*dto.SubscriptionsResponse, error) {
-	contentIDs, err := c.contentRepo.GetSubscribedContentIDs(userID)
+func (c *ContentService) GetSubscribedContentIDs(ctx context.Context, userID int) (*dto.SubscriptionsResponse, error) {
+	ctx, span := tracing.Start(ctx, "ContentService.GetSubscribedContentIDs")
+	defer span.End()
+	contentIDs, err := c.contentRepo.GetSubscribedContentIDs(ctx, userID)
 	switch {
 	case errors.Is(err, repository.ErrUserNotFound):
 		return nil, usecase.ErrUserNotFound
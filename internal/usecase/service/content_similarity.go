@@ -0,0 +1,51 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+)
+
+const (
+	// сколько похожего контента хранится и показывается для каждого контента
+	similarContentLimit = 10
+	// сколько измененного контента пересчитывается в одной транзакции
+	similarContentBatch = 100
+)
+
+type ContentSimilarityService struct {
+	similarityRepo repository.ContentSimilarity
+}
+
+func NewContentSimilarityService(similarityRepo repository.ContentSimilarity) usecase.ContentSimilarity {
+	return &ContentSimilarityService{
+		similarityRepo: similarityRepo,
+	}
+}
+
+// RefreshSimilarContent разбирает очередь измененного контента порциями, пока она не опустеет
+func (c *ContentSimilarityService) RefreshSimilarContent(ctx context.Context) error {
+	ctx, span := tracing.Start(ctx, "ContentSimilarityService.RefreshSimilarContent")
+	defer span.End()
+	for {
+		refreshed, err := c.similarityRepo.RefreshStale(ctx, similarContentLimit, similarContentBatch)
+		if err != nil {
+			return entity.UsecaseWrap(errors.New("ошибка при пересчете похожего контента"), err)
+		}
+		if refreshed < similarContentBatch || ctx.Err() != nil {
+			return nil
+		}
+	}
+}
+
+func (c *ContentSimilarityService) RebuildSimilarContent(ctx context.Context) error {
+	ctx, span := tracing.Start(ctx, "ContentSimilarityService.RebuildSimilarContent")
+	defer span.End()
+	if err := c.similarityRepo.Rebuild(ctx, similarContentLimit); err != nil {
+		return entity.UsecaseWrap(errors.New("ошибка при перестройке похожего контента"), err)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mockrepo "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestContentSimilarityService_RefreshSimilarContent(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                    string
+		ExpectedErr             bool
+		SetupSimilarityRepoMock func(repo *mockrepo.MockContentSimilarity)
+	}{
+		{
+			Name: "Очередь разбирается до конца",
+			SetupSimilarityRepoMock: func(repo *mockrepo.MockContentSimilarity) {
+				gomock.InOrder(
+					repo.EXPECT().RefreshStale(gomock.Any(), similarContentLimit, similarContentBatch).
+						Return(similarContentBatch, nil),
+					repo.EXPECT().RefreshStale(gomock.Any(), similarContentLimit, similarContentBatch).
+						Return(3, nil),
+				)
+			},
+		},
+		{
+			Name: "Очередь пуста",
+			SetupSimilarityRepoMock: func(repo *mockrepo.MockContentSimilarity) {
+				repo.EXPECT().RefreshStale(gomock.Any(), similarContentLimit, similarContentBatch).Return(0, nil)
+			},
+		},
+		{
+			Name:        "Ошибка при пересчете",
+			ExpectedErr: true,
+			SetupSimilarityRepoMock: func(repo *mockrepo.MockContentSimilarity) {
+				repo.EXPECT().RefreshStale(gomock.Any(), similarContentLimit, similarContentBatch).
+					Return(0, errors.New("database error"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockSimilarityRepo := mockrepo.NewMockContentSimilarity(ctrl)
+			tc.SetupSimilarityRepoMock(mockSimilarityRepo)
+			err := NewContentSimilarityService(mockSimilarityRepo).RefreshSimilarContent(context.Background())
+			if tc.ExpectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestContentSimilarityService_RebuildSimilarContent(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockSimilarityRepo := mockrepo.NewMockContentSimilarity(ctrl)
+	mockSimilarityRepo.EXPECT().Rebuild(gomock.Any(), similarContentLimit).Return(nil)
+	require.NoError(t, NewContentSimilarityService(mockSimilarityRepo).RebuildSimilarContent(context.Background()))
+}
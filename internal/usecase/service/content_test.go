@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
@@ -62,7 +63,7 @@ func TestContentService_GetContent(t *testing.T) {
 				Type:   entity.ContentTypeSeries,
 			},
 			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
-				repo.EXPECT().GetContent(1).Return(&entity.Content{
+				repo.EXPECT().GetContent(gomock.Any(), 1).Return(&entity.Content{
 					ID:               1,
 					Title:            "Бэтмен",
 					OriginalTitle:    "Batman",
@@ -93,7 +94,7 @@ func TestContentService_GetContent(t *testing.T) {
 						},
 					},
 				}, nil).AnyTimes()
-				repo.EXPECT().GetSimilarContent(1).Return([]entity.Content{
+				repo.EXPECT().GetSimilarContent(gomock.Any(), 1).Return([]entity.Content{
 					{
 						ID:             2,
 						PosterStaticID: 1,
@@ -102,7 +103,7 @@ func TestContentService_GetContent(t *testing.T) {
 				}, nil).AnyTimes()
 			},
 			SetupStaticRepoMock: func(repo *mock_usecase.MockStatic) {
-				repo.EXPECT().GetStatic(1).Return("http://localhost:8080/static/1", nil).AnyTimes()
+				repo.EXPECT().GetStatic(gomock.Any(), 1).Return("http://localhost:8080/static/1", nil).AnyTimes()
 			},
 		},
 		{
@@ -110,10 +111,10 @@ func TestContentService_GetContent(t *testing.T) {
 			ContentID:   2,
 			ExpectedErr: entity.UsecaseWrap(fmt.Errorf("ошибка при получении контента"), fmt.Errorf("ошибка при получении контента")),
 			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
-				repo.EXPECT().GetContent(2).Return(nil, fmt.Errorf("ошибка при получении контента")).AnyTimes()
+				repo.EXPECT().GetContent(gomock.Any(), 2).Return(nil, fmt.Errorf("ошибка при получении контента")).AnyTimes()
 			},
 			SetupStaticRepoMock: func(repo *mock_usecase.MockStatic) {
-				repo.EXPECT().GetStatic(1).Return("", usecase.ErrStaticNotFound).AnyTimes()
+				repo.EXPECT().GetStatic(gomock.Any(), 1).Return("", usecase.ErrStaticNotFound).AnyTimes()
 			},
 		},
 		{
@@ -121,7 +122,7 @@ func TestContentService_GetContent(t *testing.T) {
 			ContentID:   1,
 			ExpectedErr: entity.UsecaseWrap(fmt.Errorf("ошибка при получении постера"), fmt.Errorf("ошибка при получении постера")),
 			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
-				repo.EXPECT().GetContent(1).Return(&entity.Content{
+				repo.EXPECT().GetContent(gomock.Any(), 1).Return(&entity.Content{
 					ID:               1,
 					Title:            "Бэтмен",
 					OriginalTitle:    "Batman",
@@ -135,13 +136,13 @@ func TestContentService_GetContent(t *testing.T) {
 					PicturesStaticID: []int{1},
 					Description:      "Описание фильма или сериала",
 				}, nil).AnyTimes()
-				repo.EXPECT().GetSimilarContent(1).Return([]entity.Content{{
+				repo.EXPECT().GetSimilarContent(gomock.Any(), 1).Return([]entity.Content{{
 					ID:             2,
 					PosterStaticID: 1,
 				}}, nil).AnyTimes()
 			},
 			SetupStaticRepoMock: func(repo *mock_usecase.MockStatic) {
-				repo.EXPECT().GetStatic(1).Return("", fmt.Errorf("ошибка при получении постера")).AnyTimes()
+				repo.EXPECT().GetStatic(gomock.Any(), 1).Return("", fmt.Errorf("ошибка при получении постера")).AnyTimes()
 			},
 		},
 	}
@@ -156,7 +157,7 @@ func TestContentService_GetContent(t *testing.T) {
 			contentService := NewContentService(mockContentRepo, mockStaticRepo, "")
 			tc.SetupContentRepoMock(mockContentRepo)
 			tc.SetupStaticRepoMock(mockStaticRepo)
-			content, err := contentService.GetContentByID(tc.ContentID)
+			content, err := contentService.GetContentByID(context.Background(), tc.ContentID)
 			require.EqualValues(t, tc.ExpectedErr, err)
 			require.EqualValues(t, tc.ExpectedOutput, content)
 		})
@@ -189,7 +190,7 @@ func TestContentService_GetPersonByID(t *testing.T) {
 				Roles:     map[string][]dto.PreviewContentCardVertical{"actor": {{ID: 1, Poster: "http://localhost:8080/static/1", Genres: []string{}}}},
 			},
 			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
-				repo.EXPECT().GetPerson(1).Return(&entity.Person{
+				repo.EXPECT().GetPerson(gomock.Any(), 1).Return(&entity.Person{
 					ID:            1,
 					Name:          "Кристиан Бэйл",
 					EnName:        "Christian Bale",
@@ -199,16 +200,16 @@ func TestContentService_GetPersonByID(t *testing.T) {
 					Height:        sql.NullInt64{Int64: 183, Valid: true},
 					PhotoStaticID: sql.NullInt64{Int64: 1, Valid: true},
 				}, nil).AnyTimes()
-				repo.EXPECT().GetPersonRoles(1).Return([]entity.PersonRole{
+				repo.EXPECT().GetPersonRoles(gomock.Any(), 1).Return([]entity.PersonRole{
 					{PersonID: 1, Role: entity.Role{Name: "actor"}, ContentID: 1},
 				}, nil).AnyTimes()
-				repo.EXPECT().GetPreviewContent(1).Return(&entity.Content{
+				repo.EXPECT().GetPreviewContent(gomock.Any(), 1).Return(&entity.Content{
 					ID:             1,
 					PosterStaticID: 1,
 				}, nil).AnyTimes()
 			},
 			SetupStaticRepoMock: func(repo *mock_usecase.MockStatic) {
-				repo.EXPECT().GetStatic(1).Return("http://localhost:8080/static/1", nil).AnyTimes()
+				repo.EXPECT().GetStatic(gomock.Any(), 1).Return("http://localhost:8080/static/1", nil).AnyTimes()
 			},
 		},
 	}
@@ -224,7 +225,7 @@ func TestContentService_GetPersonByID(t *testing.T) {
 			contentService := NewContentService(mockContentRepo, mockStaticRepo, "")
 			tc.SetupContentRepoMock(mockContentRepo)
 			tc.SetupStaticRepoMock(mockStaticRepo)
-			person, err := contentService.GetPersonByID(tc.PersonID)
+			person, err := contentService.GetPersonByID(context.Background(), tc.PersonID)
 			require.EqualValues(t, tc.ExpectedErr, err)
 			require.EqualValues(t, tc.ExpectedOutput, person)
 		})
@@ -252,7 +253,7 @@ func TestContentService_GetPreviewPersonByID(t *testing.T) {
 				PhotoURL: "http://localhost:8080/static/1",
 			},
 			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
-				repo.EXPECT().GetPerson(1).Return(&entity.Person{
+				repo.EXPECT().GetPerson(gomock.Any(), 1).Return(&entity.Person{
 					ID:            1,
 					Name:          "Кристиан Бэйл",
 					EnName:        "Christian Bale",
@@ -260,7 +261,7 @@ func TestContentService_GetPreviewPersonByID(t *testing.T) {
 				}, nil).AnyTimes()
 			},
 			SetupStaticRepoMock: func(repo *mock_usecase.MockStatic) {
-				repo.EXPECT().GetStatic(1).Return("http://localhost:8080/static/1", nil).AnyTimes()
+				repo.EXPECT().GetStatic(gomock.Any(), 1).Return("http://localhost:8080/static/1", nil).AnyTimes()
 			},
 		},
 		{
@@ -268,10 +269,10 @@ func TestContentService_GetPreviewPersonByID(t *testing.T) {
 			PersonID:    2,
 			ExpectedErr: entity.UsecaseWrap(fmt.Errorf("ошибка при получении персоны"), fmt.Errorf("ошибка при получении человека")),
 			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
-				repo.EXPECT().GetPerson(2).Return(nil, fmt.Errorf("ошибка при получении человека")).AnyTimes()
+				repo.EXPECT().GetPerson(gomock.Any(), 2).Return(nil, fmt.Errorf("ошибка при получении человека")).AnyTimes()
 			},
 			SetupStaticRepoMock: func(repo *mock_usecase.MockStatic) {
-				repo.EXPECT().GetStatic(1).Return("", usecase.ErrStaticNotFound).AnyTimes()
+				repo.EXPECT().GetStatic(gomock.Any(), 1).Return("", usecase.ErrStaticNotFound).AnyTimes()
 			},
 		},
 		{
@@ -279,7 +280,7 @@ func TestContentService_GetPreviewPersonByID(t *testing.T) {
 			PersonID:    1,
 			ExpectedErr: entity.UsecaseWrap(fmt.Errorf("ошибка при получении фото персоны"), fmt.Errorf("ошибка при получении фото")),
 			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
-				repo.EXPECT().GetPerson(1).Return(&entity.Person{
+				repo.EXPECT().GetPerson(gomock.Any(), 1).Return(&entity.Person{
 					ID:            1,
 					Name:          "Кристиан Бэйл",
 					EnName:        "Christian Bale",
@@ -287,7 +288,7 @@ func TestContentService_GetPreviewPersonByID(t *testing.T) {
 				}, nil).AnyTimes()
 			},
 			SetupStaticRepoMock: func(repo *mock_usecase.MockStatic) {
-				repo.EXPECT().GetStatic(1).Return("", fmt.Errorf("ошибка при получении фото")).AnyTimes()
+				repo.EXPECT().GetStatic(gomock.Any(), 1).Return("", fmt.Errorf("ошибка при получении фото")).AnyTimes()
 			},
 		},
 		{
@@ -295,7 +296,7 @@ func TestContentService_GetPreviewPersonByID(t *testing.T) {
 			PersonID:    2,
 			ExpectedErr: usecase.ErrPersonNotFound,
 			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
-				repo.EXPECT().GetPerson(2).Return(nil, repository.ErrPersonNotFound).AnyTimes()
+				repo.EXPECT().GetPerson(gomock.Any(), 2).Return(nil, repository.ErrPersonNotFound).AnyTimes()
 			},
 			SetupStaticRepoMock: func(repo *mock_usecase.MockStatic) {},
 		},
@@ -310,7 +311,7 @@ func TestContentService_GetPreviewPersonByID(t *testing.T) {
 				PhotoURL: "",
 			},
 			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
-				repo.EXPECT().GetPerson(1).Return(&entity.Person{
+				repo.EXPECT().GetPerson(gomock.Any(), 1).Return(&entity.Person{
 					ID:            1,
 					Name:          "Кристиан Бэйл",
 					EnName:        "Christian Bale",
@@ -318,7 +319,7 @@ func TestContentService_GetPreviewPersonByID(t *testing.T) {
 				}, nil).AnyTimes()
 			},
 			SetupStaticRepoMock: func(uc *mock_usecase.MockStatic) {
-				uc.EXPECT().GetStatic(1).Return("", usecase.ErrStaticNotFound).AnyTimes()
+				uc.EXPECT().GetStatic(gomock.Any(), 1).Return("", usecase.ErrStaticNotFound).AnyTimes()
 			},
 		},
 	}
@@ -334,7 +335,7 @@ func TestContentService_GetPreviewPersonByID(t *testing.T) {
 			contentService := NewContentService(mockContentRepo, mockStaticRepo, "")
 			tc.SetupContentRepoMock(mockContentRepo)
 			tc.SetupStaticRepoMock(mockStaticRepo)
-			person, err := contentService.GetPreviewPersonByID(tc.PersonID)
+			person, err := contentService.GetPreviewPersonByID(context.Background(), tc.PersonID)
 			require.EqualValues(t, tc.ExpectedErr, err)
 			require.EqualValues(t, tc.ExpectedOutput, person)
 		})
@@ -363,7 +364,7 @@ func TestContentService_GetPreviewContentByID(t *testing.T) {
 				Actors:  make([]string, 0),
 			},
 			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
-				repo.EXPECT().GetPreviewContent(1).Return(&entity.Content{
+				repo.EXPECT().GetPreviewContent(gomock.Any(), 1).Return(&entity.Content{
 					ID:             1,
 					PosterStaticID: 1,
 					Genres:         []entity.Genre{{Name: "Боевик"}},
@@ -371,7 +372,7 @@ func TestContentService_GetPreviewContentByID(t *testing.T) {
 				}, nil).AnyTimes()
 			},
 			SetupStaticRepoMock: func(repo *mock_usecase.MockStatic) {
-				repo.EXPECT().GetStatic(1).Return("http://localhost:8080/static/1", nil).AnyTimes()
+				repo.EXPECT().GetStatic(gomock.Any(), 1).Return("http://localhost:8080/static/1", nil).AnyTimes()
 			},
 		},
 		{
@@ -379,10 +380,10 @@ func TestContentService_GetPreviewContentByID(t *testing.T) {
 			ContentID:   2,
 			ExpectedErr: entity.UsecaseWrap(fmt.Errorf("ошибка при получении контента"), fmt.Errorf("ошибка при получении контента")),
 			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
-				repo.EXPECT().GetPreviewContent(2).Return(nil, fmt.Errorf("ошибка при получении контента")).AnyTimes()
+				repo.EXPECT().GetPreviewContent(gomock.Any(), 2).Return(nil, fmt.Errorf("ошибка при получении контента")).AnyTimes()
 			},
 			SetupStaticRepoMock: func(repo *mock_usecase.MockStatic) {
-				repo.EXPECT().GetStatic(1).Return("", usecase.ErrStaticNotFound).AnyTimes()
+				repo.EXPECT().GetStatic(gomock.Any(), 1).Return("", usecase.ErrStaticNotFound).AnyTimes()
 			},
 		},
 	}
@@ -398,13 +399,71 @@ func TestContentService_GetPreviewContentByID(t *testing.T) {
 			contentService := NewContentService(mockContentRepo, mockStaticRepo, "")
 			tc.SetupContentRepoMock(mockContentRepo)
 			tc.SetupStaticRepoMock(mockStaticRepo)
-			content, err := contentService.GetPreviewContentByID(tc.ContentID)
+			content, err := contentService.GetPreviewContentByID(context.Background(), tc.ContentID)
 			require.EqualValues(t, tc.ExpectedErr, err)
 			require.EqualValues(t, tc.ExpectedOutput, content)
 		})
 	}
 }
 
+func TestContentService_GetPreviewContents(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                 string
+		ContentIDs           []int
+		ExpectedOutput       []*dto.PreviewContent
+		ExpectedErr          error
+		SetupContentRepoMock func(repo *mockrepo.MockContent)
+		SetupStaticRepoMock  func(repo *mock_usecase.MockStatic)
+	}{
+		{
+			Name:       "Порядок контента сохраняется",
+			ContentIDs: []int{2, 1},
+			ExpectedOutput: []*dto.PreviewContent{
+				{ID: 2, Poster: "http://localhost:8080/static/2", Actors: make([]string, 0)},
+				{ID: 1, Genre: "Боевик", Actors: make([]string, 0)},
+			},
+			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
+				repo.EXPECT().GetPreviewContents(gomock.Any(), []int{2, 1}).Return([]*entity.Content{
+					{ID: 2, PosterStaticID: 2},
+					{ID: 1, Genres: []entity.Genre{{Name: "Боевик"}}},
+				}, nil)
+			},
+			SetupStaticRepoMock: func(repo *mock_usecase.MockStatic) {
+				repo.EXPECT().GetStatic(gomock.Any(), 2).Return("http://localhost:8080/static/2", nil)
+				repo.EXPECT().GetStatic(gomock.Any(), 0).Return("", usecase.ErrStaticNotFound)
+			},
+		},
+		{
+			Name:        "Контент не найден",
+			ContentIDs:  []int{1},
+			ExpectedErr: usecase.ErrContentNotFound,
+			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
+				repo.EXPECT().GetPreviewContents(gomock.Any(), []int{1}).Return(nil, repository.ErrContentNotFound)
+			},
+			SetupStaticRepoMock: func(repo *mock_usecase.MockStatic) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockContentRepo := mockrepo.NewMockContent(ctrl)
+			mockStaticRepo := mock_usecase.NewMockStatic(ctrl)
+			contentService := NewContentService(mockContentRepo, mockStaticRepo, "")
+			tc.SetupContentRepoMock(mockContentRepo)
+			tc.SetupStaticRepoMock(mockStaticRepo)
+			contents, err := contentService.GetPreviewContents(context.Background(), tc.ContentIDs)
+			require.EqualValues(t, tc.ExpectedErr, err)
+			require.EqualValues(t, tc.ExpectedOutput, contents)
+		})
+	}
+}
+
 func TestContentService_GetNearestOngoings(t *testing.T) {
 	t.Parallel()
 
@@ -428,25 +487,25 @@ func TestContentService_GetNearestOngoings(t *testing.T) {
 				},
 			},
 			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
-				repo.EXPECT().GetNearestOngoings(10).Return([]int{1}, nil).AnyTimes()
-				repo.EXPECT().GetPreviewContent(1).Return(&entity.Content{
+				repo.EXPECT().GetNearestOngoings(gomock.Any(), 10).Return([]int{1}, nil).AnyTimes()
+				repo.EXPECT().GetPreviewContent(gomock.Any(), 1).Return(&entity.Content{
 					ID:             1,
 					PosterStaticID: 1,
 					Genres:         []entity.Genre{{Name: "Боевик"}},
 				}, nil).AnyTimes()
 			},
 			SetupStaticRepoMock: func(repo *mock_usecase.MockStatic) {
-				repo.EXPECT().GetStatic(1).Return("http://localhost:8080/static/1", nil).AnyTimes()
+				repo.EXPECT().GetStatic(gomock.Any(), 1).Return("http://localhost:8080/static/1", nil).AnyTimes()
 			},
 		},
 		{
 			Name:        "Ошибка при получении контента",
 			ExpectedErr: entity.UsecaseWrap(fmt.Errorf("ошибка при получении ближайших релизов"), fmt.Errorf("ошибка при получении контента")),
 			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
-				repo.EXPECT().GetNearestOngoings(10).Return(nil, fmt.Errorf("ошибка при получении контента")).AnyTimes()
+				repo.EXPECT().GetNearestOngoings(gomock.Any(), 10).Return(nil, fmt.Errorf("ошибка при получении контента")).AnyTimes()
 			},
 			SetupStaticRepoMock: func(repo *mock_usecase.MockStatic) {
-				repo.EXPECT().GetStatic(1).Return("", usecase.ErrStaticNotFound).AnyTimes()
+				repo.EXPECT().GetStatic(gomock.Any(), 1).Return("", usecase.ErrStaticNotFound).AnyTimes()
 			},
 		},
 	}
@@ -462,7 +521,7 @@ func TestContentService_GetNearestOngoings(t *testing.T) {
 			contentService := NewContentService(mockContentRepo, mockStaticRepo, "")
 			tc.SetupContentRepoMock(mockContentRepo)
 			tc.SetupStaticRepoMock(mockStaticRepo)
-			content, err := contentService.GetNearestOngoings()
+			content, err := contentService.GetNearestOngoings(context.Background())
 			require.EqualValues(t, tc.ExpectedErr, err)
 			require.EqualValues(t, tc.ExpectedOutput, content)
 		})
@@ -482,14 +541,14 @@ func TestContentService_GetAllOngoingsYears(t *testing.T) {
 			Name:           "Существующие года",
 			ExpectedOutput: &dto.ReleaseYearsResponse{Years: []int{2021}},
 			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
-				repo.EXPECT().GetAllOngoingsYears().Return([]int{2021}, nil).AnyTimes()
+				repo.EXPECT().GetAllOngoingsYears(gomock.Any()).Return([]int{2021}, nil).AnyTimes()
 			},
 		},
 		{
 			Name:        "Ошибка при получении годов",
 			ExpectedErr: entity.UsecaseWrap(fmt.Errorf("ошибка при получении всех годов релизов"), fmt.Errorf("ошибка при получении годов")),
 			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
-				repo.EXPECT().GetAllOngoingsYears().Return(nil, fmt.Errorf("ошибка при получении годов")).AnyTimes()
+				repo.EXPECT().GetAllOngoingsYears(gomock.Any()).Return(nil, fmt.Errorf("ошибка при получении годов")).AnyTimes()
 			},
 		},
 	}
@@ -503,7 +562,7 @@ func TestContentService_GetAllOngoingsYears(t *testing.T) {
 			mockContentRepo := mockrepo.NewMockContent(ctrl)
 			contentService := NewContentService(mockContentRepo, nil, "")
 			tc.SetupContentRepoMock(mockContentRepo)
-			content, err := contentService.GetAllOngoingsYears()
+			content, err := contentService.GetAllOngoingsYears(context.Background())
 			require.EqualValues(t, tc.ExpectedErr, err)
 			require.EqualValues(t, tc.ExpectedOutput, content)
 		})
@@ -525,7 +584,7 @@ func TestContentService_SubscribeOnContent(t *testing.T) {
 			UserID:    1,
 			ContentID: 1,
 			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
-				repo.EXPECT().SubscribeOnContent(1, 1).Return(nil).AnyTimes()
+				repo.EXPECT().SubscribeOnContent(gomock.Any(), 1, 1).Return(nil).AnyTimes()
 			},
 		},
 		{
@@ -534,7 +593,7 @@ func TestContentService_SubscribeOnContent(t *testing.T) {
 			ContentID:   1,
 			ExpectedErr: entity.UsecaseWrap(fmt.Errorf("ошибка при подписке на контент"), fmt.Errorf("ошибка при подписке на контент")),
 			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
-				repo.EXPECT().SubscribeOnContent(1, 1).Return(fmt.Errorf("ошибка при подписке на контент")).AnyTimes()
+				repo.EXPECT().SubscribeOnContent(gomock.Any(), 1, 1).Return(fmt.Errorf("ошибка при подписке на контент")).AnyTimes()
 			},
 		},
 	}
@@ -548,7 +607,7 @@ func TestContentService_SubscribeOnContent(t *testing.T) {
 			mockContentRepo := mockrepo.NewMockContent(ctrl)
 			contentService := NewContentService(mockContentRepo, nil, "")
 			tc.SetupContentRepoMock(mockContentRepo)
-			err := contentService.SubscribeOnContent(tc.UserID, tc.ContentID)
+			err := contentService.SubscribeOnContent(context.Background(), tc.UserID, tc.ContentID)
 			require.EqualValues(t, tc.ExpectedErr, err)
 		})
 	}
@@ -569,7 +628,7 @@ func TestContentService_UnsubscribeFromContent(t *testing.T) {
 			UserID:    1,
 			ContentID: 1,
 			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
-				repo.EXPECT().UnsubscribeFromContent(1, 1).Return(nil).AnyTimes()
+				repo.EXPECT().UnsubscribeFromContent(gomock.Any(), 1, 1).Return(nil).AnyTimes()
 			},
 		},
 		{
@@ -578,7 +637,7 @@ func TestContentService_UnsubscribeFromContent(t *testing.T) {
 			ContentID:   1,
 			ExpectedErr: entity.UsecaseWrap(fmt.Errorf("ошибка при отписке от контента"), fmt.Errorf("ошибка при отписке от контента")),
 			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
-				repo.EXPECT().UnsubscribeFromContent(1, 1).Return(fmt.Errorf("ошибка при отписке от контента")).AnyTimes()
+				repo.EXPECT().UnsubscribeFromContent(gomock.Any(), 1, 1).Return(fmt.Errorf("ошибка при отписке от контента")).AnyTimes()
 			},
 		},
 	}
@@ -592,7 +651,7 @@ func TestContentService_UnsubscribeFromContent(t *testing.T) {
 			mockContentRepo := mockrepo.NewMockContent(ctrl)
 			contentService := NewContentService(mockContentRepo, nil, "")
 			tc.SetupContentRepoMock(mockContentRepo)
-			err := contentService.UnsubscribeFromContent(tc.UserID, tc.ContentID)
+			err := contentService.UnsubscribeFromContent(context.Background(), tc.UserID, tc.ContentID)
 			require.EqualValues(t, tc.ExpectedErr, err)
 		})
 	}
@@ -613,7 +672,7 @@ func TestContentService_GetSubscribedContentIDs(t *testing.T) {
 			UserID:         1,
 			ExpectedOutput: &dto.SubscriptionsResponse{Subscriptions: []int{1}},
 			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
-				repo.EXPECT().GetSubscribedContentIDs(1).Return([]int{1}, nil).AnyTimes()
+				repo.EXPECT().GetSubscribedContentIDs(gomock.Any(), 1).Return([]int{1}, nil).AnyTimes()
 			},
 		},
 		{
@@ -621,7 +680,7 @@ func TestContentService_GetSubscribedContentIDs(t *testing.T) {
 			UserID:      1,
 			ExpectedErr: entity.UsecaseWrap(fmt.Errorf("ошибка при получении подписок пользователя"), fmt.Errorf("ошибка при получении подписок")),
 			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
-				repo.EXPECT().GetSubscribedContentIDs(1).Return(nil, fmt.Errorf("ошибка при получении подписок")).AnyTimes()
+				repo.EXPECT().GetSubscribedContentIDs(gomock.Any(), 1).Return(nil, fmt.Errorf("ошибка при получении подписок")).AnyTimes()
 			},
 		},
 	}
@@ -635,9 +694,64 @@ func TestContentService_GetSubscribedContentIDs(t *testing.T) {
 			mockContentRepo := mockrepo.NewMockContent(ctrl)
 			contentService := NewContentService(mockContentRepo, nil, "")
 			tc.SetupContentRepoMock(mockContentRepo)
-			content, err := contentService.GetSubscribedContentIDs(tc.UserID)
+			content, err := contentService.GetSubscribedContentIDs(context.Background(), tc.UserID)
 			require.EqualValues(t, tc.ExpectedErr, err)
 			require.EqualValues(t, tc.ExpectedOutput, content)
 		})
 	}
 }
+
+func TestContentService_GetBestEpisodes(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                 string
+		ExpectedOutput       *dto.BestEpisodes
+		ExpectedErr          error
+		SetupContentRepoMock func(repo *mockrepo.MockContent)
+	}{
+		{
+			Name: "Успешное получение",
+			ExpectedOutput: &dto.BestEpisodes{Episodes: []dto.SeasonEpisode{
+				{
+					Episode:     dto.Episode{ID: 3, EpisodeNumber: 2, Title: "Финал", Rating: 9.5, RatingCount: 4},
+					SeasonID:    1,
+					SeasonTitle: "Сезон 1",
+				},
+			}},
+			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
+				repo.EXPECT().GetPreviewContent(gomock.Any(), 1).Return(&entity.Content{ID: 1}, nil)
+				repo.EXPECT().GetBestEpisodes(gomock.Any(), 1, 10).Return([]entity.SeasonEpisode{
+					{
+						Episode:     entity.Episode{ID: 3, EpisodeNumber: 2, Title: "Финал", Rating: 9.5, RatingCount: 4},
+						SeasonID:    1,
+						SeasonTitle: "Сезон 1",
+					},
+				}, nil)
+			},
+		},
+		{
+			Name:           "Контент не найден",
+			ExpectedOutput: nil,
+			ExpectedErr:    usecase.ErrContentNotFound,
+			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
+				repo.EXPECT().GetPreviewContent(gomock.Any(), 1).Return(nil, repository.ErrContentNotFound)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockContentRepo := mockrepo.NewMockContent(ctrl)
+			contentService := NewContentService(mockContentRepo, nil, "")
+			tc.SetupContentRepoMock(mockContentRepo)
+			output, err := contentService.GetBestEpisodes(context.Background(), 1)
+			require.EqualValues(t, tc.ExpectedOutput, output)
+			require.EqualValues(t, tc.ExpectedErr, err)
+		})
+	}
+}
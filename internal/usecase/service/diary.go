@@ -0,0 +1,240 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	"time"
+)
+
+type DiaryService struct {
+	diaryRepo repository.Diary
+	contentUC usecase.Content
+}
+
+func NewDiaryService(diaryRepo repository.Diary, contentUC usecase.Content) usecase.Diary {
+	return &DiaryService{
+		diaryRepo: diaryRepo,
+		contentUC: contentUC,
+	}
+}
+
+func diaryEntryEntityToDTO(entry *entity.DiaryEntry) dto.DiaryEntry {
+	return dto.DiaryEntry{
+		ID:        entry.ID,
+		ContentID: entry.ContentID,
+		WatchedOn: entry.WatchedOn.Format(entity.DiaryDateLayout),
+		Rating:    entry.Rating,
+		Note:      entry.Note,
+		Rewatch:   entry.Rewatch,
+		CreatedAt: entry.CreatedAt.String(),
+		UpdatedAt: entry.UpdatedAt.String(),
+	}
+}
+
+// parseDiaryEntry проверяет данные записи и возвращает их в виде entity.DiaryEntry
+func parseDiaryEntry(userID int, req dto.DiaryEntryRequest) (*entity.DiaryEntry, error) {
+	watchedOn, err := time.Parse(entity.DiaryDateLayout, req.WatchedOn)
+	if err != nil {
+		return nil, usecase.DiaryErrorIncorrectData{Err: errors.New("дата просмотра должна быть в формате ГГГГ-ММ-ДД")}
+	}
+	// дата приходит без часового пояса, поэтому у пользователей восточнее UTC "сегодня" может наступить раньше
+	if err = entity.ValidateDiaryEntry(watchedOn, req.Rating, req.Note, time.Now().Add(24*time.Hour)); err != nil {
+		return nil, usecase.DiaryErrorIncorrectData{Err: err}
+	}
+	return &entity.DiaryEntry{
+		UserID:    userID,
+		ContentID: req.ContentID,
+		WatchedOn: watchedOn,
+		Rating:    req.Rating,
+		Note:      req.Note,
+		Rewatch:   req.Rewatch,
+	}, nil
+}
+
+// validateDiaryYear проверяет год календаря или статистики
+func validateDiaryYear(year int) error {
+	if year < 1 || year > 9999 {
+		return usecase.DiaryErrorIncorrectData{Err: errors.New("некорректный год")}
+	}
+	return nil
+}
+
+func (d *DiaryService) CreateEntry(
+	ctx context.Context,
+	userID int,
+	req dto.DiaryEntryRequest,
+) (*dto.DiaryEntry, error) {
+	ctx, span := tracing.Start(ctx, "DiaryService.CreateEntry")
+	defer span.End()
+	entry, err := parseDiaryEntry(userID, req)
+	if err != nil {
+		return nil, err
+	}
+	// невышедший контент посмотреть нельзя
+	content, err := d.contentUC.GetPreviewContentByID(ctx, req.ContentID)
+	switch {
+	case errors.Is(err, usecase.ErrContentNotFound):
+		return nil, usecase.ErrDiaryContentNotFound
+	case err != nil:
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении контента"), err)
+	case content.Ongoing:
+		return nil, usecase.ErrDiaryContentNotFound
+	}
+	entry, err = d.diaryRepo.CreateEntry(ctx, entry)
+	switch {
+	case errors.Is(err, repository.ErrDiaryContentNotFound):
+		return nil, usecase.ErrDiaryContentNotFound
+	case err != nil:
+		return nil, entity.UsecaseWrap(errors.New("ошибка при добавлении записи в дневник"), err)
+	}
+	entryDTO := diaryEntryEntityToDTO(entry)
+	return &entryDTO, nil
+}
+
+func (d *DiaryService) UpdateEntry(
+	ctx context.Context,
+	userID, entryID int,
+	req dto.DiaryEntryRequest,
+) (*dto.DiaryEntry, error) {
+	ctx, span := tracing.Start(ctx, "DiaryService.UpdateEntry")
+	defer span.End()
+	entry, err := parseDiaryEntry(userID, req)
+	if err != nil {
+		return nil, err
+	}
+	entry.ID = entryID
+	entry, err = d.diaryRepo.UpdateEntry(ctx, entry)
+	switch {
+	case errors.Is(err, repository.ErrDiaryEntryNotFound):
+		return nil, usecase.ErrDiaryEntryNotFound
+	case err != nil:
+		return nil, entity.UsecaseWrap(errors.New("ошибка при изменении записи дневника"), err)
+	}
+	entryDTO := diaryEntryEntityToDTO(entry)
+	return &entryDTO, nil
+}
+
+func (d *DiaryService) DeleteEntry(ctx context.Context, userID, entryID int) error {
+	ctx, span := tracing.Start(ctx, "DiaryService.DeleteEntry")
+	defer span.End()
+	err := d.diaryRepo.DeleteEntry(ctx, userID, entryID)
+	switch {
+	case errors.Is(err, repository.ErrDiaryEntryNotFound):
+		return usecase.ErrDiaryEntryNotFound
+	case err != nil:
+		return entity.UsecaseWrap(errors.New("ошибка при удалении записи дневника"), err)
+	default:
+		return nil
+	}
+}
+
+func (d *DiaryService) GetEntry(ctx context.Context, userID, entryID int) (*dto.DiaryEntryWithContent, error) {
+	ctx, span := tracing.Start(ctx, "DiaryService.GetEntry")
+	defer span.End()
+	entry, err := d.diaryRepo.GetEntry(ctx, userID, entryID)
+	switch {
+	case errors.Is(err, repository.ErrDiaryEntryNotFound):
+		return nil, usecase.ErrDiaryEntryNotFound
+	case err != nil:
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении записи дневника"), err)
+	}
+	content, err := d.contentUC.GetPreviewContentByID(ctx, entry.ContentID)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении контента записи дневника"), err)
+	}
+	return &dto.DiaryEntryWithContent{
+		DiaryEntry: diaryEntryEntityToDTO(entry),
+		Content:    *content,
+	}, nil
+}
+
+func (d *DiaryService) GetCalendar(ctx context.Context, userID, year, month int) (*dto.DiaryCalendar, error) {
+	ctx, span := tracing.Start(ctx, "DiaryService.GetCalendar")
+	defer span.End()
+	if err := validateDiaryYear(year); err != nil {
+		return nil, err
+	}
+	if month < 1 || month > 12 {
+		return nil, usecase.DiaryErrorIncorrectData{Err: errors.New("месяц должен быть от 1 до 12")}
+	}
+	from := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+	entries, err := d.diaryRepo.GetEntriesByPeriod(ctx, userID, from, from.AddDate(0, 1, 0))
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении записей дневника"), err)
+	}
+	contentIDs := make([]int, len(entries))
+	for i, entry := range entries {
+		contentIDs[i] = entry.ContentID
+	}
+	previews, err := d.contentUC.GetPreviewContents(ctx, contentIDs)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении контента записей дневника"), err)
+	}
+	calendar := &dto.DiaryCalendar{Year: year, Month: month, Entries: len(entries), Days: make([]dto.DiaryDay, 0)}
+	// записи упорядочены по дате просмотра, поэтому новый день начинается при смене даты
+	for i, entry := range entries {
+		entryDTO := dto.DiaryEntryWithContent{DiaryEntry: diaryEntryEntityToDTO(entry), Content: *previews[i]}
+		last := len(calendar.Days) - 1
+		if last < 0 || calendar.Days[last].Date != entryDTO.WatchedOn {
+			calendar.Days = append(calendar.Days, dto.DiaryDay{Date: entryDTO.WatchedOn})
+			last++
+		}
+		calendar.Days[last].Entries = append(calendar.Days[last].Entries, entryDTO)
+	}
+	return calendar, nil
+}
+
+func (d *DiaryService) GetYearStats(ctx context.Context, userID, year int) (*dto.DiaryYearStats, error) {
+	ctx, span := tracing.Start(ctx, "DiaryService.GetYearStats")
+	defer span.End()
+	if err := validateDiaryYear(year); err != nil {
+		return nil, err
+	}
+	from := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	entries, err := d.diaryRepo.GetEntriesByPeriod(ctx, userID, from, from.AddDate(1, 0, 0))
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении записей дневника"), err)
+	}
+	stats := entity.NewDiaryYearStats(year, entries)
+	statsDTO := &dto.DiaryYearStats{
+		Year:           stats.Year,
+		Entries:        stats.Entries,
+		UniqueContents: stats.UniqueContents,
+		Rewatches:      stats.Rewatches,
+		AverageRating:  stats.AverageRating,
+		Months:         make([]dto.DiaryMonthStats, len(stats.Months)),
+	}
+	for i, count := range stats.Months {
+		statsDTO.Months[i] = dto.DiaryMonthStats{Month: i + 1, Entries: count}
+	}
+	return statsDTO, nil
+}
+
+func (d *DiaryService) GetContentHistory(
+	ctx context.Context,
+	userID, contentID int,
+) (*dto.DiaryContentHistory, error) {
+	ctx, span := tracing.Start(ctx, "DiaryService.GetContentHistory")
+	defer span.End()
+	entries, err := d.diaryRepo.GetEntriesByContent(ctx, userID, contentID)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении просмотров контента"), err)
+	}
+	history := &dto.DiaryContentHistory{
+		ContentID: contentID,
+		Views:     len(entries),
+		Entries:   make([]dto.DiaryEntry, len(entries)),
+	}
+	for i, entry := range entries {
+		history.Entries[i] = diaryEntryEntityToDTO(entry)
+		if entry.Rewatch {
+			history.Rewatches++
+		}
+	}
+	return history, nil
+}
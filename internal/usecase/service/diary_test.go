@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	mockrepo "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository/mocks"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	mock_usecase "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDiaryService_CreateEntry(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Now()
+	watchedOn := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		Name               string
+		Request            dto.DiaryEntryRequest
+		ExpectedOutput     *dto.DiaryEntry
+		ExpectedErr        error
+		SetupDiaryRepoMock func(repo *mockrepo.MockDiary)
+		SetupContentUCMock func(uc *mock_usecase.MockContent)
+	}{
+		{
+			Name:    "Успешное добавление",
+			Request: dto.DiaryEntryRequest{ContentID: 2, WatchedOn: "2024-06-01", Rating: 8, Rewatch: true},
+			ExpectedOutput: &dto.DiaryEntry{
+				ID:        5,
+				ContentID: 2,
+				WatchedOn: "2024-06-01",
+				Rating:    8,
+				Rewatch:   true,
+				CreatedAt: fixedTime.String(),
+				UpdatedAt: fixedTime.String(),
+			},
+			SetupDiaryRepoMock: func(repo *mockrepo.MockDiary) {
+				repo.EXPECT().CreateEntry(gomock.Any(), &entity.DiaryEntry{
+					UserID:    1,
+					ContentID: 2,
+					WatchedOn: watchedOn,
+					Rating:    8,
+					Rewatch:   true,
+				}).Return(&entity.DiaryEntry{
+					ID:        5,
+					UserID:    1,
+					ContentID: 2,
+					WatchedOn: watchedOn,
+					Rating:    8,
+					Rewatch:   true,
+					CreatedAt: fixedTime,
+					UpdatedAt: fixedTime,
+				}, nil)
+			},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {
+				uc.EXPECT().GetPreviewContentByID(gomock.Any(), 2).Return(&dto.PreviewContent{ID: 2}, nil)
+			},
+		},
+		{
+			Name:               "Неверный формат даты",
+			Request:            dto.DiaryEntryRequest{ContentID: 2, WatchedOn: "01.06.2024"},
+			ExpectedErr:        usecase.DiaryErrorIncorrectData{},
+			SetupDiaryRepoMock: func(repo *mockrepo.MockDiary) {},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {},
+		},
+		{
+			Name: "Дата в будущем",
+			Request: dto.DiaryEntryRequest{
+				ContentID: 2,
+				WatchedOn: time.Now().AddDate(0, 0, 3).Format(entity.DiaryDateLayout),
+			},
+			ExpectedErr:        usecase.DiaryErrorIncorrectData{},
+			SetupDiaryRepoMock: func(repo *mockrepo.MockDiary) {},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {},
+		},
+		{
+			Name:               "Контент еще не вышел",
+			Request:            dto.DiaryEntryRequest{ContentID: 2, WatchedOn: "2024-06-01"},
+			ExpectedErr:        usecase.ErrDiaryContentNotFound,
+			SetupDiaryRepoMock: func(repo *mockrepo.MockDiary) {},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {
+				uc.EXPECT().GetPreviewContentByID(gomock.Any(), 2).
+					Return(&dto.PreviewContent{ID: 2, Ongoing: true}, nil)
+			},
+		},
+		{
+			Name:        "Контент удален",
+			Request:     dto.DiaryEntryRequest{ContentID: 2, WatchedOn: "2024-06-01"},
+			ExpectedErr: usecase.ErrDiaryContentNotFound,
+			SetupDiaryRepoMock: func(repo *mockrepo.MockDiary) {
+				repo.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Return(nil, repository.ErrDiaryContentNotFound)
+			},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {
+				uc.EXPECT().GetPreviewContentByID(gomock.Any(), 2).Return(&dto.PreviewContent{ID: 2}, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockDiaryRepo := mockrepo.NewMockDiary(ctrl)
+			mockContentUC := mock_usecase.NewMockContent(ctrl)
+			tc.SetupDiaryRepoMock(mockDiaryRepo)
+			tc.SetupContentUCMock(mockContentUC)
+			service := NewDiaryService(mockDiaryRepo, mockContentUC)
+			output, err := service.CreateEntry(context.Background(), 1, tc.Request)
+			require.Equal(t, tc.ExpectedOutput, output)
+			if _, ok := tc.ExpectedErr.(usecase.DiaryErrorIncorrectData); ok {
+				require.ErrorAs(t, err, &usecase.DiaryErrorIncorrectData{})
+			} else {
+				require.Equal(t, tc.ExpectedErr, err)
+			}
+		})
+	}
+}
+
+func TestDiaryService_GetCalendar(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDiaryRepo := mockrepo.NewMockDiary(ctrl)
+	mockContentUC := mock_usecase.NewMockContent(ctrl)
+	from := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	mockDiaryRepo.EXPECT().GetEntriesByPeriod(gomock.Any(), 1, from, from.AddDate(0, 1, 0)).
+		Return([]*entity.DiaryEntry{
+			{ID: 1, ContentID: 2, WatchedOn: from},
+			{ID: 2, ContentID: 3, WatchedOn: from},
+			{ID: 3, ContentID: 2, WatchedOn: from.AddDate(0, 0, 9), Rewatch: true},
+		}, nil)
+	mockContentUC.EXPECT().GetPreviewContents(gomock.Any(), []int{2, 3, 2}).
+		Return([]*dto.PreviewContent{{ID: 2}, {ID: 3}, {ID: 2}}, nil)
+	service := NewDiaryService(mockDiaryRepo, mockContentUC)
+
+	calendar, err := service.GetCalendar(context.Background(), 1, 2024, 6)
+	require.NoError(t, err)
+	require.Equal(t, 3, calendar.Entries)
+	require.Len(t, calendar.Days, 2)
+	require.Equal(t, "2024-06-01", calendar.Days[0].Date)
+	require.Len(t, calendar.Days[0].Entries, 2)
+	require.Equal(t, "2024-06-10", calendar.Days[1].Date)
+	require.Equal(t, 3, calendar.Days[1].Entries[0].ID)
+	require.True(t, calendar.Days[1].Entries[0].Rewatch)
+
+	_, err = service.GetCalendar(context.Background(), 1, 2024, 13)
+	require.ErrorAs(t, err, &usecase.DiaryErrorIncorrectData{})
+}
+
+func TestDiaryService_GetYearStats(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDiaryRepo := mockrepo.NewMockDiary(ctrl)
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockDiaryRepo.EXPECT().GetEntriesByPeriod(gomock.Any(), 1, from, from.AddDate(1, 0, 0)).
+		Return([]*entity.DiaryEntry{
+			{ContentID: 2, WatchedOn: from, Rating: 6},
+			{ContentID: 2, WatchedOn: from.AddDate(0, 4, 0), Rewatch: true},
+		}, nil)
+	service := NewDiaryService(mockDiaryRepo, nil)
+
+	stats, err := service.GetYearStats(context.Background(), 1, 2024)
+	require.NoError(t, err)
+	require.Equal(t, 2, stats.Entries)
+	require.Equal(t, 1, stats.UniqueContents)
+	require.Equal(t, 1, stats.Rewatches)
+	require.InDelta(t, 6, stats.AverageRating, 1e-9)
+	require.Len(t, stats.Months, 12)
+	require.Equal(t, dto.DiaryMonthStats{Month: 5, Entries: 1}, stats.Months[4])
+}
@@ -1,27 +1,46 @@
 package service
 
 import (
+	"context"
+	"encoding/csv"
 	"errors"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	"github.com/mailru/easyjson"
+	"io"
 )
 
+// favouriteExportBatch количество записей истории, которое читается из базы за один запрос при выгрузке
+const favouriteExportBatch = 500
+
 type FavouriteService struct {
 	contentUC     usecase.Content
 	favouriteRepo repository.Favourite
+	activityRepo  repository.Activity
+	statsRepo     repository.UserStats
 }
 
-func NewFavouriteService(favouriteRepo repository.Favourite, contentUC usecase.Content) usecase.Favourite {
+func NewFavouriteService(
+	favouriteRepo repository.Favourite,
+	contentUC usecase.Content,
+	activityRepo repository.Activity,
+	statsRepo repository.UserStats,
+) usecase.Favourite {
 	return &FavouriteService{
 		favouriteRepo: favouriteRepo,
 		contentUC:     contentUC,
+		activityRepo:  activityRepo,
+		statsRepo:     statsRepo,
 	}
 }
 
-func (f FavouriteService) CreateFavourite(userID, contentID int, category string) error {
-	currentStatus, err := f.GetStatus(userID, contentID)
+func (f FavouriteService) CreateFavourite(ctx context.Context, userID, contentID int, category string) error {
+	ctx, span := tracing.Start(ctx, "FavouriteService.CreateFavourite")
+	defer span.End()
+	currentStatus, err := f.GetStatus(ctx, userID, contentID)
 	switch {
 	case errors.Is(err, usecase.ErrFavouriteNotFound):
 		// всё ок, добавляем статус
@@ -33,37 +52,47 @@ func (f FavouriteService) CreateFavourite(userID, contentID int, category string
 			return nil
 		}
 		// сначала удаляем старый статус
-		err = f.favouriteRepo.DeleteFavourite(userID, contentID)
+		err = f.favouriteRepo.DeleteFavourite(ctx, userID, contentID)
 		if err != nil {
 			return entity.UsecaseWrap(err, errors.New("ошибка при удалении из избранного в FavouriteService"))
 		}
 	}
 
-	err = f.favouriteRepo.CreateFavourite(userID, contentID, category)
+	err = f.favouriteRepo.CreateFavourite(ctx, userID, contentID, category)
 	switch {
 	case errors.Is(err, repository.ErrFavouriteContentNotFound):
 		return usecase.ErrFavouriteContentNotFound
 	case err != nil:
 		return entity.UsecaseWrap(err, errors.New("ошибка при добавлении в избранное в FavouriteService"))
-	default:
-		return nil
 	}
+	invalidateUserStats(ctx, f.statsRepo, userID, entity.UserStatsSectionWatched, entity.UserStatsSectionActivity)
+	recordActivity(ctx, f.activityRepo, &entity.Activity{
+		UserID:    userID,
+		Type:      entity.ActivityFavourite,
+		ContentID: contentID,
+		Category:  category,
+	})
+	return nil
 }
 
-func (f FavouriteService) DeleteFavourite(userID, contentID int) error {
-	err := f.favouriteRepo.DeleteFavourite(userID, contentID)
+func (f FavouriteService) DeleteFavourite(ctx context.Context, userID, contentID int) error {
+	ctx, span := tracing.Start(ctx, "FavouriteService.DeleteFavourite")
+	defer span.End()
+	err := f.favouriteRepo.DeleteFavourite(ctx, userID, contentID)
 	switch {
 	case errors.Is(err, repository.ErrFavouriteNotFound):
 		return usecase.ErrFavouriteNotFound
 	case err != nil:
 		return entity.UsecaseWrap(err, errors.New("ошибка при удалении из избранного в FavouriteService"))
-	default:
-		return nil
 	}
+	invalidateUserStats(ctx, f.statsRepo, userID, entity.UserStatsSectionWatched, entity.UserStatsSectionActivity)
+	return nil
 }
 
-func (f FavouriteService) GetFavourites(userID int) (*dto.FavouritesResponse, error) {
-	favourites, err := f.favouriteRepo.GetFavourites(userID)
+func (f FavouriteService) GetFavourites(ctx context.Context, userID int) (*dto.FavouritesResponse, error) {
+	ctx, span := tracing.Start(ctx, "FavouriteService.GetFavourites")
+	defer span.End()
+	favourites, err := f.favouriteRepo.GetFavourites(ctx, userID)
 	switch {
 	case errors.Is(err, repository.ErrFavouriteUserNotFound):
 		return nil, usecase.ErrFavouriteUserNotFound
@@ -73,21 +102,27 @@ func (f FavouriteService) GetFavourites(userID int) (*dto.FavouritesResponse, er
 	response := dto.FavouritesResponse{
 		Favourites: make([]dto.Favourite, len(favourites)),
 	}
+	contentIDs := make([]int, len(favourites))
+	for index, favourite := range favourites {
+		contentIDs[index] = favourite.ContentID
+	}
+	contents, err := f.contentUC.GetPreviewContents(ctx, contentIDs)
+	if err != nil {
+		return nil, entity.UsecaseWrap(err, errors.New("ошибка при получении контента из избранного в FavouriteService"))
+	}
 	for index, favourite := range favourites {
-		content, err := f.contentUC.GetPreviewContentByID(favourite.ContentID)
-		if err != nil {
-			return nil, entity.UsecaseWrap(err, errors.New("ошибка при получении контента из избранного в FavouriteService"))
-		}
 		response.Favourites[index] = dto.Favourite{
-			Content:  *content,
+			Content:  *contents[index],
 			Category: favourite.Category,
 		}
 	}
 	return &response, nil
 }
 
-func (f FavouriteService) GetStatus(userID, contentID int) (*dto.FavouriteStatusResponse, error) {
-	status, err := f.favouriteRepo.GetFavourite(userID, contentID)
+func (f FavouriteService) GetStatus(ctx context.Context, userID, contentID int) (*dto.FavouriteStatusResponse, error) {
+	ctx, span := tracing.Start(ctx, "FavouriteService.GetStatus")
+	defer span.End()
+	status, err := f.favouriteRepo.GetFavourite(ctx, userID, contentID)
 	switch {
 	case errors.Is(err, repository.ErrFavouriteNotFound):
 		return nil, usecase.ErrFavouriteNotFound
@@ -100,3 +135,134 @@ func (f FavouriteService) GetStatus(userID, contentID int) (*dto.FavouriteStatus
 		}, nil
 	}
 }
+
+func exportItemEntityToDTO(item *entity.ExportItem) *dto.ExportItem {
+	exportItem := &dto.ExportItem{
+		ContentID:     item.ContentID,
+		KinopoiskID:   item.KinopoiskID,
+		Title:         item.Title,
+		OriginalTitle: item.OriginalTitle,
+		Year:          item.Year,
+		Category:      item.Category,
+		Rating:        item.Rating,
+	}
+	if item.AddedAt.Valid {
+		exportItem.AddedAt = item.AddedAt.Time.String()
+	}
+	if item.RatedAt.Valid {
+		exportItem.RatedAt = item.RatedAt.Time.String()
+	}
+	// у рецензии всегда есть заголовок, поэтому по нему видно, писал ли ее пользователь
+	if item.ReviewTitle != "" {
+		exportItem.Review = &dto.ExportReview{
+			Title:  item.ReviewTitle,
+			Text:   item.ReviewText,
+			Rating: item.ReviewRating,
+		}
+		if item.ReviewedAt.Valid {
+			exportItem.Review.CreatedAt = item.ReviewedAt.Time.String()
+		}
+	}
+	return exportItem
+}
+
+// exportWriter записывает историю пользователя в выгрузку по одной записи
+type exportWriter interface {
+	write(item *entity.ExportItem) error
+	close() error
+}
+
+type csvExportWriter struct {
+	writer     *csv.Writer
+	letterboxd bool
+}
+
+func (c *csvExportWriter) write(item *entity.ExportItem) error {
+	if !c.letterboxd {
+		return c.writer.Write(item.CSVRecord())
+	}
+	if !item.Watched() {
+		return nil
+	}
+	return c.writer.Write(item.LetterboxdRecord())
+}
+
+func (c *csvExportWriter) close() error {
+	c.writer.Flush()
+	return c.writer.Error()
+}
+
+// jsonExportWriter пишет JSON-массив по элементу, не собирая его в памяти
+type jsonExportWriter struct {
+	w       io.Writer
+	written bool
+}
+
+func (j *jsonExportWriter) write(item *entity.ExportItem) error {
+	separator := ","
+	if !j.written {
+		separator = "["
+		j.written = true
+	}
+	if _, err := io.WriteString(j.w, separator); err != nil {
+		return err
+	}
+	_, err := easyjson.MarshalToWriter(exportItemEntityToDTO(item), j.w)
+	return err
+}
+
+func (j *jsonExportWriter) close() error {
+	end := "]"
+	if !j.written {
+		end = "[]"
+	}
+	_, err := io.WriteString(j.w, end)
+	return err
+}
+
+func newExportWriter(format string, w io.Writer) (exportWriter, error) {
+	if format == entity.ExportFormatJSON {
+		return &jsonExportWriter{w: w}, nil
+	}
+	writer := &csvExportWriter{writer: csv.NewWriter(w), letterboxd: format == entity.ExportFormatLetterboxd}
+	header := entity.ExportCSVHeader
+	if writer.letterboxd {
+		header = entity.LetterboxdCSVHeader
+	}
+	if err := writer.writer.Write(header); err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+func (f FavouriteService) ExportHistory(ctx context.Context, userID int, format string, w io.Writer) error {
+	ctx, span := tracing.Start(ctx, "FavouriteService.ExportHistory")
+	defer span.End()
+	if err := entity.ValidateExportFormat(format); err != nil {
+		return usecase.ErrFavouriteExportFormat
+	}
+	writer, err := newExportWriter(format, w)
+	if err != nil {
+		return entity.UsecaseWrap(err, errors.New("ошибка при записи выгрузки в FavouriteService"))
+	}
+	afterContentID := 0
+	for {
+		items, err := f.favouriteRepo.GetExportItems(ctx, userID, afterContentID, favouriteExportBatch)
+		if err != nil {
+			return entity.UsecaseWrap(err, errors.New("ошибка при получении истории для выгрузки в FavouriteService"))
+		}
+		for _, item := range items {
+			if err = writer.write(item); err != nil {
+				return entity.UsecaseWrap(err, errors.New("ошибка при записи выгрузки в FavouriteService"))
+			}
+		}
+		if len(items) < favouriteExportBatch {
+			break
+		}
+		afterContentID = items[len(items)-1].ContentID
+	}
+	if err = writer.close(); err != nil {
+		return entity.UsecaseWrap(err, errors.New("ошибка при записи выгрузки в FavouriteService"))
+	}
+	return nil
+}
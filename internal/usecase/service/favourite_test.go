@@ -1,15 +1,20 @@
 package service
 
 import (
-	//"database/sql"
+	"bytes"
+	"context"
+	"database/sql"
 	"errors"
 	mock_usecase "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/mocks"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
 	mockrepo "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository/mocks"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
 
 	//"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
@@ -25,6 +30,7 @@ func TestFavouriteService_DeleteFavourite(t *testing.T) {
 		ContentID              int
 		ExpectedErr            error
 		SetupFavouriteRepoMock func(repo *mockrepo.MockFavourite)
+		SetupStatsRepoMock     func(repo *mockrepo.MockUserStats)
 	}{
 		{
 			Name:        "Успех",
@@ -32,7 +38,12 @@ func TestFavouriteService_DeleteFavourite(t *testing.T) {
 			ContentID:   1,
 			ExpectedErr: nil,
 			SetupFavouriteRepoMock: func(repo *mockrepo.MockFavourite) {
-				repo.EXPECT().DeleteFavourite(1, 1).Return(nil).AnyTimes()
+				repo.EXPECT().DeleteFavourite(gomock.Any(), 1, 1).Return(nil).AnyTimes()
+			},
+			SetupStatsRepoMock: func(repo *mockrepo.MockUserStats) {
+				repo.EXPECT().
+					InvalidateUserStats(gomock.Any(), 1, entity.UserStatsSectionWatched, entity.UserStatsSectionActivity).
+					Return(nil)
 			},
 		},
 		{
@@ -41,8 +52,9 @@ func TestFavouriteService_DeleteFavourite(t *testing.T) {
 			ContentID:   1,
 			ExpectedErr: entity.UsecaseWrap(errors.New("database error"), errors.New("ошибка при удалении из избранного в FavouriteService")),
 			SetupFavouriteRepoMock: func(repo *mockrepo.MockFavourite) {
-				repo.EXPECT().DeleteFavourite(1, 1).Return(errors.New("database error"))
+				repo.EXPECT().DeleteFavourite(gomock.Any(), 1, 1).Return(errors.New("database error"))
 			},
+			SetupStatsRepoMock: func(repo *mockrepo.MockUserStats) {},
 		},
 		{
 			Name:        "Избранное не найдено",
@@ -50,8 +62,9 @@ func TestFavouriteService_DeleteFavourite(t *testing.T) {
 			ContentID:   1,
 			ExpectedErr: repository.ErrFavouriteNotFound,
 			SetupFavouriteRepoMock: func(repo *mockrepo.MockFavourite) {
-				repo.EXPECT().DeleteFavourite(1, 1).Return(repository.ErrFavouriteNotFound)
+				repo.EXPECT().DeleteFavourite(gomock.Any(), 1, 1).Return(repository.ErrFavouriteNotFound)
 			},
+			SetupStatsRepoMock: func(repo *mockrepo.MockUserStats) {},
 		},
 	}
 
@@ -63,9 +76,11 @@ func TestFavouriteService_DeleteFavourite(t *testing.T) {
 			defer ctrl.Finish()
 			mockFavouriteRepo := mockrepo.NewMockFavourite(ctrl)
 			mockContentUC := mock_usecase.NewMockContent(ctrl)
-			favService := NewFavouriteService(mockFavouriteRepo, mockContentUC)
+			mockStatsRepo := mockrepo.NewMockUserStats(ctrl)
+			favService := NewFavouriteService(mockFavouriteRepo, mockContentUC, nil, mockStatsRepo)
 			tc.SetupFavouriteRepoMock(mockFavouriteRepo)
-			err := favService.DeleteFavourite(tc.UserID, tc.ContentID)
+			tc.SetupStatsRepoMock(mockStatsRepo)
+			err := favService.DeleteFavourite(context.Background(), tc.UserID, tc.ContentID)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
 	}
@@ -92,12 +107,12 @@ func TestFavouriteService_GetFavourites(t *testing.T) {
 				},
 			},
 			SetupFavouriteRepoMock: func(repo *mockrepo.MockFavourite) {
-				repo.EXPECT().GetFavourites(1).Return([]*entity.Favourite{
+				repo.EXPECT().GetFavourites(gomock.Any(), 1).Return([]*entity.Favourite{
 					{ContentID: 1, Category: "favourite"},
 				}, nil).AnyTimes()
 			},
 			SetupContentUCMock: func(uc *mock_usecase.MockContent) {
-				uc.EXPECT().GetPreviewContentByID(1).Return(&dto.PreviewContent{ID: 1}, nil).AnyTimes()
+				uc.EXPECT().GetPreviewContents(gomock.Any(), []int{1}).Return([]*dto.PreviewContent{{ID: 1}}, nil).AnyTimes()
 			},
 		},
 		{
@@ -105,7 +120,7 @@ func TestFavouriteService_GetFavourites(t *testing.T) {
 			UserID:      1,
 			ExpectedErr: entity.UsecaseWrap(errors.New("database error"), errors.New("ошибка при получении избранного контента в FavouriteService")),
 			SetupFavouriteRepoMock: func(repo *mockrepo.MockFavourite) {
-				repo.EXPECT().GetFavourites(1).Return(nil, errors.New("database error"))
+				repo.EXPECT().GetFavourites(gomock.Any(), 1).Return(nil, errors.New("database error"))
 			},
 			SetupContentUCMock: func(uc *mock_usecase.MockContent) {
 
@@ -116,12 +131,12 @@ func TestFavouriteService_GetFavourites(t *testing.T) {
 			UserID:      1,
 			ExpectedErr: entity.UsecaseWrap(errors.New("database error"), errors.New("ошибка при получении контента из избранного в FavouriteService")),
 			SetupFavouriteRepoMock: func(repo *mockrepo.MockFavourite) {
-				repo.EXPECT().GetFavourites(1).Return([]*entity.Favourite{
+				repo.EXPECT().GetFavourites(gomock.Any(), 1).Return([]*entity.Favourite{
 					{ContentID: 1, Category: "favourite"},
 				}, nil).AnyTimes()
 			},
 			SetupContentUCMock: func(uc *mock_usecase.MockContent) {
-				uc.EXPECT().GetPreviewContentByID(1).Return(nil, errors.New("database error"))
+				uc.EXPECT().GetPreviewContents(gomock.Any(), []int{1}).Return(nil, errors.New("database error"))
 			},
 		},
 		{
@@ -129,7 +144,7 @@ func TestFavouriteService_GetFavourites(t *testing.T) {
 			UserID:      1,
 			ExpectedErr: repository.ErrFavouriteUserNotFound,
 			SetupFavouriteRepoMock: func(repo *mockrepo.MockFavourite) {
-				repo.EXPECT().GetFavourites(1).Return(nil, repository.ErrFavouriteUserNotFound)
+				repo.EXPECT().GetFavourites(gomock.Any(), 1).Return(nil, repository.ErrFavouriteUserNotFound)
 			},
 			SetupContentUCMock: func(uc *mock_usecase.MockContent) {
 
@@ -145,10 +160,10 @@ func TestFavouriteService_GetFavourites(t *testing.T) {
 			defer ctrl.Finish()
 			mockFavouriteRepo := mockrepo.NewMockFavourite(ctrl)
 			mockContentUC := mock_usecase.NewMockContent(ctrl)
-			favService := NewFavouriteService(mockFavouriteRepo, mockContentUC)
+			favService := NewFavouriteService(mockFavouriteRepo, mockContentUC, nil, nil)
 			tc.SetupFavouriteRepoMock(mockFavouriteRepo)
 			tc.SetupContentUCMock(mockContentUC)
-			response, err := favService.GetFavourites(tc.UserID)
+			response, err := favService.GetFavourites(context.Background(), tc.UserID)
 			require.Equal(t, tc.ExpectedErr, err)
 			require.Equal(t, tc.ExpectedFavourites, response)
 		})
@@ -175,7 +190,7 @@ func TestFavouriteService_GetStatus(t *testing.T) {
 				Status: "favourite",
 			},
 			SetupFavouriteRepoMock: func(repo *mockrepo.MockFavourite) {
-				repo.EXPECT().GetFavourite(1, 1).Return(&entity.Favourite{
+				repo.EXPECT().GetFavourite(gomock.Any(), 1, 1).Return(&entity.Favourite{
 					Category: "favourite",
 				}, nil).AnyTimes()
 			},
@@ -186,7 +201,7 @@ func TestFavouriteService_GetStatus(t *testing.T) {
 			ContentID:   1,
 			ExpectedErr: entity.UsecaseWrap(errors.New("database error"), errors.New("ошибка при получении статуса контента в избранном в FavouriteService")),
 			SetupFavouriteRepoMock: func(repo *mockrepo.MockFavourite) {
-				repo.EXPECT().GetFavourite(1, 1).Return(nil, errors.New("database error"))
+				repo.EXPECT().GetFavourite(gomock.Any(), 1, 1).Return(nil, errors.New("database error"))
 			},
 		},
 		{
@@ -195,7 +210,7 @@ func TestFavouriteService_GetStatus(t *testing.T) {
 			ContentID:   1,
 			ExpectedErr: repository.ErrFavouriteNotFound,
 			SetupFavouriteRepoMock: func(repo *mockrepo.MockFavourite) {
-				repo.EXPECT().GetFavourite(1, 1).Return(nil, repository.ErrFavouriteNotFound)
+				repo.EXPECT().GetFavourite(gomock.Any(), 1, 1).Return(nil, repository.ErrFavouriteNotFound)
 			},
 		},
 	}
@@ -208,9 +223,9 @@ func TestFavouriteService_GetStatus(t *testing.T) {
 			defer ctrl.Finish()
 			mockFavouriteRepo := mockrepo.NewMockFavourite(ctrl)
 			mockContentUC := mock_usecase.NewMockContent(ctrl)
-			favService := NewFavouriteService(mockFavouriteRepo, mockContentUC)
+			favService := NewFavouriteService(mockFavouriteRepo, mockContentUC, nil, nil)
 			tc.SetupFavouriteRepoMock(mockFavouriteRepo)
-			response, err := favService.GetStatus(tc.UserID, tc.ContentID)
+			response, err := favService.GetStatus(context.Background(), tc.UserID, tc.ContentID)
 			require.Equal(t, tc.ExpectedErr, err)
 			require.Equal(t, tc.ExpectedStatus, response)
 		})
@@ -227,6 +242,7 @@ func TestFavouriteService_CreateFavourite(t *testing.T) {
 		Category               string
 		ExpectedErr            error
 		SetupFavouriteRepoMock func(repo *mockrepo.MockFavourite)
+		SetupActivityRepoMock  func(repo *mockrepo.MockActivity)
 	}{
 		{
 			Name:        "Успех",
@@ -236,11 +252,30 @@ func TestFavouriteService_CreateFavourite(t *testing.T) {
 			ExpectedErr: nil,
 			SetupFavouriteRepoMock: func(repo *mockrepo.MockFavourite) {
 				gomock.InOrder(
-					repo.EXPECT().GetFavourite(1, 1).Return(&entity.Favourite{Category: "oldFavourite"}, nil),
-					repo.EXPECT().DeleteFavourite(1, 1).Return(nil),
-					repo.EXPECT().CreateFavourite(1, 1, "newFavourite").Return(nil),
+					repo.EXPECT().GetFavourite(gomock.Any(), 1, 1).Return(&entity.Favourite{Category: "oldFavourite"}, nil),
+					repo.EXPECT().DeleteFavourite(gomock.Any(), 1, 1).Return(nil),
+					repo.EXPECT().CreateFavourite(gomock.Any(), 1, 1, "newFavourite").Return(nil),
 				)
 			},
+			SetupActivityRepoMock: func(repo *mockrepo.MockActivity) {
+				repo.EXPECT().AddActivity(gomock.Any(), &entity.Activity{
+					UserID: 1, Type: entity.ActivityFavourite, ContentID: 1, Category: "newFavourite",
+				}).Return(nil)
+			},
+		},
+		{
+			Name:        "Ошибка записи в ленту не прерывает добавление",
+			UserID:      1,
+			ContentID:   1,
+			Category:    "newFavourite",
+			ExpectedErr: nil,
+			SetupFavouriteRepoMock: func(repo *mockrepo.MockFavourite) {
+				repo.EXPECT().GetFavourite(gomock.Any(), 1, 1).Return(nil, repository.ErrFavouriteNotFound)
+				repo.EXPECT().CreateFavourite(gomock.Any(), 1, 1, "newFavourite").Return(nil)
+			},
+			SetupActivityRepoMock: func(repo *mockrepo.MockActivity) {
+				repo.EXPECT().AddActivity(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+			},
 		},
 		{
 			Name:        "Избранное уже в нужной категории",
@@ -249,8 +284,9 @@ func TestFavouriteService_CreateFavourite(t *testing.T) {
 			Category:    "favourite",
 			ExpectedErr: nil,
 			SetupFavouriteRepoMock: func(repo *mockrepo.MockFavourite) {
-				repo.EXPECT().GetFavourite(1, 1).Return(&entity.Favourite{Category: "favourite"}, nil)
+				repo.EXPECT().GetFavourite(gomock.Any(), 1, 1).Return(&entity.Favourite{Category: "favourite"}, nil)
 			},
+			SetupActivityRepoMock: func(repo *mockrepo.MockActivity) {},
 		},
 		{
 			Name:        "Ошибка при создании избранного",
@@ -260,11 +296,12 @@ func TestFavouriteService_CreateFavourite(t *testing.T) {
 			ExpectedErr: entity.UsecaseWrap(errors.New("database error"), errors.New("ошибка при добавлении в избранное в FavouriteService")),
 			SetupFavouriteRepoMock: func(repo *mockrepo.MockFavourite) {
 				gomock.InOrder(
-					repo.EXPECT().GetFavourite(1, 1).Return(&entity.Favourite{Category: "oldFavourite"}, nil),
-					repo.EXPECT().DeleteFavourite(1, 1).Return(nil),
-					repo.EXPECT().CreateFavourite(1, 1, "newFavourite").Return(errors.New("database error")),
+					repo.EXPECT().GetFavourite(gomock.Any(), 1, 1).Return(&entity.Favourite{Category: "oldFavourite"}, nil),
+					repo.EXPECT().DeleteFavourite(gomock.Any(), 1, 1).Return(nil),
+					repo.EXPECT().CreateFavourite(gomock.Any(), 1, 1, "newFavourite").Return(errors.New("database error")),
 				)
 			},
+			SetupActivityRepoMock: func(repo *mockrepo.MockActivity) {},
 		},
 	}
 
@@ -276,10 +313,131 @@ func TestFavouriteService_CreateFavourite(t *testing.T) {
 			defer ctrl.Finish()
 			mockFavouriteRepo := mockrepo.NewMockFavourite(ctrl)
 			mockContentUC := mock_usecase.NewMockContent(ctrl)
-			favService := NewFavouriteService(mockFavouriteRepo, mockContentUC)
+			mockActivityRepo := mockrepo.NewMockActivity(ctrl)
+			mockStatsRepo := mockrepo.NewMockUserStats(ctrl)
+			mockStatsRepo.EXPECT().InvalidateUserStats(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			favService := NewFavouriteService(mockFavouriteRepo, mockContentUC, mockActivityRepo, mockStatsRepo)
+			tc.SetupFavouriteRepoMock(mockFavouriteRepo)
+			tc.SetupActivityRepoMock(mockActivityRepo)
+			err := favService.CreateFavourite(context.Background(), tc.UserID, tc.ContentID, tc.Category)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestFavouriteService_ExportHistory(t *testing.T) {
+	t.Parallel()
+
+	addedAt := time.Date(2024, 6, 14, 10, 0, 0, 0, time.UTC)
+	items := []*entity.ExportItem{
+		{
+			ContentID:     1,
+			KinopoiskID:   409,
+			Title:         "Схватка",
+			OriginalTitle: "Heat",
+			Year:          1995,
+			Category:      entity.FavouriteCategoryWatched,
+			AddedAt:       sql.NullTime{Time: addedAt, Valid: true},
+			Rating:        9,
+		},
+		{
+			ContentID:    2,
+			Title:        "Чужой",
+			ReviewTitle:  "Страшно",
+			ReviewText:   "Очень",
+			ReviewRating: 8,
+		},
+		{
+			ContentID: 3,
+			Title:     "Дюна",
+			Category:  entity.FavouriteCategoryPlanned,
+		},
+	}
+	testCases := []struct {
+		Name                   string
+		Format                 string
+		ExpectedOutput         string
+		ExpectedErr            error
+		SetupFavouriteRepoMock func(repo *mockrepo.MockFavourite)
+	}{
+		{
+			Name:   "CSV",
+			Format: entity.ExportFormatCSV,
+			ExpectedOutput: "content_id,kinopoisk_id,title,original_title,year,category,added_at," +
+				"rating,rated_at,review_title,review_text,review_rating,reviewed_at\n" +
+				"1,409,Схватка,Heat,1995,watched,2024-06-14 10:00:00 +0000,9,,,,,\n" +
+				"2,,Чужой,,,,,,,Страшно,Очень,8,\n" +
+				"3,,Дюна,,,planned,,,,,,,\n",
+			SetupFavouriteRepoMock: func(repo *mockrepo.MockFavourite) {
+				repo.EXPECT().GetExportItems(gomock.Any(), 1, 0, favouriteExportBatch).Return(items, nil)
+			},
+		},
+		{
+			Name:   "Letterboxd без запланированного",
+			Format: entity.ExportFormatLetterboxd,
+			ExpectedOutput: "Title,Year,Rating10,WatchedDate,Review\n" +
+				"Heat,1995,9,2024-06-14,\n" +
+				"Чужой,,8,,\"Страшно\n\nОчень\"\n",
+			SetupFavouriteRepoMock: func(repo *mockrepo.MockFavourite) {
+				repo.EXPECT().GetExportItems(gomock.Any(), 1, 0, favouriteExportBatch).Return(items, nil)
+			},
+		},
+		{
+			Name:   "JSON",
+			Format: entity.ExportFormatJSON,
+			ExpectedOutput: `[{"contentID":1,"kinopoiskID":409,"title":"Схватка","originalTitle":"Heat","year":1995,` +
+				`"category":"watched","addedAt":"2024-06-14 10:00:00 +0000 UTC","rating":9},` +
+				`{"contentID":2,"title":"Чужой","review":{"title":"Страшно","text":"Очень","rating":8,"createdAt":""}},` +
+				`{"contentID":3,"title":"Дюна","category":"planned"}]`,
+			SetupFavouriteRepoMock: func(repo *mockrepo.MockFavourite) {
+				repo.EXPECT().GetExportItems(gomock.Any(), 1, 0, favouriteExportBatch).Return(items, nil)
+			},
+		},
+		{
+			Name:           "Пустая история в JSON",
+			Format:         entity.ExportFormatJSON,
+			ExpectedOutput: "[]",
+			SetupFavouriteRepoMock: func(repo *mockrepo.MockFavourite) {
+				repo.EXPECT().GetExportItems(gomock.Any(), 1, 0, favouriteExportBatch).Return([]*entity.ExportItem{}, nil)
+			},
+		},
+		{
+			Name:           "История читается частями",
+			Format:         entity.ExportFormatJSON,
+			ExpectedOutput: "[" + strings.Repeat(`{"contentID":7,"title":""},`, favouriteExportBatch) + `{"contentID":8,"title":""}]`,
+			SetupFavouriteRepoMock: func(repo *mockrepo.MockFavourite) {
+				batch := make([]*entity.ExportItem, favouriteExportBatch)
+				for i := range batch {
+					batch[i] = &entity.ExportItem{ContentID: 7}
+				}
+				gomock.InOrder(
+					repo.EXPECT().GetExportItems(gomock.Any(), 1, 0, favouriteExportBatch).Return(batch, nil),
+					repo.EXPECT().GetExportItems(gomock.Any(), 1, 7, favouriteExportBatch).
+						Return([]*entity.ExportItem{{ContentID: 8}}, nil),
+				)
+			},
+		},
+		{
+			Name:                   "Неизвестный формат",
+			Format:                 "xml",
+			ExpectedErr:            usecase.ErrFavouriteExportFormat,
+			SetupFavouriteRepoMock: func(repo *mockrepo.MockFavourite) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockFavouriteRepo := mockrepo.NewMockFavourite(ctrl)
 			tc.SetupFavouriteRepoMock(mockFavouriteRepo)
-			err := favService.CreateFavourite(tc.UserID, tc.ContentID, tc.Category)
+			favService := NewFavouriteService(mockFavouriteRepo, mock_usecase.NewMockContent(ctrl), mockrepo.NewMockActivity(ctrl), nil)
+			var output bytes.Buffer
+			err := favService.ExportHistory(context.Background(), 1, tc.Format, &output)
 			require.Equal(t, tc.ExpectedErr, err)
+			require.Equal(t, tc.ExpectedOutput, output.String())
 		})
 	}
 }
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/logger"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+)
+
+type FeedService struct {
+	activityRepo repository.Activity
+	userUC       usecase.User
+	contentUC    usecase.Content
+}
+
+func NewFeedService(activityRepo repository.Activity, userUC usecase.User, contentUC usecase.Content) usecase.Feed {
+	return &FeedService{
+		activityRepo: activityRepo,
+		userUC:       userUC,
+		contentUC:    contentUC,
+	}
+}
+
+// recordActivity записывает событие в журнал, из которого собирается лента подписок. Лента вторична, поэтому
+// ошибка не прерывает запрос, а только логируется
+func recordActivity(ctx context.Context, activityRepo repository.Activity, activity *entity.Activity) {
+	if err := activityRepo.AddActivity(ctx, activity); err != nil {
+		logger.ForPackage("service").WarnContext(ctx, "не удалось записать событие в ленту",
+			"type", activity.Type,
+			"user_id", activity.UserID,
+			"content_id", activity.ContentID,
+			"error", err,
+		)
+	}
+}
+
+func (f *FeedService) GetFeed(ctx context.Context, userID, count, page int) (*dto.Feed, error) {
+	ctx, span := tracing.Start(ctx, "FeedService.GetFeed")
+	defer span.End()
+	activities, err := f.activityRepo.GetFeed(ctx, userID, page, count)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении ленты"), err)
+	}
+	total, err := f.activityRepo.GetFeedCount(ctx, userID)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении количества событий в ленте"), err)
+	}
+	contentIDs := make([]int, len(activities))
+	for i, activity := range activities {
+		contentIDs[i] = activity.ContentID
+	}
+	previews, err := f.contentUC.GetPreviewContents(ctx, contentIDs)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении контента ленты"), err)
+	}
+	// в ленте обычно несколько событий одного пользователя подряд, поэтому пользователи запрашиваются по разу
+	users := make(map[int]*dto.FollowUser)
+	feed := &dto.Feed{
+		Items: make([]dto.FeedItem, len(activities)),
+		Page:  page,
+		Count: count,
+		Pages: (total + count - 1) / count,
+		Total: total,
+	}
+	for i, activity := range activities {
+		user, ok := users[activity.UserID]
+		if !ok {
+			user, err = getFollowUser(ctx, f.userUC, activity.UserID)
+			if err != nil {
+				return nil, err
+			}
+			users[activity.UserID] = user
+		}
+		feed.Items[i] = dto.FeedItem{
+			ID:        activity.ID,
+			Type:      activity.Type,
+			User:      *user,
+			Content:   *previews[i],
+			ReviewID:  activity.ReviewID,
+			ListID:    activity.ListID,
+			Rating:    activity.Rating,
+			Category:  activity.Category,
+			CreatedAt: activity.CreatedAt.String(),
+		}
+	}
+	return feed, nil
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	mockrepo "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository/mocks"
+	mock_usecase "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFeedService_GetFeed(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Now()
+	testCases := []struct {
+		Name                  string
+		ExpectedOutput        *dto.Feed
+		ExpectedErr           bool
+		SetupActivityRepoMock func(repo *mockrepo.MockActivity)
+		SetupUserUCMock       func(uc *mock_usecase.MockUser)
+		SetupContentUCMock    func(uc *mock_usecase.MockContent)
+	}{
+		{
+			Name: "Лента с несколькими событиями одного пользователя",
+			ExpectedOutput: &dto.Feed{
+				Items: []dto.FeedItem{
+					{
+						ID:        3,
+						Type:      entity.ActivityRating,
+						User:      dto.FollowUser{ID: 2, Name: "Егор"},
+						Content:   dto.PreviewContent{ID: 10, Title: "Бэтмен"},
+						Rating:    8,
+						CreatedAt: fixedTime.String(),
+					},
+					{
+						ID:        2,
+						Type:      entity.ActivityReview,
+						User:      dto.FollowUser{ID: 2, Name: "Егор"},
+						Content:   dto.PreviewContent{ID: 11, Title: "Джокер"},
+						ReviewID:  7,
+						CreatedAt: fixedTime.String(),
+					},
+				},
+				Page:  1,
+				Count: 20,
+				Pages: 1,
+				Total: 2,
+			},
+			SetupActivityRepoMock: func(repo *mockrepo.MockActivity) {
+				repo.EXPECT().GetFeed(gomock.Any(), 1, 1, 20).Return([]*entity.Activity{
+					{ID: 3, UserID: 2, Type: entity.ActivityRating, ContentID: 10, Rating: 8, CreatedAt: fixedTime},
+					{ID: 2, UserID: 2, Type: entity.ActivityReview, ContentID: 11, ReviewID: 7, CreatedAt: fixedTime},
+				}, nil)
+				repo.EXPECT().GetFeedCount(gomock.Any(), 1).Return(2, nil)
+			},
+			SetupUserUCMock: func(uc *mock_usecase.MockUser) {
+				// пользователь запрашивается один раз на всю страницу
+				uc.EXPECT().GetUser(gomock.Any(), 2).
+					Return(&dto.UserProfile{ID: 2, Name: "Егор", Email: "egor@mail.ru"}, nil).
+					Times(1)
+			},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {
+				uc.EXPECT().GetPreviewContents(gomock.Any(), []int{10, 11}).Return([]*dto.PreviewContent{
+					{ID: 10, Title: "Бэтмен"},
+					{ID: 11, Title: "Джокер"},
+				}, nil)
+			},
+		},
+		{
+			Name:           "Ошибка при получении ленты",
+			ExpectedOutput: nil,
+			ExpectedErr:    true,
+			SetupActivityRepoMock: func(repo *mockrepo.MockActivity) {
+				repo.EXPECT().GetFeed(gomock.Any(), 1, 1, 20).Return(nil, errors.New("database error"))
+			},
+			SetupUserUCMock:    func(uc *mock_usecase.MockUser) {},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockActivityRepo := mockrepo.NewMockActivity(ctrl)
+			mockUserUC := mock_usecase.NewMockUser(ctrl)
+			mockContentUC := mock_usecase.NewMockContent(ctrl)
+			tc.SetupActivityRepoMock(mockActivityRepo)
+			tc.SetupUserUCMock(mockUserUC)
+			tc.SetupContentUCMock(mockContentUC)
+			service := NewFeedService(mockActivityRepo, mockUserUC, mockContentUC)
+			output, err := service.GetFeed(context.Background(), 1, 20, 1)
+			require.Equal(t, tc.ExpectedOutput, output)
+			require.Equal(t, tc.ExpectedErr, err != nil)
+		})
+	}
+}
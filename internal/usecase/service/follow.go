@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+)
+
+type FollowService struct {
+	followRepo repository.Follow
+	userUC     usecase.User
+}
+
+func NewFollowService(followRepo repository.Follow, userUC usecase.User) usecase.Follow {
+	return &FollowService{
+		followRepo: followRepo,
+		userUC:     userUC,
+	}
+}
+
+// getFollowUser возвращает пользователя для списков подписок и ленты. Почта, как и в публичном профиле, не
+// возвращается
+func getFollowUser(ctx context.Context, userUC usecase.User, userID int) (*dto.FollowUser, error) {
+	user, err := userUC.GetUser(ctx, userID)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении пользователя"), err)
+	}
+	return &dto.FollowUser{
+		ID:     user.ID,
+		Name:   user.Name,
+		Avatar: user.Avatar,
+	}, nil
+}
+
+func (f *FollowService) Follow(ctx context.Context, followerID, followeeID int) error {
+	ctx, span := tracing.Start(ctx, "FollowService.Follow")
+	defer span.End()
+	if followerID == followeeID {
+		return usecase.ErrFollowSelf
+	}
+	err := f.followRepo.Follow(ctx, followerID, followeeID)
+	switch {
+	case errors.Is(err, repository.ErrFollowUserNotFound):
+		return usecase.ErrFollowUserNotFound
+	case err != nil:
+		return entity.UsecaseWrap(errors.New("ошибка при подписке на пользователя"), err)
+	default:
+		return nil
+	}
+}
+
+func (f *FollowService) Unfollow(ctx context.Context, followerID, followeeID int) error {
+	ctx, span := tracing.Start(ctx, "FollowService.Unfollow")
+	defer span.End()
+	err := f.followRepo.Unfollow(ctx, followerID, followeeID)
+	switch {
+	case errors.Is(err, repository.ErrFollowNotFound):
+		return usecase.ErrFollowNotFound
+	case err != nil:
+		return entity.UsecaseWrap(errors.New("ошибка при отмене подписки"), err)
+	default:
+		return nil
+	}
+}
+
+func (f *FollowService) GetFollowStatus(ctx context.Context, viewerID, userID int) (*dto.FollowStatus, error) {
+	ctx, span := tracing.Start(ctx, "FollowService.GetFollowStatus")
+	defer span.End()
+	status := new(dto.FollowStatus)
+	var err error
+	status.Following, err = f.followRepo.IsFollowing(ctx, viewerID, userID)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при проверке подписки"), err)
+	}
+	status.FollowersCount, err = f.followRepo.GetFollowersCount(ctx, userID)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении количества подписчиков"), err)
+	}
+	status.FollowingCount, err = f.followRepo.GetFollowingCount(ctx, userID)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении количества подписок"), err)
+	}
+	return status, nil
+}
+
+// followUsersToDTO собирает страницу списка подписчиков или подписок
+func (f *FollowService) followUsersToDTO(
+	ctx context.Context,
+	userIDs []int,
+	total, count, page int,
+) (*dto.FollowUsers, error) {
+	users := make([]dto.FollowUser, len(userIDs))
+	for i, userID := range userIDs {
+		user, err := getFollowUser(ctx, f.userUC, userID)
+		if err != nil {
+			return nil, err
+		}
+		users[i] = *user
+	}
+	return &dto.FollowUsers{
+		Users: users,
+		Page:  page,
+		Count: count,
+		Pages: (total + count - 1) / count,
+		Total: total,
+	}, nil
+}
+
+func (f *FollowService) GetFollowers(ctx context.Context, userID, count, page int) (*dto.FollowUsers, error) {
+	ctx, span := tracing.Start(ctx, "FollowService.GetFollowers")
+	defer span.End()
+	followerIDs, err := f.followRepo.GetFollowers(ctx, userID, page, count)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении подписчиков"), err)
+	}
+	total, err := f.followRepo.GetFollowersCount(ctx, userID)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении количества подписчиков"), err)
+	}
+	return f.followUsersToDTO(ctx, followerIDs, total, count, page)
+}
+
+func (f *FollowService) GetFollowing(ctx context.Context, userID, count, page int) (*dto.FollowUsers, error) {
+	ctx, span := tracing.Start(ctx, "FollowService.GetFollowing")
+	defer span.End()
+	followeeIDs, err := f.followRepo.GetFollowing(ctx, userID, page, count)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении подписок"), err)
+	}
+	total, err := f.followRepo.GetFollowingCount(ctx, userID)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении количества подписок"), err)
+	}
+	return f.followUsersToDTO(ctx, followeeIDs, total, count, page)
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	mockrepo "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository/mocks"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	mock_usecase "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFollowService_Follow(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                string
+		FolloweeID          int
+		ExpectedErr         error
+		SetupFollowRepoMock func(repo *mockrepo.MockFollow)
+	}{
+		{
+			Name:       "Успешная подписка",
+			FolloweeID: 2,
+			SetupFollowRepoMock: func(repo *mockrepo.MockFollow) {
+				repo.EXPECT().Follow(gomock.Any(), 1, 2).Return(nil)
+			},
+		},
+		{
+			Name:                "Подписка на себя",
+			FolloweeID:          1,
+			ExpectedErr:         usecase.ErrFollowSelf,
+			SetupFollowRepoMock: func(repo *mockrepo.MockFollow) {},
+		},
+		{
+			Name:        "Пользователь не найден",
+			FolloweeID:  2,
+			ExpectedErr: usecase.ErrFollowUserNotFound,
+			SetupFollowRepoMock: func(repo *mockrepo.MockFollow) {
+				repo.EXPECT().Follow(gomock.Any(), 1, 2).Return(repository.ErrFollowUserNotFound)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockFollowRepo := mockrepo.NewMockFollow(ctrl)
+			tc.SetupFollowRepoMock(mockFollowRepo)
+			service := NewFollowService(mockFollowRepo, nil)
+			err := service.Follow(context.Background(), 1, tc.FolloweeID)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestFollowService_Unfollow(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockFollowRepo := mockrepo.NewMockFollow(ctrl)
+	mockFollowRepo.EXPECT().Unfollow(gomock.Any(), 1, 2).Return(repository.ErrFollowNotFound)
+	service := NewFollowService(mockFollowRepo, nil)
+	err := service.Unfollow(context.Background(), 1, 2)
+	require.Equal(t, usecase.ErrFollowNotFound, err)
+}
+
+func TestFollowService_GetFollowers(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                string
+		ExpectedOutput      *dto.FollowUsers
+		ExpectedErr         bool
+		SetupFollowRepoMock func(repo *mockrepo.MockFollow)
+		SetupUserUCMock     func(uc *mock_usecase.MockUser)
+	}{
+		{
+			Name: "Подписчики без почты",
+			ExpectedOutput: &dto.FollowUsers{
+				Users: []dto.FollowUser{{ID: 3, Name: "Егор", Avatar: "avatar.jpg"}, {ID: 2, Name: "Ваня"}},
+				Page:  1,
+				Count: 20,
+				Pages: 1,
+				Total: 2,
+			},
+			SetupFollowRepoMock: func(repo *mockrepo.MockFollow) {
+				repo.EXPECT().GetFollowers(gomock.Any(), 1, 1, 20).Return([]int{3, 2}, nil)
+				repo.EXPECT().GetFollowersCount(gomock.Any(), 1).Return(2, nil)
+			},
+			SetupUserUCMock: func(uc *mock_usecase.MockUser) {
+				uc.EXPECT().GetUser(gomock.Any(), 3).
+					Return(&dto.UserProfile{ID: 3, Name: "Егор", Email: "egor@mail.ru", Avatar: "avatar.jpg"}, nil)
+				uc.EXPECT().GetUser(gomock.Any(), 2).
+					Return(&dto.UserProfile{ID: 2, Name: "Ваня", Email: "vanya@mail.ru"}, nil)
+			},
+		},
+		{
+			Name:           "Ошибка при получении пользователя",
+			ExpectedOutput: nil,
+			ExpectedErr:    true,
+			SetupFollowRepoMock: func(repo *mockrepo.MockFollow) {
+				repo.EXPECT().GetFollowers(gomock.Any(), 1, 1, 20).Return([]int{3}, nil)
+				repo.EXPECT().GetFollowersCount(gomock.Any(), 1).Return(1, nil)
+			},
+			SetupUserUCMock: func(uc *mock_usecase.MockUser) {
+				uc.EXPECT().GetUser(gomock.Any(), 3).Return(nil, errors.New("database error"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockFollowRepo := mockrepo.NewMockFollow(ctrl)
+			mockUserUC := mock_usecase.NewMockUser(ctrl)
+			tc.SetupFollowRepoMock(mockFollowRepo)
+			tc.SetupUserUCMock(mockUserUC)
+			service := NewFollowService(mockFollowRepo, mockUserUC)
+			output, err := service.GetFollowers(context.Background(), 1, 20, 1)
+			require.Equal(t, tc.ExpectedOutput, output)
+			require.Equal(t, tc.ExpectedErr, err != nil)
+		})
+	}
+}
+
+func TestFollowService_GetFollowStatus(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockFollowRepo := mockrepo.NewMockFollow(ctrl)
+	mockFollowRepo.EXPECT().IsFollowing(gomock.Any(), 1, 2).Return(true, nil)
+	mockFollowRepo.EXPECT().GetFollowersCount(gomock.Any(), 2).Return(10, nil)
+	mockFollowRepo.EXPECT().GetFollowingCount(gomock.Any(), 2).Return(5, nil)
+	service := NewFollowService(mockFollowRepo, nil)
+	status, err := service.GetFollowStatus(context.Background(), 1, 2)
+	require.NoError(t, err)
+	require.Equal(t, &dto.FollowStatus{Following: true, FollowersCount: 10, FollowingCount: 5}, status)
+}
@@ -0,0 +1,316 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/logger"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	"io"
+	"sync"
+)
+
+// ImportService импортирует историю просмотров. Оценки и избранное сохраняются напрямую через репозитории,
+// минуя события ленты: иначе импорт нескольких сотен фильмов заполнил бы ленты подписчиков
+type ImportService struct {
+	importRepo    repository.Import
+	ratingRepo    repository.UserRating
+	favouriteRepo repository.Favourite
+	contentRepo   repository.Content
+	contentUC     usecase.Content
+	statsRepo     repository.UserStats
+	// async запускает импорт в фоне, в тестах подменяется на синхронный вызов
+	async func(func())
+	// slots ограничивает число одновременных импортов: каждый импорт занимает место в канале до завершения
+	slots chan struct{}
+	// running отслеживает запущенные импорты, чтобы сервер дождался их при остановке
+	running sync.WaitGroup
+}
+
+func NewImportService(
+	importRepo repository.Import,
+	ratingRepo repository.UserRating,
+	favouriteRepo repository.Favourite,
+	contentRepo repository.Content,
+	contentUC usecase.Content,
+	statsRepo repository.UserStats,
+	maxConcurrent int,
+) usecase.Import {
+	return &ImportService{
+		importRepo:    importRepo,
+		ratingRepo:    ratingRepo,
+		favouriteRepo: favouriteRepo,
+		contentRepo:   contentRepo,
+		contentUC:     contentUC,
+		statsRepo:     statsRepo,
+		async: func(f func()) {
+			go f()
+		},
+		slots: make(chan struct{}, maxConcurrent),
+	}
+}
+
+func importJobEntityToDTO(job *entity.ImportJob, rows []*entity.ImportRow) *dto.ImportJob {
+	unmatched := make([]dto.ImportRow, len(rows))
+	for i, row := range rows {
+		unmatched[i] = dto.ImportRow{
+			Row:           row.RowNumber,
+			Title:         row.Title,
+			OriginalTitle: row.OriginalTitle,
+			Year:          row.Year,
+			KinopoiskID:   row.KinopoiskID,
+			Rating:        row.Rating,
+			ContentID:     row.ContentID,
+		}
+	}
+	return &dto.ImportJob{
+		ID:          job.ID,
+		Source:      job.Source,
+		Category:    job.Category,
+		Status:      job.Status,
+		TotalRows:   job.TotalRows,
+		MatchedRows: job.MatchedRows,
+		Error:       job.Error,
+		CreatedAt:   job.CreatedAt.String(),
+		UpdatedAt:   job.UpdatedAt.String(),
+		Unmatched:   unmatched,
+	}
+}
+
+func (i *ImportService) StartImport(
+	ctx context.Context,
+	userID int,
+	source, category string,
+	file io.Reader,
+) (*dto.ImportJob, error) {
+	ctx, span := tracing.Start(ctx, "ImportService.StartImport")
+	defer span.End()
+	if category == "" {
+		category = entity.FavouriteCategoryWatched
+	}
+	if err := entity.ValidateImportSource(source); err != nil {
+		return nil, usecase.ImportErrorIncorrectData{Err: err}
+	}
+	if err := entity.ValidateFavouriteCategory(category); err != nil {
+		return nil, usecase.ImportErrorIncorrectData{Err: err}
+	}
+	// файл разбирается сразу, чтобы об ошибках формата пользователь узнал в ответе на запрос
+	rows, err := entity.ParseImportFile(source, file)
+	if err != nil {
+		return nil, usecase.ImportErrorIncorrectData{Err: err}
+	}
+	// место занимается до создания задачи, чтобы при отказе в базе не оставалась задача, которая никогда
+	// не запустится
+	select {
+	case i.slots <- struct{}{}:
+	default:
+		return nil, usecase.ErrImportTooManyJobs
+	}
+	job, err := i.importRepo.CreateJob(ctx, &entity.ImportJob{
+		UserID:   userID,
+		Source:   source,
+		Category: category,
+	})
+	if err != nil {
+		<-i.slots
+		return nil, entity.UsecaseWrap(errors.New("ошибка при создании задачи импорта"), err)
+	}
+	job.TotalRows = len(rows)
+	jobDTO := importJobEntityToDTO(job, nil)
+	// импорт переживает запрос, поэтому контекст запроса не должен его отменять
+	runCtx := context.WithoutCancel(ctx)
+	i.running.Add(1)
+	i.async(func() {
+		defer i.running.Done()
+		defer func() { <-i.slots }()
+		i.run(runCtx, job, rows)
+	})
+	return jobDTO, nil
+}
+
+func (i *ImportService) FailInterruptedJobs(ctx context.Context) error {
+	ctx, span := tracing.Start(ctx, "ImportService.FailInterruptedJobs")
+	defer span.End()
+	// строки файла хранятся только в памяти процесса, поэтому прерванный импорт продолжить нельзя
+	failed, err := i.importRepo.FailUnfinishedJobs(ctx, "импорт прерван перезапуском сервера, загрузите файл еще раз")
+	if err != nil {
+		return entity.UsecaseWrap(errors.New("ошибка при завершении прерванных импортов"), err)
+	}
+	if failed > 0 {
+		logger.ForPackage("service").WarnContext(ctx, "прерванные импорты помечены неудавшимися", "count", failed)
+	}
+	return nil
+}
+
+func (i *ImportService) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		i.running.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// run сопоставляет строки с контентом и импортирует найденные. Несопоставленные строки сохраняются
+// для ручного разбора. При ошибке базы задача помечается неудавшейся
+func (i *ImportService) run(ctx context.Context, job *entity.ImportJob, rows []*entity.ImportRow) {
+	ctx, span := tracing.Start(ctx, "ImportService.run")
+	defer span.End()
+	// часть строк могла импортироваться и до ошибки, поэтому статистика сбрасывается в любом случае
+	defer invalidateUserStats(ctx, i.statsRepo, job.UserID)
+	job.Status = entity.ImportStatusRunning
+	i.updateJob(ctx, job)
+	unmatched := make([]*entity.ImportRow, 0)
+	for _, row := range rows {
+		contentID, ongoing, err := i.importRepo.FindContent(ctx, row)
+		if errors.Is(err, repository.ErrImportContentNotFound) {
+			row.JobID = job.ID
+			unmatched = append(unmatched, row)
+			continue
+		}
+		if err == nil {
+			err = i.importContent(ctx, job, row, contentID, ongoing)
+		}
+		if err != nil {
+			i.failJob(ctx, job, err)
+			return
+		}
+		job.MatchedRows++
+	}
+	if err := i.importRepo.AddUnmatchedRows(ctx, unmatched); err != nil {
+		i.failJob(ctx, job, err)
+		return
+	}
+	job.Status = entity.ImportStatusDone
+	i.updateJob(ctx, job)
+}
+
+// importContent добавляет контент в избранное и ставит оценку из строки. Контент, который уже есть
+// в избранном, остается в своей категории. Невышедшему контенту оценки не ставятся
+func (i *ImportService) importContent(
+	ctx context.Context,
+	job *entity.ImportJob,
+	row *entity.ImportRow,
+	contentID int,
+	ongoing bool,
+) error {
+	if err := i.favouriteRepo.CreateFavourite(ctx, job.UserID, contentID, job.Category); err != nil {
+		return entity.UsecaseWrap(errors.New("ошибка при добавлении в избранное"), err)
+	}
+	if row.Rating == 0 || ongoing {
+		return nil
+	}
+	_, err := i.ratingRepo.SetRating(ctx, &entity.UserRating{
+		UserID:    job.UserID,
+		ContentID: contentID,
+		Rating:    row.Rating,
+	})
+	if err != nil {
+		return entity.UsecaseWrap(errors.New("ошибка при сохранении оценки"), err)
+	}
+	invalidateContent(ctx, i.contentRepo, contentID)
+	return nil
+}
+
+// updateJob сохраняет состояние задачи. Ошибка только логируется: импорт продолжается, а пользователь
+// увидит итог при следующем успешном сохранении
+func (i *ImportService) updateJob(ctx context.Context, job *entity.ImportJob) {
+	if err := i.importRepo.UpdateJob(ctx, job); err != nil {
+		logger.ForPackage("service").WarnContext(ctx, "не удалось сохранить состояние импорта",
+			"job_id", job.ID,
+			"error", err,
+		)
+	}
+}
+
+func (i *ImportService) failJob(ctx context.Context, job *entity.ImportJob, err error) {
+	logger.ForPackage("service").ErrorContext(ctx, "ошибка при импорте истории просмотров",
+		"job_id", job.ID,
+		"error", err,
+	)
+	job.Status = entity.ImportStatusFailed
+	job.Error = "не удалось импортировать историю, попробуйте позже"
+	i.updateJob(ctx, job)
+}
+
+// getUserJob возвращает задачу импорта, если она принадлежит пользователю
+func (i *ImportService) getUserJob(ctx context.Context, userID, jobID int) (*entity.ImportJob, error) {
+	job, err := i.importRepo.GetJob(ctx, jobID)
+	switch {
+	case errors.Is(err, repository.ErrImportJobNotFound):
+		return nil, usecase.ErrImportJobNotFound
+	case err != nil:
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении задачи импорта"), err)
+	case job.UserID != userID:
+		return nil, usecase.ErrImportJobNotFound
+	}
+	return job, nil
+}
+
+func (i *ImportService) GetJob(ctx context.Context, userID, jobID int) (*dto.ImportJob, error) {
+	ctx, span := tracing.Start(ctx, "ImportService.GetJob")
+	defer span.End()
+	job, err := i.getUserJob(ctx, userID, jobID)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := i.importRepo.GetUnmatchedRows(ctx, jobID)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении несопоставленных строк"), err)
+	}
+	return importJobEntityToDTO(job, rows), nil
+}
+
+func (i *ImportService) ResolveRow(
+	ctx context.Context,
+	userID, jobID, rowNumber int,
+	req dto.ImportResolveRequest,
+) error {
+	ctx, span := tracing.Start(ctx, "ImportService.ResolveRow")
+	defer span.End()
+	job, err := i.getUserJob(ctx, userID, jobID)
+	if err != nil {
+		return err
+	}
+	row, err := i.importRepo.GetUnmatchedRow(ctx, jobID, rowNumber)
+	switch {
+	case errors.Is(err, repository.ErrImportRowNotFound):
+		return usecase.ErrImportRowNotFound
+	case err != nil:
+		return entity.UsecaseWrap(errors.New("ошибка при получении строки импорта"), err)
+	}
+	content, err := i.contentUC.GetPreviewContentByID(ctx, req.ContentID)
+	switch {
+	case errors.Is(err, usecase.ErrContentNotFound):
+		return usecase.ErrImportContentNotFound
+	case err != nil:
+		return entity.UsecaseWrap(errors.New("ошибка при получении контента"), err)
+	}
+	err = i.importRepo.ResolveRow(ctx, jobID, rowNumber, req.ContentID)
+	switch {
+	case errors.Is(err, repository.ErrImportContentNotFound):
+		return usecase.ErrImportContentNotFound
+	case errors.Is(err, repository.ErrImportRowNotFound):
+		return usecase.ErrImportRowNotFound
+	case err != nil:
+		return entity.UsecaseWrap(errors.New("ошибка при сопоставлении строки импорта"), err)
+	}
+	if err = i.importContent(ctx, job, row, req.ContentID, content.Ongoing); err != nil {
+		return err
+	}
+	invalidateUserStats(ctx, i.statsRepo, userID)
+	// повторное сопоставление уже разобранной строки не меняет счетчик
+	if row.ContentID == 0 {
+		job.MatchedRows++
+		i.updateJob(ctx, job)
+	}
+	return nil
+}
@@ -0,0 +1,461 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	mockrepo "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository/mocks"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	mock_usecase "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type importMocks struct {
+	importRepo    *mockrepo.MockImport
+	ratingRepo    *mockrepo.MockUserRating
+	favouriteRepo *mockrepo.MockFavourite
+	contentRepo   *mockrepo.MockContent
+	contentUC     *mock_usecase.MockContent
+	statsRepo     *mockrepo.MockUserStats
+}
+
+// newSyncImportService создает сервис, который выполняет импорт синхронно, чтобы проверить его результат
+func newSyncImportService(ctrl *gomock.Controller) (*ImportService, importMocks) {
+	mocks := importMocks{
+		importRepo:    mockrepo.NewMockImport(ctrl),
+		ratingRepo:    mockrepo.NewMockUserRating(ctrl),
+		favouriteRepo: mockrepo.NewMockFavourite(ctrl),
+		contentRepo:   mockrepo.NewMockContent(ctrl),
+		contentUC:     mock_usecase.NewMockContent(ctrl),
+		statsRepo:     mockrepo.NewMockUserStats(ctrl),
+	}
+	mocks.statsRepo.EXPECT().InvalidateUserStats(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	service := &ImportService{
+		importRepo:    mocks.importRepo,
+		ratingRepo:    mocks.ratingRepo,
+		favouriteRepo: mocks.favouriteRepo,
+		contentRepo:   mocks.contentRepo,
+		contentUC:     mocks.contentUC,
+		statsRepo:     mocks.statsRepo,
+		async: func(f func()) {
+			f()
+		},
+		slots: make(chan struct{}, 1),
+	}
+	return service, mocks
+}
+
+func TestImportService_StartImport(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Now()
+	file := "Name,Year,Rating\nHeat,1995,4.5\nAlien,1979,\nUnknown,2000,3\n"
+	testCases := []struct {
+		Name           string
+		Source         string
+		Category       string
+		File           string
+		ExpectedOutput *dto.ImportJob
+		ExpectedErr    error
+		Setup          func(mocks importMocks)
+	}{
+		{
+			Name:   "Успешный импорт",
+			Source: entity.ImportSourceLetterboxd,
+			File:   file,
+			ExpectedOutput: &dto.ImportJob{
+				ID:        1,
+				Source:    entity.ImportSourceLetterboxd,
+				Category:  entity.FavouriteCategoryWatched,
+				Status:    entity.ImportStatusPending,
+				TotalRows: 3,
+				CreatedAt: fixedTime.String(),
+				UpdatedAt: fixedTime.String(),
+				Unmatched: []dto.ImportRow{},
+			},
+			Setup: func(mocks importMocks) {
+				mocks.importRepo.EXPECT().CreateJob(gomock.Any(), &entity.ImportJob{
+					UserID:   1,
+					Source:   entity.ImportSourceLetterboxd,
+					Category: entity.FavouriteCategoryWatched,
+				}).DoAndReturn(func(_ context.Context, job *entity.ImportJob) (*entity.ImportJob, error) {
+					job.ID = 1
+					job.Status = entity.ImportStatusPending
+					job.CreatedAt = fixedTime
+					job.UpdatedAt = fixedTime
+					return job, nil
+				})
+				mocks.importRepo.EXPECT().UpdateJob(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				mocks.importRepo.EXPECT().FindContent(gomock.Any(), gomock.Any()).Return(10, false, nil)
+				mocks.importRepo.EXPECT().FindContent(gomock.Any(), gomock.Any()).Return(11, true, nil)
+				mocks.importRepo.EXPECT().FindContent(gomock.Any(), gomock.Any()).
+					Return(0, false, repository.ErrImportContentNotFound)
+				mocks.favouriteRepo.EXPECT().CreateFavourite(gomock.Any(), 1, 10, entity.FavouriteCategoryWatched).
+					Return(nil)
+				mocks.favouriteRepo.EXPECT().CreateFavourite(gomock.Any(), 1, 11, entity.FavouriteCategoryWatched).
+					Return(nil)
+				// оценка ставится только вышедшему контенту, у которого она есть в файле
+				mocks.ratingRepo.EXPECT().SetRating(gomock.Any(), &entity.UserRating{UserID: 1, ContentID: 10, Rating: 9}).
+					Return(&entity.UserRating{}, nil)
+				mocks.contentRepo.EXPECT().InvalidateContent(gomock.Any(), 10).Return(nil)
+				mocks.importRepo.EXPECT().AddUnmatchedRows(gomock.Any(), []*entity.ImportRow{
+					{JobID: 1, RowNumber: 4, Title: "Unknown", Year: 2000, Rating: 6},
+				}).Return(nil)
+			},
+		},
+		{
+			Name:        "Неизвестная категория",
+			Source:      entity.ImportSourceLetterboxd,
+			Category:    "liked",
+			File:        file,
+			ExpectedErr: usecase.ImportErrorIncorrectData{Err: errors.New("неизвестная категория избранного")},
+			Setup:       func(mocks importMocks) {},
+		},
+		{
+			Name:        "Неизвестный источник",
+			Source:      "netflix",
+			File:        file,
+			ExpectedErr: usecase.ImportErrorIncorrectData{Err: errors.New("источник должен быть letterboxd, imdb или kinopoisk")},
+			Setup:       func(mocks importMocks) {},
+		},
+		{
+			Name:        "Некорректный файл",
+			Source:      entity.ImportSourceIMDb,
+			File:        file,
+			ExpectedErr: usecase.ImportErrorIncorrectData{Err: errors.New("в файле нет столбца с названием")},
+			Setup:       func(mocks importMocks) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			service, mocks := newSyncImportService(ctrl)
+			tc.Setup(mocks)
+			output, err := service.StartImport(context.Background(), 1, tc.Source, tc.Category, strings.NewReader(tc.File))
+			require.Equal(t, tc.ExpectedOutput, output)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestImportService_StartImportLimit(t *testing.T) {
+	t.Parallel()
+
+	file := "Name,Year\nHeat,1995\n"
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	service, mocks := newSyncImportService(ctrl)
+
+	// все места заняты: задача не создается
+	service.slots <- struct{}{}
+	_, err := service.StartImport(context.Background(), 1, entity.ImportSourceLetterboxd, "", strings.NewReader(file))
+	require.Equal(t, usecase.ErrImportTooManyJobs, err)
+	<-service.slots
+
+	// задача не создалась, место освобождается
+	mocks.importRepo.EXPECT().CreateJob(gomock.Any(), gomock.Any()).Return(nil, errors.New("database error"))
+	_, err = service.StartImport(context.Background(), 1, entity.ImportSourceLetterboxd, "", strings.NewReader(file))
+	require.Error(t, err)
+	require.Empty(t, service.slots)
+}
+
+func TestImportService_Wait(t *testing.T) {
+	t.Parallel()
+
+	file := "Name,Year\nHeat,1995\n"
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	service, mocks := newSyncImportService(ctrl)
+	service.async = func(f func()) {
+		go f()
+	}
+	release := make(chan struct{})
+	mocks.importRepo.EXPECT().CreateJob(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, job *entity.ImportJob) (*entity.ImportJob, error) {
+			job.ID = 1
+			return job, nil
+		})
+	mocks.importRepo.EXPECT().UpdateJob(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+	mocks.importRepo.EXPECT().FindContent(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(context.Context, *entity.ImportRow) (int, bool, error) {
+			<-release
+			return 0, false, repository.ErrImportContentNotFound
+		})
+	mocks.importRepo.EXPECT().AddUnmatchedRows(gomock.Any(), gomock.Any()).Return(nil)
+
+	_, err := service.StartImport(context.Background(), 1, entity.ImportSourceLetterboxd, "", strings.NewReader(file))
+	require.NoError(t, err)
+	// пока импорт выполняется, второй не запускается, а Wait ждет до отмены контекста
+	_, err = service.StartImport(context.Background(), 1, entity.ImportSourceLetterboxd, "", strings.NewReader(file))
+	require.Equal(t, usecase.ErrImportTooManyJobs, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, service.Wait(ctx), context.DeadlineExceeded)
+
+	close(release)
+	require.NoError(t, service.Wait(context.Background()))
+	require.Empty(t, service.slots)
+}
+
+func TestImportService_FailInterruptedJobs(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name        string
+		ExpectedErr error
+		Setup       func(mocks importMocks)
+	}{
+		{
+			Name:        "Прерванные задачи помечены неудавшимися",
+			ExpectedErr: nil,
+			Setup: func(mocks importMocks) {
+				mocks.importRepo.EXPECT().
+					FailUnfinishedJobs(gomock.Any(), "импорт прерван перезапуском сервера, загрузите файл еще раз").
+					Return(int64(2), nil)
+			},
+		},
+		{
+			Name: "Ошибка базы",
+			ExpectedErr: entity.UsecaseWrap(
+				errors.New("ошибка при завершении прерванных импортов"),
+				errors.New("database error"),
+			),
+			Setup: func(mocks importMocks) {
+				mocks.importRepo.EXPECT().FailUnfinishedJobs(gomock.Any(), gomock.Any()).
+					Return(int64(0), errors.New("database error"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			service, mocks := newSyncImportService(ctrl)
+			tc.Setup(mocks)
+			err := service.FailInterruptedJobs(context.Background())
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestImportService_Run(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name           string
+		ExpectedStatus string
+		ExpectedError  string
+		Setup          func(mocks importMocks)
+	}{
+		{
+			Name:           "Импорт завершен",
+			ExpectedStatus: entity.ImportStatusDone,
+			Setup: func(mocks importMocks) {
+				mocks.importRepo.EXPECT().UpdateJob(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				mocks.importRepo.EXPECT().FindContent(gomock.Any(), gomock.Any()).Return(10, false, nil)
+				mocks.favouriteRepo.EXPECT().CreateFavourite(gomock.Any(), 1, 10, entity.FavouriteCategoryPlanned).
+					Return(nil)
+				mocks.importRepo.EXPECT().AddUnmatchedRows(gomock.Any(), []*entity.ImportRow{}).Return(nil)
+			},
+		},
+		{
+			Name:           "Ошибка базы при сопоставлении",
+			ExpectedStatus: entity.ImportStatusFailed,
+			ExpectedError:  "не удалось импортировать историю, попробуйте позже",
+			Setup: func(mocks importMocks) {
+				mocks.importRepo.EXPECT().UpdateJob(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				mocks.importRepo.EXPECT().FindContent(gomock.Any(), gomock.Any()).
+					Return(0, false, errors.New("database error"))
+			},
+		},
+		{
+			Name:           "Ошибка при добавлении в избранное",
+			ExpectedStatus: entity.ImportStatusFailed,
+			ExpectedError:  "не удалось импортировать историю, попробуйте позже",
+			Setup: func(mocks importMocks) {
+				mocks.importRepo.EXPECT().UpdateJob(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+				mocks.importRepo.EXPECT().FindContent(gomock.Any(), gomock.Any()).Return(10, false, nil)
+				mocks.favouriteRepo.EXPECT().CreateFavourite(gomock.Any(), 1, 10, entity.FavouriteCategoryPlanned).
+					Return(errors.New("database error"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			service, mocks := newSyncImportService(ctrl)
+			tc.Setup(mocks)
+			job := &entity.ImportJob{ID: 1, UserID: 1, Category: entity.FavouriteCategoryPlanned}
+			service.run(context.Background(), job, []*entity.ImportRow{{RowNumber: 2, Title: "Heat"}})
+			require.Equal(t, tc.ExpectedStatus, job.Status)
+			require.Equal(t, tc.ExpectedError, job.Error)
+		})
+	}
+}
+
+func TestImportService_GetJob(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Now()
+	testCases := []struct {
+		Name           string
+		ExpectedOutput *dto.ImportJob
+		ExpectedErr    error
+		Setup          func(mocks importMocks)
+	}{
+		{
+			Name: "Задача с несопоставленными строками",
+			ExpectedOutput: &dto.ImportJob{
+				ID:          5,
+				Source:      entity.ImportSourceIMDb,
+				Category:    entity.FavouriteCategoryWatched,
+				Status:      entity.ImportStatusDone,
+				TotalRows:   2,
+				MatchedRows: 1,
+				CreatedAt:   fixedTime.String(),
+				UpdatedAt:   fixedTime.String(),
+				Unmatched:   []dto.ImportRow{{Row: 3, Title: "Unknown", Year: 2000}},
+			},
+			Setup: func(mocks importMocks) {
+				mocks.importRepo.EXPECT().GetJob(gomock.Any(), 5).Return(&entity.ImportJob{
+					ID:          5,
+					UserID:      1,
+					Source:      entity.ImportSourceIMDb,
+					Category:    entity.FavouriteCategoryWatched,
+					Status:      entity.ImportStatusDone,
+					TotalRows:   2,
+					MatchedRows: 1,
+					CreatedAt:   fixedTime,
+					UpdatedAt:   fixedTime,
+				}, nil)
+				mocks.importRepo.EXPECT().GetUnmatchedRows(gomock.Any(), 5).Return([]*entity.ImportRow{
+					{JobID: 5, RowNumber: 3, Title: "Unknown", Year: 2000},
+				}, nil)
+			},
+		},
+		{
+			Name:        "Чужая задача",
+			ExpectedErr: usecase.ErrImportJobNotFound,
+			Setup: func(mocks importMocks) {
+				mocks.importRepo.EXPECT().GetJob(gomock.Any(), 5).Return(&entity.ImportJob{ID: 5, UserID: 2}, nil)
+			},
+		},
+		{
+			Name:        "Задача не найдена",
+			ExpectedErr: usecase.ErrImportJobNotFound,
+			Setup: func(mocks importMocks) {
+				mocks.importRepo.EXPECT().GetJob(gomock.Any(), 5).Return(nil, repository.ErrImportJobNotFound)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			service, mocks := newSyncImportService(ctrl)
+			tc.Setup(mocks)
+			output, err := service.GetJob(context.Background(), 1, 5)
+			require.Equal(t, tc.ExpectedOutput, output)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestImportService_ResolveRow(t *testing.T) {
+	t.Parallel()
+
+	job := func() *entity.ImportJob {
+		return &entity.ImportJob{ID: 5, UserID: 1, Category: entity.FavouriteCategoryWatched, MatchedRows: 1}
+	}
+	testCases := []struct {
+		Name        string
+		ExpectedErr error
+		Setup       func(mocks importMocks)
+	}{
+		{
+			Name: "Успешное сопоставление",
+			Setup: func(mocks importMocks) {
+				mocks.importRepo.EXPECT().GetJob(gomock.Any(), 5).Return(job(), nil)
+				mocks.importRepo.EXPECT().GetUnmatchedRow(gomock.Any(), 5, 3).
+					Return(&entity.ImportRow{JobID: 5, RowNumber: 3, Rating: 7}, nil)
+				mocks.contentUC.EXPECT().GetPreviewContentByID(gomock.Any(), 10).Return(&dto.PreviewContent{ID: 10}, nil)
+				mocks.importRepo.EXPECT().ResolveRow(gomock.Any(), 5, 3, 10).Return(nil)
+				mocks.favouriteRepo.EXPECT().CreateFavourite(gomock.Any(), 1, 10, entity.FavouriteCategoryWatched).
+					Return(nil)
+				mocks.ratingRepo.EXPECT().SetRating(gomock.Any(), &entity.UserRating{UserID: 1, ContentID: 10, Rating: 7}).
+					Return(&entity.UserRating{}, nil)
+				mocks.contentRepo.EXPECT().InvalidateContent(gomock.Any(), 10).Return(nil)
+				mocks.importRepo.EXPECT().UpdateJob(gomock.Any(), &entity.ImportJob{
+					ID:          5,
+					UserID:      1,
+					Category:    entity.FavouriteCategoryWatched,
+					MatchedRows: 2,
+				}).Return(nil)
+			},
+		},
+		{
+			Name: "Повторное сопоставление не меняет счетчик",
+			Setup: func(mocks importMocks) {
+				mocks.importRepo.EXPECT().GetJob(gomock.Any(), 5).Return(job(), nil)
+				mocks.importRepo.EXPECT().GetUnmatchedRow(gomock.Any(), 5, 3).
+					Return(&entity.ImportRow{JobID: 5, RowNumber: 3, ContentID: 9}, nil)
+				mocks.contentUC.EXPECT().GetPreviewContentByID(gomock.Any(), 10).Return(&dto.PreviewContent{ID: 10}, nil)
+				mocks.importRepo.EXPECT().ResolveRow(gomock.Any(), 5, 3, 10).Return(nil)
+				mocks.favouriteRepo.EXPECT().CreateFavourite(gomock.Any(), 1, 10, entity.FavouriteCategoryWatched).
+					Return(nil)
+			},
+		},
+		{
+			Name:        "Строка не найдена",
+			ExpectedErr: usecase.ErrImportRowNotFound,
+			Setup: func(mocks importMocks) {
+				mocks.importRepo.EXPECT().GetJob(gomock.Any(), 5).Return(job(), nil)
+				mocks.importRepo.EXPECT().GetUnmatchedRow(gomock.Any(), 5, 3).Return(nil, repository.ErrImportRowNotFound)
+			},
+		},
+		{
+			Name:        "Контент не найден",
+			ExpectedErr: usecase.ErrImportContentNotFound,
+			Setup: func(mocks importMocks) {
+				mocks.importRepo.EXPECT().GetJob(gomock.Any(), 5).Return(job(), nil)
+				mocks.importRepo.EXPECT().GetUnmatchedRow(gomock.Any(), 5, 3).
+					Return(&entity.ImportRow{JobID: 5, RowNumber: 3}, nil)
+				mocks.contentUC.EXPECT().GetPreviewContentByID(gomock.Any(), 10).Return(nil, usecase.ErrContentNotFound)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			service, mocks := newSyncImportService(ctrl)
+			tc.Setup(mocks)
+			err := service.ResolveRow(context.Background(), 1, 5, 3, dto.ImportResolveRequest{ContentID: 10})
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
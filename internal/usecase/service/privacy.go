@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+)
+
+type PrivacyService struct {
+	privacyRepo repository.Privacy
+	followRepo  repository.Follow
+}
+
+func NewPrivacyService(privacyRepo repository.Privacy, followRepo repository.Follow) usecase.Privacy {
+	return &PrivacyService{
+		privacyRepo: privacyRepo,
+		followRepo:  followRepo,
+	}
+}
+
+func privacySettingsEntityToDTO(settings *entity.PrivacySettings) *dto.PrivacySettings {
+	return &dto.PrivacySettings{
+		Favourites: settings.Favourites,
+		Reviews:    settings.Reviews,
+		Ratings:    settings.Ratings,
+		Lists:      settings.Lists,
+	}
+}
+
+func (p *PrivacyService) GetSettings(ctx context.Context, userID int) (*dto.PrivacySettings, error) {
+	ctx, span := tracing.Start(ctx, "PrivacyService.GetSettings")
+	defer span.End()
+	settings, err := p.privacyRepo.GetPrivacySettings(ctx, userID)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении настроек приватности"), err)
+	}
+	return privacySettingsEntityToDTO(settings), nil
+}
+
+func (p *PrivacyService) UpdateSettings(
+	ctx context.Context,
+	userID int,
+	settingsDTO dto.PrivacySettings,
+) (*dto.PrivacySettings, error) {
+	ctx, span := tracing.Start(ctx, "PrivacyService.UpdateSettings")
+	defer span.End()
+	settings := &entity.PrivacySettings{
+		UserID:     userID,
+		Favourites: settingsDTO.Favourites,
+		Reviews:    settingsDTO.Reviews,
+		Ratings:    settingsDTO.Ratings,
+		Lists:      settingsDTO.Lists,
+	}
+	if err := settings.Validate(); err != nil {
+		return nil, usecase.PrivacyErrorIncorrectData{Err: err}
+	}
+	err := p.privacyRepo.UpdatePrivacySettings(ctx, settings)
+	switch {
+	case errors.Is(err, repository.ErrPrivacyUserNotFound):
+		return nil, usecase.ErrPrivacyUserNotFound
+	case err != nil:
+		return nil, entity.UsecaseWrap(errors.New("ошибка при изменении настроек приватности"), err)
+	default:
+		return privacySettingsEntityToDTO(settings), nil
+	}
+}
+
+func (p *PrivacyService) CanView(ctx context.Context, viewerID, ownerID int, section string) (bool, error) {
+	ctx, span := tracing.Start(ctx, "PrivacyService.CanView")
+	defer span.End()
+	visible, err := p.GetVisibleSections(ctx, viewerID, ownerID)
+	if err != nil {
+		return false, err
+	}
+	return visible[section], nil
+}
+
+func (p *PrivacyService) GetVisibleSections(ctx context.Context, viewerID, ownerID int) (map[string]bool, error) {
+	ctx, span := tracing.Start(ctx, "PrivacyService.GetVisibleSections")
+	defer span.End()
+	isOwner := viewerID == ownerID
+	settings := entity.DefaultPrivacySettings(ownerID)
+	isFollower := false
+	// владельцу видно все, его настройки и подписку можно не запрашивать
+	if !isOwner {
+		var err error
+		settings, err = p.privacyRepo.GetPrivacySettings(ctx, ownerID)
+		if err != nil {
+			return nil, entity.UsecaseWrap(errors.New("ошибка при получении настроек приватности"), err)
+		}
+		isFollower, err = p.followRepo.IsFollowing(ctx, viewerID, ownerID)
+		if err != nil {
+			return nil, entity.UsecaseWrap(errors.New("ошибка при проверке подписки"), err)
+		}
+	}
+	visible := make(map[string]bool, 4)
+	for _, section := range []string{
+		entity.PrivacySectionFavourites,
+		entity.PrivacySectionReviews,
+		entity.PrivacySectionRatings,
+		entity.PrivacySectionLists,
+	} {
+		visible[section] = entity.PrivacyAllows(settings.Level(section), isOwner, isFollower)
+	}
+	return visible, nil
+}
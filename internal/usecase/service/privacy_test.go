@@ -0,0 +1,161 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	mockrepo "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository/mocks"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPrivacyService_GetVisibleSections(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                 string
+		ViewerID             int
+		ExpectedOutput       map[string]bool
+		SetupPrivacyRepoMock func(repo *mockrepo.MockPrivacy)
+		SetupFollowRepoMock  func(repo *mockrepo.MockFollow)
+	}{
+		{
+			Name:     "Владелец видит все",
+			ViewerID: 1,
+			ExpectedOutput: map[string]bool{
+				entity.PrivacySectionFavourites: true,
+				entity.PrivacySectionReviews:    true,
+				entity.PrivacySectionRatings:    true,
+				entity.PrivacySectionLists:      true,
+			},
+			SetupPrivacyRepoMock: func(repo *mockrepo.MockPrivacy) {},
+			SetupFollowRepoMock:  func(repo *mockrepo.MockFollow) {},
+		},
+		{
+			Name:     "Другой пользователь",
+			ViewerID: 2,
+			ExpectedOutput: map[string]bool{
+				entity.PrivacySectionFavourites: false,
+				entity.PrivacySectionReviews:    true,
+				entity.PrivacySectionRatings:    false,
+				entity.PrivacySectionLists:      true,
+			},
+			SetupPrivacyRepoMock: func(repo *mockrepo.MockPrivacy) {
+				repo.EXPECT().GetPrivacySettings(gomock.Any(), 1).Return(&entity.PrivacySettings{
+					UserID:     1,
+					Favourites: entity.PrivacyPrivate,
+					Reviews:    entity.PrivacyPublic,
+					Ratings:    entity.PrivacyFollowers,
+					Lists:      entity.PrivacyPublic,
+				}, nil)
+			},
+			SetupFollowRepoMock: func(repo *mockrepo.MockFollow) {
+				repo.EXPECT().IsFollowing(gomock.Any(), 2, 1).Return(false, nil)
+			},
+		},
+		{
+			Name:     "Подписчик видит разделы для подписчиков",
+			ViewerID: 2,
+			ExpectedOutput: map[string]bool{
+				entity.PrivacySectionFavourites: false,
+				entity.PrivacySectionReviews:    true,
+				entity.PrivacySectionRatings:    true,
+				entity.PrivacySectionLists:      true,
+			},
+			SetupPrivacyRepoMock: func(repo *mockrepo.MockPrivacy) {
+				repo.EXPECT().GetPrivacySettings(gomock.Any(), 1).Return(&entity.PrivacySettings{
+					UserID:     1,
+					Favourites: entity.PrivacyPrivate,
+					Reviews:    entity.PrivacyPublic,
+					Ratings:    entity.PrivacyFollowers,
+					Lists:      entity.PrivacyFollowers,
+				}, nil)
+			},
+			SetupFollowRepoMock: func(repo *mockrepo.MockFollow) {
+				repo.EXPECT().IsFollowing(gomock.Any(), 2, 1).Return(true, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPrivacyRepo := mockrepo.NewMockPrivacy(ctrl)
+			mockFollowRepo := mockrepo.NewMockFollow(ctrl)
+			tc.SetupPrivacyRepoMock(mockPrivacyRepo)
+			tc.SetupFollowRepoMock(mockFollowRepo)
+			service := NewPrivacyService(mockPrivacyRepo, mockFollowRepo)
+			output, err := service.GetVisibleSections(context.Background(), tc.ViewerID, 1)
+			require.NoError(t, err)
+			require.Equal(t, tc.ExpectedOutput, output)
+		})
+	}
+}
+
+func TestPrivacyService_UpdateSettings(t *testing.T) {
+	t.Parallel()
+
+	settings := dto.PrivacySettings{Favourites: "public", Reviews: "public", Ratings: "private", Lists: "followers"}
+	testCases := []struct {
+		Name                 string
+		Input                dto.PrivacySettings
+		ExpectedOutput       *dto.PrivacySettings
+		ExpectedErr          error
+		SetupPrivacyRepoMock func(repo *mockrepo.MockPrivacy)
+	}{
+		{
+			Name:           "Успешное изменение",
+			Input:          settings,
+			ExpectedOutput: &settings,
+			SetupPrivacyRepoMock: func(repo *mockrepo.MockPrivacy) {
+				repo.EXPECT().UpdatePrivacySettings(gomock.Any(), &entity.PrivacySettings{
+					UserID:     1,
+					Favourites: "public",
+					Reviews:    "public",
+					Ratings:    "private",
+					Lists:      "followers",
+				}).Return(nil)
+			},
+		},
+		{
+			Name:                 "Неизвестный уровень",
+			Input:                dto.PrivacySettings{Favourites: "friends", Reviews: "public", Ratings: "public", Lists: "public"},
+			ExpectedErr:          usecase.PrivacyErrorIncorrectData{},
+			SetupPrivacyRepoMock: func(repo *mockrepo.MockPrivacy) {},
+		},
+		{
+			Name:        "Пользователь не найден",
+			Input:       settings,
+			ExpectedErr: usecase.ErrPrivacyUserNotFound,
+			SetupPrivacyRepoMock: func(repo *mockrepo.MockPrivacy) {
+				repo.EXPECT().UpdatePrivacySettings(gomock.Any(), gomock.Any()).Return(repository.ErrPrivacyUserNotFound)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockPrivacyRepo := mockrepo.NewMockPrivacy(ctrl)
+			tc.SetupPrivacyRepoMock(mockPrivacyRepo)
+			service := NewPrivacyService(mockPrivacyRepo, nil)
+			output, err := service.UpdateSettings(context.Background(), 1, tc.Input)
+			require.Equal(t, tc.ExpectedOutput, output)
+			if _, ok := tc.ExpectedErr.(usecase.PrivacyErrorIncorrectData); ok {
+				require.ErrorAs(t, err, &usecase.PrivacyErrorIncorrectData{})
+			} else {
+				require.Equal(t, tc.ExpectedErr, err)
+			}
+		})
+	}
+}
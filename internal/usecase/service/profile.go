@@ -0,0 +1,230 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/logger"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	"math"
+)
+
+// число последних рецензий и любимых жанров в публичном профиле
+const (
+	profileRecentReviews = 3
+	profileTopGenres     = 5
+)
+
+// размер топов и число месяцев активности в статистике пользователя
+const (
+	profileStatsTop    = 10
+	profileStatsMonths = 12
+)
+
+type ProfileService struct {
+	profileRepo repository.Profile
+	statsRepo   repository.UserStats
+	userUC      usecase.User
+	privacyUC   usecase.Privacy
+	reviewUC    usecase.Review
+}
+
+func NewProfileService(
+	profileRepo repository.Profile,
+	statsRepo repository.UserStats,
+	userUC usecase.User,
+	privacyUC usecase.Privacy,
+	reviewUC usecase.Review,
+) usecase.Profile {
+	return &ProfileService{
+		profileRepo: profileRepo,
+		statsRepo:   statsRepo,
+		userUC:      userUC,
+		privacyUC:   privacyUC,
+		reviewUC:    reviewUC,
+	}
+}
+
+// invalidateUserStats сбрасывает кэш разделов статистики пользователя после изменения избранного, оценок или
+// рецензий. Ошибка не прерывает запрос: в худшем случае статистика обновится по истечении TTL кэша
+func invalidateUserStats(ctx context.Context, statsRepo repository.UserStats, userID int, sections ...string) {
+	if err := statsRepo.InvalidateUserStats(ctx, userID, sections...); err != nil {
+		logger.ForPackage("service").WarnContext(ctx, "не удалось сбросить кэш статистики пользователя",
+			"user_id", userID,
+			"error", err,
+		)
+	}
+}
+
+// invalidateContent сбрасывает кэш контента после изменения оценок или рецензий, так как его рейтинг
+// пересчитывается триггером в базе. Ошибка не прерывает запрос: в худшем случае рейтинг обновится по истечении
+// TTL кэша
+func invalidateContent(ctx context.Context, contentRepo repository.Content, contentID int) {
+	if err := contentRepo.InvalidateContent(ctx, contentID); err != nil {
+		logger.ForPackage("service").WarnContext(ctx, "не удалось сбросить кэш контента",
+			"content_id", contentID,
+			"error", err,
+		)
+	}
+}
+
+func (p *ProfileService) GetPublicProfile(ctx context.Context, viewerID, userID int) (*dto.PublicProfile, error) {
+	ctx, span := tracing.Start(ctx, "ProfileService.GetPublicProfile")
+	defer span.End()
+	user, err := p.userUC.GetUser(ctx, userID)
+	switch {
+	case errors.Is(err, usecase.ErrUserNotFound):
+		return nil, usecase.ErrProfileUserNotFound
+	case err != nil:
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении пользователя"), err)
+	}
+	visible, err := p.privacyUC.GetVisibleSections(ctx, viewerID, userID)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при проверке настроек приватности"), err)
+	}
+	// почта в публичный профиль не попадает
+	profile := &dto.PublicProfile{
+		ID:     user.ID,
+		Name:   user.Name,
+		Avatar: user.Avatar,
+		Rating: user.Rating,
+		Me:     viewerID == userID,
+	}
+	stats, err := p.profileRepo.GetProfileStats(ctx, userID, viewerID != userID)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении счетчиков профиля"), err)
+	}
+	if visible[entity.PrivacySectionRatings] {
+		profile.RatingsCount = &stats.Ratings
+	}
+	if visible[entity.PrivacySectionFavourites] {
+		profile.FavouritesCount = &stats.Favourites
+	}
+	if visible[entity.PrivacySectionLists] {
+		profile.ListsCount = &stats.Lists
+	}
+	if visible[entity.PrivacySectionReviews] {
+		var reviews *dto.ReviewResponseList
+		reviews, err = p.reviewUC.GetUserReviews(ctx, userID, profileRecentReviews, 1, dto.ReviewFilter{})
+		if err != nil {
+			return nil, entity.UsecaseWrap(errors.New("ошибка при получении рецензий пользователя"), err)
+		}
+		profile.ReviewsCount = &reviews.Total
+		profile.RecentReviews = reviews.Reviews
+	}
+	genres, err := p.profileRepo.GetTopGenres(
+		ctx, userID, profileTopGenres, visible[entity.PrivacySectionReviews], visible[entity.PrivacySectionRatings],
+	)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении любимых жанров"), err)
+	}
+	profile.TopGenres = make([]dto.GenreCount, len(genres))
+	for i, genre := range genres {
+		profile.TopGenres[i] = dto.GenreCount{ID: genre.ID, Name: genre.Name, Count: genre.Count}
+	}
+	return profile, nil
+}
+
+func (p *ProfileService) GetUserStats(ctx context.Context, viewerID, userID int) (*dto.UserStats, error) {
+	ctx, span := tracing.Start(ctx, "ProfileService.GetUserStats")
+	defer span.End()
+	_, err := p.userUC.GetUser(ctx, userID)
+	switch {
+	case errors.Is(err, usecase.ErrUserNotFound):
+		return nil, usecase.ErrProfileUserNotFound
+	case err != nil:
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении пользователя"), err)
+	}
+	visible, err := p.privacyUC.GetVisibleSections(ctx, viewerID, userID)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при проверке настроек приватности"), err)
+	}
+	stats := &dto.UserStats{Activity: make([]dto.MonthActivity, 0)}
+	// просмотренный контент - это избранное, поэтому он виден только вместе с ним
+	if visible[entity.PrivacySectionFavourites] {
+		var watched *entity.WatchedStats
+		if watched, err = p.statsRepo.GetWatchedStats(ctx, userID, profileStatsTop); err != nil {
+			return nil, entity.UsecaseWrap(errors.New("ошибка при получении статистики просмотров"), err)
+		}
+		stats.Watched = watchedStatsEntityToDTO(watched)
+	}
+	if visible[entity.PrivacySectionRatings] || visible[entity.PrivacySectionReviews] {
+		var ratings *entity.RatingStats
+		if ratings, err = p.statsRepo.GetRatingStats(ctx, userID); err != nil {
+			return nil, entity.UsecaseWrap(errors.New("ошибка при получении статистики оценок"), err)
+		}
+		count, average, siteAverage := ratings.Average(
+			visible[entity.PrivacySectionRatings], visible[entity.PrivacySectionReviews],
+		)
+		stats.Ratings = &dto.RatingStats{
+			Count:       count,
+			Average:     math.Round(average*100) / 100,
+			SiteAverage: math.Round(siteAverage*100) / 100,
+		}
+	}
+	if visible[entity.PrivacySectionFavourites] || stats.Ratings != nil {
+		var activity []*entity.MonthActivity
+		if activity, err = p.statsRepo.GetMonthlyActivity(ctx, userID, profileStatsMonths); err != nil {
+			return nil, entity.UsecaseWrap(errors.New("ошибка при получении активности пользователя"), err)
+		}
+		stats.Activity = monthActivityEntityToDTO(activity, visible)
+	}
+	return stats, nil
+}
+
+func watchedStatsEntityToDTO(watched *entity.WatchedStats) *dto.WatchedStats {
+	stats := &dto.WatchedStats{
+		Categories: watched.Categories,
+		Runtime:    watched.Runtime,
+		Genres:     make([]dto.GenreCount, len(watched.Genres)),
+		Countries:  make([]dto.CountryCount, len(watched.Countries)),
+		Directors:  make([]dto.PersonCount, len(watched.Directors)),
+		Actors:     make([]dto.PersonCount, len(watched.Actors)),
+	}
+	for i, genre := range watched.Genres {
+		stats.Genres[i] = dto.GenreCount{ID: genre.ID, Name: genre.Name, Count: genre.Count}
+	}
+	for i, country := range watched.Countries {
+		stats.Countries[i] = dto.CountryCount{ID: country.ID, Name: country.Name, Count: country.Count}
+	}
+	for i, person := range watched.Directors {
+		stats.Directors[i] = personCountEntityToDTO(person)
+	}
+	for i, person := range watched.Actors {
+		stats.Actors[i] = personCountEntityToDTO(person)
+	}
+	return stats
+}
+
+func personCountEntityToDTO(person *entity.PersonCount) dto.PersonCount {
+	return dto.PersonCount{ID: person.ID, Name: person.Name, EnName: person.EnName, Count: person.Count}
+}
+
+// monthActivityEntityToDTO оставляет только счетчики видимых разделов. Отмеченные серии относятся к избранному.
+// Месяцы, в которых не осталось видимой активности, пропускаются
+func monthActivityEntityToDTO(activity []*entity.MonthActivity, visible map[string]bool) []dto.MonthActivity {
+	result := make([]dto.MonthActivity, 0, len(activity))
+	for _, month := range activity {
+		monthDTO := dto.MonthActivity{Month: month.Month.Format("2006-01")}
+		empty := true
+		if visible[entity.PrivacySectionFavourites] && month.Favourites+month.Episodes > 0 {
+			monthDTO.Favourites, monthDTO.Episodes = &month.Favourites, &month.Episodes
+			empty = false
+		}
+		if visible[entity.PrivacySectionRatings] && month.Ratings > 0 {
+			monthDTO.Ratings = &month.Ratings
+			empty = false
+		}
+		if visible[entity.PrivacySectionReviews] && month.Reviews > 0 {
+			monthDTO.Reviews = &month.Reviews
+			empty = false
+		}
+		if !empty {
+			result = append(result, monthDTO)
+		}
+	}
+	return result
+}
@@ -0,0 +1,293 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	mockrepo "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository/mocks"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository/postgres"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	mock_usecase "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/mocks"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestProfileService_GetPublicProfile(t *testing.T) {
+	t.Parallel()
+
+	ratings, favourites, lists, reviews := 10, 5, 2, 4
+	testCases := []struct {
+		Name                 string
+		ExpectedOutput       *dto.PublicProfile
+		ExpectedErr          error
+		SetupProfileRepoMock func(repo *mockrepo.MockProfile)
+		SetupUserUCMock      func(uc *mock_usecase.MockUser)
+		SetupPrivacyUCMock   func(uc *mock_usecase.MockPrivacy)
+		SetupReviewUCMock    func(uc *mock_usecase.MockReview)
+	}{
+		{
+			Name: "Все разделы открыты",
+			ExpectedOutput: &dto.PublicProfile{
+				ID:              1,
+				Name:            "Егор",
+				Rating:          100,
+				ReviewsCount:    &reviews,
+				RatingsCount:    &ratings,
+				FavouritesCount: &favourites,
+				ListsCount:      &lists,
+				RecentReviews:   []dto.ReviewResponse{{ContentName: "Фильм"}},
+				TopGenres:       []dto.GenreCount{{ID: 3, Name: "Драма", Count: 7}},
+			},
+			SetupProfileRepoMock: func(repo *mockrepo.MockProfile) {
+				repo.EXPECT().GetProfileStats(gomock.Any(), 1, true).
+					Return(&entity.ProfileStats{Ratings: 10, Favourites: 5, Lists: 2}, nil)
+				repo.EXPECT().GetTopGenres(gomock.Any(), 1, 5, true, true).Return([]*entity.GenreCount{
+					{Genre: entity.Genre{ID: 3, Name: "Драма"}, Count: 7},
+				}, nil)
+			},
+			SetupUserUCMock: func(uc *mock_usecase.MockUser) {
+				uc.EXPECT().GetUser(gomock.Any(), 1).
+					Return(&dto.UserProfile{ID: 1, Name: "Егор", Email: "egor@mail.ru", Rating: 100}, nil)
+			},
+			SetupPrivacyUCMock: func(uc *mock_usecase.MockPrivacy) {
+				uc.EXPECT().GetVisibleSections(gomock.Any(), 2, 1).Return(map[string]bool{
+					entity.PrivacySectionFavourites: true,
+					entity.PrivacySectionReviews:    true,
+					entity.PrivacySectionRatings:    true,
+					entity.PrivacySectionLists:      true,
+				}, nil)
+			},
+			SetupReviewUCMock: func(uc *mock_usecase.MockReview) {
+				uc.EXPECT().GetUserReviews(gomock.Any(), 1, 3, 1, dto.ReviewFilter{}).Return(&dto.ReviewResponseList{
+					Reviews: []dto.ReviewResponse{{ContentName: "Фильм"}},
+					Total:   4,
+				}, nil)
+			},
+		},
+		{
+			Name: "Все разделы скрыты",
+			ExpectedOutput: &dto.PublicProfile{
+				ID:        1,
+				Name:      "Егор",
+				Rating:    100,
+				TopGenres: []dto.GenreCount{},
+			},
+			SetupProfileRepoMock: func(repo *mockrepo.MockProfile) {
+				repo.EXPECT().GetProfileStats(gomock.Any(), 1, true).
+					Return(&entity.ProfileStats{Ratings: 10, Favourites: 5, Lists: 2}, nil)
+				repo.EXPECT().GetTopGenres(gomock.Any(), 1, 5, false, false).Return([]*entity.GenreCount{}, nil)
+			},
+			SetupUserUCMock: func(uc *mock_usecase.MockUser) {
+				uc.EXPECT().GetUser(gomock.Any(), 1).
+					Return(&dto.UserProfile{ID: 1, Name: "Егор", Email: "egor@mail.ru", Rating: 100}, nil)
+			},
+			SetupPrivacyUCMock: func(uc *mock_usecase.MockPrivacy) {
+				uc.EXPECT().GetVisibleSections(gomock.Any(), 2, 1).Return(map[string]bool{}, nil)
+			},
+			SetupReviewUCMock: func(uc *mock_usecase.MockReview) {},
+		},
+		{
+			Name:                 "Пользователь не найден",
+			ExpectedErr:          usecase.ErrProfileUserNotFound,
+			SetupProfileRepoMock: func(repo *mockrepo.MockProfile) {},
+			SetupUserUCMock: func(uc *mock_usecase.MockUser) {
+				uc.EXPECT().GetUser(gomock.Any(), 1).Return(nil, usecase.ErrUserNotFound)
+			},
+			SetupPrivacyUCMock: func(uc *mock_usecase.MockPrivacy) {},
+			SetupReviewUCMock:  func(uc *mock_usecase.MockReview) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockProfileRepo := mockrepo.NewMockProfile(ctrl)
+			mockUserUC := mock_usecase.NewMockUser(ctrl)
+			mockPrivacyUC := mock_usecase.NewMockPrivacy(ctrl)
+			mockReviewUC := mock_usecase.NewMockReview(ctrl)
+			tc.SetupProfileRepoMock(mockProfileRepo)
+			tc.SetupUserUCMock(mockUserUC)
+			tc.SetupPrivacyUCMock(mockPrivacyUC)
+			tc.SetupReviewUCMock(mockReviewUC)
+			service := NewProfileService(mockProfileRepo, nil, mockUserUC, mockPrivacyUC, mockReviewUC)
+			output, err := service.GetPublicProfile(context.Background(), 2, 1)
+			require.Equal(t, tc.ExpectedErr, err)
+			require.Equal(t, tc.ExpectedOutput, output)
+		})
+	}
+}
+
+func TestProfileService_GetUserStats(t *testing.T) {
+	t.Parallel()
+
+	may := time.Date(2024, time.May, 1, 0, 0, 0, 0, time.UTC)
+	watched := &entity.WatchedStats{
+		Categories: map[string]int{"watched": 3},
+		Runtime:    390,
+		Genres:     []*entity.GenreCount{{Genre: entity.Genre{ID: 3, Name: "Драма"}, Count: 2}},
+		Countries:  []*entity.CountryCount{{Country: entity.Country{ID: 1, Name: "США"}, Count: 3}},
+		Directors:  []*entity.PersonCount{{ID: 7, Name: "Майкл Манн", EnName: "Michael Mann", Count: 2}},
+		Actors:     []*entity.PersonCount{},
+	}
+	ratingStats := &entity.RatingStats{
+		Ratings: entity.RatingSum{Count: 2, Sum: 16, SiteSum: 14},
+		Reviews: entity.RatingSum{Count: 1, Sum: 5, SiteSum: 7.5},
+	}
+	activity := []*entity.MonthActivity{
+		{Month: may, Favourites: 2, Reviews: 1},
+		{Month: may.AddDate(0, 1, 0), Reviews: 1},
+	}
+	favourites, episodes, reviews := 2, 0, 1
+	testCases := []struct {
+		Name               string
+		ExpectedOutput     *dto.UserStats
+		ExpectedErr        error
+		SetupStatsRepoMock func(repo *mockrepo.MockUserStats)
+		SetupUserUCMock    func(uc *mock_usecase.MockUser)
+		SetupPrivacyUCMock func(uc *mock_usecase.MockPrivacy)
+	}{
+		{
+			Name: "Все разделы открыты",
+			ExpectedOutput: &dto.UserStats{
+				Watched: &dto.WatchedStats{
+					Categories: map[string]int{"watched": 3},
+					Runtime:    390,
+					Genres:     []dto.GenreCount{{ID: 3, Name: "Драма", Count: 2}},
+					Countries:  []dto.CountryCount{{ID: 1, Name: "США", Count: 3}},
+					Directors:  []dto.PersonCount{{ID: 7, Name: "Майкл Манн", EnName: "Michael Mann", Count: 2}},
+					Actors:     []dto.PersonCount{},
+				},
+				Ratings: &dto.RatingStats{Count: 3, Average: 7, SiteAverage: 7.17},
+				Activity: []dto.MonthActivity{
+					{Month: "2024-05", Favourites: &favourites, Episodes: &episodes, Reviews: &reviews},
+					{Month: "2024-06", Reviews: &reviews},
+				},
+			},
+			SetupStatsRepoMock: func(repo *mockrepo.MockUserStats) {
+				repo.EXPECT().GetWatchedStats(gomock.Any(), 1, 10).Return(watched, nil)
+				repo.EXPECT().GetRatingStats(gomock.Any(), 1).Return(ratingStats, nil)
+				repo.EXPECT().GetMonthlyActivity(gomock.Any(), 1, 12).Return(activity, nil)
+			},
+			SetupUserUCMock: func(uc *mock_usecase.MockUser) {
+				uc.EXPECT().GetUser(gomock.Any(), 1).Return(&dto.UserProfile{ID: 1}, nil)
+			},
+			SetupPrivacyUCMock: func(uc *mock_usecase.MockPrivacy) {
+				uc.EXPECT().GetVisibleSections(gomock.Any(), 2, 1).Return(map[string]bool{
+					entity.PrivacySectionFavourites: true,
+					entity.PrivacySectionReviews:    true,
+					entity.PrivacySectionRatings:    true,
+				}, nil)
+			},
+		},
+		{
+			Name: "Видны только оценки",
+			ExpectedOutput: &dto.UserStats{
+				Ratings:  &dto.RatingStats{Count: 2, Average: 8, SiteAverage: 7},
+				Activity: []dto.MonthActivity{},
+			},
+			SetupStatsRepoMock: func(repo *mockrepo.MockUserStats) {
+				repo.EXPECT().GetRatingStats(gomock.Any(), 1).Return(ratingStats, nil)
+				repo.EXPECT().GetMonthlyActivity(gomock.Any(), 1, 12).Return(activity, nil)
+			},
+			SetupUserUCMock: func(uc *mock_usecase.MockUser) {
+				uc.EXPECT().GetUser(gomock.Any(), 1).Return(&dto.UserProfile{ID: 1}, nil)
+			},
+			SetupPrivacyUCMock: func(uc *mock_usecase.MockPrivacy) {
+				uc.EXPECT().GetVisibleSections(gomock.Any(), 2, 1).Return(map[string]bool{
+					entity.PrivacySectionRatings: true,
+				}, nil)
+			},
+		},
+		{
+			Name:               "Все разделы скрыты",
+			ExpectedOutput:     &dto.UserStats{Activity: []dto.MonthActivity{}},
+			SetupStatsRepoMock: func(repo *mockrepo.MockUserStats) {},
+			SetupUserUCMock: func(uc *mock_usecase.MockUser) {
+				uc.EXPECT().GetUser(gomock.Any(), 1).Return(&dto.UserProfile{ID: 1}, nil)
+			},
+			SetupPrivacyUCMock: func(uc *mock_usecase.MockPrivacy) {
+				uc.EXPECT().GetVisibleSections(gomock.Any(), 2, 1).Return(map[string]bool{}, nil)
+			},
+		},
+		{
+			Name:               "Пользователь не найден",
+			ExpectedErr:        usecase.ErrProfileUserNotFound,
+			SetupStatsRepoMock: func(repo *mockrepo.MockUserStats) {},
+			SetupUserUCMock: func(uc *mock_usecase.MockUser) {
+				uc.EXPECT().GetUser(gomock.Any(), 1).Return(nil, usecase.ErrUserNotFound)
+			},
+			SetupPrivacyUCMock: func(uc *mock_usecase.MockPrivacy) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockStatsRepo := mockrepo.NewMockUserStats(ctrl)
+			mockUserUC := mock_usecase.NewMockUser(ctrl)
+			mockPrivacyUC := mock_usecase.NewMockPrivacy(ctrl)
+			tc.SetupStatsRepoMock(mockStatsRepo)
+			tc.SetupUserUCMock(mockUserUC)
+			tc.SetupPrivacyUCMock(mockPrivacyUC)
+			service := NewProfileService(nil, mockStatsRepo, mockUserUC, mockPrivacyUC, nil)
+			output, err := service.GetUserStats(context.Background(), 2, 1)
+			require.Equal(t, tc.ExpectedErr, err)
+			require.Equal(t, tc.ExpectedOutput, output)
+		})
+	}
+}
+
+func TestProfileService_GetPublicProfileHiddenReviews(t *testing.T) {
+	t.Parallel()
+
+	// у пользователя две рецензии, одна из которых скрыта модератором: база не должна ее вернуть
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	mock.ExpectQuery(regexp.QuoteMeta("FROM review WHERE hidden = $1 AND user_id = $2 ORDER BY created_at DESC")).
+		WithArgs(false, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "content_id", "title", "text", "hidden"}).
+			AddRow(1, 1, 1, "title", "text", false))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM review WHERE hidden = $1 AND user_id = $2")).
+		WithArgs(false, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	mockUserRepo := mockrepo.NewMockUser(ctrl)
+	mockUserRepo.EXPECT().GetUserByID(gomock.Any(), 1).Return(&entity.User{ID: 1, Name: "Егор"}, nil)
+	mockContentRepo := mockrepo.NewMockContent(ctrl)
+	mockContentRepo.EXPECT().GetContent(gomock.Any(), 1).Return(&entity.Content{Title: "Фильм"}, nil)
+	mockStaticUC := mock_usecase.NewMockStatic(ctrl)
+	mockStaticUC.EXPECT().GetStatic(gomock.Any(), gomock.Any()).Return("", usecase.ErrStaticNotFound)
+	reviewUC := NewReviewService(
+		postgres.NewReviewRepository(sqlx.NewDb(db, "sqlmock")), mockUserRepo, mockContentRepo, mockStaticUC,
+		nil, nil, nil, nil,
+	)
+	mockProfileRepo := mockrepo.NewMockProfile(ctrl)
+	mockProfileRepo.EXPECT().GetProfileStats(gomock.Any(), 1, true).Return(&entity.ProfileStats{}, nil)
+	mockProfileRepo.EXPECT().GetTopGenres(gomock.Any(), 1, 5, true, false).Return([]*entity.GenreCount{}, nil)
+	mockUserUC := mock_usecase.NewMockUser(ctrl)
+	mockUserUC.EXPECT().GetUser(gomock.Any(), 1).Return(&dto.UserProfile{ID: 1, Name: "Егор"}, nil)
+	mockPrivacyUC := mock_usecase.NewMockPrivacy(ctrl)
+	mockPrivacyUC.EXPECT().GetVisibleSections(gomock.Any(), 2, 1).
+		Return(map[string]bool{entity.PrivacySectionReviews: true}, nil)
+	service := NewProfileService(mockProfileRepo, nil, mockUserUC, mockPrivacyUC, reviewUC)
+
+	output, err := service.GetPublicProfile(context.Background(), 2, 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, *output.ReviewsCount)
+	require.Len(t, output.RecentReviews, 1)
+	require.Equal(t, 1, output.RecentReviews[0].ID)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
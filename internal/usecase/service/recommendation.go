@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+)
+
+// сколько соседей хранится для каждого контента
+const recommendationNeighbors = 20
+
+type RecommendationService struct {
+	recommendationRepo repository.Recommendation
+	contentUC          usecase.Content
+}
+
+func NewRecommendationService(
+	recommendationRepo repository.Recommendation,
+	contentUC usecase.Content,
+) usecase.Recommendation {
+	return &RecommendationService{
+		recommendationRepo: recommendationRepo,
+		contentUC:          contentUC,
+	}
+}
+
+func (r *RecommendationService) GetRecommendations(
+	ctx context.Context,
+	userID, limit int,
+) (*dto.Recommendations, error) {
+	ctx, span := tracing.Start(ctx, "RecommendationService.GetRecommendations")
+	defer span.End()
+	recommendations, err := r.recommendationRepo.GetRecommendations(ctx, userID, limit)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении рекомендаций"), err)
+	}
+	result := &dto.Recommendations{Items: make([]dto.Recommendation, 0, len(recommendations))}
+	if len(recommendations) == 0 {
+		return result, nil
+	}
+	// превью рекомендованного контента и контента, которым они объясняются, запрашиваются одним вызовом
+	ids := make([]int, 0, 2*len(recommendations))
+	requested := make(map[int]bool, 2*len(recommendations))
+	for _, recommendation := range recommendations {
+		for _, id := range []int{recommendation.ContentID, recommendation.SourceID} {
+			if !requested[id] {
+				requested[id] = true
+				ids = append(ids, id)
+			}
+		}
+	}
+	// GetPreviewContents возвращает превью всех запрошенных ID или ошибку, поэтому каждое из них есть в previewByID
+	previews, err := r.contentUC.GetPreviewContents(ctx, ids)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении рекомендованного контента"), err)
+	}
+	previewByID := make(map[int]*dto.PreviewContent, len(previews))
+	for _, preview := range previews {
+		previewByID[preview.ID] = preview
+	}
+	for _, recommendation := range recommendations {
+		content, source := previewByID[recommendation.ContentID], previewByID[recommendation.SourceID]
+		result.Items = append(result.Items, dto.Recommendation{
+			Content:   *content,
+			BecauseOf: dto.RecommendationSource{ID: source.ID, Title: source.Title},
+			Reason:    recommendationReason(recommendation.SourceLiked, source.Title),
+		})
+	}
+	return result, nil
+}
+
+// recommendationReason объясняет рекомендацию контентом, который внес в нее наибольший вклад
+func recommendationReason(liked bool, title string) string {
+	if liked {
+		return fmt.Sprintf("Потому что вам понравился «%s»", title)
+	}
+	return fmt.Sprintf("Потому что вы смотрели «%s»", title)
+}
+
+func (r *RecommendationService) RefreshNeighbors(ctx context.Context) error {
+	ctx, span := tracing.Start(ctx, "RecommendationService.RefreshNeighbors")
+	defer span.End()
+	if err := r.recommendationRepo.RefreshNeighbors(ctx, recommendationNeighbors); err != nil {
+		return entity.UsecaseWrap(errors.New("ошибка при пересчете похожего контента"), err)
+	}
+	return nil
+}
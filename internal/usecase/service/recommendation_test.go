@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	mockrepo "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository/mocks"
+	mock_usecase "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestRecommendationService_GetRecommendations(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                        string
+		ExpectedOutput              *dto.Recommendations
+		ExpectedErr                 bool
+		SetupRecommendationRepoMock func(repo *mockrepo.MockRecommendation)
+		SetupContentUCMock          func(uc *mock_usecase.MockContent)
+	}{
+		{
+			Name: "Рекомендации с общим источником",
+			ExpectedOutput: &dto.Recommendations{
+				Items: []dto.Recommendation{
+					{
+						Content:   dto.PreviewContent{ID: 5, Title: "Темный рыцарь"},
+						BecauseOf: dto.RecommendationSource{ID: 2, Title: "Бэтмен"},
+						Reason:    "Потому что вам понравился «Бэтмен»",
+					},
+					{
+						Content:   dto.PreviewContent{ID: 6, Title: "Начало"},
+						BecauseOf: dto.RecommendationSource{ID: 2, Title: "Бэтмен"},
+						Reason:    "Потому что вам понравился «Бэтмен»",
+					},
+					{
+						Content:   dto.PreviewContent{ID: 7, Title: "Престиж"},
+						BecauseOf: dto.RecommendationSource{ID: 3, Title: "Интерстеллар"},
+						Reason:    "Потому что вы смотрели «Интерстеллар»",
+					},
+				},
+			},
+			SetupRecommendationRepoMock: func(repo *mockrepo.MockRecommendation) {
+				repo.EXPECT().GetRecommendations(gomock.Any(), 1, 20).Return([]*entity.Recommendation{
+					{ContentID: 5, Score: 1.4, SourceID: 2, SourceLiked: true},
+					{ContentID: 6, Score: 0.9, SourceID: 2, SourceLiked: true},
+					{ContentID: 7, Score: 0.3, SourceID: 3, SourceLiked: false},
+				}, nil)
+			},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {
+				uc.EXPECT().GetPreviewContents(gomock.Any(), []int{5, 2, 6, 7, 3}).Return([]*dto.PreviewContent{
+					{ID: 5, Title: "Темный рыцарь"},
+					{ID: 2, Title: "Бэтмен"},
+					{ID: 6, Title: "Начало"},
+					{ID: 7, Title: "Престиж"},
+					{ID: 3, Title: "Интерстеллар"},
+				}, nil)
+			},
+		},
+		{
+			Name:           "Нет рекомендаций",
+			ExpectedOutput: &dto.Recommendations{Items: []dto.Recommendation{}},
+			SetupRecommendationRepoMock: func(repo *mockrepo.MockRecommendation) {
+				repo.EXPECT().GetRecommendations(gomock.Any(), 1, 20).Return([]*entity.Recommendation{}, nil)
+			},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {},
+		},
+		{
+			Name:        "Ошибка при получении рекомендаций",
+			ExpectedErr: true,
+			SetupRecommendationRepoMock: func(repo *mockrepo.MockRecommendation) {
+				repo.EXPECT().GetRecommendations(gomock.Any(), 1, 20).Return(nil, errors.New("database error"))
+			},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {},
+		},
+		{
+			Name:        "Ошибка при получении контента",
+			ExpectedErr: true,
+			SetupRecommendationRepoMock: func(repo *mockrepo.MockRecommendation) {
+				repo.EXPECT().GetRecommendations(gomock.Any(), 1, 20).Return([]*entity.Recommendation{
+					{ContentID: 5, Score: 1.4, SourceID: 2, SourceLiked: true},
+				}, nil)
+			},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {
+				uc.EXPECT().GetPreviewContents(gomock.Any(), []int{5, 2}).Return(nil, errors.New("error"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRecommendationRepo := mockrepo.NewMockRecommendation(ctrl)
+			mockContentUC := mock_usecase.NewMockContent(ctrl)
+			tc.SetupRecommendationRepoMock(mockRecommendationRepo)
+			tc.SetupContentUCMock(mockContentUC)
+			recommendationService := NewRecommendationService(mockRecommendationRepo, mockContentUC)
+			output, err := recommendationService.GetRecommendations(context.Background(), 1, 20)
+			if tc.ExpectedErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.ExpectedOutput, output)
+			}
+		})
+	}
+}
+
+func TestRecommendationService_RefreshNeighbors(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockRecommendationRepo := mockrepo.NewMockRecommendation(ctrl)
+	mockRecommendationRepo.EXPECT().RefreshNeighbors(gomock.Any(), recommendationNeighbors).Return(nil)
+	recommendationService := NewRecommendationService(mockRecommendationRepo, mock_usecase.NewMockContent(ctrl))
+	require.NoError(t, recommendationService.RefreshNeighbors(context.Background()))
+}
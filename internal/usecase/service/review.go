@@ -1,20 +1,27 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
 	"strings"
 )
 
 type ReviewService struct {
-	reviewRepo  repository.Review
-	userRepo    repository.User
-	contentRepo repository.Content
-	staticUC    usecase.Static
-	profanityUC usecase.Profanity
+	reviewRepo   repository.Review
+	userRepo     repository.User
+	contentRepo  repository.Content
+	staticUC     usecase.Static
+	profanityUC  usecase.Profanity
+	activityRepo repository.Activity
+	statsRepo    repository.UserStats
+	// moderationRepo нужен, чтобы модераторы видели скрытые рецензии
+	moderationRepo repository.ReviewModeration
 }
 
 func NewReviewService(
@@ -23,19 +30,53 @@ func NewReviewService(
 	contentRepo repository.Content,
 	staticUC usecase.Static,
 	profanityUC usecase.Profanity,
+	activityRepo repository.Activity,
+	statsRepo repository.UserStats,
+	moderationRepo repository.ReviewModeration,
 ) usecase.Review {
 	return &ReviewService{
-		reviewRepo:  reviewRepo,
-		userRepo:    userRepo,
-		contentRepo: contentRepo,
-		staticUC:    staticUC,
-		profanityUC: profanityUC,
+		reviewRepo:     reviewRepo,
+		userRepo:       userRepo,
+		contentRepo:    contentRepo,
+		staticUC:       staticUC,
+		profanityUC:    profanityUC,
+		activityRepo:   activityRepo,
+		statsRepo:      statsRepo,
+		moderationRepo: moderationRepo,
+	}
+}
+
+// parseSpoilers отделяет разметку спойлеров от текста рецензии. В базе хранится текст с разметкой, клиенту
+// отдается чистый текст и диапазоны спойлеров в нем
+func parseSpoilers(text string) (string, []dto.SpoilerRange) {
+	text, spoilerRanges := entity.ParseSpoilers(text)
+	spoilers := make([]dto.SpoilerRange, len(spoilerRanges))
+	for i, spoiler := range spoilerRanges {
+		spoilers[i] = dto.SpoilerRange{Start: spoiler.Start, End: spoiler.End}
+	}
+	return text, spoilers
+}
+
+// reviewRevisionToDTO конвертирует entity.ReviewRevision в dto.ReviewRevision
+func reviewRevisionToDTO(revision *entity.ReviewRevision) dto.ReviewRevision {
+	text, spoilers := parseSpoilers(revision.Text)
+	return dto.ReviewRevision{
+		Rating:      revision.ContentRating,
+		Title:       revision.Title,
+		Text:        text,
+		HasSpoilers: revision.HasSpoilers,
+		Spoilers:    spoilers,
+		CreatedAt:   revision.CreatedAt.String(),
+		ReplacedAt:  revision.ReplacedAt.String(),
 	}
 }
 
 // reviewEntityToDTO конвертирует entity.Review в dto.ReviewResponse, добавляя дополнительные поля автора и контента
-func (r *ReviewService) reviewEntityToDTO(reviewEntity *entity.Review) (*dto.ReviewResponse, error) {
-	author, err := r.userRepo.GetUserByID(reviewEntity.AuthorID)
+func (r *ReviewService) reviewEntityToDTO(
+	ctx context.Context,
+	reviewEntity *entity.Review,
+) (*dto.ReviewResponse, error) {
+	author, err := r.userRepo.GetUserByID(ctx, reviewEntity.AuthorID)
 	switch {
 	case errors.Is(err, repository.ErrUserNotFound):
 		return nil, usecase.ErrUserNotFound
@@ -49,7 +90,7 @@ func (r *ReviewService) reviewEntityToDTO(reviewEntity *entity.Review) (*dto.Rev
 		authorName = author.Email
 	}
 	var avatar string
-	avatar, err = r.staticUC.GetStatic(author.AvatarUploadID)
+	avatar, err = r.staticUC.GetStatic(ctx, author.AvatarUploadID)
 	switch {
 	case errors.Is(err, usecase.ErrStaticNotFound):
 		// аватара может и не быть
@@ -57,24 +98,34 @@ func (r *ReviewService) reviewEntityToDTO(reviewEntity *entity.Review) (*dto.Rev
 	case err != nil:
 		return nil, entity.UsecaseWrap(errors.New("ошибка при получении аватара"), err)
 	}
-	content, err := r.contentRepo.GetContent(reviewEntity.ContentID)
+	content, err := r.contentRepo.GetContent(ctx, reviewEntity.ContentID)
 	switch {
 	case errors.Is(err, repository.ErrContentNotFound):
 		return nil, usecase.ErrContentNotFound
 	case err != nil:
 		return nil, entity.UsecaseWrap(errors.New("ошибка при получении контента"), err)
 	}
+	text, spoilers := parseSpoilers(reviewEntity.Text)
+	var editedAt string
+	if reviewEntity.EditedAt.Valid {
+		editedAt = reviewEntity.EditedAt.Time.String()
+	}
 	return &dto.ReviewResponse{
 		Review: dto.Review{
-			ID:        reviewEntity.ID,
-			AuthorID:  reviewEntity.AuthorID,
-			ContentID: reviewEntity.ContentID,
-			Rating:    reviewEntity.ContentRating,
-			Title:     reviewEntity.Title,
-			Text:      reviewEntity.Text,
-			CreatedAt: reviewEntity.CreatedAt.String(),
-			Likes:     reviewEntity.Likes,
-			Dislikes:  reviewEntity.Dislikes,
+			ID:          reviewEntity.ID,
+			AuthorID:    reviewEntity.AuthorID,
+			ContentID:   reviewEntity.ContentID,
+			Rating:      reviewEntity.ContentRating,
+			Title:       reviewEntity.Title,
+			Text:        text,
+			CreatedAt:   reviewEntity.CreatedAt.String(),
+			Likes:       reviewEntity.Likes,
+			Dislikes:    reviewEntity.Dislikes,
+			Comments:    reviewEntity.Comments,
+			HasSpoilers: reviewEntity.HasSpoilers,
+			EditCount:   reviewEntity.EditCount,
+			EditedAt:    editedAt,
+			Spoilers:    spoilers,
 		},
 		AuthorName:   authorName,
 		AuthorAvatar: avatar,
@@ -83,10 +134,13 @@ func (r *ReviewService) reviewEntityToDTO(reviewEntity *entity.Review) (*dto.Rev
 }
 
 // reviewEntitiesToDTO конвертирует массив entity.Review в массив dto.ReviewResponse
-func (r *ReviewService) reviewEntitiesToDTO(reviewEntities []*entity.Review) (*dto.ReviewResponseList, error) {
+func (r *ReviewService) reviewEntitiesToDTO(
+	ctx context.Context,
+	reviewEntities []*entity.Review,
+) (*dto.ReviewResponseList, error) {
 	reviews := make([]dto.ReviewResponse, len(reviewEntities))
 	for i, review := range reviewEntities {
-		toDTO, err := r.reviewEntityToDTO(review)
+		toDTO, err := r.reviewEntityToDTO(ctx, review)
 		if err != nil {
 			return nil, err
 		}
@@ -95,13 +149,43 @@ func (r *ReviewService) reviewEntitiesToDTO(reviewEntities []*entity.Review) (*d
 	return &dto.ReviewResponseList{Reviews: reviews}, nil
 }
 
+// reviewFilterToEntity конвертирует dto.ReviewFilter в entity.ReviewFilter, проверяя режим сортировки и фильтры
+func reviewFilterToEntity(filter dto.ReviewFilter) (entity.ReviewFilter, error) {
+	entityFilter := entity.ReviewFilter{
+		HideSpoilers:  filter.HideSpoilers,
+		Sort:          filter.Sort,
+		Sentiment:     filter.Sentiment,
+		MinTextLength: filter.MinTextLength,
+	}
+	if err := entity.ValidateReviewFilter(entityFilter); err != nil {
+		return entity.ReviewFilter{}, usecase.ReviewErrorIncorrectData{Err: err}
+	}
+	return entityFilter, nil
+}
+
+// hasSpoilers возвращает true, если автор отметил рецензию как содержащую спойлеры, либо разметил их в тексте
+func hasSpoilers(marked bool, text string) bool {
+	_, spoilers := entity.ParseSpoilers(text)
+	return marked || len(spoilers) > 0
+}
+
 // GetLatestReviews возвращает последние count отзывов
-func (r *ReviewService) GetLatestReviews(count int) (*dto.ReviewResponseList, error) {
-	reviewEntities, err := r.reviewRepo.GetLatestReviews(count)
+func (r *ReviewService) GetLatestReviews(
+	ctx context.Context,
+	count int,
+	filter dto.ReviewFilter,
+) (*dto.ReviewResponseList, error) {
+	ctx, span := tracing.Start(ctx, "ReviewService.GetLatestReviews")
+	defer span.End()
+	entityFilter, err := reviewFilterToEntity(filter)
+	if err != nil {
+		return nil, err
+	}
+	reviewEntities, err := r.reviewRepo.GetLatestReviews(ctx, count, entityFilter)
 	if err != nil {
 		return nil, entity.UsecaseWrap(errors.New("ошибка при получении последних отзывов"), err)
 	}
-	reviewDTOs, err := r.reviewEntitiesToDTO(reviewEntities)
+	reviewDTOs, err := r.reviewEntitiesToDTO(ctx, reviewEntities)
 	if err != nil {
 		return nil, err
 	}
@@ -113,16 +197,26 @@ func (r *ReviewService) GetLatestReviews(count int) (*dto.ReviewResponseList, er
 }
 
 // GetUserReviews возвращает count отзывов на странице с номером page пользователя с userID
-func (r *ReviewService) GetUserReviews(userID, count, page int) (*dto.ReviewResponseList, error) {
-	reviewEntities, err := r.reviewRepo.GetReviewsByAuthorID(userID, page, count)
+func (r *ReviewService) GetUserReviews(
+	ctx context.Context,
+	userID, count, page int,
+	filter dto.ReviewFilter,
+) (*dto.ReviewResponseList, error) {
+	ctx, span := tracing.Start(ctx, "ReviewService.GetUserReviews")
+	defer span.End()
+	entityFilter, err := reviewFilterToEntity(filter)
+	if err != nil {
+		return nil, err
+	}
+	reviewEntities, err := r.reviewRepo.GetReviewsByAuthorID(ctx, userID, page, count, entityFilter)
 	if err != nil {
 		return nil, entity.UsecaseWrap(errors.New("ошибка при получении отзывов пользователя"), err)
 	}
-	reviewDTOs, err := r.reviewEntitiesToDTO(reviewEntities)
+	reviewDTOs, err := r.reviewEntitiesToDTO(ctx, reviewEntities)
 	if err != nil {
 		return nil, err
 	}
-	reviewDTOs.Total, err = r.reviewRepo.GetReviewsCountByAuthorID(userID)
+	reviewDTOs.Total, err = r.reviewRepo.GetReviewsCountByAuthorID(ctx, userID, entityFilter)
 	if err != nil {
 		return nil, entity.UsecaseWrap(errors.New("ошибка при получении количества отзывов пользователя"), err)
 	}
@@ -132,16 +226,26 @@ func (r *ReviewService) GetUserReviews(userID, count, page int) (*dto.ReviewResp
 	return reviewDTOs, nil
 }
 
-func (r *ReviewService) GetContentReviews(contentID, count, page int) (*dto.ReviewResponseList, error) {
-	reviewEntities, err := r.reviewRepo.GetReviewsByContentID(contentID, page, count)
+func (r *ReviewService) GetContentReviews(
+	ctx context.Context,
+	contentID, count, page int,
+	filter dto.ReviewFilter,
+) (*dto.ReviewResponseList, error) {
+	ctx, span := tracing.Start(ctx, "ReviewService.GetContentReviews")
+	defer span.End()
+	entityFilter, err := reviewFilterToEntity(filter)
+	if err != nil {
+		return nil, err
+	}
+	reviewEntities, err := r.reviewRepo.GetReviewsByContentID(ctx, contentID, page, count, entityFilter)
 	if err != nil {
 		return nil, entity.UsecaseWrap(errors.New("ошибка при получении отзывов контента"), err)
 	}
-	reviewDTOs, err := r.reviewEntitiesToDTO(reviewEntities)
+	reviewDTOs, err := r.reviewEntitiesToDTO(ctx, reviewEntities)
 	if err != nil {
 		return nil, err
 	}
-	reviewDTOs.Total, err = r.reviewRepo.GetReviewsCountByContentID(contentID)
+	reviewDTOs.Total, err = r.reviewRepo.GetReviewsCountByContentID(ctx, contentID, entityFilter)
 	if err != nil {
 		return nil, entity.UsecaseWrap(errors.New("ошибка при получении количества отзывов"), err)
 	}
@@ -151,31 +255,115 @@ func (r *ReviewService) GetContentReviews(contentID, count, page int) (*dto.Revi
 	return reviewDTOs, nil
 }
 
-func (r *ReviewService) GetReview(reviewID int) (*dto.ReviewResponse, error) {
-	reviewEntity, err := r.reviewRepo.GetReviewByID(reviewID)
+// canSeeHidden проверяет, может ли пользователь видеть скрытую модератором рецензию: это разрешено только
+// ее автору и модераторам
+func canSeeHidden(
+	ctx context.Context,
+	moderationRepo repository.ReviewModeration,
+	review *entity.Review,
+	viewerID int,
+) (bool, error) {
+	if viewerID <= 0 {
+		return false, nil
+	}
+	if review.AuthorID == viewerID {
+		return true, nil
+	}
+	isModerator, err := moderationRepo.IsModerator(ctx, viewerID)
+	if err != nil {
+		return false, entity.UsecaseWrap(errors.New("ошибка при проверке прав модератора"), err)
+	}
+	return isModerator, nil
+}
+
+func (r *ReviewService) GetReview(ctx context.Context, reviewID, viewerID int) (*dto.ReviewResponse, error) {
+	ctx, span := tracing.Start(ctx, "ReviewService.GetReview")
+	defer span.End()
+	reviewEntity, err := r.reviewRepo.GetReviewByID(ctx, reviewID)
 	switch {
 	case errors.Is(err, repository.ErrReviewNotFound):
 		return nil, usecase.ErrReviewNotFound
 	case err != nil:
 		return nil, entity.UsecaseWrap(errors.New("ошибка при получении отзыва"), err)
 	}
-	return r.reviewEntityToDTO(reviewEntity)
+	if reviewEntity.Hidden {
+		visible, err := canSeeHidden(ctx, r.moderationRepo, reviewEntity, viewerID)
+		if err != nil {
+			return nil, err
+		}
+		if !visible {
+			return nil, usecase.ErrReviewNotFound
+		}
+	}
+	return r.reviewEntityToDTO(ctx, reviewEntity)
+}
+
+func (r *ReviewService) GetReviewHistory(ctx context.Context, reviewID, viewerID int) (*dto.ReviewHistory, error) {
+	ctx, span := tracing.Start(ctx, "ReviewService.GetReviewHistory")
+	defer span.End()
+	review, err := r.GetReview(ctx, reviewID, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	revisions, err := r.reviewRepo.GetReviewRevisions(ctx, reviewID)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении истории правок рецензии"), err)
+	}
+	history := &dto.ReviewHistory{
+		Review:    *review,
+		Revisions: make([]dto.ReviewRevision, len(revisions)),
+	}
+	for i, revision := range revisions {
+		history.Revisions[i] = reviewRevisionToDTO(revision)
+	}
+	return history, nil
+}
+
+func (r *ReviewService) GetContentRatingStats(ctx context.Context, contentID int) (*dto.ContentRatingStats, error) {
+	ctx, span := tracing.Start(ctx, "ReviewService.GetContentRatingStats")
+	defer span.End()
+	stats, err := r.reviewRepo.GetContentRatingStats(ctx, contentID)
+	switch {
+	case errors.Is(err, repository.ErrContentNotFound):
+		return nil, usecase.ErrContentNotFound
+	case err != nil:
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении распределения оценок контента"), err)
+	}
+	histogram := make([]dto.RatingHistogramBar, len(stats.Histogram))
+	for i, count := range stats.Histogram {
+		histogram[i] = dto.RatingHistogramBar{Rating: i + 1, Count: count}
+	}
+	return &dto.ContentRatingStats{
+		ContentID:      stats.ContentID,
+		RatingCount:    stats.Count(),
+		Average:        stats.Average(),
+		Median:         stats.Median(),
+		WeightedRating: stats.WeightedRating,
+		Histogram:      histogram,
+	}, nil
 }
 
-func (r *ReviewService) GetContentReviewByAuthor(authorID, contentID int) (*dto.ReviewResponse, error) {
-	reviewEntity, err := r.reviewRepo.GetContentReviewByAuthor(authorID, contentID)
+func (r *ReviewService) GetContentReviewByAuthor(
+	ctx context.Context,
+	authorID, contentID int,
+) (*dto.ReviewResponse, error) {
+	ctx, span := tracing.Start(ctx, "ReviewService.GetContentReviewByAuthor")
+	defer span.End()
+	reviewEntity, err := r.reviewRepo.GetContentReviewByAuthor(ctx, authorID, contentID)
 	switch {
 	case errors.Is(err, repository.ErrReviewNotFound):
 		return nil, usecase.ErrReviewNotFound
 	case err != nil:
 		return nil, entity.UsecaseWrap(errors.New("ошибка при получении отзыва"), err)
 	}
-	return r.reviewEntityToDTO(reviewEntity)
+	return r.reviewEntityToDTO(ctx, reviewEntity)
 }
 
-func (r *ReviewService) CreateReview(review dto.ReviewCreate) (*dto.ReviewResponse, error) {
+func (r *ReviewService) CreateReview(ctx context.Context, review dto.ReviewCreate) (*dto.ReviewResponse, error) {
+	ctx, span := tracing.Start(ctx, "ReviewService.CreateReview")
+	defer span.End()
 	// на невышедший контент ставить рецензии нельзя!
-	content, err := r.contentRepo.GetContent(review.ContentID)
+	content, err := r.contentRepo.GetContent(ctx, review.ContentID)
 	if err != nil && errors.Is(err, repository.ErrContentNotFound) || content.Ongoing {
 		return nil, usecase.ErrReviewContentNotFound
 	}
@@ -185,20 +373,21 @@ func (r *ReviewService) CreateReview(review dto.ReviewCreate) (*dto.ReviewRespon
 	if err := entity.ValidateReview(review.Rating, review.Title, review.Text); err != nil {
 		return nil, usecase.ReviewErrorIncorrectData{Err: err}
 	}
-	filtratedText, err := r.profanityUC.FilterMessage(strings.TrimSpace(review.Text))
+	filtratedText, err := r.profanityUC.FilterMessage(ctx, strings.TrimSpace(review.Text))
 	if err != nil {
 		return nil, entity.UsecaseWrap(errors.New("ошибка при фильтрации содержания рецензии"), err)
 	}
-	filtratedTitle, err := r.profanityUC.FilterMessage(strings.TrimSpace(review.Title))
+	filtratedTitle, err := r.profanityUC.FilterMessage(ctx, strings.TrimSpace(review.Title))
 	if err != nil {
 		return nil, entity.UsecaseWrap(errors.New("ошибка при фильтрации заголовка рецензии"), err)
 	}
-	reviewEntity, err := r.reviewRepo.AddReview(&entity.Review{
+	reviewEntity, err := r.reviewRepo.AddReview(ctx, &entity.Review{
 		AuthorID:      review.UserID,
 		ContentID:     review.ContentID,
 		ContentRating: review.Rating,
 		Title:         filtratedTitle,
 		Text:          filtratedText,
+		HasSpoilers:   hasSpoilers(review.HasSpoilers, filtratedText),
 	})
 	switch {
 	case errors.Is(err, repository.ErrReviewViolation):
@@ -210,11 +399,24 @@ func (r *ReviewService) CreateReview(review dto.ReviewCreate) (*dto.ReviewRespon
 	case err != nil:
 		return nil, entity.UsecaseWrap(errors.New("ошибка при добавлении отзыва"), err)
 	}
-	return r.reviewEntityToDTO(reviewEntity)
+	metrics.ReviewsCreated.Inc()
+	invalidateContent(ctx, r.contentRepo, review.ContentID)
+	invalidateUserStats(ctx, r.statsRepo, review.UserID,
+		entity.UserStatsSectionRatings, entity.UserStatsSectionActivity,
+	)
+	recordActivity(ctx, r.activityRepo, &entity.Activity{
+		UserID:    review.UserID,
+		Type:      entity.ActivityReview,
+		ContentID: review.ContentID,
+		ReviewID:  reviewEntity.ID,
+	})
+	return r.reviewEntityToDTO(ctx, reviewEntity)
 }
 
-func (r *ReviewService) EditReview(review dto.ReviewUpdate) (*dto.ReviewResponse, error) {
-	currentReview, err := r.reviewRepo.GetReviewByID(review.ReviewID)
+func (r *ReviewService) EditReview(ctx context.Context, review dto.ReviewUpdate) (*dto.ReviewResponse, error) {
+	ctx, span := tracing.Start(ctx, "ReviewService.EditReview")
+	defer span.End()
+	currentReview, err := r.reviewRepo.GetReviewByID(ctx, review.ReviewID)
 	switch {
 	case errors.Is(err, repository.ErrReviewNotFound):
 		return nil, usecase.ErrReviewNotFound
@@ -227,20 +429,21 @@ func (r *ReviewService) EditReview(review dto.ReviewUpdate) (*dto.ReviewResponse
 	if err = entity.ValidateReview(review.Rating, review.Title, review.Title); err != nil {
 		return nil, usecase.ReviewErrorIncorrectData{Err: err}
 	}
-	filtratedText, err := r.profanityUC.FilterMessage(strings.TrimSpace(review.Text))
+	filtratedText, err := r.profanityUC.FilterMessage(ctx, strings.TrimSpace(review.Text))
 	if err != nil {
 		return nil, entity.UsecaseWrap(errors.New("ошибка при фильтрации содержания рецензии"), err)
 	}
-	filtratedTitle, err := r.profanityUC.FilterMessage(strings.TrimSpace(review.Title))
+	filtratedTitle, err := r.profanityUC.FilterMessage(ctx, strings.TrimSpace(review.Title))
 	if err != nil {
 		return nil, entity.UsecaseWrap(errors.New("ошибка при фильтрации заголовка рецензии"), err)
 	}
-	err = r.reviewRepo.UpdateReview(&entity.Review{
+	err = r.reviewRepo.UpdateReview(ctx, &entity.Review{
 		ID:            review.ReviewID,
 		AuthorID:      review.UserID,
 		ContentRating: review.Rating,
 		Title:         filtratedTitle,
 		Text:          filtratedText,
+		HasSpoilers:   hasSpoilers(review.HasSpoilers, filtratedText),
 	})
 	switch {
 	case errors.Is(err, repository.ErrReviewNotFound):
@@ -250,18 +453,23 @@ func (r *ReviewService) EditReview(review dto.ReviewUpdate) (*dto.ReviewResponse
 	case err != nil:
 		return nil, entity.UsecaseWrap(errors.New("ошибка при обновлении отзыва"), err)
 	}
-	reviewEntity, err := r.reviewRepo.GetReviewByID(review.ReviewID)
+	invalidateContent(ctx, r.contentRepo, currentReview.ContentID)
+	// оценка рецензии могла измениться, а активность по месяцам считается по дате создания и не меняется
+	invalidateUserStats(ctx, r.statsRepo, review.UserID, entity.UserStatsSectionRatings)
+	reviewEntity, err := r.reviewRepo.GetReviewByID(ctx, review.ReviewID)
 	switch {
 	case errors.Is(err, repository.ErrReviewNotFound):
 		return nil, usecase.ErrReviewNotFound
 	case err != nil:
 		return nil, entity.UsecaseWrap(errors.New("ошибка при получении отзыва"), err)
 	}
-	return r.reviewEntityToDTO(reviewEntity)
+	return r.reviewEntityToDTO(ctx, reviewEntity)
 }
 
-func (r *ReviewService) DeleteReview(reviewID, userID int) error {
-	review, err := r.reviewRepo.GetReviewByID(reviewID)
+func (r *ReviewService) DeleteReview(ctx context.Context, reviewID, userID int) error {
+	ctx, span := tracing.Start(ctx, "ReviewService.DeleteReview")
+	defer span.End()
+	review, err := r.reviewRepo.GetReviewByID(ctx, reviewID)
 	switch {
 	case errors.Is(err, repository.ErrReviewNotFound):
 		return usecase.ErrReviewNotFound
@@ -271,18 +479,33 @@ func (r *ReviewService) DeleteReview(reviewID, userID int) error {
 	if review.AuthorID != userID {
 		return usecase.ErrReviewForbidden
 	}
-	err = r.reviewRepo.DeleteReviewByID(reviewID)
+	err = r.reviewRepo.DeleteReviewByID(ctx, reviewID)
 	switch {
 	case errors.Is(err, repository.ErrReviewNotFound):
 		return usecase.ErrReviewNotFound
 	case err != nil:
 		return entity.UsecaseWrap(errors.New("ошибка при удалении отзыва"), err)
 	}
+	invalidateContent(ctx, r.contentRepo, review.ContentID)
+	invalidateUserStats(ctx, r.statsRepo, userID, entity.UserStatsSectionRatings, entity.UserStatsSectionActivity)
 	return nil
 }
 
-func (r *ReviewService) VoteReview(reviewID, userID int, vote bool) error {
-	userVote, err := r.reviewRepo.IsVotedByUser(reviewID, userID)
+func (r *ReviewService) VoteReview(ctx context.Context, userID, reviewID int, vote bool) error {
+	ctx, span := tracing.Start(ctx, "ReviewService.VoteReview")
+	defer span.End()
+	review, err := r.reviewRepo.GetReviewByID(ctx, reviewID)
+	switch {
+	case errors.Is(err, repository.ErrReviewNotFound):
+		return usecase.ErrReviewNotFound
+	case err != nil:
+		return entity.UsecaseWrap(errors.New("ошибка при получении отзыва"), err)
+	}
+	// за скрытую модератором рецензию голосовать нельзя, как и за несуществующую
+	if review.Hidden {
+		return usecase.ErrReviewNotFound
+	}
+	userVote, err := r.reviewRepo.IsVotedByUser(ctx, reviewID, userID)
 	if err != nil {
 		return entity.UsecaseWrap(errors.New("ошибка при проверке оценки"), err)
 	}
@@ -295,10 +518,10 @@ func (r *ReviewService) VoteReview(reviewID, userID int, vote bool) error {
 		return nil
 	case userVote == 1:
 		// пользователь поставил дизлайк, но хочет поставить лайк
-		err = r.reviewRepo.UnVoteReview(reviewID, userID)
+		err = r.reviewRepo.UnVoteReview(ctx, reviewID, userID)
 	case userVote == -1:
 		// пользователь поставил лайк, но хочет поставить дизлайк
-		err = r.reviewRepo.UnVoteReview(reviewID, userID)
+		err = r.reviewRepo.UnVoteReview(ctx, reviewID, userID)
 	}
 	switch {
 	case errors.Is(err, repository.ErrReviewVoteNotFound):
@@ -307,7 +530,7 @@ func (r *ReviewService) VoteReview(reviewID, userID int, vote bool) error {
 	case err != nil:
 		return entity.UsecaseWrap(errors.New("ошибка при удалении оценки"), err)
 	}
-	err = r.reviewRepo.VoteReview(reviewID, userID, vote)
+	err = r.reviewRepo.VoteReview(ctx, reviewID, userID, vote)
 	switch {
 	case errors.Is(err, repository.ErrReviewNotFound):
 		return usecase.ErrReviewNotFound
@@ -316,19 +539,24 @@ func (r *ReviewService) VoteReview(reviewID, userID int, vote bool) error {
 	case err != nil:
 		return entity.UsecaseWrap(errors.New("ошибка при оценке отзыва"), err)
 	}
+	metrics.ReviewVotes.WithLabelValues(metrics.VoteLabel(vote)).Inc()
 	return nil
 }
 
-func (r *ReviewService) IsVotedByUser(userID, reviewID int) (int, error) {
-	isVoted, err := r.reviewRepo.IsVotedByUser(reviewID, userID)
+func (r *ReviewService) IsVotedByUser(ctx context.Context, userID, reviewID int) (int, error) {
+	ctx, span := tracing.Start(ctx, "ReviewService.IsVotedByUser")
+	defer span.End()
+	isVoted, err := r.reviewRepo.IsVotedByUser(ctx, reviewID, userID)
 	if err != nil {
 		return 0, entity.UsecaseWrap(errors.New("ошибка при проверке оценки"), err)
 	}
 	return isVoted, nil
 }
 
-func (r *ReviewService) UnVoteReview(userID, reviewID int) error {
-	err := r.reviewRepo.UnVoteReview(reviewID, userID)
+func (r *ReviewService) UnVoteReview(ctx context.Context, userID, reviewID int) error {
+	ctx, span := tracing.Start(ctx, "ReviewService.UnVoteReview")
+	defer span.End()
+	err := r.reviewRepo.UnVoteReview(ctx, reviewID, userID)
 	switch {
 	case errors.Is(err, repository.ErrReviewVoteNotFound):
 		return usecase.ErrReviewVoteNotFound
@@ -0,0 +1,306 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	"strings"
+)
+
+type ReviewCommentService struct {
+	commentRepo    repository.ReviewComment
+	reviewRepo     repository.Review
+	userRepo       repository.User
+	staticUC       usecase.Static
+	profanityUC    usecase.Profanity
+	moderationRepo repository.ReviewModeration
+}
+
+func NewReviewCommentService(
+	commentRepo repository.ReviewComment,
+	reviewRepo repository.Review,
+	userRepo repository.User,
+	staticUC usecase.Static,
+	profanityUC usecase.Profanity,
+	moderationRepo repository.ReviewModeration,
+) usecase.ReviewComment {
+	return &ReviewCommentService{
+		commentRepo:    commentRepo,
+		reviewRepo:     reviewRepo,
+		userRepo:       userRepo,
+		staticUC:       staticUC,
+		profanityUC:    profanityUC,
+		moderationRepo: moderationRepo,
+	}
+}
+
+// commentAuthor данные автора, которые отдаются вместе с комментарием
+type commentAuthor struct {
+	name   string
+	avatar string
+}
+
+// getCommentAuthor возвращает имя и аватар автора комментария. Уже полученные авторы берутся из authors
+func (r *ReviewCommentService) getCommentAuthor(
+	ctx context.Context,
+	authorID int,
+	authors map[int]commentAuthor,
+) (commentAuthor, error) {
+	if author, ok := authors[authorID]; ok {
+		return author, nil
+	}
+	user, err := r.userRepo.GetUserByID(ctx, authorID)
+	switch {
+	case errors.Is(err, repository.ErrUserNotFound):
+		return commentAuthor{}, usecase.ErrUserNotFound
+	case err != nil:
+		return commentAuthor{}, entity.UsecaseWrap(errors.New("ошибка при получении пользователя"), err)
+	}
+	author := commentAuthor{name: user.Name}
+	if user.Name == "" {
+		// если пользователь не указал имя, то показываем его мейл
+		author.name = user.Email
+	}
+	author.avatar, err = r.staticUC.GetStatic(ctx, user.AvatarUploadID)
+	switch {
+	case errors.Is(err, usecase.ErrStaticNotFound):
+		// аватара может и не быть
+		author.avatar = ""
+	case err != nil:
+		return commentAuthor{}, entity.UsecaseWrap(errors.New("ошибка при получении аватара"), err)
+	}
+	authors[authorID] = author
+	return author, nil
+}
+
+// commentEntityToDTO конвертирует entity.ReviewComment в dto.ReviewCommentResponse, добавляя данные автора
+func (r *ReviewCommentService) commentEntityToDTO(
+	ctx context.Context,
+	comment *entity.ReviewComment,
+	authors map[int]commentAuthor,
+) (*dto.ReviewCommentResponse, error) {
+	author, err := r.getCommentAuthor(ctx, comment.AuthorID, authors)
+	if err != nil {
+		return nil, err
+	}
+	return &dto.ReviewCommentResponse{
+		ReviewComment: dto.ReviewComment{
+			ID:        comment.ID,
+			ReviewID:  comment.ReviewID,
+			AuthorID:  comment.AuthorID,
+			ParentID:  int(comment.ParentID.Int64),
+			Text:      comment.Text,
+			CreatedAt: comment.CreatedAt.String(),
+			UpdatedAt: comment.UpdatedAt.String(),
+		},
+		AuthorName:   author.name,
+		AuthorAvatar: author.avatar,
+	}, nil
+}
+
+// checkReview проверяет, что рецензия существует и видна пользователю viewerID. Скрытая модератором рецензия
+// для остальных пользователей считается ненайденной
+func (r *ReviewCommentService) checkReview(ctx context.Context, reviewID, viewerID int) error {
+	review, err := r.reviewRepo.GetReviewByID(ctx, reviewID)
+	switch {
+	case errors.Is(err, repository.ErrReviewNotFound):
+		return usecase.ErrReviewNotFound
+	case err != nil:
+		return entity.UsecaseWrap(errors.New("ошибка при получении рецензии"), err)
+	}
+	if !review.Hidden {
+		return nil
+	}
+	visible, err := canSeeHidden(ctx, r.moderationRepo, review, viewerID)
+	if err != nil {
+		return err
+	}
+	if !visible {
+		return usecase.ErrReviewNotFound
+	}
+	return nil
+}
+
+// getOwnComment возвращает комментарий, если его автор - userID
+func (r *ReviewCommentService) getOwnComment(
+	ctx context.Context,
+	commentID, userID int,
+) (*entity.ReviewComment, error) {
+	comment, err := r.commentRepo.GetCommentByID(ctx, commentID)
+	switch {
+	case errors.Is(err, repository.ErrReviewCommentNotFound):
+		return nil, usecase.ErrReviewCommentNotFound
+	case err != nil:
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении комментария"), err)
+	}
+	if comment.AuthorID != userID {
+		return nil, usecase.ErrReviewCommentForbidden
+	}
+	return comment, nil
+}
+
+// filterCommentText проверяет текст комментария и фильтрует в нем нецензурную лексику
+func (r *ReviewCommentService) filterCommentText(ctx context.Context, text string) (string, error) {
+	if err := entity.ValidateReviewCommentText(text); err != nil {
+		return "", usecase.ReviewErrorIncorrectData{Err: err}
+	}
+	filtratedText, err := r.profanityUC.FilterMessage(ctx, strings.TrimSpace(text))
+	if err != nil {
+		return "", entity.UsecaseWrap(errors.New("ошибка при фильтрации содержания комментария"), err)
+	}
+	return filtratedText, nil
+}
+
+// GetReviewComments возвращает count комментариев верхнего уровня на странице page. Ответы на них загружаются
+// одним запросом и возвращаются целиком
+func (r *ReviewCommentService) GetReviewComments(
+	ctx context.Context,
+	reviewID, viewerID, count, page int,
+) (*dto.ReviewCommentResponseList, error) {
+	ctx, span := tracing.Start(ctx, "ReviewCommentService.GetReviewComments")
+	defer span.End()
+	if err := r.checkReview(ctx, reviewID, viewerID); err != nil {
+		return nil, err
+	}
+	comments, err := r.commentRepo.GetCommentsByReviewID(ctx, reviewID, page, count)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении комментариев"), err)
+	}
+	parentIDs := make([]int, len(comments))
+	for i, comment := range comments {
+		parentIDs[i] = comment.ID
+	}
+	replies, err := r.commentRepo.GetRepliesByParentIDs(ctx, parentIDs)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении ответов на комментарии"), err)
+	}
+	authors := make(map[int]commentAuthor)
+	repliesByParent := make(map[int][]dto.ReviewCommentResponse, len(comments))
+	for _, reply := range replies {
+		replyDTO, err := r.commentEntityToDTO(ctx, reply, authors)
+		if err != nil {
+			return nil, err
+		}
+		parentID := int(reply.ParentID.Int64)
+		repliesByParent[parentID] = append(repliesByParent[parentID], *replyDTO)
+	}
+	response := &dto.ReviewCommentResponseList{Comments: make([]dto.ReviewCommentResponse, len(comments))}
+	for i, comment := range comments {
+		commentDTO, err := r.commentEntityToDTO(ctx, comment, authors)
+		if err != nil {
+			return nil, err
+		}
+		commentDTO.Replies = repliesByParent[comment.ID]
+		response.Comments[i] = *commentDTO
+	}
+	response.Total, err = r.commentRepo.GetCommentsCountByReviewID(ctx, reviewID)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении количества комментариев"), err)
+	}
+	response.Page = page
+	response.Count = len(comments)
+	response.Pages = (response.Total + count - 1) / count
+	return response, nil
+}
+
+func (r *ReviewCommentService) CreateComment(
+	ctx context.Context,
+	create dto.ReviewCommentCreate,
+) (*dto.ReviewCommentResponse, error) {
+	ctx, span := tracing.Start(ctx, "ReviewCommentService.CreateComment")
+	defer span.End()
+	if err := r.checkReview(ctx, create.ReviewID, create.UserID); err != nil {
+		return nil, err
+	}
+	comment := &entity.ReviewComment{
+		ReviewID: create.ReviewID,
+		AuthorID: create.UserID,
+	}
+	if create.ParentID != 0 {
+		parent, err := r.commentRepo.GetCommentByID(ctx, create.ParentID)
+		switch {
+		case errors.Is(err, repository.ErrReviewCommentNotFound):
+			return nil, usecase.ErrReviewCommentNotFound
+		case err != nil:
+			return nil, entity.UsecaseWrap(errors.New("ошибка при получении комментария"), err)
+		}
+		if parent.ReviewID != create.ReviewID {
+			return nil, usecase.ErrReviewCommentNotFound
+		}
+		// допускается только один уровень вложенности
+		if parent.ParentID.Valid {
+			return nil, usecase.ReviewErrorIncorrectData{Err: usecase.ErrReviewCommentTooDeep}
+		}
+		comment.ParentID = sql.NullInt64{Int64: int64(parent.ID), Valid: true}
+	}
+	var err error
+	comment.Text, err = r.filterCommentText(ctx, create.Text)
+	if err != nil {
+		return nil, err
+	}
+	comment, err = r.commentRepo.AddComment(ctx, comment)
+	switch {
+	case errors.Is(err, repository.ErrReviewCommentViolation):
+		// рецензию или родительский комментарий удалили, пока создавался ответ
+		return nil, usecase.ErrReviewNotFound
+	case errors.Is(err, repository.ErrReviewCommentBadRequest):
+		return nil, usecase.ReviewErrorIncorrectData{Err: err}
+	case err != nil:
+		return nil, entity.UsecaseWrap(errors.New("ошибка при добавлении комментария"), err)
+	}
+	return r.commentEntityToDTO(ctx, comment, make(map[int]commentAuthor))
+}
+
+func (r *ReviewCommentService) EditComment(
+	ctx context.Context,
+	update dto.ReviewCommentUpdate,
+) (*dto.ReviewCommentResponse, error) {
+	ctx, span := tracing.Start(ctx, "ReviewCommentService.EditComment")
+	defer span.End()
+	comment, err := r.getOwnComment(ctx, update.CommentID, update.UserID)
+	if err != nil {
+		return nil, err
+	}
+	filtratedText, err := r.filterCommentText(ctx, update.Text)
+	if err != nil {
+		return nil, err
+	}
+	err = r.commentRepo.UpdateComment(ctx, &entity.ReviewComment{ID: comment.ID, Text: filtratedText})
+	switch {
+	case errors.Is(err, repository.ErrReviewCommentNotFound):
+		return nil, usecase.ErrReviewCommentNotFound
+	case errors.Is(err, repository.ErrReviewCommentBadRequest):
+		return nil, usecase.ReviewErrorIncorrectData{Err: err}
+	case err != nil:
+		return nil, entity.UsecaseWrap(errors.New("ошибка при обновлении комментария"), err)
+	}
+	comment, err = r.commentRepo.GetCommentByID(ctx, comment.ID)
+	switch {
+	case errors.Is(err, repository.ErrReviewCommentNotFound):
+		return nil, usecase.ErrReviewCommentNotFound
+	case err != nil:
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении комментария"), err)
+	}
+	return r.commentEntityToDTO(ctx, comment, make(map[int]commentAuthor))
+}
+
+func (r *ReviewCommentService) DeleteComment(ctx context.Context, commentID, userID int) error {
+	ctx, span := tracing.Start(ctx, "ReviewCommentService.DeleteComment")
+	defer span.End()
+	if _, err := r.getOwnComment(ctx, commentID, userID); err != nil {
+		return err
+	}
+	err := r.commentRepo.DeleteCommentByID(ctx, commentID)
+	switch {
+	case errors.Is(err, repository.ErrReviewCommentNotFound):
+		return usecase.ErrReviewCommentNotFound
+	case err != nil:
+		return entity.UsecaseWrap(errors.New("ошибка при удалении комментария"), err)
+	}
+	return nil
+}
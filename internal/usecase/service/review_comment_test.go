@@ -0,0 +1,499 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	mockrepo "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository/mocks"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	mock_usecase "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReviewCommentService_GetReviewComments(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name                    string
+		ReviewID                int
+		ViewerID                int
+		ExpectedOutput          *dto.ReviewCommentResponseList
+		ExpectedErr             error
+		SetupCommentRepoMock    func(repo *mockrepo.MockReviewComment)
+		SetupReviewRepoMock     func(repo *mockrepo.MockReview)
+		SetupUserRepoMock       func(repo *mockrepo.MockUser)
+		SetupStaticUCMock       func(uc *mock_usecase.MockStatic)
+		SetupModerationRepoMock func(repo *mockrepo.MockReviewModeration)
+	}{
+		{
+			Name:     "Ответы прикрепляются к комментариям",
+			ReviewID: 1,
+			ExpectedOutput: &dto.ReviewCommentResponseList{
+				Comments: []dto.ReviewCommentResponse{
+					{
+						ReviewComment: dto.ReviewComment{
+							ID:        1,
+							ReviewID:  1,
+							AuthorID:  1,
+							Text:      "первый",
+							CreatedAt: fixedTime.String(),
+							UpdatedAt: fixedTime.String(),
+						},
+						AuthorName:   "Author",
+						AuthorAvatar: "avatars/avatar.jpg",
+						Replies: []dto.ReviewCommentResponse{
+							{
+								ReviewComment: dto.ReviewComment{
+									ID:        3,
+									ReviewID:  1,
+									AuthorID:  1,
+									ParentID:  1,
+									Text:      "ответ",
+									CreatedAt: fixedTime.String(),
+									UpdatedAt: fixedTime.String(),
+								},
+								AuthorName:   "Author",
+								AuthorAvatar: "avatars/avatar.jpg",
+							},
+						},
+					},
+					{
+						ReviewComment: dto.ReviewComment{
+							ID:        2,
+							ReviewID:  1,
+							AuthorID:  1,
+							Text:      "второй",
+							CreatedAt: fixedTime.String(),
+							UpdatedAt: fixedTime.String(),
+						},
+						AuthorName:   "Author",
+						AuthorAvatar: "avatars/avatar.jpg",
+					},
+				},
+				Page:  1,
+				Count: 2,
+				Pages: 1,
+				Total: 2,
+			},
+			ExpectedErr: nil,
+			SetupCommentRepoMock: func(repo *mockrepo.MockReviewComment) {
+				repo.EXPECT().GetCommentsByReviewID(gomock.Any(), 1, 1, 10).Return([]*entity.ReviewComment{
+					{ID: 1, ReviewID: 1, AuthorID: 1, Text: "первый", CreatedAt: fixedTime, UpdatedAt: fixedTime},
+					{ID: 2, ReviewID: 1, AuthorID: 1, Text: "второй", CreatedAt: fixedTime, UpdatedAt: fixedTime},
+				}, nil)
+				repo.EXPECT().GetRepliesByParentIDs(gomock.Any(), []int{1, 2}).Return([]*entity.ReviewComment{
+					{
+						ID:        3,
+						ReviewID:  1,
+						AuthorID:  1,
+						ParentID:  sql.NullInt64{Int64: 1, Valid: true},
+						Text:      "ответ",
+						CreatedAt: fixedTime,
+						UpdatedAt: fixedTime,
+					},
+				}, nil)
+				repo.EXPECT().GetCommentsCountByReviewID(gomock.Any(), 1).Return(2, nil)
+			},
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetReviewByID(gomock.Any(), 1).Return(&entity.Review{ID: 1}, nil)
+			},
+			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
+				// автор запрашивается один раз на все комментарии
+				repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(&entity.User{ID: 1, Name: "Author", AvatarUploadID: 1}, nil)
+			},
+			SetupStaticUCMock: func(uc *mock_usecase.MockStatic) {
+				uc.EXPECT().GetStatic(gomock.Any(), 1).Return("avatars/avatar.jpg", nil)
+			},
+		},
+		{
+			Name:                 "Рецензия не найдена",
+			ReviewID:             1,
+			ExpectedErr:          usecase.ErrReviewNotFound,
+			SetupCommentRepoMock: func(repo *mockrepo.MockReviewComment) {},
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetReviewByID(gomock.Any(), 1).Return(nil, repository.ErrReviewNotFound)
+			},
+			SetupUserRepoMock: func(repo *mockrepo.MockUser) {},
+			SetupStaticUCMock: func(uc *mock_usecase.MockStatic) {},
+		},
+		{
+			Name:        "Ошибка при получении комментариев",
+			ReviewID:    1,
+			ExpectedErr: entity.UsecaseWrap(errors.New("ошибка при получении комментариев"), errors.New("database error")),
+			SetupCommentRepoMock: func(repo *mockrepo.MockReviewComment) {
+				repo.EXPECT().GetCommentsByReviewID(gomock.Any(), 1, 1, 10).Return(nil, errors.New("database error"))
+			},
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetReviewByID(gomock.Any(), 1).Return(&entity.Review{ID: 1}, nil)
+			},
+			SetupUserRepoMock: func(repo *mockrepo.MockUser) {},
+			SetupStaticUCMock: func(uc *mock_usecase.MockStatic) {},
+		},
+		{
+			Name:                 "Скрытая рецензия не видна неавторизованному пользователю",
+			ReviewID:             1,
+			ViewerID:             -1,
+			ExpectedErr:          usecase.ErrReviewNotFound,
+			SetupCommentRepoMock: func(repo *mockrepo.MockReviewComment) {},
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetReviewByID(gomock.Any(), 1).Return(&entity.Review{ID: 1, AuthorID: 1, Hidden: true}, nil)
+			},
+			SetupUserRepoMock: func(repo *mockrepo.MockUser) {},
+			SetupStaticUCMock: func(uc *mock_usecase.MockStatic) {},
+		},
+		{
+			Name:                 "Скрытая рецензия не видна другому пользователю",
+			ReviewID:             1,
+			ViewerID:             2,
+			ExpectedErr:          usecase.ErrReviewNotFound,
+			SetupCommentRepoMock: func(repo *mockrepo.MockReviewComment) {},
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetReviewByID(gomock.Any(), 1).Return(&entity.Review{ID: 1, AuthorID: 1, Hidden: true}, nil)
+			},
+			SetupUserRepoMock: func(repo *mockrepo.MockUser) {},
+			SetupStaticUCMock: func(uc *mock_usecase.MockStatic) {},
+			SetupModerationRepoMock: func(repo *mockrepo.MockReviewModeration) {
+				repo.EXPECT().IsModerator(gomock.Any(), 2).Return(false, nil)
+			},
+		},
+		{
+			Name:           "Автор видит комментарии к своей скрытой рецензии",
+			ReviewID:       1,
+			ViewerID:       1,
+			ExpectedOutput: &dto.ReviewCommentResponseList{Comments: []dto.ReviewCommentResponse{}, Page: 1},
+			SetupCommentRepoMock: func(repo *mockrepo.MockReviewComment) {
+				repo.EXPECT().GetCommentsByReviewID(gomock.Any(), 1, 1, 10).Return([]*entity.ReviewComment{}, nil)
+				repo.EXPECT().GetRepliesByParentIDs(gomock.Any(), []int{}).Return(nil, nil)
+				repo.EXPECT().GetCommentsCountByReviewID(gomock.Any(), 1).Return(0, nil)
+			},
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetReviewByID(gomock.Any(), 1).Return(&entity.Review{ID: 1, AuthorID: 1, Hidden: true}, nil)
+			},
+			SetupUserRepoMock: func(repo *mockrepo.MockUser) {},
+			SetupStaticUCMock: func(uc *mock_usecase.MockStatic) {},
+		},
+		{
+			Name:           "Модератор видит комментарии к скрытой рецензии",
+			ReviewID:       1,
+			ViewerID:       3,
+			ExpectedOutput: &dto.ReviewCommentResponseList{Comments: []dto.ReviewCommentResponse{}, Page: 1},
+			SetupCommentRepoMock: func(repo *mockrepo.MockReviewComment) {
+				repo.EXPECT().GetCommentsByReviewID(gomock.Any(), 1, 1, 10).Return([]*entity.ReviewComment{}, nil)
+				repo.EXPECT().GetRepliesByParentIDs(gomock.Any(), []int{}).Return(nil, nil)
+				repo.EXPECT().GetCommentsCountByReviewID(gomock.Any(), 1).Return(0, nil)
+			},
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetReviewByID(gomock.Any(), 1).Return(&entity.Review{ID: 1, AuthorID: 1, Hidden: true}, nil)
+			},
+			SetupUserRepoMock: func(repo *mockrepo.MockUser) {},
+			SetupStaticUCMock: func(uc *mock_usecase.MockStatic) {},
+			SetupModerationRepoMock: func(repo *mockrepo.MockReviewModeration) {
+				repo.EXPECT().IsModerator(gomock.Any(), 3).Return(true, nil)
+			},
+		},
+		{
+			Name:     "Ошибка при проверке прав модератора",
+			ReviewID: 1,
+			ViewerID: 2,
+			ExpectedErr: entity.UsecaseWrap(
+				errors.New("ошибка при проверке прав модератора"),
+				errors.New("database error"),
+			),
+			SetupCommentRepoMock: func(repo *mockrepo.MockReviewComment) {},
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetReviewByID(gomock.Any(), 1).Return(&entity.Review{ID: 1, AuthorID: 1, Hidden: true}, nil)
+			},
+			SetupUserRepoMock: func(repo *mockrepo.MockUser) {},
+			SetupStaticUCMock: func(uc *mock_usecase.MockStatic) {},
+			SetupModerationRepoMock: func(repo *mockrepo.MockReviewModeration) {
+				repo.EXPECT().IsModerator(gomock.Any(), 2).Return(false, errors.New("database error"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockCommentRepo := mockrepo.NewMockReviewComment(ctrl)
+			mockReviewRepo := mockrepo.NewMockReview(ctrl)
+			mockUserRepo := mockrepo.NewMockUser(ctrl)
+			mockStaticUC := mock_usecase.NewMockStatic(ctrl)
+			mockModerationRepo := mockrepo.NewMockReviewModeration(ctrl)
+			tc.SetupCommentRepoMock(mockCommentRepo)
+			tc.SetupReviewRepoMock(mockReviewRepo)
+			tc.SetupUserRepoMock(mockUserRepo)
+			tc.SetupStaticUCMock(mockStaticUC)
+			if tc.SetupModerationRepoMock != nil {
+				tc.SetupModerationRepoMock(mockModerationRepo)
+			}
+			service := NewReviewCommentService(
+				mockCommentRepo, mockReviewRepo, mockUserRepo, mockStaticUC, nil, mockModerationRepo,
+			)
+			output, err := service.GetReviewComments(context.Background(), tc.ReviewID, tc.ViewerID, 10, 1)
+			require.Equal(t, tc.ExpectedErr, err)
+			require.Equal(t, tc.ExpectedOutput, output)
+		})
+	}
+}
+
+func TestReviewCommentService_CreateComment(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                 string
+		Create               dto.ReviewCommentCreate
+		ExpectedErr          error
+		SetupCommentRepoMock func(repo *mockrepo.MockReviewComment)
+		SetupUserRepoMock    func(repo *mockrepo.MockUser)
+		SetupProfanityUCMock func(uc *mock_usecase.MockProfanity)
+	}{
+		{
+			Name: "Успешный ответ на комментарий",
+			Create: dto.ReviewCommentCreate{
+				ReviewCommentCreateRequest: dto.ReviewCommentCreateRequest{ParentID: 1, Text: " Test Text "},
+				ReviewID:                   1,
+				UserID:                     1,
+			},
+			ExpectedErr: nil,
+			SetupCommentRepoMock: func(repo *mockrepo.MockReviewComment) {
+				repo.EXPECT().GetCommentByID(gomock.Any(), 1).Return(&entity.ReviewComment{ID: 1, ReviewID: 1}, nil)
+				repo.EXPECT().AddComment(gomock.Any(), &entity.ReviewComment{
+					ReviewID: 1,
+					AuthorID: 1,
+					ParentID: sql.NullInt64{Int64: 1, Valid: true},
+					Text:     "Test ****",
+				}).Return(&entity.ReviewComment{ID: 2, ReviewID: 1, AuthorID: 1}, nil)
+			},
+			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
+				repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(&entity.User{ID: 1, Email: "email@email.com"}, nil)
+			},
+			SetupProfanityUCMock: func(uc *mock_usecase.MockProfanity) {
+				uc.EXPECT().FilterMessage(gomock.Any(), "Test Text").Return("Test ****", nil)
+			},
+		},
+		{
+			Name: "Ответ на ответ",
+			Create: dto.ReviewCommentCreate{
+				ReviewCommentCreateRequest: dto.ReviewCommentCreateRequest{ParentID: 2, Text: "Test Text"},
+				ReviewID:                   1,
+				UserID:                     1,
+			},
+			ExpectedErr: usecase.ReviewErrorIncorrectData{Err: usecase.ErrReviewCommentTooDeep},
+			SetupCommentRepoMock: func(repo *mockrepo.MockReviewComment) {
+				repo.EXPECT().GetCommentByID(gomock.Any(), 2).Return(&entity.ReviewComment{
+					ID:       2,
+					ReviewID: 1,
+					ParentID: sql.NullInt64{Int64: 1, Valid: true},
+				}, nil)
+			},
+			SetupUserRepoMock:    func(repo *mockrepo.MockUser) {},
+			SetupProfanityUCMock: func(uc *mock_usecase.MockProfanity) {},
+		},
+		{
+			Name: "Родительский комментарий к другой рецензии",
+			Create: dto.ReviewCommentCreate{
+				ReviewCommentCreateRequest: dto.ReviewCommentCreateRequest{ParentID: 1, Text: "Test Text"},
+				ReviewID:                   1,
+				UserID:                     1,
+			},
+			ExpectedErr: usecase.ErrReviewCommentNotFound,
+			SetupCommentRepoMock: func(repo *mockrepo.MockReviewComment) {
+				repo.EXPECT().GetCommentByID(gomock.Any(), 1).Return(&entity.ReviewComment{ID: 1, ReviewID: 2}, nil)
+			},
+			SetupUserRepoMock:    func(repo *mockrepo.MockUser) {},
+			SetupProfanityUCMock: func(uc *mock_usecase.MockProfanity) {},
+		},
+		{
+			Name: "Пустой текст",
+			Create: dto.ReviewCommentCreate{
+				ReviewCommentCreateRequest: dto.ReviewCommentCreateRequest{Text: "   "},
+				ReviewID:                   1,
+				UserID:                     1,
+			},
+			ExpectedErr: usecase.ReviewErrorIncorrectData{
+				Err: errors.New("количество символов в тексте комментария должно быть от 1 до 2000"),
+			},
+			SetupCommentRepoMock: func(repo *mockrepo.MockReviewComment) {},
+			SetupUserRepoMock:    func(repo *mockrepo.MockUser) {},
+			SetupProfanityUCMock: func(uc *mock_usecase.MockProfanity) {},
+		},
+		{
+			Name: "Ошибка фильтрации",
+			Create: dto.ReviewCommentCreate{
+				ReviewCommentCreateRequest: dto.ReviewCommentCreateRequest{Text: "Test Text"},
+				ReviewID:                   1,
+				UserID:                     1,
+			},
+			ExpectedErr: entity.UsecaseWrap(
+				errors.New("ошибка при фильтрации содержания комментария"),
+				errors.New("profanity error"),
+			),
+			SetupCommentRepoMock: func(repo *mockrepo.MockReviewComment) {},
+			SetupUserRepoMock:    func(repo *mockrepo.MockUser) {},
+			SetupProfanityUCMock: func(uc *mock_usecase.MockProfanity) {
+				uc.EXPECT().FilterMessage(gomock.Any(), "Test Text").Return("", errors.New("profanity error"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockCommentRepo := mockrepo.NewMockReviewComment(ctrl)
+			mockReviewRepo := mockrepo.NewMockReview(ctrl)
+			mockUserRepo := mockrepo.NewMockUser(ctrl)
+			mockStaticUC := mock_usecase.NewMockStatic(ctrl)
+			mockProfanityUC := mock_usecase.NewMockProfanity(ctrl)
+			mockReviewRepo.EXPECT().GetReviewByID(gomock.Any(), tc.Create.ReviewID).Return(&entity.Review{ID: 1}, nil)
+			mockStaticUC.EXPECT().GetStatic(gomock.Any(), gomock.Any()).Return("", usecase.ErrStaticNotFound).AnyTimes()
+			tc.SetupCommentRepoMock(mockCommentRepo)
+			tc.SetupUserRepoMock(mockUserRepo)
+			tc.SetupProfanityUCMock(mockProfanityUC)
+			service := NewReviewCommentService(
+				mockCommentRepo, mockReviewRepo, mockUserRepo, mockStaticUC, mockProfanityUC, nil,
+			)
+			_, err := service.CreateComment(context.Background(), tc.Create)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestReviewCommentService_CreateCommentHiddenReview(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                    string
+		UserID                  int
+		ExpectedErr             error
+		SetupModerationRepoMock func(repo *mockrepo.MockReviewModeration)
+	}{
+		{
+			Name:        "Автор комментирует свою скрытую рецензию",
+			UserID:      1,
+			ExpectedErr: nil,
+		},
+		{
+			Name:        "Модератор комментирует скрытую рецензию",
+			UserID:      3,
+			ExpectedErr: nil,
+			SetupModerationRepoMock: func(repo *mockrepo.MockReviewModeration) {
+				repo.EXPECT().IsModerator(gomock.Any(), 3).Return(true, nil)
+			},
+		},
+		{
+			Name:        "Другой пользователь не может комментировать скрытую рецензию",
+			UserID:      2,
+			ExpectedErr: usecase.ErrReviewNotFound,
+			SetupModerationRepoMock: func(repo *mockrepo.MockReviewModeration) {
+				repo.EXPECT().IsModerator(gomock.Any(), 2).Return(false, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockCommentRepo := mockrepo.NewMockReviewComment(ctrl)
+			mockReviewRepo := mockrepo.NewMockReview(ctrl)
+			mockUserRepo := mockrepo.NewMockUser(ctrl)
+			mockStaticUC := mock_usecase.NewMockStatic(ctrl)
+			mockProfanityUC := mock_usecase.NewMockProfanity(ctrl)
+			mockModerationRepo := mockrepo.NewMockReviewModeration(ctrl)
+			mockReviewRepo.EXPECT().GetReviewByID(gomock.Any(), 1).
+				Return(&entity.Review{ID: 1, AuthorID: 1, Hidden: true}, nil)
+			if tc.SetupModerationRepoMock != nil {
+				tc.SetupModerationRepoMock(mockModerationRepo)
+			}
+			if tc.ExpectedErr == nil {
+				mockProfanityUC.EXPECT().FilterMessage(gomock.Any(), "Test Text").Return("Test Text", nil)
+				mockCommentRepo.EXPECT().AddComment(gomock.Any(), gomock.Any()).
+					Return(&entity.ReviewComment{ID: 1, ReviewID: 1, AuthorID: tc.UserID}, nil)
+				mockUserRepo.EXPECT().GetUserByID(gomock.Any(), tc.UserID).
+					Return(&entity.User{ID: tc.UserID, Name: "User"}, nil)
+				mockStaticUC.EXPECT().GetStatic(gomock.Any(), gomock.Any()).Return("", usecase.ErrStaticNotFound)
+			}
+			service := NewReviewCommentService(
+				mockCommentRepo, mockReviewRepo, mockUserRepo, mockStaticUC, mockProfanityUC, mockModerationRepo,
+			)
+			_, err := service.CreateComment(context.Background(), dto.ReviewCommentCreate{
+				ReviewCommentCreateRequest: dto.ReviewCommentCreateRequest{Text: "Test Text"},
+				ReviewID:                   1,
+				UserID:                     tc.UserID,
+			})
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestReviewCommentService_DeleteComment(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                 string
+		CommentID            int
+		UserID               int
+		ExpectedErr          error
+		SetupCommentRepoMock func(repo *mockrepo.MockReviewComment)
+	}{
+		{
+			Name:        "Успешное удаление",
+			CommentID:   1,
+			UserID:      1,
+			ExpectedErr: nil,
+			SetupCommentRepoMock: func(repo *mockrepo.MockReviewComment) {
+				repo.EXPECT().GetCommentByID(gomock.Any(), 1).Return(&entity.ReviewComment{ID: 1, AuthorID: 1}, nil)
+				repo.EXPECT().DeleteCommentByID(gomock.Any(), 1).Return(nil)
+			},
+		},
+		{
+			Name:        "Чужой комментарий",
+			CommentID:   1,
+			UserID:      2,
+			ExpectedErr: usecase.ErrReviewCommentForbidden,
+			SetupCommentRepoMock: func(repo *mockrepo.MockReviewComment) {
+				repo.EXPECT().GetCommentByID(gomock.Any(), 1).Return(&entity.ReviewComment{ID: 1, AuthorID: 1}, nil)
+			},
+		},
+		{
+			Name:        "Комментарий не найден",
+			CommentID:   1,
+			UserID:      1,
+			ExpectedErr: usecase.ErrReviewCommentNotFound,
+			SetupCommentRepoMock: func(repo *mockrepo.MockReviewComment) {
+				repo.EXPECT().GetCommentByID(gomock.Any(), 1).Return(nil, repository.ErrReviewCommentNotFound)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockCommentRepo := mockrepo.NewMockReviewComment(ctrl)
+			tc.SetupCommentRepoMock(mockCommentRepo)
+			service := NewReviewCommentService(mockCommentRepo, nil, nil, nil, nil, nil)
+			err := service.DeleteComment(context.Background(), tc.CommentID, tc.UserID)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/logger"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	"strings"
+)
+
+type ReviewModerationService struct {
+	moderationRepo repository.ReviewModeration
+	reviewRepo     repository.Review
+	contentRepo    repository.Content
+	statsRepo      repository.UserStats
+	reviewUC       usecase.Review
+}
+
+func NewReviewModerationService(
+	moderationRepo repository.ReviewModeration,
+	reviewRepo repository.Review,
+	contentRepo repository.Content,
+	statsRepo repository.UserStats,
+	reviewUC usecase.Review,
+) usecase.ReviewModeration {
+	return &ReviewModerationService{
+		moderationRepo: moderationRepo,
+		reviewRepo:     reviewRepo,
+		contentRepo:    contentRepo,
+		statsRepo:      statsRepo,
+		reviewUC:       reviewUC,
+	}
+}
+
+// checkModerator возвращает ErrModerationForbidden, если пользователь не модератор
+func (r *ReviewModerationService) checkModerator(ctx context.Context, userID int) error {
+	isModerator, err := r.moderationRepo.IsModerator(ctx, userID)
+	if err != nil {
+		return entity.UsecaseWrap(errors.New("ошибка при проверке прав модератора"), err)
+	}
+	if !isModerator {
+		return usecase.ErrModerationForbidden
+	}
+	return nil
+}
+
+func (r *ReviewModerationService) ReportReview(ctx context.Context, report dto.ReviewReportCreate) error {
+	ctx, span := tracing.Start(ctx, "ReviewModerationService.ReportReview")
+	defer span.End()
+	comment := strings.TrimSpace(report.Comment)
+	if err := entity.ValidateReviewReport(report.Reason, comment); err != nil {
+		return usecase.ReviewErrorIncorrectData{Err: err}
+	}
+	review, err := r.reviewRepo.GetReviewByID(ctx, report.ReviewID)
+	switch {
+	case errors.Is(err, repository.ErrReviewNotFound):
+		return usecase.ErrReviewNotFound
+	case err != nil:
+		return entity.UsecaseWrap(errors.New("ошибка при получении рецензии"), err)
+	}
+	if review.AuthorID == report.UserID {
+		return usecase.ReviewErrorIncorrectData{Err: errors.New("нельзя пожаловаться на свою рецензию")}
+	}
+	err = r.moderationRepo.AddReport(ctx, &entity.ReviewReport{
+		ReviewID: report.ReviewID,
+		UserID:   report.UserID,
+		Reason:   report.Reason,
+		Comment:  comment,
+	})
+	switch {
+	case errors.Is(err, repository.ErrReviewNotFound):
+		return usecase.ErrReviewNotFound
+	case errors.Is(err, repository.ErrReviewReportAlreadyExists):
+		return usecase.ErrReviewReportAlreadyExists
+	case errors.Is(err, repository.ErrReviewReportBadRequest):
+		return usecase.ReviewErrorIncorrectData{Err: err}
+	case err != nil:
+		return entity.UsecaseWrap(errors.New("ошибка при добавлении жалобы"), err)
+	}
+	return nil
+}
+
+func (r *ReviewModerationService) GetModerationQueue(
+	ctx context.Context,
+	moderatorID, count, page int,
+) (*dto.ModerationQueue, error) {
+	ctx, span := tracing.Start(ctx, "ReviewModerationService.GetModerationQueue")
+	defer span.End()
+	if err := r.checkModerator(ctx, moderatorID); err != nil {
+		return nil, err
+	}
+	reported, err := r.moderationRepo.GetReportedReviews(ctx, page, count)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении очереди модерации"), err)
+	}
+	queue := &dto.ModerationQueue{Reviews: make([]dto.ModerationQueueItem, len(reported))}
+	for i, item := range reported {
+		review, err := r.reviewUC.GetReview(ctx, item.ReviewID, moderatorID)
+		if err != nil {
+			return nil, err
+		}
+		queue.Reviews[i] = dto.ModerationQueueItem{
+			Review:         *review,
+			Hidden:         item.Hidden,
+			Reports:        item.Reports,
+			Reasons:        item.Reasons,
+			LastReportedAt: item.LastReportedAt.String(),
+		}
+		// автор мог отредактировать рецензию после жалоб, поэтому модератору показывается и первоначальная версия
+		revisions, err := r.reviewRepo.GetReviewRevisions(ctx, item.ReviewID)
+		if err != nil {
+			return nil, entity.UsecaseWrap(errors.New("ошибка при получении истории правок рецензии"), err)
+		}
+		if len(revisions) > 0 {
+			original := reviewRevisionToDTO(revisions[0])
+			queue.Reviews[i].Original = &original
+		}
+	}
+	queue.Total, err = r.moderationRepo.GetReportedReviewsCount(ctx)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении размера очереди модерации"), err)
+	}
+	queue.Page = page
+	queue.Count = len(reported)
+	queue.Pages = (queue.Total + count - 1) / count
+	return queue, nil
+}
+
+func (r *ReviewModerationService) ModerateReview(ctx context.Context, decision dto.ModerationDecisionCreate) error {
+	ctx, span := tracing.Start(ctx, "ReviewModerationService.ModerateReview")
+	defer span.End()
+	if err := r.checkModerator(ctx, decision.ModeratorID); err != nil {
+		return err
+	}
+	note := strings.TrimSpace(decision.Note)
+	if err := entity.ValidateModerationDecision(decision.Action, note); err != nil {
+		return usecase.ReviewErrorIncorrectData{Err: err}
+	}
+	review, err := r.reviewRepo.GetReviewByID(ctx, decision.ReviewID)
+	switch {
+	case errors.Is(err, repository.ErrReviewNotFound):
+		return usecase.ErrReviewNotFound
+	case err != nil:
+		return entity.UsecaseWrap(errors.New("ошибка при получении рецензии"), err)
+	}
+	err = r.moderationRepo.ResolveReview(ctx, &entity.ModerationDecision{
+		ReviewID:    decision.ReviewID,
+		ModeratorID: decision.ModeratorID,
+		Action:      decision.Action,
+		Note:        note,
+	})
+	switch {
+	case errors.Is(err, repository.ErrReviewNotFound):
+		return usecase.ErrReviewNotFound
+	case err != nil:
+		return entity.UsecaseWrap(errors.New("ошибка при применении решения модератора"), err)
+	}
+	logger.ForPackage("service").InfoContext(ctx, "решение модератора по рецензии",
+		"review_id", decision.ReviewID,
+		"moderator_id", decision.ModeratorID,
+		"action", decision.Action,
+	)
+	// скрытие и удаление рецензии меняют рейтинг контента
+	invalidateContent(ctx, r.contentRepo, review.ContentID)
+	// статистика автора не учитывает скрытые рецензии ни в оценках, ни в активности по месяцам
+	invalidateUserStats(ctx, r.statsRepo, review.AuthorID,
+		entity.UserStatsSectionRatings, entity.UserStatsSectionActivity,
+	)
+	return nil
+}
+
+func (r *ReviewModerationService) GetModerationLog(
+	ctx context.Context,
+	moderatorID, count, page int,
+) (*dto.ModerationLog, error) {
+	ctx, span := tracing.Start(ctx, "ReviewModerationService.GetModerationLog")
+	defer span.End()
+	if err := r.checkModerator(ctx, moderatorID); err != nil {
+		return nil, err
+	}
+	decisions, err := r.moderationRepo.GetModerationLog(ctx, page, count)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении журнала модерации"), err)
+	}
+	log := &dto.ModerationLog{Entries: make([]dto.ModerationLogEntry, len(decisions))}
+	for i, decision := range decisions {
+		log.Entries[i] = dto.ModerationLogEntry{
+			ID:          decision.ID,
+			ReviewID:    decision.ReviewID,
+			ModeratorID: decision.ModeratorID,
+			Action:      decision.Action,
+			Note:        decision.Note,
+			CreatedAt:   decision.CreatedAt.String(),
+		}
+	}
+	log.Total, err = r.moderationRepo.GetModerationLogCount(ctx)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении размера журнала модерации"), err)
+	}
+	log.Page = page
+	log.Count = len(decisions)
+	log.Pages = (log.Total + count - 1) / count
+	return log, nil
+}
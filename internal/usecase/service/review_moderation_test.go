@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	mockrepo "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository/mocks"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestReviewModerationService_ReportReview(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                    string
+		Report                  dto.ReviewReportCreate
+		ExpectedErr             error
+		SetupModerationRepoMock func(repo *mockrepo.MockReviewModeration)
+		SetupReviewRepoMock     func(repo *mockrepo.MockReview)
+	}{
+		{
+			Name: "Успешная жалоба",
+			Report: dto.ReviewReportCreate{
+				ReviewReportRequest: dto.ReviewReportRequest{Reason: entity.ReviewReportSpam, Comment: " реклама "},
+				ReviewID:            1,
+				UserID:              2,
+			},
+			ExpectedErr: nil,
+			SetupModerationRepoMock: func(repo *mockrepo.MockReviewModeration) {
+				repo.EXPECT().AddReport(gomock.Any(), &entity.ReviewReport{
+					ReviewID: 1,
+					UserID:   2,
+					Reason:   entity.ReviewReportSpam,
+					Comment:  "реклама",
+				}).Return(nil)
+			},
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetReviewByID(gomock.Any(), 1).Return(&entity.Review{ID: 1, AuthorID: 1}, nil)
+			},
+		},
+		{
+			Name: "Неизвестная причина",
+			Report: dto.ReviewReportCreate{
+				ReviewReportRequest: dto.ReviewReportRequest{Reason: "boring"},
+				ReviewID:            1,
+				UserID:              2,
+			},
+			ExpectedErr: usecase.ReviewErrorIncorrectData{
+				Err: errors.New("причина жалобы должна быть одной из: spam, insult, spoiler, offtopic, other"),
+			},
+			SetupModerationRepoMock: func(repo *mockrepo.MockReviewModeration) {},
+			SetupReviewRepoMock:     func(repo *mockrepo.MockReview) {},
+		},
+		{
+			Name: "Жалоба на свою рецензию",
+			Report: dto.ReviewReportCreate{
+				ReviewReportRequest: dto.ReviewReportRequest{Reason: entity.ReviewReportOther},
+				ReviewID:            1,
+				UserID:              1,
+			},
+			ExpectedErr:             usecase.ReviewErrorIncorrectData{Err: errors.New("нельзя пожаловаться на свою рецензию")},
+			SetupModerationRepoMock: func(repo *mockrepo.MockReviewModeration) {},
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetReviewByID(gomock.Any(), 1).Return(&entity.Review{ID: 1, AuthorID: 1}, nil)
+			},
+		},
+		{
+			Name: "Повторная жалоба",
+			Report: dto.ReviewReportCreate{
+				ReviewReportRequest: dto.ReviewReportRequest{Reason: entity.ReviewReportInsult},
+				ReviewID:            1,
+				UserID:              2,
+			},
+			ExpectedErr: usecase.ErrReviewReportAlreadyExists,
+			SetupModerationRepoMock: func(repo *mockrepo.MockReviewModeration) {
+				repo.EXPECT().AddReport(gomock.Any(), gomock.Any()).Return(repository.ErrReviewReportAlreadyExists)
+			},
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetReviewByID(gomock.Any(), 1).Return(&entity.Review{ID: 1, AuthorID: 1}, nil)
+			},
+		},
+		{
+			Name: "Рецензия не найдена",
+			Report: dto.ReviewReportCreate{
+				ReviewReportRequest: dto.ReviewReportRequest{Reason: entity.ReviewReportInsult},
+				ReviewID:            1,
+				UserID:              2,
+			},
+			ExpectedErr:             usecase.ErrReviewNotFound,
+			SetupModerationRepoMock: func(repo *mockrepo.MockReviewModeration) {},
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetReviewByID(gomock.Any(), 1).Return(nil, repository.ErrReviewNotFound)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockModerationRepo := mockrepo.NewMockReviewModeration(ctrl)
+			mockReviewRepo := mockrepo.NewMockReview(ctrl)
+			tc.SetupModerationRepoMock(mockModerationRepo)
+			tc.SetupReviewRepoMock(mockReviewRepo)
+			service := NewReviewModerationService(mockModerationRepo, mockReviewRepo, nil, nil, nil)
+			err := service.ReportReview(context.Background(), tc.Report)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestReviewModerationService_ModerateReview(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                    string
+		Decision                dto.ModerationDecisionCreate
+		ExpectedErr             error
+		SetupModerationRepoMock func(repo *mockrepo.MockReviewModeration)
+		SetupReviewRepoMock     func(repo *mockrepo.MockReview)
+		SetupContentRepoMock    func(repo *mockrepo.MockContent)
+		SetupStatsRepoMock      func(repo *mockrepo.MockUserStats)
+	}{
+		{
+			Name: "Рецензия скрыта",
+			Decision: dto.ModerationDecisionCreate{
+				ModerationDecisionRequest: dto.ModerationDecisionRequest{Action: entity.ModerationHide, Note: "оскорбления"},
+				ReviewID:                  1,
+				ModeratorID:               10,
+			},
+			ExpectedErr: nil,
+			SetupModerationRepoMock: func(repo *mockrepo.MockReviewModeration) {
+				repo.EXPECT().IsModerator(gomock.Any(), 10).Return(true, nil)
+				repo.EXPECT().ResolveReview(gomock.Any(), &entity.ModerationDecision{
+					ReviewID:    1,
+					ModeratorID: 10,
+					Action:      entity.ModerationHide,
+					Note:        "оскорбления",
+				}).Return(nil)
+			},
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetReviewByID(gomock.Any(), 1).Return(&entity.Review{ID: 1, AuthorID: 3, ContentID: 5}, nil)
+			},
+			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
+				repo.EXPECT().InvalidateContent(gomock.Any(), 5).Return(nil)
+			},
+			SetupStatsRepoMock: func(repo *mockrepo.MockUserStats) {
+				repo.EXPECT().InvalidateUserStats(gomock.Any(), 3,
+					entity.UserStatsSectionRatings, entity.UserStatsSectionActivity,
+				).Return(nil)
+			},
+		},
+		{
+			Name: "Не модератор",
+			Decision: dto.ModerationDecisionCreate{
+				ModerationDecisionRequest: dto.ModerationDecisionRequest{Action: entity.ModerationDelete},
+				ReviewID:                  1,
+				ModeratorID:               2,
+			},
+			ExpectedErr: usecase.ErrModerationForbidden,
+			SetupModerationRepoMock: func(repo *mockrepo.MockReviewModeration) {
+				repo.EXPECT().IsModerator(gomock.Any(), 2).Return(false, nil)
+			},
+			SetupReviewRepoMock:  func(repo *mockrepo.MockReview) {},
+			SetupContentRepoMock: func(repo *mockrepo.MockContent) {},
+			SetupStatsRepoMock:   func(repo *mockrepo.MockUserStats) {},
+		},
+		{
+			Name: "Неизвестное решение",
+			Decision: dto.ModerationDecisionCreate{
+				ModerationDecisionRequest: dto.ModerationDecisionRequest{Action: "ban"},
+				ReviewID:                  1,
+				ModeratorID:               10,
+			},
+			ExpectedErr: usecase.ReviewErrorIncorrectData{
+				Err: errors.New("решение модератора должно быть одним из: approve, hide, delete"),
+			},
+			SetupModerationRepoMock: func(repo *mockrepo.MockReviewModeration) {
+				repo.EXPECT().IsModerator(gomock.Any(), 10).Return(true, nil)
+			},
+			SetupReviewRepoMock:  func(repo *mockrepo.MockReview) {},
+			SetupContentRepoMock: func(repo *mockrepo.MockContent) {},
+			SetupStatsRepoMock:   func(repo *mockrepo.MockUserStats) {},
+		},
+		{
+			Name: "Ошибка при применении решения",
+			Decision: dto.ModerationDecisionCreate{
+				ModerationDecisionRequest: dto.ModerationDecisionRequest{Action: entity.ModerationApprove},
+				ReviewID:                  1,
+				ModeratorID:               10,
+			},
+			ExpectedErr: entity.UsecaseWrap(
+				errors.New("ошибка при применении решения модератора"),
+				errors.New("database error"),
+			),
+			SetupModerationRepoMock: func(repo *mockrepo.MockReviewModeration) {
+				repo.EXPECT().IsModerator(gomock.Any(), 10).Return(true, nil)
+				repo.EXPECT().ResolveReview(gomock.Any(), gomock.Any()).Return(errors.New("database error"))
+			},
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetReviewByID(gomock.Any(), 1).Return(&entity.Review{ID: 1, ContentID: 5}, nil)
+			},
+			SetupContentRepoMock: func(repo *mockrepo.MockContent) {},
+			SetupStatsRepoMock:   func(repo *mockrepo.MockUserStats) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockModerationRepo := mockrepo.NewMockReviewModeration(ctrl)
+			mockReviewRepo := mockrepo.NewMockReview(ctrl)
+			mockContentRepo := mockrepo.NewMockContent(ctrl)
+			mockStatsRepo := mockrepo.NewMockUserStats(ctrl)
+			tc.SetupModerationRepoMock(mockModerationRepo)
+			tc.SetupReviewRepoMock(mockReviewRepo)
+			tc.SetupContentRepoMock(mockContentRepo)
+			tc.SetupStatsRepoMock(mockStatsRepo)
+			service := NewReviewModerationService(
+				mockModerationRepo, mockReviewRepo, mockContentRepo, mockStatsRepo, nil,
+			)
+			err := service.ModerateReview(context.Background(), tc.Decision)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
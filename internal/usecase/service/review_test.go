@@ -1,8 +1,11 @@
 package service
 
 import (
+	"context"
+	"database/sql"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
@@ -31,7 +34,7 @@ func TestGetLatestReviews(t *testing.T) {
 			Limit:       10,
 			ExpectedErr: nil,
 			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
-				repo.EXPECT().AddReview(&entity.Review{
+				repo.EXPECT().AddReview(gomock.Any(), &entity.Review{
 					AuthorID:      1,
 					ContentID:     1,
 					ContentRating: 5,
@@ -47,7 +50,7 @@ func TestGetLatestReviews(t *testing.T) {
 				}, nil).AnyTimes()
 
 				// Add this line
-				repo.EXPECT().GetLatestReviews(gomock.Any()).Return(nil, nil).AnyTimes()
+				repo.EXPECT().GetLatestReviews(gomock.Any(), gomock.Any(), entity.ReviewFilter{}).Return(nil, nil).AnyTimes()
 			},
 		},
 	}
@@ -62,9 +65,9 @@ func TestGetLatestReviews(t *testing.T) {
 			mockContentRepo := mockrepo.NewMockContent(ctrl)
 			mockUserRepo := mockrepo.NewMockUser(ctrl)
 			mockStaticRepo := mock_usecase.NewMockStatic(ctrl)
-			service := NewReviewService(mockReviewRepo, mockUserRepo, mockContentRepo, mockStaticRepo, nil)
+			service := NewReviewService(mockReviewRepo, mockUserRepo, mockContentRepo, mockStaticRepo, nil, nil, nil, nil)
 			tc.SetupReviewRepoMock(mockReviewRepo)
-			_, err := service.GetLatestReviews(tc.Limit)
+			_, err := service.GetLatestReviews(context.Background(), tc.Limit, dto.ReviewFilter{})
 			require.Equal(t, tc.ExpectedErr, err)
 		})
 	}
@@ -88,8 +91,8 @@ func TestReviewService_GetUserReviews(t *testing.T) {
 			Page:        1,
 			ExpectedErr: nil,
 			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
-				repo.EXPECT().GetReviewsByAuthorID(1, 1, 5).Return([]*entity.Review{}, nil).AnyTimes()
-				repo.EXPECT().GetReviewsCountByAuthorID(1).Return(10, nil).AnyTimes()
+				repo.EXPECT().GetReviewsByAuthorID(gomock.Any(), 1, 1, 5, entity.ReviewFilter{}).Return([]*entity.Review{}, nil).AnyTimes()
+				repo.EXPECT().GetReviewsCountByAuthorID(gomock.Any(), 1, entity.ReviewFilter{}).Return(10, nil).AnyTimes()
 			},
 		},
 		{
@@ -99,7 +102,7 @@ func TestReviewService_GetUserReviews(t *testing.T) {
 			Page:        1,
 			ExpectedErr: entity.UsecaseWrap(errors.New("ошибка при получении отзывов пользователя"), errors.New("database error")),
 			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
-				repo.EXPECT().GetReviewsByAuthorID(1, 1, 5).Return(nil, errors.New("database error"))
+				repo.EXPECT().GetReviewsByAuthorID(gomock.Any(), 1, 1, 5, entity.ReviewFilter{}).Return(nil, errors.New("database error"))
 			},
 		},
 		{
@@ -109,8 +112,8 @@ func TestReviewService_GetUserReviews(t *testing.T) {
 			Page:        1,
 			ExpectedErr: entity.UsecaseWrap(errors.New("ошибка при получении количества отзывов пользователя"), errors.New("database error")),
 			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
-				repo.EXPECT().GetReviewsByAuthorID(1, 1, 5).Return([]*entity.Review{}, nil).AnyTimes()
-				repo.EXPECT().GetReviewsCountByAuthorID(1).Return(0, errors.New("database error"))
+				repo.EXPECT().GetReviewsByAuthorID(gomock.Any(), 1, 1, 5, entity.ReviewFilter{}).Return([]*entity.Review{}, nil).AnyTimes()
+				repo.EXPECT().GetReviewsCountByAuthorID(gomock.Any(), 1, entity.ReviewFilter{}).Return(0, errors.New("database error"))
 			},
 		},
 	}
@@ -125,9 +128,9 @@ func TestReviewService_GetUserReviews(t *testing.T) {
 			mockContentRepo := mockrepo.NewMockContent(ctrl)
 			mockUserRepo := mockrepo.NewMockUser(ctrl)
 			mockStaticRepo := mock_usecase.NewMockStatic(ctrl)
-			service := NewReviewService(mockReviewRepo, mockUserRepo, mockContentRepo, mockStaticRepo, nil)
+			service := NewReviewService(mockReviewRepo, mockUserRepo, mockContentRepo, mockStaticRepo, nil, nil, nil, nil)
 			tc.SetupReviewRepoMock(mockReviewRepo)
-			_, err := service.GetUserReviews(tc.UserID, tc.Count, tc.Page)
+			_, err := service.GetUserReviews(context.Background(), tc.UserID, tc.Count, tc.Page, dto.ReviewFilter{})
 			require.Equal(t, tc.ExpectedErr, err)
 		})
 	}
@@ -141,6 +144,7 @@ func TestReviewService_GetContentReviews(t *testing.T) {
 		ContentID           int
 		Count               int
 		Page                int
+		Filter              dto.ReviewFilter
 		ExpectedErr         error
 		SetupReviewRepoMock func(repo *mockrepo.MockReview)
 	}{
@@ -151,10 +155,42 @@ func TestReviewService_GetContentReviews(t *testing.T) {
 			Page:        1,
 			ExpectedErr: nil,
 			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
-				repo.EXPECT().GetReviewsByContentID(1, 1, 5).Return([]*entity.Review{}, nil).AnyTimes()
-				repo.EXPECT().GetReviewsCountByContentID(1).Return(10, nil).AnyTimes()
+				repo.EXPECT().GetReviewsByContentID(gomock.Any(), 1, 1, 5, entity.ReviewFilter{}).Return([]*entity.Review{}, nil).AnyTimes()
+				repo.EXPECT().GetReviewsCountByContentID(gomock.Any(), 1, entity.ReviewFilter{}).Return(10, nil).AnyTimes()
 			},
 		},
+		{
+			Name:      "Sorted and filtered",
+			ContentID: 1,
+			Count:     5,
+			Page:      1,
+			Filter: dto.ReviewFilter{
+				Sort:          entity.ReviewSortControversial,
+				Sentiment:     entity.ReviewSentimentNegative,
+				MinTextLength: 500,
+			},
+			ExpectedErr: nil,
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				filter := entity.ReviewFilter{
+					Sort:          entity.ReviewSortControversial,
+					Sentiment:     entity.ReviewSentimentNegative,
+					MinTextLength: 500,
+				}
+				repo.EXPECT().GetReviewsByContentID(gomock.Any(), 1, 1, 5, filter).Return([]*entity.Review{}, nil)
+				repo.EXPECT().GetReviewsCountByContentID(gomock.Any(), 1, filter).Return(0, nil)
+			},
+		},
+		{
+			Name:      "Unknown sort",
+			ContentID: 1,
+			Count:     5,
+			Page:      1,
+			Filter:    dto.ReviewFilter{Sort: "random"},
+			ExpectedErr: usecase.ReviewErrorIncorrectData{
+				Err: errors.New("сортировка должна быть одной из: newest, oldest, helpful, controversial, highest, lowest"),
+			},
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {},
+		},
 		{
 			Name:        "Error when getting reviews",
 			ContentID:   1,
@@ -162,7 +198,7 @@ func TestReviewService_GetContentReviews(t *testing.T) {
 			Page:        1,
 			ExpectedErr: entity.UsecaseWrap(errors.New("ошибка при получении отзывов контента"), errors.New("database error")),
 			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
-				repo.EXPECT().GetReviewsByContentID(1, 1, 5).Return(nil, errors.New("database error"))
+				repo.EXPECT().GetReviewsByContentID(gomock.Any(), 1, 1, 5, entity.ReviewFilter{}).Return(nil, errors.New("database error"))
 			},
 		},
 		{
@@ -172,8 +208,8 @@ func TestReviewService_GetContentReviews(t *testing.T) {
 			Page:        1,
 			ExpectedErr: entity.UsecaseWrap(errors.New("ошибка при получении количества отзывов"), errors.New("database error")),
 			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
-				repo.EXPECT().GetReviewsByContentID(1, 1, 5).Return([]*entity.Review{}, nil).AnyTimes()
-				repo.EXPECT().GetReviewsCountByContentID(1).Return(0, errors.New("database error"))
+				repo.EXPECT().GetReviewsByContentID(gomock.Any(), 1, 1, 5, entity.ReviewFilter{}).Return([]*entity.Review{}, nil).AnyTimes()
+				repo.EXPECT().GetReviewsCountByContentID(gomock.Any(), 1, entity.ReviewFilter{}).Return(0, errors.New("database error"))
 			},
 		},
 	}
@@ -188,9 +224,9 @@ func TestReviewService_GetContentReviews(t *testing.T) {
 			mockContentRepo := mockrepo.NewMockContent(ctrl)
 			mockUserRepo := mockrepo.NewMockUser(ctrl)
 			mockStaticRepo := mock_usecase.NewMockStatic(ctrl)
-			service := NewReviewService(mockReviewRepo, mockUserRepo, mockContentRepo, mockStaticRepo, nil)
+			service := NewReviewService(mockReviewRepo, mockUserRepo, mockContentRepo, mockStaticRepo, nil, nil, nil, nil)
 			tc.SetupReviewRepoMock(mockReviewRepo)
-			_, err := service.GetContentReviews(tc.ContentID, tc.Count, tc.Page)
+			_, err := service.GetContentReviews(context.Background(), tc.ContentID, tc.Count, tc.Page, tc.Filter)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
 	}
@@ -199,19 +235,95 @@ func TestReviewService_GetContentReviews(t *testing.T) {
 func TestReviewService_GetReview(t *testing.T) {
 	t.Parallel()
 
+	hiddenReview := &entity.Review{ID: 1, AuthorID: 1, ContentID: 1, Hidden: true}
+	setupDTOMocks := func(userRepo *mockrepo.MockUser, contentRepo *mockrepo.MockContent, staticUC *mock_usecase.MockStatic) {
+		userRepo.EXPECT().GetUserByID(gomock.Any(), 1).Return(&entity.User{Name: "Author"}, nil)
+		staticUC.EXPECT().GetStatic(gomock.Any(), gomock.Any()).Return("", usecase.ErrStaticNotFound)
+		contentRepo.EXPECT().GetContent(gomock.Any(), 1).Return(&entity.Content{Title: "Content"}, nil)
+	}
+
 	testCases := []struct {
-		Name                string
-		ReviewID            int
-		ExpectedErr         error
-		SetupReviewRepoMock func(repo *mockrepo.MockReview)
+		Name                    string
+		ReviewID                int
+		ViewerID                int
+		ExpectedErr             error
+		SetupReviewRepoMock     func(repo *mockrepo.MockReview)
+		SetupModerationRepoMock func(repo *mockrepo.MockReviewModeration)
+		SetupDTOMocks           func(*mockrepo.MockUser, *mockrepo.MockContent, *mock_usecase.MockStatic)
 	}{
 		{
 			Name:        "Review not found",
 			ReviewID:    1,
 			ExpectedErr: repository.ErrReviewNotFound,
 			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
-				repo.EXPECT().GetReviewByID(1).Return(nil, repository.ErrReviewNotFound)
+				repo.EXPECT().GetReviewByID(gomock.Any(), 1).Return(nil, repository.ErrReviewNotFound)
+			},
+			SetupModerationRepoMock: func(repo *mockrepo.MockReviewModeration) {},
+			SetupDTOMocks:           func(*mockrepo.MockUser, *mockrepo.MockContent, *mock_usecase.MockStatic) {},
+		},
+		{
+			Name:        "Скрытая рецензия не видна неавторизованному пользователю",
+			ReviewID:    1,
+			ViewerID:    -1,
+			ExpectedErr: usecase.ErrReviewNotFound,
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetReviewByID(gomock.Any(), 1).Return(hiddenReview, nil)
+			},
+			SetupModerationRepoMock: func(repo *mockrepo.MockReviewModeration) {},
+			SetupDTOMocks:           func(*mockrepo.MockUser, *mockrepo.MockContent, *mock_usecase.MockStatic) {},
+		},
+		{
+			Name:        "Скрытая рецензия не видна другому пользователю",
+			ReviewID:    1,
+			ViewerID:    2,
+			ExpectedErr: usecase.ErrReviewNotFound,
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetReviewByID(gomock.Any(), 1).Return(hiddenReview, nil)
+			},
+			SetupModerationRepoMock: func(repo *mockrepo.MockReviewModeration) {
+				repo.EXPECT().IsModerator(gomock.Any(), 2).Return(false, nil)
+			},
+			SetupDTOMocks: func(*mockrepo.MockUser, *mockrepo.MockContent, *mock_usecase.MockStatic) {},
+		},
+		{
+			Name:        "Скрытая рецензия видна автору",
+			ReviewID:    1,
+			ViewerID:    1,
+			ExpectedErr: nil,
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetReviewByID(gomock.Any(), 1).Return(hiddenReview, nil)
 			},
+			SetupModerationRepoMock: func(repo *mockrepo.MockReviewModeration) {},
+			SetupDTOMocks:           setupDTOMocks,
+		},
+		{
+			Name:        "Скрытая рецензия видна модератору",
+			ReviewID:    1,
+			ViewerID:    2,
+			ExpectedErr: nil,
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetReviewByID(gomock.Any(), 1).Return(hiddenReview, nil)
+			},
+			SetupModerationRepoMock: func(repo *mockrepo.MockReviewModeration) {
+				repo.EXPECT().IsModerator(gomock.Any(), 2).Return(true, nil)
+			},
+			SetupDTOMocks: setupDTOMocks,
+		},
+		{
+			Name:     "Ошибка при проверке прав модератора",
+			ReviewID: 1,
+			ViewerID: 2,
+			ExpectedErr: entity.UsecaseWrap(
+				errors.New("ошибка при проверке прав модератора"),
+				errors.New("database error"),
+			),
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetReviewByID(gomock.Any(), 1).Return(hiddenReview, nil)
+			},
+			SetupModerationRepoMock: func(repo *mockrepo.MockReviewModeration) {
+				repo.EXPECT().IsModerator(gomock.Any(), 2).Return(false, errors.New("database error"))
+			},
+			SetupDTOMocks: func(*mockrepo.MockUser, *mockrepo.MockContent, *mock_usecase.MockStatic) {},
 		},
 	}
 
@@ -225,9 +337,233 @@ func TestReviewService_GetReview(t *testing.T) {
 			mockContentRepo := mockrepo.NewMockContent(ctrl)
 			mockUserRepo := mockrepo.NewMockUser(ctrl)
 			mockStaticRepo := mock_usecase.NewMockStatic(ctrl)
-			service := NewReviewService(mockReviewRepo, mockUserRepo, mockContentRepo, mockStaticRepo, nil)
+			mockModerationRepo := mockrepo.NewMockReviewModeration(ctrl)
+			service := NewReviewService(
+				mockReviewRepo, mockUserRepo, mockContentRepo, mockStaticRepo, nil, nil, nil, mockModerationRepo,
+			)
+			tc.SetupReviewRepoMock(mockReviewRepo)
+			tc.SetupModerationRepoMock(mockModerationRepo)
+			tc.SetupDTOMocks(mockUserRepo, mockContentRepo, mockStaticRepo)
+			_, err := service.GetReview(context.Background(), tc.ReviewID, tc.ViewerID)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestReviewService_GetReviewHistory(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		Name                 string
+		ReviewID             int
+		ExpectedOutput       *dto.ReviewHistory
+		ExpectedErr          error
+		SetupReviewRepoMock  func(repo *mockrepo.MockReview)
+		SetupUserRepoMock    func(repo *mockrepo.MockUser)
+		SetupContentRepoMock func(repo *mockrepo.MockContent)
+		SetupStaticUCMock    func(uc *mock_usecase.MockStatic)
+	}{
+		{
+			Name:     "Отредактированная рецензия",
+			ReviewID: 1,
+			ExpectedOutput: &dto.ReviewHistory{
+				Review: dto.ReviewResponse{
+					Review: dto.Review{
+						ID:        1,
+						AuthorID:  1,
+						ContentID: 1,
+						Rating:    8,
+						Title:     "Title",
+						Text:      "Финал: все выжили",
+						CreatedAt: fixedTime.String(),
+						EditCount: 1,
+						EditedAt:  fixedTime.Add(time.Hour).String(),
+						Spoilers:  []dto.SpoilerRange{{Start: 7, End: 17}},
+					},
+					AuthorName:  "Author",
+					ContentName: "Content",
+				},
+				Revisions: []dto.ReviewRevision{
+					{
+						Rating:     3,
+						Title:      "Title",
+						Text:       "Скучно",
+						Spoilers:   []dto.SpoilerRange{},
+						CreatedAt:  fixedTime.String(),
+						ReplacedAt: fixedTime.Add(time.Hour).String(),
+					},
+				},
+			},
+			ExpectedErr: nil,
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetReviewByID(gomock.Any(), 1).Return(&entity.Review{
+					ID:            1,
+					AuthorID:      1,
+					ContentID:     1,
+					ContentRating: 8,
+					Title:         "Title",
+					Text:          "Финал: [spoiler]все выжили[/spoiler]",
+					CreatedAt:     fixedTime,
+					EditCount:     1,
+					EditedAt:      sql.NullTime{Time: fixedTime.Add(time.Hour), Valid: true},
+				}, nil)
+				repo.EXPECT().GetReviewRevisions(gomock.Any(), 1).Return([]*entity.ReviewRevision{
+					{
+						ID:            1,
+						ReviewID:      1,
+						Title:         "Title",
+						Text:          "Скучно",
+						ContentRating: 3,
+						CreatedAt:     fixedTime,
+						ReplacedAt:    fixedTime.Add(time.Hour),
+					},
+				}, nil)
+			},
+			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
+				repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(&entity.User{Name: "Author"}, nil)
+			},
+			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
+				repo.EXPECT().GetContent(gomock.Any(), 1).Return(&entity.Content{Title: "Content"}, nil)
+			},
+			SetupStaticUCMock: func(uc *mock_usecase.MockStatic) {
+				uc.EXPECT().GetStatic(gomock.Any(), gomock.Any()).Return("", usecase.ErrStaticNotFound)
+			},
+		},
+		{
+			Name:                 "Рецензия не найдена",
+			ReviewID:             1,
+			ExpectedOutput:       nil,
+			ExpectedErr:          usecase.ErrReviewNotFound,
+			SetupUserRepoMock:    func(repo *mockrepo.MockUser) {},
+			SetupContentRepoMock: func(repo *mockrepo.MockContent) {},
+			SetupStaticUCMock:    func(uc *mock_usecase.MockStatic) {},
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetReviewByID(gomock.Any(), 1).Return(nil, repository.ErrReviewNotFound)
+			},
+		},
+		{
+			Name:           "Ошибка при получении истории",
+			ReviewID:       1,
+			ExpectedOutput: nil,
+			ExpectedErr: entity.UsecaseWrap(
+				errors.New("ошибка при получении истории правок рецензии"),
+				errors.New("database error"),
+			),
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetReviewByID(gomock.Any(), 1).Return(&entity.Review{ID: 1, AuthorID: 1, ContentID: 1}, nil)
+				repo.EXPECT().GetReviewRevisions(gomock.Any(), 1).Return(nil, errors.New("database error"))
+			},
+			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
+				repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(&entity.User{}, nil)
+			},
+			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
+				repo.EXPECT().GetContent(gomock.Any(), 1).Return(&entity.Content{}, nil)
+			},
+			SetupStaticUCMock: func(uc *mock_usecase.MockStatic) {
+				uc.EXPECT().GetStatic(gomock.Any(), gomock.Any()).Return("", nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockReviewRepo := mockrepo.NewMockReview(ctrl)
+			mockContentRepo := mockrepo.NewMockContent(ctrl)
+			mockUserRepo := mockrepo.NewMockUser(ctrl)
+			mockStaticUC := mock_usecase.NewMockStatic(ctrl)
+			tc.SetupReviewRepoMock(mockReviewRepo)
+			tc.SetupUserRepoMock(mockUserRepo)
+			tc.SetupContentRepoMock(mockContentRepo)
+			tc.SetupStaticUCMock(mockStaticUC)
+			service := NewReviewService(mockReviewRepo, mockUserRepo, mockContentRepo, mockStaticUC, nil, nil, nil, nil)
+			output, err := service.GetReviewHistory(context.Background(), tc.ReviewID, 1)
+			require.Equal(t, tc.ExpectedOutput, output)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestReviewService_GetContentRatingStats(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                string
+		ContentID           int
+		ExpectedOutput      *dto.ContentRatingStats
+		ExpectedErr         error
+		SetupReviewRepoMock func(repo *mockrepo.MockReview)
+	}{
+		{
+			Name:      "Успешное получение",
+			ContentID: 1,
+			ExpectedOutput: &dto.ContentRatingStats{
+				ContentID:      1,
+				RatingCount:    3,
+				Average:        8,
+				Median:         7,
+				WeightedRating: 6.85,
+				Histogram: []dto.RatingHistogramBar{
+					{Rating: 1, Count: 0},
+					{Rating: 2, Count: 0},
+					{Rating: 3, Count: 0},
+					{Rating: 4, Count: 0},
+					{Rating: 5, Count: 0},
+					{Rating: 6, Count: 0},
+					{Rating: 7, Count: 2},
+					{Rating: 8, Count: 0},
+					{Rating: 9, Count: 0},
+					{Rating: 10, Count: 1},
+				},
+			},
+			ExpectedErr: nil,
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetContentRatingStats(gomock.Any(), 1).Return(&entity.ContentRatingStats{
+					ContentID:      1,
+					Histogram:      [10]int{6: 2, 9: 1},
+					WeightedRating: 6.85,
+				}, nil)
+			},
+		},
+		{
+			Name:           "Контент не найден",
+			ContentID:      1,
+			ExpectedOutput: nil,
+			ExpectedErr:    usecase.ErrContentNotFound,
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetContentRatingStats(gomock.Any(), 1).Return(nil, repository.ErrContentNotFound)
+			},
+		},
+		{
+			Name:           "Ошибка базы данных",
+			ContentID:      1,
+			ExpectedOutput: nil,
+			ExpectedErr: entity.UsecaseWrap(
+				errors.New("ошибка при получении распределения оценок контента"),
+				errors.New("database error"),
+			),
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetContentRatingStats(gomock.Any(), 1).Return(nil, errors.New("database error"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockReviewRepo := mockrepo.NewMockReview(ctrl)
 			tc.SetupReviewRepoMock(mockReviewRepo)
-			_, err := service.GetReview(tc.ReviewID)
+			service := NewReviewService(mockReviewRepo, nil, nil, nil, nil, nil, nil, nil)
+			output, err := service.GetContentRatingStats(context.Background(), tc.ContentID)
+			require.Equal(t, tc.ExpectedOutput, output)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
 	}
@@ -251,7 +587,7 @@ func TestReviewService_GetContentReviewByAuthor(t *testing.T) {
 			ContentID:   1,
 			ExpectedErr: entity.UsecaseWrap(errors.New("ошибка при получении отзыва"), errors.New("database error")),
 			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
-				repo.EXPECT().GetContentReviewByAuthor(1, 1).Return(nil, errors.New("database error"))
+				repo.EXPECT().GetContentReviewByAuthor(gomock.Any(), 1, 1).Return(nil, errors.New("database error"))
 			},
 		},
 		{
@@ -260,7 +596,7 @@ func TestReviewService_GetContentReviewByAuthor(t *testing.T) {
 			ContentID:   1,
 			ExpectedErr: usecase.ErrReviewNotFound,
 			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
-				repo.EXPECT().GetContentReviewByAuthor(1, 1).Return(nil, repository.ErrReviewNotFound)
+				repo.EXPECT().GetContentReviewByAuthor(gomock.Any(), 1, 1).Return(nil, repository.ErrReviewNotFound)
 			},
 		},
 	}
@@ -275,9 +611,9 @@ func TestReviewService_GetContentReviewByAuthor(t *testing.T) {
 			mockContentRepo := mockrepo.NewMockContent(ctrl)
 			mockUserRepo := mockrepo.NewMockUser(ctrl)
 			mockStaticRepo := mock_usecase.NewMockStatic(ctrl)
-			service := NewReviewService(mockReviewRepo, mockUserRepo, mockContentRepo, mockStaticRepo, nil)
+			service := NewReviewService(mockReviewRepo, mockUserRepo, mockContentRepo, mockStaticRepo, nil, nil, nil, nil)
 			tc.SetupReviewRepoMock(mockReviewRepo)
-			_, err := service.GetContentReviewByAuthor(tc.AuthorID, tc.ContentID)
+			_, err := service.GetContentReviewByAuthor(context.Background(), tc.AuthorID, tc.ContentID)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
 	}
@@ -309,15 +645,15 @@ func TestReviewService_CreateReview(t *testing.T) {
 			},
 			ExpectedErr: entity.UsecaseWrap(errors.New("ошибка при добавлении отзыва"), errors.New("database error")),
 			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
-				repo.EXPECT().AddReview(gomock.Any()).Return(nil, errors.New("database error"))
+				repo.EXPECT().AddReview(gomock.Any(), gomock.Any()).Return(nil, errors.New("database error"))
 			},
 			SetupProfanityUCMock: func(uc *mock_usecase.MockProfanity) {
-				uc.EXPECT().FilterMessage("Test Text").Return("Test ****", nil)
-				uc.EXPECT().FilterMessage("Test Title").Return("**** Title", nil)
+				uc.EXPECT().FilterMessage(gomock.Any(), "Test Text").Return("Test ****", nil)
+				uc.EXPECT().FilterMessage(gomock.Any(), "Test Title").Return("**** Title", nil)
 			},
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {},
 			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
-				repo.EXPECT().GetContent(1).Return(&entity.Content{}, nil)
+				repo.EXPECT().GetContent(gomock.Any(), 1).Return(&entity.Content{}, nil)
 			},
 			SetupStaticUCMock: func(uc *mock_usecase.MockStatic) {},
 		},
@@ -334,15 +670,15 @@ func TestReviewService_CreateReview(t *testing.T) {
 			},
 			ExpectedErr: usecase.ErrReviewAlreadyExists,
 			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
-				repo.EXPECT().AddReview(gomock.Any()).Return(nil, repository.ErrReviewAlreadyExists)
+				repo.EXPECT().AddReview(gomock.Any(), gomock.Any()).Return(nil, repository.ErrReviewAlreadyExists)
 			},
 			SetupProfanityUCMock: func(uc *mock_usecase.MockProfanity) {
-				uc.EXPECT().FilterMessage("Test Text").Return("Test ****", nil)
-				uc.EXPECT().FilterMessage("Test Title").Return("**** Title", nil)
+				uc.EXPECT().FilterMessage(gomock.Any(), "Test Text").Return("Test ****", nil)
+				uc.EXPECT().FilterMessage(gomock.Any(), "Test Title").Return("**** Title", nil)
 			},
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {},
 			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
-				repo.EXPECT().GetContent(1).Return(&entity.Content{}, nil)
+				repo.EXPECT().GetContent(gomock.Any(), 1).Return(&entity.Content{}, nil)
 			},
 			SetupStaticUCMock: func(uc *mock_usecase.MockStatic) {},
 		},
@@ -360,11 +696,11 @@ func TestReviewService_CreateReview(t *testing.T) {
 			ExpectedErr:         entity.UsecaseWrap(errors.New("ошибка при фильтрации содержания рецензии"), errors.New("profanity error")),
 			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {},
 			SetupProfanityUCMock: func(uc *mock_usecase.MockProfanity) {
-				uc.EXPECT().FilterMessage("Test Text").Return("", errors.New("profanity error"))
+				uc.EXPECT().FilterMessage(gomock.Any(), "Test Text").Return("", errors.New("profanity error"))
 			},
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {},
 			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
-				repo.EXPECT().GetContent(1).Return(&entity.Content{}, nil)
+				repo.EXPECT().GetContent(gomock.Any(), 1).Return(&entity.Content{}, nil)
 			},
 			SetupStaticUCMock: func(uc *mock_usecase.MockStatic) {},
 		},
@@ -382,12 +718,12 @@ func TestReviewService_CreateReview(t *testing.T) {
 			ExpectedErr:         entity.UsecaseWrap(errors.New("ошибка при фильтрации заголовка рецензии"), errors.New("profanity error")),
 			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {},
 			SetupProfanityUCMock: func(uc *mock_usecase.MockProfanity) {
-				uc.EXPECT().FilterMessage("Test Text").Return("Test ****", nil)
-				uc.EXPECT().FilterMessage("Test Title").Return("", errors.New("profanity error"))
+				uc.EXPECT().FilterMessage(gomock.Any(), "Test Text").Return("Test ****", nil)
+				uc.EXPECT().FilterMessage(gomock.Any(), "Test Title").Return("", errors.New("profanity error"))
 			},
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {},
 			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
-				repo.EXPECT().GetContent(1).Return(&entity.Content{}, nil)
+				repo.EXPECT().GetContent(gomock.Any(), 1).Return(&entity.Content{}, nil)
 			},
 			SetupStaticUCMock: func(uc *mock_usecase.MockStatic) {},
 		},
@@ -404,7 +740,7 @@ func TestReviewService_CreateReview(t *testing.T) {
 			},
 			ExpectedErr: nil,
 			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
-				repo.EXPECT().AddReview(&entity.Review{
+				repo.EXPECT().AddReview(gomock.Any(), &entity.Review{
 					AuthorID:      1,
 					ContentID:     1,
 					ContentRating: 5,
@@ -420,17 +756,64 @@ func TestReviewService_CreateReview(t *testing.T) {
 				}, nil)
 			},
 			SetupProfanityUCMock: func(uc *mock_usecase.MockProfanity) {
-				uc.EXPECT().FilterMessage("Test Text").Return("Test ****", nil)
-				uc.EXPECT().FilterMessage("Test Title").Return("**** Title", nil)
+				uc.EXPECT().FilterMessage(gomock.Any(), "Test Text").Return("Test ****", nil)
+				uc.EXPECT().FilterMessage(gomock.Any(), "Test Title").Return("**** Title", nil)
+			},
+			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
+				repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(&entity.User{}, nil)
+			},
+			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
+				repo.EXPECT().GetContent(gomock.Any(), 1).Return(&entity.Content{}, nil).AnyTimes()
+				repo.EXPECT().InvalidateContent(gomock.Any(), 1).Return(nil)
+			},
+			SetupStaticUCMock: func(uc *mock_usecase.MockStatic) {
+				uc.EXPECT().GetStatic(gomock.Any(), gomock.Any()).Return("", nil)
+			},
+		},
+		{
+			Name: "Спойлер в тексте",
+			Review: dto.ReviewCreate{
+				ReviewCreateRequest: dto.ReviewCreateRequest{
+					ContentID: 1,
+					Rating:    5,
+					Title:     "Test Title",
+					Text:      "Финал: [spoiler]все выжили[/spoiler]",
+				},
+				UserID: 1,
+			},
+			ExpectedErr: nil,
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().AddReview(gomock.Any(), &entity.Review{
+					AuthorID:      1,
+					ContentID:     1,
+					ContentRating: 5,
+					Title:         "Test Title",
+					Text:          "Финал: [spoiler]все выжили[/spoiler]",
+					HasSpoilers:   true,
+				}).Return(&entity.Review{
+					ID:            1,
+					AuthorID:      1,
+					ContentID:     1,
+					ContentRating: 5,
+					Title:         "Test Title",
+					Text:          "Финал: [spoiler]все выжили[/spoiler]",
+					HasSpoilers:   true,
+				}, nil)
+			},
+			SetupProfanityUCMock: func(uc *mock_usecase.MockProfanity) {
+				uc.EXPECT().FilterMessage(gomock.Any(), "Финал: [spoiler]все выжили[/spoiler]").
+					Return("Финал: [spoiler]все выжили[/spoiler]", nil)
+				uc.EXPECT().FilterMessage(gomock.Any(), "Test Title").Return("Test Title", nil)
 			},
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
-				repo.EXPECT().GetUserByID(1).Return(&entity.User{}, nil)
+				repo.EXPECT().GetUserByID(gomock.Any(), 1).Return(&entity.User{}, nil)
 			},
 			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
-				repo.EXPECT().GetContent(1).Return(&entity.Content{}, nil).AnyTimes()
+				repo.EXPECT().GetContent(gomock.Any(), 1).Return(&entity.Content{}, nil).AnyTimes()
+				repo.EXPECT().InvalidateContent(gomock.Any(), 1).Return(nil)
 			},
 			SetupStaticUCMock: func(uc *mock_usecase.MockStatic) {
-				uc.EXPECT().GetStatic(gomock.Any()).Return("", nil)
+				uc.EXPECT().GetStatic(gomock.Any(), gomock.Any()).Return("", nil)
 			},
 		},
 		{
@@ -449,7 +832,7 @@ func TestReviewService_CreateReview(t *testing.T) {
 			SetupProfanityUCMock: func(uc *mock_usecase.MockProfanity) {},
 			SetupUserRepoMock:    func(repo *mockrepo.MockUser) {},
 			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
-				repo.EXPECT().GetContent(1).Return(&entity.Content{}, nil)
+				repo.EXPECT().GetContent(gomock.Any(), 1).Return(&entity.Content{}, nil)
 			},
 			SetupStaticUCMock: func(uc *mock_usecase.MockStatic) {},
 		},
@@ -466,15 +849,15 @@ func TestReviewService_CreateReview(t *testing.T) {
 			},
 			ExpectedErr: usecase.ErrReviewContentNotFound,
 			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
-				repo.EXPECT().AddReview(gomock.Any()).Return(nil, repository.ErrReviewViolation)
+				repo.EXPECT().AddReview(gomock.Any(), gomock.Any()).Return(nil, repository.ErrReviewViolation)
 			},
 			SetupProfanityUCMock: func(uc *mock_usecase.MockProfanity) {
-				uc.EXPECT().FilterMessage("Test Text").Return("Test ****", nil)
-				uc.EXPECT().FilterMessage("Test Title").Return("**** Title", nil)
+				uc.EXPECT().FilterMessage(gomock.Any(), "Test Text").Return("Test ****", nil)
+				uc.EXPECT().FilterMessage(gomock.Any(), "Test Title").Return("**** Title", nil)
 			},
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {},
 			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
-				repo.EXPECT().GetContent(1).Return(&entity.Content{}, nil)
+				repo.EXPECT().GetContent(gomock.Any(), 1).Return(&entity.Content{}, nil)
 			},
 			SetupStaticUCMock: func(uc *mock_usecase.MockStatic) {},
 		},
@@ -491,13 +874,20 @@ func TestReviewService_CreateReview(t *testing.T) {
 			mockUserRepo := mockrepo.NewMockUser(ctrl)
 			mockStaticUC := mock_usecase.NewMockStatic(ctrl)
 			mockProfanityUC := mock_usecase.NewMockProfanity(ctrl)
-			service := NewReviewService(mockReviewRepo, mockUserRepo, mockContentRepo, mockStaticUC, mockProfanityUC)
+			mockActivityRepo := mockrepo.NewMockActivity(ctrl)
+			mockActivityRepo.EXPECT().AddActivity(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockStatsRepo := mockrepo.NewMockUserStats(ctrl)
+			mockStatsRepo.EXPECT().InvalidateUserStats(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			service := NewReviewService(
+				mockReviewRepo, mockUserRepo, mockContentRepo, mockStaticUC, mockProfanityUC, mockActivityRepo,
+				mockStatsRepo, nil,
+			)
 			tc.SetupProfanityUCMock(mockProfanityUC)
 			tc.SetupReviewRepoMock(mockReviewRepo)
 			tc.SetupUserRepoMock(mockUserRepo)
 			tc.SetupContentRepoMock(mockContentRepo)
 			tc.SetupStaticUCMock(mockStaticUC)
-			_, err := service.CreateReview(tc.Review)
+			_, err := service.CreateReview(context.Background(), tc.Review)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
 	}
@@ -508,7 +898,83 @@ func TestDeleteReview(t *testing.T) {
 }
 
 func TestVoteReview(t *testing.T) {
-	// Setup
+	t.Parallel()
+
+	testCases := []struct {
+		Name                string
+		Vote                bool
+		ExpectedErr         error
+		SetupReviewRepoMock func(repo *mockrepo.MockReview)
+	}{
+		{
+			Name:        "Успешный лайк",
+			Vote:        true,
+			ExpectedErr: nil,
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetReviewByID(gomock.Any(), 5).Return(&entity.Review{ID: 5, AuthorID: 2}, nil)
+				repo.EXPECT().IsVotedByUser(gomock.Any(), 5, 1).Return(0, nil)
+				repo.EXPECT().VoteReview(gomock.Any(), 5, 1, true).Return(nil)
+			},
+		},
+		{
+			Name:        "Повторный лайк",
+			Vote:        true,
+			ExpectedErr: nil,
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetReviewByID(gomock.Any(), 5).Return(&entity.Review{ID: 5, AuthorID: 2}, nil)
+				repo.EXPECT().IsVotedByUser(gomock.Any(), 5, 1).Return(1, nil)
+			},
+		},
+		{
+			Name:        "Смена лайка на дизлайк",
+			Vote:        false,
+			ExpectedErr: nil,
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetReviewByID(gomock.Any(), 5).Return(&entity.Review{ID: 5, AuthorID: 2}, nil)
+				repo.EXPECT().IsVotedByUser(gomock.Any(), 5, 1).Return(1, nil)
+				repo.EXPECT().UnVoteReview(gomock.Any(), 5, 1).Return(nil)
+				repo.EXPECT().VoteReview(gomock.Any(), 5, 1, false).Return(nil)
+			},
+		},
+		{
+			Name:        "Рецензия не найдена",
+			Vote:        true,
+			ExpectedErr: usecase.ErrReviewNotFound,
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetReviewByID(gomock.Any(), 5).Return(nil, repository.ErrReviewNotFound)
+			},
+		},
+		{
+			Name:        "Скрытая рецензия",
+			Vote:        true,
+			ExpectedErr: usecase.ErrReviewNotFound,
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetReviewByID(gomock.Any(), 5).Return(&entity.Review{ID: 5, AuthorID: 2, Hidden: true}, nil)
+			},
+		},
+		{
+			Name:        "Ошибка при получении рецензии",
+			Vote:        true,
+			ExpectedErr: entity.UsecaseWrap(errors.New("ошибка при получении отзыва"), errors.New("database error")),
+			SetupReviewRepoMock: func(repo *mockrepo.MockReview) {
+				repo.EXPECT().GetReviewByID(gomock.Any(), 5).Return(nil, errors.New("database error"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockReviewRepo := mockrepo.NewMockReview(ctrl)
+			tc.SetupReviewRepoMock(mockReviewRepo)
+			service := NewReviewService(mockReviewRepo, nil, nil, nil, nil, nil, nil, nil)
+			err := service.VoteReview(context.Background(), 1, 5, tc.Vote)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
 }
 
 func TestIsVotedByUser(t *testing.T) {
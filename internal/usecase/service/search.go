@@ -1,10 +1,12 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
 )
 
@@ -20,29 +22,27 @@ func NewSearchService(searchRepo repository.Search, contentUC usecase.Content) u
 	}
 }
 
-func (s SearchService) Search(query string) (*dto.SearchResult, error) {
-	contents, err := s.searchRepo.SearchContent(query)
+func (s SearchService) Search(ctx context.Context, query string) (*dto.SearchResult, error) {
+	ctx, span := tracing.Start(ctx, "SearchService.Search")
+	defer span.End()
+	contents, err := s.searchRepo.SearchContent(ctx, query)
 	if err != nil {
 		return nil, entity.UsecaseWrap(err, errors.New("ошибка при поиске контента в SearchService"))
 	}
-	persons, err := s.searchRepo.SearchPerson(query)
+	persons, err := s.searchRepo.SearchPerson(ctx, query)
 	if err != nil {
 		return nil, entity.UsecaseWrap(err, errors.New("ошибка при поиске персон в SearchService"))
 	}
 
 	result := dto.SearchResult{
-		Content: make([]*dto.PreviewContent, len(contents)),
 		Persons: make([]*dto.PersonPreviewWithPhoto, len(persons)),
 	}
-	for index, content := range contents {
-		contentDTO, err := s.contentUC.GetPreviewContentByID(content)
-		if err != nil {
-			return nil, errors.Join(err, errors.New("ошибка при получении контента из Search"))
-		}
-		result.Content[index] = contentDTO
+	result.Content, err = s.contentUC.GetPreviewContents(ctx, contents)
+	if err != nil {
+		return nil, errors.Join(err, errors.New("ошибка при получении контента из Search"))
 	}
 	for index, person := range persons {
-		personDTO, err := s.contentUC.GetPreviewPersonByID(person)
+		personDTO, err := s.contentUC.GetPreviewPersonByID(ctx, person)
 		if err != nil {
 			return nil, errors.Join(err, errors.New("ошибка при получении персоны из Search"))
 		}
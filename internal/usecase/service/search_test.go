@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
@@ -91,12 +92,12 @@ func TestSearchService_Search(t *testing.T) {
 			Input:       "query",
 			ExpectedErr: nil,
 			SetupSearchRepoMock: func(repo *mockrepo.MockSearch) {
-				repo.EXPECT().SearchContent(gomock.Any()).Return([]int{1}, nil)
-				repo.EXPECT().SearchPerson(gomock.Any()).Return([]int{1}, nil)
+				repo.EXPECT().SearchContent(gomock.Any(), gomock.Any()).Return([]int{1}, nil)
+				repo.EXPECT().SearchPerson(gomock.Any(), gomock.Any()).Return([]int{1}, nil)
 			},
 			SetupContentUCMock: func(uc *mockusecase.MockContent) {
-				uc.EXPECT().GetPreviewContentByID(1).Return(&dto.PreviewContent{}, nil)
-				uc.EXPECT().GetPreviewPersonByID(1).Return(&dto.PersonPreviewWithPhoto{}, nil)
+				uc.EXPECT().GetPreviewContents(gomock.Any(), []int{1}).Return([]*dto.PreviewContent{{}}, nil)
+				uc.EXPECT().GetPreviewPersonByID(gomock.Any(), 1).Return(&dto.PersonPreviewWithPhoto{}, nil)
 			},
 		},
 		{
@@ -104,12 +105,12 @@ func TestSearchService_Search(t *testing.T) {
 			Input:       "query",
 			ExpectedErr: nil,
 			SetupSearchRepoMock: func(repo *mockrepo.MockSearch) {
-				repo.EXPECT().SearchContent(gomock.Any()).Return([]int{1}, nil)
-				repo.EXPECT().SearchPerson(gomock.Any()).Return([]int{1}, nil)
+				repo.EXPECT().SearchContent(gomock.Any(), gomock.Any()).Return([]int{1}, nil)
+				repo.EXPECT().SearchPerson(gomock.Any(), gomock.Any()).Return([]int{1}, nil)
 			},
 			SetupContentUCMock: func(uc *mockusecase.MockContent) {
-				uc.EXPECT().GetPreviewContentByID(1).Return(&dto.PreviewContent{}, nil)
-				uc.EXPECT().GetPreviewPersonByID(1).Return(&dto.PersonPreviewWithPhoto{}, nil)
+				uc.EXPECT().GetPreviewContents(gomock.Any(), []int{1}).Return([]*dto.PreviewContent{{}}, nil)
+				uc.EXPECT().GetPreviewPersonByID(gomock.Any(), 1).Return(&dto.PersonPreviewWithPhoto{}, nil)
 			},
 		},
 		{
@@ -117,7 +118,7 @@ func TestSearchService_Search(t *testing.T) {
 			Input:       "query",
 			ExpectedErr: entity.UsecaseWrap(errors.New("ошибка при поиске контента в SearchService"), errors.New("ошибка при поиске контента в SearchService")),
 			SetupSearchRepoMock: func(repo *mockrepo.MockSearch) {
-				repo.EXPECT().SearchContent(gomock.Any()).Return(nil, errors.New("ошибка при поиске контента в SearchService"))
+				repo.EXPECT().SearchContent(gomock.Any(), gomock.Any()).Return(nil, errors.New("ошибка при поиске контента в SearchService"))
 			},
 			SetupContentUCMock: func(uc *mockusecase.MockContent) {
 
@@ -128,8 +129,8 @@ func TestSearchService_Search(t *testing.T) {
 			Input:       "query",
 			ExpectedErr: entity.UsecaseWrap(errors.New("ошибка при поиске персон в SearchService"), errors.New("ошибка при поиске персон в SearchService")),
 			SetupSearchRepoMock: func(repo *mockrepo.MockSearch) {
-				repo.EXPECT().SearchContent(gomock.Any()).Return([]int{1}, nil)
-				repo.EXPECT().SearchPerson(gomock.Any()).Return(nil, errors.New("ошибка при поиске персон в SearchService"))
+				repo.EXPECT().SearchContent(gomock.Any(), gomock.Any()).Return([]int{1}, nil)
+				repo.EXPECT().SearchPerson(gomock.Any(), gomock.Any()).Return(nil, errors.New("ошибка при поиске персон в SearchService"))
 			},
 			SetupContentUCMock: func(uc *mockusecase.MockContent) {
 
@@ -148,7 +149,7 @@ func TestSearchService_Search(t *testing.T) {
 			searchService := NewSearchService(mockSearchRepo, mockContentUC)
 			tc.SetupSearchRepoMock(mockSearchRepo)
 			tc.SetupContentUCMock(mockContentUC)
-			_, err := searchService.Search(tc.Input)
+			_, err := searchService.Search(context.Background(), tc.Input)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
 	}
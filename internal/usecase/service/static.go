@@ -2,11 +2,14 @@ package service
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"github.com/chai2010/webp"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
 	"github.com/google/uuid"
 	"image"
@@ -29,8 +32,10 @@ func NewStaticService(staticRepo repository.Static) usecase.Static {
 	}
 }
 
-func (s *StaticService) GetStatic(staticID int) (string, error) {
-	staticURL, err := s.staticRepo.GetStaticURL(staticID)
+func (s *StaticService) GetStatic(ctx context.Context, staticID int) (string, error) {
+	ctx, span := tracing.Start(ctx, "StaticService.GetStatic")
+	defer span.End()
+	staticURL, err := s.staticRepo.GetStaticURL(ctx, staticID)
 	switch {
 	case errors.Is(err, repository.ErrStaticNotFound):
 		return "", usecase.ErrStaticNotFound
@@ -41,7 +46,9 @@ func (s *StaticService) GetStatic(staticID int) (string, error) {
 	}
 }
 
-func (s *StaticService) UploadAvatar(reader io.ReadSeeker) (int, error) {
+func (s *StaticService) UploadAvatar(ctx context.Context, reader io.ReadSeeker) (int, error) {
+	ctx, span := tracing.Start(ctx, "StaticService.UploadAvatar")
+	defer span.End()
 	// Проверка размера файла
 	size, err := reader.Seek(0, io.SeekEnd)
 	if err != nil {
@@ -113,15 +120,18 @@ func (s *StaticService) UploadAvatar(reader io.ReadSeeker) (int, error) {
 	}
 
 	// Загрузка обработанного изображения на сервер
-	id, err := s.staticRepo.UploadStatic("avatars", uuid.New().String()+".webp", bytes.NewReader(out.Bytes()))
+	id, err := s.staticRepo.UploadStatic(ctx, "avatars", uuid.New().String()+".webp", bytes.NewReader(out.Bytes()))
 	if err != nil {
 		return -1, err
 	}
+	metrics.StaticUploads.WithLabelValues("avatars").Inc()
 	return id, nil
 }
 
-func (s *StaticService) GetStaticFile(staticURI string) (io.ReadSeeker, error) {
-	static, err := s.staticRepo.GetStaticFile(staticURI)
+func (s *StaticService) GetStaticFile(ctx context.Context, staticURI string) (io.ReadSeeker, error) {
+	ctx, span := tracing.Start(ctx, "StaticService.GetStaticFile")
+	defer span.End()
+	static, err := s.staticRepo.GetStaticFile(ctx, staticURI)
 	switch {
 	case errors.Is(err, repository.ErrStaticNotFound):
 		return nil, usecase.ErrStaticNotFound
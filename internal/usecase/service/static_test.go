@@ -2,6 +2,7 @@ package service
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
 	mockrepo "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository/mocks"
@@ -27,7 +28,7 @@ func TestStaticService_GetStatic(t *testing.T) {
 			Input:       1,
 			ExpectedErr: nil,
 			SetupStaticRepoMock: func(repo *mockrepo.MockStatic) {
-				repo.EXPECT().GetStaticURL(1).Return("path", nil)
+				repo.EXPECT().GetStaticURL(gomock.Any(), 1).Return("path", nil)
 			},
 		},
 		{
@@ -35,7 +36,7 @@ func TestStaticService_GetStatic(t *testing.T) {
 			Input:       2,
 			ExpectedErr: entity.UsecaseWrap(fmt.Errorf("не удалось получить аватар"), fmt.Errorf("ошибка при получении статики")),
 			SetupStaticRepoMock: func(repo *mockrepo.MockStatic) {
-				repo.EXPECT().GetStaticURL(2).Return("", fmt.Errorf("не удалось получить аватар"))
+				repo.EXPECT().GetStaticURL(gomock.Any(), 2).Return("", fmt.Errorf("не удалось получить аватар"))
 			},
 		},
 	}
@@ -49,7 +50,7 @@ func TestStaticService_GetStatic(t *testing.T) {
 			mockStaticRepo := mockrepo.NewMockStatic(ctrl)
 			staticService := NewStaticService(mockStaticRepo)
 			tc.SetupStaticRepoMock(mockStaticRepo)
-			_, err := staticService.GetStatic(tc.Input)
+			_, err := staticService.GetStatic(context.Background(), tc.Input)
 			require.EqualValues(t, tc.ExpectedErr, err)
 		})
 	}
@@ -78,7 +79,7 @@ func TestStaticService_UploadAvatar(t *testing.T) {
 			ExpectedErr: nil,
 			SetupStaticRepoMock: func(repo *mockrepo.MockStatic) {
 				repo.EXPECT().GetMaxSize().Return(1000000).AnyTimes()
-				repo.EXPECT().UploadStatic(gomock.Any(), gomock.Any(), gomock.Any()).Return(1, nil)
+				repo.EXPECT().UploadStatic(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(1, nil)
 			},
 		},
 		{
@@ -136,7 +137,7 @@ func TestStaticService_UploadAvatar(t *testing.T) {
 			ExpectedErr: fmt.Errorf("ошибка при создании записи в БД"),
 			SetupStaticRepoMock: func(repo *mockrepo.MockStatic) {
 				repo.EXPECT().GetMaxSize().Return(1000000).AnyTimes()
-				repo.EXPECT().UploadStatic(gomock.Any(), gomock.Any(), gomock.Any()).Return(-1, fmt.Errorf("ошибка при создании записи в БД"))
+				repo.EXPECT().UploadStatic(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(-1, fmt.Errorf("ошибка при создании записи в БД"))
 			},
 		},
 	}
@@ -150,7 +151,7 @@ func TestStaticService_UploadAvatar(t *testing.T) {
 			mockStaticRepo := mockrepo.NewMockStatic(ctrl)
 			staticService := NewStaticService(mockStaticRepo)
 			tc.SetupStaticRepoMock(mockStaticRepo)
-			_, err := staticService.UploadAvatar(tc.Input())
+			_, err := staticService.UploadAvatar(context.Background(), tc.Input())
 			require.EqualValues(t, tc.ExpectedErr, err)
 		})
 	}
@@ -1,10 +1,13 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/metrics"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
 	"io"
 )
@@ -21,7 +24,9 @@ func NewUserService(userRepo repository.User, staticUC usecase.Static) usecase.U
 	}
 }
 
-func (u *UserService) Register(regDTO *dto.Register) (int, error) {
+func (u *UserService) Register(ctx context.Context, regDTO *dto.Register) (int, error) {
+	ctx, span := tracing.Start(ctx, "UserService.Register")
+	defer span.End()
 	if err := entity.ValidateEmail(regDTO.Email); err != nil {
 		return -1, usecase.UserIncorrectDataError{Err: err}
 	}
@@ -32,7 +37,7 @@ func (u *UserService) Register(regDTO *dto.Register) (int, error) {
 	if err != nil {
 		return -1, entity.UsecaseWrap(errors.New("ошибка при хешировании пароля"), err)
 	}
-	user, err := u.userRepo.AddUser(regDTO.Email, hash, salt)
+	user, err := u.userRepo.AddUser(ctx, regDTO.Email, hash, salt)
 	switch {
 	case errors.Is(err, repository.ErrUserAlreadyExists):
 		return -1, usecase.ErrUserAlreadyExists
@@ -41,11 +46,14 @@ func (u *UserService) Register(regDTO *dto.Register) (int, error) {
 	case err != nil:
 		return -1, entity.UsecaseWrap(errors.New("ошибка при регистрации пользователя"), err)
 	}
+	metrics.UserRegistrations.Inc()
 	return user.ID, nil
 }
 
-func (u *UserService) Login(login *dto.Login) (int, error) {
-	user, err := u.userRepo.GetUserByEmail(login.Login)
+func (u *UserService) Login(ctx context.Context, login *dto.Login) (int, error) {
+	ctx, span := tracing.Start(ctx, "UserService.Login")
+	defer span.End()
+	user, err := u.userRepo.GetUserByEmail(ctx, login.Login)
 	if errors.Is(err, repository.ErrUserNotFound) {
 		return -1, usecase.ErrUserNotFound
 	}
@@ -58,15 +66,17 @@ func (u *UserService) Login(login *dto.Login) (int, error) {
 	return user.ID, nil
 }
 
-func (u *UserService) UpdateAvatar(userID int, reader io.ReadSeeker) error {
-	user, err := u.userRepo.GetUserByID(userID)
+func (u *UserService) UpdateAvatar(ctx context.Context, userID int, reader io.ReadSeeker) error {
+	ctx, span := tracing.Start(ctx, "UserService.UpdateAvatar")
+	defer span.End()
+	user, err := u.userRepo.GetUserByID(ctx, userID)
 	switch {
 	case errors.Is(err, repository.ErrUserNotFound):
 		return usecase.ErrUserNotFound
 	case err != nil:
 		return entity.UsecaseWrap(errors.New("ошибка при поиске пользователя"), err)
 	}
-	uploadID, err := u.staticUC.UploadAvatar(reader)
+	uploadID, err := u.staticUC.UploadAvatar(ctx, reader)
 	switch {
 	case errors.Is(err, usecase.ErrStaticTooBigFile):
 		return usecase.UserIncorrectDataError{Err: err}
@@ -78,15 +88,17 @@ func (u *UserService) UpdateAvatar(userID int, reader io.ReadSeeker) error {
 		return entity.UsecaseWrap(errors.New("ошибка при загрузке аватара"), err)
 	}
 	user.AvatarUploadID = uploadID
-	err = u.userRepo.UpdateUser(user)
+	err = u.userRepo.UpdateUser(ctx, user)
 	if err != nil {
 		return entity.UsecaseWrap(errors.New("ошибка при обновлении пользователя"), err)
 	}
 	return nil
 }
 
-func (u *UserService) UpdateInfo(userID int, update *dto.UserUpdate) error {
-	user, err := u.userRepo.GetUserByID(userID)
+func (u *UserService) UpdateInfo(ctx context.Context, userID int, update *dto.UserUpdate) error {
+	ctx, span := tracing.Start(ctx, "UserService.UpdateInfo")
+	defer span.End()
+	user, err := u.userRepo.GetUserByID(ctx, userID)
 	switch {
 	case errors.Is(err, repository.ErrUserNotFound):
 		return usecase.ErrUserNotFound
@@ -101,15 +113,17 @@ func (u *UserService) UpdateInfo(userID int, update *dto.UserUpdate) error {
 		return usecase.UserIncorrectDataError{Err: err}
 	}
 	user.Name = update.Name
-	err = u.userRepo.UpdateUser(user)
+	err = u.userRepo.UpdateUser(ctx, user)
 	if err != nil {
 		return entity.UsecaseWrap(errors.New("ошибка при обновлении пользователя"), err)
 	}
 	return nil
 }
 
-func (u *UserService) GetUser(userID int) (*dto.UserProfile, error) {
-	user, err := u.userRepo.GetUserByID(userID)
+func (u *UserService) GetUser(ctx context.Context, userID int) (*dto.UserProfile, error) {
+	ctx, span := tracing.Start(ctx, "UserService.GetUser")
+	defer span.End()
+	user, err := u.userRepo.GetUserByID(ctx, userID)
 	switch {
 	case errors.Is(err, repository.ErrUserNotFound):
 		return nil, usecase.ErrUserNotFound
@@ -118,7 +132,7 @@ func (u *UserService) GetUser(userID int) (*dto.UserProfile, error) {
 	}
 	var avatar string
 	if user.AvatarUploadID > 0 {
-		avatar, err = u.staticUC.GetStatic(user.AvatarUploadID)
+		avatar, err = u.staticUC.GetStatic(ctx, user.AvatarUploadID)
 		switch {
 		case errors.Is(err, usecase.ErrStaticNotFound):
 			avatar = ""
@@ -135,8 +149,10 @@ func (u *UserService) GetUser(userID int) (*dto.UserProfile, error) {
 	}, nil
 }
 
-func (u *UserService) UpdatePassword(userID int, update *dto.UpdatePassword) error {
-	user, err := u.userRepo.GetUserByID(userID)
+func (u *UserService) UpdatePassword(ctx context.Context, userID int, update *dto.UpdatePassword) error {
+	ctx, span := tracing.Start(ctx, "UserService.UpdatePassword")
+	defer span.End()
+	user, err := u.userRepo.GetUserByID(ctx, userID)
 	switch {
 	case errors.Is(err, repository.ErrUserNotFound):
 		return usecase.ErrUserNotFound
@@ -155,7 +171,7 @@ func (u *UserService) UpdatePassword(userID int, update *dto.UpdatePassword) err
 	}
 	user.PasswordHash = hash
 	user.PasswordSalt = salt
-	err = u.userRepo.UpdateUser(user)
+	err = u.userRepo.UpdateUser(ctx, user)
 	if err != nil {
 		return entity.UsecaseWrap(errors.New("ошибка при обновлении пользователя"), err)
 	}
@@ -0,0 +1,293 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+)
+
+type UserListService struct {
+	userListRepo repository.UserList
+	contentUC    usecase.Content
+	privacyUC    usecase.Privacy
+	activityRepo repository.Activity
+}
+
+func NewUserListService(
+	userListRepo repository.UserList,
+	contentUC usecase.Content,
+	privacyUC usecase.Privacy,
+	activityRepo repository.Activity,
+) usecase.UserList {
+	return &UserListService{
+		userListRepo: userListRepo,
+		contentUC:    contentUC,
+		privacyUC:    privacyUC,
+		activityRepo: activityRepo,
+	}
+}
+
+func userListEntityToDTO(list *entity.UserList) dto.UserList {
+	return dto.UserList{
+		ID:          list.ID,
+		UserID:      list.UserID,
+		Title:       list.Title,
+		Description: list.Description,
+		IsPublic:    list.IsPublic,
+		ItemsCount:  list.ItemsCount,
+		CreatedAt:   list.CreatedAt.String(),
+		UpdatedAt:   list.UpdatedAt.String(),
+	}
+}
+
+// getOwnList возвращает список, если он принадлежит пользователю userID
+func (u *UserListService) getOwnList(ctx context.Context, userID, listID int) (*entity.UserList, error) {
+	list, err := u.userListRepo.GetList(ctx, listID)
+	switch {
+	case errors.Is(err, repository.ErrUserListNotFound):
+		return nil, usecase.ErrUserListNotFound
+	case err != nil:
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении списка"), err)
+	case list.UserID != userID:
+		return nil, usecase.ErrUserListForbidden
+	default:
+		return list, nil
+	}
+}
+
+func (u *UserListService) CreateList(
+	ctx context.Context,
+	userID int,
+	req dto.UserListRequest,
+) (*dto.UserList, error) {
+	ctx, span := tracing.Start(ctx, "UserListService.CreateList")
+	defer span.End()
+	if err := entity.ValidateUserList(req.Title, req.Description); err != nil {
+		return nil, usecase.UserListErrorIncorrectData{Err: err}
+	}
+	list, err := u.userListRepo.CreateList(ctx, &entity.UserList{
+		UserID:      userID,
+		Title:       req.Title,
+		Description: req.Description,
+		IsPublic:    req.IsPublic,
+	})
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при создании списка"), err)
+	}
+	listDTO := userListEntityToDTO(list)
+	return &listDTO, nil
+}
+
+func (u *UserListService) UpdateList(
+	ctx context.Context,
+	userID, listID int,
+	req dto.UserListRequest,
+) (*dto.UserList, error) {
+	ctx, span := tracing.Start(ctx, "UserListService.UpdateList")
+	defer span.End()
+	if err := entity.ValidateUserList(req.Title, req.Description); err != nil {
+		return nil, usecase.UserListErrorIncorrectData{Err: err}
+	}
+	list, err := u.getOwnList(ctx, userID, listID)
+	if err != nil {
+		return nil, err
+	}
+	list.Title = req.Title
+	list.Description = req.Description
+	list.IsPublic = req.IsPublic
+	list, err = u.userListRepo.UpdateList(ctx, list)
+	switch {
+	case errors.Is(err, repository.ErrUserListNotFound):
+		return nil, usecase.ErrUserListNotFound
+	case err != nil:
+		return nil, entity.UsecaseWrap(errors.New("ошибка при изменении списка"), err)
+	}
+	listDTO := userListEntityToDTO(list)
+	return &listDTO, nil
+}
+
+func (u *UserListService) DeleteList(ctx context.Context, userID, listID int) error {
+	ctx, span := tracing.Start(ctx, "UserListService.DeleteList")
+	defer span.End()
+	if _, err := u.getOwnList(ctx, userID, listID); err != nil {
+		return err
+	}
+	err := u.userListRepo.DeleteList(ctx, listID)
+	switch {
+	case errors.Is(err, repository.ErrUserListNotFound):
+		return usecase.ErrUserListNotFound
+	case err != nil:
+		return entity.UsecaseWrap(errors.New("ошибка при удалении списка"), err)
+	default:
+		return nil
+	}
+}
+
+func (u *UserListService) GetList(ctx context.Context, viewerID, listID int) (*dto.UserListWithItems, error) {
+	ctx, span := tracing.Start(ctx, "UserListService.GetList")
+	defer span.End()
+	list, err := u.userListRepo.GetList(ctx, listID)
+	switch {
+	case errors.Is(err, repository.ErrUserListNotFound):
+		return nil, usecase.ErrUserListNotFound
+	case err != nil:
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении списка"), err)
+	case !list.IsPublic && list.UserID != viewerID:
+		// существование чужого приватного списка не раскрывается
+		return nil, usecase.ErrUserListNotFound
+	}
+	canView, err := u.privacyUC.CanView(ctx, viewerID, list.UserID, entity.PrivacySectionLists)
+	switch {
+	case err != nil:
+		return nil, entity.UsecaseWrap(errors.New("ошибка при проверке настроек приватности"), err)
+	case !canView:
+		// публичный список пользователя, скрывшего списки, тоже не раскрывается
+		return nil, usecase.ErrUserListNotFound
+	}
+	items, err := u.userListRepo.GetListItems(ctx, listID)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении элементов списка"), err)
+	}
+	contentIDs := make([]int, len(items))
+	for i, item := range items {
+		contentIDs[i] = item.ContentID
+	}
+	previews, err := u.contentUC.GetPreviewContents(ctx, contentIDs)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении контента списка"), err)
+	}
+	listDTO := &dto.UserListWithItems{
+		UserList: userListEntityToDTO(list),
+		Items:    make([]dto.UserListItem, len(items)),
+	}
+	for i, item := range items {
+		listDTO.Items[i] = dto.UserListItem{
+			Position: item.Position,
+			Note:     item.Note,
+			AddedAt:  item.AddedAt.String(),
+			Content:  *previews[i],
+		}
+	}
+	return listDTO, nil
+}
+
+func (u *UserListService) GetUserLists(ctx context.Context, viewerID, ownerID int) (*dto.UserLists, error) {
+	ctx, span := tracing.Start(ctx, "UserListService.GetUserLists")
+	defer span.End()
+	canView, err := u.privacyUC.CanView(ctx, viewerID, ownerID, entity.PrivacySectionLists)
+	switch {
+	case err != nil:
+		return nil, entity.UsecaseWrap(errors.New("ошибка при проверке настроек приватности"), err)
+	case !canView:
+		return nil, usecase.ErrUserListsHidden
+	}
+	lists, err := u.userListRepo.GetListsByUserID(ctx, ownerID, viewerID != ownerID)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении списков пользователя"), err)
+	}
+	listsDTO := &dto.UserLists{Lists: make([]dto.UserList, len(lists))}
+	for i, list := range lists {
+		listsDTO.Lists[i] = userListEntityToDTO(list)
+	}
+	return listsDTO, nil
+}
+
+func (u *UserListService) AddItem(ctx context.Context, userID, listID int, req dto.UserListItemRequest) error {
+	ctx, span := tracing.Start(ctx, "UserListService.AddItem")
+	defer span.End()
+	if err := entity.ValidateUserListItemNote(req.Note); err != nil {
+		return usecase.UserListErrorIncorrectData{Err: err}
+	}
+	if _, err := u.getOwnList(ctx, userID, listID); err != nil {
+		return err
+	}
+	_, err := u.userListRepo.AddItem(ctx, &entity.UserListItem{
+		ListID:    listID,
+		ContentID: req.ContentID,
+		Note:      req.Note,
+	})
+	switch {
+	case errors.Is(err, repository.ErrUserListItemAlreadyExists):
+		return usecase.ErrUserListItemAlreadyExists
+	case errors.Is(err, repository.ErrUserListContentNotFound):
+		return usecase.ErrUserListContentNotFound
+	case err != nil:
+		return entity.UsecaseWrap(errors.New("ошибка при добавлении контента в список"), err)
+	}
+	// событие пишется и для приватных списков, в ленту оно попадет, только пока список публичный
+	recordActivity(ctx, u.activityRepo, &entity.Activity{
+		UserID:    userID,
+		Type:      entity.ActivityListItem,
+		ContentID: req.ContentID,
+		ListID:    listID,
+	})
+	return nil
+}
+
+func (u *UserListService) UpdateItem(
+	ctx context.Context,
+	userID, listID, contentID int,
+	req dto.UserListItemRequest,
+) error {
+	ctx, span := tracing.Start(ctx, "UserListService.UpdateItem")
+	defer span.End()
+	if err := entity.ValidateUserListItemNote(req.Note); err != nil {
+		return usecase.UserListErrorIncorrectData{Err: err}
+	}
+	if _, err := u.getOwnList(ctx, userID, listID); err != nil {
+		return err
+	}
+	err := u.userListRepo.UpdateItemNote(ctx, listID, contentID, req.Note)
+	switch {
+	case errors.Is(err, repository.ErrUserListItemNotFound):
+		return usecase.ErrUserListItemNotFound
+	case err != nil:
+		return entity.UsecaseWrap(errors.New("ошибка при изменении элемента списка"), err)
+	default:
+		return nil
+	}
+}
+
+func (u *UserListService) RemoveItem(ctx context.Context, userID, listID, contentID int) error {
+	ctx, span := tracing.Start(ctx, "UserListService.RemoveItem")
+	defer span.End()
+	if _, err := u.getOwnList(ctx, userID, listID); err != nil {
+		return err
+	}
+	err := u.userListRepo.RemoveItem(ctx, listID, contentID)
+	switch {
+	case errors.Is(err, repository.ErrUserListItemNotFound):
+		return usecase.ErrUserListItemNotFound
+	case err != nil:
+		return entity.UsecaseWrap(errors.New("ошибка при удалении контента из списка"), err)
+	default:
+		return nil
+	}
+}
+
+func (u *UserListService) ReorderItems(
+	ctx context.Context,
+	userID, listID int,
+	req dto.UserListOrderRequest,
+) error {
+	ctx, span := tracing.Start(ctx, "UserListService.ReorderItems")
+	defer span.End()
+	if _, err := u.getOwnList(ctx, userID, listID); err != nil {
+		return err
+	}
+	items, err := u.userListRepo.GetListItems(ctx, listID)
+	if err != nil {
+		return entity.UsecaseWrap(errors.New("ошибка при получении элементов списка"), err)
+	}
+	if err = entity.ValidateUserListOrder(items, req.ContentIDs); err != nil {
+		return usecase.UserListErrorIncorrectData{Err: err}
+	}
+	if err = u.userListRepo.ReorderItems(ctx, listID, req.ContentIDs); err != nil {
+		return entity.UsecaseWrap(errors.New("ошибка при изменении порядка элементов списка"), err)
+	}
+	return nil
+}
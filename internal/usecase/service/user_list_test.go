@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	mockrepo "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository/mocks"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	mock_usecase "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUserListService_GetList(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Now()
+	testCases := []struct {
+		Name                  string
+		ViewerID              int
+		ExpectedOutput        *dto.UserListWithItems
+		ExpectedErr           error
+		SetupUserListRepoMock func(repo *mockrepo.MockUserList)
+		SetupContentUCMock    func(uc *mock_usecase.MockContent)
+		SetupPrivacyUCMock    func(uc *mock_usecase.MockPrivacy)
+	}{
+		{
+			Name:     "Публичный список",
+			ViewerID: -1,
+			ExpectedOutput: &dto.UserListWithItems{
+				UserList: dto.UserList{
+					ID:         1,
+					UserID:     2,
+					Title:      "Лучшее",
+					IsPublic:   true,
+					ItemsCount: 2,
+					CreatedAt:  fixedTime.String(),
+					UpdatedAt:  fixedTime.String(),
+				},
+				Items: []dto.UserListItem{
+					{Position: 1, Note: "сначала это", AddedAt: fixedTime.String(), Content: dto.PreviewContent{ID: 5}},
+					{Position: 2, AddedAt: fixedTime.String(), Content: dto.PreviewContent{ID: 3}},
+				},
+			},
+			SetupUserListRepoMock: func(repo *mockrepo.MockUserList) {
+				repo.EXPECT().GetList(gomock.Any(), 1).Return(&entity.UserList{
+					ID:         1,
+					UserID:     2,
+					Title:      "Лучшее",
+					IsPublic:   true,
+					ItemsCount: 2,
+					CreatedAt:  fixedTime,
+					UpdatedAt:  fixedTime,
+				}, nil)
+				repo.EXPECT().GetListItems(gomock.Any(), 1).Return([]*entity.UserListItem{
+					{ListID: 1, ContentID: 5, Position: 1, Note: "сначала это", AddedAt: fixedTime},
+					{ListID: 1, ContentID: 3, Position: 2, AddedAt: fixedTime},
+				}, nil)
+			},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {
+				uc.EXPECT().GetPreviewContents(gomock.Any(), []int{5, 3}).
+					Return([]*dto.PreviewContent{{ID: 5}, {ID: 3}}, nil)
+			},
+			SetupPrivacyUCMock: func(uc *mock_usecase.MockPrivacy) {
+				uc.EXPECT().CanView(gomock.Any(), -1, 2, entity.PrivacySectionLists).Return(true, nil)
+			},
+		},
+		{
+			Name:        "Пользователь скрыл списки",
+			ViewerID:    3,
+			ExpectedErr: usecase.ErrUserListNotFound,
+			SetupUserListRepoMock: func(repo *mockrepo.MockUserList) {
+				repo.EXPECT().GetList(gomock.Any(), 1).Return(&entity.UserList{ID: 1, UserID: 2, IsPublic: true}, nil)
+			},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {},
+			SetupPrivacyUCMock: func(uc *mock_usecase.MockPrivacy) {
+				uc.EXPECT().CanView(gomock.Any(), 3, 2, entity.PrivacySectionLists).Return(false, nil)
+			},
+		},
+		{
+			Name:        "Чужой приватный список",
+			ViewerID:    3,
+			ExpectedErr: usecase.ErrUserListNotFound,
+			SetupUserListRepoMock: func(repo *mockrepo.MockUserList) {
+				repo.EXPECT().GetList(gomock.Any(), 1).Return(&entity.UserList{ID: 1, UserID: 2}, nil)
+			},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {},
+			SetupPrivacyUCMock: func(uc *mock_usecase.MockPrivacy) {},
+		},
+		{
+			Name:     "Свой приватный список",
+			ViewerID: 2,
+			ExpectedOutput: &dto.UserListWithItems{
+				UserList: dto.UserList{
+					ID:        1,
+					UserID:    2,
+					CreatedAt: time.Time{}.String(),
+					UpdatedAt: time.Time{}.String(),
+				},
+				Items: []dto.UserListItem{},
+			},
+			SetupUserListRepoMock: func(repo *mockrepo.MockUserList) {
+				repo.EXPECT().GetList(gomock.Any(), 1).Return(&entity.UserList{ID: 1, UserID: 2}, nil)
+				repo.EXPECT().GetListItems(gomock.Any(), 1).Return([]*entity.UserListItem{}, nil)
+			},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {
+				uc.EXPECT().GetPreviewContents(gomock.Any(), []int{}).Return([]*dto.PreviewContent{}, nil)
+			},
+			SetupPrivacyUCMock: func(uc *mock_usecase.MockPrivacy) {
+				uc.EXPECT().CanView(gomock.Any(), 2, 2, entity.PrivacySectionLists).Return(true, nil)
+			},
+		},
+		{
+			Name:        "Список не найден",
+			ViewerID:    2,
+			ExpectedErr: usecase.ErrUserListNotFound,
+			SetupUserListRepoMock: func(repo *mockrepo.MockUserList) {
+				repo.EXPECT().GetList(gomock.Any(), 1).Return(nil, repository.ErrUserListNotFound)
+			},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {},
+			SetupPrivacyUCMock: func(uc *mock_usecase.MockPrivacy) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUserListRepo := mockrepo.NewMockUserList(ctrl)
+			mockContentUC := mock_usecase.NewMockContent(ctrl)
+			mockPrivacyUC := mock_usecase.NewMockPrivacy(ctrl)
+			tc.SetupUserListRepoMock(mockUserListRepo)
+			tc.SetupContentUCMock(mockContentUC)
+			tc.SetupPrivacyUCMock(mockPrivacyUC)
+			service := NewUserListService(mockUserListRepo, mockContentUC, mockPrivacyUC, nil)
+			output, err := service.GetList(context.Background(), tc.ViewerID, 1)
+			require.Equal(t, tc.ExpectedErr, err)
+			require.Equal(t, tc.ExpectedOutput, output)
+		})
+	}
+}
+
+func TestUserListService_AddItem(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                  string
+		Request               dto.UserListItemRequest
+		ExpectedErr           error
+		SetupUserListRepoMock func(repo *mockrepo.MockUserList)
+		SetupActivityRepoMock func(repo *mockrepo.MockActivity)
+	}{
+		{
+			Name:    "Успешное добавление",
+			Request: dto.UserListItemRequest{ContentID: 5, Note: "заметка"},
+			SetupUserListRepoMock: func(repo *mockrepo.MockUserList) {
+				repo.EXPECT().GetList(gomock.Any(), 1).Return(&entity.UserList{ID: 1, UserID: 2}, nil)
+				repo.EXPECT().AddItem(gomock.Any(), &entity.UserListItem{ListID: 1, ContentID: 5, Note: "заметка"}).
+					Return(&entity.UserListItem{ListID: 1, ContentID: 5, Position: 3, Note: "заметка"}, nil)
+			},
+			SetupActivityRepoMock: func(repo *mockrepo.MockActivity) {
+				repo.EXPECT().AddActivity(gomock.Any(), &entity.Activity{
+					UserID: 2, Type: entity.ActivityListItem, ContentID: 5, ListID: 1,
+				}).Return(nil)
+			},
+		},
+		{
+			Name:        "Чужой список",
+			Request:     dto.UserListItemRequest{ContentID: 5},
+			ExpectedErr: usecase.ErrUserListForbidden,
+			SetupUserListRepoMock: func(repo *mockrepo.MockUserList) {
+				repo.EXPECT().GetList(gomock.Any(), 1).Return(&entity.UserList{ID: 1, UserID: 3}, nil)
+			},
+			SetupActivityRepoMock: func(repo *mockrepo.MockActivity) {},
+		},
+		{
+			Name:        "Контент уже в списке",
+			Request:     dto.UserListItemRequest{ContentID: 5},
+			ExpectedErr: usecase.ErrUserListItemAlreadyExists,
+			SetupUserListRepoMock: func(repo *mockrepo.MockUserList) {
+				repo.EXPECT().GetList(gomock.Any(), 1).Return(&entity.UserList{ID: 1, UserID: 2}, nil)
+				repo.EXPECT().AddItem(gomock.Any(), gomock.Any()).Return(nil, repository.ErrUserListItemAlreadyExists)
+			},
+			SetupActivityRepoMock: func(repo *mockrepo.MockActivity) {},
+		},
+		{
+			Name:        "Контент не найден",
+			Request:     dto.UserListItemRequest{ContentID: 5},
+			ExpectedErr: usecase.ErrUserListContentNotFound,
+			SetupUserListRepoMock: func(repo *mockrepo.MockUserList) {
+				repo.EXPECT().GetList(gomock.Any(), 1).Return(&entity.UserList{ID: 1, UserID: 2}, nil)
+				repo.EXPECT().AddItem(gomock.Any(), gomock.Any()).Return(nil, repository.ErrUserListContentNotFound)
+			},
+			SetupActivityRepoMock: func(repo *mockrepo.MockActivity) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockUserListRepo := mockrepo.NewMockUserList(ctrl)
+			mockActivityRepo := mockrepo.NewMockActivity(ctrl)
+			tc.SetupUserListRepoMock(mockUserListRepo)
+			tc.SetupActivityRepoMock(mockActivityRepo)
+			service := NewUserListService(mockUserListRepo, nil, nil, mockActivityRepo)
+			err := service.AddItem(context.Background(), 2, 1, tc.Request)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestUserListService_ReorderItems(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockUserListRepo := mockrepo.NewMockUserList(ctrl)
+	items := []*entity.UserListItem{{ContentID: 5, Position: 1}, {ContentID: 3, Position: 2}}
+	mockUserListRepo.EXPECT().GetList(gomock.Any(), 1).Return(&entity.UserList{ID: 1, UserID: 2}, nil).Times(2)
+	mockUserListRepo.EXPECT().GetListItems(gomock.Any(), 1).Return(items, nil).Times(2)
+	mockUserListRepo.EXPECT().ReorderItems(gomock.Any(), 1, []int{3, 5}).Return(nil)
+	service := NewUserListService(mockUserListRepo, nil, nil, nil)
+
+	err := service.ReorderItems(context.Background(), 2, 1, dto.UserListOrderRequest{ContentIDs: []int{3, 5}})
+	require.NoError(t, err)
+
+	err = service.ReorderItems(context.Background(), 2, 1, dto.UserListOrderRequest{ContentIDs: []int{3, 3}})
+	require.ErrorAs(t, err, &usecase.UserListErrorIncorrectData{})
+}
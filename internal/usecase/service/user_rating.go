@@ -0,0 +1,203 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+)
+
+type UserRatingService struct {
+	ratingRepo   repository.UserRating
+	contentRepo  repository.Content
+	contentUC    usecase.Content
+	activityRepo repository.Activity
+	statsRepo    repository.UserStats
+}
+
+func NewUserRatingService(
+	ratingRepo repository.UserRating,
+	contentRepo repository.Content,
+	contentUC usecase.Content,
+	activityRepo repository.Activity,
+	statsRepo repository.UserStats,
+) usecase.UserRating {
+	return &UserRatingService{
+		ratingRepo:   ratingRepo,
+		contentRepo:  contentRepo,
+		contentUC:    contentUC,
+		activityRepo: activityRepo,
+		statsRepo:    statsRepo,
+	}
+}
+
+func userRatingEntityToDTO(rating *entity.UserRating) dto.UserRating {
+	return dto.UserRating{
+		ContentID: rating.ContentID,
+		Rating:    rating.Rating,
+		CreatedAt: rating.CreatedAt.String(),
+		UpdatedAt: rating.UpdatedAt.String(),
+	}
+}
+
+func (u *UserRatingService) SetRating(ctx context.Context, set dto.UserRatingSet) (*dto.UserRating, error) {
+	ctx, span := tracing.Start(ctx, "UserRatingService.SetRating")
+	defer span.End()
+	if err := entity.ValidateReviewRating(set.Rating); err != nil {
+		return nil, usecase.ErrUserRatingOutOfRange
+	}
+	// как и рецензии, оценки невышедшему контенту ставить нельзя
+	content, err := u.contentUC.GetPreviewContentByID(ctx, set.ContentID)
+	switch {
+	case errors.Is(err, usecase.ErrContentNotFound):
+		return nil, usecase.ErrUserRatingContentNotFound
+	case err != nil:
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении контента"), err)
+	case content.Ongoing:
+		return nil, usecase.ErrUserRatingContentNotFound
+	}
+	rating, err := u.ratingRepo.SetRating(ctx, &entity.UserRating{
+		UserID:    set.UserID,
+		ContentID: set.ContentID,
+		Rating:    set.Rating,
+	})
+	switch {
+	case errors.Is(err, repository.ErrUserRatingViolation):
+		return nil, usecase.ErrUserRatingContentNotFound
+	case err != nil:
+		return nil, entity.UsecaseWrap(errors.New("ошибка при сохранении оценки"), err)
+	}
+	invalidateContent(ctx, u.contentRepo, set.ContentID)
+	invalidateUserStats(ctx, u.statsRepo, set.UserID, entity.UserStatsSectionRatings, entity.UserStatsSectionActivity)
+	recordActivity(ctx, u.activityRepo, &entity.Activity{
+		UserID:    set.UserID,
+		Type:      entity.ActivityRating,
+		ContentID: set.ContentID,
+		Rating:    set.Rating,
+	})
+	ratingDTO := userRatingEntityToDTO(rating)
+	return &ratingDTO, nil
+}
+
+func (u *UserRatingService) DeleteRating(ctx context.Context, userID, contentID int) error {
+	ctx, span := tracing.Start(ctx, "UserRatingService.DeleteRating")
+	defer span.End()
+	err := u.ratingRepo.DeleteRating(ctx, userID, contentID)
+	switch {
+	case errors.Is(err, repository.ErrUserRatingNotFound):
+		return usecase.ErrUserRatingNotFound
+	case err != nil:
+		return entity.UsecaseWrap(errors.New("ошибка при удалении оценки"), err)
+	}
+	invalidateContent(ctx, u.contentRepo, contentID)
+	invalidateUserStats(ctx, u.statsRepo, userID, entity.UserStatsSectionRatings, entity.UserStatsSectionActivity)
+	return nil
+}
+
+func (u *UserRatingService) GetRating(ctx context.Context, userID, contentID int) (*dto.UserRating, error) {
+	ctx, span := tracing.Start(ctx, "UserRatingService.GetRating")
+	defer span.End()
+	rating, err := u.ratingRepo.GetRating(ctx, userID, contentID)
+	switch {
+	case errors.Is(err, repository.ErrUserRatingNotFound):
+		return nil, usecase.ErrUserRatingNotFound
+	case err != nil:
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении оценки"), err)
+	}
+	ratingDTO := userRatingEntityToDTO(rating)
+	return &ratingDTO, nil
+}
+
+func (u *UserRatingService) GetUserRatings(
+	ctx context.Context,
+	userID, count, page int,
+) (*dto.UserRatingList, error) {
+	ctx, span := tracing.Start(ctx, "UserRatingService.GetUserRatings")
+	defer span.End()
+	ratings, err := u.ratingRepo.GetRatingsByUserID(ctx, userID, page, count)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении оценок пользователя"), err)
+	}
+	contentIDs := make([]int, len(ratings))
+	for i, rating := range ratings {
+		contentIDs[i] = rating.ContentID
+	}
+	previews, err := u.contentUC.GetPreviewContents(ctx, contentIDs)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении оцененного контента"), err)
+	}
+	response := &dto.UserRatingList{Ratings: make([]dto.RatedContent, len(ratings))}
+	for i, rating := range ratings {
+		response.Ratings[i] = dto.RatedContent{
+			UserRating: userRatingEntityToDTO(rating),
+			Content:    *previews[i],
+		}
+	}
+	response.Total, err = u.ratingRepo.GetRatingsCountByUserID(ctx, userID)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении количества оценок пользователя"), err)
+	}
+	response.Page = page
+	response.Count = len(ratings)
+	response.Pages = (response.Total + count - 1) / count
+	return response, nil
+}
+
+// getSeriesPartContentID возвращает id сериала, к которому относится сезон или эпизод
+func (u *UserRatingService) getSeriesPartContentID(ctx context.Context, part string, partID int) (int, error) {
+	seriesPart := entity.SeriesPart(part)
+	if seriesPart != entity.SeriesPartSeason && seriesPart != entity.SeriesPartEpisode {
+		return 0, usecase.ErrUserRatingSeriesPartNotFound
+	}
+	contentID, err := u.ratingRepo.GetSeriesPartContentID(ctx, seriesPart, partID)
+	switch {
+	case errors.Is(err, repository.ErrUserRatingSeriesPartNotFound):
+		return 0, usecase.ErrUserRatingSeriesPartNotFound
+	case err != nil:
+		return 0, entity.UsecaseWrap(errors.New("ошибка при получении сериала"), err)
+	}
+	return contentID, nil
+}
+
+func (u *UserRatingService) SetSeriesPartRating(ctx context.Context, set dto.SeriesPartRatingSet) error {
+	ctx, span := tracing.Start(ctx, "UserRatingService.SetSeriesPartRating")
+	defer span.End()
+	if err := entity.ValidateReviewRating(set.Rating); err != nil {
+		return usecase.ErrUserRatingOutOfRange
+	}
+	contentID, err := u.getSeriesPartContentID(ctx, set.Part, set.PartID)
+	if err != nil {
+		return err
+	}
+	err = u.ratingRepo.SetSeriesPartRating(ctx, entity.SeriesPart(set.Part), set.UserID, set.PartID, set.Rating)
+	switch {
+	case errors.Is(err, repository.ErrUserRatingSeriesPartNotFound):
+		return usecase.ErrUserRatingSeriesPartNotFound
+	case err != nil:
+		return entity.UsecaseWrap(errors.New("ошибка при сохранении оценки"), err)
+	}
+	// средние оценки сезонов и эпизодов хранятся в кэше вместе с сериалом
+	invalidateContent(ctx, u.contentRepo, contentID)
+	return nil
+}
+
+func (u *UserRatingService) DeleteSeriesPartRating(ctx context.Context, part string, userID, partID int) error {
+	ctx, span := tracing.Start(ctx, "UserRatingService.DeleteSeriesPartRating")
+	defer span.End()
+	contentID, err := u.getSeriesPartContentID(ctx, part, partID)
+	if err != nil {
+		return err
+	}
+	err = u.ratingRepo.DeleteSeriesPartRating(ctx, entity.SeriesPart(part), userID, partID)
+	switch {
+	case errors.Is(err, repository.ErrUserRatingNotFound):
+		return usecase.ErrUserRatingNotFound
+	case err != nil:
+		return entity.UsecaseWrap(errors.New("ошибка при удалении оценки"), err)
+	}
+	invalidateContent(ctx, u.contentRepo, contentID)
+	return nil
+}
@@ -0,0 +1,368 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	mockrepo "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository/mocks"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	mock_usecase "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestUserRatingService_SetRating(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Now()
+
+	testCases := []struct {
+		Name                 string
+		Set                  dto.UserRatingSet
+		ExpectedOutput       *dto.UserRating
+		ExpectedErr          error
+		SetupRatingRepoMock  func(repo *mockrepo.MockUserRating)
+		SetupContentRepoMock func(repo *mockrepo.MockContent)
+		SetupContentUCMock   func(uc *mock_usecase.MockContent)
+	}{
+		{
+			Name: "Успешная оценка",
+			Set: dto.UserRatingSet{
+				UserRatingRequest: dto.UserRatingRequest{Rating: 8},
+				ContentID:         2,
+				UserID:            1,
+			},
+			ExpectedOutput: &dto.UserRating{
+				ContentID: 2,
+				Rating:    8,
+				CreatedAt: fixedTime.String(),
+				UpdatedAt: fixedTime.String(),
+			},
+			ExpectedErr: nil,
+			SetupRatingRepoMock: func(repo *mockrepo.MockUserRating) {
+				repo.EXPECT().SetRating(gomock.Any(), &entity.UserRating{UserID: 1, ContentID: 2, Rating: 8}).
+					Return(&entity.UserRating{
+						UserID:    1,
+						ContentID: 2,
+						Rating:    8,
+						CreatedAt: fixedTime,
+						UpdatedAt: fixedTime,
+					}, nil)
+			},
+			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
+				repo.EXPECT().InvalidateContent(gomock.Any(), 2).Return(nil)
+			},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {
+				uc.EXPECT().GetPreviewContentByID(gomock.Any(), 2).Return(&dto.PreviewContent{ID: 2}, nil)
+			},
+		},
+		{
+			Name: "Оценка вне диапазона",
+			Set: dto.UserRatingSet{
+				UserRatingRequest: dto.UserRatingRequest{Rating: 11},
+				ContentID:         2,
+				UserID:            1,
+			},
+			ExpectedOutput:       nil,
+			ExpectedErr:          usecase.ErrUserRatingOutOfRange,
+			SetupRatingRepoMock:  func(repo *mockrepo.MockUserRating) {},
+			SetupContentRepoMock: func(repo *mockrepo.MockContent) {},
+			SetupContentUCMock:   func(uc *mock_usecase.MockContent) {},
+		},
+		{
+			Name: "Контент еще не вышел",
+			Set: dto.UserRatingSet{
+				UserRatingRequest: dto.UserRatingRequest{Rating: 8},
+				ContentID:         2,
+				UserID:            1,
+			},
+			ExpectedOutput:       nil,
+			ExpectedErr:          usecase.ErrUserRatingContentNotFound,
+			SetupRatingRepoMock:  func(repo *mockrepo.MockUserRating) {},
+			SetupContentRepoMock: func(repo *mockrepo.MockContent) {},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {
+				uc.EXPECT().GetPreviewContentByID(gomock.Any(), 2).Return(&dto.PreviewContent{ID: 2, Ongoing: true}, nil)
+			},
+		},
+		{
+			Name: "Контент не найден",
+			Set: dto.UserRatingSet{
+				UserRatingRequest: dto.UserRatingRequest{Rating: 8},
+				ContentID:         2,
+				UserID:            1,
+			},
+			ExpectedOutput:       nil,
+			ExpectedErr:          usecase.ErrUserRatingContentNotFound,
+			SetupRatingRepoMock:  func(repo *mockrepo.MockUserRating) {},
+			SetupContentRepoMock: func(repo *mockrepo.MockContent) {},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {
+				uc.EXPECT().GetPreviewContentByID(gomock.Any(), 2).Return(nil, usecase.ErrContentNotFound)
+			},
+		},
+		{
+			Name: "Ошибка при сохранении",
+			Set: dto.UserRatingSet{
+				UserRatingRequest: dto.UserRatingRequest{Rating: 8},
+				ContentID:         2,
+				UserID:            1,
+			},
+			ExpectedOutput: nil,
+			ExpectedErr: entity.UsecaseWrap(
+				errors.New("ошибка при сохранении оценки"),
+				errors.New("database error"),
+			),
+			SetupRatingRepoMock: func(repo *mockrepo.MockUserRating) {
+				repo.EXPECT().SetRating(gomock.Any(), gomock.Any()).Return(nil, errors.New("database error"))
+			},
+			SetupContentRepoMock: func(repo *mockrepo.MockContent) {},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {
+				uc.EXPECT().GetPreviewContentByID(gomock.Any(), 2).Return(&dto.PreviewContent{ID: 2}, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRatingRepo := mockrepo.NewMockUserRating(ctrl)
+			mockContentRepo := mockrepo.NewMockContent(ctrl)
+			mockContentUC := mock_usecase.NewMockContent(ctrl)
+			tc.SetupRatingRepoMock(mockRatingRepo)
+			tc.SetupContentRepoMock(mockContentRepo)
+			tc.SetupContentUCMock(mockContentUC)
+			mockActivityRepo := mockrepo.NewMockActivity(ctrl)
+			mockActivityRepo.EXPECT().AddActivity(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			mockStatsRepo := mockrepo.NewMockUserStats(ctrl)
+			mockStatsRepo.EXPECT().InvalidateUserStats(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+			service := NewUserRatingService(
+				mockRatingRepo, mockContentRepo, mockContentUC, mockActivityRepo, mockStatsRepo,
+			)
+			output, err := service.SetRating(context.Background(), tc.Set)
+			require.Equal(t, tc.ExpectedOutput, output)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestUserRatingService_DeleteRating(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                 string
+		ExpectedErr          error
+		SetupRatingRepoMock  func(repo *mockrepo.MockUserRating)
+		SetupContentRepoMock func(repo *mockrepo.MockContent)
+		SetupStatsRepoMock   func(repo *mockrepo.MockUserStats)
+	}{
+		{
+			Name:        "Успешное удаление",
+			ExpectedErr: nil,
+			SetupRatingRepoMock: func(repo *mockrepo.MockUserRating) {
+				repo.EXPECT().DeleteRating(gomock.Any(), 1, 2).Return(nil)
+			},
+			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
+				repo.EXPECT().InvalidateContent(gomock.Any(), 2).Return(errors.New("redis error"))
+			},
+			SetupStatsRepoMock: func(repo *mockrepo.MockUserStats) {
+				repo.EXPECT().
+					InvalidateUserStats(gomock.Any(), 1, entity.UserStatsSectionRatings, entity.UserStatsSectionActivity).
+					Return(errors.New("redis error"))
+			},
+		},
+		{
+			Name:        "Оценка не найдена",
+			ExpectedErr: usecase.ErrUserRatingNotFound,
+			SetupRatingRepoMock: func(repo *mockrepo.MockUserRating) {
+				repo.EXPECT().DeleteRating(gomock.Any(), 1, 2).Return(repository.ErrUserRatingNotFound)
+			},
+			SetupContentRepoMock: func(repo *mockrepo.MockContent) {},
+			SetupStatsRepoMock:   func(repo *mockrepo.MockUserStats) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRatingRepo := mockrepo.NewMockUserRating(ctrl)
+			mockContentRepo := mockrepo.NewMockContent(ctrl)
+			tc.SetupRatingRepoMock(mockRatingRepo)
+			tc.SetupContentRepoMock(mockContentRepo)
+			mockStatsRepo := mockrepo.NewMockUserStats(ctrl)
+			tc.SetupStatsRepoMock(mockStatsRepo)
+			service := NewUserRatingService(mockRatingRepo, mockContentRepo, nil, nil, mockStatsRepo)
+			err := service.DeleteRating(context.Background(), 1, 2)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestUserRatingService_SetSeriesPartRating(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                 string
+		Set                  dto.SeriesPartRatingSet
+		ExpectedErr          error
+		SetupRatingRepoMock  func(repo *mockrepo.MockUserRating)
+		SetupContentRepoMock func(repo *mockrepo.MockContent)
+	}{
+		{
+			Name: "Успешная оценка эпизода",
+			Set: dto.SeriesPartRatingSet{
+				UserRatingRequest: dto.UserRatingRequest{Rating: 9},
+				Part:              "episode",
+				PartID:            5,
+				UserID:            1,
+			},
+			ExpectedErr: nil,
+			SetupRatingRepoMock: func(repo *mockrepo.MockUserRating) {
+				repo.EXPECT().GetSeriesPartContentID(gomock.Any(), entity.SeriesPartEpisode, 5).Return(2, nil)
+				repo.EXPECT().SetSeriesPartRating(gomock.Any(), entity.SeriesPartEpisode, 1, 5, 9).Return(nil)
+			},
+			SetupContentRepoMock: func(repo *mockrepo.MockContent) {
+				repo.EXPECT().InvalidateContent(gomock.Any(), 2).Return(nil)
+			},
+		},
+		{
+			Name: "Оценка вне диапазона",
+			Set: dto.SeriesPartRatingSet{
+				UserRatingRequest: dto.UserRatingRequest{Rating: 0},
+				Part:              "season",
+				PartID:            5,
+				UserID:            1,
+			},
+			ExpectedErr:          usecase.ErrUserRatingOutOfRange,
+			SetupRatingRepoMock:  func(repo *mockrepo.MockUserRating) {},
+			SetupContentRepoMock: func(repo *mockrepo.MockContent) {},
+		},
+		{
+			Name: "Неизвестная часть сериала",
+			Set: dto.SeriesPartRatingSet{
+				UserRatingRequest: dto.UserRatingRequest{Rating: 9},
+				Part:              "series",
+				PartID:            5,
+				UserID:            1,
+			},
+			ExpectedErr:          usecase.ErrUserRatingSeriesPartNotFound,
+			SetupRatingRepoMock:  func(repo *mockrepo.MockUserRating) {},
+			SetupContentRepoMock: func(repo *mockrepo.MockContent) {},
+		},
+		{
+			Name: "Сезон не найден",
+			Set: dto.SeriesPartRatingSet{
+				UserRatingRequest: dto.UserRatingRequest{Rating: 9},
+				Part:              "season",
+				PartID:            5,
+				UserID:            1,
+			},
+			ExpectedErr: usecase.ErrUserRatingSeriesPartNotFound,
+			SetupRatingRepoMock: func(repo *mockrepo.MockUserRating) {
+				repo.EXPECT().GetSeriesPartContentID(gomock.Any(), entity.SeriesPartSeason, 5).
+					Return(0, repository.ErrUserRatingSeriesPartNotFound)
+			},
+			SetupContentRepoMock: func(repo *mockrepo.MockContent) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRatingRepo := mockrepo.NewMockUserRating(ctrl)
+			mockContentRepo := mockrepo.NewMockContent(ctrl)
+			tc.SetupRatingRepoMock(mockRatingRepo)
+			tc.SetupContentRepoMock(mockContentRepo)
+			service := NewUserRatingService(mockRatingRepo, mockContentRepo, nil, nil, nil)
+			err := service.SetSeriesPartRating(context.Background(), tc.Set)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
+
+func TestUserRatingService_GetUserRatings(t *testing.T) {
+	t.Parallel()
+
+	fixedTime := time.Now()
+
+	testCases := []struct {
+		Name                string
+		ExpectedOutput      *dto.UserRatingList
+		ExpectedErr         error
+		SetupRatingRepoMock func(repo *mockrepo.MockUserRating)
+		SetupContentUCMock  func(uc *mock_usecase.MockContent)
+	}{
+		{
+			Name: "Успешное получение",
+			ExpectedOutput: &dto.UserRatingList{
+				Ratings: []dto.RatedContent{
+					{
+						UserRating: dto.UserRating{
+							ContentID: 2,
+							Rating:    8,
+							CreatedAt: fixedTime.String(),
+							UpdatedAt: fixedTime.String(),
+						},
+						Content: dto.PreviewContent{ID: 2, Title: "Бэтмен"},
+					},
+				},
+				Page:  1,
+				Count: 1,
+				Pages: 1,
+				Total: 1,
+			},
+			ExpectedErr: nil,
+			SetupRatingRepoMock: func(repo *mockrepo.MockUserRating) {
+				repo.EXPECT().GetRatingsByUserID(gomock.Any(), 1, 1, 20).Return([]*entity.UserRating{
+					{UserID: 1, ContentID: 2, Rating: 8, CreatedAt: fixedTime, UpdatedAt: fixedTime},
+				}, nil)
+				repo.EXPECT().GetRatingsCountByUserID(gomock.Any(), 1).Return(1, nil)
+			},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {
+				uc.EXPECT().GetPreviewContents(gomock.Any(), []int{2}).
+					Return([]*dto.PreviewContent{{ID: 2, Title: "Бэтмен"}}, nil)
+			},
+		},
+		{
+			Name:           "Ошибка при получении оценок",
+			ExpectedOutput: nil,
+			ExpectedErr: entity.UsecaseWrap(
+				errors.New("ошибка при получении оценок пользователя"),
+				errors.New("database error"),
+			),
+			SetupRatingRepoMock: func(repo *mockrepo.MockUserRating) {
+				repo.EXPECT().GetRatingsByUserID(gomock.Any(), 1, 1, 20).Return(nil, errors.New("database error"))
+			},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockRatingRepo := mockrepo.NewMockUserRating(ctrl)
+			mockContentUC := mock_usecase.NewMockContent(ctrl)
+			tc.SetupRatingRepoMock(mockRatingRepo)
+			tc.SetupContentUCMock(mockContentUC)
+			service := NewUserRatingService(mockRatingRepo, nil, mockContentUC, nil, nil)
+			output, err := service.GetUserRatings(context.Background(), 1, 20, 1)
+			require.Equal(t, tc.ExpectedOutput, output)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
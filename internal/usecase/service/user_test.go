@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
@@ -30,7 +31,7 @@ func TestUserService_Register(t *testing.T) {
 			ExpectedID:  1,
 			ExpectedErr: nil,
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
-				repo.EXPECT().AddUser(gomock.Any(), gomock.Any(), gomock.Any()).Return(&entity.User{ID: 1}, nil)
+				repo.EXPECT().AddUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&entity.User{ID: 1}, nil)
 			},
 		},
 		{
@@ -53,7 +54,7 @@ func TestUserService_Register(t *testing.T) {
 			ExpectedID:  -1,
 			ExpectedErr: usecase.ErrUserAlreadyExists,
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
-				repo.EXPECT().AddUser(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, repository.ErrUserAlreadyExists)
+				repo.EXPECT().AddUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, repository.ErrUserAlreadyExists)
 			},
 		},
 		{
@@ -62,7 +63,7 @@ func TestUserService_Register(t *testing.T) {
 			ExpectedID:  -1,
 			ExpectedErr: usecase.UserIncorrectDataError{Err: repository.ErrUserIncorrectData},
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
-				repo.EXPECT().AddUser(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, repository.ErrUserIncorrectData)
+				repo.EXPECT().AddUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, repository.ErrUserIncorrectData)
 			},
 		},
 		{
@@ -71,7 +72,7 @@ func TestUserService_Register(t *testing.T) {
 			ExpectedID:  -1,
 			ExpectedErr: entity.UsecaseWrap(errors.New("ошибка при регистрации пользователя"), errors.New("123")),
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
-				repo.EXPECT().AddUser(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("123"))
+				repo.EXPECT().AddUser(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.New("123"))
 			},
 		},
 	}
@@ -85,7 +86,7 @@ func TestUserService_Register(t *testing.T) {
 			mockUserRepo := mockrepo.NewMockUser(ctrl)
 			userService := NewUserService(mockUserRepo, nil)
 			tc.SetupUserRepoMock(mockUserRepo)
-			id, err := userService.Register(tc.Input)
+			id, err := userService.Register(context.Background(), tc.Input)
 			require.Equal(t, tc.ExpectedErr, err)
 			require.Equal(t, tc.ExpectedID, id)
 		})
@@ -109,7 +110,7 @@ func TestUserService_Login(t *testing.T) {
 			ExpectedErr: nil,
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
 				salt, hash, _ := entity.HashPassword("AmazingPassword123!")
-				repo.EXPECT().GetUserByEmail(gomock.Any()).Return(&entity.User{ID: 1, PasswordHash: hash, PasswordSalt: salt}, nil)
+				repo.EXPECT().GetUserByEmail(gomock.Any(), gomock.Any()).Return(&entity.User{ID: 1, PasswordHash: hash, PasswordSalt: salt}, nil)
 			},
 		},
 		{
@@ -119,7 +120,7 @@ func TestUserService_Login(t *testing.T) {
 			ExpectedErr: usecase.UserIncorrectDataError{Err: errors.New("неверный пароль")},
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
 				salt, hash, _ := entity.HashPassword("BadPassword1!")
-				repo.EXPECT().GetUserByEmail(gomock.Any()).Return(&entity.User{ID: 1, PasswordHash: hash, PasswordSalt: salt}, nil)
+				repo.EXPECT().GetUserByEmail(gomock.Any(), gomock.Any()).Return(&entity.User{ID: 1, PasswordHash: hash, PasswordSalt: salt}, nil)
 			},
 		},
 		{
@@ -128,7 +129,7 @@ func TestUserService_Login(t *testing.T) {
 			ExpectedID:  -1,
 			ExpectedErr: usecase.ErrUserNotFound,
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
-				repo.EXPECT().GetUserByEmail(gomock.Any()).Return(nil, repository.ErrUserNotFound)
+				repo.EXPECT().GetUserByEmail(gomock.Any(), gomock.Any()).Return(nil, repository.ErrUserNotFound)
 			},
 		},
 		{
@@ -137,7 +138,7 @@ func TestUserService_Login(t *testing.T) {
 			ExpectedID:  -1,
 			ExpectedErr: entity.UsecaseWrap(errors.New("ошибка при поиске пользователя"), errors.New("error")),
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
-				repo.EXPECT().GetUserByEmail(gomock.Any()).Return(nil, errors.New("error"))
+				repo.EXPECT().GetUserByEmail(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
 			},
 		},
 	}
@@ -151,7 +152,7 @@ func TestUserService_Login(t *testing.T) {
 			mockUserRepo := mockrepo.NewMockUser(ctrl)
 			userService := NewUserService(mockUserRepo, nil)
 			tc.SetupUserRepoMock(mockUserRepo)
-			id, err := userService.Login(tc.Input)
+			id, err := userService.Login(context.Background(), tc.Input)
 			require.Equal(t, tc.ExpectedErr, err)
 			require.Equal(t, tc.ExpectedID, id)
 		})
@@ -175,11 +176,11 @@ func TestUserService_UpdateAvatar(t *testing.T) {
 			Upload:      nil,
 			ExpectedErr: nil,
 			SetupStaticUCMock: func(staticUC *mock_usecase.MockStatic) {
-				staticUC.EXPECT().UploadAvatar(gomock.Any()).Return(1, nil)
+				staticUC.EXPECT().UploadAvatar(gomock.Any(), gomock.Any()).Return(1, nil)
 			},
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
-				repo.EXPECT().GetUserByID(gomock.Any()).Return(&entity.User{ID: 1}, nil)
-				repo.EXPECT().UpdateUser(gomock.Any()).Return(nil)
+				repo.EXPECT().GetUserByID(gomock.Any(), gomock.Any()).Return(&entity.User{ID: 1}, nil)
+				repo.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Return(nil)
 			},
 		},
 		{
@@ -189,7 +190,7 @@ func TestUserService_UpdateAvatar(t *testing.T) {
 			ExpectedErr:       usecase.ErrUserNotFound,
 			SetupStaticUCMock: func(staticUC *mock_usecase.MockStatic) {},
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
-				repo.EXPECT().GetUserByID(gomock.Any()).Return(nil, repository.ErrUserNotFound)
+				repo.EXPECT().GetUserByID(gomock.Any(), gomock.Any()).Return(nil, repository.ErrUserNotFound)
 			},
 		},
 		{
@@ -199,7 +200,7 @@ func TestUserService_UpdateAvatar(t *testing.T) {
 			ExpectedErr:       entity.UsecaseWrap(errors.New("ошибка при поиске пользователя"), errors.New("error")),
 			SetupStaticUCMock: func(staticUC *mock_usecase.MockStatic) {},
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
-				repo.EXPECT().GetUserByID(gomock.Any()).Return(nil, errors.New("error"))
+				repo.EXPECT().GetUserByID(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
 			},
 		},
 		{
@@ -208,10 +209,10 @@ func TestUserService_UpdateAvatar(t *testing.T) {
 			Upload:      nil,
 			ExpectedErr: usecase.UserIncorrectDataError{Err: usecase.ErrStaticTooBigFile},
 			SetupStaticUCMock: func(staticUC *mock_usecase.MockStatic) {
-				staticUC.EXPECT().UploadAvatar(gomock.Any()).Return(0, usecase.ErrStaticTooBigFile)
+				staticUC.EXPECT().UploadAvatar(gomock.Any(), gomock.Any()).Return(0, usecase.ErrStaticTooBigFile)
 			},
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
-				repo.EXPECT().GetUserByID(gomock.Any()).Return(&entity.User{ID: 1}, nil)
+				repo.EXPECT().GetUserByID(gomock.Any(), gomock.Any()).Return(&entity.User{ID: 1}, nil)
 			},
 		},
 		{
@@ -220,10 +221,10 @@ func TestUserService_UpdateAvatar(t *testing.T) {
 			Upload:      nil,
 			ExpectedErr: usecase.UserIncorrectDataError{Err: usecase.ErrStaticNotImage},
 			SetupStaticUCMock: func(staticUC *mock_usecase.MockStatic) {
-				staticUC.EXPECT().UploadAvatar(gomock.Any()).Return(0, usecase.ErrStaticNotImage)
+				staticUC.EXPECT().UploadAvatar(gomock.Any(), gomock.Any()).Return(0, usecase.ErrStaticNotImage)
 			},
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
-				repo.EXPECT().GetUserByID(gomock.Any()).Return(&entity.User{ID: 1}, nil)
+				repo.EXPECT().GetUserByID(gomock.Any(), gomock.Any()).Return(&entity.User{ID: 1}, nil)
 			},
 		},
 		{
@@ -232,10 +233,10 @@ func TestUserService_UpdateAvatar(t *testing.T) {
 			Upload:      nil,
 			ExpectedErr: usecase.UserIncorrectDataError{Err: usecase.ErrStaticImageDimensions},
 			SetupStaticUCMock: func(staticUC *mock_usecase.MockStatic) {
-				staticUC.EXPECT().UploadAvatar(gomock.Any()).Return(0, usecase.ErrStaticImageDimensions)
+				staticUC.EXPECT().UploadAvatar(gomock.Any(), gomock.Any()).Return(0, usecase.ErrStaticImageDimensions)
 			},
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
-				repo.EXPECT().GetUserByID(gomock.Any()).Return(&entity.User{ID: 1}, nil)
+				repo.EXPECT().GetUserByID(gomock.Any(), gomock.Any()).Return(&entity.User{ID: 1}, nil)
 			},
 		},
 		{
@@ -244,10 +245,10 @@ func TestUserService_UpdateAvatar(t *testing.T) {
 			Upload:      nil,
 			ExpectedErr: entity.UsecaseWrap(errors.New("ошибка при загрузке аватара"), errors.New("error")),
 			SetupStaticUCMock: func(staticUC *mock_usecase.MockStatic) {
-				staticUC.EXPECT().UploadAvatar(gomock.Any()).Return(0, errors.New("error"))
+				staticUC.EXPECT().UploadAvatar(gomock.Any(), gomock.Any()).Return(0, errors.New("error"))
 			},
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
-				repo.EXPECT().GetUserByID(gomock.Any()).Return(&entity.User{ID: 1}, nil)
+				repo.EXPECT().GetUserByID(gomock.Any(), gomock.Any()).Return(&entity.User{ID: 1}, nil)
 			},
 		},
 		{
@@ -256,11 +257,11 @@ func TestUserService_UpdateAvatar(t *testing.T) {
 			Upload:      nil,
 			ExpectedErr: entity.UsecaseWrap(errors.New("ошибка при обновлении пользователя"), errors.New("error")),
 			SetupStaticUCMock: func(staticUC *mock_usecase.MockStatic) {
-				staticUC.EXPECT().UploadAvatar(gomock.Any()).Return(1, nil)
+				staticUC.EXPECT().UploadAvatar(gomock.Any(), gomock.Any()).Return(1, nil)
 			},
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
-				repo.EXPECT().GetUserByID(gomock.Any()).Return(&entity.User{ID: 1}, nil)
-				repo.EXPECT().UpdateUser(gomock.Any()).Return(errors.New("error"))
+				repo.EXPECT().GetUserByID(gomock.Any(), gomock.Any()).Return(&entity.User{ID: 1}, nil)
+				repo.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Return(errors.New("error"))
 			},
 		},
 	}
@@ -276,7 +277,7 @@ func TestUserService_UpdateAvatar(t *testing.T) {
 			userService := NewUserService(mockUserRepo, staticService)
 			tc.SetupStaticUCMock(staticService)
 			tc.SetupUserRepoMock(mockUserRepo)
-			err := userService.UpdateAvatar(tc.UserID, tc.Upload)
+			err := userService.UpdateAvatar(context.Background(), tc.UserID, tc.Upload)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
 	}
@@ -298,8 +299,8 @@ func TestUserService_UpdateInfo(t *testing.T) {
 			Update:      &dto.UserUpdate{Name: "name", Email: "email@email.com"},
 			ExpectedErr: nil,
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
-				repo.EXPECT().GetUserByID(gomock.Any()).Return(&entity.User{ID: 1}, nil)
-				repo.EXPECT().UpdateUser(gomock.Any()).Return(nil)
+				repo.EXPECT().GetUserByID(gomock.Any(), gomock.Any()).Return(&entity.User{ID: 1}, nil)
+				repo.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Return(nil)
 			},
 		},
 		{
@@ -308,7 +309,7 @@ func TestUserService_UpdateInfo(t *testing.T) {
 			Update:      &dto.UserUpdate{Name: "name", Email: "email@email.com"},
 			ExpectedErr: usecase.ErrUserNotFound,
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
-				repo.EXPECT().GetUserByID(gomock.Any()).Return(nil, repository.ErrUserNotFound)
+				repo.EXPECT().GetUserByID(gomock.Any(), gomock.Any()).Return(nil, repository.ErrUserNotFound)
 			},
 		},
 		{
@@ -317,7 +318,7 @@ func TestUserService_UpdateInfo(t *testing.T) {
 			Update:      &dto.UserUpdate{Name: "name", Email: "email@email.com"},
 			ExpectedErr: entity.UsecaseWrap(errors.New("ошибка при поиске пользователя"), errors.New("error")),
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
-				repo.EXPECT().GetUserByID(gomock.Any()).Return(nil, errors.New("error"))
+				repo.EXPECT().GetUserByID(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
 			},
 		},
 		{
@@ -326,7 +327,7 @@ func TestUserService_UpdateInfo(t *testing.T) {
 			Update:      &dto.UserUpdate{Name: "name", Email: "email"},
 			ExpectedErr: usecase.UserIncorrectDataError{Err: errors.New("невалидная почта")},
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
-				repo.EXPECT().GetUserByID(gomock.Any()).Return(&entity.User{ID: 1}, nil)
+				repo.EXPECT().GetUserByID(gomock.Any(), gomock.Any()).Return(&entity.User{ID: 1}, nil)
 			},
 		},
 		{
@@ -335,7 +336,7 @@ func TestUserService_UpdateInfo(t *testing.T) {
 			Update:      &dto.UserUpdate{Name: "VeryVeryVeryVeryVeryVeryVeryVeryLongName", Email: "email@email.com"},
 			ExpectedErr: usecase.UserIncorrectDataError{Err: errors.New("имя не может быть длиннее 30 символов")},
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
-				repo.EXPECT().GetUserByID(gomock.Any()).Return(&entity.User{ID: 1}, nil)
+				repo.EXPECT().GetUserByID(gomock.Any(), gomock.Any()).Return(&entity.User{ID: 1}, nil)
 			},
 		},
 		{
@@ -344,8 +345,8 @@ func TestUserService_UpdateInfo(t *testing.T) {
 			Update:      &dto.UserUpdate{Name: "name", Email: "email@email.com"},
 			ExpectedErr: entity.UsecaseWrap(errors.New("ошибка при обновлении пользователя"), errors.New("error")),
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
-				repo.EXPECT().GetUserByID(gomock.Any()).Return(&entity.User{ID: 1}, nil)
-				repo.EXPECT().UpdateUser(gomock.Any()).Return(errors.New("error"))
+				repo.EXPECT().GetUserByID(gomock.Any(), gomock.Any()).Return(&entity.User{ID: 1}, nil)
+				repo.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Return(errors.New("error"))
 			},
 		},
 	}
@@ -359,7 +360,7 @@ func TestUserService_UpdateInfo(t *testing.T) {
 			mockUserRepo := mockrepo.NewMockUser(ctrl)
 			userService := NewUserService(mockUserRepo, nil)
 			tc.SetupUserRepoMock(mockUserRepo)
-			err := userService.UpdateInfo(tc.UserID, tc.Update)
+			err := userService.UpdateInfo(context.Background(), tc.UserID, tc.Update)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
 	}
@@ -381,8 +382,8 @@ func TestUserService_GetUser(t *testing.T) {
 			ExpectedProfile: &dto.UserProfile{ID: 1, Name: "name", Email: "email", Avatar: "avatar", Rating: 0},
 			ExpectedErr:     nil,
 			SetupUserRepoMock: func(userRepo *mockrepo.MockUser, reviewRepo *mockrepo.MockReview, staticUC *mock_usecase.MockStatic) {
-				userRepo.EXPECT().GetUserByID(gomock.Any()).Return(&entity.User{ID: 1, Name: "name", Email: "email", AvatarUploadID: 1}, nil)
-				staticUC.EXPECT().GetStatic(gomock.Any()).Return("avatar", nil)
+				userRepo.EXPECT().GetUserByID(gomock.Any(), gomock.Any()).Return(&entity.User{ID: 1, Name: "name", Email: "email", AvatarUploadID: 1}, nil)
+				staticUC.EXPECT().GetStatic(gomock.Any(), gomock.Any()).Return("avatar", nil)
 			},
 		},
 		{
@@ -391,7 +392,7 @@ func TestUserService_GetUser(t *testing.T) {
 			ExpectedProfile: nil,
 			ExpectedErr:     usecase.ErrUserNotFound,
 			SetupUserRepoMock: func(userRepo *mockrepo.MockUser, reviewRepo *mockrepo.MockReview, staticUC *mock_usecase.MockStatic) {
-				userRepo.EXPECT().GetUserByID(gomock.Any()).Return(nil, repository.ErrUserNotFound)
+				userRepo.EXPECT().GetUserByID(gomock.Any(), gomock.Any()).Return(nil, repository.ErrUserNotFound)
 			},
 		},
 		{
@@ -400,7 +401,7 @@ func TestUserService_GetUser(t *testing.T) {
 			ExpectedProfile: nil,
 			ExpectedErr:     entity.UsecaseWrap(errors.New("ошибка при поиске пользователя"), errors.New("error")),
 			SetupUserRepoMock: func(userRepo *mockrepo.MockUser, reviewRepo *mockrepo.MockReview, staticUC *mock_usecase.MockStatic) {
-				userRepo.EXPECT().GetUserByID(gomock.Any()).Return(nil, errors.New("error"))
+				userRepo.EXPECT().GetUserByID(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
 			},
 		},
 		{
@@ -409,8 +410,8 @@ func TestUserService_GetUser(t *testing.T) {
 			ExpectedProfile: &dto.UserProfile{ID: 1, Name: "name", Email: "email", Avatar: "", Rating: 0},
 			ExpectedErr:     nil,
 			SetupUserRepoMock: func(userRepo *mockrepo.MockUser, reviewRepo *mockrepo.MockReview, staticUC *mock_usecase.MockStatic) {
-				userRepo.EXPECT().GetUserByID(gomock.Any()).Return(&entity.User{ID: 1, Name: "name", Email: "email", AvatarUploadID: 1}, nil)
-				staticUC.EXPECT().GetStatic(gomock.Any()).Return("", usecase.ErrStaticNotFound)
+				userRepo.EXPECT().GetUserByID(gomock.Any(), gomock.Any()).Return(&entity.User{ID: 1, Name: "name", Email: "email", AvatarUploadID: 1}, nil)
+				staticUC.EXPECT().GetStatic(gomock.Any(), gomock.Any()).Return("", usecase.ErrStaticNotFound)
 			},
 		},
 		{
@@ -419,8 +420,8 @@ func TestUserService_GetUser(t *testing.T) {
 			ExpectedProfile: nil,
 			ExpectedErr:     entity.UsecaseWrap(errors.New("ошибка при получении аватара"), errors.New("error")),
 			SetupUserRepoMock: func(userRepo *mockrepo.MockUser, reviewRepo *mockrepo.MockReview, staticUC *mock_usecase.MockStatic) {
-				userRepo.EXPECT().GetUserByID(gomock.Any()).Return(&entity.User{ID: 1, Name: "name", Email: "email", AvatarUploadID: 1}, nil)
-				staticUC.EXPECT().GetStatic(gomock.Any()).Return("", errors.New("error"))
+				userRepo.EXPECT().GetUserByID(gomock.Any(), gomock.Any()).Return(&entity.User{ID: 1, Name: "name", Email: "email", AvatarUploadID: 1}, nil)
+				staticUC.EXPECT().GetStatic(gomock.Any(), gomock.Any()).Return("", errors.New("error"))
 			},
 		},
 	}
@@ -436,7 +437,7 @@ func TestUserService_GetUser(t *testing.T) {
 			mockStaticUC := mock_usecase.NewMockStatic(ctrl)
 			userService := NewUserService(mockUserRepo, mockStaticUC)
 			tc.SetupUserRepoMock(mockUserRepo, mockReviewRepo, mockStaticUC)
-			profile, err := userService.GetUser(tc.UserID)
+			profile, err := userService.GetUser(context.Background(), tc.UserID)
 			require.Equal(t, tc.ExpectedErr, err)
 			require.Equal(t, tc.ExpectedProfile, profile)
 		})
@@ -460,8 +461,8 @@ func TestUserService_UpdatePassword(t *testing.T) {
 			ExpectedErr: nil,
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
 				salt, hash, _ := entity.HashPassword("AmazingPassword123!")
-				repo.EXPECT().GetUserByID(gomock.Any()).Return(&entity.User{ID: 1, PasswordHash: hash, PasswordSalt: salt}, nil)
-				repo.EXPECT().UpdateUser(gomock.Any()).Return(nil)
+				repo.EXPECT().GetUserByID(gomock.Any(), gomock.Any()).Return(&entity.User{ID: 1, PasswordHash: hash, PasswordSalt: salt}, nil)
+				repo.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Return(nil)
 			},
 		},
 		{
@@ -471,8 +472,8 @@ func TestUserService_UpdatePassword(t *testing.T) {
 			ExpectedErr: entity.UsecaseWrap(errors.New("ошибка при обновлении пользователя"), errors.New("error")),
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
 				salt, hash, _ := entity.HashPassword("AmazingPassword123!")
-				repo.EXPECT().GetUserByID(gomock.Any()).Return(&entity.User{ID: 1, PasswordHash: hash, PasswordSalt: salt}, nil)
-				repo.EXPECT().UpdateUser(gomock.Any()).Return(errors.New("error"))
+				repo.EXPECT().GetUserByID(gomock.Any(), gomock.Any()).Return(&entity.User{ID: 1, PasswordHash: hash, PasswordSalt: salt}, nil)
+				repo.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Return(errors.New("error"))
 			},
 		},
 		{
@@ -482,7 +483,7 @@ func TestUserService_UpdatePassword(t *testing.T) {
 			ExpectedErr: usecase.UserIncorrectDataError{Err: errors.New("пароль должен содержать не менее 8 символов")},
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
 				salt, hash, _ := entity.HashPassword("AmazingPassword123!")
-				repo.EXPECT().GetUserByID(gomock.Any()).Return(&entity.User{ID: 1, PasswordHash: hash, PasswordSalt: salt}, nil)
+				repo.EXPECT().GetUserByID(gomock.Any(), gomock.Any()).Return(&entity.User{ID: 1, PasswordHash: hash, PasswordSalt: salt}, nil)
 			},
 		},
 		{
@@ -492,7 +493,7 @@ func TestUserService_UpdatePassword(t *testing.T) {
 			ExpectedErr: usecase.UserIncorrectDataError{Err: errors.New("неверный пароль")},
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
 				salt, hash, _ := entity.HashPassword("AmazingPassword1!")
-				repo.EXPECT().GetUserByID(gomock.Any()).Return(&entity.User{ID: 1, PasswordHash: hash, PasswordSalt: salt}, nil)
+				repo.EXPECT().GetUserByID(gomock.Any(), gomock.Any()).Return(&entity.User{ID: 1, PasswordHash: hash, PasswordSalt: salt}, nil)
 			},
 		},
 		{
@@ -501,7 +502,7 @@ func TestUserService_UpdatePassword(t *testing.T) {
 			Update:      &dto.UpdatePassword{OldPassword: "AmazingPassword123!", NewPassword: "AmazingPassword123!"},
 			ExpectedErr: entity.UsecaseWrap(errors.New("ошибка при поиске пользователя"), errors.New("error")),
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
-				repo.EXPECT().GetUserByID(gomock.Any()).Return(nil, errors.New("error"))
+				repo.EXPECT().GetUserByID(gomock.Any(), gomock.Any()).Return(nil, errors.New("error"))
 			},
 		},
 		{
@@ -510,7 +511,7 @@ func TestUserService_UpdatePassword(t *testing.T) {
 			Update:      &dto.UpdatePassword{OldPassword: "AmazingPassword123!", NewPassword: "AmazingPassword123!"},
 			ExpectedErr: usecase.ErrUserNotFound,
 			SetupUserRepoMock: func(repo *mockrepo.MockUser) {
-				repo.EXPECT().GetUserByID(gomock.Any()).Return(nil, repository.ErrUserNotFound)
+				repo.EXPECT().GetUserByID(gomock.Any(), gomock.Any()).Return(nil, repository.ErrUserNotFound)
 			},
 		},
 	}
@@ -524,7 +525,7 @@ func TestUserService_UpdatePassword(t *testing.T) {
 			mockUserRepo := mockrepo.NewMockUser(ctrl)
 			userService := NewUserService(mockUserRepo, nil)
 			tc.SetupUserRepoMock(mockUserRepo)
-			err := userService.UpdatePassword(tc.UserID, tc.Update)
+			err := userService.UpdatePassword(context.Background(), tc.UserID, tc.Update)
 			require.Equal(t, tc.ExpectedErr, err)
 		})
 	}
@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/logger"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/tracing"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+)
+
+type WatchProgressService struct {
+	progressRepo repository.WatchProgress
+	contentUC    usecase.Content
+	favouriteUC  usecase.Favourite
+	statsRepo    repository.UserStats
+}
+
+func NewWatchProgressService(
+	progressRepo repository.WatchProgress,
+	contentUC usecase.Content,
+	favouriteUC usecase.Favourite,
+	statsRepo repository.UserStats,
+) usecase.WatchProgress {
+	return &WatchProgressService{
+		progressRepo: progressRepo,
+		contentUC:    contentUC,
+		favouriteUC:  favouriteUC,
+		statsRepo:    statsRepo,
+	}
+}
+
+func episodeProgressEntityToDTO(episode entity.EpisodeProgress) dto.EpisodeProgress {
+	return dto.EpisodeProgress{
+		Episode: dto.Episode{
+			ID:            episode.ID,
+			EpisodeNumber: episode.EpisodeNumber,
+			Title:         episode.Title,
+			Duration:      episode.Duration,
+			Rating:        episode.Rating,
+			RatingCount:   episode.RatingCount,
+		},
+		Watched: episode.Watched,
+	}
+}
+
+func watchProgressEntityToDTO(progress *entity.WatchProgress) *dto.WatchProgress {
+	progressDTO := &dto.WatchProgress{
+		ContentID: progress.ContentID,
+		Watched:   progress.WatchedCount(),
+		Total:     len(progress.Episodes),
+		Completed: progress.Completed(),
+		Seasons:   make([]dto.SeasonProgress, 0),
+	}
+	// эпизоды упорядочены по сезонам, поэтому новый сезон начинается при смене SeasonID
+	for _, episode := range progress.Episodes {
+		last := len(progressDTO.Seasons) - 1
+		if last < 0 || progressDTO.Seasons[last].ID != episode.SeasonID {
+			progressDTO.Seasons = append(progressDTO.Seasons, dto.SeasonProgress{
+				ID:       episode.SeasonID,
+				Title:    episode.SeasonTitle,
+				Episodes: make([]dto.EpisodeProgress, 0),
+			})
+			last++
+		}
+		progressDTO.Seasons[last].Episodes = append(progressDTO.Seasons[last].Episodes,
+			episodeProgressEntityToDTO(episode))
+	}
+	return progressDTO
+}
+
+// getWatchProgress проверяет, что контент существует и является сериалом, и возвращает прогресс его просмотра
+func (w *WatchProgressService) getWatchProgress(
+	ctx context.Context,
+	userID, contentID int,
+) (*entity.WatchProgress, error) {
+	content, err := w.contentUC.GetPreviewContentByID(ctx, contentID)
+	switch {
+	case errors.Is(err, usecase.ErrContentNotFound):
+		return nil, usecase.ErrWatchProgressContentNotFound
+	case err != nil:
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении контента"), err)
+	case content.Type != entity.ContentTypeSeries:
+		return nil, usecase.ErrWatchProgressNotSeries
+	}
+	progress, err := w.progressRepo.GetWatchProgress(ctx, userID, contentID)
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при получении прогресса просмотра"), err)
+	}
+	return progress, nil
+}
+
+// selectEpisodes возвращает индексы эпизодов сериала, к которым относится запрос
+func selectEpisodes(progress *entity.WatchProgress, req dto.WatchProgressRequest) ([]int, error) {
+	selected := 0
+	for _, id := range []int{req.EpisodeID, req.SeasonID, req.UpToEpisodeID} {
+		if id != 0 {
+			selected++
+		}
+	}
+	if selected != 1 {
+		return nil, usecase.ErrWatchProgressInvalidRequest
+	}
+	indexes := make([]int, 0)
+	for i, episode := range progress.Episodes {
+		switch {
+		case req.EpisodeID != 0 && episode.ID == req.EpisodeID:
+			indexes = append(indexes, i)
+		case req.SeasonID != 0 && episode.SeasonID == req.SeasonID:
+			indexes = append(indexes, i)
+		case req.UpToEpisodeID != 0:
+			indexes = append(indexes, i)
+			if episode.ID == req.UpToEpisodeID {
+				return indexes, nil
+			}
+		}
+	}
+	// эпизод, до которого отмечается просмотр, не найден в сериале
+	if req.UpToEpisodeID != 0 || len(indexes) == 0 {
+		return nil, usecase.ErrWatchProgressEpisodeNotFound
+	}
+	return indexes, nil
+}
+
+// completeFavourite переводит досмотренный сериал в категорию watched. Сериалы, которых нет в избранном или
+// которые отмечены как favourite, не меняются: категория у контента одна, и отметка favourite потерялась бы
+func (w *WatchProgressService) completeFavourite(ctx context.Context, userID, contentID int) error {
+	status, err := w.favouriteUC.GetStatus(ctx, userID, contentID)
+	switch {
+	case errors.Is(err, usecase.ErrFavouriteNotFound):
+		return nil
+	case err != nil:
+		return err
+	}
+	switch status.Status {
+	case entity.FavouriteCategoryWatching, entity.FavouriteCategoryRewatching,
+		entity.FavouriteCategoryPlanned, entity.FavouriteCategoryAbandoned:
+		return w.favouriteUC.CreateFavourite(ctx, userID, contentID, entity.FavouriteCategoryWatched)
+	default:
+		return nil
+	}
+}
+
+func (w *WatchProgressService) GetProgress(ctx context.Context, userID, contentID int) (*dto.WatchProgress, error) {
+	ctx, span := tracing.Start(ctx, "WatchProgressService.GetProgress")
+	defer span.End()
+	progress, err := w.getWatchProgress(ctx, userID, contentID)
+	if err != nil {
+		return nil, err
+	}
+	return watchProgressEntityToDTO(progress), nil
+}
+
+func (w *WatchProgressService) UpdateProgress(
+	ctx context.Context,
+	userID, contentID int,
+	req dto.WatchProgressRequest,
+) (*dto.WatchProgress, error) {
+	ctx, span := tracing.Start(ctx, "WatchProgressService.UpdateProgress")
+	defer span.End()
+	progress, err := w.getWatchProgress(ctx, userID, contentID)
+	if err != nil {
+		return nil, err
+	}
+	indexes, err := selectEpisodes(progress, req)
+	if err != nil {
+		return nil, err
+	}
+	episodeIDs := make([]int, len(indexes))
+	for i, index := range indexes {
+		episodeIDs[i] = progress.Episodes[index].ID
+		progress.Episodes[index].Watched = req.Watched
+	}
+	if req.Watched {
+		err = w.progressRepo.MarkEpisodesWatched(ctx, userID, episodeIDs)
+	} else {
+		err = w.progressRepo.UnmarkEpisodesWatched(ctx, userID, episodeIDs)
+	}
+	if err != nil {
+		return nil, entity.UsecaseWrap(errors.New("ошибка при сохранении прогресса просмотра"), err)
+	}
+	invalidateUserStats(ctx, w.statsRepo, userID, entity.UserStatsSectionWatched, entity.UserStatsSectionActivity)
+	if req.Watched && progress.Completed() {
+		// отметки уже сохранены, поэтому ошибка смены категории не прерывает запрос
+		if err = w.completeFavourite(ctx, userID, contentID); err != nil {
+			logger.ForPackage("service").WarnContext(ctx, "не удалось перенести сериал в просмотренное",
+				"content_id", contentID,
+				"error", err,
+			)
+		}
+	}
+	return watchProgressEntityToDTO(progress), nil
+}
+
+func (w *WatchProgressService) GetNextEpisode(ctx context.Context, userID, contentID int) (*dto.SeasonEpisode, error) {
+	ctx, span := tracing.Start(ctx, "WatchProgressService.GetNextEpisode")
+	defer span.End()
+	progress, err := w.getWatchProgress(ctx, userID, contentID)
+	if err != nil {
+		return nil, err
+	}
+	next := progress.NextEpisode()
+	if next == nil {
+		return nil, usecase.ErrWatchProgressCompleted
+	}
+	return &dto.SeasonEpisode{
+		Episode:     episodeProgressEntityToDTO(*next).Episode,
+		SeasonID:    next.SeasonID,
+		SeasonTitle: next.SeasonTitle,
+	}, nil
+}
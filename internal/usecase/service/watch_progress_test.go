@@ -0,0 +1,257 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+	mockrepo "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/repository/mocks"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase"
+	mock_usecase "github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/usecase/mocks"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// testSeriesProgress - сериал из двух сезонов: эпизоды 1, 2 в первом и 3 во втором
+func testSeriesProgress(watched ...bool) *entity.WatchProgress {
+	progress := &entity.WatchProgress{ContentID: 2}
+	for i, w := range watched {
+		seasonID := 1
+		if i >= 2 {
+			seasonID = 2
+		}
+		progress.Episodes = append(progress.Episodes, entity.EpisodeProgress{
+			SeasonEpisode: entity.SeasonEpisode{
+				Episode:  entity.Episode{ID: i + 1, EpisodeNumber: i + 1},
+				SeasonID: seasonID,
+			},
+			Watched: w,
+		})
+	}
+	return progress
+}
+
+func TestWatchProgressService_UpdateProgress(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                  string
+		Request               dto.WatchProgressRequest
+		ExpectedWatched       int
+		ExpectedErr           error
+		SetupProgressRepoMock func(repo *mockrepo.MockWatchProgress)
+		SetupContentUCMock    func(uc *mock_usecase.MockContent)
+		SetupFavouriteUCMock  func(uc *mock_usecase.MockFavourite)
+	}{
+		{
+			Name:            "Отметка одного эпизода",
+			Request:         dto.WatchProgressRequest{Watched: true, EpisodeID: 2},
+			ExpectedWatched: 2,
+			SetupProgressRepoMock: func(repo *mockrepo.MockWatchProgress) {
+				repo.EXPECT().GetWatchProgress(gomock.Any(), 1, 2).Return(testSeriesProgress(true, false, false), nil)
+				repo.EXPECT().MarkEpisodesWatched(gomock.Any(), 1, []int{2}).Return(nil)
+			},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {
+				uc.EXPECT().GetPreviewContentByID(gomock.Any(), 2).
+					Return(&dto.PreviewContent{ID: 2, Type: entity.ContentTypeSeries}, nil)
+			},
+			SetupFavouriteUCMock: func(uc *mock_usecase.MockFavourite) {},
+		},
+		{
+			Name:            "Отметка сезона",
+			Request:         dto.WatchProgressRequest{Watched: true, SeasonID: 1},
+			ExpectedWatched: 2,
+			SetupProgressRepoMock: func(repo *mockrepo.MockWatchProgress) {
+				repo.EXPECT().GetWatchProgress(gomock.Any(), 1, 2).Return(testSeriesProgress(false, false, false), nil)
+				repo.EXPECT().MarkEpisodesWatched(gomock.Any(), 1, []int{1, 2}).Return(nil)
+			},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {
+				uc.EXPECT().GetPreviewContentByID(gomock.Any(), 2).
+					Return(&dto.PreviewContent{ID: 2, Type: entity.ContentTypeSeries}, nil)
+			},
+			SetupFavouriteUCMock: func(uc *mock_usecase.MockFavourite) {},
+		},
+		{
+			Name:            "Все эпизоды просмотрены, сериал переносится в просмотренное",
+			Request:         dto.WatchProgressRequest{Watched: true, UpToEpisodeID: 3},
+			ExpectedWatched: 3,
+			SetupProgressRepoMock: func(repo *mockrepo.MockWatchProgress) {
+				repo.EXPECT().GetWatchProgress(gomock.Any(), 1, 2).Return(testSeriesProgress(true, false, false), nil)
+				repo.EXPECT().MarkEpisodesWatched(gomock.Any(), 1, []int{1, 2, 3}).Return(nil)
+			},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {
+				uc.EXPECT().GetPreviewContentByID(gomock.Any(), 2).
+					Return(&dto.PreviewContent{ID: 2, Type: entity.ContentTypeSeries}, nil)
+			},
+			SetupFavouriteUCMock: func(uc *mock_usecase.MockFavourite) {
+				uc.EXPECT().GetStatus(gomock.Any(), 1, 2).
+					Return(&dto.FavouriteStatusResponse{Status: entity.FavouriteCategoryWatching}, nil)
+				uc.EXPECT().CreateFavourite(gomock.Any(), 1, 2, entity.FavouriteCategoryWatched).Return(nil)
+			},
+		},
+		{
+			Name:            "Все эпизоды просмотрены, сериал в категории favourite не переносится",
+			Request:         dto.WatchProgressRequest{Watched: true, EpisodeID: 3},
+			ExpectedWatched: 3,
+			SetupProgressRepoMock: func(repo *mockrepo.MockWatchProgress) {
+				repo.EXPECT().GetWatchProgress(gomock.Any(), 1, 2).Return(testSeriesProgress(true, true, false), nil)
+				repo.EXPECT().MarkEpisodesWatched(gomock.Any(), 1, []int{3}).Return(nil)
+			},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {
+				uc.EXPECT().GetPreviewContentByID(gomock.Any(), 2).
+					Return(&dto.PreviewContent{ID: 2, Type: entity.ContentTypeSeries}, nil)
+			},
+			SetupFavouriteUCMock: func(uc *mock_usecase.MockFavourite) {
+				uc.EXPECT().GetStatus(gomock.Any(), 1, 2).
+					Return(&dto.FavouriteStatusResponse{Status: entity.FavouriteCategoryFavourite}, nil)
+			},
+		},
+		{
+			Name:            "Снятие отметки",
+			Request:         dto.WatchProgressRequest{Watched: false, EpisodeID: 1},
+			ExpectedWatched: 0,
+			SetupProgressRepoMock: func(repo *mockrepo.MockWatchProgress) {
+				repo.EXPECT().GetWatchProgress(gomock.Any(), 1, 2).Return(testSeriesProgress(true, false, false), nil)
+				repo.EXPECT().UnmarkEpisodesWatched(gomock.Any(), 1, []int{1}).Return(nil)
+			},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {
+				uc.EXPECT().GetPreviewContentByID(gomock.Any(), 2).
+					Return(&dto.PreviewContent{ID: 2, Type: entity.ContentTypeSeries}, nil)
+			},
+			SetupFavouriteUCMock: func(uc *mock_usecase.MockFavourite) {},
+		},
+		{
+			Name:        "Эпизод из другого сериала",
+			Request:     dto.WatchProgressRequest{Watched: true, UpToEpisodeID: 10},
+			ExpectedErr: usecase.ErrWatchProgressEpisodeNotFound,
+			SetupProgressRepoMock: func(repo *mockrepo.MockWatchProgress) {
+				repo.EXPECT().GetWatchProgress(gomock.Any(), 1, 2).Return(testSeriesProgress(false, false, false), nil)
+			},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {
+				uc.EXPECT().GetPreviewContentByID(gomock.Any(), 2).
+					Return(&dto.PreviewContent{ID: 2, Type: entity.ContentTypeSeries}, nil)
+			},
+			SetupFavouriteUCMock: func(uc *mock_usecase.MockFavourite) {},
+		},
+		{
+			Name:        "Указано несколько полей",
+			Request:     dto.WatchProgressRequest{Watched: true, EpisodeID: 1, SeasonID: 1},
+			ExpectedErr: usecase.ErrWatchProgressInvalidRequest,
+			SetupProgressRepoMock: func(repo *mockrepo.MockWatchProgress) {
+				repo.EXPECT().GetWatchProgress(gomock.Any(), 1, 2).Return(testSeriesProgress(false, false, false), nil)
+			},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {
+				uc.EXPECT().GetPreviewContentByID(gomock.Any(), 2).
+					Return(&dto.PreviewContent{ID: 2, Type: entity.ContentTypeSeries}, nil)
+			},
+			SetupFavouriteUCMock: func(uc *mock_usecase.MockFavourite) {},
+		},
+		{
+			Name:                  "Фильм",
+			Request:               dto.WatchProgressRequest{Watched: true, EpisodeID: 1},
+			ExpectedErr:           usecase.ErrWatchProgressNotSeries,
+			SetupProgressRepoMock: func(repo *mockrepo.MockWatchProgress) {},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {
+				uc.EXPECT().GetPreviewContentByID(gomock.Any(), 2).
+					Return(&dto.PreviewContent{ID: 2, Type: entity.ContentTypeMovie}, nil)
+			},
+			SetupFavouriteUCMock: func(uc *mock_usecase.MockFavourite) {},
+		},
+		{
+			Name:                  "Контент не найден",
+			Request:               dto.WatchProgressRequest{Watched: true, EpisodeID: 1},
+			ExpectedErr:           usecase.ErrWatchProgressContentNotFound,
+			SetupProgressRepoMock: func(repo *mockrepo.MockWatchProgress) {},
+			SetupContentUCMock: func(uc *mock_usecase.MockContent) {
+				uc.EXPECT().GetPreviewContentByID(gomock.Any(), 2).Return(nil, usecase.ErrContentNotFound)
+			},
+			SetupFavouriteUCMock: func(uc *mock_usecase.MockFavourite) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockProgressRepo := mockrepo.NewMockWatchProgress(ctrl)
+			mockContentUC := mock_usecase.NewMockContent(ctrl)
+			mockFavouriteUC := mock_usecase.NewMockFavourite(ctrl)
+			tc.SetupProgressRepoMock(mockProgressRepo)
+			tc.SetupContentUCMock(mockContentUC)
+			tc.SetupFavouriteUCMock(mockFavouriteUC)
+			mockStatsRepo := mockrepo.NewMockUserStats(ctrl)
+			mockStatsRepo.EXPECT().
+				InvalidateUserStats(gomock.Any(), 1, entity.UserStatsSectionWatched, entity.UserStatsSectionActivity).
+				Return(nil).AnyTimes()
+			service := NewWatchProgressService(mockProgressRepo, mockContentUC, mockFavouriteUC, mockStatsRepo)
+			output, err := service.UpdateProgress(context.Background(), 1, 2, tc.Request)
+			require.Equal(t, tc.ExpectedErr, err)
+			if tc.ExpectedErr == nil {
+				require.Equal(t, tc.ExpectedWatched, output.Watched)
+				require.Equal(t, 3, output.Total)
+				require.Len(t, output.Seasons, 2)
+			}
+		})
+	}
+}
+
+func TestWatchProgressService_GetNextEpisode(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                  string
+		ExpectedOutput        *dto.SeasonEpisode
+		ExpectedErr           error
+		SetupProgressRepoMock func(repo *mockrepo.MockWatchProgress)
+	}{
+		{
+			Name: "Следующий эпизод в другом сезоне",
+			ExpectedOutput: &dto.SeasonEpisode{
+				Episode:  dto.Episode{ID: 3, EpisodeNumber: 3},
+				SeasonID: 2,
+			},
+			SetupProgressRepoMock: func(repo *mockrepo.MockWatchProgress) {
+				repo.EXPECT().GetWatchProgress(gomock.Any(), 1, 2).Return(testSeriesProgress(true, true, false), nil)
+			},
+		},
+		{
+			Name:        "Все эпизоды просмотрены",
+			ExpectedErr: usecase.ErrWatchProgressCompleted,
+			SetupProgressRepoMock: func(repo *mockrepo.MockWatchProgress) {
+				repo.EXPECT().GetWatchProgress(gomock.Any(), 1, 2).Return(testSeriesProgress(true, true, true), nil)
+			},
+		},
+		{
+			Name: "Ошибка при получении прогресса",
+			ExpectedErr: entity.UsecaseWrap(
+				errors.New("ошибка при получении прогресса просмотра"),
+				errors.New("database error"),
+			),
+			SetupProgressRepoMock: func(repo *mockrepo.MockWatchProgress) {
+				repo.EXPECT().GetWatchProgress(gomock.Any(), 1, 2).Return(nil, errors.New("database error"))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockProgressRepo := mockrepo.NewMockWatchProgress(ctrl)
+			mockContentUC := mock_usecase.NewMockContent(ctrl)
+			mockContentUC.EXPECT().GetPreviewContentByID(gomock.Any(), 2).
+				Return(&dto.PreviewContent{ID: 2, Type: entity.ContentTypeSeries}, nil)
+			tc.SetupProgressRepoMock(mockProgressRepo)
+			service := NewWatchProgressService(mockProgressRepo, mockContentUC, nil, nil)
+			output, err := service.GetNextEpisode(context.Background(), 1, 2)
+			require.Equal(t, tc.ExpectedOutput, output)
+			require.Equal(t, tc.ExpectedErr, err)
+		})
+	}
+}
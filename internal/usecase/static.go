@@ -1,6 +1,7 @@
 package usecase
 
 import (
+	"context"
 	"errors"
 	"io"
 )
@@ -10,17 +11,17 @@ type Static interface {
 	// GetStatic возвращает путь к статике по ID
 	// Возможные ошибки:
 	// ErrStaticNotFound - статика с таким id не найдена
-	GetStatic(staticID int) (string, error)
+	GetStatic(ctx context.Context, staticID int) (string, error)
 	// GetStaticFile возвращает статику по ID
 	// Возможные ошибки:
 	// ErrStaticNotFound - статика с таким id не найдена
-	GetStaticFile(staticURI string) (io.ReadSeeker, error)
+	GetStaticFile(ctx context.Context, staticURI string) (io.ReadSeeker, error)
 	// UploadAvatar загружает аватар на сервер
 	// Возможные ошибки:
 	// ErrStaticTooBigFile - файл слишком большой
 	// ErrStaticNotImage - файл не является валидным изображением
 	// ErrStaticImageDimensions - изображение имеет недопустимые размеры
-	UploadAvatar(reader io.ReadSeeker) (int, error)
+	UploadAvatar(ctx context.Context, reader io.ReadSeeker) (int, error)
 }
 
 var (
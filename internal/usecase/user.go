@@ -1,6 +1,7 @@
 package usecase
 
 import (
+	"context"
 	"errors"
 	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
 	"io"
@@ -12,31 +13,31 @@ type User interface {
 	// Возможные ошибки:
 	// ErrUserAlreadyExists - пользователь с такой почтой уже существует
 	// UserIncorrectDataError - некорректные данные
-	Register(*dto.Register) (int, error)
+	Register(ctx context.Context, register *dto.Register) (int, error)
 	// Login авторизация пользователя.
 	// Возможные ошибки:
 	// ErrUserNotFound - пользователь не найден
 	// UserIncorrectDataError - некорректные данные
-	Login(*dto.Login) (int, error)
+	Login(ctx context.Context, login *dto.Login) (int, error)
 	// UpdateAvatar обновление аватара пользователя.
 	// Возможные ошибки:
 	// ErrUserNotFound - пользователь не найден
 	// UserIncorrectDataError - некорректные данные
-	UpdateAvatar(userID int, reader io.ReadSeeker) error
+	UpdateAvatar(ctx context.Context, userID int, reader io.ReadSeeker) error
 	// UpdateInfo обновление информации о пользователе.
 	// Возможные ошибки:
 	// ErrUserNotFound - пользователь не найден
 	// UserIncorrectDataError - некорректные данные
-	UpdateInfo(userID int, update *dto.UserUpdate) error
+	UpdateInfo(ctx context.Context, userID int, update *dto.UserUpdate) error
 	// UpdatePassword обновление пароля пользователя.
 	// Возможные ошибки:
 	// ErrUserNotFound - пользователь не найден
 	// UserIncorrectDataError - некорректные данные
-	UpdatePassword(userID int, update *dto.UpdatePassword) error
+	UpdatePassword(ctx context.Context, userID int, update *dto.UpdatePassword) error
 	// GetUser получение профиля пользователя.
 	// Возможные ошибки:
 	// ErrUserNotFound - пользователь не найден
-	GetUser(userID int) (*dto.UserProfile, error)
+	GetUser(ctx context.Context, userID int) (*dto.UserProfile, error)
 }
 
 // UserIncorrectDataError это ошибка некорректных данных пользователя
@@ -0,0 +1,77 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_user_list.go
+type UserList interface {
+	// CreateList создает список пользователя
+	// Возможные ошибки:
+	// UserListErrorIncorrectData - некорректное название или описание
+	CreateList(ctx context.Context, userID int, req dto.UserListRequest) (*dto.UserList, error)
+	// UpdateList изменяет название, описание и видимость списка
+	// Возможные ошибки:
+	// ErrUserListNotFound - список не найден
+	// ErrUserListForbidden - список принадлежит другому пользователю
+	// UserListErrorIncorrectData - некорректное название или описание
+	UpdateList(ctx context.Context, userID, listID int, req dto.UserListRequest) (*dto.UserList, error)
+	// DeleteList удаляет список
+	// Возможные ошибки:
+	// ErrUserListNotFound - список не найден
+	// ErrUserListForbidden - список принадлежит другому пользователю
+	DeleteList(ctx context.Context, userID, listID int) error
+	// GetList возвращает список вместе с элементами. viewerID - ID текущего пользователя, у неавторизованного
+	// пользователя не совпадает ни с одним ID. Возвращает ошибку ErrUserListNotFound, если список не найден или
+	// это чужой приватный список или пользователь скрыл списки настройками приватности
+	GetList(ctx context.Context, viewerID, listID int) (*dto.UserListWithItems, error)
+	// GetUserLists возвращает списки пользователя ownerID. Чужие приватные списки не возвращаются.
+	// Возвращает ошибку ErrUserListsHidden, если пользователь скрыл списки настройками приватности
+	GetUserLists(ctx context.Context, viewerID, ownerID int) (*dto.UserLists, error)
+	// AddItem добавляет контент в конец списка
+	// Возможные ошибки:
+	// ErrUserListNotFound - список не найден
+	// ErrUserListForbidden - список принадлежит другому пользователю
+	// ErrUserListContentNotFound - контент не найден
+	// ErrUserListItemAlreadyExists - контент уже есть в списке
+	// UserListErrorIncorrectData - слишком длинная заметка
+	AddItem(ctx context.Context, userID, listID int, req dto.UserListItemRequest) error
+	// UpdateItem изменяет заметку к элементу списка
+	// Возможные ошибки:
+	// ErrUserListNotFound - список не найден
+	// ErrUserListForbidden - список принадлежит другому пользователю
+	// ErrUserListItemNotFound - контента нет в списке
+	// UserListErrorIncorrectData - слишком длинная заметка
+	UpdateItem(ctx context.Context, userID, listID, contentID int, req dto.UserListItemRequest) error
+	// RemoveItem удаляет контент из списка
+	// Возможные ошибки:
+	// ErrUserListNotFound - список не найден
+	// ErrUserListForbidden - список принадлежит другому пользователю
+	// ErrUserListItemNotFound - контента нет в списке
+	RemoveItem(ctx context.Context, userID, listID, contentID int) error
+	// ReorderItems задает новый порядок элементов списка
+	// Возможные ошибки:
+	// ErrUserListNotFound - список не найден
+	// ErrUserListForbidden - список принадлежит другому пользователю
+	// UserListErrorIncorrectData - новый порядок не совпадает с элементами списка
+	ReorderItems(ctx context.Context, userID, listID int, req dto.UserListOrderRequest) error
+}
+
+type UserListErrorIncorrectData struct {
+	Err error
+}
+
+func (e UserListErrorIncorrectData) Error() string {
+	return e.Err.Error()
+}
+
+var (
+	ErrUserListNotFound          = errors.New("список не найден")
+	ErrUserListForbidden         = errors.New("список принадлежит другому пользователю")
+	ErrUserListContentNotFound   = errors.New("контент не найден")
+	ErrUserListItemNotFound      = errors.New("контента нет в списке")
+	ErrUserListItemAlreadyExists = errors.New("контент уже есть в списке")
+	ErrUserListsHidden           = errors.New("пользователь скрыл списки")
+)
@@ -0,0 +1,42 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_user_rating.go
+type UserRating interface {
+	// SetRating оценка контента без рецензии. Повторная оценка заменяет предыдущую.
+	// Возможные ошибки:
+	// ErrUserRatingContentNotFound - контент не найден или еще не вышел
+	// ErrUserRatingOutOfRange - оценка не от 1 до 10
+	SetRating(ctx context.Context, set dto.UserRatingSet) (*dto.UserRating, error)
+	// DeleteRating удаление оценки.
+	// Возвращает ошибку ErrUserRatingNotFound, если оценка не найдена
+	DeleteRating(ctx context.Context, userID, contentID int) error
+	// GetRating получение оценки пользователя.
+	// Возвращает ошибку ErrUserRatingNotFound, если оценка не найдена
+	GetRating(ctx context.Context, userID, contentID int) (*dto.UserRating, error)
+	// GetUserRatings получение оценок пользователя вместе с превью контента, начиная с последних измененных
+	GetUserRatings(ctx context.Context, userID, count, page int) (*dto.UserRatingList, error)
+	// SetSeriesPartRating оценка сезона или эпизода сериала. Повторная оценка заменяет предыдущую.
+	// Возможные ошибки:
+	// ErrUserRatingSeriesPartNotFound - сезон или эпизод не найден
+	// ErrUserRatingOutOfRange - оценка не от 1 до 10
+	SetSeriesPartRating(ctx context.Context, set dto.SeriesPartRatingSet) error
+	// DeleteSeriesPartRating удаление оценки сезона или эпизода.
+	// Возможные ошибки:
+	// ErrUserRatingSeriesPartNotFound - сезон или эпизод не найден
+	// ErrUserRatingNotFound - оценка не найдена
+	DeleteSeriesPartRating(ctx context.Context, part string, userID, partID int) error
+}
+
+var (
+	ErrUserRatingNotFound        = errors.New("оценка не найдена")
+	ErrUserRatingContentNotFound = errors.New("контент не найден")
+	ErrUserRatingOutOfRange      = errors.New("оценка должна быть в диапазоне от 1 до 10")
+
+	ErrUserRatingSeriesPartNotFound = errors.New("сезон или эпизод не найден")
+)
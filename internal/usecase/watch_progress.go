@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"github.com/go-park-mail-ru/2024_1_Cyberkotletki/internal/entity/dto"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/mock_watch_progress.go
+type WatchProgress interface {
+	// GetProgress возвращает прогресс просмотра сериала
+	// Возможные ошибки:
+	// ErrWatchProgressContentNotFound - контент не найден
+	// ErrWatchProgressNotSeries - контент не является сериалом
+	GetProgress(ctx context.Context, userID, contentID int) (*dto.WatchProgress, error)
+	// UpdateProgress ставит или снимает отметки о просмотре и возвращает обновленный прогресс. Когда просмотрены
+	// все эпизоды, категория сериала в избранном меняется на watched
+	// Возможные ошибки:
+	// ErrWatchProgressContentNotFound - контент не найден
+	// ErrWatchProgressNotSeries - контент не является сериалом
+	// ErrWatchProgressEpisodeNotFound - эпизод или сезон не относится к сериалу
+	// ErrWatchProgressInvalidRequest - должно быть указано ровно одно из полей запроса
+	UpdateProgress(ctx context.Context, userID, contentID int, req dto.WatchProgressRequest) (*dto.WatchProgress, error)
+	// GetNextEpisode возвращает эпизод, с которого стоит продолжить просмотр
+	// Возможные ошибки:
+	// ErrWatchProgressContentNotFound - контент не найден
+	// ErrWatchProgressNotSeries - контент не является сериалом
+	// ErrWatchProgressCompleted - все эпизоды просмотрены
+	GetNextEpisode(ctx context.Context, userID, contentID int) (*dto.SeasonEpisode, error)
+}
+
+var (
+	ErrWatchProgressContentNotFound = errors.New("контент не найден")
+	ErrWatchProgressNotSeries       = errors.New("контент не является сериалом")
+	ErrWatchProgressEpisodeNotFound = errors.New("эпизод или сезон не найден в сериале")
+	ErrWatchProgressInvalidRequest  = errors.New("нужно указать ровно один эпизод, сезон или эпизод, до которого " +
+		"отмечается просмотр")
+	ErrWatchProgressCompleted = errors.New("все эпизоды просмотрены")
+)
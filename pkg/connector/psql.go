@@ -1,15 +1,21 @@
 package connector
 
-import "github.com/jmoiron/sqlx"
+import (
+	"github.com/XSAM/otelsql"
+	"github.com/jmoiron/sqlx"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+)
 
 func GetPostgresConnector(dsn string) (*sqlx.DB, error) {
-	db, err := sqlx.Open("postgres", dsn)
+	// otelsql создает спан на каждый запрос, если в контексте есть активная трассировка
+	db, err := otelsql.Open("postgres", dsn, otelsql.WithAttributes(semconv.DBSystemPostgreSQL))
 	if err != nil {
 		return nil, err
 	}
-	if err = db.Ping(); err != nil {
+	dbx := sqlx.NewDb(db, "postgres")
+	if err = dbx.Ping(); err != nil {
 		return nil, err
 	}
-	db.SetMaxOpenConns(0)
-	return db, nil
+	dbx.SetMaxOpenConns(0)
+	return dbx, nil
 }
@@ -2,6 +2,7 @@ package connector
 
 import (
 	"context"
+	"github.com/redis/go-redis/extra/redisotel/v9"
 	"github.com/redis/go-redis/v9"
 )
 
@@ -11,6 +12,9 @@ func GetRedisConnector(addr, password string, db int) (*redis.Client, error) {
 		Password: password,
 		DB:       db,
 	})
+	if err := redisotel.InstrumentTracing(rdb); err != nil {
+		return nil, err
+	}
 	if err := rdb.Ping(context.Background()).Err(); err != nil {
 		return nil, err
 	}